@@ -100,14 +100,16 @@ func (avv *AsyncVoteVerifier) executeVoteVerification(task interface{}) interfac
 	select {
 	case <-req.ctx.Done():
 		// request cancelled, return an error response on the channel
-		return &asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: &req, index: req.index}
+		return &asyncVerifyVoteResponse{err: context.Cause(req.ctx), cancelled: true, req: &req, index: req.index}
 	default:
 		// request was not cancelled, so we verify it here and return the result on the channel
-		v, err := req.uv.verify(req.l)
+		v, err := req.uv.verifyContext(req.ctx, req.l)
 		req.message.Vote = v
 
+		// A vote whose weight lookups were abandoned because the request went
+		// stale meanwhile is reported as cancelled, like one never verified.
 		var e *LedgerDroppedRoundError
-		cancelled := errors.As(err, &e)
+		cancelled := errors.As(err, &e) || (err != nil && req.ctx.Err() != nil)
 
 		return &asyncVerifyVoteResponse{v: v, index: req.index, message: req.message, err: err, cancelled: cancelled, req: &req}
 	}
@@ -119,13 +121,13 @@ func (avv *AsyncVoteVerifier) executeEqVoteVerification(task interface{}) interf
 	select {
 	case <-req.ctx.Done():
 		// request cancelled, return an error response on the channel
-		return &asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: &req, index: req.index}
+		return &asyncVerifyVoteResponse{err: context.Cause(req.ctx), cancelled: true, req: &req, index: req.index}
 	default:
 		// request was not cancelled, so we verify it here and return the result on the channel
-		ev, err := req.uev.verify(req.l)
+		ev, err := req.uev.verifyContext(req.ctx, req.l)
 
 		var e *LedgerDroppedRoundError
-		cancelled := errors.As(err, &e)
+		cancelled := errors.As(err, &e) || (err != nil && req.ctx.Err() != nil)
 
 		return &asyncVerifyVoteResponse{ev: ev, index: req.index, message: req.message, err: err, cancelled: cancelled, req: &req}
 	}
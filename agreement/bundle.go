@@ -189,6 +189,13 @@ func (b unauthenticatedBundle) verifyAsync(ctx context.Context, l LedgerReader,
 		voters[ev.Sender] = true
 	}
 
+	// resolve the external weights of all voters before verifying any vote
+	rvs := make([]rawVote, 0, len(voters))
+	for sender := range voters {
+		rvs = append(rvs, rawVote{Sender: sender, Round: b.Round})
+	}
+	resolveWeights(ctx, l, rvs)
+
 	// make a buffer large enough to queue all results so we never wait
 	results := make(chan asyncVerifyVoteResponse, len(b.Votes)+len(b.EquivocationVotes))
 
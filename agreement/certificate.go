@@ -37,10 +37,7 @@ type Certificate unauthenticatedBundle
 // Callers may want to cache the result of this check, as it is relatively
 // expensive.
 func (c Certificate) Authenticate(e bookkeeping.Block, l LedgerReader, avv *AsyncVoteVerifier) (err error) {
-	if c.Step != cert {
-		return fmt.Errorf("certificate step is %v != Cert", c.Step)
-	}
-	err = c.claimsToAuthenticate(e)
+	err = c.CheckClaims(e)
 	if err != nil {
 		return
 	}
@@ -48,6 +45,17 @@ func (c Certificate) Authenticate(e bookkeeping.Block, l LedgerReader, avv *Asyn
 	return
 }
 
+// CheckClaims checks that the certificate is for the cert step and claims to
+// authenticate block e, without verifying any of its votes. Nodes that cannot
+// look up the external weights the votes are verified with, such as followers
+// without a weight oracle, accept blocks on this check alone.
+func (c Certificate) CheckClaims(e bookkeeping.Block) error {
+	if c.Step != cert {
+		return fmt.Errorf("certificate step is %v != Cert", c.Step)
+	}
+	return c.claimsToAuthenticate(e)
+}
+
 // claimsToAuthenticate(b, r) checks whether this certificate claims that block b was agreed on in round r.
 // Separately, the certificate itself will need to be checked, and its votes will need to be checked against a mu that's sufficiently up-to-date to get selection parameters.
 // Fetching code could potentially do this part of the checking before the mu is caught up.
@@ -366,3 +366,31 @@ func TestCertificateDupVote(t *testing.T) {
 	require.Error(t, verifyBundleAgainstLedger(bundle, ledger, avv))
 	require.Error(t, cert.Authenticate(block, ledger, avv))
 }
+
+// TestCertificateCheckClaims checks that CheckClaims accepts a certificate
+// for the block it names without verifying its votes.
+func TestCertificateCheckClaims(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+	block := makeRandomBlock(1)
+
+	votes := make([]vote, 0)
+	for j, addr := range addresses {
+		vote, err := makeVoteTesting(addr, vrfSecrets[j], otSecrets[j], ledger, round, 0, cert, block.Digest())
+		if err == nil {
+			votes = append(votes, vote)
+		}
+	}
+	c := makeCertTesting(block.Digest(), votes, nil)
+	require.NoError(t, c.CheckClaims(block))
+
+	// Votes are not verified.
+	c.Votes[0].Sig = crypto.OneTimeSignature{}
+	require.NoError(t, c.CheckClaims(block))
+
+	require.Error(t, c.CheckClaims(makeRandomBlock(2)))
+	c.Step = soft
+	require.Error(t, c.CheckClaims(block))
+}
@@ -19,6 +19,7 @@ package agreement
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -129,6 +130,8 @@ type (
 		validator        BlockValidator
 		ledger           LedgerReader
 		proposalContexts pendingRequestsContext
+		shedder          *voteShedder
+		voteResults      chan asyncVerifyVoteResponse
 		log              logging.Logger
 
 		quit chan struct{}
@@ -164,12 +167,21 @@ func makeCryptoVerifier(l LedgerReader, v BlockValidator, voteVerifier *AsyncVot
 		ledger:           l,
 		validator:        v,
 		proposalContexts: makePendingRequestsContext(),
+		shedder:          makeVoteShedder(l),
 		quit:             make(chan struct{}),
 	}
 	c.votes = voteChanPair{
 		in:  make(chan cryptoVoteRequest, voteVerifier.Parallelism()),
 		out: make(chan asyncVerifyVoteResponse, 3*voteVerifier.Parallelism()),
 	}
+	c.voteResults = c.votes.out
+	if c.shedder != nil {
+		// Pass the results of vote verification by the shedder, so that it
+		// can forget votes whose verification failed.
+		c.voteResults = make(chan asyncVerifyVoteResponse, cap(c.votes.out))
+		c.wg.Add(1)
+		go c.voteResultWorker()
+	}
 	c.bundles = bundleChanPair{
 		in:  make(chan cryptoBundleRequest, 1),
 		out: make(chan cryptoResult, 3),
@@ -202,6 +214,7 @@ func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
 	defer close(toBundleWait)
 	defer c.wg.Done()
 
+	batch := make([]cryptoVoteRequest, 0, cap(c.votes.in))
 	for {
 		select {
 		case votereq, ok := <-votesin:
@@ -213,16 +226,39 @@ func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
 				continue
 			}
 
-			uv := votereq.message.UnauthenticatedVote
-			err := c.voteVerifier.verifyVote(votereq.ctx, c.ledger, uv, votereq.TaskIndex, votereq.message, c.votes.out)
-			if err != nil && c.votes.out != nil {
+			// Take the votes already waiting too, so that the weights
+			// they need are resolved together before any is verified.
+			batch = append(batch[:0], votereq)
+		drain:
+			for len(batch) < cap(c.votes.in) {
 				select {
-				case c.votes.out <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
+				case req, ok := <-votesin:
+					if !ok {
+						votesin = nil
+						break drain
+					}
+					batch = append(batch, req)
 				default:
-					voteVerifierOutFullCounter.Inc(nil)
-					c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
+					break drain
+				}
+			}
+			c.resolveVoteWeights(batch)
+
+			for _, votereq := range batch {
+				uv := votereq.message.UnauthenticatedVote
+				err := c.voteVerifier.verifyVote(votereq.ctx, c.ledger, uv, votereq.TaskIndex, votereq.message, c.voteResults)
+				if err != nil && c.votes.out != nil {
+					select {
+					case c.votes.out <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
+					default:
+						voteVerifierOutFullCounter.Inc(nil)
+						c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
+					}
 				}
 			}
+			if votesin == nil && bundlesin == nil {
+				return
+			}
 		case bundlereq, ok := <-bundlesin:
 			if !ok {
 				bundlesin = nil
@@ -251,6 +287,64 @@ func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
 	}
 }
 
+// voteResultWorker tells the shedder how the verification of each vote went
+// and passes its result on.
+func (c *poolCryptoVerifier) voteResultWorker() {
+	defer c.wg.Done()
+	for {
+		select {
+		case res := <-c.voteResults:
+			c.shedder.verified(res)
+			select {
+			case c.votes.out <- res:
+			case <-c.quit:
+				return
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// resolveVoteWeights resolves the external weights needed by the requests
+// that have not been cancelled. The lookups are abandoned once all of those
+// requests are cancelled too, or the verifier quits.
+func (c *poolCryptoVerifier) resolveVoteWeights(batch []cryptoVoteRequest) {
+	live := make([]context.Context, 0, len(batch))
+	votes := make([]rawVote, 0, len(batch))
+	for _, req := range batch {
+		if req.ctx.Err() == nil {
+			live = append(live, req.ctx)
+			votes = append(votes, req.message.UnauthenticatedVote.R)
+		}
+	}
+	if len(votes) < 2 {
+		// Nothing to resolve together; see resolveWeights.
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var pending atomic.Int32
+	pending.Store(int32(len(live)))
+	for _, reqCtx := range live {
+		stop := context.AfterFunc(reqCtx, func() {
+			if pending.Add(-1) == 0 {
+				cancel()
+			}
+		})
+		defer stop()
+	}
+	go func() {
+		select {
+		case <-c.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	resolveWeights(ctx, c.ledger, votes)
+}
+
 func (c *poolCryptoVerifier) bundleWaitWorker(fromVoteFill <-chan bundleFuture) {
 	defer c.wg.Done()
 	for future := range fromVoteFill {
@@ -281,6 +375,10 @@ func (c *poolCryptoVerifier) bundleWaitWorker(fromVoteFill <-chan bundleFuture)
 func (c *poolCryptoVerifier) VerifyVote(ctx context.Context, request cryptoVoteRequest) {
 	c.proposalContexts.clearStaleContexts(request.Round, request.Period, false, false)
 	request.ctx = c.proposalContexts.addVote(request)
+	if c.shedder.shed(request) {
+		voteVerifierShedCounter.Inc(nil)
+		request.ctx = shedVoteContext
+	}
 	switch request.Tag {
 	case protocol.AgreementVoteTag:
 		select {
@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
 	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/timers"
 )
@@ -62,6 +63,7 @@ type Fuzzer struct {
 	blockValidator   agreement.BlockValidator
 	agreementParams  []agreement.Parameters
 	disableTraces    bool
+	oracles          []*mock.Oracle
 }
 
 type FuzzerConfig struct {
@@ -71,6 +73,10 @@ type FuzzerConfig struct {
 	Filters       []NetworkFilterFactory
 	LogLevel      logging.Level
 	DisableTraces bool
+	// Weights, when set, gives node i's account the consensus weight
+	// Weights[i], served to each node by its own in-memory weight oracle
+	// (see Fuzzer.Oracle). Otherwise weights follow stake.
+	Weights []uint64
 }
 
 // MakeFuzzer creates a fuzzer object with nodesCount nodes.
@@ -107,6 +113,9 @@ func MakeFuzzer(config FuzzerConfig) *Fuzzer {
 	n.log.SetLevel(config.LogLevel)
 
 	n.initAccountsAndBalances((&[32]byte{})[:], config.OnlineNodes)
+	if len(config.Weights) > 0 {
+		n.initOracles(config.Weights)
+	}
 	for i := range n.agreements {
 		if !n.initAgreementNode(i, config.Filters...) {
 			return nil
@@ -121,6 +130,9 @@ func (n *Fuzzer) initAgreementNode(nodeID int, filters ...NetworkFilterFactory)
 	n.disconnected[nodeID] = make([]bool, n.nodesCount)
 	n.facades[nodeID] = MakeNetworkFacade(n, nodeID)
 	n.ledgers[nodeID] = makeTestLedger(n.balances, n.LedgerSync)
+	if n.oracles != nil {
+		n.ledgers[nodeID].oracle = n.oracles[nodeID]
+	}
 	n.clocks[nodeID] = n.facades[nodeID]
 
 	n.crashAccessors[nodeID], err = db.MakeAccessor(n.networkName+"_"+strconv.Itoa(nodeID)+"_crash.db", false, true)
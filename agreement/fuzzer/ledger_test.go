@@ -137,6 +137,10 @@ type testLedger struct {
 	// constant
 	state map[basics.Address]basics.AccountData
 
+	// oracle, when set, answers ExternalWeight and TotalExternalWeight
+	// instead of the stake in state.
+	oracle ledgercore.WeightOracle
+
 	notifications map[basics.Round]signal
 
 	Sync                  testLedgerSyncFunc
@@ -353,7 +357,7 @@ func (l *testLedger) ConsensusVersion(r basics.Round) (protocol.ConsensusVersion
 }
 
 // ExternalWeight implements ledgercore.ExternalWeighter for testing.
-// Uses the account's stake (MicroAlgos) as the weight for backward compatibility.
+// Without an oracle, it uses the account's stake (MicroAlgos) as the weight for backward compatibility.
 func (l *testLedger) ExternalWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -362,6 +366,9 @@ func (l *testLedger) ExternalWeight(balanceRound basics.Round, addr basics.Addre
 		err := fmt.Errorf("ExternalWeight called on future round: %v >= %v! (this is probably a bug)", balanceRound, l.nextRound)
 		panic(err)
 	}
+	if l.oracle != nil {
+		return l.oracle.Weight(balanceRound, addr, selectionID)
+	}
 
 	acct, ok := l.state[addr]
 	if !ok {
@@ -374,7 +381,7 @@ func (l *testLedger) ExternalWeight(balanceRound basics.Round, addr basics.Addre
 }
 
 // TotalExternalWeight implements ledgercore.ExternalWeighter for testing.
-// Returns the sum of Online account stakes as the total weight.
+// Without an oracle, it returns the sum of Online account stakes as the total weight.
 // This matches the behavior of Circulation() which only counts voting stake.
 func (l *testLedger) TotalExternalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
 	l.mu.Lock()
@@ -384,6 +391,9 @@ func (l *testLedger) TotalExternalWeight(balanceRound basics.Round, voteRound ba
 		err := fmt.Errorf("TotalExternalWeight called on future round: %v >= %v! (this is probably a bug)", balanceRound, l.nextRound)
 		panic(err)
 	}
+	if l.oracle != nil {
+		return l.oracle.TotalWeight(balanceRound, voteRound)
+	}
 
 	var total uint64
 	for _, rec := range l.state {
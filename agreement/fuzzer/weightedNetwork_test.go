@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package fuzzer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// initOracles gives every node its own weight oracle, all of them answering
+// with the same weights, as the daemons of a real network must.
+func (n *Fuzzer) initOracles(weights []uint64) {
+	if len(weights) != n.nodesCount {
+		panic(fmt.Sprintf("%d weights for %d nodes", len(weights), n.nodesCount))
+	}
+	var total uint64
+	for i, w := range weights {
+		if n.balances[n.accounts[i].Parent].Status != basics.Online {
+			continue
+		}
+		if w == 0 {
+			// agreement treats a zero weight for an eligible account as a
+			// broken daemon and panics.
+			panic(fmt.Sprintf("online node %d has zero weight", i))
+		}
+		total += w
+	}
+
+	n.oracles = make([]*mock.Oracle, n.nodesCount)
+	for nodeID := range n.oracles {
+		o := mock.New()
+		for i, w := range weights {
+			o.SetWeight(n.accounts[i].Parent, w)
+		}
+		o.SetTotalWeight(total)
+		n.oracles[nodeID] = o
+	}
+}
+
+// Oracle returns the weight oracle of node nodeID, through which tests change
+// the weights it sees or make its queries fail. It is nil unless the fuzzer
+// was configured with Weights.
+func (n *Fuzzer) Oracle(nodeID int) *mock.Oracle {
+	if n.oracles == nil {
+		return nil
+	}
+	return n.oracles[nodeID]
+}
+
+// ProposerCounts returns how many of the blocks in node nodeID's ledger each
+// node proposed.
+func (n *Fuzzer) ProposerCounts(nodeID int) []int {
+	nodes := make(map[basics.Address]int, n.nodesCount)
+	for i, acct := range n.accounts {
+		nodes[acct.Parent] = i
+	}
+	counts := make([]int, n.nodesCount)
+	l := n.ledgers[nodeID]
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, block := range l.entries {
+		if i, ok := nodes[block.Proposer()]; ok {
+			counts[i]++
+		}
+	}
+	return counts
+}
+
+func TestWeightedNetworkWhale(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// The first node holds 90% of the weight but the same stake as the others.
+	network := MakeFuzzer(FuzzerConfig{
+		FuzzerName:    "weightedNetworkWhale",
+		NodesCount:    5,
+		Weights:       []uint64{9000, 250, 250, 250, 250},
+		LogLevel:      logging.Error,
+		DisableTraces: true,
+	})
+	require.NotNil(t, network)
+	network.Start()
+	ok, result := network.Run(100, 20, 100)
+	require.False(t, result.NetworkStalled)
+	network.Shutdown()
+	require.True(t, ok, "nodes ended at rounds %d-%d", result.PostRecoveryLowRound, result.PostRecoveryHighRound)
+
+	counts := network.ProposerCounts(0)
+	blocks := 0
+	for _, c := range counts {
+		blocks += c
+	}
+	require.GreaterOrEqual(t, blocks, 5)
+	require.Greater(t, counts[0], blocks/2, "proposers: %v", counts)
+}
+
+func TestWeightedNetworkOracleFault(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	network := MakeFuzzer(FuzzerConfig{
+		FuzzerName:    "weightedNetworkOracleFault",
+		NodesCount:    5,
+		Weights:       []uint64{3000, 3000, 3000, 3000, 500},
+		LogLevel:      logging.Error,
+		DisableTraces: true,
+	})
+	require.NotNil(t, network)
+
+	// The light node's daemon fails every weight query: it can neither vote
+	// nor verify votes, but the others hold enough weight to go on without it.
+	faulty := network.Oracle(4)
+	faulty.SetWeightError(&ledgercore.DaemonError{Code: "internal", Msg: "database unavailable"})
+	network.Start()
+	_, result := network.Run(100, 0, 100)
+	require.False(t, result.NetworkStalled)
+	low, high := network.CheckRounds()
+	require.Greater(t, high, result.StartHighRound)
+	require.Equal(t, network.ledgers[4].NextRound(), low, "the faulty node kept up")
+	for i := 0; i < 4; i++ {
+		require.Equal(t, high, network.ledgers[i].NextRound(), "node %d", i)
+	}
+
+	// Once its daemon recovers, the node catches up and rejoins.
+	faulty.SetWeightError(nil)
+	ok, result := network.Run(20, 50, 100)
+	require.False(t, result.NetworkStalled)
+	network.Shutdown()
+	require.True(t, ok, "nodes ended at rounds %d-%d", result.PostRecoveryLowRound, result.PostRecoveryHighRound)
+	require.Greater(t, result.PostRecoveryHighRound, high)
+}
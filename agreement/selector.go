@@ -17,6 +17,7 @@
 package agreement
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -69,6 +71,14 @@ func seedRound(r basics.Round, cparams config.ConsensusParams) basics.Round {
 
 // membership obtains membership verification parameters for the given address and round.
 func membership(l LedgerReader, addr basics.Address, r basics.Round, p period, s step) (m committee.Membership, err error) {
+	return membershipContext(context.Background(), l, addr, r, p, s)
+}
+
+// membershipContext is membership, with the external weight lookups abandoned
+// once ctx is done. Vote verification passes the context of its request, which
+// is done once the vote's round and period are stale, so that a slow weight
+// daemon is not waited on for a vote that would be dropped anyway.
+func membershipContext(ctx context.Context, l LedgerReader, addr basics.Address, r basics.Round, p period, s step) (m committee.Membership, err error) {
 	cparams, err := l.ConsensusParams(ParamsRound(r))
 	if err != nil {
 		return
@@ -76,11 +86,37 @@ func membership(l LedgerReader, addr basics.Address, r basics.Round, p period, s
 	balanceRound := BalanceRound(r, cparams)
 	seedRound := seedRound(r, cparams)
 
-	record, err := l.LookupAgreement(balanceRound, addr)
+	var wd ledgercore.WeightedAgreementData
+	if cparams.EnableExternalWeightOracle {
+		wd, err = lookupWeighted(ctx, l, balanceRound, r, addr)
+	} else {
+		wd.OnlineAccountData, err = l.LookupAgreement(balanceRound, addr)
+	}
 	if err != nil {
-		err = fmt.Errorf("membership (r=%d): Failed to obtain balance record for address %v in round %d: %w", r, addr, balanceRound, err)
+		var we *ledgercore.ExternalWeightError
+		if !errors.As(err, &we) {
+			err = fmt.Errorf("membership (r=%d): Failed to obtain balance record for address %v in round %d: %w", r, addr, balanceRound, err)
+			return
+		}
+		// Check error type: not_found/bad_request/unsupported are invariant violations
+		// (we only query for key-eligible participants per §3.2), internal is operational
+		var de *ledgercore.DaemonError
+		if errors.As(err, &de) && de.Code != "internal" {
+			// not_found, bad_request, unsupported → invariant violation
+			if we.Total {
+				weightInvariantViolation(r, nil, "daemon invariant violation for total weight: %v", err)
+			}
+			weightInvariantViolation(r, &addr, "daemon invariant violation for addr %v: %v", addr, err)
+		}
+		// internal or network error → return error for operational handling
+		if we.Total {
+			err = fmt.Errorf("membership (r=%d): Failed to obtain total external weight: %w", r, err)
+		} else {
+			err = fmt.Errorf("membership (r=%d): Failed to obtain external weight for address %v: %w", r, addr, err)
+		}
 		return
 	}
+	record := wd.OnlineAccountData
 
 	total, err := l.Circulation(balanceRound, r)
 	if err != nil {
@@ -97,65 +133,107 @@ func membership(l LedgerReader, addr basics.Address, r basics.Round, p period, s
 	m.Record = committee.BalanceRecord{OnlineAccountData: record, Addr: addr}
 	m.Selector = selector{Seed: seed, Round: r, Period: p, Step: s}
 	m.TotalMoney = total
+	m.BalanceRound = balanceRound
 
-	// CRITICAL: Gate weight queries on vote-key validity (see DD §3.2).
-	// membership() is called BEFORE vote-key validity checks in vote.go,
-	// so we may receive messages from accounts with expired/invalid keys.
-	// Without this check, we would panic on valid daemon responses for ineligible accounts.
-	keyEligible := (r >= record.VoteFirstValid) && (record.VoteLastValid == 0 || r <= record.VoteLastValid)
-
-	if !keyEligible {
-		// Leave ExternalWeight and TotalExternalWeight as zero.
-		// vote.verify will reject this message immediately afterward
-		// based on the same key validity check.
+	if !cparams.EnableExternalWeightOracle {
+		// Stake-based protocol: sortition uses the record's voting stake.
 		return m, nil
 	}
-
-	// Fetch external weights - REQUIRED for this weighted-selection network.
-	// Only reached for accounts with valid vote keys at round r.
-	ew, ok := l.(ledgercore.ExternalWeighter)
-	if !ok {
-		// This is a local invariant violation: startup should have validated oracle configuration.
-		logging.Base().Panicf("membership (r=%d): weighted network requires ExternalWeighter support", r)
-	}
-
-	m.ExternalWeight, err = ew.ExternalWeight(balanceRound, addr, record.SelectionID)
-	if err != nil {
-		// Check error type: not_found/bad_request/unsupported are invariant violations
-		// (we only query for key-eligible participants per §3.2), internal is operational
-		var de *ledgercore.DaemonError
-		if errors.As(err, &de) && de.Code != "internal" {
-			// not_found, bad_request, unsupported → invariant violation
-			logging.Base().Panicf("membership (r=%d): daemon invariant violation for addr %v: %v", r, addr, err)
-		}
-		// internal or network error → return error for operational handling
-		err = fmt.Errorf("membership (r=%d): Failed to obtain external weight for address %v: %w", r, addr, err)
-		return
-	}
-
-	m.TotalExternalWeight, err = ew.TotalExternalWeight(balanceRound, r)
-	if err != nil {
-		var de *ledgercore.DaemonError
-		if errors.As(err, &de) && de.Code != "internal" {
-			logging.Base().Panicf("membership (r=%d): daemon invariant violation for total weight: %v", r, err)
-		}
-		err = fmt.Errorf("membership (r=%d): Failed to obtain total external weight: %w", r, err)
-		return
+	if !wd.KeyEligible {
+		// Leave ExternalWeight and TotalExternalWeight as zero. vote.verify
+		// rejects this message immediately afterward, on the same key
+		// validity check or, for an account excluded by a weight override,
+		// because its credential has no weight.
+		return m, nil
 	}
+	m.ExternalWeight = wd.ExternalWeight
+	m.TotalExternalWeight = wd.TotalExternalWeight
 
 	// Validate non-zero weight requirements per protocol spec.
 	if m.ExternalWeight == 0 {
-		logging.Base().Panicf("membership (r=%d): eligible participant %v has zero weight (invalid daemon state)", r, addr)
+		weightInvariantViolation(r, &addr, "eligible participant %v has zero weight (invalid daemon state)", addr)
 	}
 	if m.TotalExternalWeight == 0 {
-		logging.Base().Panicf("membership (r=%d): total weight is zero (invalid daemon state)", r)
+		weightInvariantViolation(r, nil, "total weight is zero (invalid daemon state)")
 	}
 
 	// Validate population alignment: total must include this account's weight
 	if m.TotalExternalWeight < m.ExternalWeight {
-		logging.Base().Panicf("membership (r=%d): TotalExternalWeight %d < ExternalWeight %d (population alignment violated)",
-			r, m.TotalExternalWeight, m.ExternalWeight)
+		weightInvariantViolation(r, &addr, "TotalExternalWeight %d < ExternalWeight %d (population alignment violated)",
+			m.TotalExternalWeight, m.ExternalWeight)
+	}
+
+	// On networks with signed weights, attach the daemon's attestation so
+	// credential verification can check it. Node startup refuses such a
+	// protocol unless the weight oracle can attest weights, so a ledger
+	// without the support only fails the vote.
+	if cparams.ExternalWeightAttestation {
+		wa, ok := l.(ledgercore.ExternalWeightAttester)
+		if !ok {
+			err = fmt.Errorf("membership (r=%d): ledger cannot obtain the weight attestations the protocol requires", r)
+			return
+		}
+		var att committee.WeightAttestation
+		att, err = wa.ExternalWeightAttestation(balanceRound, addr, record.SelectionID)
+		if err != nil {
+			err = fmt.Errorf("membership (r=%d): Failed to obtain weight attestation for address %v: %w", r, addr, err)
+			return
+		}
+		m.Attestation = &att
+		m.GenesisHash = wa.GenesisHash()
 	}
 
 	return m, nil
 }
+
+// weightInvariantViolation reports a violated invariant of the weight oracle
+// protocol, found checking membership in round r, to telemetry and panics. addr
+// is the account whose weights violate it, nil if the violation is not its own.
+func weightInvariantViolation(r basics.Round, addr *basics.Address, format string, args ...interface{}) {
+	reason := fmt.Sprintf(format, args...)
+	details := telemetryspec.WeightOracleInvariantViolationEventDetails{Round: uint64(r), Reason: reason}
+	if addr != nil {
+		details.Address = addr.String()
+	}
+	log := logging.Base()
+	log.EventWithDetails(telemetryspec.Agreement, telemetryspec.WeightOracleInvariantViolationEvent, details)
+	log.Panicf("membership (r=%d): %s", r, reason)
+}
+
+// lookupWeighted obtains the account's agreement data and, if its vote key is
+// valid at r, its external weights. Ledgers implementing
+// ledgercore.ContextWeightedAgreementLookup or
+// ledgercore.WeightedAgreementLookup answer in a single call; otherwise the
+// data is assembled from LookupAgreement and the ledgercore.ExternalWeighter
+// methods. The weight lookups are abandoned once ctx is done, as far as the
+// ledger allows.
+func lookupWeighted(ctx context.Context, l LedgerReader, balanceRound basics.Round, r basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+	if wl, ok := l.(ledgercore.ContextWeightedAgreementLookup); ok {
+		return wl.LookupAgreementWeightedContext(ctx, balanceRound, r, addr)
+	}
+	if wl, ok := l.(ledgercore.WeightedAgreementLookup); ok {
+		return wl.LookupAgreementWeighted(balanceRound, r, addr)
+	}
+
+	record, err := l.LookupAgreement(balanceRound, addr)
+	if err != nil {
+		return ledgercore.WeightedAgreementData{}, err
+	}
+
+	// CRITICAL: Gate weight queries on vote-key validity (see DD §3.2).
+	// membership() is called BEFORE vote-key validity checks in vote.go,
+	// so we may receive messages from accounts with expired/invalid keys.
+	// Without this check, we would panic on valid daemon responses for ineligible accounts.
+	if !ledgercore.VoteKeyEligible(record, r) {
+		return ledgercore.WeightedAgreementData{OnlineAccountData: record}, nil
+	}
+
+	// Fetch external weights - REQUIRED for this weighted-selection network.
+	// Only reached for accounts with valid vote keys at round r.
+	ew, ok := l.(ledgercore.ExternalWeighter)
+	if !ok {
+		// This is a local invariant violation: startup should have validated oracle configuration.
+		weightInvariantViolation(r, nil, "weighted network requires ExternalWeighter support")
+	}
+	return ledgercore.AttachExternalWeightsContext(ctx, record, ew, balanceRound, r, addr)
+}
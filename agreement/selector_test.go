@@ -17,6 +17,7 @@
 package agreement
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	oraclemock "github.com/algorand/go-algorand/node/weightoracle/mock"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -39,13 +41,8 @@ type mockLedgerReaderWithWeights struct {
 	seedFn            func(basics.Round) (committee.Seed, error)
 	consensusParamsFn func(basics.Round) (config.ConsensusParams, error)
 
-	// ExternalWeighter method implementations (nil means not supported)
-	externalWeightFn      func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error)
-	totalExternalWeightFn func(basics.Round, basics.Round) (uint64, error)
-
-	// Tracking for verification
-	externalWeightCalled      bool
-	totalExternalWeightCalled bool
+	// weights answers the ExternalWeighter methods and records the queries
+	weights *oraclemock.Oracle
 }
 
 // LedgerReader interface implementation
@@ -99,19 +96,11 @@ func (m *mockLedgerReaderWithWeights) ConsensusVersion(basics.Round) (protocol.C
 // ExternalWeighter interface implementation
 
 func (m *mockLedgerReaderWithWeights) ExternalWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
-	m.externalWeightCalled = true
-	if m.externalWeightFn != nil {
-		return m.externalWeightFn(balanceRound, addr, selectionID)
-	}
-	return 0, nil
+	return m.weights.Weight(balanceRound, addr, selectionID)
 }
 
 func (m *mockLedgerReaderWithWeights) TotalExternalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
-	m.totalExternalWeightCalled = true
-	if m.totalExternalWeightFn != nil {
-		return m.totalExternalWeightFn(balanceRound, voteRound)
-	}
-	return 0, nil
+	return m.weights.TotalWeight(balanceRound, voteRound)
 }
 
 // mockLedgerReaderNoWeights implements only LedgerReader (not ExternalWeighter)
@@ -165,6 +154,10 @@ func TestMembershipEligibleAccount(t *testing.T) {
 	testSelectionID := crypto.VRFVerifier{4, 5, 6}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(500)
+	weights.SetTotalWeight(10000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -175,22 +168,18 @@ func TestMembershipEligibleAccount(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
-			require.Equal(t, testAddr, addr)
-			require.Equal(t, testSelectionID, selectionID)
-			return 500, nil
-		},
-		totalExternalWeightFn: func(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
-			return 10000, nil
-		},
+		weights: weights,
 	}
 
 	m, err := membership(mock, testAddr, testRound, 0, soft)
 	require.NoError(t, err)
 	require.Equal(t, uint64(500), m.ExternalWeight)
 	require.Equal(t, uint64(10000), m.TotalExternalWeight)
-	require.True(t, mock.externalWeightCalled)
-	require.True(t, mock.totalExternalWeightCalled)
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodWeight))
+	call := weights.Calls()[0]
+	require.Equal(t, testAddr, call.Address)
+	require.Equal(t, testSelectionID, call.SelectionID)
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodTotalWeight))
 }
 
 // Test: Ineligible account (r > VoteLastValid) should have zero weights and no daemon query
@@ -200,6 +189,7 @@ func TestMembershipIneligibleExpiredKeys(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -209,22 +199,14 @@ func TestMembershipIneligibleExpiredKeys(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			t.Fatal("ExternalWeight should not be called for ineligible account")
-			return 0, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			t.Fatal("TotalExternalWeight should not be called for ineligible account")
-			return 0, nil
-		},
+		weights: weights,
 	}
 
 	m, err := membership(mock, testAddr, testRound, 0, soft)
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), m.ExternalWeight)
 	require.Equal(t, uint64(0), m.TotalExternalWeight)
-	require.False(t, mock.externalWeightCalled)
-	require.False(t, mock.totalExternalWeightCalled)
+	require.Empty(t, weights.Calls())
 }
 
 // Test: Ineligible account (r < VoteFirstValid) should have zero weights and no daemon query
@@ -234,6 +216,7 @@ func TestMembershipIneligibleKeysNotYetValid(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -243,22 +226,14 @@ func TestMembershipIneligibleKeysNotYetValid(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			t.Fatal("ExternalWeight should not be called for ineligible account")
-			return 0, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			t.Fatal("TotalExternalWeight should not be called for ineligible account")
-			return 0, nil
-		},
+		weights: weights,
 	}
 
 	m, err := membership(mock, testAddr, testRound, 0, soft)
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), m.ExternalWeight)
 	require.Equal(t, uint64(0), m.TotalExternalWeight)
-	require.False(t, mock.externalWeightCalled)
-	require.False(t, mock.totalExternalWeightCalled)
+	require.Empty(t, weights.Calls())
 }
 
 // Test: Perpetual keys (VoteLastValid == 0) should always be eligible
@@ -269,6 +244,10 @@ func TestMembershipPerpetualKeys(t *testing.T) {
 	testSelectionID := crypto.VRFVerifier{4, 5, 6}
 	testRound := basics.Round(100000) // Very high round number
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(750)
+	weights.SetTotalWeight(15000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -279,20 +258,15 @@ func TestMembershipPerpetualKeys(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
-			return 750, nil
-		},
-		totalExternalWeightFn: func(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
-			return 15000, nil
-		},
+		weights: weights,
 	}
 
 	m, err := membership(mock, testAddr, testRound, 0, soft)
 	require.NoError(t, err)
 	require.Equal(t, uint64(750), m.ExternalWeight)
 	require.Equal(t, uint64(15000), m.TotalExternalWeight)
-	require.True(t, mock.externalWeightCalled)
-	require.True(t, mock.totalExternalWeightCalled)
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodWeight))
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodTotalWeight))
 }
 
 // Test: ExternalWeighter type assertion failure should panic
@@ -325,6 +299,10 @@ func TestMembershipZeroWeightPanic(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(0) // Zero weight for eligible participant
+	weights.SetTotalWeight(10000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -334,12 +312,7 @@ func TestMembershipZeroWeightPanic(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 0, nil // Zero weight for eligible participant
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 10000, nil
-		},
+		weights: weights,
 	}
 
 	require.Panics(t, func() {
@@ -354,6 +327,10 @@ func TestMembershipZeroTotalWeightPanic(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(500)
+	weights.SetTotalWeight(0) // Zero total weight
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -363,12 +340,7 @@ func TestMembershipZeroTotalWeightPanic(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 500, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 0, nil // Zero total weight
-		},
+		weights: weights,
 	}
 
 	require.Panics(t, func() {
@@ -383,6 +355,10 @@ func TestMembershipTotalLessThanIndividualPanic(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(500)
+	weights.SetTotalWeight(100) // Less than individual weight (500)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -392,12 +368,7 @@ func TestMembershipTotalLessThanIndividualPanic(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 500, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 100, nil // Less than individual weight (500)
-		},
+		weights: weights,
 	}
 
 	require.Panics(t, func() {
@@ -412,6 +383,10 @@ func TestMembershipDaemonErrorNotFoundPanic(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "account not found"})
+	weights.SetTotalWeight(10000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -421,12 +396,7 @@ func TestMembershipDaemonErrorNotFoundPanic(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 0, &ledgercore.DaemonError{Code: "not_found", Msg: "account not found"}
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 10000, nil
-		},
+		weights: weights,
 	}
 
 	require.Panics(t, func() {
@@ -441,6 +411,10 @@ func TestMembershipDaemonErrorBadRequestPanic(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetWeightError(&ledgercore.DaemonError{Code: "bad_request", Msg: "invalid request"})
+	weights.SetTotalWeight(10000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -450,12 +424,7 @@ func TestMembershipDaemonErrorBadRequestPanic(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 0, &ledgercore.DaemonError{Code: "bad_request", Msg: "invalid request"}
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 10000, nil
-		},
+		weights: weights,
 	}
 
 	require.Panics(t, func() {
@@ -470,6 +439,10 @@ func TestMembershipDaemonErrorUnsupportedPanic(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetWeightError(&ledgercore.DaemonError{Code: "unsupported", Msg: "operation not supported"})
+	weights.SetTotalWeight(10000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -479,12 +452,7 @@ func TestMembershipDaemonErrorUnsupportedPanic(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 0, &ledgercore.DaemonError{Code: "unsupported", Msg: "operation not supported"}
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 10000, nil
-		},
+		weights: weights,
 	}
 
 	require.Panics(t, func() {
@@ -499,6 +467,10 @@ func TestMembershipDaemonErrorInternalReturnsError(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetWeightError(&ledgercore.DaemonError{Code: "internal", Msg: "internal server error"})
+	weights.SetTotalWeight(10000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -508,12 +480,7 @@ func TestMembershipDaemonErrorInternalReturnsError(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 0, &ledgercore.DaemonError{Code: "internal", Msg: "internal server error"}
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 10000, nil
-		},
+		weights: weights,
 	}
 
 	// Should NOT panic; should return error
@@ -533,6 +500,10 @@ func TestMembershipNetworkErrorReturnsError(t *testing.T) {
 
 	networkError := errors.New("connection timeout")
 
+	weights := oraclemock.New()
+	weights.SetWeightError(networkError)
+	weights.SetTotalWeight(10000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -542,12 +513,7 @@ func TestMembershipNetworkErrorReturnsError(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 0, networkError
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 10000, nil
-		},
+		weights: weights,
 	}
 
 	// Should NOT panic; should return error
@@ -565,6 +531,10 @@ func TestMembershipTotalWeightDaemonErrorInternalReturnsError(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(500)
+	weights.SetTotalWeightError(&ledgercore.DaemonError{Code: "internal", Msg: "internal server error"})
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -574,12 +544,7 @@ func TestMembershipTotalWeightDaemonErrorInternalReturnsError(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 500, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 0, &ledgercore.DaemonError{Code: "internal", Msg: "internal server error"}
-		},
+		weights: weights,
 	}
 
 	// Should NOT panic; should return error
@@ -597,6 +562,10 @@ func TestMembershipTotalWeightDaemonErrorNotFoundPanic(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100)
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(500)
+	weights.SetTotalWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "total weight not found"})
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -606,12 +575,7 @@ func TestMembershipTotalWeightDaemonErrorNotFoundPanic(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 500, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 0, &ledgercore.DaemonError{Code: "not_found", Msg: "total weight not found"}
-		},
+		weights: weights,
 	}
 
 	require.Panics(t, func() {
@@ -628,6 +592,10 @@ func TestMembershipTotalWeightNetworkErrorReturnsError(t *testing.T) {
 
 	networkError := errors.New("connection refused")
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(500)
+	weights.SetTotalWeightError(networkError)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -637,12 +605,7 @@ func TestMembershipTotalWeightNetworkErrorReturnsError(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 500, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 0, networkError
-		},
+		weights: weights,
 	}
 
 	// Should NOT panic; should return error
@@ -660,6 +623,10 @@ func TestMembershipBoundaryRoundEqualsVoteFirstValid(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(100) // Same as VoteFirstValid
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(300)
+	weights.SetTotalWeight(5000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -669,19 +636,14 @@ func TestMembershipBoundaryRoundEqualsVoteFirstValid(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 300, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 5000, nil
-		},
+		weights: weights,
 	}
 
 	m, err := membership(mock, testAddr, testRound, 0, soft)
 	require.NoError(t, err)
 	require.Equal(t, uint64(300), m.ExternalWeight)
 	require.Equal(t, uint64(5000), m.TotalExternalWeight)
-	require.True(t, mock.externalWeightCalled)
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodWeight))
 }
 
 // Test: Boundary condition - round equals VoteLastValid
@@ -691,6 +653,10 @@ func TestMembershipBoundaryRoundEqualsVoteLastValid(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(500) // Same as VoteLastValid
 
+	weights := oraclemock.New()
+	weights.SetDefaultWeight(400)
+	weights.SetTotalWeight(8000)
+
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -700,19 +666,14 @@ func TestMembershipBoundaryRoundEqualsVoteLastValid(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			return 400, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			return 8000, nil
-		},
+		weights: weights,
 	}
 
 	m, err := membership(mock, testAddr, testRound, 0, soft)
 	require.NoError(t, err)
 	require.Equal(t, uint64(400), m.ExternalWeight)
 	require.Equal(t, uint64(8000), m.TotalExternalWeight)
-	require.True(t, mock.externalWeightCalled)
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodWeight))
 }
 
 // Test: Boundary condition - round is one past VoteLastValid (should be ineligible)
@@ -722,6 +683,7 @@ func TestMembershipBoundaryRoundOnePastVoteLastValid(t *testing.T) {
 	testAddr := basics.Address{1, 2, 3}
 	testRound := basics.Round(501) // One past VoteLastValid
 
+	weights := oraclemock.New()
 	mock := &mockLedgerReaderWithWeights{
 		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
 			return basics.OnlineAccountData{
@@ -731,19 +693,247 @@ func TestMembershipBoundaryRoundOnePastVoteLastValid(t *testing.T) {
 				},
 			}, nil
 		},
-		externalWeightFn: func(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
-			t.Fatal("ExternalWeight should not be called for ineligible account")
-			return 0, nil
-		},
-		totalExternalWeightFn: func(basics.Round, basics.Round) (uint64, error) {
-			t.Fatal("TotalExternalWeight should not be called for ineligible account")
-			return 0, nil
-		},
+		weights: weights,
 	}
 
 	m, err := membership(mock, testAddr, testRound, 0, soft)
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), m.ExternalWeight)
 	require.Equal(t, uint64(0), m.TotalExternalWeight)
-	require.False(t, mock.externalWeightCalled)
+	require.Empty(t, weights.Calls())
+}
+
+// mockLedgerReaderWeightedLookup implements ledgercore.WeightedAgreementLookup
+// on top of mockLedgerReaderWithWeights, counting combined lookups.
+type mockLedgerReaderWeightedLookup struct {
+	mockLedgerReaderWithWeights
+	weightedLookupFn func(basics.Round, basics.Round, basics.Address) (ledgercore.WeightedAgreementData, error)
+	weightedLookups  int
+}
+
+func (m *mockLedgerReaderWeightedLookup) LookupAgreementWeighted(balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+	m.weightedLookups++
+	return m.weightedLookupFn(balanceRound, voteRound, addr)
+}
+
+// Test: a ledger with a combined lookup is queried once, without separate weight calls
+func TestMembershipWeightedLookup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testAddr := basics.Address{1, 2, 3}
+	testRound := basics.Round(100)
+
+	weights := oraclemock.New()
+	mock := &mockLedgerReaderWeightedLookup{
+		mockLedgerReaderWithWeights: mockLedgerReaderWithWeights{weights: weights},
+		weightedLookupFn: func(balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+			require.Equal(t, testAddr, addr)
+			require.Equal(t, testRound, voteRound)
+			return ledgercore.WeightedAgreementData{
+				OnlineAccountData: basics.OnlineAccountData{
+					VotingData: basics.VotingData{
+						VoteFirstValid: basics.Round(1),
+						VoteLastValid:  basics.Round(1000),
+					},
+				},
+				KeyEligible:         true,
+				ExternalWeight:      500,
+				TotalExternalWeight: 10000,
+			}, nil
+		},
+	}
+
+	m, err := membership(mock, testAddr, testRound, 0, soft)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), m.ExternalWeight)
+	require.Equal(t, uint64(10000), m.TotalExternalWeight)
+	require.Equal(t, 1, mock.weightedLookups)
+	require.Empty(t, weights.Calls())
+
+	// An ineligible key leaves the weights at zero.
+	mock.weightedLookupFn = func(basics.Round, basics.Round, basics.Address) (ledgercore.WeightedAgreementData, error) {
+		return ledgercore.WeightedAgreementData{}, nil
+	}
+	m, err = membership(mock, testAddr, testRound, 0, soft)
+	require.NoError(t, err)
+	require.Zero(t, m.ExternalWeight)
+	require.Zero(t, m.TotalExternalWeight)
+
+	// Weight errors keep their classification through the combined lookup.
+	mock.weightedLookupFn = func(basics.Round, basics.Round, basics.Address) (ledgercore.WeightedAgreementData, error) {
+		return ledgercore.WeightedAgreementData{}, &ledgercore.ExternalWeightError{
+			Total: true,
+			Err:   &ledgercore.DaemonError{Code: "internal", Msg: "database unavailable"},
+		}
+	}
+	_, err = membership(mock, testAddr, testRound, 0, soft)
+	require.ErrorContains(t, err, "Failed to obtain total external weight")
+	require.True(t, ledgercore.IsDaemonError(err, "internal"))
+
+	mock.weightedLookupFn = func(basics.Round, basics.Round, basics.Address) (ledgercore.WeightedAgreementData, error) {
+		return ledgercore.WeightedAgreementData{}, &ledgercore.ExternalWeightError{
+			Err: &ledgercore.DaemonError{Code: "not_found", Msg: "account not found"},
+		}
+	}
+	require.Panics(t, func() {
+		membership(mock, testAddr, testRound, 0, soft)
+	})
+}
+
+// mockLedgerReaderContextLookup implements
+// ledgercore.ContextWeightedAgreementLookup on top of
+// mockLedgerReaderWeightedLookup, failing lookups whose context is done.
+type mockLedgerReaderContextLookup struct {
+	mockLedgerReaderWeightedLookup
+	contexts []context.Context
+}
+
+func (m *mockLedgerReaderContextLookup) LookupAgreementWeightedContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+	m.contexts = append(m.contexts, ctx)
+	if err := ctx.Err(); err != nil {
+		return ledgercore.WeightedAgreementData{}, &ledgercore.ExternalWeightError{Err: err}
+	}
+	return m.weightedLookupFn(balanceRound, voteRound, addr)
+}
+
+// Test: membershipContext passes its context to the weight lookups, and a
+// lookup abandoned because the context is done is an operational error
+func TestMembershipContext(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testAddr := basics.Address{1, 2, 3}
+	testRound := basics.Round(100)
+	eligible := basics.OnlineAccountData{
+		VotingData: basics.VotingData{
+			VoteFirstValid: basics.Round(1),
+			VoteLastValid:  basics.Round(1000),
+		},
+	}
+
+	weights := oraclemock.New()
+	mock := &mockLedgerReaderContextLookup{
+		mockLedgerReaderWeightedLookup: mockLedgerReaderWeightedLookup{
+			mockLedgerReaderWithWeights: mockLedgerReaderWithWeights{weights: weights},
+			weightedLookupFn: func(basics.Round, basics.Round, basics.Address) (ledgercore.WeightedAgreementData, error) {
+				return ledgercore.WeightedAgreementData{
+					OnlineAccountData:   eligible,
+					KeyEligible:         true,
+					ExternalWeight:      500,
+					TotalExternalWeight: 10000,
+				}, nil
+			},
+		},
+	}
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, 1)
+	m, err := membershipContext(ctx, mock, testAddr, testRound, 0, soft)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), m.ExternalWeight)
+	require.Equal(t, []context.Context{ctx}, mock.contexts)
+	require.Zero(t, mock.weightedLookups)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = membershipContext(cancelled, mock, testAddr, testRound, 0, soft)
+	require.ErrorIs(t, err, context.Canceled)
+	require.ErrorContains(t, err, "Failed to obtain external weight")
+
+	// Ledgers without context support are not asked for weights once the
+	// context is done.
+	plain := &mockLedgerReaderWithWeights{
+		weights: weights,
+		lookupAgreementFn: func(basics.Round, basics.Address) (basics.OnlineAccountData, error) {
+			return eligible, nil
+		},
+	}
+	_, err = membershipContext(cancelled, plain, testAddr, testRound, 0, soft)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, weights.Calls())
+}
+
+// Test: Protocols without external weighting never query the daemon
+func TestMembershipStakeProtocolSkipsWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testAddr := basics.Address{1, 2, 3}
+	testRound := basics.Round(100)
+
+	weights := oraclemock.New()
+	mock := &mockLedgerReaderWithWeights{
+		weights: weights,
+		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
+			return basics.OnlineAccountData{
+				MicroAlgosWithRewards: basics.MicroAlgos{Raw: 5000},
+				VotingData: basics.VotingData{
+					VoteFirstValid: basics.Round(1),
+					VoteLastValid:  basics.Round(1000),
+				},
+			}, nil
+		},
+		consensusParamsFn: func(basics.Round) (config.ConsensusParams, error) {
+			cparams := config.Consensus[protocol.ConsensusCurrentVersion]
+			cparams.EnableExternalWeightOracle = false
+			return cparams, nil
+		},
+	}
+
+	m, err := membership(mock, testAddr, testRound, 0, soft)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), m.Record.MicroAlgosWithRewards.Raw)
+	require.Zero(t, m.ExternalWeight)
+	require.Zero(t, m.TotalExternalWeight)
+	require.Empty(t, weights.Calls())
+}
+
+// excludingLedger answers ledgercore.ErrWeightExcluded, as a weight oracle
+// does for an account overridden to zero weight, for one account's weight.
+type excludingLedger struct {
+	Ledger
+	excluded basics.Address
+}
+
+func (l excludingLedger) ExternalWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	if addr == l.excluded {
+		return 0, ledgercore.ErrWeightExcluded
+	}
+	return l.Ledger.(ledgercore.ExternalWeighter).ExternalWeight(balanceRound, addr, selectionID)
+}
+
+func (l excludingLedger) TotalExternalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	return l.Ledger.(ledgercore.ExternalWeighter).TotalExternalWeight(balanceRound, voteRound)
+}
+
+// Test: Votes from an account excluded by a weight override are rejected,
+// not taken for a daemon reporting zero weight for an eligible account
+func TestMembershipExcludedAccountVote(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	// Find a vote that verifies while its sender is not excluded.
+	var uv unauthenticatedVote
+	var sender basics.Address
+	for i, addr := range addresses {
+		rv := rawVote{Sender: addr, Round: round, Step: soft, Proposal: proposalValue{BlockDigest: randomBlockHash()}}
+		v, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+		if _, err := v.verify(ledger); err == nil {
+			uv, sender = v, addr
+			break
+		}
+	}
+	require.NotZero(t, sender)
+
+	excluding := excludingLedger{Ledger: ledger, excluded: sender}
+	var m committee.Membership
+	var err error
+	require.NotPanics(t, func() { m, err = membership(excluding, sender, round, 0, soft) })
+	require.NoError(t, err)
+	require.Zero(t, m.ExternalWeight)
+	require.Zero(t, m.TotalExternalWeight)
+
+	require.NotPanics(t, func() { _, err = uv.verify(excluding) })
+	require.ErrorContains(t, err, "credential has weight 0")
 }
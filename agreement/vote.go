@@ -17,6 +17,7 @@
 package agreement
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -95,8 +96,14 @@ type (
 
 // verify verifies that a vote that was received from the network is valid.
 func (uv unauthenticatedVote) verify(l LedgerReader) (vote, error) {
+	return uv.verifyContext(context.Background(), l)
+}
+
+// verifyContext is verify, with the external weight lookups abandoned once ctx
+// is done (see membershipContext).
+func (uv unauthenticatedVote) verifyContext(ctx context.Context, l LedgerReader) (vote, error) {
 	rv := uv.R
-	m, err := membership(l, rv.Sender, rv.Round, rv.Period, rv.Step)
+	m, err := membershipContext(ctx, l, rv.Sender, rv.Round, rv.Period, rv.Step)
 	if err != nil {
 		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
 	}
@@ -205,6 +212,12 @@ func (v vote) u() unauthenticatedVote {
 }
 
 func (pair unauthenticatedEquivocationVote) verify(l LedgerReader) (equivocationVote, error) {
+	return pair.verifyContext(context.Background(), l)
+}
+
+// verifyContext is verify, with the external weight lookups abandoned once ctx
+// is done (see membershipContext).
+func (pair unauthenticatedEquivocationVote) verifyContext(ctx context.Context, l LedgerReader) (equivocationVote, error) {
 	if pair.Proposals[0] == pair.Proposals[1] {
 		return equivocationVote{}, fmt.Errorf("isEquivocationPair: not an equivocation pair: identical vote (block hash %v == %v)", pair.Proposals[0], pair.Proposals[1])
 	}
@@ -215,12 +228,12 @@ func (pair unauthenticatedEquivocationVote) verify(l LedgerReader) (equivocation
 	uv0 := unauthenticatedVote{R: rv0, Cred: pair.Cred, Sig: pair.Sigs[0]}
 	uv1 := unauthenticatedVote{R: rv1, Cred: pair.Cred, Sig: pair.Sigs[1]}
 
-	v0, err := uv0.verify(l)
+	v0, err := uv0.verifyContext(ctx, l)
 	if err != nil {
 		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 0: %w", err)
 	}
 
-	_, err = uv1.verify(l)
+	_, err = uv1.verifyContext(ctx, l)
 	if err != nil {
 		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 1: %w", err)
 	}
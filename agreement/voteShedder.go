@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"errors"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var voteVerifierShedCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_agreement_vote_verifier_duplicates_shed", Description: "Number of duplicate votes not verified while the weight oracle was saturated"})
+
+// errVoteShed cancels the verification of a shed vote.
+var errVoteShed = errors.New("duplicate of a vote already sent for verification, shed while the weight oracle is saturated")
+
+// shedVoteContext is the context of shed vote verification requests, which
+// the vote verifier returns as cancelled without verifying them.
+var shedVoteContext = func() context.Context {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(errVoteShed)
+	return ctx
+}()
+
+// voteShedderRoundCapacity bounds the votes remembered for each round, so that
+// a flood of distinct invalid votes cannot grow them without limit.
+const voteShedderRoundCapacity = 1 << 16
+
+// A voteShedder picks the duplicate votes that are not worth verifying while
+// the weight oracle is saturated. A vote is a duplicate if an identical vote,
+// relayed by another peer, was already sent for verification in its round:
+// the duplicate would only be filtered once verified, since the first copy
+// will have reached the vote tracker, and repeating a failed verification
+// takes weight lookups from the votes that drive the player.
+//
+// Votes are only remembered while the oracle is saturated, so that votes are
+// not encoded and hashed when nothing will be shed; a duplicate of a vote sent
+// before saturation began is verified. Proposal-votes are never shed, since a
+// duplicate may carry the payload the first copy lacked. A vote whose
+// verification failed because its weights could not be obtained, as when the
+// daemon timed out, is forgotten, so that a copy retransmitted later is
+// verified rather than shed.
+type voteShedder struct {
+	oracle ledgercore.ExternalWeightBackpressure
+
+	mu deadlock.Mutex
+
+	// sent holds digests of the votes of each round sent for verification.
+	sent map[round]map[crypto.Digest]struct{}
+}
+
+// makeVoteShedder returns a voteShedder for the weight oracle behind l, or nil
+// if l cannot tell whether it is saturated.
+func makeVoteShedder(l LedgerReader) *voteShedder {
+	oracle, ok := l.(ledgercore.ExternalWeightBackpressure)
+	if !ok {
+		return nil
+	}
+	return &voteShedder{oracle: oracle, sent: make(map[round]map[crypto.Digest]struct{})}
+}
+
+// shed reports whether the vote in request should not be verified, and if not
+// remembers it. Like the verifier's request contexts, the votes of round r are
+// forgotten once a request for round r+2 arrives.
+func (s *voteShedder) shed(request cryptoVoteRequest) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for r := range s.sent {
+		if r+2 <= request.Round {
+			delete(s.sent, r)
+		}
+	}
+	uv := request.message.UnauthenticatedVote
+	if uv.R.Step == propose || !s.oracle.ExternalWeightSaturated() {
+		return false
+	}
+	votes := s.sent[request.Round]
+	if votes == nil {
+		votes = make(map[crypto.Digest]struct{})
+		s.sent[request.Round] = votes
+	}
+	key := crypto.Hash(protocol.Encode(&uv))
+	if _, ok := votes[key]; ok {
+		return true
+	}
+	if len(votes) < voteShedderRoundCapacity {
+		votes[key] = struct{}{}
+	}
+	return false
+}
+
+// verified forgets the vote of res if its verification failed for want of
+// weights. The weight lookup errors that reach verification are operational:
+// those that mean the daemon is broken stop the node instead.
+func (s *voteShedder) verified(res asyncVerifyVoteResponse) {
+	var we *ledgercore.ExternalWeightError
+	if s == nil || !errors.As(res.err, &we) {
+		return
+	}
+	uv := res.message.UnauthenticatedVote
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	votes := s.sent[uv.R.Round]
+	if len(votes) == 0 {
+		return
+	}
+	delete(votes, crypto.Hash(protocol.Encode(&uv)))
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// saturationLedger is a LedgerReader whose weight oracle is saturated when
+// saturated is set.
+type saturationLedger struct {
+	LedgerReader
+	saturated bool
+}
+
+func (l *saturationLedger) ExternalWeightSaturated() bool {
+	return l.saturated
+}
+
+func shedderTestRequest(sender byte, r round, s step) cryptoVoteRequest {
+	uv := unauthenticatedVote{R: rawVote{Sender: basics.Address{sender}, Round: r, Step: s}}
+	return cryptoVoteRequest{message: message{UnauthenticatedVote: uv}, Round: r}
+}
+
+func TestVoteShedderDuplicates(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Nil(t, makeVoteShedder(nil))
+
+	l := &saturationLedger{}
+	s := makeVoteShedder(l)
+	require.False(t, s.shed(shedderTestRequest(1, 10, soft)))
+	require.False(t, s.shed(shedderTestRequest(1, 10, soft)), "duplicates are verified while the oracle keeps up")
+	require.Empty(t, s.sent[10], "votes are not remembered while the oracle keeps up")
+
+	l.saturated = true
+	require.False(t, s.shed(shedderTestRequest(1, 10, soft)), "copies sent before saturation are not remembered")
+	require.True(t, s.shed(shedderTestRequest(1, 10, soft)))
+	require.False(t, s.shed(shedderTestRequest(2, 10, soft)), "first copies are verified")
+	require.False(t, s.shed(shedderTestRequest(1, 10, cert)))
+	require.False(t, s.shed(shedderTestRequest(1, 11, soft)))
+	require.False(t, s.shed(shedderTestRequest(3, 10, propose)))
+	require.False(t, s.shed(shedderTestRequest(3, 10, propose)), "proposal-votes are never shed")
+
+	// Round 10 is forgotten once round 12 arrives.
+	require.False(t, s.shed(shedderTestRequest(4, 12, soft)))
+	require.NotContains(t, s.sent, round(10))
+	require.Contains(t, s.sent, round(11))
+	require.False(t, s.shed(shedderTestRequest(1, 10, soft)))
+
+	// Votes are no longer shed once the oracle catches up.
+	l.saturated = false
+	require.False(t, s.shed(shedderTestRequest(1, 10, soft)))
+}
+
+// TestVoteShedderCancelsVerification checks that a shed vote comes back from
+// the verifier as cancelled, with the reason it was shed.
+func TestVoteShedderCancelsVerification(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	voteVerifier := MakeAsyncVoteVerifier(nil)
+	defer voteVerifier.Quit()
+	verifier := makeCryptoVerifier(&saturationLedger{saturated: true}, testBlockValidator{}, voteVerifier, logging.TestingLog(t))
+	defer verifier.Quit()
+
+	// The first copy is remembered as sent, without verifying it.
+	request := shedderTestRequest(1, 10, soft)
+	request.Tag = protocol.AgreementVoteTag
+	require.False(t, verifier.(*poolCryptoVerifier).shedder.shed(request))
+	request.TaskIndex = 1
+	verifier.VerifyVote(context.Background(), request)
+
+	res := <-verifier.VerifiedVotes()
+	require.Equal(t, uint64(1), res.index)
+	require.True(t, res.cancelled)
+	require.ErrorIs(t, res.err, errVoteShed)
+}
+
+// TestVoteShedderForgetsWeightFailures checks that a vote whose verification
+// failed for want of weights is forgotten, so that a retransmitted copy is
+// verified, while one that failed on its own merits stays remembered.
+func TestVoteShedderForgetsWeightFailures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s := makeVoteShedder(&saturationLedger{saturated: true})
+	timedOut := shedderTestRequest(1, 10, soft)
+	invalid := shedderTestRequest(2, 10, soft)
+	require.False(t, s.shed(timedOut))
+	require.False(t, s.shed(invalid))
+
+	weightErr := &ledgercore.ExternalWeightError{Err: errors.New("weight daemon request timed out")}
+	s.verified(asyncVerifyVoteResponse{message: timedOut.message, err: fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", weightErr)})
+	s.verified(asyncVerifyVoteResponse{message: invalid.message, err: errors.New("unauthenticatedVote.verify: could not verify FS signature")})
+
+	require.False(t, s.shed(timedOut), "the retransmission of a vote that failed for want of weights is verified")
+	require.True(t, s.shed(timedOut))
+	require.True(t, s.shed(invalid))
+}
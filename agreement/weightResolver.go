@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// weightResolutionTimeout bounds how long resolving the weights of a batch of
+// votes may hold up their verification. Resolution runs on the goroutine that
+// dispatches votes and bundles to the verification pool, so without a bound a
+// slow weight daemon would stall the verification of every vote, even of those
+// whose weights are cached. Votes whose weights are not resolved in time look
+// them up as they are verified, in the pool.
+const weightResolutionTimeout = 500 * time.Millisecond
+
+// resolveWeights looks up, in one pass, the external weights needed to verify
+// the credentials of a batch of votes, so that their verification, which runs
+// in parallel, finds the weights in the weight oracle's cache rather than each
+// vote asking the daemon on its own. Only the round and sender of each vote
+// are used.
+//
+// A single vote gains nothing from this, so it is left to its verification.
+// The account weights of each balance round are asked for together, and each
+// total weight once. Nothing is done unless the ledger implements
+// ledgercore.ExternalWeightBatcher. Lookups are abandoned once ctx is done or
+// weightResolutionTimeout has passed. Failures are ignored: verifying the vote
+// looks the weight up again and reports them.
+func resolveWeights(ctx context.Context, l LedgerReader, votes []rawVote) {
+	batcher, ok := l.(ledgercore.ExternalWeightBatcher)
+	if !ok || len(votes) < 2 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, weightResolutionTimeout)
+	defer cancel()
+
+	type totalKey struct {
+		balanceRound basics.Round
+		voteRound    basics.Round
+	}
+	queries := make(map[basics.Round][]ledgercore.WeightQuery)
+	seen := make(map[basics.Round]map[ledgercore.WeightQuery]bool)
+	totals := make(map[totalKey]bool)
+	for _, rv := range votes {
+		cparams, err := l.ConsensusParams(ParamsRound(rv.Round))
+		if err != nil || !cparams.EnableExternalWeightOracle {
+			continue
+		}
+		balanceRound := BalanceRound(rv.Round, cparams)
+		record, err := l.LookupAgreement(balanceRound, rv.Sender)
+		if err != nil || !ledgercore.VoteKeyEligible(record, rv.Round) {
+			// The daemon need not answer for accounts that are not eligible.
+			continue
+		}
+		q := ledgercore.WeightQuery{Addr: rv.Sender, SelectionID: record.SelectionID}
+		if seen[balanceRound] == nil {
+			seen[balanceRound] = make(map[ledgercore.WeightQuery]bool)
+		}
+		if !seen[balanceRound][q] {
+			seen[balanceRound][q] = true
+			queries[balanceRound] = append(queries[balanceRound], q)
+		}
+		totals[totalKey{balanceRound, rv.Round}] = true
+	}
+
+	for balanceRound, qs := range queries {
+		batcher.ExternalWeights(ctx, balanceRound, qs) //nolint:errcheck // verification reports failures
+	}
+	if cw, ok := l.(ledgercore.ContextExternalWeighter); ok {
+		for k := range totals {
+			cw.TotalExternalWeightContext(ctx, k.balanceRound, k.voteRound) //nolint:errcheck // verification reports failures
+		}
+	} else if ew, ok := l.(ledgercore.ExternalWeighter); ok {
+		for k := range totals {
+			if ctx.Err() != nil {
+				return
+			}
+			ew.TotalExternalWeight(k.balanceRound, k.voteRound) //nolint:errcheck // verification reports failures
+		}
+	}
+}
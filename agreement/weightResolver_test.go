@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	oraclemock "github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// batchingLedger records the batches of external weights asked of it.
+type batchingLedger struct {
+	*mockLedgerReaderWithWeights
+	batches map[basics.Round][]ledgercore.WeightQuery
+}
+
+func (l *batchingLedger) ExternalWeights(_ context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	l.batches[balanceRound] = append(l.batches[balanceRound], queries...)
+	return make([]ledgercore.WeightResult, len(queries)), nil
+}
+
+// TestResolveWeights checks that the weights of a batch of votes are asked
+// for together, once per account, and only for eligible accounts.
+func TestResolveWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	weights := oraclemock.New()
+	l := &batchingLedger{
+		mockLedgerReaderWithWeights: &mockLedgerReaderWithWeights{
+			lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
+				// Account 3's key is no longer valid.
+				lastValid := basics.Round(1000)
+				if a[0] == 3 {
+					lastValid = 10
+				}
+				return basics.OnlineAccountData{VotingData: basics.VotingData{
+					VoteFirstValid: 1,
+					VoteLastValid:  lastValid,
+					SelectionID:    crypto.VRFVerifier{a[0]},
+				}}, nil
+			},
+			weights: weights,
+		},
+		batches: make(map[basics.Round][]ledgercore.WeightQuery),
+	}
+	balanceRound := BalanceRound(100, config.Consensus[protocol.ConsensusCurrentVersion])
+
+	resolveWeights(context.Background(), l, []rawVote{
+		{Sender: basics.Address{1}, Round: 100, Step: soft},
+		{Sender: basics.Address{2}, Round: 100, Step: soft},
+		{Sender: basics.Address{1}, Round: 100, Step: cert},
+		{Sender: basics.Address{3}, Round: 100, Step: soft},
+	})
+	require.Equal(t, map[basics.Round][]ledgercore.WeightQuery{balanceRound: {
+		{Addr: basics.Address{1}, SelectionID: crypto.VRFVerifier{1}},
+		{Addr: basics.Address{2}, SelectionID: crypto.VRFVerifier{2}},
+	}}, l.batches)
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodTotalWeight))
+	require.Zero(t, weights.CallCount(oraclemock.MethodWeight))
+
+	// A single vote is left to its verification.
+	clear(l.batches)
+	resolveWeights(context.Background(), l, []rawVote{{Sender: basics.Address{1}, Round: 100}})
+	require.Empty(t, l.batches)
+
+	// Without batch support nothing is looked up.
+	resolveWeights(context.Background(), l.mockLedgerReaderWithWeights, []rawVote{{Sender: basics.Address{1}, Round: 100}, {Sender: basics.Address{2}, Round: 100}})
+	require.Equal(t, 1, weights.CallCount(oraclemock.MethodTotalWeight))
+}
+
+// hangingLedger's batches wait for their context, as if the weight daemon
+// never answered.
+type hangingLedger struct {
+	*mockLedgerReaderWithWeights
+}
+
+func (l hangingLedger) ExternalWeights(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// TestResolveWeightsHungDaemon checks that a weight daemon that does not
+// answer holds up the dispatch of votes no longer than
+// weightResolutionTimeout, and no longer than the votes are of use.
+func TestResolveWeightsHungDaemon(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l := hangingLedger{&mockLedgerReaderWithWeights{
+		lookupAgreementFn: func(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
+			return basics.OnlineAccountData{VotingData: basics.VotingData{VoteFirstValid: 1, VoteLastValid: 1000}}, nil
+		},
+		weights: oraclemock.New(),
+	}}
+	votes := []rawVote{{Sender: basics.Address{1}, Round: 100}, {Sender: basics.Address{2}, Round: 100}}
+
+	start := time.Now()
+	resolveWeights(context.Background(), l, votes)
+	require.GreaterOrEqual(t, time.Since(start), weightResolutionTimeout)
+	require.Less(t, time.Since(start), 10*weightResolutionTimeout)
+
+	// Once every vote of the batch is stale, resolution is abandoned.
+	c := &poolCryptoVerifier{ledger: l, quit: make(chan struct{})}
+	batch := make([]cryptoVoteRequest, len(votes))
+	var cancels []context.CancelFunc
+	for i, rv := range votes {
+		var cancel context.CancelFunc
+		batch[i].ctx, cancel = context.WithCancel(context.Background())
+		batch[i].message.UnauthenticatedVote = unauthenticatedVote{R: rv}
+		cancels = append(cancels, cancel)
+	}
+	time.AfterFunc(10*time.Millisecond, func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	})
+	start = time.Now()
+	c.resolveVoteWeights(batch)
+	require.Less(t, time.Since(start), weightResolutionTimeout)
+}
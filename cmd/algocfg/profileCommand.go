@@ -45,6 +45,8 @@ var (
 			cfg.MaxAcctLookback = 256
 			cfg.EnableTxnEvalTracer = true
 			cfg.DisableAPIAuth = true
+			// Sandboxes have no weight daemon; weigh accounts by stake.
+			cfg.EnableBuiltinStakeWeightOracle = true
 			return cfg
 		},
 	}
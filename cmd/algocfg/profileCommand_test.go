@@ -51,6 +51,8 @@ func Test_getConfigForArg(t *testing.T) {
 		cfg, err := getConfigForArg("development")
 		require.NoError(t, err)
 		require.True(t, cfg.DisableAPIAuth)
+		require.True(t, cfg.EnableBuiltinStakeWeightOracle)
+		require.NoError(t, cfg.ValidateExternalWeightOracleConfig())
 	})
 
 	t.Run("valid config test archival node", func(t *testing.T) {
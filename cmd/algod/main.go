@@ -201,6 +201,16 @@ func run() int {
 		log.Fatalf("Error validating DNSBootstrap input: %v", err)
 	}
 
+	// Follower nodes do not take part in consensus; they use a weight oracle only
+	// to verify block certificates, and only if one is configured.
+	if !cfg.EnableFollowMode || cfg.ExternalWeightOracleConfigured() {
+		err = cfg.ValidateExternalWeightOracleConfig()
+		if err != nil {
+			// log is not setup yet, this will log to stderr
+			log.Fatalf("Error validating weight oracle settings: %v", err)
+		}
+	}
+
 	// Apply network-specific consensus overrides, noting the configurable consensus protocols file
 	// takes precedence over network-specific overrides.
 	config.ApplyShorterUpgradeRoundsForDevNetworks(genesis.Network)
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+var weightOverrideKeyfile string
+var weightOverrideMnemonic string
+var weightOverrideInfile string
+var weightOverrideOutfile string
+
+func init() {
+	weightOverrideCmd.Flags().StringVarP(&weightOverrideKeyfile, "keyfile", "k", "", "Private key filename")
+	weightOverrideCmd.Flags().StringVarP(&weightOverrideMnemonic, "mnemonic", "m", "", "Private key mnemonic")
+	weightOverrideCmd.Flags().StringVarP(&weightOverrideInfile, "infile", "i", "", "Override body input filename (use \"-\" for stdin)")
+	weightOverrideCmd.MarkFlagRequired("infile")
+	weightOverrideCmd.Flags().StringVarP(&weightOverrideOutfile, "outfile", "o", "", "Signed override output filename (use \"-\" for stdout)")
+	weightOverrideCmd.MarkFlagRequired("outfile")
+}
+
+var weightOverrideCmd = &cobra.Command{
+	Use:   "weight-override",
+	Short: "Sign a weight override file for ExternalWeightOracleOverrideFile",
+	Long: `Sign a JSON override body, of the form
+{"genesis_hash": "<base64>", "reason": "...", "expires": "2026-01-02T15:04:05Z", "effective_round": "<round>", "weights": {"<address>": "0"}},
+so that nodes listing the key's address in ExternalWeightOracleOverrideSigners use its weights instead of the weight daemon's,
+at balance rounds from effective_round on, until it expires. A weight of "0" excludes the account from committees.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		seed := loadKeyfileOrMnemonic(weightOverrideKeyfile, weightOverrideMnemonic)
+		key := crypto.GenerateSignatureSecrets(seed)
+
+		body, err := readFile(weightOverrideInfile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read override body from %s: %v\n", weightOverrideInfile, err)
+			os.Exit(1)
+		}
+		var parsed weightoracle.OverrideBody
+		if err := json.Unmarshal(body, &parsed); err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot parse override body: %v\n", err)
+			os.Exit(1)
+		}
+
+		override, err := weightoracle.SignOverride(body, key)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot sign override: %v\n", err)
+			os.Exit(1)
+		}
+		signed, err := json.MarshalIndent(override, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot encode signed override: %v\n", err)
+			os.Exit(1)
+		}
+		err = writeFile(weightOverrideOutfile, append(signed, '\n'), 0600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot write signed override to %s: %v\n", weightOverrideOutfile, err)
+			os.Exit(1)
+		}
+	},
+}
@@ -41,6 +41,7 @@ import (
 	algodAcct "github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/libgoal"
 	"github.com/algorand/go-algorand/libgoal/participation"
 	"github.com/algorand/go-algorand/protocol"
@@ -1477,6 +1478,13 @@ var partkeyInfoCmd = &cobra.Command{
 				reportErrorf(errorRequestFail, err)
 			}
 
+			// Only ask the node how to show weights if it reports any.
+			var display ledgercore.WeightDisplay
+			if slices.ContainsFunc(parts, func(part model.ParticipationKey) bool {
+				return part.ExternalWeight != nil || part.SelectionWeight != nil
+			}) {
+				display = nodeWeightDisplay(client)
+			}
 			for _, part := range parts {
 				fmt.Println()
 				fmt.Printf("Participation ID:          %s\n", part.Id)
@@ -1495,11 +1503,27 @@ var partkeyInfoCmd = &cobra.Command{
 				if part.Key.StateProofKey != nil {
 					fmt.Printf("State proof key:           %s\n", base64.StdEncoding.EncodeToString(*part.Key.StateProofKey))
 				}
+				fmt.Print(partkeyWeightsString(part, display))
 			}
 		})
 	},
 }
 
+// partkeyWeightsString returns the lines of partkeyinfo about the weights of
+// part, shown as display asks.
+func partkeyWeightsString(part model.ParticipationKey, display ledgercore.WeightDisplay) string {
+	// An account's keys may be weighed differently; only the registered
+	// key's weight counts toward its external weight.
+	var lines string
+	if part.ExternalWeight != nil {
+		lines += fmt.Sprintf("External weight:           %s\n", display.Format(*part.ExternalWeight))
+	}
+	if part.SelectionWeight != nil {
+		lines += fmt.Sprintf("Selection key weight:      %s\n", display.Format(*part.SelectionWeight))
+	}
+	return lines
+}
+
 var markNonparticipatingCmd = &cobra.Command{
 	Use:   "marknonparticipating",
 	Short: "Permanently mark an account as not participating (i.e. offline and earns no rewards)",
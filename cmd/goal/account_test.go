@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestPartkeyWeightsString checks that partkeyinfo shows a key's weights in
+// the unit the weight daemon declares.
+func TestPartkeyWeightsString(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Empty(t, partkeyWeightsString(model.ParticipationKey{}, ledgercore.WeightDisplay{}))
+
+	external, selection := uint64(0), uint64(1_234_500_000)
+	part := model.ParticipationKey{ExternalWeight: &external, SelectionWeight: &selection}
+	require.Equal(t,
+		"External weight:           0\nSelection key weight:      1234500000\n",
+		partkeyWeightsString(part, ledgercore.WeightDisplay{}))
+	require.Equal(t,
+		"External weight:           0 credits\nSelection key weight:      1234.5 credits\n",
+		partkeyWeightsString(part, ledgercore.WeightDisplay{Unit: "credits", Scale: 1_000_000}))
+}
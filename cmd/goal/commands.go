@@ -90,6 +90,9 @@ func init() {
 	// application.go
 	rootCmd.AddCommand(appCmd)
 
+	// weightoracle.go
+	rootCmd.AddCommand(weightOracleCmd)
+
 	// Config
 	defaultDataDirValue := []string{""}
 	rootCmd.PersistentFlags().StringArrayVarP(&datadir.DataDirs, "datadir", "d", defaultDataDirValue, "Data directory for the node")
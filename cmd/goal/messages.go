@@ -64,6 +64,11 @@ const (
 	infoNodeStatus                          = "Last committed block: %d\nTime since last block: %s\nSync Time: %s\nLast consensus protocol: %s\nNext consensus protocol: %s\nRound for next consensus protocol: %d\nNext consensus protocol supported: %v"
 	infoNodeStatusConsensusUpgradeVoting    = "Consensus upgrade state: Voting\nYes votes: %d\nNo votes: %d\nVotes remaining: %d\nYes votes required: %d\nVote window close round: %d"
 	infoNodeStatusConsensusUpgradeScheduled = "Consensus upgrade state: Scheduled"
+	infoNodeStatusTotalExternalWeight       = "Total external weight: %s"
+	infoNodeStatusWeightOracleState         = "Weight daemon state: %s (%d consecutive failed health checks)"
+	infoNodeStatusWeightOracleLastAnswer    = "Time since weight daemon last answered: %s"
+	infoNodeStatusWeightOracleVersions      = "Weight daemon versions: algorithm %s, protocol %s"
+	infoNodeStatusWeightOracleQueries       = "Weight daemon queries: %d\nWeight cache: %d hits, %d misses (%.1f%% hit rate)"
 	catchupStoppedOnUnsupported             = "Last supported block (%d) is committed. The next block consensus protocol is not supported. Catchup service is stopped."
 	infoNodeCatchpointCatchupStatus         = "Last committed block: %d\nSync Time: %s\nCatchpoint: %s"
 	infoNodeCatchpointCatchupAccounts       = "Catchpoint total accounts: %d\nCatchpoint accounts processed: %d\nCatchpoint accounts verified: %d\nCatchpoint total KVs: %d\nCatchpoint KVs processed: %d\nCatchpoint KVs verified: %d"
@@ -92,6 +97,23 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorWeightOracleBundle                 = "Cannot get weight daemon diagnostics: %v"
+	infoWeightOracleBundle                  = "Wrote weight daemon diagnostics to %s"
+	errorWeightCacheExport                  = "Cannot export cached weight daemon answers: %v"
+	infoWeightCacheExport                   = "Wrote cached weight daemon answers to %s"
+
+	// Weight oracle
+	errorWeightOracleStatus       = "Cannot get the weight daemon status: %v"
+	errorWeightOracleLookup       = "Cannot look up the weight: %v"
+	errorWeightOracleClient       = "Cannot set up a client for the node's weight daemon: %v"
+	errorWeightOracleSelectionKey = "Cannot base64-decode selection key %s: %v"
+	errorWeightOracleDirectArgs   = "--balance-round and --selection-key are only used with --daemon, and only together"
+	infoWeightOracleNotUsed       = "The node does not use an external weight daemon"
+	infoWeightOracleDaemon        = "Weight daemon: %s\nGenesis hash: %s\nWeight algorithm version: %s\nWeight protocol version: %s\nCapabilities: %s\nPing time: %s"
+	infoWeightOracleWeight        = "Account: %s\nRound: %d\nBalance round: %d\nSelection key: %s\nWeight: %s"
+	infoWeightOracleIneligible    = "Account %s has no participation key registered for round %d (balance round %d), so its weight is zero"
+	infoWeightOracleDaemonWeight  = "Weight from the daemon: %s"
+	infoWeightOracleTotal         = "Balance round: %d\nVote round: %d\nTotal weight: %s"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -452,6 +452,33 @@ func getStatus(dataDir string) {
 	}
 }
 
+// makeWeightOracleStatusString returns the lines of the node status about
+// the external weight daemon, each preceded by a newline, or an empty string
+// if the node does not use one.
+func makeWeightOracleStatusString(stat model.NodeStatusResponse) string {
+	var s string
+	if stat.WeightOracleState != nil {
+		s += "\n" + fmt.Sprintf(infoNodeStatusWeightOracleState, *stat.WeightOracleState, nilToZero(stat.WeightOracleConsecutiveFailures))
+	}
+	if stat.WeightOracleTimeSinceLastAnswer != nil {
+		s += "\n" + fmt.Sprintf(infoNodeStatusWeightOracleLastAnswer, fmt.Sprintf("%.1fs", time.Duration(*stat.WeightOracleTimeSinceLastAnswer).Seconds()))
+	}
+	if stat.WeightOracleAlgorithmVersion != nil {
+		s += "\n" + fmt.Sprintf(infoNodeStatusWeightOracleVersions, *stat.WeightOracleAlgorithmVersion, nilToZero(stat.WeightOracleProtocolVersion))
+	}
+	if stat.WeightOracleQueries != nil {
+		// Queries counts every request to the daemon, health checks
+		// included, so the hit rate is taken over cache lookups only.
+		hits, misses := nilToZero(stat.WeightOracleCacheHits), nilToZero(stat.WeightOracleCacheMisses)
+		var hitRate float64
+		if hits+misses > 0 {
+			hitRate = 100 * float64(hits) / float64(hits+misses)
+		}
+		s += "\n" + fmt.Sprintf(infoNodeStatusWeightOracleQueries, *stat.WeightOracleQueries, hits, misses, hitRate)
+	}
+	return s
+}
+
 func makeStatusString(stat model.NodeStatusResponse) string {
 	lastRoundTime := fmt.Sprintf("%.1fs", time.Duration(stat.TimeSinceLastRound).Seconds())
 	catchupTime := fmt.Sprintf("%.1fs", time.Duration(stat.CatchupTime).Seconds())
@@ -472,6 +499,15 @@ func makeStatusString(stat model.NodeStatusResponse) string {
 			statusString = statusString + "\n" + fmt.Sprintf(nodeLastCatchpoint, *stat.LastCatchpoint)
 		}
 
+		if stat.TotalExternalWeight != nil {
+			display := ledgercore.WeightDisplay{
+				Unit:  nilToZero(stat.ExternalWeightUnit),
+				Scale: nilToZero(stat.ExternalWeightScale),
+			}
+			statusString = statusString + "\n" + fmt.Sprintf(infoNodeStatusTotalExternalWeight, display.Format(*stat.TotalExternalWeight))
+		}
+		statusString += makeWeightOracleStatusString(stat)
+
 		if stat.StoppedAtUnsupportedRound {
 			statusString = statusString + "\n" + fmt.Sprintf(catchupStoppedOnUnsupported, stat.LastRound)
 		}
@@ -21,10 +21,12 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -113,3 +115,34 @@ func TestGetMissingCatchpointLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeWeightOracleStatusString(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Empty(t, makeWeightOracleStatusString(model.NodeStatusResponse{}))
+
+	state, failures := "degraded", uint64(1)
+	since := int64(2500 * time.Millisecond)
+	algorithm, protocol := "1.0", "2"
+	queries, hits, misses := uint64(40), uint64(30), uint64(10)
+	stat := model.NodeStatusResponse{
+		WeightOracleState:               &state,
+		WeightOracleConsecutiveFailures: &failures,
+		WeightOracleTimeSinceLastAnswer: &since,
+		WeightOracleAlgorithmVersion:    &algorithm,
+		WeightOracleProtocolVersion:     &protocol,
+		WeightOracleQueries:             &queries,
+		WeightOracleCacheHits:           &hits,
+		WeightOracleCacheMisses:         &misses,
+	}
+	require.Equal(t, "\nWeight daemon state: degraded (1 consecutive failed health checks)"+
+		"\nTime since weight daemon last answered: 2.5s"+
+		"\nWeight daemon versions: algorithm 1.0, protocol 2"+
+		"\nWeight daemon queries: 40\nWeight cache: 30 hits, 10 misses (75.0% hit rate)", makeWeightOracleStatusString(stat))
+
+	// With no cache lookups yet, the hit rate is zero rather than NaN.
+	var zero uint64
+	require.Equal(t, "\nWeight daemon queries: 40\nWeight cache: 0 hits, 0 misses (0.0% hit rate)",
+		makeWeightOracleStatusString(model.NodeStatusResponse{WeightOracleQueries: &queries, WeightOracleCacheHits: &zero, WeightOracleCacheMisses: &zero}))
+}
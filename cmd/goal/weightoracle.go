@@ -0,0 +1,319 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+var weightOracleBundleOut string
+var weightCacheExportOut string
+var weightCacheExportSince uint64
+
+var weightOracleDirect bool
+var weightOracleRound uint64
+var weightOracleBalanceRound uint64
+var weightOracleVoteRound uint64
+var weightOracleSelectionKey string
+
+func init() {
+	nodeCmd.AddCommand(weightOracleBundleCmd)
+	nodeCmd.AddCommand(weightCacheExportCmd)
+
+	weightOracleCmd.AddCommand(weightOracleStatusCmd)
+	weightOracleCmd.AddCommand(weightOracleWeightCmd)
+	weightOracleCmd.AddCommand(weightOracleTotalCmd)
+
+	weightOracleCmd.PersistentFlags().BoolVar(&weightOracleDirect, "daemon", false, "Query the weight daemon the node is configured to use directly, rather than through the node")
+
+	weightOracleWeightCmd.Flags().Uint64VarP(&weightOracleRound, "round", "r", 0, "Look up the weight for selecting the committees of this round (default the round after the latest)")
+	weightOracleWeightCmd.Flags().Uint64Var(&weightOracleBalanceRound, "balance-round", 0, "With --daemon and --selection-key, the balance round to look up, without asking the node")
+	weightOracleWeightCmd.Flags().StringVar(&weightOracleSelectionKey, "selection-key", "", "With --daemon and --balance-round, the base64 selection key to look up, without asking the node")
+
+	weightOracleTotalCmd.Flags().Uint64Var(&weightOracleBalanceRound, "balance-round", 0, "The balance round to look up")
+	weightOracleTotalCmd.Flags().Uint64Var(&weightOracleVoteRound, "vote-round", 0, "The vote round to look up")
+	weightOracleTotalCmd.MarkFlagRequired("balance-round")
+	weightOracleTotalCmd.MarkFlagRequired("vote-round")
+
+	weightOracleBundleCmd.Flags().StringVarP(&weightOracleBundleOut, "out", "o", "", "Write the archive to this file (default weightoracle-<time>.tar.gz)")
+
+	weightCacheExportCmd.Flags().StringVarP(&weightCacheExportOut, "out", "o", "", "Write the answers to this file (default weightcache-<time>.json)")
+	weightCacheExportCmd.Flags().Uint64Var(&weightCacheExportSince, "since", 0, "Export only answers for this balance round and later")
+}
+
+var weightOracleBundleCmd = &cobra.Command{
+	Use:   "weight-oracle-bundle",
+	Short: "Save the node's weight daemon diagnostics for a bug report",
+	Long: "Save the node's weight daemon client settings, the daemon's identity, query and cache counts, " +
+		"the most recent daemon requests and the health check history to a .tar.gz archive. " +
+		"Credentials are redacted. Requires the node's admin API token.",
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		bundle, err := client.WeightOracleBundle()
+		if err != nil {
+			reportErrorf(errorWeightOracleBundle, err)
+		}
+
+		out := weightOracleBundleOut
+		if out == "" {
+			out = fmt.Sprintf("weightoracle-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z"))
+		}
+		if err := os.WriteFile(out, bundle, 0600); err != nil {
+			reportErrorf(fileWriteError, out, err)
+		}
+		reportInfof(infoWeightOracleBundle, out)
+	},
+}
+
+var weightCacheExportCmd = &cobra.Command{
+	Use:   "weight-cache-export",
+	Short: "Save the weight daemon answers the node has cached, to seed a replacement daemon",
+	Long: "Save the weights and total weights the node has cached from its weight daemon, for recent balance rounds, " +
+		"in the weight file format of the test daemon. A replacement daemon seeded with them gives the answers " +
+		"the network has been using. Requires the node's admin API token.",
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		export, err := client.WeightCacheExport(basics.Round(weightCacheExportSince))
+		if err != nil {
+			reportErrorf(errorWeightCacheExport, err)
+		}
+
+		out := weightCacheExportOut
+		if out == "" {
+			out = fmt.Sprintf("weightcache-%s.json", time.Now().UTC().Format("20060102T150405Z"))
+		}
+		if err := os.WriteFile(out, export, 0600); err != nil {
+			reportErrorf(fileWriteError, out, err)
+		}
+		reportInfof(infoWeightCacheExport, out)
+	},
+}
+
+var weightOracleCmd = &cobra.Command{
+	Use:   "weightoracle",
+	Short: "Query the external weight daemon, through the node or directly",
+	Long: "Query the weights and status of the external weight daemon, to debug weight discrepancies. " +
+		"By default the node is asked, which answers as consensus does, from its cache if it has the answer. " +
+		"With --daemon the daemon in the node's configuration is asked directly. " +
+		"Queries through the node require the node's admin API token.",
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var weightOracleStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show whether the weight daemon is answering, and its versions",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		if weightOracleDirect {
+			daemon, endpoint := ensureWeightDaemonClient(dataDir)
+			start := time.Now()
+			if err := daemon.Ping(); err != nil {
+				reportErrorf(errorWeightOracleStatus, err)
+			}
+			ping := time.Since(start)
+			identity, err := daemon.Identity()
+			if err != nil {
+				reportErrorf(errorWeightOracleStatus, err)
+			}
+			reportInfof(infoWeightOracleDaemon, endpoint, identity.GenesisHash, identity.WeightAlgorithmVersion, identity.WeightProtocolVersion, capabilitiesString(identity), ping)
+			return
+		}
+
+		client := ensureAlgodClient(dataDir)
+		stat, err := client.Status()
+		if err != nil {
+			reportErrorf(errorWeightOracleStatus, err)
+		}
+		status := makeWeightOracleStatusString(stat)
+		if status == "" {
+			reportInfoln(infoWeightOracleNotUsed)
+			return
+		}
+		// The status lines each start with a newline.
+		reportInfoln(status[1:])
+	},
+}
+
+var weightOracleWeightCmd = &cobra.Command{
+	Use:   "weight [address]",
+	Short: "Look up the weight of an account",
+	Long: "Look up the weight of an account for selecting the committees of a round, under the selection key " +
+		"it had registered at the round's balance round. With --daemon the node finds the balance round and key, " +
+		"and the weight the daemon answers directly is shown next to the node's, unless --balance-round and " +
+		"--selection-key are given, in which case the node is not asked at all.",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		addr, err := basics.UnmarshalChecksumAddress(args[0])
+		if err != nil {
+			reportErrorf(errorParseAddr, err)
+		}
+		balanceRoundSet := cmd.Flags().Changed("balance-round")
+		if balanceRoundSet != (weightOracleSelectionKey != "") || balanceRoundSet && !weightOracleDirect {
+			reportErrorln(errorWeightOracleDirectArgs)
+		}
+
+		if balanceRoundSet {
+			key, err := base64.StdEncoding.DecodeString(weightOracleSelectionKey)
+			var selectionID crypto.VRFVerifier
+			if err == nil && len(key) != len(selectionID) {
+				err = fmt.Errorf("want %d bytes, got %d", len(selectionID), len(key))
+			}
+			if err != nil {
+				reportErrorf(errorWeightOracleSelectionKey, weightOracleSelectionKey, err)
+			}
+			copy(selectionID[:], key)
+			daemon, _ := ensureWeightDaemonClient(dataDir)
+			weight, err := daemon.Weight(basics.Round(weightOracleBalanceRound), addr, selectionID)
+			if err != nil {
+				reportErrorf(errorWeightOracleLookup, err)
+			}
+			reportInfof(infoWeightOracleDaemonWeight, daemonWeightDisplay(daemon).Format(weight))
+			return
+		}
+
+		client := ensureAlgodClient(dataDir)
+		w, err := client.ExternalWeight(basics.Round(weightOracleRound), addr)
+		if err != nil {
+			reportErrorf(errorWeightOracleLookup, err)
+		}
+		if !w.Eligible {
+			reportInfof(infoWeightOracleIneligible, addr, w.Round, w.BalanceRound)
+			return
+		}
+		display := nodeWeightDisplay(client)
+		reportInfof(infoWeightOracleWeight, addr, w.Round, w.BalanceRound, base64.StdEncoding.EncodeToString(w.SelectionID), display.Format(w.Weight))
+		if weightOracleDirect {
+			var selectionID crypto.VRFVerifier
+			copy(selectionID[:], w.SelectionID)
+			daemon, _ := ensureWeightDaemonClient(dataDir)
+			weight, err := daemon.Weight(w.BalanceRound, addr, selectionID)
+			if err != nil {
+				reportErrorf(errorWeightOracleLookup, err)
+			}
+			reportInfof(infoWeightOracleDaemonWeight, display.Format(weight))
+		}
+	},
+}
+
+var weightOracleTotalCmd = &cobra.Command{
+	Use:   "total",
+	Short: "Look up the total weight for a balance round and vote round",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		balanceRound, voteRound := basics.Round(weightOracleBalanceRound), basics.Round(weightOracleVoteRound)
+		if weightOracleDirect {
+			daemon, _ := ensureWeightDaemonClient(dataDir)
+			total, err := daemon.TotalWeight(balanceRound, voteRound)
+			if err != nil {
+				reportErrorf(errorWeightOracleLookup, err)
+			}
+			reportInfof(infoWeightOracleTotal, balanceRound, voteRound, daemonWeightDisplay(daemon).Format(total))
+			return
+		}
+
+		client := ensureAlgodClient(dataDir)
+		total, err := client.TotalExternalWeight(balanceRound, voteRound)
+		if err != nil {
+			reportErrorf(errorWeightOracleLookup, err)
+		}
+		reportInfof(infoWeightOracleTotal, total.BalanceRound, total.VoteRound, nodeWeightDisplay(client).Format(total.TotalWeight))
+	},
+}
+
+// ensureWeightDaemonClient returns a client for the weight daemon the node in
+// dataDir is configured to use, set up as the node sets up its own, and the
+// daemon's URL.
+func ensureWeightDaemonClient(dataDir string) (*weightoracle.Client, *url.URL) {
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		reportErrorf(errLoadingConfig, dataDir, err)
+	}
+	endpoint, err := cfg.ExternalWeightOracleEndpoint()
+	if err != nil {
+		reportErrorf(errorWeightOracleClient, err)
+	}
+	secrets, err := cfg.LoadExternalWeightOracleSecrets(dataDir)
+	if err != nil {
+		reportErrorf(errorWeightOracleClient, err)
+	}
+	clientConfig := weightoracle.MakeClientConfig(cfg)
+	clientConfig.AuthToken = secrets.AuthToken
+	clientConfig.SigningKey = secrets.SigningKey
+	clientConfig.TLSRootCAs = secrets.TLSRootCAs
+	clientConfig.TLSClientCertificate = secrets.TLSClientCertificate
+	return weightoracle.NewClientWithConfig(endpoint, clientConfig), endpoint
+}
+
+// nodeWeightDisplay returns how the node's weight oracle asks for weights to
+// be shown, or the plain display if the node cannot tell.
+func nodeWeightDisplay(client libgoal.Client) ledgercore.WeightDisplay {
+	stat, err := client.Status()
+	if err != nil {
+		return ledgercore.WeightDisplay{}
+	}
+	return ledgercore.WeightDisplay{
+		Unit:  nilToZero(stat.ExternalWeightUnit),
+		Scale: nilToZero(stat.ExternalWeightScale),
+	}
+}
+
+// capabilitiesString lists the capabilities of a daemon's identity for
+// people.
+func capabilitiesString(identity ledgercore.DaemonIdentity) string {
+	switch {
+	case identity.Capabilities == nil:
+		return "not listed"
+	case len(identity.Capabilities) == 0:
+		return "none"
+	}
+	return strings.Join(identity.Capabilities, ", ")
+}
+
+// daemonWeightDisplay returns how the daemon asks for weights to be shown, or
+// the plain display if it does not answer.
+func daemonWeightDisplay(daemon *weightoracle.Client) ledgercore.WeightDisplay {
+	identity, err := daemon.Identity()
+	if err != nil {
+		return ledgercore.WeightDisplay{}
+	}
+	return identity.Display
+}
@@ -55,6 +55,7 @@ var applicationCount uint64
 var balRange []string
 var lastPartKeyRound basics.Round
 var deterministicKeys bool
+var weightOracleAutoPorts bool
 
 func init() {
 	rootCmd.AddCommand(generateCmd)
@@ -81,6 +82,7 @@ func init() {
 	generateCmd.Flags().Uint64VarP(&applicationCount, "napps", "", 7, "Application Count")
 	generateCmd.Flags().StringArrayVar(&balRange, "bal", []string{}, "Application Count")
 	generateCmd.Flags().BoolVarP(&deterministicKeys, "deterministic", "", false, "Whether to generate deterministic keys")
+	generateCmd.Flags().BoolVarP(&weightOracleAutoPorts, "weight-oracle-auto-ports", "", false, "Give every node of a goalnet template a free local weight daemon port when the network is created")
 	generateCmd.Flags().Uint64VarP((*uint64)(&lastPartKeyRound), "last-part-key-round", "", uint64(gen.DefaultGenesis.LastPartKeyRound), "LastPartKeyRound in genesis.json")
 
 	longParts := make([]string, len(generateTemplateLines)+1)
@@ -246,6 +248,7 @@ func generateNetworkGoalTemplate(templateFilename string, wallets, relays, nodes
 	template := netdeploy.NetworkTemplate{}
 	template.Nodes = make([]remote.NodeConfigGoal, 0, relays+nodes+npnNodes)
 	template.Genesis = generateWalletGenesisData(walletsToGenerate, 0)
+	template.WeightOracleAutoPorts = weightOracleAutoPorts
 	for i := 0; i < relays; i++ {
 		name := "relay" + strconv.Itoa(i+1)
 		newNode := remote.NodeConfigGoal{
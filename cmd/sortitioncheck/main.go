@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// sortitioncheck runs many credential verifications across external weight
+// distributions and checks the selections against the binomial model, to
+// catch regressions in weighted sortition.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/committee/sortitioncheck"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var consensusVersion = flag.String("protocol", string(protocol.ConsensusFuture), "Consensus version whose parameters are used")
+var step = flag.String("step", "soft", "Committee to select: propose, soft, cert or next")
+var accounts = flag.Int("accounts", 1000, "Number of accounts")
+var trials = flag.Int("trials", 1000, "Number of selection seeds each account is verified against")
+var dist = flag.String("dist", "all", "Weight distribution: uniform, zipf, whale or all")
+var confidence = flag.Float64("confidence", 0.999, "Probability that a correct implementation passes all checks of a distribution")
+var seed = flag.Int64("seed", 1, "Seed for the accounts, weights and selection seeds")
+var workers = flag.Int("workers", 0, "Verifications run in parallel; 0 means one per CPU")
+
+func main() {
+	flag.Parse()
+
+	proto, ok := config.Consensus[protocol.ConsensusVersion(*consensusVersion)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown consensus version %q\n", *consensusVersion)
+		os.Exit(1)
+	}
+	committeeSizes := map[string]uint64{
+		"propose": proto.NumProposers,
+		"soft":    proto.SoftCommitteeSize,
+		"cert":    proto.CertCommitteeSize,
+		"next":    proto.NextCommitteeSize,
+	}
+	committeeSize, ok := committeeSizes[*step]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown -step %q\n", *step)
+		os.Exit(1)
+	}
+
+	var dists []sortitioncheck.Distribution
+	for _, d := range sortitioncheck.DefaultDistributions() {
+		if *dist == "all" || strings.HasPrefix(d.Name, *dist+"(") {
+			dists = append(dists, d)
+		}
+	}
+	if len(dists) == 0 {
+		fmt.Fprintf(os.Stderr, "unknown -dist %q\n", *dist)
+		os.Exit(1)
+	}
+
+	cfg := sortitioncheck.Config{
+		Proto:         proto,
+		CommitteeSize: committeeSize,
+		Accounts:      *accounts,
+		Trials:        *trials,
+		Confidence:    *confidence,
+		Seed:          *seed,
+		Workers:       *workers,
+	}
+	failed := false
+	for _, d := range dists {
+		start := time.Now()
+		res, err := sortitioncheck.Run(cfg, d)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", d.Name, err)
+			os.Exit(1)
+		}
+		status := "ok"
+		if len(res.Failures) > 0 {
+			status = "FAIL"
+			failed = true
+		}
+		fmt.Printf("%-24s %s: %d verifications in %v, max |z| %.2f (bound %.2f)\n",
+			d.Name, status, res.Verifications, time.Since(start).Round(time.Millisecond), res.MaxZ, res.Critical)
+		for _, f := range res.Failures {
+			fmt.Printf("  %s\n", f)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
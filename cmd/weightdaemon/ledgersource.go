@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// ledgerSource passes through the online stake in the ledger of an algod data
+// directory, as the built-in stake oracle of a node does: each account weighs
+// its online stake. It answers for the balance rounds whose online accounts
+// the ledger still holds, and the ledger does not advance while the daemon has
+// it open, so no node may be running on the data directory.
+type ledgerSource struct {
+	*weightoracle.StakeOracle
+	ledger      *data.Ledger
+	genesisHash crypto.Digest
+}
+
+func openLedgerSource(dataDir string) (*ledgerSource, error) {
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(dataDir, config.GenesisJSONFile))
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	log := logging.Base()
+	genesisDirs, err := cfg.EnsureAndResolveGenesisDirs(dataDir, genesis.ID(), log)
+	if err != nil {
+		return nil, err
+	}
+	genalloc, err := genesis.Balances()
+	if err != nil {
+		return nil, err
+	}
+	ledgerPaths := ledger.DirsAndPrefix{
+		DBFilePrefix:        config.LedgerFilenamePrefix,
+		ResolvedGenesisDirs: genesisDirs,
+	}
+	l, err := data.LoadLedger(log, ledgerPaths, false, genesis.Proto, genalloc, genesis.ID(), genesis.Hash(), cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open the ledger in %s: %w", dataDir, err)
+	}
+	return &ledgerSource{
+		StakeOracle: weightoracle.NewStakeOracle(l.Ledger, genesis.Hash()),
+		ledger:      l,
+		genesisHash: genesis.Hash(),
+	}, nil
+}
+
+// GenesisHash is the genesis hash of the ledger's network, which the daemon
+// reports in its identity.
+func (s *ledgerSource) GenesisHash() crypto.Digest {
+	return s.genesisHash
+}
+
+func (s *ledgerSource) Close() error {
+	s.ledger.Close()
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// weightdaemon is a weight daemon: it serves the weight oracle protocol algod
+// speaks, from weights kept in a JSON file or a SQLite database, or from the
+// online stake in a ledger. Operators run it in place of the Python test
+// daemon, which is meant for tests only.
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+var listenAddr = flag.String("listen", "127.0.0.1:9876", "Address to listen on, as host:port")
+var socketPath = flag.String("socket", "", "Path of a unix domain socket to listen on instead of -listen")
+var sourceSpec = flag.String("source", "", "Where the weights come from: file:<path>, sqlite:<path> or ledger:<data directory>")
+var genesisHashFlag = flag.String("genesis-hash", "", "Genesis hash of the network, in base64 or hex; the ledger source reads it from the ledger")
+var algorithmVersion = flag.String("algorithm-version", ledgercore.ExpectedWeightAlgorithmVersion, "Weight algorithm version to report")
+var protocolVersion = flag.String("protocol-version", ledgercore.ExpectedWeightProtocolVersion, "Weight protocol version to report")
+var weightUnit = flag.String("weight-unit", "", "Unit to display weights in, such as credits")
+var weightScale = flag.Uint64("weight-scale", 0, "Weight units in one -weight-unit, a power of ten")
+var authTokenRef = flag.String("auth-token", "", "Bearer token every request must present, as \"file:<path>\" or \"env:<NAME>\"")
+var signingKeyRef = flag.String("signing-key", "", "HMAC key every request must be signed with, as \"file:<path>\" or \"env:<NAME>\"")
+var tlsCert = flag.String("tls-cert", "", "PEM certificate chain to serve HTTPS with; requires -tls-key")
+var tlsKey = flag.String("tls-key", "", "PEM private key of -tls-cert")
+
+func main() {
+	flag.Parse()
+
+	if *sourceSpec == "" {
+		fail("-source is required")
+	}
+	if (*tlsCert == "") != (*tlsKey == "") || *tlsCert != "" && *socketPath != "" {
+		fail("-tls-cert and -tls-key must be given together, without -socket")
+	}
+	source, err := openSource(*sourceSpec)
+	if err != nil {
+		fail("cannot open the weight source: %v", err)
+	}
+	defer source.Close()
+
+	identity := ledgercore.DaemonIdentity{
+		WeightAlgorithmVersion: *algorithmVersion,
+		WeightProtocolVersion:  *protocolVersion,
+		Display:                ledgercore.WeightDisplay{Unit: *weightUnit, Scale: *weightScale},
+	}
+	if !powerOfTen(*weightScale) {
+		fail("-weight-scale %d is not a power of ten", *weightScale)
+	}
+	if hasher, ok := source.(interface{ GenesisHash() crypto.Digest }); ok {
+		if *genesisHashFlag != "" {
+			fail("-genesis-hash cannot be given with the ledger source")
+		}
+		identity.GenesisHash = hasher.GenesisHash()
+	} else {
+		if *genesisHashFlag == "" {
+			fail("-genesis-hash is required")
+		}
+		identity.GenesisHash, err = parseGenesisHash(*genesisHashFlag)
+		if err != nil {
+			fail("invalid -genesis-hash: %v", err)
+		}
+	}
+
+	handler := weightoracle.NewHandler(newOracle(source, identity))
+	if *signingKeyRef != "" {
+		key, err := config.LoadSecret(*signingKeyRef, ".")
+		if err != nil {
+			fail("invalid -signing-key: %v", err)
+		}
+		handler = weightoracle.RequireSignatures(handler, key.Bytes())
+	}
+	if *authTokenRef != "" {
+		token, err := config.LoadSecret(*authTokenRef, ".")
+		if err != nil {
+			fail("invalid -auth-token: %v", err)
+		}
+		handler = weightoracle.RequireAuthToken(handler, token.Bytes())
+	}
+
+	var listener net.Listener
+	if *socketPath != "" {
+		os.Remove(*socketPath)
+		listener, err = net.Listen("unix", *socketPath)
+		if err == nil {
+			// Only the daemon's user may connect, or replace the socket.
+			err = os.Chmod(*socketPath, 0600)
+		}
+	} else {
+		listener, err = net.Listen("tcp", *listenAddr)
+	}
+	if err != nil {
+		fail("cannot listen: %v", err)
+	}
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	log := logging.Base()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		for sig := range signals {
+			if sig == syscall.SIGHUP {
+				reload(log, source)
+				continue
+			}
+			log.Infof("weightdaemon stopping on %v", sig)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			server.Shutdown(ctx)
+			cancel()
+			return
+		}
+	}()
+
+	log.Infof("weightdaemon serving weights from %s on %s", *sourceSpec, listener.Addr())
+	if *tlsCert != "" {
+		err = server.ServeTLS(listener, *tlsCert, *tlsKey)
+	} else {
+		err = server.Serve(listener)
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
+		fail("%v", err)
+	}
+}
+
+// reload rereads the weights of a source that can, keeping the old weights if
+// the new ones cannot be read.
+func reload(log logging.Logger, source weightSource) {
+	r, ok := source.(reloader)
+	if !ok {
+		log.Infof("weightdaemon: this weight source reads its weights as it answers; nothing to reload")
+		return
+	}
+	if err := r.Reload(); err != nil {
+		log.Errorf("weightdaemon: cannot reload the weights, still serving the old ones: %v", err)
+		return
+	}
+	log.Infof("weightdaemon: reloaded the weights")
+}
+
+// powerOfTen reports whether scale is a power of ten, or 0 for none, as clients
+// require of the weight scale.
+func powerOfTen(scale uint64) bool {
+	if scale == 0 {
+		return true
+	}
+	for scale%10 == 0 {
+		scale /= 10
+	}
+	return scale == 1
+}
+
+// parseGenesisHash parses a genesis hash given in hex or base64.
+func parseGenesisHash(s string) (crypto.Digest, error) {
+	var d crypto.Digest
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		b, err = base64.StdEncoding.DecodeString(s)
+	}
+	if err != nil {
+		return d, errors.New("not hex or base64")
+	}
+	if len(b) != len(d) {
+		return d, fmt.Errorf("%d bytes, not %d", len(b), len(d))
+	}
+	copy(d[:], b)
+	return d, nil
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
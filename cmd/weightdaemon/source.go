@@ -0,0 +1,369 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// weightSource is where the daemon gets the weights it serves. Weights and
+// totals it does not have are answered with a not_found DaemonError.
+type weightSource interface {
+	Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error)
+	TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error)
+
+	// Ping checks that the source can answer.
+	Ping() error
+
+	// Close releases the source.
+	Close() error
+}
+
+// reloader is implemented by sources that can reread their weights while the
+// daemon runs.
+type reloader interface {
+	Reload() error
+}
+
+// openSource opens the source named by spec: "file:<path>", "sqlite:<path>"
+// or "ledger:<data directory>".
+func openSource(spec string) (weightSource, error) {
+	kind, target, ok := strings.Cut(spec, ":")
+	if !ok || target == "" {
+		return nil, fmt.Errorf("source %q is not file:<path>, sqlite:<path> or ledger:<data directory>", spec)
+	}
+	switch kind {
+	case "file":
+		return openFileSource(target)
+	case "sqlite":
+		return openSQLiteSource(target)
+	case "ledger":
+		return openLedgerSource(target)
+	}
+	return nil, fmt.Errorf("unknown source kind %q: want file, sqlite or ledger", kind)
+}
+
+// oracle serves a weight source as a ledgercore.WeightOracle, with the
+// identity given on the command line.
+type oracle struct {
+	source   weightSource
+	identity ledgercore.DaemonIdentity
+}
+
+func (o *oracle) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	return o.source.Weight(balanceRound, addr, selectionID)
+}
+
+func (o *oracle) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	return o.source.TotalWeight(balanceRound, voteRound)
+}
+
+func (o *oracle) Ping() error {
+	return o.source.Ping()
+}
+
+func (o *oracle) Identity() (ledgercore.DaemonIdentity, error) {
+	return o.identity, nil
+}
+
+// newOracle returns the oracle serving source with identity. It pushes weight
+// tables, and lists the push capability, only for sources that can tell when
+// one arrives.
+func newOracle(source weightSource, identity ledgercore.DaemonIdentity) ledgercore.WeightOracle {
+	o := &oracle{source: source, identity: identity}
+	if publisher, ok := source.(weightoracle.WeightTablePublisher); ok {
+		return &pushingOracle{oracle: o, publisher: publisher}
+	}
+	return o
+}
+
+// pushingOracle is an oracle whose source pushes its weight tables.
+type pushingOracle struct {
+	*oracle
+	publisher weightoracle.WeightTablePublisher
+}
+
+// NextWeightTable waits for the weight table of a balance round at or after
+// from.
+func (o *pushingOracle) NextWeightTable(ctx context.Context, from basics.Round) (basics.Round, error) {
+	return o.publisher.NextWeightTable(ctx, from)
+}
+
+// WeightTable lists the weights of a balance round, for sources that can.
+func (o *oracle) WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	tabler, ok := o.source.(weightoracle.WeightTabler)
+	if !ok {
+		return nil, &ledgercore.DaemonError{Code: "not_found", Msg: "this weight source cannot list its weights"}
+	}
+	return tabler.WeightTable(balanceRound)
+}
+
+func notFound(format string, args ...interface{}) error {
+	return &ledgercore.DaemonError{Code: "not_found", Msg: fmt.Sprintf(format, args...)}
+}
+
+// weightKey identifies a weight in a fileSource.
+type weightKey struct {
+	balanceRound basics.Round
+	query        ledgercore.WeightQuery
+}
+
+// totalKey identifies a total weight in a fileSource.
+type totalKey struct {
+	balanceRound basics.Round
+	voteRound    basics.Round
+}
+
+// weightTables are the weights of a fileSource, indexed for lookups.
+type weightTables struct {
+	weights map[weightKey]uint64
+	totals  map[totalKey]uint64
+	rounds  map[basics.Round][]ledgercore.WeightTableEntry
+
+	// replaced is closed when a reload replaces these tables.
+	replaced chan struct{}
+}
+
+// fileSource serves the weights of a JSON file in the format of
+// weightoracle.CacheExport, which is also the test daemon's --weight-file
+// format and what goal node weight-cache-export writes.
+type fileSource struct {
+	path   string
+	tables atomic.Pointer[weightTables]
+}
+
+func openFileSource(path string) (*fileSource, error) {
+	s := &fileSource{path: path}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload rereads the file. Lookups answer from the old weights until the new
+// ones are read in full, and keep doing so if the file cannot be read.
+func (s *fileSource) Reload() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	var export weightoracle.CacheExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+	tables, err := indexWeights(export)
+	if err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+	if old := s.tables.Swap(tables); old != nil {
+		close(old.replaced)
+	}
+	return nil
+}
+
+// indexWeights indexes the weights of export for lookups.
+func indexWeights(export weightoracle.CacheExport) (*weightTables, error) {
+	t := &weightTables{
+		weights:  make(map[weightKey]uint64, len(export.Weights)),
+		totals:   make(map[totalKey]uint64, len(export.TotalWeights)),
+		rounds:   make(map[basics.Round][]ledgercore.WeightTableEntry),
+		replaced: make(chan struct{}),
+	}
+	for key, weight := range export.Weights {
+		balanceRound, q, err := weightoracle.ParseWeightExportKey(key)
+		if err != nil {
+			return nil, err
+		}
+		t.weights[weightKey{balanceRound: balanceRound, query: q}] = weight
+		t.rounds[balanceRound] = append(t.rounds[balanceRound], ledgercore.WeightTableEntry{Addr: q.Addr, SelectionID: q.SelectionID, Weight: weight})
+	}
+	for key, total := range export.TotalWeights {
+		balanceRound, voteRound, err := weightoracle.ParseTotalWeightExportKey(key)
+		if err != nil {
+			return nil, err
+		}
+		t.totals[totalKey{balanceRound: balanceRound, voteRound: voteRound}] = total
+	}
+	for _, table := range t.rounds {
+		sort.Slice(table, func(i, j int) bool {
+			return strings.Compare(table[i].Addr.String(), table[j].Addr.String()) < 0
+		})
+	}
+	return t, nil
+}
+
+func (s *fileSource) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	key := weightKey{balanceRound: balanceRound, query: ledgercore.WeightQuery{Addr: addr, SelectionID: selectionID}}
+	weight, ok := s.tables.Load().weights[key]
+	if !ok {
+		return 0, notFound("no weight for %v under that selection ID at balance round %d", addr, balanceRound)
+	}
+	return weight, nil
+}
+
+func (s *fileSource) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	total, ok := s.tables.Load().totals[totalKey{balanceRound: balanceRound, voteRound: voteRound}]
+	if !ok {
+		return 0, notFound("no total weight for balance round %d and vote round %d", balanceRound, voteRound)
+	}
+	return total, nil
+}
+
+func (s *fileSource) WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	table, ok := s.tables.Load().rounds[balanceRound]
+	if !ok {
+		return nil, notFound("no weights for balance round %d", balanceRound)
+	}
+	return table, nil
+}
+
+// NextWeightTable returns the earliest balance round at or after from in the
+// file, waiting for a reload to add one if there is none.
+func (s *fileSource) NextWeightTable(ctx context.Context, from basics.Round) (basics.Round, error) {
+	for {
+		tables := s.tables.Load()
+		found := false
+		var earliest basics.Round
+		for rnd := range tables.rounds {
+			if rnd >= from && (!found || rnd < earliest) {
+				earliest, found = rnd, true
+			}
+		}
+		if found {
+			return earliest, nil
+		}
+		select {
+		case <-tables.replaced:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+func (s *fileSource) Ping() error  { return nil }
+func (s *fileSource) Close() error { return nil }
+
+// sqliteSource serves the weights of a SQLite database, opened read-only so
+// that another process can keep it up to date. It reads two tables:
+//
+//	weights(address TEXT, selection_id TEXT, balance_round INTEGER, weight INTEGER)
+//	total_weights(balance_round INTEGER, vote_round INTEGER, total_weight INTEGER)
+//
+// with addresses in their checksummed base32 form and selection IDs in hex.
+type sqliteSource struct {
+	accessor db.Accessor
+}
+
+func openSQLiteSource(path string) (*sqliteSource, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	accessor, err := db.MakeAccessor(path, true, false)
+	if err != nil {
+		return nil, err
+	}
+	s := &sqliteSource{accessor: accessor}
+	if err := s.Ping(); err != nil {
+		accessor.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Ping checks that the database has both tables.
+func (s *sqliteSource) Ping() error {
+	for _, table := range []string{"weights", "total_weights"} {
+		var name string
+		err := s.accessor.Handle.QueryRow("SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&name)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("database has no %s table", table)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqliteSource) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	var weight uint64
+	err := s.accessor.Handle.QueryRow("SELECT weight FROM weights WHERE address = ? AND selection_id = ? AND balance_round = ?",
+		addr.String(), hex.EncodeToString(selectionID[:]), uint64(balanceRound)).Scan(&weight)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, notFound("no weight for %v under that selection ID at balance round %d", addr, balanceRound)
+	}
+	return weight, err
+}
+
+func (s *sqliteSource) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	var total uint64
+	err := s.accessor.Handle.QueryRow("SELECT total_weight FROM total_weights WHERE balance_round = ? AND vote_round = ?",
+		uint64(balanceRound), uint64(voteRound)).Scan(&total)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, notFound("no total weight for balance round %d and vote round %d", balanceRound, voteRound)
+	}
+	return total, err
+}
+
+func (s *sqliteSource) WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	rows, err := s.accessor.Handle.Query("SELECT address, selection_id, weight FROM weights WHERE balance_round = ? ORDER BY address", uint64(balanceRound))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var table []ledgercore.WeightTableEntry
+	for rows.Next() {
+		var address, selectionID string
+		var entry ledgercore.WeightTableEntry
+		if err := rows.Scan(&address, &selectionID, &entry.Weight); err != nil {
+			return nil, err
+		}
+		_, q, err := weightoracle.ParseWeightExportKey(fmt.Sprintf("%s:%s:%d", address, selectionID, balanceRound))
+		if err != nil {
+			return nil, err
+		}
+		entry.Addr, entry.SelectionID = q.Addr, q.SelectionID
+		table = append(table, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(table) == 0 {
+		return nil, notFound("no weights for balance round %d", balanceRound)
+	}
+	return table, nil
+}
+
+func (s *sqliteSource) Close() error {
+	s.accessor.Close()
+	return nil
+}
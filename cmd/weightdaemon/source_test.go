@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/codecs"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var testAddr = basics.Address{1}
+var testSelectionID = crypto.VRFVerifier{2}
+
+func testWeightKey(balanceRound basics.Round) string {
+	return fmt.Sprintf("%s:%s:%d", testAddr, hex.EncodeToString(testSelectionID[:]), balanceRound)
+}
+
+// checkSource checks that a source holding a weight of 50 for testAddr at
+// balance round 100 and a total of 125 for vote round 420 serves them through
+// the daemon protocol.
+func checkSource(t *testing.T, source weightSource) {
+	genesisHash := crypto.Hash([]byte("genesis"))
+	o := newOracle(source, ledgercore.DaemonIdentity{
+		GenesisHash:            genesisHash,
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	})
+	server := httptest.NewServer(weightoracle.NewHandler(o))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client := weightoracle.NewClientURL(u)
+
+	require.NoError(t, client.Ping())
+	identity, err := client.Identity()
+	require.NoError(t, err)
+	require.Equal(t, genesisHash, identity.GenesisHash)
+	require.Contains(t, identity.Capabilities, weightoracle.CapabilityBatch)
+	_, pushes := source.(weightoracle.WeightTablePublisher)
+	require.Equal(t, pushes, identity.Supports(weightoracle.CapabilityPush))
+
+	weight, err := client.Weight(100, testAddr, testSelectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), weight)
+	_, err = client.Weight(101, testAddr, testSelectionID)
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	_, err = client.Weight(100, testAddr, crypto.VRFVerifier{3})
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+
+	total, err := client.TotalWeight(100, 420)
+	require.NoError(t, err)
+	require.Equal(t, uint64(125), total)
+	_, err = client.TotalWeight(100, 421)
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+
+	table, err := client.WeightTable(100)
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightTableEntry{{Addr: testAddr, SelectionID: testSelectionID, Weight: 50}}, table)
+}
+
+func TestFileSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "weights.json")
+	export := weightoracle.CacheExport{
+		Weights:      map[string]uint64{testWeightKey(100): 50},
+		TotalWeights: map[string]uint64{"100:420": 125},
+	}
+	require.NoError(t, codecs.SaveObjectToFile(path, export, true))
+	source, err := openSource("file:" + path)
+	require.NoError(t, err)
+	checkSource(t, source)
+
+	// A reload picks up new weights, and a failed one keeps the old ones.
+	export.Weights[testWeightKey(100)] = 60
+	require.NoError(t, codecs.SaveObjectToFile(path, export, true))
+	require.NoError(t, source.(reloader).Reload())
+	weight, err := source.Weight(100, testAddr, testSelectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), weight)
+
+	export.Weights["not-a-key"] = 1
+	require.NoError(t, codecs.SaveObjectToFile(path, export, true))
+	require.Error(t, source.(reloader).Reload())
+	weight, err = source.Weight(100, testAddr, testSelectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), weight)
+}
+
+func TestFileSourcePushesReloadedTables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "weights.json")
+	export := weightoracle.CacheExport{Weights: map[string]uint64{testWeightKey(100): 50}}
+	require.NoError(t, codecs.SaveObjectToFile(path, export, true))
+	source, err := openFileSource(path)
+	require.NoError(t, err)
+	server := httptest.NewServer(weightoracle.NewHandler(newOracle(source, ledgercore.DaemonIdentity{})))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client := weightoracle.NewClientURL(u)
+	ctx := context.Background()
+
+	rnd, cached, err := client.NextWeightTable(ctx, 90, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(100), rnd)
+	require.Equal(t, 1, cached)
+	_, _, err = client.NextWeightTable(ctx, 101, 50*time.Millisecond)
+	require.ErrorIs(t, err, weightoracle.ErrNoNewWeightTable)
+
+	// A reload adding a later round pushes it to a waiting client.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		export.Weights[testWeightKey(101)] = 60
+		if err := codecs.SaveObjectToFile(path, export, true); err != nil {
+			t.Error(err)
+		}
+		if err := source.Reload(); err != nil {
+			t.Error(err)
+		}
+	}()
+	rnd, _, err = client.NextWeightTable(ctx, 101, 10*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(101), rnd)
+}
+
+func TestSQLiteSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "weights.sqlite")
+	_, err := openSource("sqlite:" + path)
+	require.Error(t, err)
+
+	writer, err := db.MakeAccessor(path, false, false)
+	require.NoError(t, err)
+	defer writer.Close()
+	_, err = writer.Handle.Exec("CREATE TABLE weights (address TEXT, selection_id TEXT, balance_round INTEGER, weight INTEGER)")
+	require.NoError(t, err)
+	_, err = openSource("sqlite:" + path)
+	require.ErrorContains(t, err, "no total_weights table")
+
+	_, err = writer.Handle.Exec("CREATE TABLE total_weights (balance_round INTEGER, vote_round INTEGER, total_weight INTEGER)")
+	require.NoError(t, err)
+	_, err = writer.Handle.Exec("INSERT INTO weights VALUES (?, ?, 100, 50)", testAddr.String(), hex.EncodeToString(testSelectionID[:]))
+	require.NoError(t, err)
+	_, err = writer.Handle.Exec("INSERT INTO total_weights VALUES (100, 420, 125)")
+	require.NoError(t, err)
+
+	source, err := openSource("sqlite:" + path)
+	require.NoError(t, err)
+	defer source.Close()
+	checkSource(t, source)
+
+	// Weights written while the daemon runs are served at once.
+	_, err = writer.Handle.Exec("UPDATE weights SET weight = 60")
+	require.NoError(t, err)
+	weight, err := source.Weight(100, testAddr, testSelectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), weight)
+}
+
+func TestLedgerSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	online := basics.Address{7}
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "weightdaemon-test",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     basics.Address{8}.String(),
+		RewardsPool: basics.Address{9}.String(),
+		Allocation: []bookkeeping.GenesisAllocation{
+			{Address: basics.Address{8}.String(), State: bookkeeping.GenesisAccountData{MicroAlgos: basics.MicroAlgos{Raw: 500000}}},
+			{Address: basics.Address{9}.String(), State: bookkeeping.GenesisAccountData{MicroAlgos: basics.MicroAlgos{Raw: 500000}}},
+			{Address: online.String(), State: bookkeeping.GenesisAccountData{
+				Status:          basics.Online,
+				MicroAlgos:      basics.MicroAlgos{Raw: 1000000},
+				VoteID:          crypto.OneTimeSignatureVerifier{1},
+				SelectionID:     testSelectionID,
+				VoteLastValid:   1000,
+				VoteKeyDilution: 10,
+			}},
+		},
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, config.GenesisJSONFile), protocol.EncodeJSON(genesis), 0644))
+
+	source, err := openSource("ledger:" + dataDir)
+	require.NoError(t, err)
+	defer source.Close()
+	require.Equal(t, genesis.Hash(), source.(*ledgerSource).GenesisHash())
+
+	weight, err := source.Weight(0, online, testSelectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000000), weight)
+	_, err = source.Weight(0, online, crypto.VRFVerifier{3})
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	total, err := source.TotalWeight(0, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000000), total)
+}
+
+func TestOpenSourceErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, spec := range []string{"", "file", "file:", "postgres:weights", "file:" + filepath.Join(t.TempDir(), "missing.json")} {
+		_, err := openSource(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestParseGenesisHash(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	d := crypto.Hash([]byte("genesis"))
+	for _, s := range []string{hex.EncodeToString(d[:]), base64.StdEncoding.EncodeToString(d[:])} {
+		parsed, err := parseGenesisHash(s)
+		require.NoError(t, err)
+		require.Equal(t, d, parsed)
+	}
+	_, err := parseGenesisHash("abcd")
+	require.Error(t, err)
+	_, err = parseGenesisHash("not a hash")
+	require.Error(t, err)
+
+	require.True(t, powerOfTen(0))
+	require.True(t, powerOfTen(1))
+	require.True(t, powerOfTen(1000000))
+	require.False(t, powerOfTen(20))
+}
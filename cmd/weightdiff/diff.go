@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// account is one weight query key.
+type account struct {
+	addr        basics.Address
+	selectionID crypto.VRFVerifier
+}
+
+func (a account) String() string {
+	if a.selectionID.IsEmpty() {
+		return a.addr.String()
+	}
+	return fmt.Sprintf("%s/%s", a.addr, hex.EncodeToString(a.selectionID[:8]))
+}
+
+// parseAccounts reads one account per line, as an address optionally
+// followed by a hex selection ID. Blank lines and lines starting with # are
+// skipped.
+func parseAccounts(r io.Reader) ([]account, error) {
+	var accounts []account
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("line %d: expected an address and an optional selection ID", line)
+		}
+		var a account
+		var err error
+		a.addr, err = basics.UnmarshalChecksumAddress(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if len(fields) == 2 {
+			b, err := hex.DecodeString(fields[1])
+			if err != nil || len(b) != len(a.selectionID) {
+				return nil, fmt.Errorf("line %d: selection ID must be %d hex-encoded bytes", line, len(a.selectionID))
+			}
+			copy(a.selectionID[:], b)
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, scanner.Err()
+}
+
+// answer is what a daemon said to one query, reduced to what consensus
+// depends on: the value, or the daemon error code. Other failures, such as
+// timeouts, say nothing about the daemon's answer and are kept apart.
+type answer struct {
+	value   uint64
+	code    string
+	failure error
+}
+
+func makeAnswer(value uint64, err error) answer {
+	var de *ledgercore.DaemonError
+	switch {
+	case err == nil:
+		return answer{value: value}
+	case errors.As(err, &de):
+		return answer{code: de.Code}
+	default:
+		return answer{failure: err}
+	}
+}
+
+func (a answer) String() string {
+	if a.code != "" {
+		return "error " + a.code
+	}
+	return fmt.Sprintf("%d", a.value)
+}
+
+// divergence is a query the two daemons answered differently.
+type divergence struct {
+	voteRound basics.Round
+	query     string
+	a, b      answer
+}
+
+// differ sends the same queries to two daemons and compares the answers.
+type differ struct {
+	a, b            ledgercore.WeightOracle
+	firstRound      basics.Round
+	rounds          int
+	balanceLookback basics.Round
+
+	// accounts are queried every round. If empty, each round queries the
+	// accounts listed in either daemon's weight table.
+	accounts []account
+}
+
+// result is the outcome of a differ run.
+type result struct {
+	identity    []string
+	queries     int
+	divergences []divergence
+	failures    []string
+}
+
+// run compares the daemons' identities, then their answers round by round.
+func (d differ) run() (*result, error) {
+	res := &result{}
+	idA, errA := d.a.Identity()
+	idB, errB := d.b.Identity()
+	if errA != nil || errB != nil {
+		return nil, fmt.Errorf("identity: %v / %v", errA, errB)
+	}
+	if idA.GenesisHash != idB.GenesisHash {
+		res.identity = append(res.identity, fmt.Sprintf("genesis hash %s != %s", idA.GenesisHash, idB.GenesisHash))
+	}
+	if idA.WeightAlgorithmVersion != idB.WeightAlgorithmVersion {
+		res.identity = append(res.identity, fmt.Sprintf("algorithm version %q != %q", idA.WeightAlgorithmVersion, idB.WeightAlgorithmVersion))
+	}
+	if idA.WeightProtocolVersion != idB.WeightProtocolVersion {
+		res.identity = append(res.identity, fmt.Sprintf("protocol version %q != %q", idA.WeightProtocolVersion, idB.WeightProtocolVersion))
+	}
+
+	for i := 0; i < d.rounds; i++ {
+		voteRound := d.firstRound + basics.Round(i)
+		balanceRound := voteRound.SubSaturate(d.balanceLookback)
+
+		accounts := d.accounts
+		if len(accounts) == 0 {
+			var err error
+			accounts, err = d.tableAccounts(balanceRound)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res.compare(voteRound, fmt.Sprintf("total weight at balance round %d", balanceRound),
+			makeAnswer(d.a.TotalWeight(balanceRound, voteRound)),
+			makeAnswer(d.b.TotalWeight(balanceRound, voteRound)))
+		for _, acct := range accounts {
+			res.compare(voteRound, fmt.Sprintf("weight of %s at balance round %d", acct, balanceRound),
+				makeAnswer(d.a.Weight(balanceRound, acct.addr, acct.selectionID)),
+				makeAnswer(d.b.Weight(balanceRound, acct.addr, acct.selectionID)))
+		}
+	}
+	return res, nil
+}
+
+// tableAccounts returns the accounts in either daemon's weight table, sorted.
+func (d differ) tableAccounts(balanceRound basics.Round) ([]account, error) {
+	seen := make(map[account]bool)
+	for _, oracle := range []ledgercore.WeightOracle{d.a, d.b} {
+		tabler, ok := oracle.(weightoracle.WeightTabler)
+		if !ok {
+			return nil, fmt.Errorf("daemon cannot list its weights; pass -accounts")
+		}
+		table, err := tabler.WeightTable(balanceRound)
+		if err != nil {
+			return nil, fmt.Errorf("weight table at balance round %d: %w; pass -accounts", balanceRound, err)
+		}
+		for _, e := range table {
+			seen[account{addr: e.Addr, selectionID: e.SelectionID}] = true
+		}
+	}
+	accounts := make([]account, 0, len(seen))
+	for a := range seen {
+		accounts = append(accounts, a)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		if accounts[i].addr != accounts[j].addr {
+			return accounts[i].addr.String() < accounts[j].addr.String()
+		}
+		return hex.EncodeToString(accounts[i].selectionID[:]) < hex.EncodeToString(accounts[j].selectionID[:])
+	})
+	return accounts, nil
+}
+
+func (r *result) compare(voteRound basics.Round, query string, a, b answer) {
+	r.queries++
+	if a.failure != nil || b.failure != nil {
+		r.failures = append(r.failures, fmt.Sprintf("round %d, %s: %v / %v", voteRound, query, a.failure, b.failure))
+		return
+	}
+	if a != b {
+		r.divergences = append(r.divergences, divergence{voteRound: voteRound, query: query, a: a, b: b})
+	}
+}
+
+// ok reports whether the daemons agreed on everything they were asked.
+func (r *result) ok() bool {
+	return len(r.identity) == 0 && len(r.divergences) == 0 && len(r.failures) == 0
+}
+
+// print writes the result, listing at most maxLines divergences and failures
+// each.
+func (r *result) print(w io.Writer, maxLines int) {
+	for _, s := range r.identity {
+		fmt.Fprintf(w, "IDENTITY MISMATCH: %s\n", s)
+	}
+	for i, d := range r.divergences {
+		if i == maxLines {
+			fmt.Fprintf(w, "... %d more divergences\n", len(r.divergences)-maxLines)
+			break
+		}
+		fmt.Fprintf(w, "DIVERGED round %d, %s: A %s, B %s\n", d.voteRound, d.query, d.a, d.b)
+	}
+	for i, f := range r.failures {
+		if i == maxLines {
+			fmt.Fprintf(w, "... %d more failed queries\n", len(r.failures)-maxLines)
+			break
+		}
+		fmt.Fprintf(w, "FAILED %s\n", f)
+	}
+	fmt.Fprintf(w, "%d queries: %d divergences, %d failed queries\n", r.queries, len(r.divergences), len(r.failures))
+}
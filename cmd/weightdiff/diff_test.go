@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/node/weightoracle/weightoracletest"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testAccount(i byte) account {
+	var a account
+	a.addr[0] = i
+	a.selectionID[0] = i
+	return a
+}
+
+func TestParseAccounts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := testAccount(1)
+	input := "# accounts\n\n" + a.addr.String() + "\n" +
+		a.addr.String() + " " + strings.Repeat("ab", 32) + "\n"
+	accounts, err := parseAccounts(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	require.Equal(t, a.addr, accounts[0].addr)
+	require.True(t, accounts[0].selectionID.IsEmpty())
+	require.Equal(t, byte(0xab), accounts[1].selectionID[31])
+
+	_, err = parseAccounts(strings.NewReader("not-an-address\n"))
+	require.ErrorContains(t, err, "line 1")
+	_, err = parseAccounts(strings.NewReader(a.addr.String() + " abcd\n"))
+	require.ErrorContains(t, err, "32 hex-encoded bytes")
+}
+
+// TestDiffDaemons runs the differ against two HTTP daemons that disagree.
+func TestDiffDaemons(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	same, diff, missing := testAccount(1), testAccount(2), testAccount(3)
+	da := weightoracletest.NewDaemon(t).SetWeight(same.addr, 10).SetWeight(diff.addr, 20).
+		SetWeight(missing.addr, 30).SetTotal(100)
+	db := weightoracletest.NewDaemon(t).SetWeight(same.addr, 10).SetWeight(diff.addr, 21).
+		SetTotal(100)
+
+	// Clients cache weights, so each run gets new ones.
+	newDiffer := func() differ {
+		return differ{
+			a:               weightoracle.NewClientURL(da.URL()),
+			b:               weightoracle.NewClientURL(db.URL()),
+			firstRound:      400,
+			rounds:          2,
+			balanceLookback: 320,
+			accounts:        []account{same, diff, missing},
+		}
+	}
+	res, err := newDiffer().run()
+	require.NoError(t, err)
+	require.Empty(t, res.identity)
+	require.Empty(t, res.failures)
+	require.Equal(t, 8, res.queries)
+	require.Len(t, res.divergences, 4)
+	require.Equal(t, basics.Round(400), res.divergences[0].voteRound)
+	require.Contains(t, res.divergences[0].query, "balance round 80")
+	require.Equal(t, answer{value: 20}, res.divergences[0].a)
+	require.Equal(t, answer{value: 21}, res.divergences[0].b)
+	require.False(t, res.ok())
+
+	// A daemon error is an answer too: B saying not_found where A gives a
+	// weight diverges, while the same error from both does not.
+	db.SetError(mock.MethodWeight, "not_found", "unknown account")
+	res, err = newDiffer().run()
+	require.NoError(t, err)
+	require.Len(t, res.divergences, 6)
+	require.Equal(t, answer{code: "not_found"}, res.divergences[0].b)
+	da.SetError(mock.MethodWeight, "not_found", "unknown account")
+	res, err = newDiffer().run()
+	require.NoError(t, err)
+	require.True(t, res.ok())
+
+	da.SetVersions(ledgercore.ExpectedWeightProtocolVersion, "2.0")
+	res, err = newDiffer().run()
+	require.NoError(t, err)
+	require.Len(t, res.identity, 1)
+
+	var out bytes.Buffer
+	res.print(&out, 10)
+	require.Contains(t, out.String(), `IDENTITY MISMATCH: algorithm version "2.0" != "1.0"`)
+	require.Contains(t, out.String(), "8 queries: 0 divergences, 0 failed queries")
+}
+
+func TestDiffFailedQueries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	da := weightoracletest.NewDaemon(t)
+	db := weightoracletest.NewDaemon(t)
+	d := differ{
+		a:        weightoracle.NewClientURL(da.URL()),
+		b:        weightoracle.NewClientURL(db.URL()),
+		rounds:   1,
+		accounts: []account{testAccount(1)},
+	}
+	db.Close()
+	_, err := d.run()
+	require.ErrorContains(t, err, "identity")
+
+	// A query that fails outright is reported apart from divergences.
+	ob := mock.New()
+	ob.SetTotalWeight(1000000)
+	d.b = &failingWeights{Oracle: ob}
+	res, err := d.run()
+	require.NoError(t, err)
+	require.Empty(t, res.divergences)
+	require.Len(t, res.failures, 1)
+	require.False(t, res.ok())
+}
+
+type failingWeights struct {
+	*mock.Oracle
+}
+
+func (f *failingWeights) Weight(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
+	return 0, errors.New("timeout")
+}
+
+// tableOracle is a mock oracle that also lists its weights.
+type tableOracle struct {
+	*mock.Oracle
+	table []ledgercore.WeightTableEntry
+}
+
+func (o *tableOracle) WeightTable(basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	return o.table, nil
+}
+
+func TestDiffTableAccounts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a1, a2 := testAccount(1), testAccount(2)
+	oa := &tableOracle{Oracle: mock.New(), table: []ledgercore.WeightTableEntry{{Addr: a1.addr, SelectionID: a1.selectionID, Weight: 5}}}
+	ob := &tableOracle{Oracle: mock.New(), table: []ledgercore.WeightTableEntry{{Addr: a2.addr, SelectionID: a2.selectionID, Weight: 5}}}
+	oa.SetWeight(a1.addr, 5)
+	ob.SetWeight(a2.addr, 5)
+
+	d := differ{a: oa, b: ob, firstRound: 10, rounds: 1}
+	res, err := d.run()
+	require.NoError(t, err)
+	// Each account is in only one table, so the other daemon answers with
+	// its default weight of zero.
+	require.Equal(t, 3, res.queries)
+	require.Len(t, res.divergences, 2)
+
+	d.a = mock.New()
+	_, err = d.run()
+	require.ErrorContains(t, err, "pass -accounts")
+}
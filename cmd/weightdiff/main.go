@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// weightdiff sends the same weight queries to two weight daemons and reports
+// every answer on which they differ. Nodes whose daemons disagree select
+// different committees, so operators run it before moving a network from one
+// daemon implementation to another.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+var urlA = flag.String("a", "http://127.0.0.1:9876", "Base URL of the first weight daemon")
+var urlB = flag.String("b", "http://127.0.0.1:9877", "Base URL of the second weight daemon")
+var authTokenA = flag.String("auth-token-a", "", "Bearer token reference for the first daemon, as \"file:<path>\" or \"env:<NAME>\"")
+var authTokenB = flag.String("auth-token-b", "", "Bearer token reference for the second daemon, as \"file:<path>\" or \"env:<NAME>\"")
+var firstRound = flag.Uint64("first-round", 1000, "First vote round to query")
+var rounds = flag.Int("rounds", 10, "Number of consecutive vote rounds to query")
+var balanceLookback = flag.Uint64("balance-lookback", 320, "Distance between a vote round and its balance round")
+var accountsFile = flag.String("accounts", "", "File listing the accounts to query, one address and optional hex selection ID per line; by default the accounts in either daemon's weight table are queried")
+var queryTimeout = flag.Duration("timeout", weightoracle.DefaultQueryTimeout, "Timeout for a single query")
+var maxLines = flag.Int("max-lines", 50, "Maximum number of divergences and of failed queries to list")
+
+func main() {
+	flag.Parse()
+
+	if *rounds <= 0 {
+		fail("-rounds must be positive")
+	}
+	d := differ{
+		a:               client("a", *urlA, *authTokenA),
+		b:               client("b", *urlB, *authTokenB),
+		firstRound:      basics.Round(*firstRound),
+		rounds:          *rounds,
+		balanceLookback: basics.Round(*balanceLookback),
+	}
+	if *accountsFile != "" {
+		f, err := os.Open(*accountsFile)
+		if err != nil {
+			fail("%v", err)
+		}
+		d.accounts, err = parseAccounts(f)
+		f.Close()
+		if err != nil {
+			fail("%s: %v", *accountsFile, err)
+		}
+		if len(d.accounts) == 0 {
+			fail("%s lists no accounts", *accountsFile)
+		}
+	}
+
+	start := time.Now()
+	res, err := d.run()
+	if err != nil {
+		fail("%v", err)
+	}
+	res.print(os.Stdout, *maxLines)
+	fmt.Printf("Compared %s (A) with %s (B) over vote rounds %d-%d in %v\n",
+		*urlA, *urlB, *firstRound, *firstRound+uint64(*rounds)-1, time.Since(start).Round(time.Millisecond))
+	if !res.ok() {
+		os.Exit(1)
+	}
+}
+
+func client(name, rawURL, authTokenRef string) *weightoracle.Client {
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		fail("invalid -%s: %v", name, err)
+	}
+	cfg := weightoracle.DefaultClientConfig()
+	cfg.QueryTimeout = *queryTimeout
+	cfg.AuthToken, err = config.LoadSecret(authTokenRef, ".")
+	if err != nil {
+		fail("invalid -auth-token-%s: %v", name, err)
+	}
+	return weightoracle.NewClientWithConfig(baseURL, cfg)
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// workload describes the daemon traffic of one node. Each round the node
+// looks up the weight of every vote it receives; lookups that miss its cache
+// reach the daemon in batches, and it asks once for the round's total weight.
+type workload struct {
+	rounds          int
+	roundTime       time.Duration
+	firstRound      uint64
+	balanceLookback uint64
+	accounts        int
+	lookups         int
+	missRatio       float64
+	batchSize       int
+	seed            int64
+}
+
+func (w workload) validate() error {
+	switch {
+	case w.rounds <= 0:
+		return fmt.Errorf("-rounds must be positive")
+	case w.accounts <= 0:
+		return fmt.Errorf("-accounts must be positive")
+	case w.lookups < 0:
+		return fmt.Errorf("-lookups must not be negative")
+	case w.missRatio < 0 || w.missRatio > 1:
+		return fmt.Errorf("-miss-ratio must be between 0 and 1")
+	case w.batchSize <= 0:
+		return fmt.Errorf("-batch must be positive")
+	case w.misses() > w.accounts:
+		// A node caches each account's weight for the round, so it never
+		// asks the daemon about the same account twice in one round.
+		return fmt.Errorf("%d cache misses per round exceed the %d accounts; lower -lookups or -miss-ratio, or raise -accounts",
+			w.misses(), w.accounts)
+	}
+	return nil
+}
+
+// misses is the number of weight queries that reach the daemon each round.
+func (w workload) misses() int {
+	return int(math.Round(float64(w.lookups) * w.missRatio))
+}
+
+// run sends the workload to oracle and collects the results.
+func (w workload) run(oracle ledgercore.WeightOracle) *report {
+	rng := rand.New(rand.NewSource(w.seed))
+	addrs := make([]basics.Address, w.accounts)
+	selectionIDs := make([]crypto.VRFVerifier, w.accounts)
+	for i := range addrs {
+		rng.Read(addrs[i][:])
+		rng.Read(selectionIDs[i][:])
+	}
+
+	r := newReport()
+	start := time.Now()
+	for i := 0; i < w.rounds; i++ {
+		if wait := time.Until(start.Add(time.Duration(i) * w.roundTime)); wait > 0 {
+			time.Sleep(wait)
+		} else if i > 0 && w.roundTime > 0 {
+			r.overruns++
+		}
+
+		voteRound := basics.Round(w.firstRound + uint64(i))
+		balanceRound := voteRound.SubSaturate(basics.Round(w.balanceLookback))
+		roundStart := time.Now()
+		r.time(endpointTotalWeight, func() error {
+			_, err := oracle.TotalWeight(balanceRound, voteRound)
+			return err
+		})
+
+		// Which accounts miss the cache varies from round to round.
+		missed := rng.Perm(w.accounts)[:w.misses()]
+		for len(missed) > 0 {
+			batch := missed[:min(w.batchSize, len(missed))]
+			missed = missed[len(batch):]
+			var wg sync.WaitGroup
+			for _, idx := range batch {
+				wg.Add(1)
+				go func(idx int) {
+					defer wg.Done()
+					r.time(endpointWeight, func() error {
+						_, err := oracle.Weight(balanceRound, addrs[idx], selectionIDs[idx])
+						return err
+					})
+				}(idx)
+			}
+			wg.Wait()
+		}
+		r.roundDurations = append(r.roundDurations, time.Since(roundStart))
+	}
+	r.elapsed = time.Since(start)
+	return r
+}
+
+const (
+	endpointWeight      = "/weight"
+	endpointTotalWeight = "/total_weight"
+)
+
+// report holds the outcome of a workload run.
+type report struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]map[string]int
+
+	roundDurations []time.Duration
+	overruns       int
+	elapsed        time.Duration
+}
+
+func newReport() *report {
+	return &report{
+		latencies: make(map[string][]time.Duration),
+		errors:    make(map[string]map[string]int),
+	}
+}
+
+// time runs query and records its latency, and its error if any, for endpoint.
+func (r *report) time(endpoint string, query func() error) {
+	begin := time.Now()
+	err := query()
+	latency := time.Since(begin)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latencies[endpoint] = append(r.latencies[endpoint], latency)
+	if err != nil {
+		if r.errors[endpoint] == nil {
+			r.errors[endpoint] = make(map[string]int)
+		}
+		r.errors[endpoint][errorKind(err)]++
+	}
+}
+
+// errorKind groups query errors into a few kinds worth reporting separately.
+func errorKind(err error) string {
+	var de *ledgercore.DaemonError
+	var be *weightoracle.WeightBoundsError
+	switch {
+	case errors.As(err, &de):
+		return "daemon error " + de.Code
+	case errors.Is(err, weightoracle.ErrUnauthorized):
+		return "unauthorized"
+	case errors.As(err, &be):
+		return "out of bounds"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	}
+	return "transport"
+}
+
+// percentile returns the p-th percentile of sorted by the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+func (r *report) print(out io.Writer) {
+	fmt.Fprintf(out, "%-14s %8s %8s %10s %10s %10s %10s %10s\n", "endpoint", "queries", "errors", "p50", "p90", "p99", "p99.9", "max")
+	for _, endpoint := range []string{endpointWeight, endpointTotalWeight} {
+		lat := slices.Clone(r.latencies[endpoint])
+		slices.Sort(lat)
+		failed := 0
+		for _, n := range r.errors[endpoint] {
+			failed += n
+		}
+		fmt.Fprintf(out, "%-14s %8d %8d %10v %10v %10v %10v %10v\n", endpoint, len(lat), failed,
+			percentile(lat, 50).Round(time.Microsecond), percentile(lat, 90).Round(time.Microsecond),
+			percentile(lat, 99).Round(time.Microsecond), percentile(lat, 99.9).Round(time.Microsecond),
+			percentile(lat, 100).Round(time.Microsecond))
+	}
+
+	fmt.Fprintln(out)
+	for _, endpoint := range []string{endpointWeight, endpointTotalWeight} {
+		queries := len(r.latencies[endpoint])
+		kinds := make([]string, 0, len(r.errors[endpoint]))
+		for kind := range r.errors[endpoint] {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+		for _, kind := range kinds {
+			n := r.errors[endpoint][kind]
+			fmt.Fprintf(out, "%s %s: %d (%.2f%%)\n", endpoint, kind, n, 100*float64(n)/float64(queries))
+		}
+	}
+
+	rounds := slices.Clone(r.roundDurations)
+	slices.Sort(rounds)
+	var total int
+	for _, lat := range r.latencies {
+		total += len(lat)
+	}
+	fmt.Fprintf(out, "Round burst duration: p50 %v, p99 %v, max %v\n",
+		percentile(rounds, 50).Round(time.Microsecond), percentile(rounds, 99).Round(time.Microsecond),
+		percentile(rounds, 100).Round(time.Microsecond))
+	fmt.Fprintf(out, "Rounds that started late: %d of %d\n", r.overruns, len(rounds))
+	fmt.Fprintf(out, "Throughput: %.1f queries/s over %v\n", float64(total)/r.elapsed.Seconds(), r.elapsed.Round(time.Millisecond))
+}
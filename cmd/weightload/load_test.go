@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWorkloadValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := workload{rounds: 1, accounts: 10, lookups: 30, missRatio: 0.3, batchSize: 4}
+	require.NoError(t, w.validate())
+	require.Equal(t, 9, w.misses())
+
+	w.missRatio = 0.5
+	require.ErrorContains(t, w.validate(), "15 cache misses per round exceed the 10 accounts")
+
+	w.missRatio = 1.5
+	require.ErrorContains(t, w.validate(), "-miss-ratio")
+}
+
+// TestWorkloadRun runs a small workload against an in-process daemon and
+// checks the queries it sent and the report.
+func TestWorkloadRun(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(10)
+	oracle.SetTotalWeight(1000)
+	server, err := weightoracle.StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	w := workload{rounds: 3, firstRound: 400, balanceLookback: 320, accounts: 20, lookups: 50, missRatio: 0.2, batchSize: 4, seed: 7}
+	require.NoError(t, w.validate())
+	r := w.run(weightoracle.NewClientWithConfig(server.URL(), weightoracle.DefaultClientConfig()))
+
+	require.Equal(t, 3, oracle.CallCount(mock.MethodTotalWeight))
+	require.Equal(t, 30, oracle.CallCount(mock.MethodWeight))
+	require.Len(t, r.latencies[endpointWeight], 30)
+	require.Len(t, r.latencies[endpointTotalWeight], 3)
+	require.Empty(t, r.errors)
+	require.Len(t, r.roundDurations, 3)
+
+	// Each round asks about distinct accounts at its own balance round.
+	seen := make(map[mock.Call]bool)
+	for _, c := range oracle.Calls() {
+		if c.Method == mock.MethodWeight {
+			require.False(t, seen[c], "duplicate query %+v", c)
+			seen[c] = true
+			require.Contains(t, []uint64{80, 81, 82}, uint64(c.BalanceRound))
+		}
+	}
+
+	var out bytes.Buffer
+	r.print(&out)
+	require.Contains(t, out.String(), "/weight")
+	require.Contains(t, out.String(), "Rounds that started late: 0 of 3")
+}
+
+func TestReportErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	r := newReport()
+	r.time(endpointWeight, func() error { return nil })
+	r.time(endpointWeight, func() error { return &ledgercore.DaemonError{Code: "not_found", Msg: "unknown"} })
+	r.time(endpointWeight, func() error { return weightoracle.ErrUnauthorized })
+	r.time(endpointWeight, func() error { return errors.New("connection refused") })
+	require.Equal(t, map[string]int{"daemon error not_found": 1, "unauthorized": 1, "transport": 1}, r.errors[endpointWeight])
+
+	r.elapsed = time.Second
+	var out bytes.Buffer
+	r.print(&out)
+	require.Contains(t, out.String(), "/weight daemon error not_found: 1 (25.00%)")
+}
+
+func TestPercentile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i))
+	}
+	require.Equal(t, time.Duration(50), percentile(sorted, 50))
+	require.Equal(t, time.Duration(99), percentile(sorted, 99))
+	require.Equal(t, time.Duration(100), percentile(sorted, 100))
+	require.Equal(t, time.Duration(1), percentile(sorted, 0))
+	require.Zero(t, percentile(nil, 50))
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// weightload replays the weight daemon query pattern of a node against a
+// daemon and reports latency percentiles and error rates, so that daemon
+// operators can size a deployment before joining a network.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+var daemonURL = flag.String("url", "http://127.0.0.1:9876", "Weight daemon base URL")
+var authTokenRef = flag.String("auth-token", "", "Bearer token reference, as \"file:<path>\" or \"env:<NAME>\"")
+var rounds = flag.Int("rounds", 100, "Number of rounds to simulate")
+var roundTime = flag.Duration("round-time", 3*time.Second, "Time between the start of consecutive rounds; 0 runs rounds back to back")
+var firstRound = flag.Uint64("first-round", 1000, "First vote round to query")
+var balanceLookback = flag.Uint64("balance-lookback", 320, "Distance between a vote round and its balance round")
+var accounts = flag.Int("accounts", 100, "Number of online accounts whose votes a node sees")
+var lookups = flag.Int("lookups", 300, "Weight lookups a node makes per round, before its cache")
+var missRatio = flag.Float64("miss-ratio", 0.3, "Fraction of weight lookups that miss the node's cache and reach the daemon")
+var batchSize = flag.Int("batch", 16, "Weight queries a node sends to the daemon at once; a round's misses are sent in consecutive batches")
+var queryTimeout = flag.Duration("timeout", weightoracle.DefaultQueryTimeout, "Timeout for a single query")
+var seed = flag.Int64("seed", 1, "Seed for the simulated account addresses")
+
+func main() {
+	flag.Parse()
+
+	w := workload{
+		rounds:          *rounds,
+		roundTime:       *roundTime,
+		firstRound:      *firstRound,
+		balanceLookback: *balanceLookback,
+		accounts:        *accounts,
+		lookups:         *lookups,
+		missRatio:       *missRatio,
+		batchSize:       *batchSize,
+		seed:            *seed,
+	}
+	if err := w.validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	baseURL, err := url.Parse(*daemonURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -url: %v\n", err)
+		os.Exit(1)
+	}
+	cfg := weightoracle.DefaultClientConfig()
+	cfg.QueryTimeout = *queryTimeout
+	cfg.AuthToken, err = config.LoadSecret(*authTokenRef, ".")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -auth-token: %v\n", err)
+		os.Exit(1)
+	}
+	client := weightoracle.NewClientWithConfig(baseURL, cfg)
+
+	id, err := client.Identity()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "weight daemon at %s did not answer /identity: %v\n", baseURL, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Daemon %s: genesis %s, protocol %s, algorithm %s\n", baseURL, id.GenesisHash, id.WeightProtocolVersion, id.WeightAlgorithmVersion)
+	fmt.Printf("Simulating %d rounds: %d weight queries in batches of %d and 1 total weight query per round\n\n",
+		w.rounds, w.misses(), w.batchSize)
+
+	report := w.run(client)
+	report.print(os.Stdout)
+}
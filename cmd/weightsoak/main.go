@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// weightsoak queries a weight daemon the way a node does for as long as it is
+// left running, and periodically reports latency drift, the probe's own
+// memory use and whether the daemon's answers for fixed rounds ever change.
+// It is meant for burn-in of new daemon releases before they go to production.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+var daemonURL = flag.String("url", "http://127.0.0.1:9876", "Weight daemon base URL")
+var authTokenRef = flag.String("auth-token", "", "Bearer token reference, as \"file:<path>\" or \"env:<NAME>\"")
+var duration = flag.Duration("duration", 0, "How long to run; 0 runs until interrupted")
+var roundTime = flag.Duration("round-time", 3*time.Second, "Time between simulated rounds")
+var reportInterval = flag.Duration("report-interval", 10*time.Minute, "Time between report lines")
+var stabilityInterval = flag.Duration("stability-interval", time.Minute, "Time between re-queries of the fixed rounds")
+var firstRound = flag.Uint64("first-round", 1000, "First vote round to query")
+var balanceLookback = flag.Uint64("balance-lookback", 320, "Distance between a vote round and its balance round")
+var accounts = flag.Int("accounts", 100, "Number of online accounts")
+var voters = flag.Int("voters", 30, "Accounts whose votes a node sees each round")
+var steps = flag.Int("steps", 3, "Votes per voter and round; all but the first are answered from the client cache")
+var batchSize = flag.Int("batch", 16, "Voters whose weights are looked up at once")
+var stabilityRounds = flag.Int("stability-rounds", 3, "Number of fixed rounds, starting at -first-round, whose answers must never change")
+var stabilityAccounts = flag.Int("stability-accounts", 10, "Accounts whose weights are checked at each fixed round")
+var queryTimeout = flag.Duration("timeout", weightoracle.DefaultQueryTimeout, "Timeout for a single query")
+var seed = flag.Int64("seed", 1, "Seed for the simulated account addresses")
+
+func main() {
+	flag.Parse()
+
+	cfg := soakConfig{
+		roundTime:         *roundTime,
+		reportInterval:    *reportInterval,
+		stabilityInterval: *stabilityInterval,
+		firstRound:        *firstRound,
+		balanceLookback:   *balanceLookback,
+		accounts:          *accounts,
+		voters:            *voters,
+		steps:             *steps,
+		batchSize:         *batchSize,
+		stabilityRounds:   *stabilityRounds,
+		stabilityAccounts: *stabilityAccounts,
+		seed:              *seed,
+	}
+	if err := cfg.validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	baseURL, err := url.Parse(*daemonURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -url: %v\n", err)
+		os.Exit(1)
+	}
+	clientCfg := weightoracle.DefaultClientConfig()
+	clientCfg.QueryTimeout = *queryTimeout
+	clientCfg.AuthToken, err = config.LoadSecret(*authTokenRef, ".")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -auth-token: %v\n", err)
+		os.Exit(1)
+	}
+	newClient := func() ledgercore.WeightOracle {
+		return weightoracle.NewClientWithConfig(baseURL, clientCfg)
+	}
+
+	id, err := newClient().Identity()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "weight daemon at %s did not answer /identity: %v\n", baseURL, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Daemon %s: genesis %s, protocol %s, algorithm %s\n", baseURL, id.GenesisHash, id.WeightProtocolVersion, id.WeightAlgorithmVersion)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if *duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *duration)
+		defer cancel()
+	}
+
+	p := newProber(cfg, newClient, os.Stdout)
+	p.run(ctx)
+	if p.unstable > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// soakConfig describes the traffic of the simulated node and how often the
+// probe reports and checks answer stability.
+type soakConfig struct {
+	roundTime         time.Duration
+	reportInterval    time.Duration
+	stabilityInterval time.Duration
+	firstRound        uint64
+	balanceLookback   uint64
+	accounts          int
+	voters            int
+	steps             int
+	batchSize         int
+	stabilityRounds   int
+	stabilityAccounts int
+	seed              int64
+}
+
+func (c soakConfig) validate() error {
+	switch {
+	case c.roundTime <= 0 || c.reportInterval <= 0 || c.stabilityInterval <= 0:
+		return fmt.Errorf("-round-time, -report-interval and -stability-interval must be positive")
+	case c.accounts <= 0:
+		return fmt.Errorf("-accounts must be positive")
+	case c.voters < 0 || c.voters > c.accounts:
+		return fmt.Errorf("-voters must be between 0 and -accounts")
+	case c.steps <= 0 || c.batchSize <= 0:
+		return fmt.Errorf("-steps and -batch must be positive")
+	case c.stabilityRounds < 0 || c.stabilityAccounts < 0 || c.stabilityAccounts > c.accounts:
+		return fmt.Errorf("-stability-rounds must not be negative and -stability-accounts must be between 0 and -accounts")
+	}
+	return nil
+}
+
+// balanceRound returns the balance round whose weights vote round rnd uses.
+func (c soakConfig) balanceRound(rnd basics.Round) basics.Round {
+	return rnd.SubSaturate(basics.Round(c.balanceLookback))
+}
+
+// answerKey identifies a query whose answer must never change: a weight when
+// addr is set, a total weight otherwise.
+type answerKey struct {
+	balanceRound basics.Round
+	voteRound    basics.Round
+	addr         basics.Address
+}
+
+// window collects the daemon latencies and errors of one report interval.
+type window struct {
+	mu        sync.Mutex
+	latencies []time.Duration
+	errors    int
+}
+
+func (w *window) record(latency time.Duration, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.latencies = append(w.latencies, latency)
+	if err != nil {
+		w.errors++
+	}
+}
+
+// take returns the collected latencies, sorted, and error count, and starts a
+// new window.
+func (w *window) take() ([]time.Duration, int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	lat, errs := w.latencies, w.errors
+	w.latencies, w.errors = nil, 0
+	slices.Sort(lat)
+	return lat, errs
+}
+
+// prober runs the soak: a long-lived client queried like a node, and fresh
+// clients that re-query the fixed rounds.
+type prober struct {
+	cfg       soakConfig
+	newClient func() ledgercore.WeightOracle
+	out       io.Writer
+
+	addrs        []basics.Address
+	selectionIDs []crypto.VRFVerifier
+	rng          *rand.Rand
+	window       window
+
+	// baseline is the first report with any queries, which later reports
+	// are compared against.
+	baseline struct {
+		set      bool
+		p50, p99 time.Duration
+		heap     uint64
+	}
+
+	answers     map[answerKey]uint64
+	unstable    int
+	checkErrors int
+}
+
+func newProber(cfg soakConfig, newClient func() ledgercore.WeightOracle, out io.Writer) *prober {
+	p := &prober{
+		cfg:          cfg,
+		newClient:    newClient,
+		out:          out,
+		addrs:        make([]basics.Address, cfg.accounts),
+		selectionIDs: make([]crypto.VRFVerifier, cfg.accounts),
+		rng:          rand.New(rand.NewSource(cfg.seed)),
+		answers:      make(map[answerKey]uint64),
+	}
+	for i := range p.addrs {
+		p.rng.Read(p.addrs[i][:])
+		p.rng.Read(p.selectionIDs[i][:])
+	}
+	return p
+}
+
+// run probes until ctx is done, then prints a final report.
+func (p *prober) run(ctx context.Context) {
+	client := p.newClient()
+	rounds := time.NewTicker(p.cfg.roundTime)
+	defer rounds.Stop()
+	reports := time.NewTicker(p.cfg.reportInterval)
+	defer reports.Stop()
+	checks := time.NewTicker(p.cfg.stabilityInterval)
+	defer checks.Stop()
+
+	p.checkStability()
+	voteRound := basics.Round(p.cfg.firstRound)
+	for {
+		select {
+		case <-ctx.Done():
+			p.report()
+			return
+		case <-rounds.C:
+			p.runRound(client, voteRound)
+			voteRound++
+		case <-reports.C:
+			p.report()
+		case <-checks.C:
+			p.checkStability()
+		}
+	}
+}
+
+// runRound looks up the weights of the round's voters the way a node does:
+// each voter's first vote reaches the daemon, the rest hit the client cache.
+func (p *prober) runRound(client ledgercore.WeightOracle, voteRound basics.Round) {
+	balanceRound := p.cfg.balanceRound(voteRound)
+	begin := time.Now()
+	_, err := client.TotalWeight(balanceRound, voteRound)
+	p.window.record(time.Since(begin), err)
+
+	voters := p.rng.Perm(p.cfg.accounts)[:p.cfg.voters]
+	for len(voters) > 0 {
+		batch := voters[:min(p.cfg.batchSize, len(voters))]
+		voters = voters[len(batch):]
+		var wg sync.WaitGroup
+		for _, idx := range batch {
+			wg.Add(1)
+			go func(idx int) {
+				defer wg.Done()
+				for step := 0; step < p.cfg.steps; step++ {
+					begin := time.Now()
+					_, err := client.Weight(balanceRound, p.addrs[idx], p.selectionIDs[idx])
+					if step == 0 {
+						p.window.record(time.Since(begin), err)
+					}
+				}
+			}(idx)
+		}
+		wg.Wait()
+	}
+}
+
+// checkStability re-queries the fixed rounds with an uncached client and
+// reports any answer that differs from the first one seen.
+func (p *prober) checkStability() {
+	client := p.newClient()
+	check := func(key answerKey, value uint64, err error) {
+		if err != nil {
+			p.checkErrors++
+			return
+		}
+		first, seen := p.answers[key]
+		if !seen {
+			p.answers[key] = value
+			return
+		}
+		if value == first {
+			return
+		}
+		p.unstable++
+		if key.addr.IsZero() {
+			fmt.Fprintf(p.out, "%s UNSTABLE: total weight at balance round %d for vote round %d changed from %d to %d\n",
+				time.Now().UTC().Format(time.RFC3339), key.balanceRound, key.voteRound, first, value)
+		} else {
+			fmt.Fprintf(p.out, "%s UNSTABLE: weight of %s at balance round %d changed from %d to %d\n",
+				time.Now().UTC().Format(time.RFC3339), key.addr, key.balanceRound, first, value)
+		}
+	}
+
+	for i := 0; i < p.cfg.stabilityRounds; i++ {
+		voteRound := basics.Round(p.cfg.firstRound + uint64(i))
+		balanceRound := p.cfg.balanceRound(voteRound)
+		total, err := client.TotalWeight(balanceRound, voteRound)
+		check(answerKey{balanceRound: balanceRound, voteRound: voteRound}, total, err)
+		for idx := 0; idx < p.cfg.stabilityAccounts; idx++ {
+			w, err := client.Weight(balanceRound, p.addrs[idx], p.selectionIDs[idx])
+			check(answerKey{balanceRound: balanceRound, addr: p.addrs[idx]}, w, err)
+		}
+	}
+}
+
+// report prints one line for the current window and starts a new one.
+func (p *prober) report() {
+	lat, errs := p.window.take()
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	line := fmt.Sprintf("%s queries=%d errors=%d", time.Now().UTC().Format(time.RFC3339), len(lat), errs)
+	if len(lat) > 0 {
+		p50, p99 := percentile(lat, 50), percentile(lat, 99)
+		line += fmt.Sprintf(" (%.2f%%) p50=%v p99=%v max=%v", 100*float64(errs)/float64(len(lat)),
+			p50.Round(time.Microsecond), p99.Round(time.Microsecond), lat[len(lat)-1].Round(time.Microsecond))
+		if !p.baseline.set {
+			p.baseline.set = true
+			p.baseline.p50, p.baseline.p99, p.baseline.heap = p50, p99, mem.HeapInuse
+		}
+		line += fmt.Sprintf(" drift p50=x%.2f p99=x%.2f", ratio(p50, p.baseline.p50), ratio(p99, p.baseline.p99))
+	}
+	line += fmt.Sprintf(" heap=%.1fMiB", float64(mem.HeapInuse)/(1<<20))
+	if p.baseline.set {
+		line += fmt.Sprintf(" (%+.1fMiB)", (float64(mem.HeapInuse)-float64(p.baseline.heap))/(1<<20))
+	}
+	line += fmt.Sprintf(" goroutines=%d stability-errors=%d unstable=%d", runtime.NumGoroutine(), p.checkErrors, p.unstable)
+	fmt.Fprintln(p.out, line)
+}
+
+// percentile returns the p-th percentile of sorted by the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+func ratio(d, base time.Duration) float64 {
+	if base <= 0 {
+		return 1
+	}
+	return float64(d) / float64(base)
+}
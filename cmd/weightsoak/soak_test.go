@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testSoakConfig() soakConfig {
+	return soakConfig{
+		roundTime:         10 * time.Millisecond,
+		reportInterval:    100 * time.Millisecond,
+		stabilityInterval: 20 * time.Millisecond,
+		firstRound:        400,
+		balanceLookback:   320,
+		accounts:          20,
+		voters:            8,
+		steps:             3,
+		batchSize:         4,
+		stabilityRounds:   2,
+		stabilityAccounts: 3,
+		seed:              1,
+	}
+}
+
+// startSoakDaemon starts an in-process daemon serving oracle and returns a
+// constructor for clients of it.
+func startSoakDaemon(t *testing.T, oracle ledgercore.WeightOracle) func() ledgercore.WeightOracle {
+	server, err := weightoracle.StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	return func() ledgercore.WeightOracle {
+		return weightoracle.NewClientWithConfig(server.URL(), weightoracle.DefaultClientConfig())
+	}
+}
+
+func TestSoakStable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(10)
+	oracle.SetTotalWeight(200)
+	cfg := testSoakConfig()
+	require.NoError(t, cfg.validate())
+
+	var out bytes.Buffer
+	p := newProber(cfg, startSoakDaemon(t, oracle), &out)
+	ctx, cancel := context.WithTimeout(context.Background(), 350*time.Millisecond)
+	defer cancel()
+	p.run(ctx)
+
+	require.Zero(t, p.unstable)
+	require.Zero(t, p.checkErrors)
+	require.Len(t, p.answers, cfg.stabilityRounds*(1+cfg.stabilityAccounts))
+	require.NotContains(t, out.String(), "UNSTABLE")
+	require.Contains(t, out.String(), "drift p50=x")
+	require.GreaterOrEqual(t, strings.Count(out.String(), "\n"), 3)
+
+	// Only the first vote of each voter reaches the daemon; the other steps
+	// are answered from the client cache.
+	queries := oracle.CallCount(mock.MethodTotalWeight)
+	require.Less(t, oracle.CallCount(mock.MethodWeight), queries*cfg.voters*cfg.steps)
+}
+
+func TestSoakDetectsChangedAnswers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(10)
+	oracle.SetTotalWeight(200)
+	cfg := testSoakConfig()
+	cfg.stabilityRounds = 1
+
+	var out bytes.Buffer
+	p := newProber(cfg, startSoakDaemon(t, oracle), &out)
+	p.checkStability()
+	require.Zero(t, p.unstable)
+
+	oracle.SetWeight(p.addrs[1], 11)
+	oracle.SetTotalWeight(201)
+	p.checkStability()
+	require.Equal(t, 2, p.unstable)
+	require.Contains(t, out.String(), "UNSTABLE: weight of "+p.addrs[1].String()+" at balance round 80 changed from 10 to 11")
+	require.Contains(t, out.String(), "UNSTABLE: total weight at balance round 80 for vote round 400 changed from 200 to 201")
+
+	// Errors are counted but are not instability; the changed total weight
+	// is reported again.
+	oracle.SetWeightError(&ledgercore.DaemonError{Code: "internal", Msg: "down"})
+	p.checkStability()
+	require.Equal(t, 3, p.unstable)
+	require.Equal(t, cfg.stabilityAccounts, p.checkErrors)
+}
@@ -128,6 +128,11 @@ func loadConfigFromFile(configFile string) (c Local, migrations []MigrationResul
 	// If a config file does not have version, it is assumed to be zero.
 	// All fields listed in migrate() might be changed if an actual value matches to default value from a previous version.
 	c, migrations, err = migrate(c)
+	if err != nil {
+		return
+	}
+	c, oracleMigrations := migrateWeightOraclePort(c)
+	migrations = append(migrations, oracleMigrations...)
 	return
 }
 
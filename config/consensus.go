@@ -18,6 +18,7 @@ package config
 
 import (
 	"maps"
+	"slices"
 	"time"
 
 	"github.com/algorand/go-algorand/config/bounds"
@@ -580,6 +581,32 @@ type ConsensusParams struct {
 	// specify the current app. This parameter can be removed and assumed true
 	// after the first consensus release in which it is set true.
 	AllowZeroLocalAppRef bool
+
+	// ExternalWeightAttestation requires that every non-zero external weight
+	// used for sortition be accompanied by a weight daemon signature over
+	// (balance round, address, selection ID, weight, genesis hash). Credentials whose membership lacks a
+	// valid attestation are rejected.
+	ExternalWeightAttestation bool
+
+	// ExternalWeightAttestationKey is the ed25519 public key of the weight
+	// daemon whose attestations are trusted when ExternalWeightAttestation is
+	// set. Attestations signed by any other key are rejected, and all of them
+	// are while it is zero.
+	ExternalWeightAttestationKey [32]byte
+
+	// EnableExternalWeightOracle selects committee members and evaluates
+	// absenteeism by the weights reported by the external weight daemon
+	// rather than by online stake. A network that starts out on stake can
+	// switch over at an upgrade boundary by enabling it in a later version.
+	EnableExternalWeightOracle bool
+
+	// WeightAlgorithmVersions lists the weight algorithm versions that weight
+	// daemons may run in this protocol version besides the one the node
+	// expects. Listing both sides of an algorithm change in the versions
+	// around it lets operators upgrade their daemons while the network
+	// transitions, and the next version ends the transition by dropping the
+	// old algorithm.
+	WeightAlgorithmVersions []string
 }
 
 // ProposerPayoutRules puts several related consensus parameters in one place. The same
@@ -774,6 +801,7 @@ func (cp ConsensusProtocols) DeepCopy() ConsensusProtocols {
 	for consensusVersion, consensusParams := range cp {
 		// recreate the ApprovedUpgrades map since we don't want to modify the original one.
 		consensusParams.ApprovedUpgrades = maps.Clone(consensusParams.ApprovedUpgrades)
+		consensusParams.WeightAlgorithmVersions = slices.Clone(consensusParams.WeightAlgorithmVersions)
 		staticConsensus[consensusVersion] = consensusParams
 	}
 	return staticConsensus
@@ -859,6 +887,8 @@ func initConsensusProtocols() {
 		MaxBalLookback: 320,
 
 		MaxTxGroupSize: 1,
+
+		EnableExternalWeightOracle: true,
 	}
 
 	v7.ApprovedUpgrades = map[protocol.ConsensusVersion]uint64{}
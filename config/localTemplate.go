@@ -17,9 +17,17 @@
 package config
 
 import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -671,7 +679,343 @@ type Local struct {
 	// ExternalWeightOraclePort specifies the TCP port for connecting to the external weight daemon.
 	// A value of 0 means no external weight daemon is configured, which will cause startup
 	// failure if the node attempts to use external weights for consensus.
+	//
+	// Deprecated: use ExternalWeightOracleURL. A non-zero port is treated as the URL http://127.0.0.1:<port>.
 	ExternalWeightOraclePort uint16 `version[39]:"0"`
+
+	// ExternalWeightOracleURL is the base URL of the external weight daemon, e.g. "http://127.0.0.1:9876"
+	// or "https://oracle.example.com/weights". The scheme must be http or https; the path, if any, is
+	// prefixed to every endpoint. It replaces ExternalWeightOraclePort, and only one of the two may be set.
+	// A daemon on the same host may instead listen on a unix domain socket, named by its absolute path
+	// as in "unix:///run/weightd/weightd.sock", and is then spoken to over HTTP on that socket.
+	// Any local user can connect to a loopback port, or bind it while the daemon is down, so use a
+	// socket, or ExternalWeightOracleAuthToken or ExternalWeightOracleSigningKey, on hosts shared with
+	// other users. The node connects to a socket only if it is owned by ExternalWeightOracleSocketOwner
+	// and cannot be written by its group or others.
+	// Nodes in EnableFollowMode may leave it unset; they then accept block certificates after checking
+	// only that they name the block, since verifying a certificate's votes needs their weights.
+	ExternalWeightOracleURL string `version[39]:""`
+
+	// ExternalWeightOracleSocketOwner is the uid that must own the weight daemon's unix domain socket; -1,
+	// the default, is the user algod runs as. The node refuses to connect to a socket owned by another
+	// user, or writable by its group or others, since whoever could have replaced it could serve the
+	// node any weights. It is ignored unless ExternalWeightOracleURL names a socket.
+	ExternalWeightOracleSocketOwner int64 `version[39]:"-1"`
+
+	// ExternalWeightOracleDialTimeout is the timeout for establishing a connection to the weight daemon.
+	ExternalWeightOracleDialTimeout time.Duration `version[39]:"5000000000"`
+
+	// ExternalWeightOracleHTTP2 sends weight daemon requests over HTTP/2, so that concurrent queries share
+	// a connection instead of each taking one. An https daemon that does not offer HTTP/2 is still
+	// reached over HTTP/1.1, but an http daemon must accept unencrypted HTTP/2 without an upgrade
+	// (h2c with prior knowledge). ExternalWeightOracleMaxConnections then limits the shared connections,
+	// and ExternalWeightOracleConnectionOverflow does not apply.
+	ExternalWeightOracleHTTP2 bool `version[39]:"false"`
+
+	// ExternalWeightOracleProtocol is the protocol spoken to the weight daemon: "http" for JSON over HTTP,
+	// or "grpc" for the gRPC service defined in node/weightoracle/weightoracle.proto, which carries
+	// weights and rounds as binary integers and shares one HTTP/2 connection between concurrent queries.
+	// A gRPC daemon is reached at the host of ExternalWeightOracleURL, or at its unix socket, and the URL
+	// must not have a path. ExternalWeightOracleSigningKey, ExternalWeightOracleHTTP2 and
+	// ExternalWeightOracleConnectionOverflow apply only to "http"; ExternalWeightOracleAuthToken is sent
+	// as the authorization metadata of every call.
+	ExternalWeightOracleProtocol string `version[39]:"http"`
+
+	// ExternalWeightOracleEncoding is how HTTP requests to the weight daemon are encoded: "auto" sends
+	// weight, total weight and weight table queries in msgpack, which carries addresses, selection IDs
+	// and integers in binary, once the daemon's identity lists the "msgpack" capability, and JSON
+	// otherwise; "json" always sends JSON. It does not apply to ExternalWeightOracleProtocol "grpc".
+	ExternalWeightOracleEncoding string `version[39]:"auto"`
+
+	// ExternalWeightOracleQueryTimeout is the timeout for a single request to the weight daemon,
+	// from sending the request to reading the complete response.
+	ExternalWeightOracleQueryTimeout time.Duration `version[39]:"10000000000"`
+
+	// ExternalWeightOracleMaxAttempts is the number of times a request is sent to the weight daemon
+	// before a retryable failure is reported. 1 disables retries.
+	ExternalWeightOracleMaxAttempts uint64 `version[39]:"1"`
+
+	// ExternalWeightOracleRetryInitialBackoff is the delay before the first retry of a failed weight
+	// daemon request. The delay doubles on each further retry, up to ExternalWeightOracleRetryMaxBackoff.
+	ExternalWeightOracleRetryInitialBackoff time.Duration `version[39]:"100000000"`
+
+	// ExternalWeightOracleRetryMaxBackoff caps the delay between retries of a failed weight daemon request.
+	ExternalWeightOracleRetryMaxBackoff time.Duration `version[39]:"2000000000"`
+
+	// ExternalWeightOracleRetryJitter is the percentage, from 0 to 100, by which each delay between retries
+	// of a failed weight daemon request is randomly shortened, so that requests failing together are not
+	// retried together.
+	ExternalWeightOracleRetryJitter uint64 `version[39]:"20"`
+
+	// ExternalWeightOracleRetryBudget, when not zero, bounds the time a weight daemon request may take
+	// from its first attempt to its last, delays between retries included: attempts are cut short to fit
+	// and no retry is made that could not finish in time. It must not exceed
+	// ExternalWeightOracleQueryTimeout, so that retries never make a vote wait longer than a single
+	// attempt could. Zero gives each attempt the whole ExternalWeightOracleQueryTimeout.
+	ExternalWeightOracleRetryBudget time.Duration `version[39]:"0"`
+
+	// ExternalWeightOracleRetryableErrors is a comma-separated list of the weight daemon failures that are retried:
+	// "connect" (the request could not be sent or the connection broke), "timeout" (no answer within
+	// ExternalWeightOracleQueryTimeout), "internal" (the daemon answered with an internal error) and
+	// "unavailable" (an HTTP 5xx status without a daemon error, typically from a proxy).
+	// A local daemon rarely benefits from more than "connect"; remote services usually want all four.
+	ExternalWeightOracleRetryableErrors string `version[39]:"connect,timeout"`
+
+	// ExternalWeightOracleCircuitBreakerThreshold is the percentage of the last
+	// ExternalWeightOracleCircuitBreakerWindow weight daemon requests that must have failed, by timing out,
+	// not getting through or with an internal or unavailable error, for the node to stop sending requests
+	// for ExternalWeightOracleCircuitBreakerCooldown and fail them at once instead. After the cool-down one
+	// request is sent to find out whether the daemon is back. 0 disables the circuit breaker.
+	ExternalWeightOracleCircuitBreakerThreshold uint64        `version[39]:"50"`
+	ExternalWeightOracleCircuitBreakerWindow    uint64        `version[39]:"20"`
+	ExternalWeightOracleCircuitBreakerCooldown  time.Duration `version[39]:"5000000000"`
+
+	// ExternalWeightOracleWeightCacheSize is the number of account weights cached by the weight oracle client.
+	ExternalWeightOracleWeightCacheSize uint64 `version[39]:"10000"`
+
+	// ExternalWeightOracleWeightCacheMinSize and ExternalWeightOracleWeightCacheMaxSize let the weight cache
+	// resize itself. When the maximum is set, the cache starts at ExternalWeightOracleWeightCacheSize and
+	// follows the number of distinct weights recently queried per round, within these bounds; a minimum of 0
+	// means ExternalWeightOracleWeightCacheSize. When the maximum is 0 the cache keeps its configured size.
+	ExternalWeightOracleWeightCacheMinSize uint64 `version[39]:"0"`
+	ExternalWeightOracleWeightCacheMaxSize uint64 `version[39]:"0"`
+
+	// ExternalWeightOracleNotFoundCacheTTL is how long the weight daemon's answer that it does not know an
+	// account is reused for further weight lookups of the same account and balance round, so that a flood
+	// of votes from unknown accounts cannot overwhelm the daemon. Keep it short: a daemon that is behind
+	// the chain may know the account soon after. 0 asks the daemon every time.
+	ExternalWeightOracleNotFoundCacheTTL time.Duration `version[39]:"2000000000"`
+
+	// ExternalWeightOraclePersistCache saves the weight daemon answers the node has cached for balance
+	// rounds it may still need, every minute and when it stops, and loads them when it starts again, so
+	// that catching up after a restart does not ask the daemon for all of them at once. Answers saved from
+	// a daemon with a different genesis hash or weight algorithm version are not loaded.
+	ExternalWeightOraclePersistCache bool `version[39]:"false"`
+
+	// ExternalWeightOracleTotalWeightCacheSize is the number of total weights cached by the weight oracle client.
+	ExternalWeightOracleTotalWeightCacheSize uint64 `version[39]:"1000"`
+
+	// ExternalWeightOracleCacheEviction is which entry the full weight and total weight caches drop: "lru"
+	// the least recently used, and "round" one for the oldest balance round cached. Nodes with many
+	// participation keys may prefer "round", which keeps the weights of the rounds still being voted on
+	// through bursts of lookups for older rounds, such as while catching up.
+	ExternalWeightOracleCacheEviction string `version[39]:"lru"`
+
+	// ExternalWeightOracleFailureMode is what the node does when the weight daemon cannot be reached:
+	// "halt" fails the weight lookups, so the node stops verifying votes and blocks until the daemon is
+	// back; "stale-cache" answers them with the weights cached for the same account up to
+	// ExternalWeightOracleStaleRounds balance rounds earlier, when there are any; and "observe-only" does
+	// the same to keep following the chain, but stops the node's own voting and proposing meanwhile.
+	ExternalWeightOracleFailureMode string `version[39]:"halt"`
+
+	// ExternalWeightOracleStaleRounds is how many balance rounds back the weights answered while the
+	// weight daemon cannot be reached may be from (see ExternalWeightOracleFailureMode).
+	ExternalWeightOracleStaleRounds uint64 `version[39]:"8"`
+
+	// ExternalWeightOracleMaxConcurrentRequests limits the number of requests in flight to the weight daemon.
+	// Requests beyond the limit wait for a free slot until their query timeout expires. 0 means unlimited.
+	ExternalWeightOracleMaxConcurrentRequests uint64 `version[39]:"64"`
+
+	// ExternalWeightOracleMaxConcurrentRequestsPerEndpoint limits the requests in flight to individual
+	// weight daemon endpoints, as a comma-separated list of endpoint=limit pairs, e.g.
+	// "weight=32,weight_table=1". The endpoints are "ping", "identity", "weight", "weights",
+	// "total_weight" and "weight_table". Endpoints not listed are bounded only by ExternalWeightOracleMaxConcurrentRequests.
+	ExternalWeightOracleMaxConcurrentRequestsPerEndpoint string `version[39]:""`
+
+	// ExternalWeightOracleMaxConnections limits the sockets the node keeps open to the weight daemon,
+	// counting idle ones. Each attempt at a request holds a connection while it runs. 0 means unlimited.
+	ExternalWeightOracleMaxConnections uint64 `version[39]:"64"`
+
+	// ExternalWeightOracleConnectionOverflow is what happens to a request attempt when all
+	// ExternalWeightOracleMaxConnections connections are busy: "queue" waits for one until the query
+	// timeout expires, and "reject" fails the request at once, leaving the caller to fall back.
+	ExternalWeightOracleConnectionOverflow string `version[39]:"queue"`
+
+	// ExternalWeightOracleWarmConnections is how many connections to the weight daemon the node opens once
+	// the daemon has been validated at startup, and then keeps open while idle, so that the first agreement
+	// rounds do not wait on TCP and TLS handshakes. With ExternalWeightOracleHTTP2 a single connection is
+	// kept. 0 disables the warm-up.
+	ExternalWeightOracleWarmConnections uint64 `version[39]:"0"`
+
+	// ExternalWeightOraclePrefetchRounds is how many vote rounds ahead of the latest block the node looks up
+	// the total weight and the weights of its own participation keys, as each block is committed, so that
+	// agreement finds them cached instead of waiting on the weight daemon. 0 disables prefetching.
+	ExternalWeightOraclePrefetchRounds uint64 `version[39]:"2"`
+
+	// ExternalWeightOracleSubscribeRounds is how many vote rounds ahead of the latest block the node keeps the
+	// weight daemon's weight tables cached, asking the daemon to push each table over a long-polled /subscribe
+	// request as soon as it is computed, instead of pulling weights while votes are verified. A daemon that does
+	// not push tables is left alone. 0 disables the subscription; it applies only to "http" daemons.
+	ExternalWeightOracleSubscribeRounds uint64 `version[39]:"0"`
+
+	// ExternalWeightOracleImportRounds is how many vote rounds ahead of the latest block the node imports the
+	// weight daemon's full weight tables into its weight cache, one /weight_table request per balance round,
+	// so that the votes of every account, not only those of its own participation keys, are verified without
+	// querying the daemon for each. It suits archival and relay nodes; ExternalWeightOracleWeightCacheSize
+	// must hold that many tables. A daemon that does not serve tables is left alone. 0 disables importing.
+	ExternalWeightOracleImportRounds uint64 `version[39]:"0"`
+
+	// ExternalWeightOracleTraceRequests marks each weight daemon request as a task, named after its
+	// endpoint, in execution traces taken through the pprof trace endpoint, so that a trace of a slow round
+	// shows the requests it waited on. Requests are always labeled in CPU profiles with their endpoint and
+	// phase: startup, membership, verification or background.
+	ExternalWeightOracleTraceRequests bool `version[39]:"false"`
+
+	// ExternalWeightOracleLookupLogSampling logs one in every that many weight and total weight lookups at
+	// debug level, as a structured entry with the daemon, endpoint, rounds, account, latency, cache state and
+	// the weight answered or the error, so that the weights behind a disputed vote can be traced to what the
+	// daemon returned. 1 logs every lookup; 0 logs none.
+	ExternalWeightOracleLookupLogSampling uint64 `version[39]:"0"`
+
+	// ExternalWeightOracleBackpressureQueueDepth is how many weight daemon requests, waiting or in flight,
+	// mean the daemon is not keeping up with the node. While they stay at or above it for
+	// ExternalWeightOracleBackpressureWindow, agreement stops verifying duplicates of votes already being
+	// verified, which would only be discarded once verified, so that the node's own participation
+	// stays responsive. 0 disables this criterion.
+	ExternalWeightOracleBackpressureQueueDepth uint64 `version[39]:"128"`
+
+	// ExternalWeightOracleBackpressureLatencyFactor is how many times its usual level the recent latency of
+	// weight daemon requests must reach, for ExternalWeightOracleBackpressureWindow, to be taken as a sign
+	// that the daemon is saturated. The usual level follows lasting changes over several minutes. 0 disables
+	// this criterion.
+	ExternalWeightOracleBackpressureLatencyFactor uint64 `version[39]:"4"`
+
+	// ExternalWeightOracleBackpressureWindow is how long the weight daemon must look saturated before
+	// agreement starts shedding work, and how long it must look healthy again before it stops.
+	ExternalWeightOracleBackpressureWindow time.Duration `version[39]:"2000000000"`
+
+	// ExternalWeightOracleRateLimitPerEndpoint limits how many requests per second are sent to individual
+	// weight daemon endpoints, as a comma-separated list of endpoint=rate pairs, e.g. "weight=500", with
+	// the same endpoint names as ExternalWeightOracleMaxConcurrentRequestsPerEndpoint. Up to one second's
+	// worth of requests may be sent at once. Once the rate is used up, weight lookups of accounts with
+	// no participation key on this node are dropped with an error, so that a flood of votes cannot
+	// overload a shared daemon; all other requests wait for their turn. Endpoints not listed are not
+	// rate limited.
+	ExternalWeightOracleRateLimitPerEndpoint string `version[39]:""`
+
+	// ExternalWeightOracleHealthCheckInterval is how often the node pings the weight daemon to detect outages
+	// between queries. 0 disables the health check.
+	ExternalWeightOracleHealthCheckInterval time.Duration `version[39]:"30000000000"`
+
+	// ExternalWeightOracleMaxWeight is the largest account weight accepted from the weight daemon.
+	// Larger answers are rejected as a daemon fault. 0 means no limit.
+	ExternalWeightOracleMaxWeight uint64 `version[39]:"0"`
+
+	// ExternalWeightOracleMaxTotalWeight is the largest total weight accepted from the weight daemon.
+	// Larger answers are rejected as a daemon fault. 0 means no limit.
+	ExternalWeightOracleMaxTotalWeight uint64 `version[39]:"0"`
+
+	// ExternalWeightOracleMaxWeightRatioPPM is the largest share of the total weight, in parts per million,
+	// that a single account may hold. An account weight above it is rejected as a daemon fault. 0 means no limit.
+	ExternalWeightOracleMaxWeightRatioPPM uint64 `version[39]:"0"`
+
+	// ExternalWeightOracleStrictResponses makes the weight oracle client reject daemon answers with fields
+	// it does not know, repeated fields or overlong strings, rather than ignoring what it does not use.
+	ExternalWeightOracleStrictResponses bool `version[39]:"false"`
+
+	// ExternalWeightOracleAuthToken locates the bearer token presented to the weight daemon, either as
+	// "file:<path>" (relative to the data directory; the file must be readable only by its owner) or as
+	// "env:<NAME>". The token itself may not be written here. Empty sends no token.
+	ExternalWeightOracleAuthToken string `version[39]:""`
+
+	// ExternalWeightOracleSigningKey locates a key shared with the weight daemon, in the same forms as
+	// ExternalWeightOracleAuthToken. When set, every request carries a timestamp, a nonce and an
+	// HMAC-SHA256 signature over them and the request body, so the daemon can reject forged or replayed
+	// requests without TLS. Empty sends unsigned requests.
+	ExternalWeightOracleSigningKey string `version[39]:""`
+
+	// ExternalWeightOracleSignedResponses requires the weight daemon to sign each answer with the
+	// ExternalWeightOracleSigningKey, covering the signature of the request it answers, and rejects
+	// answers that are unsigned, altered or replayed from another request.
+	ExternalWeightOracleSignedResponses bool `version[39]:"false"`
+
+	// ExternalWeightOracleTLSPins pins the key of an https weight daemon, as a comma-separated list of
+	// "sha256/<base64>" hashes of the SubjectPublicKeyInfo of acceptable daemon certificates. When set, the
+	// daemon's certificate must match one of them and is not checked against the system CA store or the
+	// URL's host name, so a self-signed certificate may be used. Empty relies on the system CA store.
+	ExternalWeightOracleTLSPins string `version[39]:""`
+
+	// ExternalWeightOracleTLSCAFile is a PEM file of the certificate authorities trusted to sign the
+	// certificate of an https weight daemon, in place of the system CA store, so that a daemon on another
+	// host or in a sidecar container can use a certificate from a private CA. The certificate must still
+	// name the URL's host, and also match a pin when ExternalWeightOracleTLSPins is set. A relative path
+	// is resolved against the data directory.
+	ExternalWeightOracleTLSCAFile string `version[39]:""`
+
+	// ExternalWeightOracleTLSClientCert and ExternalWeightOracleTLSClientKey are the certificate and
+	// private key the node presents to an https weight daemon that authenticates its clients (mutual
+	// TLS). The certificate is a PEM file, whose relative path is resolved against the data directory;
+	// the key is a secret reference, "file:<path>" or "env:<NAME>", to a PEM private key. Set both or
+	// neither.
+	ExternalWeightOracleTLSClientCert string `version[39]:""`
+	ExternalWeightOracleTLSClientKey  string `version[39]:""`
+
+	// EnableBuiltinStakeWeightOracle replaces the external weight daemon with an oracle built into the
+	// node that reports each account's online stake as its weight. It is meant for development networks,
+	// where it removes the need to run a daemon. ExternalWeightOracleURL must be left empty.
+	EnableBuiltinStakeWeightOracle bool `version[39]:"false"`
+
+	// ExternalWeightOracleCrossCheckURLs is a comma-separated list of the base URLs of further weight
+	// daemons serving the same network. They are not used for consensus: the node only compares their
+	// total weights with those of the main daemon every minute, since daemons that disagree put the
+	// network's safety at risk. They are reached with the same settings and credentials as the main
+	// daemon; with ExternalWeightOracleTLSPins, list the pins of all of them.
+	ExternalWeightOracleCrossCheckURLs string `version[39]:""`
+
+	// ExternalWeightOracleCrossCheckPolicy is what the node does when a daemon listed in
+	// ExternalWeightOracleCrossCheckURLs disagrees with the main daemon: "alert" logs an error, and
+	// "halt" also stops the node's own voting and proposing until all daemons agree again.
+	ExternalWeightOracleCrossCheckPolicy string `version[39]:"alert"`
+
+	// ExternalWeightOracleFailoverURLs is a comma-separated list of the base URLs of standby weight
+	// daemons serving the same network, in order of preference. When the daemon in use times out, cannot
+	// be reached or reports internal errors, requests move to the next one whose identity matches that of
+	// the daemon it replaces, and go back to the main daemon once it answers again. They are reached with
+	// the same settings and credentials as the main daemon; with ExternalWeightOracleTLSPins, list the
+	// pins of all of them.
+	ExternalWeightOracleFailoverURLs string `version[39]:""`
+
+	// ExternalWeightOracleProtocolVersionMatch is how closely the weight daemon's wire protocol version
+	// must follow the one the node speaks: "major" accepts any version with the same major version,
+	// "minor" any patch of the same major.minor version and "exact" only the node's own version.
+	ExternalWeightOracleProtocolVersionMatch string `version[39]:"major"`
+
+	// ExternalWeightOracleAlgorithmVersionMatch is how closely the weight daemon's weight algorithm
+	// version must follow the one the node expects: "minor" accepts any patch of the same major.minor
+	// version and "exact" only that version. Daemons running other minor versions may derive different
+	// weights, so they are never accepted.
+	ExternalWeightOracleAlgorithmVersionMatch string `version[39]:"minor"`
+
+	// ExternalWeightOracleAcceptedProtocolVersions is a comma-separated list of weight daemon wire protocol
+	// versions, such as "2.0", that the node accepts besides those ExternalWeightOracleProtocolVersionMatch
+	// allows.
+	ExternalWeightOracleAcceptedProtocolVersions string `version[39]:""`
+
+	// ExternalWeightOracleAcceptedAlgorithmVersions is a comma-separated list of weight algorithm versions
+	// that the node accepts besides those ExternalWeightOracleAlgorithmVersionMatch allows and those the
+	// consensus protocol lists. It lets operators stage the rollout of a new algorithm; since daemons
+	// deriving different weights disagree on committees, list only versions the network has agreed on.
+	ExternalWeightOracleAcceptedAlgorithmVersions string `version[39]:""`
+
+	// ExternalWeightOracleOverrideFile locates a signed file of weights that replace the weight daemon's
+	// answers for some accounts, at balance rounds from the file's effective round on and until its expiry,
+	// for incidents such as a compromised account when the daemon cannot be redeployed quickly. An account
+	// overridden to zero weight is excluded from committees. Relative paths are resolved against the data
+	// directory.
+	// Other nodes do not see the overrides, so while they are in force this node's committees may differ
+	// from theirs. Block evaluation ignores them, so that they never decide which blocks this node accepts.
+	// The file must be signed, with "algokey weight-override", by one of ExternalWeightOracleOverrideSigners.
+	ExternalWeightOracleOverrideFile string `version[39]:""`
+
+	// ExternalWeightOracleOverrideSigners is a comma-separated list of the addresses whose keys may sign
+	// the ExternalWeightOracleOverrideFile.
+	ExternalWeightOracleOverrideSigners string `version[39]:""`
+
+	// AllowRemoteWeightOracle permits ExternalWeightOracleURL to name a host other than the loopback
+	// interface. Weights decide consensus, so a remote daemon must also be reached over https or
+	// authenticated with ExternalWeightOracleAuthToken or ExternalWeightOracleSigningKey. Without this
+	// flag a node configured with a remote daemon refuses to start.
+	AllowRemoteWeightOracle bool `version[39]:"false"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
@@ -828,6 +1172,682 @@ func (e P2PHybridConfigError) Error() string {
 	return e.msg
 }
 
+// ExternalWeightOracleEndpoint returns the validated base URL of the external weight daemon,
+// derived from ExternalWeightOracleURL or, for older configurations, ExternalWeightOraclePort.
+// It returns nil if neither is set.
+func (cfg Local) ExternalWeightOracleEndpoint() (*url.URL, error) {
+	if cfg.ExternalWeightOracleURL == "" {
+		if cfg.ExternalWeightOraclePort == 0 {
+			return nil, nil
+		}
+		return &url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", cfg.ExternalWeightOraclePort)}, nil
+	}
+	if cfg.ExternalWeightOraclePort != 0 {
+		return nil, WeightOracleConfigError{msg: "ExternalWeightOraclePort is a deprecated alias for ExternalWeightOracleURL; set only one of them"}
+	}
+
+	return parseWeightOracleURL("ExternalWeightOracleURL", cfg.ExternalWeightOracleURL)
+}
+
+// ExternalWeightOracleConfigured reports whether a weight oracle is configured at
+// all, as a daemon or as the built-in stake oracle. Follower nodes run without one
+// unless it is.
+func (cfg Local) ExternalWeightOracleConfigured() bool {
+	return cfg.EnableBuiltinStakeWeightOracle || cfg.ExternalWeightOracleURL != "" || cfg.ExternalWeightOraclePort != 0
+}
+
+// ExternalWeightOracleCrossCheckEndpoints returns the validated base URLs listed in
+// ExternalWeightOracleCrossCheckURLs.
+func (cfg Local) ExternalWeightOracleCrossCheckEndpoints() ([]*url.URL, error) {
+	return parseWeightOracleURLList("ExternalWeightOracleCrossCheckURLs", cfg.ExternalWeightOracleCrossCheckURLs)
+}
+
+// ExternalWeightOracleFailoverEndpoints returns the validated base URLs listed in
+// ExternalWeightOracleFailoverURLs, in order of preference.
+func (cfg Local) ExternalWeightOracleFailoverEndpoints() ([]*url.URL, error) {
+	return parseWeightOracleURLList("ExternalWeightOracleFailoverURLs", cfg.ExternalWeightOracleFailoverURLs)
+}
+
+// parseWeightOracleURLList validates the comma-separated weight daemon base URLs of the setting called name.
+func parseWeightOracleURLList(name, list string) ([]*url.URL, error) {
+	var endpoints []*url.URL
+	for _, raw := range strings.Split(list, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		u, err := parseWeightOracleURL(name+" entry", raw)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, u)
+	}
+	return endpoints, nil
+}
+
+// parseWeightOracleURL validates the weight daemon base URL raw, taken from the setting called name.
+func parseWeightOracleURL(name, raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, WeightOracleConfigError{msg: fmt.Sprintf("invalid %s: %v", name, err)}
+	}
+	if u.Scheme == "unix" {
+		return parseWeightOracleSocketURL(name, raw, u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s %q must use the http, https or unix scheme", name, raw)}
+	}
+	if u.Host == "" {
+		return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s %q has no host", name, raw)}
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s %q must not contain credentials, a query or a fragment", name, raw)}
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+	return u, nil
+}
+
+// parseWeightOracleSocketURL checks a unix URL, which names the socket of a daemon on this host by
+// its absolute path and nothing else.
+func parseWeightOracleSocketURL(name, raw string, u *url.URL) (*url.URL, error) {
+	if u.Host != "" || u.Opaque != "" || !strings.HasPrefix(u.Path, "/") || strings.HasSuffix(u.Path, "/") {
+		return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s %q must name a socket by its absolute path, as in unix:///run/weightd.sock", name, raw)}
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s %q must not contain credentials, a query or a fragment", name, raw)}
+	}
+	return u, nil
+}
+
+// checkWeightOracleHost checks that the weight daemon at u, taken from the setting called name, is on
+// the loopback interface or a unix socket, or that a remote daemon is allowed and reached over a protected channel.
+func (cfg Local) checkWeightOracleHost(name string, u *url.URL) error {
+	if u.Scheme == "unix" || isLoopbackHost(u.Hostname()) {
+		return nil
+	}
+	if !cfg.AllowRemoteWeightOracle {
+		return WeightOracleConfigError{msg: fmt.Sprintf("%s %q is not a loopback address; set AllowRemoteWeightOracle to use a remote weight daemon", name, u.String())}
+	}
+	if u.Scheme != "https" && cfg.ExternalWeightOracleAuthToken == "" && cfg.ExternalWeightOracleSigningKey == "" {
+		return WeightOracleConfigError{msg: fmt.Sprintf("remote weight daemon %q must be reached over https or authenticated with ExternalWeightOracleAuthToken or ExternalWeightOracleSigningKey", u.String())}
+	}
+	return nil
+}
+
+// checkWeightOracleGRPCEndpoint checks that the weight daemon at u, taken from the setting called
+// name, can be reached over gRPC, whose calls are addressed to the host alone.
+func checkWeightOracleGRPCEndpoint(name string, u *url.URL) error {
+	if u.Scheme != "unix" && u.Path != "" {
+		return WeightOracleConfigError{msg: fmt.Sprintf("%s %q has a path, which ExternalWeightOracleProtocol \"grpc\" does not support", name, u.String())}
+	}
+	return nil
+}
+
+// isLoopbackHost reports whether host, as found in a URL, names the loopback
+// interface. Host names other than localhost are not resolved.
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ValidateExternalWeightOracleConfig checks the external weight oracle settings against their bounds
+// and against each other. It is meant to be called before starting a node that participates in
+// weighted consensus, so that bad combinations are reported before any work is done.
+func (cfg Local) ValidateExternalWeightOracleConfig() error {
+	endpoint, err := cfg.ExternalWeightOracleEndpoint()
+	if err != nil {
+		return err
+	}
+	if cfg.EnableBuiltinStakeWeightOracle {
+		if endpoint != nil {
+			return WeightOracleConfigError{msg: "EnableBuiltinStakeWeightOracle is set together with ExternalWeightOracleURL; the built-in oracle replaces the daemon, so set only one of them"}
+		}
+		// The daemon connection settings are unused.
+		return nil
+	}
+	if endpoint == nil {
+		return WeightOracleConfigError{msg: "ExternalWeightOracleURL must be configured (required for weighted consensus)"}
+	}
+	if err := cfg.checkWeightOracleHost("ExternalWeightOracleURL", endpoint); err != nil {
+		return err
+	}
+	crossCheck, err := cfg.ExternalWeightOracleCrossCheckEndpoints()
+	if err != nil {
+		return err
+	}
+	for _, u := range crossCheck {
+		if err := cfg.checkWeightOracleHost("ExternalWeightOracleCrossCheckURLs entry", u); err != nil {
+			return err
+		}
+		if u.String() == endpoint.String() {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleCrossCheckURLs lists the main weight daemon %q", u.String())}
+		}
+	}
+	failover, err := cfg.ExternalWeightOracleFailoverEndpoints()
+	if err != nil {
+		return err
+	}
+	for i, u := range failover {
+		if err := cfg.checkWeightOracleHost("ExternalWeightOracleFailoverURLs entry", u); err != nil {
+			return err
+		}
+		if u.String() == endpoint.String() {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleFailoverURLs lists the main weight daemon %q", u.String())}
+		}
+		for _, earlier := range failover[:i] {
+			if u.String() == earlier.String() {
+				return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleFailoverURLs lists %q twice", u.String())}
+			}
+		}
+	}
+	switch cfg.ExternalWeightOracleConnectionOverflow {
+	case WeightOracleConnectionOverflowQueue, WeightOracleConnectionOverflowReject:
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleConnectionOverflow %q must be %q or %q",
+			cfg.ExternalWeightOracleConnectionOverflow, WeightOracleConnectionOverflowQueue, WeightOracleConnectionOverflowReject)}
+	}
+	switch cfg.ExternalWeightOracleCacheEviction {
+	case WeightOracleCacheEvictionLRU, WeightOracleCacheEvictionRound:
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleCacheEviction %q must be %q or %q",
+			cfg.ExternalWeightOracleCacheEviction, WeightOracleCacheEvictionLRU, WeightOracleCacheEvictionRound)}
+	}
+	switch cfg.ExternalWeightOracleFailureMode {
+	case WeightOracleFailureHalt, WeightOracleFailureStaleCache, WeightOracleFailureObserveOnly:
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleFailureMode %q must be %q, %q or %q",
+			cfg.ExternalWeightOracleFailureMode, WeightOracleFailureHalt, WeightOracleFailureStaleCache, WeightOracleFailureObserveOnly)}
+	}
+	switch cfg.ExternalWeightOracleProtocol {
+	case WeightOracleProtocolHTTP:
+	case WeightOracleProtocolGRPC:
+		if err := checkWeightOracleGRPCEndpoint("ExternalWeightOracleURL", endpoint); err != nil {
+			return err
+		}
+		for _, u := range crossCheck {
+			if err := checkWeightOracleGRPCEndpoint("ExternalWeightOracleCrossCheckURLs entry", u); err != nil {
+				return err
+			}
+		}
+		for _, u := range failover {
+			if err := checkWeightOracleGRPCEndpoint("ExternalWeightOracleFailoverURLs entry", u); err != nil {
+				return err
+			}
+		}
+		if cfg.ExternalWeightOracleSigningKey != "" {
+			return WeightOracleConfigError{msg: "ExternalWeightOracleSigningKey signs HTTP requests and cannot be used with ExternalWeightOracleProtocol \"grpc\""}
+		}
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleProtocol %q must be %q or %q",
+			cfg.ExternalWeightOracleProtocol, WeightOracleProtocolHTTP, WeightOracleProtocolGRPC)}
+	}
+	switch cfg.ExternalWeightOracleEncoding {
+	case WeightOracleEncodingAuto, WeightOracleEncodingJSON:
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleEncoding %q must be %q or %q",
+			cfg.ExternalWeightOracleEncoding, WeightOracleEncodingAuto, WeightOracleEncodingJSON)}
+	}
+	switch cfg.ExternalWeightOracleCrossCheckPolicy {
+	case WeightOracleCrossCheckAlert, WeightOracleCrossCheckHalt:
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleCrossCheckPolicy %q must be %q or %q",
+			cfg.ExternalWeightOracleCrossCheckPolicy, WeightOracleCrossCheckAlert, WeightOracleCrossCheckHalt)}
+	}
+	switch cfg.ExternalWeightOracleProtocolVersionMatch {
+	case WeightOracleVersionMatchMajor, WeightOracleVersionMatchMinor, WeightOracleVersionMatchExact:
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleProtocolVersionMatch %q must be %q, %q or %q",
+			cfg.ExternalWeightOracleProtocolVersionMatch, WeightOracleVersionMatchMajor, WeightOracleVersionMatchMinor, WeightOracleVersionMatchExact)}
+	}
+	switch cfg.ExternalWeightOracleAlgorithmVersionMatch {
+	case WeightOracleVersionMatchMinor, WeightOracleVersionMatchExact:
+	default:
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleAlgorithmVersionMatch %q must be %q or %q",
+			cfg.ExternalWeightOracleAlgorithmVersionMatch, WeightOracleVersionMatchMinor, WeightOracleVersionMatchExact)}
+	}
+	if _, err := cfg.ExternalWeightOracleAcceptedProtocolVersionList(); err != nil {
+		return err
+	}
+	if _, err := cfg.ExternalWeightOracleAcceptedAlgorithmVersionList(); err != nil {
+		return err
+	}
+	if cfg.ExternalWeightOracleOverrideFile != "" && len(cfg.ExternalWeightOracleOverrideSignerList()) == 0 {
+		return WeightOracleConfigError{msg: "ExternalWeightOracleOverrideFile is set but ExternalWeightOracleOverrideSigners lists no signers"}
+	}
+	if cfg.ExternalWeightOracleSocketOwner < -1 {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleSocketOwner %d must be a uid, or -1 for the user algod runs as", cfg.ExternalWeightOracleSocketOwner)}
+	}
+	durations := []struct {
+		name     string
+		value    time.Duration
+		min, max time.Duration
+		zeroOK   bool
+	}{
+		{"ExternalWeightOracleDialTimeout", cfg.ExternalWeightOracleDialTimeout, 100 * time.Millisecond, time.Minute, false},
+		{"ExternalWeightOracleQueryTimeout", cfg.ExternalWeightOracleQueryTimeout, 100 * time.Millisecond, 5 * time.Minute, false},
+		{"ExternalWeightOracleRetryInitialBackoff", cfg.ExternalWeightOracleRetryInitialBackoff, 0, 10 * time.Second, true},
+		{"ExternalWeightOracleRetryMaxBackoff", cfg.ExternalWeightOracleRetryMaxBackoff, 0, time.Minute, true},
+		{"ExternalWeightOracleRetryBudget", cfg.ExternalWeightOracleRetryBudget, 100 * time.Millisecond, 5 * time.Minute, true},
+		{"ExternalWeightOracleHealthCheckInterval", cfg.ExternalWeightOracleHealthCheckInterval, time.Second, time.Hour, true},
+		{"ExternalWeightOracleBackpressureWindow", cfg.ExternalWeightOracleBackpressureWindow, 100 * time.Millisecond, time.Minute, false},
+		{"ExternalWeightOracleNotFoundCacheTTL", cfg.ExternalWeightOracleNotFoundCacheTTL, 10 * time.Millisecond, time.Minute, true},
+		{"ExternalWeightOracleCircuitBreakerCooldown", cfg.ExternalWeightOracleCircuitBreakerCooldown, 100 * time.Millisecond, 10 * time.Minute, false},
+	}
+	for _, d := range durations {
+		if d.zeroOK && d.value == 0 {
+			continue
+		}
+		if d.value < d.min || d.value > d.max {
+			return WeightOracleConfigError{msg: fmt.Sprintf("%s is %v, must be between %v and %v", d.name, d.value, d.min, d.max)}
+		}
+	}
+	if cfg.ExternalWeightOracleRetryMaxBackoff < cfg.ExternalWeightOracleRetryInitialBackoff {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleRetryMaxBackoff %v is less than ExternalWeightOracleRetryInitialBackoff %v",
+			cfg.ExternalWeightOracleRetryMaxBackoff, cfg.ExternalWeightOracleRetryInitialBackoff)}
+	}
+	if cfg.ExternalWeightOracleRetryBudget > cfg.ExternalWeightOracleQueryTimeout {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleRetryBudget %v is longer than ExternalWeightOracleQueryTimeout %v",
+			cfg.ExternalWeightOracleRetryBudget, cfg.ExternalWeightOracleQueryTimeout)}
+	}
+	if _, err := cfg.ExternalWeightOracleRetryableErrorClasses(); err != nil {
+		return err
+	}
+	endpointLimits, err := cfg.ExternalWeightOracleEndpointConcurrencyLimits()
+	if err != nil {
+		return err
+	}
+	counts := []struct {
+		name     string
+		value    uint64
+		min, max uint64
+		zeroOK   bool
+	}{
+		{"ExternalWeightOracleMaxAttempts", cfg.ExternalWeightOracleMaxAttempts, 1, 10, false},
+		{"ExternalWeightOracleRetryJitter", cfg.ExternalWeightOracleRetryJitter, 0, 100, true},
+		{"ExternalWeightOracleCircuitBreakerThreshold", cfg.ExternalWeightOracleCircuitBreakerThreshold, 1, 100, true},
+		{"ExternalWeightOracleCircuitBreakerWindow", cfg.ExternalWeightOracleCircuitBreakerWindow, 2, 10_000, false},
+		{"ExternalWeightOracleWeightCacheSize", cfg.ExternalWeightOracleWeightCacheSize, 16, 10_000_000, false},
+		{"ExternalWeightOracleWeightCacheMinSize", cfg.ExternalWeightOracleWeightCacheMinSize, 16, 10_000_000, true},
+		{"ExternalWeightOracleWeightCacheMaxSize", cfg.ExternalWeightOracleWeightCacheMaxSize, 16, 10_000_000, true},
+		{"ExternalWeightOracleTotalWeightCacheSize", cfg.ExternalWeightOracleTotalWeightCacheSize, 16, 1_000_000, false},
+		{"ExternalWeightOracleMaxConcurrentRequests", cfg.ExternalWeightOracleMaxConcurrentRequests, 1, 4096, true},
+		{"ExternalWeightOracleMaxConnections", cfg.ExternalWeightOracleMaxConnections, 1, 4096, true},
+		{"ExternalWeightOracleWarmConnections", cfg.ExternalWeightOracleWarmConnections, 1, 4096, true},
+		{"ExternalWeightOraclePrefetchRounds", cfg.ExternalWeightOraclePrefetchRounds, 1, 16, true},
+		{"ExternalWeightOracleSubscribeRounds", cfg.ExternalWeightOracleSubscribeRounds, 1, 1000, true},
+		{"ExternalWeightOracleImportRounds", cfg.ExternalWeightOracleImportRounds, 1, 1000, true},
+		{"ExternalWeightOracleLookupLogSampling", cfg.ExternalWeightOracleLookupLogSampling, 1, 1_000_000, true},
+		{"ExternalWeightOracleStaleRounds", cfg.ExternalWeightOracleStaleRounds, 1, 1000, false},
+		{"ExternalWeightOracleMaxWeightRatioPPM", cfg.ExternalWeightOracleMaxWeightRatioPPM, 1, 1_000_000, true},
+		{"ExternalWeightOracleBackpressureQueueDepth", cfg.ExternalWeightOracleBackpressureQueueDepth, 1, 1_000_000, true},
+		{"ExternalWeightOracleBackpressureLatencyFactor", cfg.ExternalWeightOracleBackpressureLatencyFactor, 2, 1000, true},
+	}
+	for _, c := range counts {
+		if c.zeroOK && c.value == 0 {
+			continue
+		}
+		if c.value < c.min || c.value > c.max {
+			return WeightOracleConfigError{msg: fmt.Sprintf("%s is %d, must be between %d and %d", c.name, c.value, c.min, c.max)}
+		}
+	}
+
+	if cfg.ExternalWeightOracleMaxConnections != 0 && cfg.ExternalWeightOracleWarmConnections > cfg.ExternalWeightOracleMaxConnections {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleWarmConnections %d is above ExternalWeightOracleMaxConnections %d",
+			cfg.ExternalWeightOracleWarmConnections, cfg.ExternalWeightOracleMaxConnections)}
+	}
+	if cfg.ExternalWeightOracleMaxConcurrentRequests != 0 && cfg.ExternalWeightOracleWarmConnections > cfg.ExternalWeightOracleMaxConcurrentRequests {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleWarmConnections %d is above ExternalWeightOracleMaxConcurrentRequests %d; the warm-up could not open them all",
+			cfg.ExternalWeightOracleWarmConnections, cfg.ExternalWeightOracleMaxConcurrentRequests)}
+	}
+	if cfg.ExternalWeightOracleWeightCacheMaxSize == 0 {
+		if cfg.ExternalWeightOracleWeightCacheMinSize != 0 {
+			return WeightOracleConfigError{msg: "ExternalWeightOracleWeightCacheMinSize requires ExternalWeightOracleWeightCacheMaxSize"}
+		}
+	} else if cfg.ExternalWeightOracleWeightCacheSize > cfg.ExternalWeightOracleWeightCacheMaxSize ||
+		cfg.ExternalWeightOracleWeightCacheSize < cfg.ExternalWeightOracleWeightCacheMinSize {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleWeightCacheSize %d must be between ExternalWeightOracleWeightCacheMinSize %d and ExternalWeightOracleWeightCacheMaxSize %d",
+			cfg.ExternalWeightOracleWeightCacheSize, cfg.ExternalWeightOracleWeightCacheMinSize, cfg.ExternalWeightOracleWeightCacheMaxSize)}
+	}
+	if cfg.ExternalWeightOracleQueryTimeout < cfg.ExternalWeightOracleDialTimeout {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleQueryTimeout %v is shorter than ExternalWeightOracleDialTimeout %v; raise the query timeout or lower the dial timeout",
+			cfg.ExternalWeightOracleQueryTimeout, cfg.ExternalWeightOracleDialTimeout)}
+	}
+	if cfg.ExternalWeightOracleMaxAttempts > 1 {
+		classes, _ := cfg.ExternalWeightOracleRetryableErrorClasses()
+		if len(classes) == 0 {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleMaxAttempts is %d but ExternalWeightOracleRetryableErrors is empty; list the failures to retry or set ExternalWeightOracleMaxAttempts to 1",
+				cfg.ExternalWeightOracleMaxAttempts)}
+		}
+	}
+	if cfg.ExternalWeightOracleHealthCheckInterval != 0 && cfg.ExternalWeightOracleHealthCheckInterval < cfg.ExternalWeightOracleQueryTimeout {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleHealthCheckInterval %v is shorter than ExternalWeightOracleQueryTimeout %v; health checks would overlap",
+			cfg.ExternalWeightOracleHealthCheckInterval, cfg.ExternalWeightOracleQueryTimeout)}
+	}
+	if cfg.ExternalWeightOracleMaxWeight != 0 && cfg.ExternalWeightOracleMaxTotalWeight != 0 &&
+		cfg.ExternalWeightOracleMaxWeight > cfg.ExternalWeightOracleMaxTotalWeight {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleMaxWeight %d is greater than ExternalWeightOracleMaxTotalWeight %d",
+			cfg.ExternalWeightOracleMaxWeight, cfg.ExternalWeightOracleMaxTotalWeight)}
+	}
+	for _, endpoint := range weightOracleEndpoints {
+		limit, ok := endpointLimits[endpoint]
+		if !ok {
+			continue
+		}
+		if limit < 1 || limit > 4096 {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleMaxConcurrentRequestsPerEndpoint limit for %s is %d, must be between 1 and 4096", endpoint, limit)}
+		}
+		if cfg.ExternalWeightOracleMaxConcurrentRequests != 0 && limit > cfg.ExternalWeightOracleMaxConcurrentRequests {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleMaxConcurrentRequestsPerEndpoint limit for %s is %d, above ExternalWeightOracleMaxConcurrentRequests %d",
+				endpoint, limit, cfg.ExternalWeightOracleMaxConcurrentRequests)}
+		}
+	}
+	rateLimits, err := cfg.ExternalWeightOracleEndpointRateLimits()
+	if err != nil {
+		return err
+	}
+	for endpoint, rate := range rateLimits {
+		if rate < 1 || rate > 1_000_000 {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleRateLimitPerEndpoint rate for %s is %d, must be between 1 and 1000000", endpoint, rate)}
+		}
+	}
+	if cfg.ExternalWeightOracleAuthToken != "" {
+		if err := ValidateSecretRef(cfg.ExternalWeightOracleAuthToken); err != nil {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleAuthToken: %v", err)}
+		}
+	}
+	if cfg.ExternalWeightOracleSigningKey != "" {
+		if err := ValidateSecretRef(cfg.ExternalWeightOracleSigningKey); err != nil {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleSigningKey: %v", err)}
+		}
+	} else if cfg.ExternalWeightOracleSignedResponses {
+		return WeightOracleConfigError{msg: "ExternalWeightOracleSignedResponses requires ExternalWeightOracleSigningKey"}
+	}
+	pins, err := cfg.ExternalWeightOracleTLSPinHashes()
+	if err != nil {
+		return err
+	}
+	if len(pins) > 0 && endpoint.Scheme != "https" {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSPins is set but ExternalWeightOracleURL %q does not use https", endpoint.String())}
+	}
+	if cfg.ExternalWeightOracleTLSCAFile != "" && endpoint.Scheme != "https" {
+		return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSCAFile is set but ExternalWeightOracleURL %q does not use https", endpoint.String())}
+	}
+	if (cfg.ExternalWeightOracleTLSClientCert == "") != (cfg.ExternalWeightOracleTLSClientKey == "") {
+		return WeightOracleConfigError{msg: "ExternalWeightOracleTLSClientCert and ExternalWeightOracleTLSClientKey must be set together"}
+	}
+	if cfg.ExternalWeightOracleTLSClientKey != "" {
+		if err := ValidateSecretRef(cfg.ExternalWeightOracleTLSClientKey); err != nil {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSClientKey: %v", err)}
+		}
+		if endpoint.Scheme != "https" {
+			return WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSClientCert is set but ExternalWeightOracleURL %q does not use https", endpoint.String())}
+		}
+	}
+	return nil
+}
+
+// WeightOracleTLSPinPrefix starts every entry of ExternalWeightOracleTLSPins.
+const WeightOracleTLSPinPrefix = "sha256/"
+
+// ExternalWeightOracleTLSPinHashes returns the SHA-256 key hashes listed in ExternalWeightOracleTLSPins.
+func (cfg Local) ExternalWeightOracleTLSPinHashes() ([][]byte, error) {
+	var pins [][]byte
+	for _, pin := range strings.Split(cfg.ExternalWeightOracleTLSPins, ",") {
+		pin = strings.TrimSpace(pin)
+		if pin == "" {
+			continue
+		}
+		encoded, ok := strings.CutPrefix(pin, WeightOracleTLSPinPrefix)
+		if !ok {
+			return nil, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSPins entry %q must start with %q", pin, WeightOracleTLSPinPrefix)}
+		}
+		hash, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSPins entry %q must be the base64 encoding of a %d-byte SHA-256 hash", pin, sha256.Size)}
+		}
+		pins = append(pins, hash)
+	}
+	return pins, nil
+}
+
+// WeightOracleSecrets is the credential material used on the weight daemon connection.
+type WeightOracleSecrets struct {
+	// AuthToken is sent to the daemon as a bearer token when not empty.
+	AuthToken Secret
+
+	// SigningKey signs every request to the daemon when not empty.
+	SigningKey Secret
+
+	// TLSRootCAs, when not nil, holds the authorities of ExternalWeightOracleTLSCAFile.
+	TLSRootCAs *x509.CertPool
+
+	// TLSClientCertificate, when not nil, is the certificate and key of
+	// ExternalWeightOracleTLSClientCert and ExternalWeightOracleTLSClientKey.
+	TLSClientCertificate *tls.Certificate
+}
+
+// LoadExternalWeightOracleSecrets loads the weight daemon credentials referenced by the
+// configuration. Relative secret files are resolved against dataDir.
+func (cfg Local) LoadExternalWeightOracleSecrets(dataDir string) (WeightOracleSecrets, error) {
+	var secrets WeightOracleSecrets
+	var err error
+	secrets.AuthToken, err = LoadSecret(cfg.ExternalWeightOracleAuthToken, dataDir)
+	if err != nil {
+		return WeightOracleSecrets{}, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleAuthToken: %v", err)}
+	}
+	secrets.SigningKey, err = LoadSecret(cfg.ExternalWeightOracleSigningKey, dataDir)
+	if err != nil {
+		return WeightOracleSecrets{}, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleSigningKey: %v", err)}
+	}
+	if cfg.ExternalWeightOracleTLSCAFile != "" {
+		pem, err := os.ReadFile(resolveDataDirPath(cfg.ExternalWeightOracleTLSCAFile, dataDir))
+		if err != nil {
+			return WeightOracleSecrets{}, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSCAFile: %v", err)}
+		}
+		secrets.TLSRootCAs = x509.NewCertPool()
+		if !secrets.TLSRootCAs.AppendCertsFromPEM(pem) {
+			return WeightOracleSecrets{}, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSCAFile %s contains no PEM certificates", cfg.ExternalWeightOracleTLSCAFile)}
+		}
+	}
+	if cfg.ExternalWeightOracleTLSClientCert != "" {
+		certPEM, err := os.ReadFile(resolveDataDirPath(cfg.ExternalWeightOracleTLSClientCert, dataDir))
+		if err != nil {
+			return WeightOracleSecrets{}, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSClientCert: %v", err)}
+		}
+		key, err := LoadSecret(cfg.ExternalWeightOracleTLSClientKey, dataDir)
+		if err != nil {
+			return WeightOracleSecrets{}, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSClientKey: %v", err)}
+		}
+		cert, err := tls.X509KeyPair(certPEM, key.Bytes())
+		if err != nil {
+			// The error does not include the key.
+			return WeightOracleSecrets{}, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleTLSClientCert and ExternalWeightOracleTLSClientKey: %v", err)}
+		}
+		secrets.TLSClientCertificate = &cert
+	}
+	return secrets, nil
+}
+
+// resolveDataDirPath resolves a relative path against dataDir.
+func resolveDataDirPath(path, dataDir string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dataDir, path)
+}
+
+// Values of ExternalWeightOracleConnectionOverflow.
+const (
+	WeightOracleConnectionOverflowQueue  = "queue"
+	WeightOracleConnectionOverflowReject = "reject"
+)
+
+// Values of ExternalWeightOracleCacheEviction.
+const (
+	WeightOracleCacheEvictionLRU   = "lru"
+	WeightOracleCacheEvictionRound = "round"
+)
+
+// Values of ExternalWeightOracleFailureMode.
+const (
+	WeightOracleFailureHalt        = "halt"
+	WeightOracleFailureStaleCache  = "stale-cache"
+	WeightOracleFailureObserveOnly = "observe-only"
+)
+
+// Values of ExternalWeightOracleProtocol.
+const (
+	WeightOracleProtocolHTTP = "http"
+	WeightOracleProtocolGRPC = "grpc"
+)
+
+// Values of ExternalWeightOracleEncoding.
+const (
+	WeightOracleEncodingAuto = "auto"
+	WeightOracleEncodingJSON = "json"
+)
+
+// Values of ExternalWeightOracleCrossCheckPolicy.
+const (
+	WeightOracleCrossCheckAlert = "alert"
+	WeightOracleCrossCheckHalt  = "halt"
+)
+
+// Values of ExternalWeightOracleProtocolVersionMatch and ExternalWeightOracleAlgorithmVersionMatch.
+const (
+	WeightOracleVersionMatchMajor = "major"
+	WeightOracleVersionMatchMinor = "minor"
+	WeightOracleVersionMatchExact = "exact"
+)
+
+// Weight daemon failure classes accepted in ExternalWeightOracleRetryableErrors.
+const (
+	WeightOracleRetryConnect     = "connect"
+	WeightOracleRetryTimeout     = "timeout"
+	WeightOracleRetryInternal    = "internal"
+	WeightOracleRetryUnavailable = "unavailable"
+)
+
+// weightOracleEndpoints are the weight daemon endpoints, as named in
+// ExternalWeightOracleMaxConcurrentRequestsPerEndpoint and ExternalWeightOracleRateLimitPerEndpoint.
+var weightOracleEndpoints = []string{"ping", "identity", "weight", "weights", "total_weight", "weight_table"}
+
+// ExternalWeightOracleEndpointConcurrencyLimits returns the per-endpoint request limits listed in
+// ExternalWeightOracleMaxConcurrentRequestsPerEndpoint, keyed by endpoint name.
+func (cfg Local) ExternalWeightOracleEndpointConcurrencyLimits() (map[string]uint64, error) {
+	return parseWeightOracleEndpointLimits("ExternalWeightOracleMaxConcurrentRequestsPerEndpoint", cfg.ExternalWeightOracleMaxConcurrentRequestsPerEndpoint)
+}
+
+// ExternalWeightOracleEndpointRateLimits returns the per-endpoint requests per second listed in
+// ExternalWeightOracleRateLimitPerEndpoint, keyed by endpoint name.
+func (cfg Local) ExternalWeightOracleEndpointRateLimits() (map[string]uint64, error) {
+	return parseWeightOracleEndpointLimits("ExternalWeightOracleRateLimitPerEndpoint", cfg.ExternalWeightOracleRateLimitPerEndpoint)
+}
+
+// parseWeightOracleEndpointLimits parses the endpoint=limit pairs of the setting called name.
+func parseWeightOracleEndpointLimits(name, setting string) (map[string]uint64, error) {
+	limits := make(map[string]uint64)
+	for _, pair := range strings.Split(setting, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		endpoint, value, ok := strings.Cut(pair, "=")
+		endpoint = strings.TrimSpace(endpoint)
+		if !ok || !slices.Contains(weightOracleEndpoints, endpoint) {
+			return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s entry %q must be <endpoint>=<limit> with endpoint one of %s",
+				name, pair, strings.Join(weightOracleEndpoints, ", "))}
+		}
+		if _, dup := limits[endpoint]; dup {
+			return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s lists %s more than once", name, endpoint)}
+		}
+		limit, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s entry %q has an invalid limit", name, pair)}
+		}
+		limits[endpoint] = limit
+	}
+	return limits, nil
+}
+
+// ExternalWeightOracleAcceptedProtocolVersionList returns the versions listed in
+// ExternalWeightOracleAcceptedProtocolVersions.
+func (cfg Local) ExternalWeightOracleAcceptedProtocolVersionList() ([]string, error) {
+	return parseWeightOracleVersions("ExternalWeightOracleAcceptedProtocolVersions", cfg.ExternalWeightOracleAcceptedProtocolVersions)
+}
+
+// ExternalWeightOracleAcceptedAlgorithmVersionList returns the versions listed in
+// ExternalWeightOracleAcceptedAlgorithmVersions.
+func (cfg Local) ExternalWeightOracleAcceptedAlgorithmVersionList() ([]string, error) {
+	return parseWeightOracleVersions("ExternalWeightOracleAcceptedAlgorithmVersions", cfg.ExternalWeightOracleAcceptedAlgorithmVersions)
+}
+
+// ExternalWeightOracleOverrideSignerList returns the addresses listed in
+// ExternalWeightOracleOverrideSigners.
+func (cfg Local) ExternalWeightOracleOverrideSignerList() []string {
+	var signers []string
+	for _, signer := range strings.Split(cfg.ExternalWeightOracleOverrideSigners, ",") {
+		if signer = strings.TrimSpace(signer); signer != "" {
+			signers = append(signers, signer)
+		}
+	}
+	return signers
+}
+
+// parseWeightOracleVersions parses a comma-separated list of major.minor[.patch]
+// versions.
+func parseWeightOracleVersions(name, setting string) ([]string, error) {
+	var versions []string
+	for _, version := range strings.Split(setting, ",") {
+		version = strings.TrimSpace(version)
+		if version == "" {
+			continue
+		}
+		parts := strings.Split(version, ".")
+		valid := len(parts) == 2 || len(parts) == 3
+		for _, part := range parts {
+			if _, err := strconv.ParseUint(part, 10, 64); err != nil {
+				valid = false
+			}
+		}
+		if !valid {
+			return nil, WeightOracleConfigError{msg: fmt.Sprintf("%s entry %q must be a major.minor or major.minor.patch version", name, version)}
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// ExternalWeightOracleRetryableErrorClasses returns the failure classes listed in
+// ExternalWeightOracleRetryableErrors.
+func (cfg Local) ExternalWeightOracleRetryableErrorClasses() ([]string, error) {
+	var classes []string
+	for _, class := range strings.Split(cfg.ExternalWeightOracleRetryableErrors, ",") {
+		class = strings.TrimSpace(class)
+		switch class {
+		case "":
+		case WeightOracleRetryConnect, WeightOracleRetryTimeout, WeightOracleRetryInternal, WeightOracleRetryUnavailable:
+			classes = append(classes, class)
+		default:
+			return nil, WeightOracleConfigError{msg: fmt.Sprintf("ExternalWeightOracleRetryableErrors contains unknown class %q", class)}
+		}
+	}
+	return classes, nil
+}
+
+// WeightOracleConfigError is an error type for external weight oracle configuration issues
+type WeightOracleConfigError struct {
+	msg string
+}
+
+func (e WeightOracleConfigError) Error() string {
+	return e.msg
+}
+
 // ensureAbsGenesisDir will convert a path to absolute, and will attempt to make a genesis directory there
 func ensureAbsGenesisDir(path string, genesisID string) (string, error) {
 	pathAbs, err := filepath.Abs(path)
@@ -20,154 +20,216 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    39,
-	AccountUpdatesStatsInterval:                5000000000,
-	AccountsRebuildSynchronousMode:             1,
-	AgreementIncomingBundlesQueueLength:        15,
-	AgreementIncomingProposalsQueueLength:      50,
-	AgreementIncomingVotesQueueLength:          20000,
-	AnnounceParticipationKey:                   true,
-	Archival:                                   false,
-	BaseLoggerDebugLevel:                       4,
-	BlockDBDir:                                 "",
-	BlockServiceCustomFallbackEndpoints:        "",
-	BlockServiceMemCap:                         500000000,
-	BroadcastConnectionsLimit:                  -1,
-	CadaverDirectory:                           "",
-	CadaverSizeTarget:                          0,
-	CatchpointDir:                              "",
-	CatchpointFileHistoryLength:                365,
-	CatchpointInterval:                         10000,
-	CatchpointTracking:                         0,
-	CatchupBlockDownloadRetryAttempts:          1000,
-	CatchupBlockValidateMode:                   0,
-	CatchupFailurePeerRefreshRate:              10,
-	CatchupGossipBlockFetchTimeoutSec:          4,
-	CatchupHTTPBlockFetchTimeoutSec:            4,
-	CatchupLedgerDownloadRetryAttempts:         50,
-	CatchupParallelBlocks:                      16,
-	ColdDataDir:                                "",
-	ConnectionsRateLimitingCount:               60,
-	ConnectionsRateLimitingWindowSeconds:       1,
-	CrashDBDir:                                 "",
-	DHTMode:                                    "",
-	DNSBootstrapID:                             "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)",
-	DNSSecurityFlags:                           9,
-	DeadlockDetection:                          0,
-	DeadlockDetectionThreshold:                 30,
-	DisableAPIAuth:                             false,
-	DisableLedgerLRUCache:                      false,
-	DisableLocalhostConnectionRateLimit:        true,
-	DisableNetworking:                          false,
-	DisableOutgoingConnectionThrottling:        false,
-	EnableAccountUpdatesStats:                  false,
-	EnableAgreementReporting:                   false,
-	EnableAgreementTimeMetrics:                 false,
-	EnableAssembleStats:                        false,
-	EnableBatchVerification:                    true,
-	EnableBlockService:                         false,
-	EnableDHTProviders:                         false,
-	EnableDeveloperAPI:                         false,
-	EnableExperimentalAPI:                      false,
-	EnableFollowMode:                           false,
-	EnableGossipBlockService:                   true,
-	EnableGossipService:                        true,
-	EnableIncomingMessageFilter:                false,
-	EnableLedgerService:                        false,
-	EnableMetricReporting:                      false,
-	EnableNetDevMetrics:                        false,
-	EnableOutgoingNetworkMessageFiltering:      true,
-	EnableP2P:                                  false,
-	EnableP2PHybridMode:                        false,
-	EnablePingHandler:                          true,
-	EnablePrivateNetworkAccessHeader:           false,
-	EnableProcessBlockStats:                    false,
-	EnableProfiler:                             false,
-	EnableRequestLogger:                        false,
-	EnableRuntimeMetrics:                       false,
-	EnableTopAccountsReporting:                 false,
-	EnableTxBacklogAppRateLimiting:             true,
-	EnableTxBacklogRateLimiting:                true,
-	EnableTxnEvalTracer:                        false,
-	EnableUsageLog:                             false,
-	EnableVerbosedTransactionSyncLogging:       false,
-	EnableVoteCompression:                      true,
-	EndpointAddress:                            "127.0.0.1:0",
-	ExternalWeightOraclePort:                   0,
-	FallbackDNSResolverAddress:                 "",
-	ForceFetchTransactions:                     false,
-	ForceRelayMessages:                         false,
-	GoMemLimit:                                 0,
-	GossipFanout:                               4,
-	HeartbeatUpdateInterval:                    600,
-	HotDataDir:                                 "",
-	IncomingConnectionsLimit:                   2400,
-	IncomingMessageFilterBucketCount:           5,
-	IncomingMessageFilterBucketSize:            512,
-	LedgerSynchronousMode:                      2,
-	LogArchiveDir:                              "",
-	LogArchiveMaxAge:                           "",
-	LogArchiveName:                             "node.archive.log",
-	LogFileDir:                                 "",
-	LogSizeLimit:                               1073741824,
-	MaxAPIBoxPerApplication:                    100000,
-	MaxAPIResourcesPerAccount:                  100000,
-	MaxAcctLookback:                            4,
-	MaxBlockHistoryLookback:                    0,
-	MaxCatchpointDownloadDuration:              43200000000000,
-	MaxConnectionsPerIP:                        8,
-	MinCatchpointFileDownloadBytesPerSecond:    20480,
-	NetAddress:                                 "",
-	NetworkMessageTraceServer:                  "",
-	NetworkProtocolVersion:                     "",
-	NodeExporterListenAddress:                  ":9100",
-	NodeExporterPath:                           "./node_exporter",
-	OptimizeAccountsDatabaseOnStartup:          false,
-	OutgoingMessageFilterBucketCount:           3,
-	OutgoingMessageFilterBucketSize:            128,
-	P2PHybridIncomingConnectionsLimit:          1200,
-	P2PHybridNetAddress:                        "",
-	P2PPersistPeerID:                           false,
-	P2PPrivateKeyLocation:                      "",
-	ParticipationKeysRefreshInterval:           60000000000,
-	PeerConnectionsUpdateInterval:              3600,
-	PeerPingPeriodSeconds:                      0,
-	PriorityPeers:                              map[string]bool{},
-	ProposalAssemblyTime:                       500000000,
-	PublicAddress:                              "",
-	ReconnectTime:                              60000000000,
-	ReservedFDs:                                256,
-	RestConnectionsHardLimit:                   2048,
-	RestConnectionsSoftLimit:                   1024,
-	RestReadTimeoutSeconds:                     15,
-	RestWriteTimeoutSeconds:                    120,
-	RunHosted:                                  false,
-	StatefulVoteCompressionTableSize:           2048,
-	StateproofDir:                              "",
-	StorageEngine:                              "sqlite",
-	SuggestedFeeBlockHistory:                   3,
-	SuggestedFeeSlidingWindowSize:              50,
-	TLSCertFile:                                "",
-	TLSKeyFile:                                 "",
-	TelemetryToLog:                             true,
-	TrackerDBDir:                               "",
-	TransactionSyncDataExchangeRate:            0,
-	TransactionSyncSignificantMessageThreshold: 0,
-	TxBacklogAppRateLimitingCongestionPct:      10,
-	TxBacklogAppRateLimitingCountERLDrops:      false,
-	TxBacklogAppTxPerSecondRate:                100,
-	TxBacklogAppTxRateLimiterMaxSize:           1048576,
-	TxBacklogRateLimitingCongestionPct:         50,
-	TxBacklogReservedCapacityPerPeer:           20,
-	TxBacklogServiceRateWindowSeconds:          10,
-	TxBacklogSize:                              26000,
-	TxIncomingFilterMaxSize:                    500000,
-	TxIncomingFilteringFlags:                   1,
-	TxPoolExponentialIncreaseFactor:            2,
-	TxPoolSize:                                 75000,
-	TxSyncIntervalSeconds:                      60,
-	TxSyncServeResponseSize:                    1000000,
-	TxSyncTimeoutSeconds:                       30,
-	UseXForwardedForAddressField:               "",
-	VerifiedTranscationsCacheSize:              150000,
+	Version:                                              39,
+	AccountUpdatesStatsInterval:                          5000000000,
+	AccountsRebuildSynchronousMode:                       1,
+	AgreementIncomingBundlesQueueLength:                  15,
+	AgreementIncomingProposalsQueueLength:                50,
+	AgreementIncomingVotesQueueLength:                    20000,
+	AllowRemoteWeightOracle:                              false,
+	AnnounceParticipationKey:                             true,
+	Archival:                                             false,
+	BaseLoggerDebugLevel:                                 4,
+	BlockDBDir:                                           "",
+	BlockServiceCustomFallbackEndpoints:                  "",
+	BlockServiceMemCap:                                   500000000,
+	BroadcastConnectionsLimit:                            -1,
+	CadaverDirectory:                                     "",
+	CadaverSizeTarget:                                    0,
+	CatchpointDir:                                        "",
+	CatchpointFileHistoryLength:                          365,
+	CatchpointInterval:                                   10000,
+	CatchpointTracking:                                   0,
+	CatchupBlockDownloadRetryAttempts:                    1000,
+	CatchupBlockValidateMode:                             0,
+	CatchupFailurePeerRefreshRate:                        10,
+	CatchupGossipBlockFetchTimeoutSec:                    4,
+	CatchupHTTPBlockFetchTimeoutSec:                      4,
+	CatchupLedgerDownloadRetryAttempts:                   50,
+	CatchupParallelBlocks:                                16,
+	ColdDataDir:                                          "",
+	ConnectionsRateLimitingCount:                         60,
+	ConnectionsRateLimitingWindowSeconds:                 1,
+	CrashDBDir:                                           "",
+	DHTMode:                                              "",
+	DNSBootstrapID:                                       "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)",
+	DNSSecurityFlags:                                     9,
+	DeadlockDetection:                                    0,
+	DeadlockDetectionThreshold:                           30,
+	DisableAPIAuth:                                       false,
+	DisableLedgerLRUCache:                                false,
+	DisableLocalhostConnectionRateLimit:                  true,
+	DisableNetworking:                                    false,
+	DisableOutgoingConnectionThrottling:                  false,
+	EnableAccountUpdatesStats:                            false,
+	EnableAgreementReporting:                             false,
+	EnableAgreementTimeMetrics:                           false,
+	EnableAssembleStats:                                  false,
+	EnableBatchVerification:                              true,
+	EnableBlockService:                                   false,
+	EnableBuiltinStakeWeightOracle:                       false,
+	EnableDHTProviders:                                   false,
+	EnableDeveloperAPI:                                   false,
+	EnableExperimentalAPI:                                false,
+	EnableFollowMode:                                     false,
+	EnableGossipBlockService:                             true,
+	EnableGossipService:                                  true,
+	EnableIncomingMessageFilter:                          false,
+	EnableLedgerService:                                  false,
+	EnableMetricReporting:                                false,
+	EnableNetDevMetrics:                                  false,
+	EnableOutgoingNetworkMessageFiltering:                true,
+	EnableP2P:                                            false,
+	EnableP2PHybridMode:                                  false,
+	EnablePingHandler:                                    true,
+	EnablePrivateNetworkAccessHeader:                     false,
+	EnableProcessBlockStats:                              false,
+	EnableProfiler:                                       false,
+	EnableRequestLogger:                                  false,
+	EnableRuntimeMetrics:                                 false,
+	EnableTopAccountsReporting:                           false,
+	EnableTxBacklogAppRateLimiting:                       true,
+	EnableTxBacklogRateLimiting:                          true,
+	EnableTxnEvalTracer:                                  false,
+	EnableUsageLog:                                       false,
+	EnableVerbosedTransactionSyncLogging:                 false,
+	EnableVoteCompression:                                true,
+	EndpointAddress:                                      "127.0.0.1:0",
+	ExternalWeightOracleAcceptedAlgorithmVersions:        "",
+	ExternalWeightOracleAcceptedProtocolVersions:         "",
+	ExternalWeightOracleAlgorithmVersionMatch:            "minor",
+	ExternalWeightOracleAuthToken:                        "",
+	ExternalWeightOracleBackpressureLatencyFactor:        4,
+	ExternalWeightOracleBackpressureQueueDepth:           128,
+	ExternalWeightOracleBackpressureWindow:               2000000000,
+	ExternalWeightOracleCacheEviction:                    "lru",
+	ExternalWeightOracleCircuitBreakerCooldown:           5000000000,
+	ExternalWeightOracleCircuitBreakerThreshold:          50,
+	ExternalWeightOracleCircuitBreakerWindow:             20,
+	ExternalWeightOracleConnectionOverflow:               "queue",
+	ExternalWeightOracleCrossCheckPolicy:                 "alert",
+	ExternalWeightOracleCrossCheckURLs:                   "",
+	ExternalWeightOracleDialTimeout:                      5000000000,
+	ExternalWeightOracleEncoding:                         "auto",
+	ExternalWeightOracleFailoverURLs:                     "",
+	ExternalWeightOracleFailureMode:                      "halt",
+	ExternalWeightOracleHTTP2:                            false,
+	ExternalWeightOracleHealthCheckInterval:              30000000000,
+	ExternalWeightOracleImportRounds:                     0,
+	ExternalWeightOracleLookupLogSampling:                0,
+	ExternalWeightOracleMaxAttempts:                      1,
+	ExternalWeightOracleMaxConcurrentRequests:            64,
+	ExternalWeightOracleMaxConcurrentRequestsPerEndpoint: "",
+	ExternalWeightOracleMaxConnections:                   64,
+	ExternalWeightOracleMaxTotalWeight:                   0,
+	ExternalWeightOracleMaxWeight:                        0,
+	ExternalWeightOracleMaxWeightRatioPPM:                0,
+	ExternalWeightOracleNotFoundCacheTTL:                 2000000000,
+	ExternalWeightOracleOverrideFile:                     "",
+	ExternalWeightOracleOverrideSigners:                  "",
+	ExternalWeightOraclePersistCache:                     false,
+	ExternalWeightOraclePort:                             0,
+	ExternalWeightOraclePrefetchRounds:                   2,
+	ExternalWeightOracleSubscribeRounds:                  0,
+	ExternalWeightOracleProtocol:                         "http",
+	ExternalWeightOracleProtocolVersionMatch:             "major",
+	ExternalWeightOracleQueryTimeout:                     10000000000,
+	ExternalWeightOracleRateLimitPerEndpoint:             "",
+	ExternalWeightOracleRetryBudget:                      0,
+	ExternalWeightOracleRetryInitialBackoff:              100000000,
+	ExternalWeightOracleRetryJitter:                      20,
+	ExternalWeightOracleRetryMaxBackoff:                  2000000000,
+	ExternalWeightOracleRetryableErrors:                  "connect,timeout",
+	ExternalWeightOracleSignedResponses:                  false,
+	ExternalWeightOracleSigningKey:                       "",
+	ExternalWeightOracleSocketOwner:                      -1,
+	ExternalWeightOracleStaleRounds:                      8,
+	ExternalWeightOracleStrictResponses:                  false,
+	ExternalWeightOracleTLSCAFile:                        "",
+	ExternalWeightOracleTLSClientCert:                    "",
+	ExternalWeightOracleTLSClientKey:                     "",
+	ExternalWeightOracleTLSPins:                          "",
+	ExternalWeightOracleTotalWeightCacheSize:             1000,
+	ExternalWeightOracleTraceRequests:                    false,
+	ExternalWeightOracleURL:                              "",
+	ExternalWeightOracleWarmConnections:                  0,
+	ExternalWeightOracleWeightCacheMaxSize:               0,
+	ExternalWeightOracleWeightCacheMinSize:               0,
+	ExternalWeightOracleWeightCacheSize:                  10000,
+	FallbackDNSResolverAddress:                           "",
+	ForceFetchTransactions:                               false,
+	ForceRelayMessages:                                   false,
+	GoMemLimit:                                           0,
+	GossipFanout:                                         4,
+	HeartbeatUpdateInterval:                              600,
+	HotDataDir:                                           "",
+	IncomingConnectionsLimit:                             2400,
+	IncomingMessageFilterBucketCount:                     5,
+	IncomingMessageFilterBucketSize:                      512,
+	LedgerSynchronousMode:                                2,
+	LogArchiveDir:                                        "",
+	LogArchiveMaxAge:                                     "",
+	LogArchiveName:                                       "node.archive.log",
+	LogFileDir:                                           "",
+	LogSizeLimit:                                         1073741824,
+	MaxAPIBoxPerApplication:                              100000,
+	MaxAPIResourcesPerAccount:                            100000,
+	MaxAcctLookback:                                      4,
+	MaxBlockHistoryLookback:                              0,
+	MaxCatchpointDownloadDuration:                        43200000000000,
+	MaxConnectionsPerIP:                                  8,
+	MinCatchpointFileDownloadBytesPerSecond:              20480,
+	NetAddress:                                           "",
+	NetworkMessageTraceServer:                            "",
+	NetworkProtocolVersion:                               "",
+	NodeExporterListenAddress:                            ":9100",
+	NodeExporterPath:                                     "./node_exporter",
+	OptimizeAccountsDatabaseOnStartup:                    false,
+	OutgoingMessageFilterBucketCount:                     3,
+	OutgoingMessageFilterBucketSize:                      128,
+	P2PHybridIncomingConnectionsLimit:                    1200,
+	P2PHybridNetAddress:                                  "",
+	P2PPersistPeerID:                                     false,
+	P2PPrivateKeyLocation:                                "",
+	ParticipationKeysRefreshInterval:                     60000000000,
+	PeerConnectionsUpdateInterval:                        3600,
+	PeerPingPeriodSeconds:                                0,
+	PriorityPeers:                                        map[string]bool{},
+	ProposalAssemblyTime:                                 500000000,
+	PublicAddress:                                        "",
+	ReconnectTime:                                        60000000000,
+	ReservedFDs:                                          256,
+	RestConnectionsHardLimit:                             2048,
+	RestConnectionsSoftLimit:                             1024,
+	RestReadTimeoutSeconds:                               15,
+	RestWriteTimeoutSeconds:                              120,
+	RunHosted:                                            false,
+	StatefulVoteCompressionTableSize:                     2048,
+	StateproofDir:                                        "",
+	StorageEngine:                                        "sqlite",
+	SuggestedFeeBlockHistory:                             3,
+	SuggestedFeeSlidingWindowSize:                        50,
+	TLSCertFile:                                          "",
+	TLSKeyFile:                                           "",
+	TelemetryToLog:                                       true,
+	TrackerDBDir:                                         "",
+	TransactionSyncDataExchangeRate:                      0,
+	TransactionSyncSignificantMessageThreshold:           0,
+	TxBacklogAppRateLimitingCongestionPct:                10,
+	TxBacklogAppRateLimitingCountERLDrops:                false,
+	TxBacklogAppTxPerSecondRate:                          100,
+	TxBacklogAppTxRateLimiterMaxSize:                     1048576,
+	TxBacklogRateLimitingCongestionPct:                   50,
+	TxBacklogReservedCapacityPerPeer:                     20,
+	TxBacklogServiceRateWindowSeconds:                    10,
+	TxBacklogSize:                                        26000,
+	TxIncomingFilterMaxSize:                              500000,
+	TxIncomingFilteringFlags:                             1,
+	TxPoolExponentialIncreaseFactor:                      2,
+	TxPoolSize:                                           75000,
+	TxSyncIntervalSeconds:                                60,
+	TxSyncServeResponseSize:                              1000000,
+	TxSyncTimeoutSeconds:                                 30,
+	UseXForwardedForAddressField:                         "",
+	VerifiedTranscationsCacheSize:                        150000,
 }
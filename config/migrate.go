@@ -164,6 +164,24 @@ func migrate(cfg Local) (newCfg Local, migrations []MigrationResult, err error)
 	return
 }
 
+// migrateWeightOraclePort moves a configured ExternalWeightOraclePort, which is deprecated,
+// into the ExternalWeightOracleURL that replaced it. Configs that already set the URL are left
+// alone, so that ValidateExternalWeightOracleConfig can report the conflict.
+func migrateWeightOraclePort(cfg Local) (newCfg Local, migrations []MigrationResult) {
+	newCfg = cfg
+	if cfg.ExternalWeightOraclePort == 0 || cfg.ExternalWeightOracleURL != "" {
+		return
+	}
+	endpoint, _ := cfg.ExternalWeightOracleEndpoint()
+	newCfg.ExternalWeightOracleURL = endpoint.String()
+	newCfg.ExternalWeightOraclePort = 0
+	migrations = []MigrationResult{
+		{FieldName: "ExternalWeightOraclePort", OldVersion: cfg.Version, NewVersion: cfg.Version, OldValue: uint64(cfg.ExternalWeightOraclePort), NewValue: uint64(0)},
+		{FieldName: "ExternalWeightOracleURL", OldVersion: cfg.Version, NewVersion: cfg.Version, OldValue: "", NewValue: newCfg.ExternalWeightOracleURL},
+	}
+	return
+}
+
 func getLatestConfigVersion() uint32 {
 	localType := reflect.TypeFor[Local]()
 	versionField, found := localType.FieldByName("Version")
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Prefixes of the secret references accepted by LoadSecret.
+const (
+	SecretFilePrefix = "file:"
+	SecretEnvPrefix  = "env:"
+)
+
+const redactedSecret = "[redacted]"
+
+// Secret holds credential material loaded by LoadSecret. Its printed and
+// JSON forms are redacted so that it cannot leak into logs or config dumps.
+type Secret struct {
+	value []byte
+}
+
+// Bytes returns the secret value.
+func (s Secret) Bytes() []byte {
+	return s.value
+}
+
+// IsEmpty reports whether no secret was loaded.
+func (s Secret) IsEmpty() bool {
+	return len(s.value) == 0
+}
+
+// String implements fmt.Stringer without revealing the value.
+func (s Secret) String() string {
+	return redactedSecret
+}
+
+// GoString implements fmt.GoStringer without revealing the value.
+func (s Secret) GoString() string {
+	return redactedSecret
+}
+
+// MarshalJSON encodes the secret as a redacted placeholder.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactedSecret)
+}
+
+// ValidateSecretRef checks that ref is a well-formed secret reference, without
+// loading it. Literal secret values are rejected: config.json is routinely
+// copied and shared, so it must only say where a secret lives.
+func ValidateSecretRef(ref string) error {
+	_, _, err := parseSecretRef(ref)
+	return err
+}
+
+func parseSecretRef(ref string) (prefix, target string, err error) {
+	for _, prefix = range []string{SecretFilePrefix, SecretEnvPrefix} {
+		if target, ok := strings.CutPrefix(ref, prefix); ok {
+			if target == "" {
+				return "", "", fmt.Errorf("secret reference %q names no %s", ref, strings.TrimSuffix(prefix, ":"))
+			}
+			return prefix, target, nil
+		}
+	}
+	// Do not echo ref here: it is most likely the secret itself.
+	return "", "", fmt.Errorf("secret reference must start with %q or %q; secret values may not be stored in the config file", SecretFilePrefix, SecretEnvPrefix)
+}
+
+// LoadSecret loads the secret that ref points to. "file:<path>" reads a file,
+// resolving a relative path against dataDir; on Unix the file must not be
+// accessible to group or others. "env:<NAME>" reads an environment variable.
+// Surrounding whitespace is trimmed. An empty ref yields an empty Secret.
+func LoadSecret(ref string, dataDir string) (Secret, error) {
+	if ref == "" {
+		return Secret{}, nil
+	}
+	prefix, target, err := parseSecretRef(ref)
+	if err != nil {
+		return Secret{}, err
+	}
+
+	var value string
+	switch prefix {
+	case SecretFilePrefix:
+		path := target
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dataDir, path)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return Secret{}, fmt.Errorf("cannot read secret file: %w", err)
+		}
+		if !info.Mode().IsRegular() {
+			return Secret{}, fmt.Errorf("secret file %s is not a regular file", path)
+		}
+		if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+			return Secret{}, fmt.Errorf("secret file %s has mode %04o; it must not be accessible to group or others (chmod 600)", path, info.Mode().Perm())
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Secret{}, fmt.Errorf("cannot read secret file: %w", err)
+		}
+		value = string(data)
+	case SecretEnvPrefix:
+		v, ok := os.LookupEnv(target)
+		if !ok {
+			return Secret{}, fmt.Errorf("secret environment variable %s is not set", target)
+		}
+		value = v
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Secret{}, fmt.Errorf("secret reference %q points to an empty value", ref)
+	}
+	return Secret{value: []byte(value)}, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestValidateSecretRef(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.NoError(t, ValidateSecretRef("file:oracle.token"))
+	require.NoError(t, ValidateSecretRef("env:ORACLE_TOKEN"))
+	require.Error(t, ValidateSecretRef("file:"))
+	require.Error(t, ValidateSecretRef("env:"))
+
+	err := ValidateSecretRef("s3cr3t-value")
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "s3cr3t-value")
+}
+
+func TestLoadSecretFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	path := filepath.Join(dataDir, "oracle.token")
+	require.NoError(t, os.WriteFile(path, []byte("s3cr3t-value\n"), 0600))
+
+	s, err := LoadSecret("file:oracle.token", dataDir)
+	require.NoError(t, err)
+	require.Equal(t, []byte("s3cr3t-value"), s.Bytes())
+
+	s, err = LoadSecret("file:"+path, "/nonexistent")
+	require.NoError(t, err)
+	require.Equal(t, []byte("s3cr3t-value"), s.Bytes())
+
+	_, err = LoadSecret("file:missing.token", dataDir)
+	require.Error(t, err)
+
+	empty := filepath.Join(dataDir, "empty.token")
+	require.NoError(t, os.WriteFile(empty, []byte(" \n"), 0600))
+	_, err = LoadSecret("file:empty.token", dataDir)
+	require.ErrorContains(t, err, "empty value")
+
+	if runtime.GOOS != "windows" {
+		require.NoError(t, os.Chmod(path, 0644))
+		_, err = LoadSecret("file:oracle.token", dataDir)
+		require.ErrorContains(t, err, "must not be accessible to group or others")
+		require.NotContains(t, err.Error(), "s3cr3t-value")
+	}
+}
+
+func TestLoadSecretEnv(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t.Setenv("TEST_LOAD_SECRET_ENV", "  s3cr3t-value ")
+	s, err := LoadSecret("env:TEST_LOAD_SECRET_ENV", "")
+	require.NoError(t, err)
+	require.Equal(t, []byte("s3cr3t-value"), s.Bytes())
+
+	_, err = LoadSecret("env:TEST_LOAD_SECRET_ENV_UNSET", "")
+	require.ErrorContains(t, err, "is not set")
+
+	s, err = LoadSecret("", "")
+	require.NoError(t, err)
+	require.True(t, s.IsEmpty())
+}
+
+func TestSecretRedacted(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s := Secret{value: []byte("s3cr3t-value")}
+	for _, out := range []string{
+		s.String(),
+		fmt.Sprintf("%v %+v %#v %s", s, s, s, s),
+		fmt.Sprintf("%+v", WeightOracleSecrets{AuthToken: s}),
+	} {
+		require.NotContains(t, out, "s3cr3t-value")
+	}
+	data, err := json.Marshal(WeightOracleSecrets{AuthToken: s})
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "s3cr3t-value")
+}
+
+// TestLoadExternalWeightOracleTLS tests that the CA file and client key pair
+// of the weight daemon connection are loaded from the data directory.
+func TestLoadExternalWeightOracleTLS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "algod"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+		KeyUsage:     x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	dataDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "ca.pem"), certPEM, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "algod.pem"), certPEM, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "algod.key"), keyPEM, 0600))
+
+	cfg := GetDefaultLocal()
+	secrets, err := cfg.LoadExternalWeightOracleSecrets(dataDir)
+	require.NoError(t, err)
+	require.Nil(t, secrets.TLSRootCAs)
+	require.Nil(t, secrets.TLSClientCertificate)
+
+	cfg.ExternalWeightOracleTLSCAFile = "ca.pem"
+	cfg.ExternalWeightOracleTLSClientCert = "algod.pem"
+	cfg.ExternalWeightOracleTLSClientKey = "file:algod.key"
+	secrets, err = cfg.LoadExternalWeightOracleSecrets(dataDir)
+	require.NoError(t, err)
+	require.NotNil(t, secrets.TLSRootCAs)
+	require.Equal(t, [][]byte{der}, secrets.TLSClientCertificate.Certificate)
+
+	// The CA file must hold certificates, and the key must match.
+	cfg.ExternalWeightOracleTLSCAFile = "algod.key"
+	_, err = cfg.LoadExternalWeightOracleSecrets(dataDir)
+	require.ErrorContains(t, err, "contains no PEM certificates")
+	cfg.ExternalWeightOracleTLSCAFile = ""
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherDER, err := x509.MarshalECPrivateKey(other)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "algod.key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: otherDER}), 0600))
+	_, err = cfg.LoadExternalWeightOracleSecrets(dataDir)
+	require.ErrorContains(t, err, "ExternalWeightOracleTLSClientKey")
+}
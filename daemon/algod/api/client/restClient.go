@@ -25,6 +25,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/test/e2e-go/globals"
@@ -619,6 +621,13 @@ func (client RestClient) Block(round basics.Round) (response v2.BlockResponseJSO
 	return
 }
 
+// BlockProposerWeight gets the external weight the proposer of the block for
+// the given round had
+func (client RestClient) BlockProposerWeight(round basics.Round) (response model.BlockProposerWeightResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d/proposer-weight", round), nil)
+	return
+}
+
 // RawBlock gets the encoded, raw msgpack block for the given round
 func (client RestClient) RawBlock(round basics.Round) (response []byte, err error) {
 	var blob Blob
@@ -667,6 +676,52 @@ func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string,
 	return
 }
 
+// WeightOracleBundle gets a gzipped tar archive of the node's weight daemon
+// diagnostics. It requires the admin API token.
+func (client RestClient) WeightOracleBundle(ctx context.Context) (bundle []byte, err error) {
+	body, err := client.doGetWithQuery(ctx, "/debug/weightoracle/bundle", nil)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(body), nil
+}
+
+// WeightCacheExport gets the weight daemon answers the node has cached for
+// balance rounds from since on, as JSON in the format of
+// weightoracle.CacheExport. It requires the admin API token.
+func (client RestClient) WeightCacheExport(ctx context.Context, since basics.Round) (export []byte, err error) {
+	params := map[string]string{"since": strconv.FormatUint(uint64(since), 10)}
+	body, err := client.doGetWithQuery(ctx, "/debug/weightoracle/cache", params)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(body), nil
+}
+
+type externalWeightParams struct {
+	Round uint64 `url:"round,omitempty"`
+}
+
+// ExternalWeight gets the weight the node's weight oracle gives addr for the
+// given round, or for the round after the latest if round is 0. It requires
+// the admin API token.
+func (client RestClient) ExternalWeight(round basics.Round, addr basics.Address) (response node.ExternalWeightLookup, err error) {
+	err = client.get(&response, "/debug/weightoracle/weight/"+addr.String(), externalWeightParams{Round: uint64(round)})
+	return
+}
+
+type totalExternalWeightParams struct {
+	BalanceRound uint64 `url:"balance-round"`
+	VoteRound    uint64 `url:"vote-round"`
+}
+
+// TotalExternalWeight gets the total weight the node's weight oracle gives for
+// the given balance and vote rounds. It requires the admin API token.
+func (client RestClient) TotalExternalWeight(balanceRound, voteRound basics.Round) (response node.TotalExternalWeightLookup, err error) {
+	err = client.get(&response, "/debug/weightoracle/total", totalExternalWeightParams{BalanceRound: uint64(balanceRound), VoteRound: uint64(voteRound)})
+	return
+}
+
 type compileParams struct {
 	SourceMap bool `url:"sourcemap,omitempty"`
 }
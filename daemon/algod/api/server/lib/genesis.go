@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lib
+
+import (
+	"encoding/json"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// GenesisWithWeightMetadata returns genesisText, the genesis file of genesis,
+// with the network's weighting regime added so that clients can discover it
+// from the /genesis endpoint: whether the genesis protocol selects committees
+// by external weight, and the weight daemon versions the node expects.
+func GenesisWithWeightMetadata(genesisText string, genesis bookkeeping.Genesis) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(genesisText), &fields); err != nil {
+		return "", err
+	}
+
+	metadata := map[string]interface{}{
+		"external-weighting":       config.Consensus[genesis.Proto].EnableExternalWeightOracle,
+		"weight-algorithm-version": ledgercore.ExpectedWeightAlgorithmVersion,
+		"weight-protocol-version":  ledgercore.ExpectedWeightProtocolVersion,
+	}
+	for key, value := range metadata {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		fields[key] = encoded
+	}
+
+	text, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
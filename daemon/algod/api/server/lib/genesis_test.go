@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lib
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGenesisWithWeightMetadata(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "v1",
+		Network:     "testnet",
+		Proto:       protocol.ConsensusCurrentVersion,
+		FeeSink:     "fees",
+		RewardsPool: "rwd",
+		Timestamp:   1234,
+		Comment:     "weighted",
+	}
+	genesisText := string(protocol.EncodeJSON(genesis))
+
+	text, err := GenesisWithWeightMetadata(genesisText, genesis)
+	require.NoError(t, err)
+	var response model.Genesis
+	require.NoError(t, json.Unmarshal([]byte(text), &response))
+	// The genesis file is served unchanged alongside the metadata.
+	require.Equal(t, "testnet", response.Network)
+	require.Equal(t, string(protocol.ConsensusCurrentVersion), response.Proto)
+	require.Equal(t, "weighted", *response.Comment)
+	require.EqualValues(t, 1234, response.Timestamp)
+	require.True(t, *response.ExternalWeighting)
+	require.Equal(t, ledgercore.ExpectedWeightAlgorithmVersion, *response.WeightAlgorithmVersion)
+	require.Equal(t, ledgercore.ExpectedWeightProtocolVersion, *response.WeightProtocolVersion)
+
+	// A protocol the node does not know does not select by external weight.
+	genesis.Proto = "unknown"
+	text, err = GenesisWithWeightMetadata(string(protocol.EncodeJSON(genesis)), genesis)
+	require.NoError(t, err)
+	response = model.Genesis{}
+	require.NoError(t, json.Unmarshal([]byte(text), &response))
+	require.False(t, *response.ExternalWeighting)
+
+	_, err = GenesisWithWeightMetadata("not json", genesis)
+	require.Error(t, err)
+}
@@ -18,9 +18,11 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -37,8 +39,10 @@ import (
 	nppublic "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/nonparticipating/public"
 	pprivate "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/participating/private"
 	ppublic "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/participating/public"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/weightoracle"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -64,6 +68,126 @@ func wrapCtx(ctx lib.ReqContext, handler func(lib.ReqContext, echo.Context)) ech
 	}
 }
 
+// weightOracleDiagnoser is implemented by nodes whose weight daemon client can
+// be inspected for a bug report.
+type weightOracleDiagnoser interface {
+	WeightOracleDiagnostics() (*weightoracle.Diagnostics, error)
+}
+
+// weightOracleBundleHandler serves the node's weight daemon diagnostics as a
+// gzipped tar archive.
+func weightOracleBundleHandler(node weightOracleDiagnoser) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		d, err := node.WeightOracleDiagnostics()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		ctx.Response().Header().Set(echo.HeaderContentType, "application/gzip")
+		ctx.Response().WriteHeader(http.StatusOK)
+		return d.WriteBundle(ctx.Response())
+	}
+}
+
+// weightCacheExporter is implemented by nodes that can export the weight
+// daemon answers they have cached.
+type weightCacheExporter interface {
+	ExportWeightCache(since basics.Round) (weightoracle.CacheExport, error)
+}
+
+// weightCacheExportHandler serves the node's cached weight daemon answers for
+// the balance rounds from the since query parameter on, by default all of
+// them, in the format a replacement daemon can be seeded with.
+func weightCacheExportHandler(node weightCacheExporter) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var since uint64
+		if s := ctx.QueryParam("since"); s != "" {
+			var err error
+			since, err = strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid since round: %v", err))
+			}
+		}
+		export, err := node.ExportWeightCache(basics.Round(since))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return ctx.JSON(http.StatusOK, export)
+	}
+}
+
+// weightLookerUp is implemented by nodes that can look up the external weights
+// their weight oracle gives.
+type weightLookerUp interface {
+	LookupExternalWeight(rnd basics.Round, addr basics.Address) (node.ExternalWeightLookup, error)
+	LookupTotalExternalWeight(balanceRound, voteRound basics.Round) (node.TotalExternalWeightLookup, error)
+}
+
+// roundQueryParam parses the round in query parameter name, which is 0 if the
+// parameter is absent.
+func roundQueryParam(ctx echo.Context, name string) (basics.Round, error) {
+	s := ctx.QueryParam(name)
+	if s == "" {
+		return 0, nil
+	}
+	rnd, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s: %v", name, err))
+	}
+	return basics.Round(rnd), nil
+}
+
+// weightLookupError maps a failed weight lookup to the HTTP error to answer.
+func weightLookupError(err error) error {
+	if errors.Is(err, node.ErrExternalWeightUnavailable) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+}
+
+// externalWeightHandler serves the weight the node's weight oracle gives an
+// account for the round in the round query parameter, by default the round
+// after the latest, for operators debugging weight discrepancies.
+func externalWeightHandler(l weightLookerUp) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		addr, err := basics.UnmarshalChecksumAddress(ctx.Param("address"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid address: %v", err))
+		}
+		rnd, err := roundQueryParam(ctx, "round")
+		if err != nil {
+			return err
+		}
+		w, err := l.LookupExternalWeight(rnd, addr)
+		if err != nil {
+			return weightLookupError(err)
+		}
+		return ctx.JSON(http.StatusOK, w)
+	}
+}
+
+// totalExternalWeightHandler serves the total weight the node's weight oracle
+// gives for the balance-round and vote-round query parameters.
+func totalExternalWeightHandler(l weightLookerUp) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		balanceRound, err := roundQueryParam(ctx, "balance-round")
+		if err != nil {
+			return err
+		}
+		voteRound, err := roundQueryParam(ctx, "vote-round")
+		if err != nil {
+			return err
+		}
+		if voteRound == 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "vote-round is required")
+		}
+		total, err := l.LookupTotalExternalWeight(balanceRound, voteRound)
+		if err != nil {
+			return weightLookupError(err)
+		}
+		return ctx.JSON(http.StatusOK, total)
+	}
+}
+
 // registerHandlers registers a set of Routes to the given router.
 func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx lib.ReqContext, m ...echo.MiddlewareFunc) {
 	for _, route := range routes {
@@ -127,6 +251,16 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		e.GET("/debug/pprof/*", echo.WrapHandler(http.DefaultServeMux), adminMiddleware...)
 		e.GET(fmt.Sprintf("%s/debug/pprof/*", middlewares.URLAuthPrefix), echo.WrapHandler(http.DefaultServeMux), adminMiddleware...)
 	}
+	if diagnoser, ok := node.(weightOracleDiagnoser); ok {
+		e.GET("/debug/weightoracle/bundle", weightOracleBundleHandler(diagnoser), adminMiddleware...)
+	}
+	if exporter, ok := node.(weightCacheExporter); ok {
+		e.GET("/debug/weightoracle/cache", weightCacheExportHandler(exporter), adminMiddleware...)
+	}
+	if l, ok := node.(weightLookerUp); ok {
+		e.GET("/debug/weightoracle/weight/:address", externalWeightHandler(l), adminMiddleware...)
+		e.GET("/debug/weightoracle/total", totalExternalWeightHandler(l), adminMiddleware...)
+	}
 	// Registering common routes (no auth)
 	registerHandlers(e, "", common.Routes, ctx)
 
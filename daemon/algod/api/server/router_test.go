@@ -16,16 +16,24 @@
 package server
 
 import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v1/routes"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/weightoracle"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -76,3 +84,123 @@ func TestGetTransactionV1Sunset(t *testing.T) {
 		assert.Equal(t, http.StatusGone, rec.Code)
 	}
 }
+
+type testDiagnoser struct {
+	d   *weightoracle.Diagnostics
+	err error
+}
+
+func (n testDiagnoser) WeightOracleDiagnostics() (*weightoracle.Diagnostics, error) {
+	return n.d, n.err
+}
+
+func TestWeightOracleBundleHandler(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/debug/weightoracle/bundle", nil)
+
+	rec := httptest.NewRecorder()
+	d := &weightoracle.Diagnostics{Time: time.Now(), Version: "test"}
+	assert.NoError(t, weightOracleBundleHandler(testDiagnoser{d: d})(e.NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/gzip", rec.Header().Get(echo.HeaderContentType))
+	gr, err := gzip.NewReader(rec.Body)
+	assert.NoError(t, err)
+	header, err := tar.NewReader(gr).Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "manifest.json", header.Name)
+
+	rec = httptest.NewRecorder()
+	err = weightOracleBundleHandler(testDiagnoser{err: errors.New("no daemon")})(e.NewContext(req, rec))
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+}
+
+// testExporter exports a cache holding one total weight at balance round 5.
+type testExporter struct {
+	err error
+}
+
+func (n testExporter) ExportWeightCache(since basics.Round) (weightoracle.CacheExport, error) {
+	export := weightoracle.CacheExport{Weights: map[string]uint64{}, TotalWeights: map[string]uint64{}}
+	if since <= 5 {
+		export.TotalWeights["5:325"] = 100
+	}
+	return export, n.err
+}
+
+func TestWeightCacheExportHandler(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	get := func(exporter testExporter, query string) *httptest.ResponseRecorder {
+		e := echo.New()
+		e.GET("/debug/weightoracle/cache", weightCacheExportHandler(exporter))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/weightoracle/cache"+query, nil))
+		return rec
+	}
+
+	rec := get(testExporter{}, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"weights":{},"total_weights":{"5:325":100}}`, rec.Body.String())
+
+	rec = get(testExporter{}, "?since=6")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"weights":{},"total_weights":{}}`, rec.Body.String())
+
+	assert.Equal(t, http.StatusBadRequest, get(testExporter{}, "?since=six").Code)
+	assert.Equal(t, http.StatusNotFound, get(testExporter{err: errors.New("no daemon")}, "").Code)
+}
+
+// testWeightLookerUp weighs every account 10 at round 7 and totals 100 at
+// balance round 3, and fails to reach its daemon for round 13.
+type testWeightLookerUp struct{}
+
+func (testWeightLookerUp) LookupExternalWeight(rnd basics.Round, addr basics.Address) (node.ExternalWeightLookup, error) {
+	switch rnd {
+	case 0, 7:
+		return node.ExternalWeightLookup{Round: 7, Address: addr, BalanceRound: 3, Eligible: true, SelectionID: []byte{9}, Weight: 10}, nil
+	case 13:
+		return node.ExternalWeightLookup{}, errors.New("daemon down")
+	}
+	return node.ExternalWeightLookup{}, fmt.Errorf("%w: no such round", node.ErrExternalWeightUnavailable)
+}
+
+func (testWeightLookerUp) LookupTotalExternalWeight(balanceRound, voteRound basics.Round) (node.TotalExternalWeightLookup, error) {
+	if balanceRound != 3 {
+		return node.TotalExternalWeightLookup{}, errors.New("daemon down")
+	}
+	return node.TotalExternalWeightLookup{BalanceRound: balanceRound, VoteRound: voteRound, TotalWeight: 100}, nil
+}
+
+func TestExternalWeightHandlers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	e.GET("/debug/weightoracle/weight/:address", externalWeightHandler(testWeightLookerUp{}))
+	e.GET("/debug/weightoracle/total", totalExternalWeightHandler(testWeightLookerUp{}))
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	addr := basics.Address{1}.String()
+	for _, query := range []string{"", "?round=7"} {
+		rec := get("/debug/weightoracle/weight/" + addr + query)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, fmt.Sprintf(`{"round":7,"address":%q,"balance-round":3,"eligible":true,"selection-id":"CQ==","weight":10}`, addr), rec.Body.String())
+	}
+	assert.Equal(t, http.StatusNotFound, get("/debug/weightoracle/weight/"+addr+"?round=8").Code)
+	assert.Equal(t, http.StatusServiceUnavailable, get("/debug/weightoracle/weight/"+addr+"?round=13").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/debug/weightoracle/weight/"+addr+"?round=seven").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/debug/weightoracle/weight/nobody").Code)
+
+	rec := get("/debug/weightoracle/total?balance-round=3&vote-round=323")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"balance-round":3,"vote-round":323,"total-weight":100}`, rec.Body.String())
+	assert.Equal(t, http.StatusServiceUnavailable, get("/debug/weightoracle/total?balance-round=4&vote-round=324").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/debug/weightoracle/total?balance-round=3").Code)
+}
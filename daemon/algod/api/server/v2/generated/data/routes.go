@@ -115,226 +115,240 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a5MbN5LgX0FwN0KWjmRLsuwZ62Jir2350WvZUqjbnttV62ywKkliugjUACg2aV3/",
-	"9wskHoWqQpFFNiXbF/NJahYeiUQikcjn+1EmVqXgwLUaPX8/KqmkK9Ag8S+a5xIU/jcHlUlWaib46Pno",
-	"nBOaZaLimpTVrGAZuYHtdDQeMfO1pHo5Go84XcHoeRhkPJLwz4pJyEfPtaxgPFLZElbUTqs1SNP37fnk",
-	"vx9Pvnj3/rO/3o3GI70tzRhKS8YXo/FoM1mIiftxRhXL1PTcjX+37ysty4Jl1CxhwvL0ouomhOXANZsz",
-	"kH0La463a30rxtmqWo2ePw5LYlzDAmTPmsryguew6VtU9JkqBbp3PebjgJX4MU66BjPozlU0GmRUZ8tS",
-	"MK4TKyH4ldjPySVE3XctYi7kiup2+4j8kPaejJ88vvu3QIpPxp99miZGWiyEpDyfhHG/CuOSS9vu7oCG",
-	"/msbAV8JPmeLSoIit0vQS5BEL4FIUKXgCoiY/QMyTZgi/3n56kciJPkBlKILeE2zGwI8EznkU3IxJ1xo",
-	"UkqxZjnkY5LDnFaFVkQL7Bno458VyG2NXQdXjEnghhbejv6hBB+NRyu1KGl2M3rXRtPd3XhUsBVLrOoH",
-	"ujEURXi1moEkYm4W5MGRoCvJ+wCyI8bw7CTJinH9+bM2Hda/ruimC96VrHhGNeQRgFpSrmhmWiCUOVNl",
-	"QbeI2hXd/O3x2AGuCC0KUgLPGV8QveGqbylm7pMthMMmgeirJRDzhZR0ARGep+QnBUhJ+FWLG+CBOshs",
-	"i59KCWsmKhU69awDp04sJKIDKSqeYlQEPzg09/Ao2/eUDOoNjni3+5tiC/epDfUlW1xtSyBzVpj7kvyj",
-	"UjoQcKVw25dAVAmZ4b05McMY5Cu24FRXEp5f80fmLzIhl5rynMrc/LKyP/1QFZpdsoX5qbA/vRQLll2y",
-	"Rc8OBFhT51Rht5X9x4yXPqp6k7xLXgpxU5XxgrL4LBhauXjRRxl2zH7SSDPI8yA34P64sa42Fy/6WOru",
-	"HnoTNrIHyF7cldQ0vIGtBAMtzeb4z2aOpEXn8reRFS9Mb13OU6g15O/YNQpU51Z+Oq+FiDfus/maCa7B",
-	"XoWRmHGGzPb5+1hykqIEqZkdlJblpBAZLSZKU40j/buE+ej56N/OakHvzHZXZ9HkL02vS+xkLmMJhvFN",
-	"aFkeMMZrIzyiqNVz0A0fskd9LiS5XbJsSfSSKcK43USUuwynKWBNuZ6ODjrJdzF3eOuAqLfCXpJ2K1oM",
-	"qHcviG04A4W074TeB6ohKSLGCWKcUJ6TRSFm4YdPzsuyRi5+Py9Li6oxYXMCDO9z2DCl1UPEDK0PWTzP",
-	"xYsp+TYe+5YVBRG82JIZuHsHcjOm5duOjzsB3CAW11CP+EAR3Gkhp2bXPBqMXHYKYkSpcikKcwXuJSPT",
-	"+DvXNqZA8/ugzn966ovR3k93KNE7pCI12V/qhxv5pEVUXZrCHoaaztt9j6MoM8oOWlIXNYJPTVf4C9Ow",
-	"UnuJJIIoIjS3PVRKuvUS1AQloS4F/aTAEk9JF4wjtGMjkHOyojd2PwTi3RACqCBpWzKz4tUt08ta5Aqo",
-	"n3beF39uQk7tOTEbTpmRjUnBlDbCEG6mIksoUOCkQbEQU9FRRDOAFnYsIsB8K2lpydx9sXIc44SG95eF",
-	"9Z43+cBLNglzrLao8Y5QHc3M9zLcJCRW4dCE4ctCZDffUbU8weGf+bG6xwKnIUugOUiypGqZOFMt2q5H",
-	"G0LfpiHSLJlFU03DEl+KhTrBEgtxCFcry69oUZipu9ystVoceNBBLgpiGhNYMW0ewIzjCViwNXDLeqbk",
-	"a5otjTBBMloU41ovIcpJAWsoiJCEcQ5yTPSS6vrw48j+oYTnSIHhgxpItBqn05iSqyVImAuJD1UJZEXx",
-	"clqZ51FZNPsE5qroClqyE16WotIGxujlcvHCrw7WwJEnhaER/LBGfPDHg0/N3O4TzsyFXRyVgIoWxrOi",
-	"ymv8BX7RANq0rq9aXk8hZI6KHqrNb0ySTEg7hL383eTmP0Bl3dlS5yelhIkbQtI1SEULs7rWoh4G8j3V",
-	"6dxzMnOqaXQyHRWmX3SWc2A/FApBJrQbr/A/tCDmsxFwDCXV1MNQTkGZJuwH3tkGVXYm08DwLS3IyurN",
-	"SEmzm4Og/KqePM1mBp28r62qzm2hW0TYoasNy9WptgkH69ur5gmxOh/Pjjpiyk6mE801BAFXoiSWfbRA",
-	"sJwCR7MIEZuTX2tfik0Kpi/FpnOliQ2cZCfMOIOZ/Zdi88JBJuR+zOPYQ5BuFsjpChTebg0ziJmlVlWf",
-	"z4Q8TpromCZqBTyhZtRImBq3kIRNq3LizmZCPW4btAYiQb20WwhoD5/CWAMLl5p+ACwoM+opsNAc6NRY",
-	"EKuSFXAC0l8mhbgZVfDpU3L53flnT57+8vSzzw1JllIsJF2R2VaDIp84PR9RelvAw+TDCaWL9OifP/MG",
-	"kea4qXGUqGQGK1p2h7KGFvswts2IadfFWhPNuOoA4CCOCOZqs2gnb2y/u/HoBcyqxSVobR7Br6WYn5wb",
-	"dmZIQYeNXpfSCBaqaZRy0tJZbpqcwUZLelZiS+C5Nb2ZdTBl3oCr2UmIqm/j83qWnDiM5rD3UBy6TfU0",
-	"23ir5FZWp9B8gJRCJq/gUgotMlFMjJzHREJ38dq1IK6F366y/buFltxSRczcaACreN6jotAbPvz+skNf",
-	"bXiNm503mF1vYnVu3iH70kR+/QopQU70hhOkzobmZC7FilCSY0eUNb4FbeUvtoJLTVflq/n8NDpSgQMl",
-	"VDxsBcrMRGwLI/0oyATP1V5tjrcGtpDpphqCsza2vC1L90Pl0HS55RmqkU5xlvu1X87UR9SWZ5EqzMBY",
-	"QL5o0OoHVXn1YcpC8UAlIDWYeomf0SLwAgpNvxHyqhZ3v5WiKk/OzttzDl0OdYtxNofc9PUaZcYXBTQk",
-	"9YWBfZpa4++yoK+C0sGuAaFHYn3JFksdvS9fS/EB7tDkLClA8YNVLhWmT1fF9KPIDfPRlTqB6FkPVnNE",
-	"Q7cxH6QzUWlCCRc54OZXKi2U9njtmIOaVVIC17Gci/oMpsgMDHVltDKrrUqiRep+qTtOaGZP6ARRo3rc",
-	"HIKrhm1lp1vSNRBaSKD5lswAOBEzs+jaywEXSRUpjezsxDonEg/ltw1gSykyUAryidNn74XXt7P3j96B",
-	"PFwNriLMQpQgcyo/zApu1nuBv4HtZE2Lyojn3/+sHv5RFqGFpsWeLcA2qY1oq++6S7kHTLuIuA1RTMpW",
-	"W2hPghGxDdMpQEMfsu+Pvd7tb4PZIYIPhMA1SPSo+aBHy0/yAYgywP+BD9YHWUJVTowY2Kt+MJKr2W9O",
-	"ufCy4Z4ZwgQFVXqy70oxjRp6E7PUiIunbhEcuEeefEmVRjGQMJ6j/tZehTiPlS3NFKMDncpwyt7XmJn0",
-	"Z/8Q606bmeudq0qFV5mqylJIDXlqeWiz7p3rR9iEucQ8Gjs8/bQglYJ9I/chMBrf4dEpAvAPqoOF2tm8",
-	"u4tDrwMjvmwPxXIDvhpHu2C89K0ixMdOtT0wMlXvgSU3plr0NhOiAIoqU6VFWRoOpScVD/36MHhpW5/r",
-	"n+q2XZK0ZiArqeQCFJqYXHsH+a1FukJb15Iq4uDw/gmo8LIucl2YzbGeKMYzmOw6L/gINq3ig3PUca/K",
-	"haQ5THIo6DbhbWE/E/v5QMLwYyOB1PoDoWEyQ2timkbqM+H9TY+bVeBUKiV4E/xCMnPOzTOqJjXX+/hJ",
-	"c8BpU3zTEeuDMAuCkaQDPx4iy9JTYkS8+9dCG7JyRIercbfSPdfSg70w6wdBII47qRUB7dn/C5SbOwhg",
-	"J51/C6pv4fXUp1p2j/of7/bGhdm6ylq3TfKK6OXLexhjHw/qsUW8plKzjJX4XP0etid/vbcnSPpKkBw0",
-	"ZQXkJPpgX/Jl3J9YN+T2mMe95gepW7vgd/StieV4z6wm8DewRbXJaxvREGmrTqGOSIxqLlzKCQLqvebN",
-	"iyduAhua6WJrBFu9hC25BQlEVTPrtdI1oWlRTuIB0jFT/TM6g3zSHL7TQ+ASh4qWl/I8tK+t3fBdtZ5c",
-	"DXS4V1YpRJHQf7ZPfAcZSQgGuQuRUphdZ7QotkSHsBlPSQ0g3QWB3hhBnnmgGmjGFZD/EhXJKMcXbqUh",
-	"CGlCouSDwrKZwYibYU7nqlpjCApYgX3N45dHj9oLf/TI7TlTZA631uWGY8M2Oh49QlXca6F043CdQNtt",
-	"jttF4tJBW6W5ZN2rrc1T9ju5uZGH7OTr1uDBwGnOlFKOcM3y780AWidzM2TtMY0Mc/DDcQeZ75ouYZ11",
-	"475fslVVUH0KQyWsaTERa5CS5bCXk7uJmeBfr2nxKnS7G49gA5mh0QwmGUYJDhwLrkwfG1hoxmGcmQNs",
-	"A0eGAgQXttel7bTnpV37LbPVCnJGNRRbUkrIwEbJGSlVhaVOiQ2ZyJaUL/AFJEW1cK7Odhxk+JWymjBZ",
-	"8c4Qh4piesMnaMJQyTA1NFv6aEsjhAE1L9u2/cM+1m5pAMVeRoMu7Wh72vagpMl0POp9+Bt8r+uHv8Vb",
-	"M2T0WGNiQz6MkFZDM9B6hvg0slIXifE2msNniOHDWGnqoVNQdieOnMLrj31+4ZdVWRbbEwhJdiAioZSg",
-	"8EqL1YDKfhVz8gPLpDgvFiLceWqrNKy6xhvb9Zee4/rmmBew4AXjMFkJDokn/Sv8+gN+HKx2tNdwz4go",
-	"EB00YPvh00BCawHNyYeQ9H03CUmmffbblk71jZCnsrLbAQe/KQZYrve6dbgpj7Wv06JImKSt+qHDRdQ4",
-	"OIUzSahSImMoKF7kauy8z60V27q1t9D/OoRGneAAt8dt2V6jMCyryIeiJJRkBUM1v+BKyyrT15yipi9a",
-	"asJZ0CsH+tXCX/kmaT10Qk3shrrmFB1Fg/4v6Rg0h4Qe6hsArx1W1WIBSrceWHOAa+5aMU4qzjTOtTLH",
-	"ZWLPSwkSPfamtuWKbsnc0IQW5DeQgswq3XxyrCqlidKsKJwh2ExDxPyaU00KoEqTHxi/2uBw3o/EH1kO",
-	"+lbIm4CF6XDGtQAOiqlJ2tPxW/sVg0ocTpYuwARjLexn7/Fc54YYmbU3klb8n0/+4/nb88l/08lvjydf",
-	"/I+zd++f3T181Pnx6d3f/vZ/mz99eve3h//x76nt87CngsEd5Bcv3Bv94gU+xKI4kTbsfwSDzIrxSZIo",
-	"Y4eiFi2STzBfhiO4h029n17CNdcbbghvTQuWG150MvJpX1OdA22PWIvKGhvXUuN5BBz4HLoHqyIJTtXi",
-	"rx9EnmtPsNPhJt7yVoyB44zq5AC6gVNwtedMudU++PbrK3LmCEE9QGJxQ0epBRIvGBfB2PDyMbsUB3Zd",
-	"82v+Aub4HhT8+TXPqaZn9jSdVQrkl7SgPIPpQpDnPijyBdX0mneuod4EUlFQc5RBKsUp6Cq9luvrt7RY",
-	"iOvrdx0/hK5s5aaKuag7Z101mZ9yYuQGUemJS+IykXBLZcoW4lN8uGho7L0TDiuTiMoqsXySGDf+dCiU",
-	"ZanayR66KCrLwqAoIlXl8hWYbSVKixA4Zpi5i701NPCjcE4lkt76J2+lQJFfV7R8y7h+RybX1ePHn2II",
-	"Xp3i4FfHAw3dbksY/PDtTUbRfu/iwq1cjk7lk5IuUjaT6+u3GmiJFIICxwpfmkVBsFsjPNBHAuBQ9QJC",
-	"LPIBW2IhOziuF5d7aXv5tF7pReEn3NRm7PS9djCKij96A/dE1tNKLyeGIyRXpcwx8HvlEwzQhblyvAeB",
-	"Ygt8AKilqMySgWRLyG5cZitYlXo7bnT3ji7uLvYMhynUGbngwDkz+MsoNwNWZU6dIEP5tp3iRtlgCBz0",
-	"DdzA9krY7tOB2cGibHRRihXVd3SRdqO71pBvfJDdGO3Nd35XPkbUpSPBuEtPFs8DXfg+/UfbCgAnONYp",
-	"omjk+ehDBJUJRFji70HBEQs1492L9FPLYzwDrtkaJlCwBZsVCTb9965dw8NqqFJCBmzto3rDgIqwOTGv",
-	"o5m9jt2LSVK+AHOpm4tYKFqg0/40aehH6XAJVOoZUL1TX8vjNBMeOhTIbzFoGpUmY7ME2Jj9ZhqVIBxu",
-	"zQMP3962jXMknh7lTmXXBPmRoPrudZD09JhHhEN4Ip+dv+/DnoT3gvNPi6kTQbbfVwaHCyluzW4aAIVP",
-	"3YgJXqJ7qlJ0AUOvo4apaGBKjIYFCAfZJ/0k5R0xb4s1HRlj4CJs94nBS5I7gPli2AOaAVoujn5ua0J0",
-	"VoVXvNh6pM4KFKiDg6glHSobdja+OAzYNBsDyWth1QPWxFp89JdU+aOfjyOOfqS0+PukktmVP+8i8r6j",
-	"upsdz1/TbdY+tvqcGRDBTQ+fRc+nzvP58kbjg3LfjUcuxCG1d4KjFJ1DAQuLE9vY01mdn6neTQPHq/kc",
-	"md4k5cgXKSMjycTNAeYh9ogQqzEng0dInYIIbLSs48DkRxEfdr44BEju8ktRPzbeXdHfkA4WtN74RkoW",
-	"pbn1WY/VKvMsxaW3qEWeloszDkMYHxPDSde0MJzUBZ7Wg3RyteHbp5WZzfl2POx7Ew08aG6NKJ0ctEor",
-	"zxyzvljw9stIvwoOWsNMbCY2Mjr5tJptZuZMJOMVME47dXht5rwHiszEBn2K8IazDu4HQ9cPmQcscgPZ",
-	"MIVUjv36xEYL3mGA7BbkU9SskPScXi2QXZ8kexwwPeJ0H9l9EqXQOxFILQVmnQbcaXT26lma0lZXEqmv",
-	"23HIDhvC1FKspu9wJneyB6Nd5Wkz1913dbrD/uRo/qx+lCR/XaXcffIy2s6lzbV4SFrGNjk0gNiB1ddt",
-	"ITaJ1qbjUhOvEdZSLMkw+q6xq4s2BQWgJmDSkKsnNymz9PX1WwUoM1z6bpGeE3eP8u3DyBtOwoIpDbVx",
-	"wTu5fHzbD6oTzWNLzPtXp0s5N+t7I0QQNKw5Fjs2lvnRV4Cu63MmlZ6gZSa5BNPoG4WatG9M07Qg3PS3",
-	"Y8qaeg6WgxGiG9hOclZUaVJ2IH3/wkD0Y7i5VDXDi5Jx6200w1T4SQfdA2yTCI917N6JoJcWQS/px8DP",
-	"sINlmhqYpKG85vR/kiPW4oW7OEuCllPE1N3QXpTu4LVRLH2X0UZCdOR2Md1l8+mcy9yPvdcby0f09wkR",
-	"dqTkWqKMiOkAQrFYQO4zvbmgUJv1yuXTKwRf1LkEze870gdOic3ih0n4duTvc+7p0Oec3ignglUxktDH",
-	"jxmEvI6uw9yDOMkCuM3cMjq83kiRRFzsGI8tIs3ox+XtHbf5pOvwVctduPbptXsYNhu3pwCau2eVAr++",
-	"3Ye2u10OdeM+p+NGitjdBwwHRIpjWkUCTIdoejg3LUuWb1qGPzvq9AiSGCjudTPBt3DG9qKl6U+8p0TP",
-	"A3MpYntn4zjD1/2ZeVtaN2bniGuOBM1ckoG8kmhEajgJd9Poh/flwCV///OlFpIuwBkCJxakew2ByzkE",
-	"DVEmekU0s37ROZvPITaAqWOMNw3gOmaOfAA991Be10oWnpQ7yfJg2qpXsB+haXpKUEqfq8VV1wzp3xuR",
-	"Si3cMdHGHWFLTOYR+B62k59pUZkHEJOqdkl1dsHmbX4ATaxX38MWR97r6WkA27MrqIF7A0ihKaNK+KSi",
-	"5OAPVKPoAj59G1t4wE6dp3fpRFvjKmj0H436YmqUkWgu5cMdm9ozxkA6ZK8u084m5mxBc1vahL5vi1i+",
-	"X+SJXh7xVAydNo6520KCjb1OZUALT/i42NHdeHQ/N48uCwsj7tmJ1+FGTu4COmFas3/D1+vADaFlKcWa",
-	"FhPnHtMna0ixdrIGNvfeNB/5WZU+FVdfn7987cC/G4+yAqicBA1H76qwXfmnWZWtvLH7GrJZ2J1K12rA",
-	"os0PmbJjB5pbzLjeUqJ1StzU7lLRQXUONfO0g/hevuk8u+wSd3h4QRkcvGpDtPXvavp00TVlhbf3emiH",
-	"KtftcocVVUryiXiAe/uGRU5/9x5Lsd9ggp6loscvSwX8upvReaIyg0uMgbPYtlhu08YPX745fPN7Yxau",
-	"r9+uPTi1bcc6bYX0/Am/PnWk13WHAaYZSH0A97BtRP4rzKqafgNyl3MVubVzfqMnF06/EbJxe7oIy6Tz",
-	"3IeTWs0Lx+Ix7SBw5TwCOrLqlFi59tfFr4ZhPXoUU9yjR2Pya+E+RADi7zP3Oz7uHj1KGqmTakfDR1Gr",
-	"yOkKHoYYjd6N+LgqEQ63w2SY8/UqCO6inwwDhVovOI/uW4e9W8kcPnP3Sw4FmJ+mQ9Qm8aZbdMfADDlB",
-	"l30RksERe2VLiyoieDsfAEbsGtLC+9BVE7E2/+4R4tUKbeATVbAs7YDEZ8ghuXUvNo0JNh5szzZzVKzH",
-	"x51XLBrdNFNHmV9bC4lmTSJcJbMS1/idCccCKs7+WUFUYhivgJbE4N9nOGpH6k/rOt3A7QrGo2OKD9/f",
-	"XGmB7EVVr9X3RbBE+vWnSl0dGHIRz9jh+TvCJRwh+VsTY+uWznt5L0HtfHPurkPtLNGeazqjb/9jzVXk",
-	"tHv4YsgGMzWZS/EbpEUGtFMmsod4AztDG8BvwFNusm3+FZwX6prZ9ez7CGS4nqOPVO6t1/CLDoX7jrm5",
-	"0+zhsI0+UIER7Xe/CkOlM5y7Teh7NMe+L81Ynh4ehgc28kzHckLe445ye0Jtao1G8Fv6nMexqmd2/Pqc",
-	"O5g78b0FvZ3RVK0l83Y1MEXb3/AN1IL4zn6DVMgOYWcnUThFaMtsvsESZG3A6mZrPvIdaqcd/AKtH5xI",
-	"cfFTc2zdZQolEsNU/JZydGXEfpYDut4KrCuI6XUrJOYYVWk3xhwytkoq5q+v3+ZZ1/ksZwtmq5pXCgid",
-	"a5dq0g1k69pbKnIFxUM6FIeaizl5PK7PrN+NnK0ZPsSwxRPbYkYV3svBLSN0McsDrpcKmz8d0HxZ8VxC",
-	"rpfKIlYJEnQFKHEGZ9wZ6FsATh5juydfkE/QZ1mxNTxMXzBORhs9f/LFeFfxbsQ41qnfxeRz5PI+liJN",
-	"2ejYbccwbNWNmg6OmEuA36D/PtlxvmzXIacLW7oraP/pWlFODUJSMK32wGT74v6iN0kLL9xaikBpKbaE",
-	"6fT8oKnhWD0B7YYhWjBIJlYrplfOWVWJlaGwuhK6ndQPhyX+fCU2D5f/iF7gZeJp/zu8suiqJ8gSHft/",
-	"RJN/jNYxoTZpbMHqEBBfJJdc+OTYWJouVKSzuDFzmaWjmIoRIXNSSsY1arAqPZ/81bzaJc0MQ5z2gTuZ",
-	"ff4sUeKtWQWJHwb4R8e7BAVynUa97CF7L+W4vuQTLvhkZThK/rDOKhGdyl539bSLcZ/nc8/Q95auzbiT",
-	"XgKsGgRII25+L1LkOwa8J3GG9RxEoQev7KPTaiXTBEMrs0M/vXnpJJGVkKliGzUDcFKJBC0ZrDHENb1J",
-	"Zsx77oUsBu3CfaD/fR3svFgaiW7+dCcfC5GFO/FOC5mdjKT/8w91in40tNvQ4ZbSUsiEetYpGj+yZ+xh",
-	"asK2Pd96JOK3HswNRhuO0sVKT8SJDSkJfX4Pl7M2SHbPGxrSJ78Sad7xKOs/eoRAP3o0dqLyr0+bny17",
-	"f/RouNduWk1ofk2g5ri7pp1A0/RNbfWXIqG084VEg+uay5aSUKwm7zJzpc7cGGPSrNb48eWO04RMHuwJ",
-	"nT5AHjX4uY2b35m/4mbWQTj9/KFZwDZJPnn4HoVxUPKl2Awlota15enpD4CiHpQM1AriSjoFepNeG3td",
-	"jiKyNaPOoBDmpRrX4BrsQfMn2gWDmvGOvahYkf9cG59bN5OkPFsm/dpnpuMv9hkQNYg0GNmScg5Fsrd9",
-	"Lf/iX9WJd/8/RM+wK8bTn9q1oC3sLUhrsJpA+Cn9+AZXTBdmghhFzZxgIctKsRA5wXnq4ik1a+wWVU8V",
-	"s02kGcBhV5V2jtGYv8HVNJmzAl1602ZwbDmRVPdwVYnRv/N6RFgbOcWqJezoIAllK7y2FV2VBeAhXIOk",
-	"C+wqOLS6Y9I4HDmqjEJUaT5hS8w/I4iuJCdiPo+WAVwzCcV2TEqqlB3ksVkWbHDu0fMnjx8/HmZbRHwN",
-	"WLvFq1/4q3pxT86wif3iio/Zmg0HgX8M9Hc11R2y+V3ichVg/1mB0ikWix9sTDgahs29bqu/hkrFU/It",
-	"pkgzhN6oUoBKUZ/kuZmWtCoLQfMx5qW++vr8JbGz2j4SEHVYfXaBGsDmEUkaeYanafUp4HrSZw0fZ3f2",
-	"HrNqpSehLmwqmaNpUZezZS1PLNQNxtiZkhdWLRv8eewkBLObyxXkURlaqwZA4jD/0ZpmS9R3Tkc7Vco9",
-	"BYmGV1H2HLA2F0Wht6FmF3JwswxXSNnWUR4ToZcgb5kCTH0Ba2jmjAwJV51C3ueQbK5WVpxbwpkeIL2G",
-	"Cl2H7oIHzoq+3q0iCVlrH+5t+6uTiWCd9UPrTV9ir3ToUKt4dcvdwVbt2Pi6H1PygzN2ZJQLzjKsd5ES",
-	"wTEb5DCz6oDSIGl7pxq5s5w4hsmS2SFG3mGxt4i2Z5kOcV2nhuir2W9LOPZPDRtXh3ABWjkeCPnYV7B3",
-	"BjrGFbgabIa+Yo4qZMLjKxmiEzxHTugePx5hQrceXes35tuPTjePaWtuGEedm0OqewlaA1uhGNrZOWGa",
-	"LAQot9pmaJp6a/pMrzYcQXg3fSkWLLtkCxzDeiAapFiP5O5Q594/2fkDm7ZfmbaufEL4ueFJZyf1636X",
-	"ZCEq7H+q7Hsv+lMuXz5CLkJuGD8ebQcx7gw7wHvZkCGs0eEPSrzPO2QTKug3R/naPFktvWELYoOHk5mL",
-	"GU+A8ZJxb/BNp+LKkncJbgye5p5+KpNU20fHII53BbToCc3BuH7rMXDfodrFIAxKcI1+jv5trIv/97CV",
-	"0KB+XVC+Jf5QGOqOhJKvaBEc8xOl/FE6c8KY9RFuFfdPsRXD1ic+OriBrr2xqKE7FmQ59J7qS3g6q/IF",
-	"6AnN81Tquy/xK8GvPrgRNpBVoQ5ZCHVtZozvUpubKBNcVasdc/kG95wuZ4oqBatZkfC4fRE+Qh52GHNh",
-	"zbb4b6oIV//OOAf8gwPQvbd9fliZhG5AfUp6NjQ9UWwxGY4JvFPuj4566uMIve5/Ukr3sed/iNDyFpeL",
-	"9yjF3742F0ecKbzj2m+vlpDIG93oBX73KclCMtkmV8KrrFNqDj0ycPMSW9YC3jdMAr6mRU/Sh9hqY+9X",
-	"a8noS/2Q9WY2odol0NOU1DxhiAqjPwWZdbxuWYa65s0+12rrWf0hjScOHzuR3m9p/L5hV7RebzVD6bUn",
-	"Hmfyq4ngUJufqwbR1ZfSohDZYM7ghjk3nfqzBYvVyiXfT3jlrVcij89C7M0FkGZs1mE5EVGBD9vkN3xa",
-	"Jb/I2/RoDf1IIJqhidMQjW4JYxsk6sHzwNip44kila3DLPmGFVif6j8vX/046t/IaAe6W+qydydV2H0b",
-	"E6Lm2uSxEA187OABghdp/bfqUaljeqr0aXAFkpMfvrEKwiEg2VRNh7R+OXTwDgEshC1MlSrd0U2QM6q3",
-	"wyM/ooZ6ey1HiakjRRXtgk+Jt49VetZNSKiFOqg2akNGGlJfKlXKyL0UvAbWXjQuJZ6t79QpDdVhoC+G",
-	"CIcdfNyNRxf5QeJTqhzWyI6SYrAv2WKpvyxEdvMd0BykLWmSek7agiYrMM9QtWQlvn9KoVhdkrgwg7lc",
-	"4kscbjo0IudqCS4xjU9Y0BnLO1CvIdNYorp2A5UAw/0cyvQSDQTeoIhNfgdXEAmQQ6mXO4Ul69xd6mVd",
-	"uRRcwBlTZAbOdLEGPiZsCtN2jFpe56UiBdC5V8JKIfSA0r5e22LRGAOdoq9OmejdYmAn7VyUVdFW850O",
-	"rwNzHmICbHzlLVV18qpWSofBoePzOWSYc39nBsC/L4FHKeHGXnWHsMyjhIAsRAli1YiTarRrWHfl4tsJ",
-	"alQW60NC2pec4wa2DxRp0FCyKHEIrD0mCT0ix9pxfV2DPtOGc4xkKtATIsj7wbsaAHWZp2PqEEQJMo8E",
-	"w9O4uZ7qpJnHQeMlmiPAMF0PnLQ3Ix8Kpn0JBrsF3vtfyi+wnr5yTqU0ZLyP9UnkolsR+tZlzMdcj8Fa",
-	"6HPng/K/+RyxdpaC3bgiOYgwa5u9pTL3LU6Sqc/emywN9DzMzOrAqK6Xz6F+OTZCMSuEEYAmfYGhzUil",
-	"4ML7QFlf6zqBGkI9BykhDzbBQiiYaOHDrA7IP+rCJ3dgz3qZH4W3lkf/AZHCdkW9ZRze1LUssCIlxbIN",
-	"1Dmfx1ghElbUQC+j+hJpNei+HfrKfvf5TXyFwd3q1T68h3Oxv0i3D71jqoP5+HTNiRMODuZejaQoR2hm",
-	"GecgJ96I264uwZuZOjG1c15lVlSJz2bQXg9OgbaDmyWVmll3la0nVJSM4wa2Z1bt4wuf+x2PgbYypAU9",
-	"ymndIoqT6qpVCu7FScD7fTOIlkIUkx7L4EW3JEb7MNyw7AYwN2yITDFS8IPmsTGTkE/QIBV8Rm6XW1/w",
-	"oSyBQ/5wSsg5t9GB3n2kWQS1NTl/oHfNv8FZ88oWuXEa6Ok1T4dZYbEZeU/u54fZwfP6eJMCwy/vOb8d",
-	"5IjZ9Yb3+cjdYlWaZqni6VD1Rte/oyVCReRnoUgJUJfWEPwVsoTEO4pgUpYoexD6B1DiDMhEFSLlhX9M",
-	"4hgzVBpT8WQIkAY+4LlaQ+EGTyLAOdntyRDrPvscqGJOJNS+Gccmg3X5VS0TV32qkfbMYZYmZ5wLCfGM",
-	"6Gdqc0WHyDZMtYz/mTEtqdwek7K1iaqUGqoXy3u9JYOjZL2Q2lmyi8OiELcTZGuTUOAppQ4w7VTz2val",
-	"Uut+5qjPIHK7pMqJiFuypDnJhJSQxT3SId4WqpWQMCkEemGmHDvm2jwSVhjXyUkhFkSUmcjB1mJLU1Df",
-	"XBXnFGUviFzZkiiwtIMpA2yfiI4HTmluX2uenaC8trfWh9/8K9PHpq+oU/HZRU+si0BPfAEolwzOYcg2",
-	"7sJr08ZhIqa2UjYtIs/ZBukGZOrIz4mWFYyJa2EFkpiE8OBTCWTFlLKgBFq6ZUWB2SPYJnJoCP5AadT2",
-	"yM4X6Ae9Zujw1swkYkXq0tyOIf1KzAMu40RsRC+lqBbLqERBgNM/3WXlHvbxKD+pCn0SMUTUTPGMrITS",
-	"7llsR6qXXLuAfpIJrqUoiqYiz8r5C2f0/YFuzrNMvxTiZkazm4f4COdCh5XmY59Soe27W88kW/kgh70U",
-	"9IZPkDzU/kzvth16tTp6Hsw7W9yvY3jYp8mPwHy3n7nut2ucdxfWXleTz6bfQuecUC1WLEsftz+X92uv",
-	"z2qKeyUTLNpCyDYLDTZDPhDfY8GdCblnF83AabKS6zlxPMK5dSAnMv9FMb49LpmD40E9d2iX7zgBa5L1",
-	"ioEtABBSmwhBV9JWT46FtMBwxMImTkGnlDagAy8c9P27H2xmhJMDpeFeQHW8kQOAn1gNxtgmwrSezTOx",
-	"8d8f1pkyjwL+bjeVN5hHn1PlZU1a0rpV+kRWPRwhXQxhpwfiFSbBmA31QwzV8Ade/hEA/Z6JDRgG+Sce",
-	"CsacsgLySapQ8kXQgY2j57qLsYxG9yUlLSfPaOWLEZuxKwkusZKV/mXTnFhSQ0oiNO9qxHkOG7AxWr+B",
-	"FLaU8DgyZ0FhKw23NAqinBSwhobDpsv2VKEUytbg+6rQmeQAJVp824q2lCdiXKiwpX1xa59EvmxDsJtU",
-	"x1jE2p0ie3QtSc3Qhk/sMVFDj5KBaM3yijbwpw4VOZq6RHOUE6jqPB8m/ok5dJqf7Ahv/ADnvn9KlPGY",
-	"eDeMDx3MgtKo28WA9nomV6rv1PO0Y3KcyiwYinC2PNi1LYnXfEOV9Jb3azW7JF+/xAbuExM8QuzXG8hQ",
-	"qnFPIcjdY6jHcuJyICG1c4DcPhhMl4Q2fwmccBGVXb6lKrxi6mSu/gc7MTZi3D20j7DR1/7D999ZgoMR",
-	"1Uq2mK6SGsj6fjr+3+Uk7jyIveOlaESBC+XdoRrz1O2eHdhAVEVOuNlPI/tjmWJ3izkuPiazyg9UFOLW",
-	"1lGOn6gvwNtzLfV5E5MTy1m4lr2f9NjlGW5rQVgUIbKiWyIk/mMepP+saMHmW+QzFnzfjaglNSTkDMjW",
-	"i8L5XZuJd4tXYw+YV8QIP5VdNxs6ZjTc1owSAW0ucl85TpAVvYF4G9BBxPLPTBvGqaoZKjXMld3azi4W",
-	"3OJ9eqYVzWMlACaa3Ta4g89zbnr/zzpsNZ7K538sC5r5qtmu/l2Tz2BhfU9cegmr3WHOXb7mSSAU66+J",
-	"Vvo0GfkR2tQDWVcq5qevUFcD7E4V8k6NsnstY6BSuFVvaUeA+KClnHoXThPD2VlSXG143+Li4ssfZ3eS",
-	"GaL7ljEE/D/QrjTcKzqRbeki7vF6bL32j7ALjUQ8CVitGnwmNhMJc7XPkcbqwWdiUwOsgu6W8UwCVdbv",
-	"6OKVe7bWCZAZN89o67UbzKphlBzmjNeslvGy0olXEOZB5tsIYbE1AdHaY5vrkzGMKLqmxas1SMnyvo0z",
-	"p8dWJ44LBnkLiuubUICEG7k7AFP1CxDjqWv9fNzMXP+22KH1nVWa8pzKPG7OOMlAGqmB3NKtOt5UFawO",
-	"+4xVNJKFmtlCIrMVkrYFpNg6a/M9DUkBQHpCi9IASxA6aSesQFYxpEWP4acLw5/CErSim0khFhj123Mg",
-	"XJ5rNB3aB6TgqES30t2wdft5FPsNdk+DFUgcI9ICZx0yxe5z/wq3Eh+hP3Gmd558q+Fsh2FbT2d7MD1S",
-	"+aIOz7DE0j2Pqch5l5gpjp73oqpPU+JpD6JNTLpEd7TqPbuI/hUu7UKsQh9eOLPpwpGKz7d6hQnqG9SO",
-	"AAxQdVwBzZyHWFcR11FUWKSMXXaDA/V0Vrvv76Ue8FCRotxZb04bHHTMOIdUG92dz2BSinKSDfFttUWK",
-	"cmdkcJA2Yeyhj8iE0LPu4HejQtmuRk60Rv2uQwuu9tYP22crK7NdKoM+JVMPR28aMMQceRkeYataw1ir",
-	"oIoZ+8e5N3Y3lWiBSRBKJGSVRCXzLd3uL0LZk33+8rvzz548/eXpZ58T04DkbAGqrmnQKuJYuyYy3tYa",
-	"fVxnxM7ydHoTfLYQizhvvfRhb2FT3Fmz3FbVyYg7JSwP0U4nLoBUcG63Mt5Re4Xj1GERf6ztSi3y5DuW",
-	"QsGH3zMpiiJdUybIVQnzS2q3IgOMeYGUIBVT2jDCpv2U6dopWy1RuYhZw9c2N5TgGXjts6MCpnt8uVIL",
-	"6fPpRX6GuRiczYnApiwcr7J2ol3rcu80q99DoRHdbWZASlE60Z7NSQoijNmSFQS9ulOboj49ctMNzNY6",
-	"7KYI0Tm/p0nvnLuXsJiT3dy+WRZcpzm92cSEeOEP5RGk2Wfd6M8zcgwnqQ0Dfxj+kUiccjKuEZb7IXhF",
-	"8n2wIyr8vOM1EZKGDAKtmyAjQR4IQE88dCNoNQqyi3KTS2tjQGuENz+3xY8farP03sgUhMR32ANeHMtc",
-	"twvBFA6c3zmx9w8BKdFS3vVRQmP5+8KjPesNF0m0RU5pojUoy5ZEVyyMAuLVVyHOvOdV0glHl0JoYl6m",
-	"RZEIY7d6HDxTMeGYJ4Fc0+Ljc41vmFT6HPEB+Zv+wK04bDlGskWlOnlCzpd0EFhRiPJHgYq/xtj6v4PZ",
-	"2eTt6GZxhv/OHYgqIVpYb+95sIADJ7c4pnXsevI5mblyP6WEjKm2Q8GtF2lCvC1INnf+tbDR7djfe5cJ",
-	"+lnoexyHufcHIj9GRrbgOeBgro/678ycejhA8rSkSLVDKAn8pXhdXOB9z7Vzz9Iwx6VyihI3HpjKqVu6",
-	"fujycB14eVUKuuscfOs3cJu48Ou1Dc1VNrjCzPX1Wz0bklAsXQ3GdMccZycpC3P/ojAfJcGZRaUbw0GS",
-	"JKxa5N6XvablLxnlaWjuohH3e+rGLy36zWj4KJhX3I4XCqBirLhn62I+Dl4Mgptuz8k1f0TUkvq3hfvz",
-	"6Wefj8Yj4NXKLL7+PhqP3Nd3qZdavknGldaJdDo+oq6awANFSrodEsy+N3VOEr91pqCPL9IozWbpN913",
-	"Zs/w4eoCEC44snpkL/YGdflz/pUAaCcxtA5rODGWJOv0QGEr9mUK+rkvLb5N/d5T7aPFfStW7HWSaxRi",
-	"uRuPFjZJGVYn+cXVqvu42+4h6MkX6JZ+nzRgFjGJtTYmj6aKkroNKMjiuiUqZGDkdVZJpreXBv9e7c5+",
-	"uUklg/o2pGdyOb+CBd7JvlrcAPc+ZnUyp0p56fpbQQuUPq1jADcypyim5GtbIcRdi397MPsLfPrXZ/nj",
-	"T5/8ZfbXx589zuDZZ188fky/eEaffPHpE3j618+ePYYn88+/mD3Nnz57Onv29Nnnn32RffrsyezZ51/8",
-	"5YGhdAOyBdRX/nk++t+T82IhJuevLyZXBtgaJ7Rk34PZG9SwzTFBISI1wysWVpQVo+f+p//lL8ppJlb1",
-	"8P7XkasHOVpqXarnZ2e3t7fTuMvZAnOgTLSosuWZnwdzWTbeK68vQlyQ9f3DHa1tTripIb+f+fbm68sr",
-	"cv76YloTzOj56PH08fQJ5lMsgdOSjZ6PPsWf8PQscd/PMIv2mXLFeM5C6OjduPOtLG2pHvNpEdKAmr+W",
-	"QAtkkeaPFWjJMv9JAs237v/qli4WIKcYMWZ/Wj8982+Ps/cur8zdrm9nsTfa2ftGcp58T0/vT7Wvydl7",
-	"X+5/94CNUu7OzzXqMBDQXc3OZlh3b2hTiFfXvxSUNtTZe3yj9/5+5u7r9EdUo9iTduaFkJ6WNpdI+mMD",
-	"he/1xixk93CmTTReRnW2rMqz9/gfPDTRimwe7zO94WfodnL2voEI97mDiObvdfe4Baaf9cCJ+Vyhd8yu",
-	"z2fv7b/RRLApQTLz9sQUZ+5Xm9XyDMvcbrs/b7lzkigglQrsJ67A6th8faItz+pI3MBHLnLf+HLLM/9I",
-	"9n7YyB2ePn5sp3+G/xm5+o6trFhn7jyP7H2+V9XbyJyNvLel5Q/w2nhjIxAjDE8+HgwX3PpeG2ZsL427",
-	"8eizj4mFC27kG1oQbGmn//QjbgLINcuAXMGqFJJKVmzJTzy4j0dl+lMUeMPFLfeQG4mjWq2o3KLUvBJr",
-	"UMSVa4qIk0gwspN9q6AwXNMwXnnU8JG3o7KaFSwbjW2e9HcoremU4OJVz92ZvNq9Hrx5Kr7deyaG70JT",
-	"Ht6RhmsQnMen7rMzJ1IKd7bek0Xbp8NC8SC1d6N/8Yh/8YgT8ghdSd57eqOrDTNdQuki7jOaLWEXq+he",
-	"pNHdPypFKgXO5Q4+4uqi9bGRyyYbqX2XR8/fdkPTHTWjVmDq3zJGUK+fGjIwJH+u0VEj2s/BVfDaVpT+",
-	"b+/+EELBV5T7k96gBetBQWXBQAb6oLxbxO5f/OH/G/5gi3NSu69joqEoVMwVtECuYBVwLlcyt04AAzlE",
-	"I+t1LYE3fj7zyo7Uw7XZ8n3jz+ZjTC0rnYvbaBY0E1rLePdpYj5Wqv332S1lejIX0qVNpnMNsttZAy3O",
-	"XGm+1q91vZvOFyziE/0Yx70nfz2j7o2S+oZcsK9j5xGd+ureiT2NfMCF/1yr6mLVF3LgoPR6+85wOQVy",
-	"7Zlzrcl5fnaG8XtLofTZ6G78vqXliT++C4Tlq5WPSsnWWP7oneGxQrIF47SYOFVIXXp09HT6eHT3/wIA",
-	"AP//sYtNdaENAQA=",
+	"H4sIAAAAAAAC/+x9/XMbN7Lgv4Lie1WOfSQlO07exltb75Q4yerFiV2Wkr13sS8BZ5okVkNgFsBQ5Pr0",
+	"v1+h8TGYGQw5pGgludqfbHHw0Wg0Go3+/DDKxKoUHLhWoxcfRiWVdAUaJP5F81yCwv/moDLJSs0EH70Y",
+	"XXBCs0xUXJOymhUsIzewnY7GI2a+llQvR+MRpysYvQiDjEcS/lExCfnohZYVjEcqW8KK2mm1Bmn6/nwx",
+	"+d/nky/ef/jsT3ej8UhvSzOG0pLxxWg82kwWYuJ+nFHFMjW9cOPf7ftKy7JgGTVLmLA8vai6CWE5cM3m",
+	"DGTfwprj7VrfinG2qlajF+dhSYxrWIDsWVNZXvIcNqO7vZ+pUqB712M+DliJH+OkazCD7lxFo0FGdbYs",
+	"BeM6sRKCX4n9nFxC1H3XIuZCrqhut4/ID2nv6fjp+d2/BVJ8Ov7s0zQx0mIhJOX5JIz7VRiXXNl2dwc0",
+	"9F/bCPhK8DlbVBIUuV2CXoIkeglEgioFV0DE7O+QacIU+a+r1z8QIcn3oBRdwBua3RDgmcghn5LLOeFC",
+	"k1KKNcshH5Mc5rQqtCJaYM9AH/+oQG5r7Dq4YkwCN7Tw8+jvSvDReLRSi5JmN6P3bTTd3Y1HBVuxxKq+",
+	"pxtDUYRXqxlIIuZmQR4cCbqSvA8gO2IMz06SrBjXnz8f3fX9uqKbLnjXsuIZ1ZBHAGpJuaKZaYFQ5kyV",
+	"Bd0iald085fzsQNcEVoUpASeM74gesNV31LM3CdbCIdNAtHXSyDmCynpAiI8T8mPCoj2X7W4AR6og8y2",
+	"+KmUsGaiUqFTzzpw6sRCIjqQouIpRkXwg0NzD4+yfU/JoN7iiHe7vym2cJ/aUF+xxfW2BDJnhbkvyd8r",
+	"pQMBVwq3fQlElZAZ3psTM4xBvmILTnUl4cU7/sT8RSbkSlOeU5mbX1b2p++rQrMrtjA/FfanV2LBsiu2",
+	"6NmBAGvqnCrstrL/mPHSR1VvknfJKyFuqjJeUBafBUMrly/7KMOO2U8aaQZ5EeQG3B831vXm8uXo7pge",
+	"ehM2sgfIXtyV1DS8ga0EAy3N5vjPZo6kRefynyMrXpjeupynUGvI37FrFKgurPx0UQsRb91n8zUTXIO9",
+	"CiMx4wyZ7YsPseQkRQlSMzsoLctJITJaTJSmGkf6dwnz0YvRv53Vgt6Z7a7OoslfmV5X2MlcxhIM45vQ",
+	"sjxgjDdGeERRq+egGz6En8hcSHK7ZNmS6CVThHG7iSh3GU5TwJpyPR0ddJLvYu7wswOi3gp7SdqtaDGg",
+	"3r0gtuEMFNK+E3ofqYakiBgniHFCeU4WhZiFHz65KMsaufj9oiwtqsaEzQkwvM9hw5RWjxEztD5k8TyX",
+	"L6fk23jsW1YURPBiS2bg7h3IzZiWbzs+7gRwg1hcQz3iI0Vwp4Wcml3zaFAK9CmIEaXKpSjMFbiXjEzj",
+	"v7q2MQWa3wd1/sNTX4z2frozrYhDKlKT/aV+uJFPWkTVpSnsYajpot33OIoyo+ygJXVZI/jUdIW/MA0r",
+	"tZdIIogiQnPbQ6WkWy9BTVAS6lLQjwos8ZR0wThCOzYCOScremP3QyDeDSGACpK2JTMclNwyvaxFroD6",
+	"aed98ccm5NSeE7PhlHFFKCmY0kYYws1UZAkFCpw0KBZiKjqKaAbQwo5FBJhvJS0tmbsvVo5jnNDw/rKw",
+	"3vMmH3jJJmGuP8c0gFAdzcz3MtwkJAoVDk0YvixEdvNXqpYnOPwzP1b3WOA0ZAk0B0mWVC0TZ6pF2/Vo",
+	"Q+jbNESaJbNoqmlY4iuxUCdYYiEO4Wpl+RUtCjN1l5u1VosDDzrIRUFMYwIrps0DmHE8AQu2Bm5Zz5R8",
+	"TbOlESZIRotiXOslRDkpYA0FEZIwzkGOiV5SXR9+HNk/lPAcKTB8UAOJVuN0GlNyvQQJcyHxoSqBrChe",
+	"TivzPCqLZp/AXBVdQUt2wstSVBpk4+Vy+dKvDtbAkSeFoRH8sEZ88MeDT8lF+IQzc2EXRyWgooXxrKjy",
+	"Gn+BXzSANq3rq5bXUwiZo6KHavMbkyQT0g5hL383ufkPUFl3ttT5SSlh4oaQdA1S0cKsrrWox4F830hR",
+	"CgXyb8AWy1MIgDNaUJ7BZMc15pq46+x2KQzHxfkVUVBAZqjPrCkTK6RFUGYNYZWPlCPH0YFv/tItNg2W",
+	"kMxc8AXxzRqTjglVxDwb8cpiWpHMLHpu8ALHXeTx8AevRQtNi4lFW3oSbEFgo0GaVdmmhGrS2CIzcXhM",
+	"O51SB5S78WjXTO05RBAdLR6PmzMpkkSbOG7RWoCxhZwhzC+1isYS4huA5jX7P9Xttudmy6mm01F7IWmN",
+	"iL15sR8+qkAmtu01/ocWMRVH3JehnI9vgsDPUOY1SLEzmQYKNNGCrKzemRhl8EFQflVPnr6mB23e11bV",
+	"7bbHLSLs0PWG5epU24SD9e1V84axOlN/nXe4w85LO5prEPWKktjrtwWCvWkdgzEIEZuTi4Vfik0Kpi/F",
+	"piMSig2cZCfExv5nkLD0pdi8dJAJuR/zOPYQpJsFmvsA7ybaMCOaWWpTz8VMyONu1o5przZgEWpGjR4j",
+	"4xaSsGlVTtzZTJiXbIPWQCSoZ3cL0e3hUxhrYOFK04+ABaVpBPw9sNAc6NRYEKuSFXAC0l8mH0EzquDT",
+	"Z+TqrxefPX32y7PPPjckWUqxkHRFZlsNinzi9ORE6W0Bj5PyCkrn6dE/f+4Nis1xU+MoUckMVrTsDmUN",
+	"lVY6sM2IadfFWhPNuOoA4CCOCOZqs2gnb22/u/HoJcyqxRVozfhCvZFifnJu2JkhBR02elNKI5irplHX",
+	"vTbOctPkDDZa0rMSWwLPkeZxHUxRpWA1OwlR9W18Xs+SE4fRHPYeikO3qZ5mG2+V3MrqFJpDkFLI5BVc",
+	"SqFFJoqJeScxkdD9vXEtiGvht6ts/26hJbdUETM3GpArnveo+IxlePD9ZYe+3vAaNztvMLvexOrcvEP2",
+	"pYn8+hVfgpzoDSdInQ3N41yKFaEkx44oa3wL2spfbAVXmq7K1/P5aWwMAgdKPEXYCpSZidgWhHGiIBM8",
+	"V3ufV96a3kKmm2oIztrY8rZg3Q+VQ9PVlmf4rjvFWe5/dDpTOVFbnkWqZANjAfkC5F4knUhl3IcpC8Uj",
+	"lYDUYOoVfkaL2ksoNP1GyOta3P1Wiqo8OTtvzzl0OdQtxtnsctPXW2QYXxTQkNQXBvZpao2/yYK+Cko7",
+	"uwaEHon1lXkYR+/LN1J8hDs0OUsKUPxgn+aF6dNV0f4gcsN8dKVOIHrWg9Uc0dBtzAfpTFSaUMJFDrj5",
+	"lUoLpT1eb+agZpWUwHUs56I+kCkyA0NdGa3MaquSaJG6X+qOE5rZEzpB1Kj0hLWrk21lp1vSNRBaSKC5",
+	"Ub4CJ2JmFl17CeEiqSIllUHp40Tiofy2AWwpRQZKGQuwNbvshde3s/eP3oE8XA2uIsxClCBzKj/OCm7W",
+	"e4G/ge1kTYvKiOff/aQe/14WYbVnu7cA26Q2oq3+7i7lHjDtIuI2RDEpW227PQlEC3wZFKChD9n3x17v",
+	"9rfB7BDBR0LgGiR6pH3Uo+Un+QhEGeD/yAfroyyhKidGDOxVPxjJ1ew3p1x42XDPDGECr7Z26u6JymgB",
+	"+zDU1nVXnFm7nOBA2iOaj2NCSSlubW8NfEpeOzNhUAsLSbPCyDpZQSUowgWHodaF1JTpNZgvOJ2D3M1K",
+	"1Y1CAau1MrTfzcD77OKxGhNVGTMmOq/kzLjE/s01NozCgYXoyARXwFWl/uycLewkbjRUkB+Aic5FXVCl",
+	"J/vEAdOoofMyZBrdwL0D97wFXlGlUYQnjOeoe7diDM6DfXCKQw1SOGXvS9pM+pN/RHenDXgOL2pVlaWQ",
+	"GvLU8tBfp3euH2AT5hLzaOzwbNeCVAr2jdyHwGh8h0e7Eos7qoN3jvP36S4OPa6M6Lk9FMsN+Goc7YLx",
+	"yreKEB8HFPTAyFS9B5bcmGrR20yIAiiqu5UWZWluF3N2Q78+DF7Z1hf6x7ptlyStCRznJLnAc6Q9TA7y",
+	"W4t0hXb+JVXEweF9s1BZad2DuzAbljxRzFgQd50XVGCYVvHBOYpVW9Ggxet2iQn1rkQ2ySbjk2DQYdmS",
+	"NZ7753TXeu4IdK5BknrFLQYWdfMuDcEoP9u2+etQBl+VC0lzmORQ0G3Cyc5+JvbzgWfCj41no1Z7CQ2T",
+	"GTqRpI9HzQ68/fi4WQVOlRBKfhAEv5CMKo0bVO+n6338pDngtCnqcef0UZgFwUgeAT8eIssepcSISItr",
+	"gZRlG9nVOGHqnmvpwV6Y9aMgEMed1Pqr9uz/DcrN7ducdv4tqL6F11OfbtlOrLI8w1rjmV6u+u/Q65rR",
+	"hNb11eB8p1pCZE5hhf7leKXbAES9JZSrW5AJManJ2CsFhPKeQYlA7z7CRXtcK9sznZSvmsvOaLaEyZLt",
+	"f/v4lfPcMWL3Q4ERMaqG3sIAuYfC7Fm2hJSI7RZieyiC9w5hzoQJ+Z+tN1kTYhOuwkCN7eVrPPGUe4WY",
+	"SZZME2m8j+6F2sMcgAIuDUVmlWZrmMwpKyoJ6tBHh8PIEmihlyRbQnivmxEhdzgKQqLgYD+rKssAMLox",
+	"rLyLPFS9Hre6/ZYhPB9MJkxB9zwc3XV0TusAUneEs29HnMt6RNAKuPZGyJ79alPub0F+IdIp8Vwy/qqg",
+	"gtDTs4o5ykLRM+qRalLiC/fn1kTMItPNDRfKxS2/Py8LLXEuZBx2tgF72xZbLQml5dboCPUAg+QY2Bj1",
+	"JDFuybj3XzLTjoNH021hf5xC2jDXUKs03rutl2jrsZh84fU+q/a8a/qeED1uIG+o1CxjJVoKvoPtyQ0n",
+	"7QmSbt4kB23Za/TBGlHKuD+xEZTtMY8zpAyydHfB75i6E8vxQSVN4G9gixarNzYYOzIUnsISlBiVMGXo",
+	"HwH1Ab+QN2PHYUMzXWwJRYa7JeYQEFXN7Mnqei9pUU7iAdLpHvpndL6QSU/Enc6ZVzhUtLxU0JR9ze6G",
+	"77ql7W6gw92UpRDFdL//bwcZSQgGRTqQUphdZ7QotkSHiH9PSQ0g3SOn2Hpw3V0RoxlXQP5bVCSj+IAu",
+	"Kw1BxyIkMj2Nd5NCM149p4uyqzEEBazAGlLwy5Mn7YU/eeL23Cgj4dZGC3Bs2EbHkydoBX0jlG4crhM4",
+	"Gpjjdpl4OKGbmHkoeifwFk/ZH5/jRh6yk29ag/tJ8Uwp5QjXLP/eDKB1MjdD1h7TyLDYJL0ZuPLrZjRL",
+	"Z92471dsVRVUn8JHDNa0mIg1SMly2MvJ3cRM8K/XtHgduqGmHzJDoxm+IeZsMXAsuDZ9bE4UMw7jzBxg",
+	"KwkOBQguba8r22mPoryO1GCrFeSMaii2pJSQQW6VbEwRFZY6JTgsyZaUL/ChJkW1cFGaLtbFMPxKWSOk",
+	"rHhniIOjQDZ8gt4jKplhAz3G3C1grSPUKKbbrif2VXVLAyiQN66MgdvTdsVJequNRzvfVOtab2/x1sx2",
+	"c6wfV0M+jJBWQzPQcQnxaWSlLhLjbTSHzxDDx3GQqYdOQdmdOIpnrT/2hbQac0GxPYGQZAciEkoJCq+0",
+	"2AKr7FcxJ9+zTIqLYiHCnae2SsOq6zdju/7Sc1zfHqPFFbxgHCYrwSGhln6NX7/Hj4MtvvYa7hkRBaKD",
+	"Bmw/fBpIaC2gOfkQkr7vJiHJtM9+28lMfSPkqRwc7YCD3xQDnAb3etS6KY91bTTRml1vQKtC73ARNQ7x",
+	"rEwSqpTIGAqKl7ka29PqHAhdJF0T/W9CVocTHOD2uC23tyiDhPWhgKIklGQFQw8LwZWWVabfcYqGumip",
+	"iTgNr+Du17h95ZukzcgJK68b6h2nqAwK5rukjmUOCX3SNwDeuKuqxQKUbj2w5gDvuGvFuHOjMNHE5rhM",
+	"7HkpQWKwxNS2NKHMc0MTWpB/ghRkVunmk2NVKU2UNjZi64NnpiFi/o5TTQqgSpPvmfEIN8N5F15/ZDno",
+	"WyFvAhamwxnXAjgopibpIJNv7VeMh3c4WbrYePN/17mOZg2PSbP2Rr69//PJf74wefbo5J/nky/+x9n7",
+	"D8/vHj/p/Pjs7i9/+b/Nnz69+8vj//z31PZ52FneC/nlS/dGv3yJD7EoxL0N++/Bn2LF+CRJlLEvd4sW",
+	"ySeY6s8R3OOm7Uov4R033vtakDUtWE71CcmnfU11DrQ9Yi0qa2xcS43nEXDgc+gerIokOFWLv34Uea49",
+	"wU5f53jLW+GdjjOqkwPoBk7B1Z4zFdH06Nuvr8mZIwT1CInFDR1lRUu8YOyHpoO12aU4J8U7/o6/hDm+",
+	"BwV/8Y7nVNMze5rOKgXySxslPl0I8sLnc3lJNX3HO9dQb+7bKB9TlPw2xSnoKr2Wd+9+Nnq2d+/ed1xA",
+	"u7KVm2qgacROOTFyg6j0xOWfnEi4pTJlz/fZCe1G2d474bAyiahcTLwdn7jxhxpwaFmqdp66LorKsjAo",
+	"ikhVuVRrZluJ0iLkvGAqpA0yNPCDcP68kt76J2+lQJFfV7T8mXH9nkzeVefnnwJpZGf71fFAQ7fbEgY/",
+	"fHvz6LXfu7jw4Poj6aSki5Rp7t27nzXQEikEBY4VvjQLzOEgaYyTEISJQ9UL8Pg4ZEssZAenJMLlXtle",
+	"PiNxelH4CTe1mfbpXjsYJfQ6egP3JAWjlV5ODEdIrkqZY+D3yvENQheUceUdABVb4ANALUVllgzW2ueS",
+	"8sKq1Ntxo7uYN+5iz3CYQp2Ry8swZwZ/GeVmwKrMqRNkKN+2s3MqG4eKg76FG9heC9t9OjCxcZRIO8oO",
+	"qfqOLtJudNca8o0PshujvfnO5d2nt3GZFDHlhSeLF4EufJ/+o20FgBMc6xRRNFIU9iGCygQisEMfCo5Y",
+	"qBnvXqSfWt4g38QBXolUGeJv5DE82EMRlyU4/Nk+k9i8MVpGORcaHaXsdUA1eTh3RsYz4Oj8AgVbsFnK",
+	"4/5vXftPBDyRkAFb+8RNYUBlFsq0ClmS7MtSUr4AQrXLSEMLjCudJp36UIpeApV6BlTv1GvzOJOgh870",
+	"J7fANbHKpTFBv3pzLphGZRGHW5uiiUnXxsW6TY/yGrdrgvxIUH33e2RTMm8Nh/BEynIvF4U9Ce8q5z8S",
+	"n+LrZfi+MjhcSHFrdtMAKHx2fszhGd3nlaKLwb5KDZPawKyHDUsZDrJPSkzKhcbO3hT/OrLYwEXY7hOD",
+	"lyQXBfPFsFE0l7SicPzc1tTqrC+vTbYih9RZgQ+PEMNkSccwgAh5fHEYsGl2D5LXQr0HrIm1+OgvqfJH",
+	"Px9HN9+RUvVvky10V4r0yyjIgOpuAnQvzrSvwLHVe80A/fzE3CdK99nRfUr00fig9ObjkeVMyb0THF8b",
+	"ORSwsDixjT2d1Sl46900cLyez5HpTVLxCpHSNpLg3BxgHqxPCLGWBTJ4hNQpiMBGDwQcmPwg4sPOF4cA",
+	"yV0KYerHxrsr+jsdxuQCRs1rQpRGOmK8T3JwLMVlMKxFw1YUHg6DoVqGk65pEbkl1oN00nHjG7GVfNv5",
+	"wDzuezsOPGhujSjFHbRK7HHU+uIHil9G+vV00BpmYjOxyXuST9DZZmbORDKk1vRKHl6bHP2RIjOxQd8r",
+	"vOFsDObB0PVD5gGLfYqZQirHfn3itQXvMEB2P3hS1KzIJ+H5UZNdn8R/HDA9z44+svskypJ+IpBait66",
+	"0pPTfO3VRzWlra4kUl+345D0MWRSSLGavsOZ3MkejHaVzM105n+tM9r35792jR4mj3tXeXmf1Pu2MwKi",
+	"Dsq83yaHBhA7sPqmLcQm0dpo1cJrhLUUS2qE7zbsGU20uSep4JOGXD25gW1a8QMoM1z5bpE+GHeP8u3j",
+	"yGtQwoIpDbURhkXZax/WRoZqV/PYEvP+1elSzs363goRBA3sSLBjY5kPvgIMU5szafy7jQUruQTT6BuF",
+	"GsdvTNO0INzYbMKUNYkdLAcjRCbfQM6KKk3KDqTvXhqIfgg3l6pmeFEybr2yZljtLOnIfIANF+GxDvA7",
+	"EfTKIugVfQj8DDtYpqmBSRrKa07/BzliLV64i7MkaDlFTN0N7UXpDl4bpXvqMtpIiI7cU6a7bGOdc5n7",
+	"sfd6rfmkU31ChB0puZYo6X068EcsFiby2+bidHlLKA9ZzwktBF/U6eLN7zsyxE9N5S3l8qzvSNHu3Pih",
+	"z4m/UTESCx8moY+aWcjrJAKYXh4nWQC3yQVHh5eULMRiTwABtog0yA/L2zvhBUkX6+uWW3Xt+2z3MGw2",
+	"bk8BNHfPKgV+fbsPbXe7HOrGfc7ZjSoguw8YDogUx7SKBJgO0fRwblqWLN+0DKR21OkRJDFQ3OsW+2rh",
+	"jO1FS9Pvek8V1keKOO9uZws6w9f9mXlbWndv57BsjgTNXB6svJJobGs4U3crpYX35cAlf/fTlRaSLsAZ",
+	"TCcWpHsNgcs5BA1RsTFFNLP+4zmbzyE2FKpjjFwN4NpnMFlhdiDlda2J4Um5kywPpq16BfsRmqanBKX0",
+	"uaRcd821rm2sUgt3TLRxR9hck6muvoPt5CejWCElZVLVrrvOftq8zQ+gifXqO9jiyHs9Yg1ge3YFNXBv",
+	"ASk0ZVQJn1RU/+mRijFmn76NLTxgpy7Su3SirXFFEvuPRn0xxStqLeXjHZvag8hAOmSvrtJOOeZsQXNb",
+	"2oS+b4tYvl/kiV4e8VQ239Yxd1vIAbfX+Q5o4QkfFzu6G4/u5w7TZWFhxD078SbcyMldQGdV6x7R8Ik7",
+	"cENoaWrU0WLi3Ij6ZA0p1k7WwObe6+iBn1XpU3H99cWrNw5845dRAJWToOHoXRW2K/8wq7LFFXdfQ7bQ",
+	"llPpWg1YtPmhmEvsaHSLRbVaSrROFdParawezzsezdOO9Hv5pvOAs0vc4QkHZXCEqw3R2Lnl+0bXlBXe",
+	"3uuhHapct8sdVjc3ySfiAe7tQxc5R957LMX+CRP0wBU9/msq4NfdjM5j1ziz+EyKknost2nj+y/fHr75",
+	"vbEdRvvjwaltO9a5LVRgS/g/qiO90zsMMM1A6gO4h20j8l9j4v/0G5C7sgDIrZ2TID25cPqNkI3b00Wi",
+	"Jp0MP57Ual44Fo9pB4Fr5xHQkVWnxMq1vy5+JUyRJ09iinvyZEx+LdyHCED8feZ+x8fdkyddoK1AkOaj",
+	"qFXkdAWPQyxL70Y8rEqEw+0wGeZivQqCu+gnw0Ch1lvQo/vWYe9WMofP3P1irP7mp+kQtUm86RbdMTBD",
+	"TtBVXyRpcFhf0Y2Je1FE8BazsJHNhrTwPnQFI63Nv3uEeLVCG/hEFSxLOyDxGXJIbt2wTWOCjQfbs80c",
+	"FeuJBeAVi0Y3zdRR5tfWQqJZkwhXycIZNX5nwrGAirN/VODyVM0ZSLwCWhKDf5/hqB2pP63rdANjn2j4",
+	"oRK+6Xao/mqHudIC2YuqXqvvy2CJ9OtPVTM+MDQlnrHD83eElThC8rcmZ85NZBBB7XxzBsNwUhHkLNGe",
+	"azqjb/9jzfBY3+/y5ZANZmoyl+KfkBYZ0E6ZyLLiAMHHI/ZOucm2+VdwXvDrjWffRyDD9Rx9pHJvvYZf",
+	"dKjNfszNnWYPh230gQqMaL/7VRgqXYRnPIrPdhpu+5E0Y556eBge2MiDHx3Ivccd5faE2hQkjSDB9DmP",
+	"WqgzO359zh3M7V3PCno7o9lN+u1qYIq2v+EbqAXxnf0GqZBFw85OorCT0JbZlGwlyNqA1S0ocuQ71E47",
+	"+AVaPzhNx8ZTc2zdZQolEsNU/JZyDd6dxnJA11uBdQUxvW6FxFTqKu3GmEPGVknF/Lt3P+dZ1/ksZwtm",
+	"M8xj9jsXtQDEDURsvnakIpcxPqSNcai5nJPzcX1m/W7kbM3wIYYtntoWM6pc+nsxb3YxywOulwqbPxvQ",
+	"fFnxXEKul8oiVgkSdAUocQZn3BnoWwBOzrHd0y/IJ+izrNgaHqcvGCejjV48/QJdvewf5ykRKYc5rQq9",
+	"i8nnyOV9LEWastGx245h2KobNR0cMZcA/4T++2TH+bJdh5wubOmuoP2na0U5XUD6mb7aA5Pti/uL3iQt",
+	"vGACW5KD0lJs+7LkrkBTw7F6Av8NQ7RguGCalXNWVWJlKMyzVn/8/HBTPC2WPgJc/iN6gZeJp/1v8Mqi",
+	"qzQ9UHTs/wFN/jFasRQ4pkZhdQiIY5FTcunrt2D15JAt0+LGzGWWjmKq2UIs1Mm4Rg1WpeeTP5lXu6SZ",
+	"YYjTPnAns8+fJ6oQNwt18sMAf3C8S1Ag12nUyx6y91KO62vyHfDJihnm/7jOvhGdyl539eS0us/zuWfo",
+	"e0vXZtxJLwFWDQKkETe/FynyHQPekzjDeg6i0INX9uC0Wsk0wdDK7NCPb185SWQlZKoeXM0AnFQiQUsG",
+	"a8h7N8mMec+9kMWgXbgP9L+tg50XSyPRzZ/u5GMhsnAn3mkhA5aR9H/6vq4ihYZ2G2LdUloKmVDPOkXj",
+	"A3vGHqYmbNvzrUcifuvB3GC04ShdrPREnODPdZ/fwuWsDZLd84aG9OmvRJp3PMr6T54g0EZRapv++qz5",
+	"2bL3J0+Ge+2m1YTm1wRqjrtrWjuOfVNbbcr5v/jQU+s+uK65rDLdbU7fZeZKnbkxxqRZUPzh5Y7ThEwe",
+	"7AmdPkAeNfi5jZvfmL/iZtZBOP384UuxeelWJWSafPLwPQrjoORLsRlKRK1ry9PT7wBFPSgZqBXElYh5",
+	"63ZJem3sdTmKyNaMOgPj8qwaZWIHe9D8gXbBoGa8Yy8qVuQ/1cbn1s0kKc+WSb/2men4i30GRA0iDYYx",
+	"sXIokr3ta/kX/6pOvPv/LnqGXTGe/tRauIO9BWkNVhMIP6Uf3+CK6cJMEKOomTstZKMpFiInOE9U6ySw",
+	"xukogfiXpk78lc1Co95IMe/Skx12VWnnGI35G1zptjkrzP96zODYciJ7C4FIjP6d1yPCGoyZDR94dnSQ",
+	"hLIVXtuKmpKweAjXIOkCuwoOre6YXA9HjopjEFWaT9gS8/QIoivJTcH3aBnANZNQbMekpErZQc7NsmCD",
+	"c49ePD0/Px9mW0R8DVi7xatf+Ot6cU/PsIn94urj2toWB4F/DPR3NdUdsvld4pJbWfG3tl5JisXiBxsT",
+	"bjrbyp3YiQDPUTk7Jd9iKjlD6I1qDgaakAy7mb61KgtB8zHm7zb+WsTOavtIQNTlhvAXBv7WEUkaeYan",
+	"s/Wp8nrSjA0fZ3eWI7NqpbG4itJ0VaaSXpoW174BYS1PLNQNxtiZkpdWLRv8eewkBLPAyxXkJEzn1ABI",
+	"HOY/WtNsaRqI6WinSrmn7uL+ck5vXAvPAWtzURR6u/YfkYObZVj3BiAVz0GOiTA66lumAFNfwBqauTU9",
+	"GF4h73NtNlcrK84t4UwPkF5DIdJDd8EDh+MGt4okZK19uLftr04mIiqZwXDqtSf/CnulQ4d4c7CWu4Ot",
+	"brLx9VGm5Htn7MgoF5xlWBckJYJj1sxhZtUBJVTS9k41cmc5cQwTpBzFyDssuvW/72WZDnFdp4boq9lv",
+	"Szj2Tw0bVyp7AVo5Hgj5GBVUrABnoGNcgSs1a+gr5qhCJjy+kiE6wXPkhO7x4xEmvuvRtX5jvv3gdPPm",
+	"7JIbxlHn5pDqqz+jga1QDO3snDBNFgKUW20zNE39bPpMrzccQXg/fSUWLLtiCxzDeiAapFiP5O5QF94/",
+	"2fkDm7ZfmbauzET4ueFJZyf1636fZCEq7H/nkymN0If+lMuXj5CLkBvGj0fbQYw7ww7wXjZkaOqPEKWh",
+	"xPu8QzYgZerhaaqPVJbesAWxwcMppBSMJ8B4xbg3+KZTcWXJuwQ3Bk9zTz+VSaqzZYNJ7XM+7gnNwbj+",
+	"7OYUQ7U2GFGCa/Rz9G/j9Ya7ih89bCU0qF8XlG+JPxSGuiOhxET6BkdvFKaaemkjnTlhzPoI22BfJ96l",
+	"2Yph6xMfHdxA195Y1NAdC9ccek/1JYadVfkCtEkxmkp99yV+JfjVBzea4jlVqNcWQl2bmfW71OYmygRX",
+	"1WrHXL7BPafLmaJKwWpWJDxuX4aPkIcdNpRmbDzm31Sxsv6dcQ74Bwege2/7/LByEt2A+pT0bGh6othi",
+	"MhwTeKfcHx311McRet3/pJTuY89/F6HlLS4X71GKv31tLo44o3rHtd9eLSHhObrRC/zuU5KFpLtNrmS+",
+	"dUvyoUcGbl5iy1rA+4ZJwNe06En6EFtt7P1qLRl9qR+y3swmVLsEepqSmicMUWH0pyCzjtcty1DXvNnn",
+	"Wm09qz+m8cThYyfS+y2N3zXsitbrrWYovfbE40x+NREcavNzVTO6+lJaFCIbzBncMBemU39WZbFauSIF",
+	"Ca+89Urk8VmIvLla+YqTvtredyx62JpgGwtX/Si32VpUnDDYeR20cgRbh5RmwuOpSbvYSM7oxzdaxh5P",
+	"NIA0U2Z58mcHe/IbriL5Rd6mR2vodgLB762/fN/S8aFwMGxKRHconN6sCj0Un50iyQNLd8eb11PI+2OB",
+	"2mYmeJwcOYxtsLDfar+xdhvjTYtU9+6EkW9YAYRx8l9Xr38Y9R/o6CR2j7bLdp80ZfQd0BA92WYTC9Gg",
+	"rR13geBF2g6iekwrmKYszRWFht4P3yg9FCSbsuuQ1q+GDt4hgIWwhdxSpW66iZJG9XZ45EfUUG+vvVli",
+	"6khRRbtAWuINjC2iK8qpzTqj9SjCGrLykHpsqdJf7sXoNfFW4HCpEW09tE4ptc5F+nLII6GDj7vx6DI/",
+	"SIxOlY8b2VFSF+0rw0y+NJaPvwLNQdoSQCm1gi0AtAKjjlBLVuI7uBSK1SW8CzOYyym/xOGmQyOzDF/E",
+	"TyFxRWcs70i/hkwL2XAHlgDD/V3K9BINBN6wjE1+A5cgCZBDqZc7hWbr5F/qZV3pF1zgobG8gzNhrYGP",
+	"CZvCtB2rmNf5yUgBdO6V8VIIPaAUtte6WTTGQKfoq1NWffdzoJN+MMquaS/E6fC6SRchNsTG2d5SVScx",
+	"a6X2GJxCYD6HDGsv7MwE+bcl8Cg14NircBGWeZQYkoVoUayyclLLRg1rQY8EtaAPA+lHqPxxA9tH6h71",
+	"P7yNcGyLfzCFNOTwIjhEOMOidq4WCNOuZEiwxz1MTZC8N778kSKNU5gsgx5C1I8p54DkZT0ifIWQPiOh",
+	"czFmKpxI0zlElNjuUBeWO6aiR5Rq9kgwPJcgNE4/exw0XiY8AgzT9eBJ68SfH+0wocU5wP1IkTCn+Xt8",
+	"r8OWCKntPXd/bmbvwhgkx6NwKZDXeeqK7QOcw97Moviw6kuU+sbmII9E0X6N30vQlBXKOcfTULkj1osb",
+	"E1/LHkhuXeUPg4ja68HXAAHlf/O5ru0sBbuBeLfQx8SkR/ctTpJxFJsRlgZ6HmZmdYBn11vxUP9CG2md",
+	"FcII8JO+APdmxGUIRXikbMxInQgSoZ6DdJcBknwhFEy08OGiB+RRtsDtwp7CaJmj8NaKTDog44FdUW85",
+	"mrd1TR6sQEyx/Ax1QTQxVoiEFWWWiYg4Cj3h4rhnh76y332eJl9RdreZqA/v4VxM9rpp+xBipjqYj0/X",
+	"nDjh9mA23kjudISFiXEOcuKdUdpVcngz4zBqIvMqs6wvPpvBCjc4leMObpY0zmTdVbZUAFFSoRvYnln1",
+	"tUsvFHY8Btq+gSzoUW7+FlGc1OamUnAvTgLeb5sJuRSimPR4OFx2S/u0D8MNM16pxFxWPsLOvOIeNY+N",
+	"mYR8gob14Pt2u9z6wjVlCRzyx1NCLriNcvZucM2i163J+SO9a/4NzppXtliXs6RN3/F0uCgWzZL35H5+",
+	"mB08r483KeD5vee3gxwxu97wPl/fW6yu1SxNPx2qnuv6qbVEqIj8LBQpAerKOrR8hSwhoQcgmFwqyoKG",
+	"fk6UOEcYogqRiiY6JgGWGSqNqXgyBEgDH6BuqaFwgycR4JyF92S6dp99LmcxJxJqH7Njk1q7PNGWias+",
+	"1V575jBLkzPOhYR4RvSXtznv/flF1oQuoXLGtKRye0zq6SaqUmrUXizv9foODt/1Qmqn7y4Oi0LcTpCt",
+	"TUKhupQ6y7RTzWvbl8au+5mjPoPIfZwqJyJuyZLmJBNSQhb3SBsILVQrIWFiahskjZuv2FybR8IK49M5",
+	"KcSCiNKoUG1NyTQF9c1VcU5R9oLIJTeJAks7ZqWuT0THA6c0t691M5mgvLa3ZpHf/GvTx6bhqVOK2kVP",
+	"rKtTT5wUKJfU0mHINu7Ci4RjE8q1jQppEXnONkg3IFNHfk60NLF9rgWO3iAhPPhUAlkxpSwogZZuWVFg",
+	"Fhy2qfkBBL/GNGp7ZOdLjOdYM3TcbWZEwh5GUs4gpJGKecBVnFCS6KUU1WIZlVoJcHrFiaycWiUe5UdV",
+	"oW81hrqbKZ6TlVDaPYvtSPWSa1f2TzLBtRRF0VREWzl/4ZxXvqebiyzTr4S4MZmNHuMjHHUKbqX52KeG",
+	"accg1DPJVl7bYS8F4+iK5KH2V6yw7QwAnkEM5p0t7tcxnO2zREVgvt/PXPfb5S66C2uvq8ln02+hC06o",
+	"FiuWpY/bH8uLv9f3PsW9UqiwPVw2LWyGfCC+x4JbJnLPLpqB02RF6gvieIRzT0NOZP6LYnx7XDIHqjtz",
+	"R3dol+84AWuS9YqBLQAQUpvQRVfSVsuPhbTAcMTC6iTRua4N6MALB32Y7webGeHkQGm4F1CdqIoA4CdW",
+	"gzG2CX1thIYJ2HXfH9cZf48C/m43lTeYR59z+FVNWhKbhIR8PRwhXdRlpyf1NSbzmQ31p1beyj3w8o8A",
+	"6PewbsAwyM/6UDDm1IThTKjuufdRBzaOnusuVjwa3ZfGxVlIRitfVN2MXUlwCeKs9C+b5vCS6qW/VU3z",
+	"rkbc6DCdph+tc1gSfRyZY6GwFdNbGgVRTgpYQ8Px3NKyqlAKZWvwfVXoTHKAEj0W2oq2lEd1hMe29sWt",
+	"fRL55A7BblIdYxFrd4rs0bUkNUMbPrHHRA09SgaiNcsr2sCfOlTkaOoSzVFOoKrzfJj4J+bQaX60I7z1",
+	"A1z4/ilRxmPi/TA+dDALSqNuFwPaG2FRqb5Tz9MBFnFKxmAowtny4JdhSbzmG6qkt7xfq9kl+folNnCf",
+	"mOARYr/eQIZSjXsKQe4eQz2WE2fCRGrnALl9MJguCW3+Ejjhon4RoUrTv2LqpNT+BzsxNmLcPbSP8DGp",
+	"4yDuv7MEByOqlTQ2uRM1Wd9Px/+bnMSdB7F3vBSNKHApCXaoxjx1u2cHNhBVkRNu9tPI/lhu3d1ijouP",
+	"yazyAxlFhq0HHz9RX4K35woem5jsiny2VVQkW3TbG6yrBWFRpJuxiAuJ/3ChyT8qWrD5FvmMBd93I2pJ",
+	"DQk5A7L1AnLxI2bi3eLV2APmFTHCT2XXzYaOGQ23NaNEQJuL3FfAFGRFbyDeBnRwsvwz04ZxqmqGSg1z",
+	"Zbe2s4sFt3ifZm5F81gJgAmztw3u4B2uTe8/1+4+8VQ+j21Z0AzyRh3PJp8xwlAgLr2E1e50DV2+5knA",
+	"t4qIVvp0P/kR2tQDWVcqdrGv4GAD7OgZ0aw3eJplDFQKt+rG7Uh0MWgpp96F08Sid5YUV03ft7i4iPzD",
+	"7E4y033fMoaA/zvalYZ7RSdC1xcL7V8PNnmIXWgkFEvAatXgM7GZSJirfY402NoAXwOsgu6W8UwCVdbv",
+	"6PK1e7bWidwZN89o63UezKphlBzmjNeslvGy0olXEOZz59sIYbE1AdHaY5vrkzGMKLqmxes1SMnyvo0z",
+	"p0fM47TzBhJvQXF9EwqQcCN3B2CqfgFiXohaPx83M9e/dYazvt9KU55TmcfNGScZSE2ZMZ9v1fGmqmB1",
+	"2GesopEs1Mx6FJmtkLQtIMXWWZvvaUgKANITWpQGWIKul5C0AlnFkBY9hp8uDH8IS9CKbozxELMX9BwI",
+	"l68fTYfYDF08M8qtdDds3X4eU3du9zRYSckxIi1w1iFT7D73r3Er8RH6I2d658m3Gs52OgnrqW8Ppkcq",
+	"X9ThRZZYuuexzNKTlc0sIF5U9emWPO1BtIlJl/6OVr1nF9G/wqWPiVXowwsAN104EjeM0ytMUN+gdgQQ",
+	"1S7GiGvlFFEdj7e2osIiZeyytByop7PafX8v9YBnEA3KnfXmtMFBx4xzSNXk3XlZJqUoJ9kQ31ZbbC23",
+	"AHhImzD20EdkQuhZd/C7UaH8YEyNzTqEhxaO7q2DuM9WVma7VAZ9SqYejt40YIg58jI8wla1JmSsihn7",
+	"x7k3djeVaIFJEEokZJVEJfMt3e4vpttTRePqrxefPX32y7PPPiemAcnZAlRdm6VVjLZ2TWS8rTV6WGfE",
+	"zvJ0ehN81iP8HKyXPmwzbIo7a5bbqjqpeqcU7yHa6cQFkDiOiQqfR+0VjlMHpfy+tiu1yJPvWAoFH3/P",
+	"jP9HujZWkKsS5pfUbkUGGPMCKUEqpjRw3bKfMl07ZaslKhex+sHa5rgTPAOvfXZUwHSPL1dqIX0+vcjP",
+	"zCfibE4mA0HheJW1E+1al3unWf0eCo3obmN0YKJ0oj2bkxREGOMjKwh6dac2RX165KYbmK112E0RonN+",
+	"T5Oe8fjAl7CYk93cvjYzekad4PRmExPihT+UR5Bmn3WjP1/SMZykNgz8bvhHIgHUybhGWO7H4BXJ98GO",
+	"rAYXHa+JkPxoEGjdRD8J8kAAeuL5G0HXUYhjVGNBWhsDWiO8+bktfnxfm6X3RqYgJL7DHvDiWPy6XQim",
+	"cOD8xgUKvg9IiZbyvo8SGsvfF97vWW+4SKItckoTrUFZtiS6YmGU0EF9FfIk9LxKOukUpBCamJdpUSTS",
+	"MFg9Dp6pmHAY1yDXtHh4rvENk0pfID4gf9sfuBWH3cdItqhUJ08s/IoOAqugDwsVf4O5If7WExx8wYmb",
+	"xRn+O3cgqoRoYb2958ECDtzHD6Nj19PPycyVLSslZEy1HQpuvUgTop1BGoscTmES/rYir+9d7uwnoe9x",
+	"HObeH4j8EBnZgueAg7k+6r8xc+rhAMnTkiLVDqEk8JfidSbB67A6V/ctcXVcSrooAe2BKenilWGC4MHL",
+	"w3Xg5VUp6K5z8K3fwG3iwq/XNjTn4uBKWaY84WxIYsR0VSvTHXM1nqS81f2LWz1IokaLSjeGgyRJWLXI",
+	"vS/7UstfMsqS0dxFI+6ndwIDAkx4kpjbR8G84na8UMgZY8U9WxfzcfBiENx0e0He8SfGW8K/Ldyfzz77",
+	"fDQeAa9WZvH199F45L6+T73U8k0yrrROBNXxEXVVUR4pUtLtkGD2vamfkvitM109vEijNJul33R/NXuG",
+	"D1cXgHDJkdUje7E3qMv/9K8EVjuJoXVYw4mxJFmntwpbsS/T1U99KR9tCYueqkUt7msKHO21xccFpe7G",
+	"I5fxEass/eJqbj7stnsIenKHuqXfJ42dRUxirY3Jo6mipIQDCku5bolKP+YwGhU809srg3+vdme/3KSS",
+	"mX0b0ou5nHXBAu9kXy1ugHsfszoZWaW8dP2toAVKn9YxgAPRQhRT8rWtdOSuxb88mv0HfPqn5/n5p0//",
+	"Y/an88/OM3j+2Rfn5/SL5/TpF58+hWd/+uz5OTydf/7F7Fn+7Pmz2fNnzz//7Ivs0+dPZ88//+I/HhlK",
+	"NyBbQH0Fsxej/zW5KBZicvHmcnJtgK1xQktmMrjd3aGGbS7M8hGpGV6xsKKsGL3wP/1Pf1FOM7Gqh/e/",
+	"jlxd29FS61K9ODu7vb2dxl3OFpgDZaJFlS3P/Dx34xbGL95chrgg6/uHO1rbnKajmhQu8Nvbr6+uycWb",
+	"y2lNMKMXo/Pp+fSpGV+UwGnJRi9Gn+JPeHqWuO9nWA3gTLmiYmchdPRu3PlmzApz92kR0hmbv5ZAC710",
+	"f6xAS5b5TxJovnX/V7d0sQA5xYgx+9P62Zl/e5x9cHll7nZ9O4u90c4+NJLz5Ht6en+qfU3OPrh8NXsG",
+	"jNWjZ87PNeowENBdzc5mYnNAU4hX178UlDbU2Qd8o/f+fubu6/RHVKPYk3bmhZCeljaXSPqjS48m69Re",
+	"6XYNVH/QG5bf7ZnWtInmzYwxvirPPuB/8HBFK7d1C870hp+he8rZB5Z3P3cQ1vy97h63wHTbHjgxnyvQ",
+	"ez6ffbD/RhOZnMmSmTcqLepfbfbWMyzrve3+vOXOmaKAVMK2H7kCq4uzHYjpUEfsBn5zmfvGV1ue+ce0",
+	"99dGLvLs/NxO/xz/M3L1bFvZs87cuR/Ze3+vSrhRKQB5dMsaEOB1uc70dIQwPH04GC659dE2TNteLnfj",
+	"0WcPiYVL7tK6YUs7/acPuAkg1ywDcg2rUkgqWbElP/LgZm6vN4wST1HgDRe33EN+Nx6parWicovS9Uqs",
+	"QRFXni4iTiLByFj2TYNCc03DeDVSw29+HpXVrGDZaGzrQrxHqU6nBByvou7O5NXz9eDNU/Ht3jMxfBea",
+	"cvOOdF2D4Dwg3r4lmNqZE6mzO1vvyaLt+2GheJTau9G/eMS/eMQJeYSuJO89vdHVhnk9oXSR+RnNlrCL",
+	"VXQv0ujuH5UilSrnagcfceUP+tjIVZON1D7Ooxc/d0PYHTWj9mDq3zxGoK+fJDIwJH+u0aEj2s/BVT/b",
+	"1pb+b+9/F0LBV5T7k96gBetpQWXBQAb6oLxbtPNf/OH/G/5gixFTu69josF4Y0dcQQvkClZRZ2kC812r",
+	"wRyikd29lsAbP595pUjqgdts+aHxZ/PRppaVzsVtNAuaE60Fvfs0MR8r1f777JYybfT8Lrk1ZivudtZA",
+	"izNXirT1a13fq/MFi5ZFP8bx8clfz6h7o6S+IRfs69h5bKe+undiTyMfmOE/1yq9WEWGHDgox35+b7ic",
+	"Arn2zLnW+Lw4O8M4v6VQ+mx0N/7Q0gbFH98HwvrgWXYp2dpAY75tJkKyBTPp453KpC6/M3o2PR/d/b8B",
+	"AMiqPEN0IAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -131,231 +131,245 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3MbN5Pgv4LibpVjH0nJjp394quv9pQ4D23s2GUp2du1fAk40yTxaQjMB2AoMj79",
-	"71doPAYzgyGHlGwnVfeTLQ4ejUaj0ejnh1EmVqXgwLUaPf8wKqmkK9Ag8S+a5xIU/jcHlUlWaib46Pno",
-	"jBOaZaLimpTVrGAZuYbtdDQeMfO1pHo5Go84XcHoeRhkPJLwz4pJyEfPtaxgPFLZElbUTqs1SNP33dnk",
-	"v08nX7//8Oxvt6PxSG9LM4bSkvHFaDzaTBZi4n6cUcUyNT1z49/u+0rLsmAZNUuYsDy9qLoJYTlwzeYM",
-	"ZN/CmuPtWt+KcbaqVqPnp2FJjGtYgOxZU1me8xw2fYuKPlOlQPeux3wcsBI/xr2uwQy6cxWNBhnV2bIU",
-	"jOvESgh+JfZzcglR912LmAu5orrdPiI/pL3H48ent/8SSPHx+NmXaWKkxUJIyvNJGPfbMC65sO1uD2jo",
-	"v7YR8K3gc7aoJChyswS9BEn0EogEVQqugIjZPyDThCnyHxevfyZCklegFF3AG5pdE+CZyCGfkvM54UKT",
-	"Uoo1yyEfkxzmtCq0Ilpgz0Af/6xAbmvsOrhiTAI3tPBu9A8l+Gg8WqlFSbPr0fs2mm5vx6OCrVhiVa/o",
-	"xlAU4dVqBpKIuVmQB0eCriTvA8iOGMOzkyQrxvVXT9t0WP+6opsueJey4hnVkEcAakm5oplpgVDmTJUF",
-	"3SJqV3Tz99OxA1wRWhSkBJ4zviB6w1XfUszc97YQDpsEoi+XQMwXUtIFRHiekl8UICXhVy2ugQfqILMt",
-	"fiolrJmoVOjUsw6cOrGQiA6kqHiKURH84NDcw6Ns3/tkUG9xxNvd3xRbuE9tqC/Y4nJbApmzwtyX5B+V",
-	"0oGAK4XbvgSiSsgM782JGcYgX7EFp7qS8PyKPzJ/kQm50JTnVObml5X96VVVaHbBFuanwv70UixYdsEW",
-	"PTsQYE2dU4XdVvYfM176qOpN8i55KcR1VcYLyuKzYGjl/EUfZdgx+0kjzSDPgtyA++PGutycv+hjqbt7",
-	"6E3YyB4ge3FXUtPwGrYSDLQ0m+M/mzmSFp3LP0ZWvDC9dTlPodaQv2PXKFCdWfnprBYi3rrP5msmuAZ7",
-	"FUZixgky2+cfYslJihKkZnZQWpaTQmS0mChNNY70rxLmo+ejfzmpBb0T212dRJO/NL0usJO5jCUYxjeh",
-	"ZXnAGG+M8IiiVs9BN3zIHvW5kORmybIl0UumCON2E1HuMpymgDXlejo66CTfxtzhnQOi3gp7SdqtaDGg",
-	"3r0gtuEMFNK+E3ofqIakiBgniHFCeU4WhZiFH744K8saufj9rCwtqsaEzQkwvM9hw5RWDxEztD5k8Tzn",
-	"L6bkh3jsG1YURPBiS2bg7h3IzZiWbzs+7gRwg1hcQz3iA0Vwp4Wcml3zaDBy2X0QI0qVS1GYK3AvGZnG",
-	"P7q2MQWa3wd1/stTX4z2frpDid4hFanJ/lI/3MgXLaLq0hT2MNR01u57HEWZUXbQkjqvEXzfdIW/MA0r",
-	"tZdIIogiQnPbQ6WkWy9BTVAS6lLQLwos8ZR0wThCOzYCOScrem33QyDeDSGACpK2JTMrXt0wvaxFroD6",
-	"aed98dcm5NSeE7PhlBnZmBRMaSMM4WYqsoQCBU4aFAsxFR1FNANoYcciAsw3kpaWzN0XK8cxTmh4f1lY",
-	"73iTD7xkkzDHaosa7wjV0cx8L8NNQmIVDk0YvilEdv0jVct7OPwzP1b3WOA0ZAk0B0mWVC0TZ6pF2/Vo",
-	"Q+jbNESaJbNoqmlY4kuxUPewxEIcwtXK8ltaFGbqLjdrrRYHHnSQi4KYxgRWTJsHMON4AhZsDdyynin5",
-	"jmZLI0yQjBbFuNZLiHJSwBoKIiRhnIMcE72kuj78OLJ/KOE5UmD4oAYSrcbpNKbkcgkS5kLiQ1UCWVG8",
-	"nFbmeVQWzT6BuSq6gpbshJelqLSBMXq5nL/wq4M1cORJYWgEP6wRH/zx4FMzt/uEM3NhF0cloKKF8ayo",
-	"8hp/gV80gDat66uW11MImaOih2rzG5MkE9IOYS9/N7n5D1BZd7bU+UUpYeKGkHQNUtHCrK61qIeBfO/r",
-	"dO45mTnVNDqZjgrTLzrLObAfCoUgE9qN1/gfWhDz2Qg4hpJq6mEop6BME/YD72yDKjuTaWD4lhZkZfVm",
-	"pKTZ9UFQfltPnmYzg07ed1ZV57bQLSLs0OWG5eq+tgkH69ur5gmxOh/Pjjpiyk6mE801BAGXoiSWfbRA",
-	"sJwCR7MIEZt7v9a+EZsUTN+ITedKExu4l50w4wxm9t+IzQsHmZD7MY9jD0G6WSCnK1B4uzXMIGaWWlV9",
-	"NhPyOGmiY5qoFfCEmlEjYWrcQhI2rcqJO5sJ9bht0BqIBPXSbiGgPXwKYw0sXGj6EbCgzKj3gYXmQPeN",
-	"BbEqWQH3QPrLpBA3owq+fEIufjx79vjJb0+efWVIspRiIemKzLYaFPnC6fmI0tsCHiYfTihdpEf/6qk3",
-	"iDTHTY2jRCUzWNGyO5Q1tNiHsW1GTLsu1ppoxlUHAAdxRDBXm0U7eWv73Y5HL2BWLS5Aa/MIfiPF/N65",
-	"YWeGFHTY6E0pjWChmkYpJy2d5KbJCWy0pCcltgSeW9ObWQdT5g24mt0LUfVtfF7PkhOH0Rz2HopDt6me",
-	"ZhtvldzK6j40HyClkMkruJRCi0wUEyPnMZHQXbxxLYhr4berbP9uoSU3VBEzNxrAKp73qCj0hg+/v+zQ",
-	"lxte42bnDWbXm1idm3fIvjSRX79CSpATveEEqbOhOZlLsSKU5NgRZY0fQFv5i63gQtNV+Xo+vx8dqcCB",
-	"EioetgJlZiK2hZF+FGSC52qvNsdbA1vIdFMNwVkbW96Wpfuhcmi62PIM1Uj3cZb7tV/O1EfUlmeRKszA",
-	"WEC+aNDqR1V59WHKQvFAJSA1mHqJn9Ei8AIKTb8X8rIWd3+QoirvnZ235xy6HOoW42wOuenrNcqMLwpo",
-	"SOoLA/s0tcbPsqBvg9LBrgGhR2J9yRZLHb0v30jxEe7Q5CwpQPGDVS4Vpk9XxfSzyA3z0ZW6B9GzHqzm",
-	"iIZuYz5IZ6LShBIucsDNr1RaKO3x2jEHNaukBK5jORf1GUyRGRjqymhlVluVRIvU/VJ3nNDMntAJokb1",
-	"uDkEVw3byk63pGsgtJBA8y2ZAXAiZmbRtZcDLpIqUhrZ2Yl1TiQeym8bwJZSZKAU5BOnz94Lr29n7x+9",
-	"A3m4GlxFmIUoQeZUfpwVXK/3An8N28maFpURz3/6VT38syxCC02LPVuAbVIb0VbfdZdyB5h2EXEbopiU",
-	"rbbQngQjYhumU4CGPmTfHXu9298Gs0MEHwmBa5DoUfNRj5af5CMQZYD/Ix+sj7KEqpwYMbBX/WAkV7Pf",
-	"nHLhZcM9M4QJCqr0ZN+VYho19CZmqREXT90iOHCPPPmSKo1iIGE8R/2tvQpxHitbmilGBzqV4ZS9rzEz",
-	"6a/+IdadNjPXO1eVCq8yVZWlkBry1PLQZt0718+wCXOJeTR2ePppQSoF+0buQ2A0vsOjUwTgH1QHC7Wz",
-	"eXcXh14HRnzZHorlBnw1jnbBeOFbRYiPnWp7YGSq3gNLbky16G0mRAEUVaZKi7I0HEpPKh769WHwwrY+",
-	"07/Ubbskac1AVlLJBSg0Mbn2DvIbi3SFtq4lVcTB4f0TUOFlXeS6MJtjPVGMZzDZdV7wEWxaxQfnqONe",
-	"lQtJc5jkUNBtwtvCfib284GE4cdGAqn1B0LDZIbWxDSN1GfC+5seN6vAqVRK8Cb4hWTmnJtnVE1qrvfx",
-	"k+aA06b4piPWB2EWBCNJB348RJalp8SIePevhTZk5YgOV+NupTuupQd7YdaPgkAcd1IrAtqz/xcoN3cQ",
-	"wO51/i2ovoXXU9/XsnvU/3i3Ny7M1lXWum2SV0QvX97DGPt4UI8t4g2VmmWsxOfqT7C999d7e4KkrwTJ",
-	"QVNWQE6iD/YlX8b9iXVDbo953Gt+kLq1C35H35pYjvfMagJ/DVtUm7yxEQ2Rtuo+1BGJUc2FSzlBQL3X",
-	"vHnxxE1gQzNdbI1gq5ewJTcggahqZr1WuiY0LcpJPEA6Zqp/RmeQT5rDd3oIXOBQ0fJSnof2tbUbvsvW",
-	"k6uBDvfKKoUoEvrP9onvICMJwSB3IVIKs+uMFsWW6BA24ympAaS7INAbI8gzD1QDzbgC8l+iIhnl+MKt",
-	"NAQhTUiUfFBYNjMYcTPM6VxVawxBASuwr3n88uhRe+GPHrk9Z4rM4ca63HBs2EbHo0eoinsjlG4crnvQ",
-	"dpvjdp64dNBWaS5Z92pr85T9Tm5u5CE7+aY1eDBwmjOllCNcs/w7M4DWydwMWXtMI8Mc/HDcQea7pktY",
-	"Z9247xdsVRVU34ehEta0mIg1SMly2MvJ3cRM8O/WtHgdut2OR7CBzNBoBpMMowQHjgWXpo8NLDTjMM7M",
-	"AbaBI0MBgnPb68J22vPSrv2W2WoFOaMaii0pJWRgo+SMlKrCUqfEhkxkS8oX+AKSolo4V2c7DjL8SllN",
-	"mKx4Z4hDRTG94RM0YahkmBqaLX20pRHCgJqXbdv+YR9rNzSAYi+jQZd2tD1te1DSZDoe9T78Db7X9cPf",
-	"4q0ZMnqsMbEhH0ZIq6EZaD1DfBpZqYvEeBvN4TPE8HGsNPXQKSi7E0dO4fXHPr/wi6osi+09CEl2ICKh",
-	"lKDwSovVgMp+FXPyimVSnBULEe48tVUaVl3jje36W89xfXvMC1jwgnGYrASHxJP+NX59hR8Hqx3tNdwz",
-	"IgpEBw3Yfvg0kNBaQHPyISR9101Ckmmf/balU30v5H1Z2e2Ag98UAyzXe9063JTH2tdpUSRM0lb90OEi",
-	"ahycwpkkVCmRMRQUz3M1dt7n1opt3dpb6H8TQqPu4QC3x23ZXqMwLKvIh6IklGQFQzW/4ErLKtNXnKKm",
-	"L1pqwlnQKwf61cLf+iZpPXRCTeyGuuIUHUWD/i/pGDSHhB7qewCvHVbVYgFKtx5Yc4Ar7loxTirONM61",
-	"MsdlYs9LCRI99qa25YpuydzQhBbkD5CCzCrdfHKsKqWJ0qwonCHYTEPE/IpTTQqgSpNXjF9ucDjvR+KP",
-	"LAd9I+R1wMJ0OONaAAfF1CTt6fiD/YpBJQ4nSxdggrEW9rP3eK5zQ4zM2htJK/7PF//+/N3Z5L/p5I/T",
-	"ydf/4+T9h6e3Dx91fnxy+/e//9/mT1/e/v3hv/9ravs87KlgcAf5+Qv3Rj9/gQ+xKE6kDfufwSCzYnyS",
-	"JMrYoahFi+QLzJfhCO5hU++nl3DF9YYbwlvTguWGF90b+bSvqc6BtkesRWWNjWup8TwCDnwO3YFVkQSn",
-	"avHXjyLPtSfY6XATb3krxsBxRnXvALqBU3C150y51T744btLcuIIQT1AYnFDR6kFEi8YF8HY8PIxuxQH",
-	"dl3xK/4C5vgeFPz5Fc+ppif2NJ1UCuQ3tKA8g+lCkOc+KPIF1fSKd66h3gRSUVBzlEEqxSnoKr2Wq6t3",
-	"tFiIq6v3HT+Ermzlpoq5qDtnXTWZn3Ji5AZR6YlL4jKRcENlyhbiU3y4aGjsvRMOK5OIyiqxfJIYN/50",
-	"KJRlqdrJHrooKsvCoCgiVeXyFZhtJUqLEDhmmLmLvTU08LNwTiWS3vgnb6VAkd9XtHzHuH5PJlfV6emX",
-	"GIJXpzj43fFAQ7fbEgY/fHuTUbTfu7hwK5ejU/mkpIuUzeTq6p0GWiKFoMCxwpdmURDs1ggP9JEAOFS9",
-	"gBCLfMCWWMgOjuvF5V7YXj6tV3pR+Ak3tRk7facdjKLij97APZH1tNLLieEIyVUpcwz8XvkEA3Rhrhzv",
-	"QaDYAh8Aaikqs2Qg2RKya5fZClal3o4b3b2ji7uLPcNhCnVGLjhwzgz+MsrNgFWZUyfIUL5tp7hRNhgC",
-	"B30L17C9FLb7dGB2sCgbXZRiRfUdXaTd6K415BsfZDdGe/Od35WPEXXpSDDu0pPF80AXvk//0bYCwD0c",
-	"6xRRNPJ89CGCygQiLPH3oOCIhZrx7kT6qeUxngHXbA0TKNiCzYoEm/7Prl3Dw2qoUkIGbO2jesOAirA5",
-	"Ma+jmb2O3YtJUr4Ac6mbi1goWqDT/jRp6EfpcAlU6hlQvVNfy+M0Ex46FMhvMGgalSZjswTYmP1mGpUg",
-	"HG7MAw/f3raNcySeHuVOZdcE+ZGg+u51kPT0mEeEQ3gin52/78OehPeC80+LqRNBtt9XBocLKW7MbhoA",
-	"hU/diAleonuqUnQBQ6+jhqloYEqMhgUIB9kn/STlHTFvizUdGWPgImz3icFLkjuA+WLYA5oBWi6Ofm5r",
-	"QnRWhde82HqkzgoUqIODqCUdKht2Nr44DNg0GwPJa2HVA9bEWnz0l1T5o5+PI45+pLT4eVLJ7Mqfdx55",
-	"31HdzY7nr+k2ax9bfc4MiOCmh8+i51Pn+Xx5o/FBue/GIxfikNo7wVGKzqGAhcWJbezprM7PVO+mgeP1",
-	"fI5Mb5Jy5IuUkZFk4uYA8xB7RIjVmJPBI6ROQQQ2WtZxYPKziA87XxwCJHf5pagfG++u6G9IBwtab3wj",
-	"JYvS3Pqsx2qVeZbi0lvUIk/LxRmHIYyPieGka1oYTuoCT+tBOrna8O3TyszmfDse9r2JBh40t0aUTg5a",
-	"pZVnjllfLHj7ZaRfBQetYSY2ExsZnXxazTYzcyaS8QoYp506vDZz3gNFZmKDPkV4w1kH94Oh64fMAxa5",
-	"gWyYQirHfn1iowXvMEB2C/IpalZIek6vFsiuT5I9DpgecbqP7L6IUujdE0gtBWadBtxpdPbqWZrSVlcS",
-	"qa/bccgOG8LUUqym73Amd7IHo13laTPX3Y91usP+5Gj+rH6SJH9dpdxd8jLazqXNtXhIWsY2OTSA2IHV",
-	"N20hNonWpuNSE68R1lIsyTD6rrGrizYFBaAmYNKQqyfXKbP01dU7BSgzXPhukZ4Td4/y7cPIG07CgikN",
-	"tXHBO7l8etsPqhPNY0vM+1enSzk363srRBA0rDkWOzaW+clXgK7rcyaVnqBlJrkE0+h7hZq0703TtCDc",
-	"9Ldjypp6DpaDEaJr2E5yVlRpUnYg/fTCQPRzuLlUNcOLknHrbTTDVPhJB90DbJMIj3Xs3omglxZBL+mn",
-	"wM+wg2WaGpikobzm9H+RI9bihbs4S4KWU8TU3dBelO7gtVEsfZfRRkJ05HYx3WXz6ZzL3I+91xvLR/T3",
-	"CRF2pORaooyI6QBCsVhA7jO9uaBQm/XK5dMrBF/UuQTN7zvSB06JzeKHSfh25O9z7unQ55zeKCeCVTGS",
-	"0MePGYS8jq7D3IM4yQK4zdwyOrzeSJFEXOwYjy0izein5e0dt/mk6/Bly1249um1exg2G7enAJq7Z5UC",
-	"v77dh7a7XQ514z6n40aK2N0HDAdEimNaRQJMh2h6ODctS5ZvWoY/O+r0CJIYKO51M8G3cMb2oqXpT7yn",
-	"RM8Dcylie2fjOMHX/Yl5W1o3ZueIa44EzVySgbySaERqOAl30+iH9+XAJf/064UWki7AGQInFqQ7DYHL",
-	"OQQNUSZ6RTSzftE5m88hNoCpY4w3DeA6Zo58AD33UF7XShaelDvJ8mDaqlewH6FpekpQSp+rxWXXDOnf",
-	"G5FKLdwx0cYdYUtM5hH4CbaTX2lRmQcQk6p2SXV2weZtfgBNrFc/wRZH3uvpaQDbsyuogXsLSKEpo0r4",
-	"pKLk4A9Uo+gCPn0bW3jATp2ld+metsZV0Og/GvXF1Cgj0VzKxzs2tWeMgXTIXl2knU3M2YLmtrQJfd8W",
-	"sXy/yBO9POKpGDptHHO3hQQbe53KgBae8HGxo9vx6G5uHl0WFkbcsxNvwo2c3AV0wrRm/4av14EbQstS",
-	"ijUtJs49pk/WkGLtZA1s7r1pPvGzKn0qLr87e/nGgX87HmUFUDkJGo7eVWG78i+zKlt5Y/c1ZLOwO5Wu",
-	"1YBFmx8yZccONDeYcb2lROuUuKndpaKD6hxq5mkH8b1803l22SXu8PCCMjh41YZo69/V9Omia8oKb+/1",
-	"0A5VrtvlDiuqlOQT8QB39g2LnP7uPJZif8AEPUtFj1+WCvh1N6PzRGUGlxgDZ7FtsdymjVffvD1883tj",
-	"Fq6u3q09OLVtxzpthfT8Cb8+daTXdYcBphlIfQD3sG1E/mvMqpp+A3KXcxW5tXN+o/cunH4vZOP2dBGW",
-	"See5jye1mheOxWPaQeDSeQR0ZNUpsXLt74vfDcN69CimuEePxuT3wn2IAMTfZ+53fNw9epQ0UifVjoaP",
-	"olaR0xU8DDEavRvxaVUiHG6GyTBn61UQ3EU/GQYKtV5wHt03Dns3kjl85u6XHAowP02HqE3iTbfojoEZ",
-	"coIu+iIkgyP2ypYWVUTwdj4AjNg1pIX3oasmYm3+3SPEqxXawCeqYFnaAYnPkENy615sGhNsPNiebeao",
-	"WI+PO69YNLpppo4yv7YWEs2aRLhKZiWu8TsTjgVUnP2zgqjEMF4BLYnBv89w1I7Un9Z1uoHbFYxHxxQf",
-	"vru50gLZi6peq++LYIn060+Vujow5CKescPzd4RLOELytybG1i2d9/Jegtr55txdh9pZoj3XdEbf/sea",
-	"q8hp9/DFkA1majKX4g9Iiwxop0xkD/EGdoY2gD+Ap9xk2/wrOC/UNbPr2fcRyHA9Rx+p3Fmv4RcdCvcd",
-	"c3On2cNhG32gAiPa734VhkpnOHeb0Pdojn1fmrE8PTwMD2zkmY7lhLzHHeX2hNrUGo3gt/Q5j2NVT+z4",
-	"9Tl3MHfiewt6M6OpWkvm7Wpgira/4RuoBfGd/QapkB3Czk6icIrQltl8gyXI2oDVzdZ85DvUTjv4BVo/",
-	"OJHi4qfm2LrLFEokhqn4DeXoyoj9LAd0vRVYVxDT60ZIzDGq0m6MOWRslVTMX129y7Ou81nOFsxWNa8U",
-	"EDrXLtWkG8jWtbdU5AqKh3QoDjXnc3I6rs+s342crRk+xLDFY9tiRhXey8EtI3QxywOulwqbPxnQfFnx",
-	"XEKul8oiVgkSdAUocQZn3BnoGwBOTrHd46/JF+izrNgaHqYvGCejjZ4//nq8q3g3Yhzr1O9i8jlyeR9L",
-	"kaZsdOy2Yxi26kZNB0fMJcAf0H+f7DhftuuQ04Ut3RW0/3StKKcGISmYVntgsn1xf9GbpIUXbi1FoLQU",
-	"W8J0en7Q1HCsnoB2wxAtGCQTqxXTK+esqsTKUFhdCd1O6ofDEn++EpuHy39EL/Ay8bT/DK8suuoJskTH",
-	"/p/R5B+jdUyoTRpbsDoExBfJJec+OTaWpgsV6SxuzFxm6SimYkTInJSScY0arErPJ38zr3ZJM8MQp33g",
-	"TmZfPU2UeGtWQeKHAf7J8S5BgVynUS97yN5LOa4v+YILPlkZjpI/rLNKRKey11097WLc5/ncM/SdpWsz",
-	"7qSXAKsGAdKIm9+JFPmOAe9InGE9B1HowSv75LRayTTB0Mrs0C9vXzpJZCVkqthGzQCcVCJBSwZrDHFN",
-	"b5IZ8457IYtBu3AX6D+vg50XSyPRzZ/u5GMhsnAn3mkhs5OR9H99VafoR0O7DR1uKS2FTKhnnaLxE3vG",
-	"HqYmbNvzrUcifuvB3GC04ShdrPREnNiQktDnc7ictUGye97QkD7+nUjzjkdZ/9EjBPrRo7ETlX9/0vxs",
-	"2fujR8O9dtNqQvNrAjXH3TXtBJqmb2qrvxEJpZ0vJBpc11y2lIRiNXmXmSt15sYYk2a1xk8vd9xPyOTB",
-	"ntDpA+RRg5/buPnM/BU3sw7C6ecPzQK2SfLJw/cojIOSb8RmKBG1ri1PT38CFPWgZKBWEFfSKdCb9NrY",
-	"63IUka0ZdQaFMC/VuAbXYA+av9AuGNSMd+xFxYr819r43LqZJOXZMunXPjMdf7PPgKhBpMHIlpRzKJK9",
-	"7Wv5N/+qTrz7/yF6hl0xnv7UrgVtYW9BWoPVBMJP6cc3uGK6MBPEKGrmBAtZVoqFyAnOUxdPqVljt6h6",
-	"qphtIs0ADruqtHOMxvwNrqbJnBXo0ps2g2PLiaS6h6tKjP6d1yPC2sgpVi1hRwdJKFvhta3oqiwAD+Ea",
-	"JF1gV8Gh1R2TxuHIUWUUokrzCVti/hlBdCU5EfN5tAzgmkkotmNSUqXsIKdmWbDBuUfPH5+eng6zLSK+",
-	"Bqzd4tUv/HW9uMcn2MR+ccXHbM2Gg8A/BvrbmuoO2fwucbkKsP+sQOkUi8UPNiYcDcPmXrfVX0Ol4in5",
-	"AVOkGUJvVClApahP8txMS1qVhaD5GPNSX3539pLYWW0fCYg6rD67QA1g84gkjTzD07T6FHA96bOGj7M7",
-	"e49ZtdKTUBc2lczRtKjL2bKWJxbqBmPsTMkLq5YN/jx2EoLZzeUK8qgMrVUDIHGY/2hNsyXqO6ejnSrl",
-	"noJEw6soew5Ym4ui0NtQsws5uFmGK6Rs6yiPidBLkDdMAaa+gDU0c0aGhKtOIe9zSDZXKyvOLeFMD5Be",
-	"Q4WuQ3fBA2dFX+9WkYSstQ93tv3VyUSwzvqh9aYvsFc6dKhVvLrl7mCrdmx83Y8peeWMHRnlgrMM612k",
-	"RHDMBjnMrDqgNEja3qlG7iwnjmGyZHaIkXdY7C2i7VmmQ1zXqSH6avbbEo79U8PG1SFcgFaOB0I+9hXs",
-	"nYGOcQWuBpuhr5ijCpnw+EqG6ATPkXt0jx+PMKFbj671e/PtZ6ebx7Q114yjzs0h1b0ErYGtUAzt7Jww",
-	"TRYClFttMzRNvTN9ppcbjiC8n74UC5ZdsAWOYT0QDVKsR3J3qDPvn+z8gU3bb01bVz4h/NzwpLOT+nW/",
-	"T7IQFfY/Vfa9F/0ply8fIRchN4wfj7aDGHeGHeC9bMgQ1ujwByXe5x2yCRX0m6N8Z56slt6wBbHBw8nM",
-	"xYwnwHjJuDf4plNxZcm7BDcGT3NPP5VJqu2jYxDHuwRa9ITmYFy/9Ri461DtYhAGJbhGP0f/NtbF/3vY",
-	"SmhQvy4o3xJ/KAx1R0LJt7QIjvmJUv4onTlhzPoIt4r7p9iKYesTHx3cQNfeWNTQHQuyHHpP9SU8nVX5",
-	"AvSE5nkq9d03+JXgVx/cCBvIqlCHLIS6NjPGd6nNTZQJrqrVjrl8gztOlzNFlYLVrEh43L4IHyEPO4y5",
-	"sGZb/DdVhKt/Z5wD/sEB6N7bPj+sTEI3oD4lPRuanii2mAzHBN4pd0dHPfVxhF73v1dK97Hnf4rQ8haX",
-	"i/coxd++MxdHnCm849pvr5aQyBvd6AV+9ynJQjLZJlfCq6xTag49MnDzElvWAt43TAK+pkVP0ofYamPv",
-	"V2vJ6Ev9kPVmNqHaJdDTlNQ8YYgKoz8FmXW8blmGuubNPtdq61n9MY0nDh87kd5vafypYVe0Xm81Q+m1",
-	"Jx5n8quJ4FCbn6sG0dWX0qIQ2WDO4IY5M536swWL1col30945a1XIo/PQuzNBZBmbNZhORFRgQ/b5Dd8",
-	"WiW/yJv0aA39SCCaoYnTEI1uCWMbJOrB88DYqeOJIpWtwyz5nhVYn+o/Ll7/POrfyGgHulvqsncnVdh9",
-	"GxOi5trksRANfOzgAYIXaf236lGpY3qq9GlwBZKTH763CsIhINlUTYe0fjl08A4BLIQtTJUq3dFNkDOq",
-	"t8MjP6KGenstR4mpI0UV7YJPibePVXrWTUiohTqoNmpDRhpSXypVysi9FLwG1l40LiWere/UKQ3VYaAv",
-	"hgiHHXzcjkfn+UHiU6oc1siOkmKwL9liqb8pRHb9I9AcpC1pknpO2oImKzDPULVkJb5/SqFYXZK4MIO5",
-	"XOJLHG46NCLncgkuMY1PWNAZyztQryHTWKK6dgOVAMP9HMr0Eg0E3qCITT6DK4gEyKHUy53CknXuLvWy",
-	"rlwKLuCMKTIDZ7pYAx8TNoVpO0Ytr/NSkQLo3CthpRB6QGlfr22xaIyBTtFXp0z0bjGwk3Yuyqpoq/lO",
-	"h9eBOQsxATa+8oaqOnlVK6XD4NDx+RwyzLm/MwPgfy6BRynhxl51h7DMo4SALEQJYtWIe9Vo17DuysW3",
-	"E9SoLNbHhLQvOcc1bB8o0qChZFHiEFh7TBJ6RI614/q6Bn2mDecYyVSgJ0SQ94N3NQDqMk/H1CGIEmQe",
-	"CYancXM91Ukzj4PGSzRHgGG6Hjhpb0Y+FEz7Egx2C7z3v5RfYD195ZxKach4H+uTyHm3IvSNy5iPuR6D",
-	"tdDnzgflf/M5Yu0sBbt2RXIQYdY2e0Nl7lvcS6Y+e2+yNNDzMDOrA6O6Xj6H+uXYCMWsEEYAmvQFhjYj",
-	"lYIL7wNlfa3rBGoI9RykhDzYBAuhYKKFD7M6IP+oC5/cgT3rZX4U3loe/QdECtsV9ZZxeFvXssCKlBTL",
-	"NlDnfB5jhUhYUQO9jOpLpNWg+3boW/vd5zfxFQZ3q1f78B7Oxf4i3T70jqkO5uPTNSdOODiYezWSohyh",
-	"mWWcg5x4I267ugRvZurE1M55lVlRJT6bQXs9OAXaDm6WVGpm3VW2nlBRMo5r2J5YtY8vfO53PAbaypAW",
-	"9CindYso7lVXrVJwL+4FvM+bQbQUopj0WAbPuyUx2ofhmmXXgLlhQ2SKkYIfNI+NmYR8gQap4DNys9z6",
-	"gg9lCRzyh1NCzriNDvTuI80iqK3J+QO9a/4NzppXtsiN00BPr3g6zAqLzcg7cj8/zA6e18ebFBh+ecf5",
-	"7SBHzK43vM9H7gar0jRLFU+Hqje6/h0tESoiPwtFSoC6sIbgb5ElJN5RBJOyRNmD0D+AEmdAJqoQKS/8",
-	"YxLHmKHSmIonQ4A08AHP1RoKN3gSAc7Jbk+GWPfZ50AVcyKh9s04Nhmsy69qmbjqU420Zw6zNDnjXEiI",
-	"Z0Q/U5srOkS2Yapl/M+MaUnl9piUrU1UpdRQvVje6y0ZHCXrhdTOkl0cFoW4mSBbm4QCTyl1gGmnmte2",
-	"L5Va9zNHfQaR2yVVTkTckiXNSSakhCzukQ7xtlCthIRJIdALM+XYMdfmkbDCuE5OCrEgosxEDrYWW5qC",
-	"+uaqOKcoe0HkypZEgaUdTBlg+0R0PHBKc/ta8+wE5bW9tT785l+aPjZ9RZ2Kzy56Yl0EeuILQLlkcA5D",
-	"tnEXXps2DhMxtZWyaRF5zjZINyBTR35OtKxgTFwLK5DEJIQHn0ogK6aUBSXQ0g0rCswewTaRQ0PwB0qj",
-	"tkd2Pkc/6DVDh7dmJhErUpfmdgzpV2IecBEnYiN6KUW1WEYlCgKc/ukuK/ewj0f5RVXok4ghomaKp2Ql",
-	"lHbPYjtSveTaBfSLTHAtRVE0FXlWzl84o+8rujnLMv1SiOsZza4f4iOcCx1Wmo99SoW27249k2zlgxz2",
-	"UtAbPkHyUPszvdt26NXq6Hkw72xxv47hYZ8mPwLz/X7mut+ucdZdWHtdTT6bfgudcUK1WLEsfdz+Wt6v",
-	"vT6rKe6VTLBoCyHbLDTYDPlAfI8Fdybknl00A6fJSq5nxPEI59aBnMj8F8X49rhkDo4H9dyhXb7jBKxJ",
-	"1isGtgBASG0iBF1JWz05FtICwxELmzgFnVLagA68cND3726wmRHuHSgNdwKq440cAPzCajDGNhGm9Wye",
-	"iY3//rDOlHkU8Le7qbzBPPqcKi9q0pLWrdInsurhCOliCDs9EC8xCcZsqB9iqIY/8PKPAOj3TGzAMMg/",
-	"8VAw5pQVkE9ShZLPgw5sHD3XXYxlNLovKWk5eUYrX4zYjF1JcImVrPQvm+bEkhpSEqF5VyPOc9iAjdH6",
-	"A6SwpYTHkTkLCltpuKVREOWkgDU0HDZdtqcKpVC2Bt9Xhc4kByjR4ttWtKU8EeNChS3ti1v7JPJlG4Ld",
-	"pDrGItbuFNmja0lqhjZ8Yo+JGnqUDERrlle0gT91qMjR1CWao5xAVef5MPFPzKHT/GJHeOsHOPP9U6KM",
-	"x8T7YXzoYBaURt0uBrTXM7lSfaeepx2T41RmwVCEs+XBrm1JvOYbqqQ3vF+r2SX5+iU2cJ+Y4BFiv9tA",
-	"hlKNewpB7h5DPZYTlwMJqZ0D5PbBYLoktPlL4ISLqOzyDVXhFVMnc/U/2ImxEePuoX2Ejb72H777zhIc",
-	"jKhWssV0ldRA1nfT8X+Wk7jzIPaOl6IRBS6Ud4dqzFO3e3ZgA1EVOeFmP43sj2WK3S3muPiYzCo/UFGI",
-	"G1tHOX6ivgBvz7XU501MTixn4Vr2ftJjl2e4rQVhUYTIim6JkPiPeZD+s6IFm2+Rz1jwfTeiltSQkDMg",
-	"Wy8K53dtJt4tXo09YF4RI/xUdt1s6JjRcFszSgS0uch95ThBVvQa4m1ABxHLPzNtGKeqZqjUMFd2azu7",
-	"WHCL9+mZVjSPlQCYaHbb4A4+z7np/T/rsNV4Kp//sSxo5qtmu/p3TT6DhfU9ceklrHaHOXf5mieBUKy/",
-	"Jlrp02TkR2hTD2RdqZifvkJdDbA7Vcg7NcrutIyBSuFWvaUdAeKDlnLfu3A/MZydJcXVhvctLi6+/Gl2",
-	"J5khum8ZQ8D/E+1Kw72iE9mWLuIer8fWa/8Eu9BIxJOA1arBZ2IzkTBX+xxprB58JjY1wCrobhnPJFBl",
-	"/Y7OX7tna50AmXHzjLZeu8GsGkbJYc54zWoZLyudeAVhHmS+jRAWWxMQrT22uT4Zw4iia1q8XoOULO/b",
-	"OHN6bHXiuGCQt6C4vgkFSLiRuwMwVb8AMZ661s/Hzcz1b4sdWt9ZpSnPqczj5oyTDKSRGsgN3arjTVXB",
-	"6rDPWEUjWaiZLSQyWyFpW0CKrbM239GQFACk92hRGmAJQifthBXIKoa06DH8dGH4S1iCVnQzKcQCo357",
-	"DoTLc42mQ/uAFByV6Fa6G7ZuP49if8DuabACiWNEWuCsQ6bYfe5f41biI/QXzvTOk281nO0wbOvpbA+m",
-	"Rypf1OEZlli65zEVOe8SM8XR815U9WlKPO1BtIlJl+iOVr1nF9G/wqVdiFXowwtnNl04UvH5Vq8wQX2D",
-	"2hGAAaqOK6CZ8xDrKuI6igqLlLHLbnCgns5q9/291AMeKlKUO+vNaYODjhnnkGqju/MZTEpRTrIhvq22",
-	"SFHujAwO0iaMPfQRmRB61h38blQo29XIidao33VowdXe+mH7bGVltktl0Kdk6uHoTQOGmCMvwyNsVWsY",
-	"axVUMWP/OPfG7qYSLTAJQomErJKoZL6h2/1FKHuyz1/8ePbs8ZPfnjz7ipgGJGcLUHVNg1YRx9o1kfG2",
-	"1ujTOiN2lqfTm+CzhVjEeeulD3sLm+LOmuW2qk5G3ClheYh2OnEBpIJzu5XxjtorHKcOi/hzbVdqkfe+",
-	"YykUfPw9k6Io0jVlglyVML+kdisywJgXSAlSMaUNI2zaT5munbLVEpWLmDV8bXNDCZ6B1z47KmC6x5cr",
-	"tZA+n17kZ5iLwdmcCGzKwvEqayfatS73TrP6PRQa0d1mBqQUpRPt2ZykIMKYLVlB0Ks7tSnq0yM33cBs",
-	"rcNuihCd83ua9M64ewmLOdnN7ZtlwXWa05tNTIgX/lAeQZp91o3+PCPHcJLaMPCn4R+JxCn3xjXCcj8G",
-	"r0i+D3ZEhZ91vCZC0pBBoHUTZCTIAwHoiYduBK1GQXZRbnJpbQxojfDm57b48ao2S++NTEFIfIc94MWx",
-	"zHW7EEzhwPnMib1fBaRES3nfRwmN5e8Lj/asN1wk0RY5pYnWoCxbEl2xMAqIV9+GOPOeV0knHF0KoYl5",
-	"mRZFIozd6nHwTMWEY54Eck2LT881vmdS6TPEB+Rv+wO34rDlGMkWlereE3K+pIPAikKUPwlU/A3G1v8n",
-	"mJ1N3o5uFmf479yBqBKihfX2ngcLOHByg2Nax67HX5GZK/dTSsiYajsU3HiRJsTbgmRz518LG92O/b1z",
-	"maBfhb7DcZh7fyDyc2RkC54DDub6qH9m5tTDAZKnJUWqHUJJ4C/F6+IC73uunTuWhjkulVOUuPHAVE7d",
-	"0vVDl4frwMurUtBd5+Bbv4HbxIVfr21orrLBFWaurt7p2ZCEYulqMKY75ji7l7Iwdy8K80kSnFlUujEc",
-	"JEnCqkXufdlrWv6SUZ6G5i4acb+nbvzSot+Mho+CecXteKEAKsaKe7Yu5uPgxSC46facXPFHRC2pf1u4",
-	"P588+2o0HgGvVmbx9ffReOS+vk+91PJNMq60TqTT8RF11QQeKFLS7ZBg9r2pc5L4rTMFfXqRRmk2S7/p",
-	"fjR7hg9XF4BwzpHVI3uxN6jLn/P/EwDtJIbWYQ0nxpJknR4obMW+TEG/9qXFt6nfe6p9tLhvxYq9TnKN",
-	"Qiy349HCJinD6iS/uVp1n3bbPQQ9+QLd0u+SBswiJrHWxuTRVFFStwEFWVy3RIUMjLzOKsn09sLg36vd",
-	"2W/XqWRQP4T0TC7nV7DAO9lXi2vg3sesTuZUKS9d/yBogdKndQzgRuYUxZR8ZyuEuGvx7w9m/wZf/u1p",
-	"fvrl43+b/e302WkGT599fXpKv35KH3/95WN48rdnT0/h8fyrr2dP8idPn8yePnn61bOvsy+fPp49/err",
-	"f3tgKN2AbAH1lX+ej/735KxYiMnZm/PJpQG2xgkt2U9g9gY1bHNMUIhIzfCKhRVlxei5/+l/+YtymolV",
-	"Pbz/deTqQY6WWpfq+cnJzc3NNO5yssAcKBMtqmx54ufBXJaN98qb8xAXZH3/cEdrmxNuasjvZ769/e7i",
-	"kpy9OZ/WBDN6Pjqdnk4fYz7FEjgt2ej56Ev8CU/PEvf9BLNonyhXjOckhI7ejjvfytKW6jGfFiENqPlr",
-	"CbRAFmn+WIGWLPOfJNB86/6vbuhiAXKKEWP2p/WTE//2OPng8src7vp2EnujnXxoJOfJ9/QM/lRJT4aX",
-	"QlyjI41/DT1QLe8wg96wDee5Qb9tiW5P6rxmhIhi76kyev4upbF1fttlNStYZoTrqSdgszsRfYWcSzX/",
-	"QP38yPJPNJsHbmg43Onk6/cfnv3tNumo3fXZqp0dd35tr+GV80Co7zEXQYDxqhhPFVb0zwrktl4SugeN",
-	"4gUMFHeSvybtwObtWrp6TQ6uKfnFOWHhV8u4gqu7C4QtJayZqFTo1LMEM0RqBeH1+h5LGaNPM9Lck9NT",
-	"z17cUz2i3RN3JOItbZpFOy6NhyR7iV0OU+8ss5gJ4qN7LH5RNjWfwSbj1IYLYRzBil5bgzB6ChPpcgU4",
-	"jLrgA0RyCIxz2+JvkI9Yh/Fuec4sEIn8q11u3cMBfPhArM4vmDVWOKfNJRQ2OxGv85fcjkdPDySUnWr1",
-	"RpbyBPivaGFAhtwn9bIQPP50EJxz6+Vurj17Pd+OR88+JQ7OueGdtCDY0l7IGNeeOAz8mosb7lsaWapa",
-	"rajcoqSkh+yxy1CHHhC+nT0S9mKn5ni/G9lrAQuplSDZCjjWL77dd72dfHC51vZchrFp78TFaEQdBl6y",
-	"u5qdzLBm7NCmoKLG/UvBl7I6+YAntPf3E/fWTH9EE4CVEk/8A7qnpc2Dlf7YQOEHvTEL2T2caRONl1Gd",
-	"Lavy5AP+BwW+aEW2BsWJ3vATdJk8+dBAhPvcQUTz97p73AJTp3vgxHyuUB7a9fnkg/03mqhBmLVQ1RSQ",
-	"vosafbuE7HqUvhZbBXqiXsTKw3RWQG6Z09MBHbjQcaejDvRblGEUef0TYXMC7SmY8jMccG5twuoTrGC/",
-	"rXHpf97yLPljd5sbeXl7fj7xz7GUaN1s+aHxZ/PIqWWlc3ETzYKGDGu760JmPlaq/ffJDWV6MhfSJXal",
-	"cw2y21kDLU5c8bDWr3VFjs4XLDMS/RhH5iZ/PaEO1aNSqATZvqU3kRLzDBtbCQGU/kbgi6bvdtpMZowj",
-	"BcU3VK2/sB+75o7OvYQ1oLe6Nhx305JhbiQpaJ5RZSRaUpcKaD4WbpPH7lNLG9/QnPiUUhNSyx5n7pXc",
-	"WNqfQxJJspsXsIbCUAwRkuzjPZ9Zlnl2+uWnm/4C5JplQC5hVQpJJSu25Bcegg6PZsXfI3lL6tTCgeSt",
-	"T7mkN804RpnOpNOsYelzLgHRG7KkPC9c7hFRYT1fQ5voKiIiZ0VzhfmSrqWQCIDNHAy5dd9SU3IRnNvQ",
-	"VazyL6jckg3aYDFXv52EouObdX4YcJWYZ4zhBwvgE8eRJjORb10Rw5GkN3pj84l02J6VM3t4YkcKTH11",
-	"gk5PIx/t4j/XetJY74gKkaBxfPfevJUVyLXXldRqtOcnJxg8uRRKn+BTv6liiz++D5jzpeJHpWRrrD2F",
-	"SBOSmRdsMXF6qLru6+jJ9HR0+/8CAAD//7S3VlweDwEA",
+	"H4sIAAAAAAAC/+x9/XMbN7Lgv4Lie1WOfSQlO3bexltb7+Q4H3qxY5elZO9d7EvAGZDEagjMAhiJXJ/+",
+	"96tufAxmBkMOKVpOqu4nWxx8NBqNRqM/P44yuSqlYMLo0fOPo5IqumKGKfyL5rliGv+bM50pXhouxej5",
+	"6EwQmmWyEoaU1azgGblim+loPOLwtaRmORqPBF2x0fMwyHik2D8rrlg+em5UxcYjnS3ZitppjWEK+v56",
+	"Nvnfp5OvP3x89pfb0XhkNiWMoY3iYjEaj9aThZy4H2dU80xPz9z4t7u+0rIseEZhCROepxdVNyE8Z8Lw",
+	"OWeqb2HN8batb8UFX1Wr0fPTsCQuDFsw1bOmsjwXOVuPbnd+ploz07se+DhgJX6Mo64BBt26ikaDjJps",
+	"WUouTGIlBL8S+zm5hKj7tkXMpVpR024fkR/S3uPx49Pbfwuk+Hj87Ms0MdJiIRUV+SSM+00Yl1zYdrd7",
+	"NPRf2wj4Roo5X1SKaXKzZGbJFDFLRhTTpRSaETn7B8sM4Zr818Wbn4hU5DXTmi7YW5pdESYymbN8Ss7n",
+	"REhDSiWvec7yMcnZnFaF0cRI7Bno458VU5sauw6uGJNMAC38OvqHlmI0Hq30oqTZ1ehDG023t+NRwVc8",
+	"sarXdA0URUS1mjFF5BwW5MFRzFRK9AFkR4zh2UqSFRfmq6ej275fV3TdBe9SVSKjhuURgEZRoWkGLRDK",
+	"nOuyoBtE7Yqu/3Y6doBrQouClEzkXCyIWQvdtxSY+2gLEWydQPTlkhH4Qkq6YBGep+RnzYjxX428YiJQ",
+	"B5lt8FOp2DWXlQ6detaBUycWEtGBkpVIMSqCHxyae3iU7XtMBvUOR7zd/k3zhfvUhvqCLy43JSNzXsB9",
+	"Sf5RaRMIuNK47UtGdMky4L05gWEA+ZovBDWVYs/fi0fwF5mQC0NFTlUOv6zsT6+rwvALvoCfCvvTK7ng",
+	"2QVf9OxAgDV1TjV2W9l/YLz0UTXr5F3ySsqrqowXlMVnAWjl/GUfZdgx+0kjzSDPgtyA++PGulyfvxzd",
+	"HtLDrMNG9gDZi7uSQsMrtlEMoKXZHP9Zz5G06Fz9a2TFC+htynkKtUD+jl2jQHVm5aezWoh45z7D10wK",
+	"w+xVGIkZJ8hsn3+MJSclS6YMt4PSspwUMqPFRBtqcKR/V2w+ej76t5Na0Dux3fVJNPkr6HWBneAyVgwY",
+	"34SW5R5jvAXhEUWtnoMOfAg/kblU5GbJsyUxS64JF3YTUe4CTlOwayrMdLTXSb6NucOvDoh6K+wlabei",
+	"xYB694LYhjOmkfad0PtANyRFxDhBjBMqcrIo5Cz88MVZWdbIxe9nZWlRNSZ8ThjH+5ytuTb6IWKG1ocs",
+	"nuf85ZR8H499w4uCSFFsyIy5e4flMKbl246POwEcEItrqEd8oAnutFRT2DWPBq2ZOQYxolS5lAVcgTvJ",
+	"CBr/4NrGFAi/D+r8p6e+GO39dAetiEMqUpP9pX64kS9aRNWlKewB1HTW7nsYRcEoW2hJn9cIPjZd4S/c",
+	"sJXeSSQRRBGhue2hStGNl6AmKAl1KehnzSzxlHTBBUI7BoFckBW9svshEe9ACEwHSduSGQ5KbrhZ1iJX",
+	"QP208774cxNyas8JbDjlQhNKCq4NCEO4mZosWYECJw2KhZiKDiKaAbSwZREB5htFS0vm7ouV47ggNLy/",
+	"LKx3vMkHXrJJmOvPMQ0gVAcz850MNwmJRoVDE4YXhcyufqB6eYTDP/NjdY8FTkOWjOZMkSXVy8SZatF2",
+	"PdoQ+oaGSLNkFk01DUt8JRf6CEss5D5crSy/oUUBU3e5WWu1OPCgg1wUBBoTtuIGHsBc4AlY8GsmLOuZ",
+	"km9ptgRhgmS0KMa1XkKWk4Jds4JIRbgQTI2JWVJTH34c2T+U8BxpBnzQMBKtxuk0puRyyRSbS4UPVcXI",
+	"iuLltILnUVk0+wTmqumKtWQnvCxlZZhqvFzOX/rVsWsmkCeFoRH8sEZ88MeDT8lZ+IQzC2kXRxVDRQsX",
+	"WVHlNf4Cv2gADa3rq1bUU0iVo6KHGviNK5JJZYewl7+bHP7DqKo7W+r8olRs4oZQ9JopTQtYXWtRDwP5",
+	"vlWylJqpvzO+WB5DAJzRgoqMTbZcY66Ju85ulhI4Ls6viWYFy4D6YE2ZXCEtMg1rCKt8oB05jvZ885du",
+	"sWmwpOJwwRfEN2tMOiZUE3g24pXFjSYZLHoOeGGHXeTx8HuvxUhDi4lFW3oSbEHY2jAFq7JNCTWksUUw",
+	"cXhMO51SB5Tb8WjbTO05ZBAdLR4PmzMpkkSbOG7RWoCxhZwhzC+1isYS4huA5jX7P9bttuNmy6mh01F7",
+	"IWmNiL15sR8+qphKbNsb/A8tYiqOuC9HOR/fBIGfocwLSLEzQQPNDDGSrKzemYAyeC8ov6knT1/Tgzbv",
+	"W6vqdtvjFhF26HLNc32sbcLB+vaqecNYnam/zjvcYeulHc01iHplSez12wLB3rSOwQBC5ProYuELuU7B",
+	"9EKuOyKhXLOj7IRc2/8MEpZeyPVLB5lUuzGPYw9BOiwQ7gO8m2jDjAiz1Kaes5lUh92sHdNebcAiFEaN",
+	"HiPjFpKwaVVO3NlMmJdsg9ZAJKhntwvR7eFTGGtg4cLQT4AFbWgE/B2w0Bzo2FiQq5IX7Aikv0w+gmZU",
+	"sy+fkIsfzp49fvLbk2dfAUmWSi4UXZHZxjBNvnB6cqLNpmAPk/IKSufp0b966g2KzXFT42hZqYytaNkd",
+	"yhoqrXRgmxFo18VaE8246gDgII7I4GqzaCfvbL/b8eglm1WLC2YMFwv9Vsn50blhZ4YUdNjobalAMNdN",
+	"o657bZzk0OSErY2iJyW2ZCJHmsd1cE21ZqvZUYiqb+PzepacOIzmbOeh2Heb6mk28VapjaqOoTlkSkmV",
+	"vIJLJY3MZDGBdxKXCd3fW9eCuBZ+u8r27xZackM1gbnRgFyJvEfFB5bhwfeXHfpyLWrcbL3B7HoTq3Pz",
+	"DtmXJvLrV3zJ1MSsBUHqbGge50quCCU5dkRZ43tmrPzFV+zC0FX5Zj4/jo1B4kCJpwhfMQ0zEduCcEE0",
+	"y6TI9c7nlbemt5DpphqCsza2vC3Y9EPl0HSxERm+645xlvsfnc5UTvRGZJEqGWAsWL5gaieSjqQy7sOU",
+	"heKBTkAKmHqFn9Gi9pIVhn4n1WUt7n6vZFUenZ235xy6HOoW42x2OfT1FhkuFgVrSOoLgH2aWuNnWdA3",
+	"QWln14DQI7G+godx9L58q+QnuEOTs6QAxQ/2aV5An66K9ieZA/MxlT6C6FkPVnNEoNuYD9KZrAyhRMic",
+	"4eZXOi2U9ni9wUHNKqWYMLGci/pArsmMAXVltILVViUxMnW/1B0nNLMndIKo0ekJa1cn28pOt6TXjNBC",
+	"MZqD8pUJImew6NpLCBdJNSmpCkofJxIP5bcNYEslM6Y1WICt2WUnvL6dvX/MFuThanAVYRaiJZlT9WlW",
+	"cHW9E/grtplc06IC8fzHX/TDP8oirPZs+xZgm9RGtNXf3aXcAaZtRNyGKCZlq223J4EYiS+DghnWh+y7",
+	"Y693+9tgdojgEyHwmin0SPukR8tP8gmIMsD/iQ/WJ1lCVU5ADOxVP4DkCvstqJBeNtwxQ5jAq62dunui",
+	"M1qwXRhq67orwa1dTgpG2iPCxzGhpJQ3trdhYkreODNhUAtLRbMCZJ2soIppIqRgQ60LqSnTa4AvOJ2D",
+	"3M1K9ZVGAau1MrTfzZj32cVjNSa6AjMmOq/kHFxi/+4aA6NwYCE6Mik0E7rSf3XOFnYSNxoqyPfAROei",
+	"Lqg2k13iADRq6LyATKMbuHfgnrfAK6oNivCEixx171aMwXmwD06xr0EKp+x9ScOkv/hHdHfagOfwotZV",
+	"WUplWJ5aHvrr9M71E1uHueQ8Gjs8240klWa7Ru5DYDS+w6NdicUdNcE7x/n7dBeHHlcgem72xXIDvhpH",
+	"22C88K0ixMcBBT0wcl3vgSU3rlv0NpOyYBTV3drIsoTbBc5u6NeHwQvb+sz8XLftkqQ1geOcJJd4joyH",
+	"yUF+Y5Gu0c6/pJo4OLxvFiorrXtwF2ZgyRPNwYK47bygAgNaxQfnIFZtRYMWr9smJtS7Etkkm4xPMUCH",
+	"ZUvWeO6f013ruSPQuWGK1CtuMbCom3dpCEb52abNX4cy+KpcKJqzSc4Kukk42dnPxH7e80z4sfFs1Gov",
+	"adhkhk4k6eNRswNvPz5sVolTJYSSnyTBLySj2uAG1fvpeh8+ac5w2hT1uHP6IMyCYCSPgB8PkWWPUmJE",
+	"pMVriZRlG9nVOGHqjmvpwV6Y9ZMgEMed1Pqr9uz/zbSb27c57vwbpvsWXk99vGU7scryDGuN52a56r9D",
+	"L2tGE1rXV4PznWoJkTllK/QvxyvdBiCaDaFC3zCVEJOajL3SjFDRMyiR6N1HhGyPa2V7bpLyVXPZGc2W",
+	"bLLku98+fuUid4zY/VBgRIyuobcwsNxDAXuWLVlKxHYLsT00wXuHcGfCZPlfrTdZE2IIV+FMj+3lC554",
+	"2r1CYJIlN0SB99GdULufA1DAJVBkVhl+zSZzyotKMb3vo8NhZMloYZYkW7LwXocRWe5wFIREKZj9rKss",
+	"YwyjG8PKu8hD1ethq9ttGcLzwVXCFHTHw9FdR+e0DiB1Rzi7dsS5rEcErZkw3gjZs19tyv0c5BcinRLP",
+	"JfBXZToIPT2rmKMsFD2jHugmJT53f24gYhaZbg5cKJc34u68LLTEuZBx2NkG7G1bbLUklJZboyPUAwyS",
+	"Y2Bj1JPEuCXj3n3J3DgOHk23YbvjFNKGuYZapfHebb1EW4/F5Auv91m1413T94TocQN5S5XhGS/RUvAj",
+	"2xzdcNKeIOnmTXJmLHuNPlgjShn3JzaCsj3mYYaUQZbuLvgdU3diOT6opAn8FdugxeqtDcaODIXHsAQl",
+	"RiVcA/0joD7gl+XN2HG2ppkpNoQiw90QOAREVzN7srreS0aWk3iAdLqH/hmdL2TSE3Grc+YFDhUtLxU0",
+	"ZV+z2+G7bGm7G+hwN2UpZTHd7f/bQUYSgkGRDqSUsOucFsWGmBDx7ympAaR75BQbD667K2I04wrIf8uK",
+	"ZBQf0GVlWNCxSIVMz+DdpNGMV8/pouxqDLGCrZg1pOCXR4/aC3/0yO05KCPZjY0WENiwjY5Hj9AK+lZq",
+	"0zhcR3A0gON2nng4oZsYPBS9E3iLp+yOz3EjD9nJt63B/aR4prR2hAvLvzMDaJ3M9ZC1xzQyLDbJrAeu",
+	"/LIZzdJZN+77BV9VBTXH8BFj17SYyGumFM/ZTk7uJuZSfHtNizehG2r6WQY0muEbYs4XA8dil9DH5kSB",
+	"cbjgcICtJDgUIHZue13YTjsU5XWkBl+tWM6pYcWGlIplLLdKNq6JDkudEhyWZEsqFvhQU7JauChNF+sC",
+	"DL/S1gipKtEZYu8okLWYoPeITmbYQI8xdwtY6wgFxXTb9cS+qm5oAIXljStj4Pa0XXGS3mrj0dY31XWt",
+	"t7d4a2a7OdSPqyEfRkiroRnouIT4BFmpi8R4G+HwATF8GgeZeugUlN2Jo3jW+mNfSCuYC4rNEYQkOxBR",
+	"rFRM45UWW2C1/Srn5DXPlDwrFjLceXqjDVt1/WZs1996juu7Q7S4UhRcsMlKCpZQS7/Br6/x42CLr72G",
+	"e0ZEgWivAdsPnwYSWgtoTj6EpO+6SUgy7bPfdjLT30l1LAdHO+DgN8UAp8GdHrVuykNdGyFas+sNaFXo",
+	"HS6ixyGelStCtZYZR0HxPNdje1qdA6GLpGui/23I6nCEA9wet+X2FmWQsD4UrCgJJVnB0cNCCm1UlZn3",
+	"gqKhLlpqIk7DK7j7NW7f+CZpM3LCyuuGei8oKoOC+S6pY5mzhD7pO8a8cVdXiwXTpvXAmjP2XrhWXDg3",
+	"CogmhuMyseelZAqDJaa2JYQyz4EmjCT/YkqSWWWaT45VpQ3RBmzE1gcPpiFy/l5QQwpGtSGvOXiEw3De",
+	"hdcfWcHMjVRXAQvT4YxrwQTTXE/SQSbf268YD+9wsnSx8fB/17mOZg2PSVh7I9/e//niP59Dnj06+dfp",
+	"5Ov/cfLh49Pbh486Pz65/dvf/m/zpy9v//bwP/89tX0edp73Qn7+0r3Rz1/iQywKcW/D/kfwp1hxMUkS",
+	"ZezL3aJF8gWm+nME97BpuzJL9l6A976R5JoWPKfmiOTTvqY6B9oesRaVNTaupcbzCNjzOXQHVkUSnKrF",
+	"Xz+JPNeeYKuvc7zlrfBOxxn10QF0A6fgas+Zimh68P23l+TEEYJ+gMTiho6yoiVeMPZD08EadinOSfFe",
+	"vBcv2Rzfg1I8fy9yauiJPU0nlWbqhY0Sny4kee7zubykhr4XnWuoN/dtlI8pSn6b4hR0lV7L+/e/gp7t",
+	"/fsPHRfQrmzlphpoGrFTTkBukJWZuPyTE8VuqErZ8312QrtRtvdWOKxMIisXE2/HJ278oQYcWpa6naeu",
+	"i6KyLABFEalql2oNtpVoI0POC65D2iCggZ+k8+dV9MY/eSvNNPl9RctfuTAfyOR9dXr6JSON7Gy/Ox4I",
+	"dLsp2eCHb28evfZ7FxceXH8UnZR0kTLNvX//q2G0RApBgWOFL80CczgoGuMkBGHiUPUCPD722RIL2d4p",
+	"iXC5F7aXz0icXhR+wk1tpn260w5GCb0O3sAdScFoZZYT4AjJVWk4Bn6vHN8gdEG50N4BUPMFPgD0Ulaw",
+	"ZGatfS4pL1uVZjNudJfzxl3sGQ7XqDNyeRnmHPCXUQEDVmVOnSBDxaadnVPbOFQc9B27YptLabtPByY2",
+	"jhJpR9khdd/RRdqN7log3/gguzHam+9c3n16G5dJEVNeeLJ4HujC9+k/2lYAOMKxThFFI0VhHyKoSiAC",
+	"O/Sh4ICFwnh3Iv3U8gb5Jg7wSqQaiL+Rx3BvD0VclhTsr/aZxOeN0TIqhDToKGWvA2rI/bkzcpExgc4v",
+	"rOALPkt53P+9a/+JgCeKZYxf+8RNYUANC+VGhyxJ9mWpqFgwQo3LSEMLjCudJp36UIpeMqrMjFGzVa8t",
+	"4kyCHjroT26YMMQql8YE/erhXHCDyiLBbmyKJq5cGxfrNj3Ia9yuieUHguq73yGbErw1HMITKcu9XBT2",
+	"JLyrnP9IfIovl+H7CnC4UPIGdhMAlD47P+bwjO7zStPFYF+lhkltYNbDhqUMB9klJSblQrCzN8W/jiw2",
+	"cBG2+wTwkuSiDL4AG0VzSSsKx89tTa3O+vIGshU5pM4KfHiEGCZLOsAAIuSJxX7Aptk9U6IW6j1gTazF",
+	"R39JtT/6+Ti6+Q6Uqj9PttBtKdLPoyADaroJ0L04074Cx1bvNWPo5yfnPlG6z47uU6KPxnulNx+PLGdK",
+	"7p0U+NrIWcEWFie2saezOgVvvZsAx5v5HJneJBWvECltIwnOzcHgwfqIEGtZIINHSJ2CCGz0QMCByU8y",
+	"PuxisQ+QwqUQpn5svLuiv9NhTC5gFF4TsgTpiIs+ycGxFJfBsBYNW1F4OAyGagEnvaZF5JZYD9JJx41v",
+	"xFbybecD87Dv7TjwoLk1ohS31yqxx0Hrix8ofhnp19Nea5jJ9cQm70k+QWfrGZyJZEgt9EoeXpsc/YEm",
+	"M7lG3yu84WwM5t7Q9UPmAYt9irlGKsd+feK1BW8/QLY/eFLUrMkX4flRk12fxH8YMD3Pjj6y+yLKkn4k",
+	"kFqK3rrSk9N87dRHNaWtriRSX7fjkPQxZFJIsZq+w5ncyR6MdpXMzXTmP9QZ7fvzX7tG95PHvau8vEvq",
+	"fdsZAdF7Zd5vk0MDiC1YfdsWYpNobbRq4TXCWoolNcJ3G/aMJtrck1SKSUOunlyxTVrxw1BmuPDdIn0w",
+	"7h4Vm4eR16BiC64Nq40wPMpee782MlS7wmNLzvtXZ0o1h/W9kzIIGtiRYMfGMu99BRimNucK/LvBgpVc",
+	"AjT6TqPG8TtomhaEG5tNuLYmsb3lYIQI8g3kvKjSpOxA+vElQPRTuLl0NcOLkgvrlTXDamdJR+Y9bLgI",
+	"j3WA34qgVxZBr+h94GfYwYKmAJMCymtO/yc5Yi1euI2zJGg5RUzdDe1F6RZeG6V76jLaSIiO3FOm22xj",
+	"nXOZ+7F3eq35pFN9QoQdKbmWKOl9OvBHLhYQ+W1zcbq8JVSErOeEFlIs6nTx8PuWDPFTqLylXZ71LSna",
+	"nRs/63Pib1SMxMKHSeijZhbyOokAppfHSRZM2OSCo/1LShZysSOAAFtEGuT75e2d8IKki/Vly6269n22",
+	"exg2G7enYDR3zyrN/Pq2H9rudjnUjfucsxtVQLYfMBwQKY4bHQkwHaLp4dy0LHm+bhlI7ajTA0hioLjX",
+	"LfbVwhnfiZam3/WOKqwPNHHe3c4WdIKv+xN4W1p3b+ewDEeCZi4PVl4pNLY1nKm7ldLC+3Lgkn/85cJI",
+	"RRfMGUwnFqQ7DYHL2QcNUbExTQy3/uM5n89ZbCjUhxi5GsC1z2CywuxAyutaE8OTcitZ7k1b9Qp2IzRN",
+	"TwlK6XNJueyaa13bWKUW7pho4w6wuSZTXf3INpNfQLFCSsqVrl13nf20eZvvQRPXqx/ZBkfe6RELgO3Y",
+	"FdTAvWNIoSmjSviko/pPD3SMMfv0bWzhHjt1lt6lI22NK5LYfzTqiyleUWspn+7Y1B5EAOmQvbpIO+XA",
+	"2WLNbWkT+q4t4vlukSd6ecRT2Xxbh9xtIQfcTuc7RgtP+LjY0e14dDd3mC4LCyPu2Im34UZO7gI6q1r3",
+	"iIZP3J4bQkuoUUeLiXMj6pM1lLx2sgY2915H9/ysSp+Ky2/PXr114INfRsGomgQNR++qsF35p1mVLa64",
+	"/RqyhbacStdqwKLND8VcYkejGyyq1VKidaqY1m5l9Xje8WiedqTfyTedB5xd4hZPOFYGR7jaEI2dW75v",
+	"9Jrywtt7PbRDlet2ucPq5ib5RDzAnX3oIufIO4+l+b/YBD1wZY//mg74dTej89gFZxafSVFRj+U2bbx+",
+	"8W7/ze+N7QDtjwentu1Y57ZQgS3h/6gP9E7vMMA0A6kP4A62jch/g4n/029A4coCILd2ToL06MLpd1I1",
+	"bk8XiZp0Mvx0Uiu8cCwe0w4Cl84joCOrTomVa39f/E64Jo8exRT36NGY/F64DxGA+PvM/Y6Pu0ePukBb",
+	"gSDNR1GrKOiKPQyxLL0bcb8qEcFuhskwZ9erILjLfjIMFGq9BT26bxz2bhR3+MzdL2D1h5+mQ9Qm8aZb",
+	"dMfADDlBF32RpMFhfUXXEPeiiRQtZmEjm4G08D50BSOtzb97hES1Qhv4RBc8SzsgiRlySGHdsKExwcaD",
+	"7dkwR8V7YgFExaPRoZk+yPzaWkg0axLhOlk4o8bvTDoWUAn+z4q5PFVzzhReAS2Jwb/PcNSO1J/WdbqB",
+	"sU80/FAJH7rtq7/aYq60QPaiqtfq+zJYIv36U9WM9wxNiWfs8PwtYSWOkPytKbhzExlEUFvfnMEwnFQE",
+	"OUu055rO6Nv/WAMe6/udvxyywVxP5kr+i6VFBrRTJrKsOEDw8Yi9U26ybf4VnBf8euPZdxHIcD1HH6nc",
+	"Wa/hFx1qsx9yc6fZw34bvacCI9rvfhWGThfhGY/is52G234kzZinHh6GBzby4EcHcu9xR4U9oTYFSSNI",
+	"MH3Ooxb6xI5fn3MHc3vXs4LezGh2lX67AkzR9jd8A40kvrPfIB2yaNjZSRR2Etpym5KtZKo2YHULihz4",
+	"DrXTDn6B1g9O6Nh4ao6tu0yhZWKYStxQYZh3p7Ec0PXWzLqCQK8bqTCVuk67MeYs46ukYv79+1/zrOt8",
+	"lvMFtxnmMfudi1pgxA1EbL52pCKXMT6kjXGoOZ+T03F9Zv1u5Pya40MMWzy2LWZUu/T3ct7sAstjwiw1",
+	"Nn8yoPmyErliuVlqi1gtSdAVoMQZnHFnzNwwJsgptnv8NfkCfZY1v2YP0xeMk9FGzx9/ja5e9o/TlIiU",
+	"szmtCrONyefI5X0sRZqy0bHbjgFs1Y2aDo6YK8b+xfrvky3ny3YdcrqwpbuCdp+uFRV0wdLP9NUOmGxf",
+	"3F/0JmnhBRPYkpxpo+SmL0vuihkKHKsn8B8YogXDBdOsnLOqliugMM9a/fHzw03xtFj6CHD5j+gFXiae",
+	"9p/hlUVXaXqg6Nj/E5r8Y7RiKXBMjcLrEBDHIqfk3NdvwerJIVumxQ3MBUtHMRW2EAt1cmFQg1WZ+eQv",
+	"8GpXNAOGOO0DdzL76mmiCnGzUKfYD/B7x7timqnrNOpVD9l7Kcf1hXwHYrLiwPwf1tk3olPZ666enNb0",
+	"eT73DH1n6RrGnfQSYNUgQBpx8zuRotgy4B2JM6xnLwrde2X3TquVShMMrWCHfn73ykkiK6lS9eBqBuCk",
+	"EsWM4uya5b2bBGPecS9UMWgX7gL953Ww82JpJLr50518LEQW7sQ7LWTAAkn/l9d1FSk0tNsQ65bSUqqE",
+	"etYpGu/ZM3Y/NWHbnm89EvFbD+YGow1H6WKlJ+IEf677fA6XszZIds8bGtLHvxMF73iU9R89QqBBUWqb",
+	"/v6k+dmy90ePhnvtptWE8GsCNYfdNa0dx76prYZy/s8/9tS6D65rLqtMd5vTdxlcqTM3xpg0C4rfv9xx",
+	"nJDJvT2h0wfIowY/t3HzmfkrbmYdhNPPH17I9Uu3KqnS5JOH71EYByUv5HooEbWuLU9PfwAU9aBkoFYQ",
+	"VyLnrdsl6bWx0+UoIlsYdcbA5Vk3ysQO9qD5E+0CoGa8ZS8qXuS/1Mbn1s2kqMiWSb/2GXT8zT4DogaR",
+	"BgNMrIIVyd72tfybf1Un3v3/kD3DrrhIf2ot3MHegrQGqwmEn9KPD7jipoAJYhQ1c6eFbDTFQuYE54lq",
+	"nQTWOB0lEP8S6sRf2Cw0+q2S8y492WFXlXGO0Zi/wZVum/MC/tdjBseWE9VbCERh9O+8HpFdMzCz4QPP",
+	"js4UoXyF17amUBIWD+E1U3SBXaVgre6YXA9HjopjEF3CJ2yJeXokMZUSUPA9WgYThitWbMakpFrbQU5h",
+	"WWyNc4+ePz49PR1mW0R8DVi7xatf+Jt6cY9PsIn94urj2toWe4F/CPS3NdXts/ld4lIbVYl3tl5JisXi",
+	"BxsTDp1t5U7sRJjIUTk7Jd9jKjkg9EY1B4AmJMNupm+tykLSfIz5u8Ffi9hZbR/FEHU5EP4C4G8dkaSR",
+	"Z3g6W58qryfN2PBxtmc5glVrg8VVtKGrMpX0Elpc+gaEtzyxUDcYY2dKXlq1bPDnsZMQzAKvViwnYTqn",
+	"BkDigP8YQ7MlNJDT0VaVck/dxd3lnN66Fp4D1uaiKPT22n9EDg7LsO4NjFQiZ2pMJOiob7hmmPqCXbNm",
+	"bk0PhlfI+1ybzdWqSghLONM9pNdQiHTfXfDA4bjBrSIJWWsf7mz7q5OJyEplbDj12pN/gb3SoUOiOVjL",
+	"3cFWN1n7+ihT8toZOzIqpOAZ1gVJieCYNXOYWXVACZW0vVOP3FlOHMMEKUcx8g6Lbv0felmmQ1zXqSH6",
+	"CvttCcf+adjalcpeMKMdD2T5GBVUvGDOQMeFZq7ULNBXzFGlSnh8JUN0gufIEd3jxyNMfNeja/0Ovv3k",
+	"dPNwdskVF6hzc0j11Z/RwFZojnZ2QbghC8m0W20zNE3/Cn2ml2uBIHyYvpILnl3wBY5hPRABKdYjuTvU",
+	"mfdPdv7A0PYbaOvKTISfG550dlK/7g9JFqLD/nc+QWmEPvSnXL58hFyE3DB+PNoWYtwadoD3MpAh1B8h",
+	"2rAS7/MO2TClUg9PqD5SWXrDFsQGD6eQUnCRAOMVF97gm07FlSXvEtwYPM09/XSmqMmWDSa1y/m4JzQH",
+	"4/qzq2MM1dpgRAmu0c/Rv42Xa+EqfvSwldCgfl1QsSH+UAB1R0IJRPoGR28Uppp6aZDOnDBmfYRtsK8T",
+	"79JsBdj6xEcHN9C1MxY1dMfCNfveU32JYWdVvmAGUoymUt+9wK8Ev/rgRiieU4V6bSHUtZlZv0ttbqJM",
+	"Cl2ttszlG9xxupxrqjVbzYqEx+3L8JHlYYeB0sDGA/+mipX174xzwN87AN172+f7lZPoBtSnpGeg6Ynm",
+	"i8lwTOCdcnd01FMfRuh1/6NSuo89/0OElre4XLxHKf72LVwccUb1jmu/vVpCwnN0o5f43ackC0l3m1wJ",
+	"vnVL8qFHBm5eYstawPuGScCvadGT9CG22tj71Voy+lI/ZL2ZTahxCfQMJTVPGKLC6E9BZh2vW5ahrnmz",
+	"z7XaelZ/SuOJw8dWpPdbGn9s2BWt11vNUHrtiYeZ/Goi2Nfm56pmdPWltChkNpgzuGHOoFN/VmW5Wrki",
+	"BQmvvOuVzOOzEHlztfIVJ321ve9Y9LCFYBsLV/0ot9ladJww2HkdtHIEW4eUZsLjKaRdbCRn9OODlrHH",
+	"E42xNFPmefJnB3vyG64i+UXdpEdr6HYCwe+sv3zX0vGhcDBbl4juUDi9WRV6KD47RZIHlu6ON6+nkPen",
+	"ArXNTPA4OXIY22Bhv9V+Y+02xpsWqe7dCSPf8YIRLsh/Xbz5adR/oKOT2D3aLtt90pTRd0BD9GSbTSxk",
+	"g7a23AVSFGk7iO4xrWCasjRXlIb1fvhOm6Eg2ZRd+7R+NXTwDgEspC3klip1002UNKq3wyM/ooZ6e+3N",
+	"ElNHiiraBdISb2BsEV1RTm3WGa1HEdaQlYfUY0uV/nIvRq+JtwKHS41o66F1Sql1LtKXQx4JHXzcjkfn",
+	"+V5idKp83MiOkrpoXwEzeQGWjx8YzZmyJYBSagVbAGjFQB2hl7zEd3ApNa9LeBcwmMspv8ThpkMjs4Av",
+	"4qeQuKIzlnekv2aZkarhDqwYG+7vUqaXCBB4wzI2+QwuQYqxnJVmuVVotk7+pVnWlX6ZCzwEyztzJqxr",
+	"JsaET9m0HauY1/nJSMHo3CvjlZRmQClsr3WzaIyBTtFXp6z69udAJ/1glF3TXojT4XWTzkJsiI2zvaG6",
+	"TmLWSu0xOIXAfM4yrL2wNRPk35dMRKkBx16Fi7DMo8SQPESLYpWVo1o2algLeiCoBb0fSD9B5Y8rtnmg",
+	"71D/w9sIx7b4B9dIQw4vUrAIZ1jUztUC4caVDAn2uPupCZL3xpc/0KRxCpNl0EOI+iHlHJC8rEeErxDS",
+	"ZyR0LsZchxMJnUNEie3O6sJyh1T0iFLNHgiG5xKExulnD4PGy4QHgAFd9560Tvz5yQ4TWpwD3A80CXPC",
+	"3+M7HbZESG3vuftrM3sXxiA5HoVLYXmdp67Y3MM57M0sig+rvkSpb20O8kgU7df4vWSG8kI753gaKnfE",
+	"enEw8bXsgeTGVf4ARNReD74GCNP+N5/r2s5S8CsW7xb6mEB6dN/iKBlHsRnhaaDnYWZeB3h2vRX39S+0",
+	"kdZZIUGAn/QFuDcjLkMowgNtY0bqRJAI9ZwpdxkgyRdSs4mRPlx0jzzKFrht2NMYLXMQ3lqRSXtkPLAr",
+	"6i1H866uyYMViCmWn6EuiCbGClFsRbllIjKOQk+4OO7YoW/sd5+nyVeU3W4m6sN7OBeTnW7aPoSY6w7m",
+	"49M1J0643ZuNN5I7HWBh4kIwNfHOKO0qOaKZcRg1kXmVWdYXn81ghRucynELN0saZ7LuKlsqgCip0BXb",
+	"nFj1tUsvFHY8Btq+gSzoUW7+FlEc1eamU3AvjgLe582EXEpZTHo8HM67pX3ah+GKg1cqgcvKR9jBK+5B",
+	"89jAJOQLNKwH37eb5cYXrilLJlj+cErImbBRzt4Nrln0ujW5eGC2zb/GWfPKFutylrTpe5EOF8WiWeqO",
+	"3M8Ps4Xn9fEmzUR+5/ntIAfMbtaiz9f3BqtrNUvTT4eq57p+ai0RKiI/C0VKgLqwDi3fIEtI6AEIJpeK",
+	"sqChnxMlzhGG6EKmookOSYAFQ6UxFU+GABkmBqhbaijc4EkEOGfhHZmu3Wefy1nOiWK1j9mhSa1dnmjL",
+	"xHWfaq89c5ilyRnnUrF4RvSXtznv/flF1oQuoWrGjaJqc0jq6SaqUmrUXizv9PoODt/1Qmqn7y4Oi0Le",
+	"TJCtTUKhupQ6C9rp5rXtS2PX/eCoz1jkPk61ExE3ZElzkkmlWBb3SBsILVQrqdgEahskjZuv+NzAI2GF",
+	"8emCFHJBZAkqVFtTMk1BfXNVQlCUvVjkkptEgaUdWKnrE9HxwCnh9rVuJhOU13bWLPKbfwl9bBqeOqWo",
+	"XfTEujr1xEkx7ZJaOgzZxl14kXBsQrm2USEtIs/5GumGqdSRnxOjILbPtcDRGySEB58qRlZcawtKoKUb",
+	"XhSYBYeva37Agl9jGrU9svM5xnNcc3TcbWZEwh4gKWcspJGKecBFnFCSmKWS1WIZlVoJcHrFiaqcWiUe",
+	"5WddoW81hrrDFE/JSmrjnsV2pHrJtSv7F5kURsmiaCqirZy/cM4rr+n6LMvMKymvILPRQ3yEo07BrTQf",
+	"+9Qw7RiEeibVyms77KUAjq5IHnp3xQrbDgDwDGIw72xxv47hbJclKgLzw27mutsud9ZdWHtdTT6bfgud",
+	"CUKNXPEsfdz+XF78vb73Ke6VQoXt4bJpYTPkA/E9FtwykXt20cwETVakPiOORzj3NORE8F8U49vjkjmj",
+	"pjN3dId2+Y4TsCZZrxjYAgAhtQldTKVstfxYSAsMRy6sThKd69qADrxw0If5brDBCEcHyrA7AdWJqggA",
+	"fmE1GGOb0NdGaEDArvv+sM74exDwt9upvME8+pzDL2rSUtgkJOTr4Qjpoi5bPakvMZnPbKg/tfZW7oGX",
+	"fwRAv4d1A4ZBftb7gjGnEIYzoabn3kcd2Dh6rrtY8Wh0XxoXZyEZrXxRdRi7UswliLPSv2qaw0tqlv5W",
+	"heZdjTjoMJ2mH61zWBJ9HJljWWErprc0CrKcFOyaNRzPLS3rCqVQfs18Xx06k5yxEj0W2oq2lEd1hMe2",
+	"9sWtfRL55A7BblIdYxFrd4rs0LUkNUNrMbHHRA89SgDRNc8r2sCf3lfkaOoS4SgnUNV5Pkz8E3PoND/b",
+	"Ed75Ac58/5Qo4zHxYRgf2psFpVG3jQHtjLCodN+pF+kAizglYzAU4Wx58MuwJF7zDV3SG9Gv1eySfP0S",
+	"G7hPXIoIsd+uWYZSjXsKsdw9hnosJ86EidQuGMvtgwG6JLT5SyaIkPWLCFWa/hVTJ6X2P9iJsREX7qF9",
+	"gI9JHQdx950lOBjRraSxyZ2oyfpuOv7PchK3HsTe8VI0oplLSbBFNeap2z07sIGsipwI2E+Q/bHcurvF",
+	"HBcfk1nlBwJFhq0HHz9RXzJvz5UiNjHZFflsq6hItui2N1hXC8KjSDewiEuF/whpyD8rWvD5BvmMBd93",
+	"I3pJgYScAdl6Abn4EZh4u3g19oB5RYz0U9l186FjRsNtYJQIaLjIfQVMSVb0isXbgA5Oln9mBhinrmao",
+	"1IAru7WdXSy4xfs0cyuax0oATJi9aXAH73ANvf9au/vEU/k8tmVBM5Y36ng2+QwIQ4G4zJKttqdr6PI1",
+	"TwK+VUS0yqf7yQ/Qpu7JulKxi30FBxtgR8+IZr3B4yxjoFK4VTduS6KLQUs59i4cJxa9s6S4avquxcVF",
+	"5O9nd5KZ7vuWMQT8P9CuNNwrOhG6vlho/3qwyX3sQiOhWAJWqwafyfVEsbne5UiDrQH4GmAddLdcZIpR",
+	"bf2Ozt+4Z2udyJ0LeEZbr/NgVg2j5GzORc1quSgrk3gFYT53sYkQFlsTEK09trk+GQNE0WtavLlmSvG8",
+	"b+Pg9Mh5nHYeIPEWFNc3oQAJN3J3AK7rFyDmhaj183EzuP6tM5z1/daGipyqPG7OBcmYMpSD+XyjDzdV",
+	"BavDLmMVjWShZtajyGyFpG0BKTbO2nxHQ1IAkB7RojTAEnS5ZEkrkFUMGdlj+OnC8KewBK3oGoyHmL2g",
+	"50C4fP1oOsRm6OKZUWGlu2Hr9vNA3bnt02AlJceIjMRZh0yx/dy/wa3ER+jPgputJ99qONvpJKynvj2Y",
+	"HqliUYcXWWLpnscyS09WNrOAeFHVp1vytMeiTUy69He06j27iP4VLn1MrEIfXgC46cKRuGGcXmGC+ga9",
+	"JYCodjFGXGuniOp4vLUVFRYpY5elZU89ndXu+3upBzxANNPurDenDQ46MM4+VZO352WZlLKcZEN8W22x",
+	"tdwC4CFtwthDH5EJoWfdwe9Gh/KDMTU26xDuWzi6tw7iLltZmW1TGfQpmXo4etOAIefIy/AIW9WaVLEq",
+	"Zuwf597Y3VSiBSZBKFEsqxQqmW/oZncx3Z4qGhc/nD17/OS3J8++ItCA5HzBdF2bpVWMtnZN5KKtNbpf",
+	"Z8TO8kx6E3zWI/wcrJc+bDNsijtrltvqOql6pxTvPtrpxAWQOI6JCp8H7RWOUwel/LG2K7XIo+9YCgWf",
+	"fs/A/yNdGyvIVQnzS2q3IgMMvEBKpjTXhgnTsp9yUztl6yUqF7H6wbXNcSdFxrz22VEBNz2+XKmF9Pn0",
+	"Ij+DT8TZnCADQeF4lbUTbVuXe6dZ/R4KjehuAzowWTrRns9JCiKM8VEVC3p1pzZFfXrkphuYrXXYTRGi",
+	"c35Pkx54fOBLWM7Jdm5fmxk9o05wetjEhHjhD+UBpNln3ejPl3QIJ6kNA38Y/pFIAHU0rhGW+yl4RfJ9",
+	"sCWrwVnHayIkPxoEWjfRT4I8EICeeP5G0HUU4hjVWFDWxoDWCG9+bosfr2uz9M7IFITEd9gBXhyLX7cL",
+	"wRQOnM9coOB1QEq0lA99lNBY/q7wfs96w0USbZFTmhjDtGVLsisWRgkd9DchT0LPq6STTkFJaQi8TIsi",
+	"kYbB6nHwTMWEw4Vh6poW9881vuNKmzPEB8vf9QduxWH3MZItKvXREwu/ooPAKuj9QiXeYm6Iv/cEB58J",
+	"4mZxhv/OHYgqIVpYb+95sIAz4eOH0bHr8Vdk5sqWlYplXLcdCm68SBOinZkCixxOAQl/W5HXdy539os0",
+	"dzgOc+8PRH6KjGzBc8DBXB/1z8ycejhA8rSkSLVDKAn8pXgdJHgdVufqriWuDktJFyWg3TMlXbwyTBA8",
+	"eHm4Dry8Ks266xx86zdwm7jw67UNzbk4uFIWlCecDUmMmK5qBd0xV+NRylvdvbjVvSRqtKh0YzhIkoRV",
+	"i9y7si+1/CWjLBnNXQRxP70TGBAA4Ulybh8F80rY8UIhZ4wV92xdzsfBi0EK6PacvBePwFvCvy3cn0+e",
+	"fTUaj5ioVrD4+vtoPHJfP6Reavk6GVdaJ4Lq+Ii6qigPNCnpZkgw+87UT0n81pmu7l+k0YbP0m+6H2DP",
+	"8OHqAhDOBbJ6ZC/2BnX5n/5/AqutxNA6rOHEWJKs01uFrdiV6eqXvpSPtoRFT9WiFveFAkc7bfFxQanb",
+	"8chlfMQqS7+5mpv3u+0egp7coW7pd0ljZxGTWGtj8miqKCnhgMJSrlui0g8cRlDBc7O5APx7tTv/7SqV",
+	"zOz7kF7M5awLFngn+xp5xYT3MauTkVXaS9ffS1qg9GkdAwQjRspiSr61lY7ctfi3B7P/YF/+5Wl++uXj",
+	"/5j95fTZacaePvv69JR+/ZQ+/vrLx+zJX549PWWP5199PXuSP3n6ZPb0ydOvnn2dffn08ezpV1//xwOg",
+	"dADZAuormD0f/a/JWbGQk7O355NLALbGCS05ZHC7vUUN2xwTbCJSM7xi2YryYvTc//Q//UU5zeSqHt7/",
+	"OnJ1bUdLY0r9/OTk5uZmGnc5WWAOlImRVbY88fPcjlsYP3t7HuKCrO8f7mhtc5qOalI4w2/vvr24JGdv",
+	"z6c1wYyej06np9PHML4smaAlHz0ffYk/4elZ4r6fYDWAE+2Kip2E0NHbcecbmBXm7tMipDOGv5aMFmbp",
+	"/lgxo3jmPylG8437v76hiwVTU4wYsz9dPznxb4+Tjy6vzO22byexN9rJx+ivCc939Az+VElPBoh0REea",
+	"KAFT0zsM0Bu24TwH9NuW6Pakz2tGiCh250SPnv+a0tjarqSsZgXPQLieegKG3YnoK+RcqvkH6udHln/C",
+	"SmpuCBzudPL1h4/P/nKbdNTu+mzVzo5bv7bX8Np5INT3mIsgwHhVjKcKK/pnxdSmXhK6B43iBQwUd5K/",
+	"Ju3A8HYtXd05BxcEzLL6ZWsZV3B1d4GwpWLXXFY6dOpZAgyRWkF4vX4Yj6y+UVsO++T01LMX91SPaPfE",
+	"HYl4S5tm0Y5L4z7JXmKXw9Q7CxYzQXx0j8XP2qaWBGxyQW24EMYRrOiVNQijpzBRLleAw6gLPkAkh8A4",
+	"ty3+BvmE9WT3DDpu3c4WiET+4C637uEAPnwgVucX3BornNPmEixK6IZd5y+5HY+e7kkoW9XqjWoLCfBf",
+	"0wJABvNdzQaenj6+PwjOhfVyh2vPXs+349Gz+8TBuXCJ8bClvZAxrj1xGMSVkDfCtwRZqlqtqNqgpGSG",
+	"7LHLUIceEL6dPRL2YqdwvH8d2WsBC0KWTPEVE1iH/XbX9XbyEf/deRnGpr0TF6MRdRh4yW5rdjKT6z2a",
+	"Mh017l8KvpT1yUc8ob2/n7i3ZvojmgCslHjiH9A9LW0erPRHl9pT1Wkp0+0aqP5o1jy/3TEttInmzcCR",
+	"rCpPPuJ/UDCMVm5r7pyYtThB18qTjzzvfu4grPl73T1ugaUiPHByPtfM7Ph88tH+G03UIOBa+GoKUt9G",
+	"jb5ZsuxqlL4+WwXJol7Eys0Q25JbJvZ0QAchTdzpoIP/DmUdTd78CI4ArD0F136GPc63Tcx+oquyLDY1",
+	"Lv3PG5Elf+xucyP/dM/PJ/7ZlhLBmy0/Nv5sHk29rEwub6JZ0OBhbXxdyOBjpdt/n9xQbkAT6dLvYj7V",
+	"bmfDaHHiiiW2fq0rEHW+YFml6MfoYKZ/PaEO1aNS6gTZvqM3kbLzDBtbSYJp80Lmmy232Hoy4wIpKL7J",
+	"aj2H/dg1i9yOE6IRugF7A3M3fRnmUFKS5hnVBv6oS2I0HxW3yWN331LJC5oTn3pqQmoZ5cy9phtL+2NI",
+	"LEl28xIC7YFiiFRkF+/5zDLPs9Mv72/6C6auecbIJVuVUlHFiw35WYTgxINZ8XdI3oo69XEgeet7Dqn9",
+	"YsqRKhGY4FSvdc1en5uJEbMmSyrygqkQ+VEyBbQJ42PqJe/UCFeYL2FdSoUA2AzDLLduXnpKLoITHLqU",
+	"Vf6llVuyQVstDOEmoeggZ50kBlwl8NwBfrBgYuI40mQm840r2jpS9Masbd6RDtuz8mgPT+xIi6mvTtDp",
+	"aeSjYvznWp8a6ydRcRI0k79+gDe1Zura61RqddvzkxMMslxKbU5QJdBUxcUfPwTMffSP+VLxa4DmFpEm",
+	"FYeXbjFx+qq69tHoyfR0dPv/BgDRg5dv8SEBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -180,6 +180,9 @@ type Account struct {
 	// Note: the raw account uses `map[int] -> Asset` for this type.
 	CreatedAssets *[]Asset `json:"created-assets,omitempty"`
 
+	// ExternalWeight The consensus weight the weight oracle assigns the account for selecting the committees of the round after this one; zero if the account cannot vote in that round. Omitted when committees are not selected by external weight.
+	ExternalWeight *uint64 `json:"external-weight,omitempty"`
+
 	// IncentiveEligible Whether or not the account can receive block incentives if its balance is in range at proposal time.
 	IncentiveEligible *bool `json:"incentive-eligible,omitempty"`
 
@@ -666,15 +669,24 @@ type EvalDeltaKeyValue struct {
 
 // Genesis defines model for Genesis.
 type Genesis struct {
-	Alloc     []GenesisAllocation `json:"alloc"`
-	Comment   *string             `json:"comment,omitempty"`
-	Devmode   *bool               `json:"devmode,omitempty"`
-	Fees      string              `json:"fees"`
-	Id        string              `json:"id"`
-	Network   string              `json:"network"`
-	Proto     string              `json:"proto"`
-	Rwd       string              `json:"rwd"`
-	Timestamp int64               `json:"timestamp"`
+	Alloc   []GenesisAllocation `json:"alloc"`
+	Comment *string             `json:"comment,omitempty"`
+	Devmode *bool               `json:"devmode,omitempty"`
+
+	// ExternalWeighting Whether the network's genesis protocol selects committees by the external weights of a weight oracle. Not part of the genesis file.
+	ExternalWeighting *bool  `json:"external-weighting,omitempty"`
+	Fees              string `json:"fees"`
+	Id                string `json:"id"`
+	Network           string `json:"network"`
+	Proto             string `json:"proto"`
+	Rwd               string `json:"rwd"`
+	Timestamp         int64  `json:"timestamp"`
+
+	// WeightAlgorithmVersion The weight algorithm version the node expects from its weight daemon. Not part of the genesis file.
+	WeightAlgorithmVersion *string `json:"weight-algorithm-version,omitempty"`
+
+	// WeightProtocolVersion The weight oracle wire protocol version the node expects from its weight daemon. Not part of the genesis file.
+	WeightProtocolVersion *string `json:"weight-protocol-version,omitempty"`
 }
 
 // GenesisAllocation defines model for GenesisAllocation.
@@ -726,6 +738,9 @@ type ParticipationKey struct {
 	// EffectiveLastValid When registered, this is the last round it may be used.
 	EffectiveLastValid *basics.Round `json:"effective-last-valid,omitempty"`
 
+	// ExternalWeight The consensus weight the weight oracle assigns the key's account for selecting the committees of the round after the latest, if this key is the one registered to vote in it; zero otherwise. Omitted when committees are not selected by external weight.
+	ExternalWeight *uint64 `json:"external-weight,omitempty"`
+
 	// Id The key's ParticipationID.
 	Id string `json:"id"`
 
@@ -740,6 +755,9 @@ type ParticipationKey struct {
 
 	// LastVote Round when this key was last used to vote.
 	LastVote *basics.Round `json:"last-vote,omitempty"`
+
+	// SelectionWeight The consensus weight the weight oracle assigns the key's account under this key's selection key, for selecting the committees of the round after the latest, whether or not the key is the one registered; an account's keys may be weighed differently. Omitted when committees are not selected by external weight.
+	SelectionWeight *uint64 `json:"selection-weight,omitempty"`
 }
 
 // PendingTransactionResponse Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
@@ -1176,6 +1194,24 @@ type BlockLogsResponse struct {
 	Logs []AppCallLogs `json:"logs"`
 }
 
+// BlockProposerWeightResponse defines model for BlockProposerWeightResponse.
+type BlockProposerWeightResponse struct {
+	// BalanceRound The balance round whose weights selected the committees of the block's round.
+	BalanceRound basics.Round `json:"balance-round"`
+
+	// Proposer The original proposer of the block, as named by its certificate.
+	Proposer string `json:"proposer"`
+
+	// Round The round of the block.
+	Round basics.Round `json:"round"`
+
+	// TotalWeight The total external weight at balance-round.
+	TotalWeight uint64 `json:"total-weight"`
+
+	// Weight The external weight of the proposer at balance-round.
+	Weight uint64 `json:"weight"`
+}
+
 // BlockResponse defines model for BlockResponse.
 type BlockResponse struct {
 	// Block Block header data.
@@ -1294,6 +1330,12 @@ type NodeStatusResponse struct {
 	// CatchupTime CatchupTime in nanoseconds
 	CatchupTime int64 `json:"catchup-time"`
 
+	// ExternalWeightScale The number of external weight units in one external-weight-unit, a power of ten. Omitted when the oracle declares none.
+	ExternalWeightScale *uint64 `json:"external-weight-scale,omitempty"`
+
+	// ExternalWeightUnit The unit the weight oracle asks for external weights to be displayed in, such as credits. Weights are integers in consensus; this is for display only. Omitted when the oracle declares none.
+	ExternalWeightUnit *string `json:"external-weight-unit,omitempty"`
+
 	// LastCatchpoint The last catchpoint seen by the node
 	LastCatchpoint *string `json:"last-catchpoint,omitempty"`
 
@@ -1318,6 +1360,9 @@ type NodeStatusResponse struct {
 	// TimeSinceLastRound TimeSinceLastRound in nanoseconds
 	TimeSinceLastRound int64 `json:"time-since-last-round"`
 
+	// TotalExternalWeight The total consensus weight the weight oracle reports for selecting the committees of the round after last-round. Omitted when committees are not selected by external weight.
+	TotalExternalWeight *uint64 `json:"total-external-weight,omitempty"`
+
 	// UpgradeDelay Upgrade delay
 	UpgradeDelay *basics.Round `json:"upgrade-delay,omitempty"`
 
@@ -1341,6 +1386,30 @@ type NodeStatusResponse struct {
 
 	// UpgradeYesVotes Yes votes cast for consensus upgrade
 	UpgradeYesVotes *basics.Round `json:"upgrade-yes-votes,omitempty"`
+
+	// WeightOracleAlgorithmVersion The weight algorithm version in the external weight daemon's last identity answer. Omitted when the node does not use an external weight daemon or has no identity answer from it.
+	WeightOracleAlgorithmVersion *string `json:"weight-oracle-algorithm-version,omitempty"`
+
+	// WeightOracleCacheHits The number of weight and total weight lookups the node answered from its cache of external weight daemon answers since it started; with weight-oracle-cache-misses, this gives the cache hit rate. Omitted when the node does not use an external weight daemon.
+	WeightOracleCacheHits *uint64 `json:"weight-oracle-cache-hits,omitempty"`
+
+	// WeightOracleCacheMisses The number of weight and total weight lookups the node could not answer from its cache of external weight daemon answers since it started. Omitted when the node does not use an external weight daemon.
+	WeightOracleCacheMisses *uint64 `json:"weight-oracle-cache-misses,omitempty"`
+
+	// WeightOracleConsecutiveFailures The number of external weight daemon health checks that failed since the last one that succeeded. Omitted with weight-oracle-state.
+	WeightOracleConsecutiveFailures *uint64 `json:"weight-oracle-consecutive-failures,omitempty"`
+
+	// WeightOracleProtocolVersion The wire protocol version in the external weight daemon's last identity answer. Omitted with weight-oracle-algorithm-version.
+	WeightOracleProtocolVersion *string `json:"weight-oracle-protocol-version,omitempty"`
+
+	// WeightOracleQueries The number of requests the node sent to the external weight daemon since it started. Omitted when the node does not use an external weight daemon.
+	WeightOracleQueries *uint64 `json:"weight-oracle-queries,omitempty"`
+
+	// WeightOracleState The liveness of the external weight daemon found by the node's health checks: healthy, degraded or down. Omitted when the node does not use an external weight daemon or does not check its health.
+	WeightOracleState *string `json:"weight-oracle-state,omitempty"`
+
+	// WeightOracleTimeSinceLastAnswer Time since the external weight daemon last answered a request, in nanoseconds. Omitted when the node does not use an external weight daemon or it has not answered yet.
+	WeightOracleTimeSinceLastAnswer *int64 `json:"weight-oracle-time-since-last-answer,omitempty"`
 }
 
 // ParticipationKeyResponse Represents a participation key used by the node.
@@ -184,231 +184,245 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3Mbt5LoX0Fxt8qPJSnZsbMnvnVqr2InOdo4sctSsnfX8k3AmSaJoyEwB8BIZHz1",
-	"32+h8RjMDIYcUrST1O4nWxw8Go1Go9HPj6NMrErBgWs1evFxVFJJV6BB4l80zyUo/G8OKpOs1Ezw0YvR",
-	"GSc0y0TFNSmrWcEycg2b6Wg8YuZrSfVyNB5xuoLRizDIeCThHxWTkI9eaFnBeKSyJayonVZrkKbv+7PJ",
-	"f51Ovvrw8flf7kbjkd6UZgylJeOL0Xi0nizExP04o4planrmxr/b9ZWWZcEyapYwYXl6UXUTwnLgms0Z",
-	"yL6FNcfbtr4V42xVrUYvTsOSGNewANmzprI85zms+xYVfaZKge5dj/k4YCV+jKOuwQy6dRWNBhnV2bIU",
-	"jOvESgh+JfZzcglR922LmAu5orrdPiI/pL0n4yend/8USPHJ+PkXaWKkxUJIyvNJGPdlGJdc2HZ3ezT0",
-	"X9sIeCn4nC0qCYrcLkEvQRK9BCJBlYIrIGL2d8g0YYr8+8WbH4mQ5AdQii7gLc2uCfBM5JBPyfmccKFJ",
-	"KcUNyyEfkxzmtCq0Ilpgz0Af/6hAbmrsOrhiTAI3tPB+9Hcl+Gg8WqlFSbPr0Yc2mu7uxqOCrVhiVT/Q",
-	"taEowqvVDCQRc7MgD44EXUneB5AdMYZnK0lWjOsvn7XpsP51Rddd8C5lxTOqIY8A1JJyRTPTAqHMmSoL",
-	"ukHUruj6r6djB7gitChICTxnfEH0mqu+pZi5j7YQDusEoi+XQMwXUtIFRHiekp8UICXhVy2ugQfqILMN",
-	"fiol3DBRqdCpZx04dWIhER1IUfEUoyL4waG5h0fZvsdkUO9wxLvt3xRbuE9tqC/Y4nJTApmzwtyX5O+V",
-	"0oGAK4XbvgSiSsgM782JGcYgX7EFp7qS8OKKPzZ/kQm50JTnVObml5X96Yeq0OyCLcxPhf3ptViw7IIt",
-	"enYgwJo6pwq7rew/Zrz0UdXr5F3yWojrqowXlMVnwdDK+as+yrBj9pNGmkGeBbkB98eNdbk+f9XHUrf3",
-	"0OuwkT1A9uKupKbhNWwkGGhpNsd/1nMkLTqXv42seGF663KeQq0hf8euUaA6s/LTWS1EvHOfzddMcA32",
-	"KozEjBNkti8+xpKTFCVIzeygtCwnhchoMVGaahzpnyXMRy9G/3RSC3ontrs6iSZ/bXpdYCdzGUswjG9C",
-	"y3KPMd4a4RFFrZ6DbviQPepzIcntkmVLopdMEcbtJqLcZThNATeU6+lor5N8F3OH9w6IeivsJWm3osWA",
-	"eveC2IYzUEj7Tuh9oBqSImKcIMYJ5TlZFGIWfnh4VpY1cvH7WVlaVI0JmxNgeJ/DmimtHiFmaH3I4nnO",
-	"X03Jd/HYt6woiODFhszA3TuQmzEt33Z83AngBrG4hnrEB4rgTgs5Nbvm0WDksmMQI0qVS1GYK3AnGZnG",
-	"f3NtYwo0vw/q/Kenvhjt/XSHEr1DKlKT/aV+uJGHLaLq0hT2MNR01u57GEWZUbbQkjqvEXxsusJfmIaV",
-	"2kkkEUQRobntoVLSjZegJigJdSnoJwWWeEq6YByhHRuBnJMVvbb7IRDvhhBABUnbkpkVr26ZXtYiV0D9",
-	"tPO++HMTcmrPidlwyoxsTAqmtBGGcDMVWUKBAicNioWYig4imgG0sGURAeZbSUtL5u6LleMYJzS8vyys",
-	"97zJB16ySZhjtUWNd4TqYGa+k+EmIbEKhyYMXxciu/4bVcsjHP6ZH6t7LHAasgSagyRLqpaJM9Wi7Xq0",
-	"IfRtGiLNklk01TQs8bVYqCMssRD7cLWyfEmLwkzd5Wat1eLAgw5yURDTmMCKafMAZhxPwILdALesZ0q+",
-	"odnSCBMko0UxrvUSopwUcAMFEZIwzkGOiV5SXR9+HNk/lPAcKTB8UAOJVuN0GlNyuQQJcyHxoSqBrChe",
-	"TivzPCqLZp/AXBVdQUt2wstSVNrAGL1czl/51cENcORJYWgEP6wRH/zx4FMzt/uEM3NhF0cloKKF8ayo",
-	"8hp/gV80gDat66uW11MImaOih2rzG5MkE9IOYS9/N7n5D1BZd7bU+bCUMHFDSHoDUtHCrK61qEeBfI91",
-	"OneczJxqGp1MR4XpF53lHNgPhUKQCe3GG/wPLYj5bAQcQ0k19TCUU1CmCfuBd7ZBlZ3JNDB8Swuysnoz",
-	"UtLsei8oX9aTp9nMoJP3jVXVuS10iwg7dLlmuTrWNuFgfXvVPCFW5+PZUUdM2cp0ormGIOBSlMSyjxYI",
-	"llPgaBYhYn30a+1rsU7B9LVYd640sYaj7IQZZzCz/1qsXznIhNyNeRx7CNLNAjldgcLbrWEGMbPUquqz",
-	"mZCHSRMd00StgCfUjBoJU+MWkrBpVU7c2Uyox22D1kAkqJe2CwHt4VMYa2DhQtNPgAVlRj0GFpoDHRsL",
-	"YlWyAo5A+sukEDejCr54Si7+dvb8ydNfnj7/0pBkKcVC0hWZbTQo8tDp+YjSmwIeJR9OKF2kR//ymTeI",
-	"NMdNjaNEJTNY0bI7lDW02IexbUZMuy7WmmjGVQcAB3FEMFebRTt5Z/vdjUevYFYtLkBr8wh+K8X86Nyw",
-	"M0MKOmz0tpRGsFBNo5STlk5y0+QE1lrSkxJbAs+t6c2sgynzBlzNjkJUfRuf17PkxGE0h52HYt9tqqfZ",
-	"xFslN7I6huYDpBQyeQWXUmiRiWJi5DwmErqLt64FcS38dpXt3y205JYqYuZGA1jF8x4VhV7z4feXHfpy",
-	"zWvcbL3B7HoTq3PzDtmXJvLrV0gJcqLXnCB1NjQncylWhJIcO6Ks8R1oK3+xFVxouirfzOfH0ZEKHCih",
-	"4mErUGYmYlsY6UdBJniudmpzvDWwhUw31RCctbHlbVm6HyqHposNz1CNdIyz3K/9cqY+ojY8i1RhBsYC",
-	"8kWDVj+pyqsPUxaKByoBqcHUa/yMFoFXUGj6rZCXtbj7nRRVeXR23p5z6HKoW4yzOeSmr9coM74ooCGp",
-	"Lwzs09Qaf5cFvQxKB7sGhB6J9TVbLHX0vnwrxSe4Q5OzpADFD1a5VJg+XRXTjyI3zEdX6giiZz1YzREN",
-	"3cZ8kM5EpQklXOSAm1+ptFDa47VjDmpWSQlcx3Iu6jOYIjMw1JXRyqy2KokWqful7jihmT2hE0SN6nFz",
-	"CK4atpWdbklvgNBCAs03ZAbAiZiZRddeDrhIqkhpZGcn1jmReCi/bQBbSpGBUpBPnD57J7y+nb1/9Bbk",
-	"4WpwFWEWogSZU/lpVnB9sxP4a9hMbmhRGfH8+5/Voz/KIrTQtNixBdgmtRFt9V13KfeAaRsRtyGKSdlq",
-	"C+1JMCK2YToFaOhD9v2x17v9bTA7RPCJEHgDEj1qPunR8pN8AqIM8H/ig/VJllCVEyMG9qofjORq9ptT",
-	"LrxsuGOGMEFBlZ7sulJMo4bexCw14uKpWwQH7pEnX1OlUQwkjOeov7VXIc5jZUszxWhPpzKcsvc1Zib9",
-	"2T/EutNm5nrnqlLhVaaqshRSQ55aHtqse+f6EdZhLjGPxg5PPy1IpWDXyH0IjMZ3eHSKAPyD6mChdjbv",
-	"7uLQ68CIL5t9sdyAr8bRNhgvfKsI8bFTbQ+MTNV7YMmNqRa9zYQogKLKVGlRloZD6UnFQ78+DF7Y1mf6",
-	"p7ptlyStGchKKrkAhSYm195BfmuRrtDWtaSKODi8fwIqvKyLXBdmc6wnivEMJtvOCz6CTav44Bx03Kty",
-	"IWkOkxwKukl4W9jPxH7ekzD82Eggtf5AaJjM0JqYppH6THh/08NmFTiVSgneBL+QzJxz84yqSc31PnzS",
-	"HHDaFN90xPogzIJgJOnAj4fIsvSUGBHv/huhDVk5osPVuFvpnmvpwV6Y9ZMgEMed1IqA9uz/CcrNHQSw",
-	"o86/AdW38HrqYy27R/2Pd3vjwmxdZa3bJnlF9PLlHYyxjwf12CLeUqlZxkp8rn4Pm6O/3tsTJH0lSA6a",
-	"sgJyEn2wL/ky7k+sG3J7zMNe84PUrV3wO/rWxHK8Z1YT+GvYoNrkrY1oiLRVx1BHJEY1Fy7lBAH1XvPm",
-	"xRM3gTXNdLExgq1ewobcggSiqpn1Wuma0LQoJ/EA6Zip/hmdQT5pDt/qIXCBQ0XLS3ke2tfWdvguW0+u",
-	"BjrcK6sUokjoP9snvoOMJASD3IVIKcyuM1oUG6JD2IynpAaQ7oJAb4wgzzxQDTTjCsh/iopklOMLt9IQ",
-	"hDQhUfJBYdnMYMTNMKdzVa0xBAWswL7m8cvjx+2FP37s9pwpModb63LDsWEbHY8foyrurVC6cbiOoO02",
-	"x+08cemgrdJcsu7V1uYpu53c3MhDdvJta/Bg4DRnSilHuGb592YArZO5HrL2mEaGOfjhuIPMd02XsM66",
-	"cd8v2KoqqD6GoRJuaDERNyAly2EnJ3cTM8G/uaHFm9DtbjyCNWSGRjOYZBglOHAsuDR9bGChGYdxZg6w",
-	"DRwZChCc214XttOOl3btt8xWK8gZ1VBsSCkhAxslZ6RUFZY6JTZkIltSvsAXkBTVwrk623GQ4VfKasJk",
-	"xTtD7CuK6TWfoAlDJcPU0Gzpoy2NEAbUvGzb9g/7WLulARR7GQ26tKPtaduDkibT8aj34W/wfVM//C3e",
-	"miGjhxoTG/JhhLQamoHWM8SnkZW6SIy30Rw+QwyfxkpTD52Csjtx5BRef+zzC7+oyrLYHEFIsgMRCaUE",
-	"hVdarAZU9quYkx9YJsVZsRDhzlMbpWHVNd7Yrr/0HNd3h7yABS8Yh8lKcEg86d/g1x/w42C1o72Ge0ZE",
-	"gWivAdsPnwYSWgtoTj6EpO+7SUgy7bPftnSqb4U8lpXdDjj4TTHAcr3TrcNNeah9nRZFwiRt1Q8dLqLG",
-	"wSmcSUKVEhlDQfE8V2PnfW6t2NatvYX+tyE06ggHuD1uy/YahWFZRT4UJaEkKxiq+QVXWlaZvuIUNX3R",
-	"UhPOgl450K8WfumbpPXQCTWxG+qKU3QUDfq/pGPQHBJ6qG8BvHZYVYsFKN16YM0BrrhrxTipONM418oc",
-	"l4k9LyVI9Nib2pYruiFzQxNakN9ACjKrdPPJsaqUJkqzonCGYDMNEfMrTjUpgCpNfmD8co3DeT8Sf2Q5",
-	"6FshrwMWpsMZ1wI4KKYmaU/H7+xXDCpxOFm6ABOMtbCfvcdznRtiZNbeSFrxfx/+24v3Z5P/opPfTidf",
-	"/cvJh4/P7h497vz49O6vf/1/zZ++uPvro3/759T2edhTweAO8vNX7o1+/gofYlGcSBv2P4JBZsX4JEmU",
-	"sUNRixbJQ8yX4QjuUVPvp5dwxfWaG8K7oQXLDS86Gvm0r6nOgbZHrEVljY1rqfE8AvZ8Dt2DVZEEp2rx",
-	"108iz7Un2OpwE295K8bAcUZ1dADdwCm42nOm3GoffPfNJTlxhKAeILG4oaPUAokXjItgbHj5mF2KA7uu",
-	"+BV/BXN8Dwr+4ornVNMTe5pOKgXya1pQnsF0IcgLHxT5imp6xTvXUG8CqSioOcogleIUdJVey9XVe1os",
-	"xNXVh44fQle2clPFXNSds66azE85MXKDqPTEJXGZSLilMmUL8Sk+XDQ09t4Kh5VJRGWVWD5JjBt/OhTK",
-	"slTtZA9dFJVlYVAUkapy+QrMthKlRQgcM8zcxd4aGvhROKcSSW/9k7dSoMivK1q+Z1x/IJOr6vT0CwzB",
-	"q1Mc/Op4oKHbTQmDH769ySja711cuJXL0al8UtJFymZydfVeAy2RQlDgWOFLsygIdmuEB/pIAByqXkCI",
-	"Rd5jSyxke8f14nIvbC+f1iu9KPyEm9qMnb7XDkZR8Qdv4I7Ielrp5cRwhOSqlDkGfq98ggG6MFeO9yBQ",
-	"bIEPALUUlVkykGwJ2bXLbAWrUm/Gje7e0cXdxZ7hMIU6IxccOGcGfxnlZsCqzKkTZCjftFPcKBsMgYO+",
-	"g2vYXArbfTowO1iUjS5KsaL6ji7SbnTXGvKND7Ibo735zu/Kx4i6dCQYd+nJ4kWgC9+n/2hbAeAIxzpF",
-	"FI08H32IoDKBCEv8PSg4YKFmvHuRfmp5jGfANbuBCRRswWZFgk3/R9eu4WE1VCkhA3bjo3rDgIqwOTGv",
-	"o5m9jt2LSVK+AHOpm4tYKFqg0/40aehH6XAJVOoZUL1VX8vjNBMeOhTIbzFoGpUmY7MEWJv9ZhqVIBxu",
-	"zQMP3962jXMknh7kTmXXBPmBoPrudZD09JBHhEN4Ip+dv+/DnoT3gvNPi6kTQbbfVwaHCyluzW4aAIVP",
-	"3YgJXqJ7qlJ0AUOvo4apaGBKjIYFCAfZJf0k5R0xb4s1HRlj4CJs94nBS5I7gPli2AOaAVoujn5ua0J0",
-	"VoU3vNh4pM4KFKiDg6glHSobdja+2A/YNBsDyWth1QPWxFp89JdU+aOfjyOOfqC0+PukktmWP+888r6j",
-	"upsdz1/TbdY+tvqcGRDBTQ+fRc+nzvP58kbjvXLfjUcuxCG1d4KjFJ1DAQuLE9vY01mdn6neTQPHm/kc",
-	"md4k5cgXKSMjycTNAeYh9pgQqzEng0dInYIIbLSs48DkRxEfdr7YB0ju8ktRPzbeXdHfkA4WtN74RkoW",
-	"pbn1WY/VKvMsxaW3qEWeloszDkMYHxPDSW9oYTipCzytB+nkasO3Tyszm/PteNT3Jhp40NwaUTrZa5VW",
-	"njlkfbHg7ZeRfhXstYaZWE9sZHTyaTVbz8yZSMYrYJx26vDazHkPFJmJNfoU4Q1nHdz3hq4fMg9Y5Aay",
-	"ZgqpHPv1iY0WvP0A2S7Ip6hZIek5vVoguz5J9jBgesTpPrJ7GKXQOxJILQVmnQbcaXR26lma0lZXEqmv",
-	"23HIDhvC1FKspu9wJneyB6Nd5Wkz193f6nSH/cnR/Fn9LEn+ukq5++RltJ1Lm2txn7SMbXJoALEFq2/b",
-	"QmwSrU3HpSZeI6ylWJJh9F1jVxdtCgpATcCkIVdPrlNm6aur9wpQZrjw3SI9J+4e5ZtHkTechAVTGmrj",
-	"gndy+fy2H1QnmseWmPevTpdybtb3ToggaFhzLHZsLPOzrwBd1+dMKj1By0xyCabRtwo1ad+apmlBuOlv",
-	"x5Q19ewtByNE17CZ5Kyo0qTsQPr+lYHox3BzqWqGFyXj1ttohqnwkw66e9gmER7r2L0VQa8tgl7Tz4Gf",
-	"YQfLNDUwSUN5zen/JEesxQu3cZYELaeIqbuhvSjdwmujWPouo42E6MjtYrrN5tM5l7kfe6c3lo/o7xMi",
-	"7EjJtUQZEdMBhGKxgNxnenNBoTbrlcunVwi+qHMJmt+3pA+cEpvFD5Pwbcnf59zToc85vVFOBKtiJKGP",
-	"HzMIeR1dh7kHcZIFcJu5ZbR/vZEiibjYMR5bRJrRz8vbO27zSdfhy5a7cO3Ta/cwbDZuTwE0d88qBX59",
-	"2w9td7sc6sZ9TseNFLHbDxgOiBTHtIoEmA7R9HBuWpYsX7cMf3bU6QEkMVDc62aCb+GM7URL0594R4me",
-	"B+ZSxPbOxnGCr/sT87a0bszOEdccCZq5JAN5JdGI1HAS7qbRD+/LgUv+/ucLLSRdgDMETixI9xoCl7MP",
-	"GqJM9IpoZv2iczafQ2wAU4cYbxrAdcwc+QB67qG8rpUsPCm3kuXetFWvYDdC0/SUoJQ+V4vLrhnSvzci",
-	"lVq4Y6KNO8CWmMwj8D1sJj/TojIPICZV7ZLq7ILN23wPmrhZfQ8bHHmnp6cBbMeuoAbuHSCFpowq4ZOK",
-	"koM/UI2iC/j0bWzhHjt1lt6lI22Nq6DRfzTqi6lRRqK5lE93bGrPGAPpkL26SDubmLMFzW1pE/quLWL5",
-	"bpEnennEUzF02jjkbgsJNnY6lQEtPOHjYkd349H93Dy6LCyMuGMn3oYbObkL6IRpzf4NX689N4SWpRQ3",
-	"tJg495g+WUOKGydrYHPvTfOZn1XpU3H5zdnrtw78u/EoK4DKSdBw9K4K25V/mlXZyhvbryGbhd2pdK0G",
-	"LNr8kCk7dqC5xYzrLSVap8RN7S4VHVTnUDNPO4jv5JvOs8sucYuHF5TBwas2RFv/rqZPF72hrPD2Xg/t",
-	"UOW6Xe6wokpJPhEPcG/fsMjp795jKfYbTNCzVPT4ZamAX3czOk9UZnCJMXAW2xbLbdr44et3+29+b8zC",
-	"1dX7Gw9ObduxTlshPX/Cr08d6HXdYYBpBlIfwB1sG5H/BrOqpt+A3OVcRW7tnN/o0YXTb4Vs3J4uwjLp",
-	"PPfppFbzwrF4TDsIXDqPgI6sOiVWrv118athWI8fxxT3+PGY/Fq4DxGA+PvM/Y6Pu8ePk0bqpNrR8FHU",
-	"KnK6gkchRqN3Iz6vSoTD7TAZ5uxmFQR30U+GgUKtF5xH963D3q1kDp+5+yWHAsxP0yFqk3jTLbpjYIac",
-	"oIu+CMngiL2ypUUVEbydDwAjdg1p4X3oqolYm3/3CPFqhTbwiSpYlnZA4jPkkNy6F5vGBBsPtmebOSrW",
-	"4+POKxaNbpqpg8yvrYVEsyYRrpJZiWv8zoRjARVn/6ggKjGMV0BLYvDvMxy1I/WndZ1u4HYF49EhxYfv",
-	"b660QPaiqtfq+ypYIv36U6Wu9gy5iGfs8Pwt4RKOkPytibF1S+e9vJOgtr45t9ehdpZozzWd0bf/seYq",
-	"cto9fDVkg5mazKX4DdIiA9opE9lDvIGdoQ3gN+ApN9k2/wrOC3XN7Hr2XQQyXM/RRyr31mv4RYfCfYfc",
-	"3Gn2sN9G76nAiPa7X4Wh0hnO3Sb0PZpj35dmLE8PD8MDG3mmYzkh73FHuT2hNrVGI/gtfc7jWNUTO359",
-	"zh3Mnfjegt7OaKrWknm7Gpii7W/4BmpBfGe/QSpkh7CzkyicIrRlNt9gCbI2YHWzNR/4DrXTDn6B1g9O",
-	"pLj4qTm27jKFEolhKn5LOboyYj/LAV1vBdYVxPS6FRJzjKq0G2MOGVslFfNXV+/zrOt8lrMFs1XNKwWE",
-	"zrVLNekGsnXtLRW5guIhHYpDzfmcnI7rM+t3I2c3DB9i2OKJbTGjCu/l4JYRupjlAddLhc2fDmi+rHgu",
-	"IddLZRGrBAm6ApQ4gzPuDPQtACen2O7JV+Qh+iwrdgOP0heMk9FGL558Nd5WvBsxjnXqtzH5HLm8j6VI",
-	"UzY6dtsxDFt1o6aDI+YS4Dfov0+2nC/bdcjpwpbuCtp9ulaUU4OQFEyrHTDZvri/6E3Swgu3liJQWooN",
-	"YTo9P2hqOFZPQLthiBYMkonViumVc1ZVYmUorK6Ebif1w2GJP1+JzcPlP6IXeJl42v8Oryy66gmyRMf+",
-	"H9HkH6N1TKhNGluwOgTEF8kl5z45NpamCxXpLG7MXGbpKKZiRMiclJJxjRqsSs8nfzGvdkkzwxCnfeBO",
-	"Zl8+S5R4a1ZB4vsB/tnxLkGBvEmjXvaQvZdyXF/ykAs+WRmOkj+qs0pEp7LXXT3tYtzn+dwz9L2lazPu",
-	"pJcAqwYB0oib34sU+ZYB70mcYT17UejeK/vstFrJNMHQyuzQT+9eO0lkJWSq2EbNAJxUIkFLBjcY4pre",
-	"JDPmPfdCFoN24T7Q/74Odl4sjUQ3f7qTj4XIwp14p4XMTkbS//mHOkU/Gtpt6HBLaSlkQj3rFI2f2TN2",
-	"PzVh255vPRLxWw/mBqMNR+lipSfixIaUhD6/h8tZGyS75w0N6ZNfiTTveJT1Hz9GoB8/HjtR+denzc+W",
-	"vT9+PNxrN60mNL8mUHPYXdNOoGn6prb6a5FQ2vlCosF1zWVLSShWk3eZuVJnbowxaVZr/Pxyx3FCJvf2",
-	"hE4fII8a/NzGze/MX3Ez6yCcfv7QLGCbJJ88fI/COCj5WqyHElHr2vL09AdAUQ9KBmoFcSWdAr1Jr42d",
-	"LkcR2ZpRZ1AI81KNa3AN9qD5E+2CQc14y15UrMh/ro3PrZtJUp4tk37tM9PxF/sMiBpEGoxsSTmHItnb",
-	"vpZ/8a/qxLv/76Jn2BXj6U/tWtAW9hakNVhNIPyUfnyDK6YLM0GMomZOsJBlpViInOA8dfGUmjV2i6qn",
-	"itkm0gzgsKtKO8dozN/gaprMWYEuvWkzOLacSKp7uKrE6N95PSLcGDnFqiXs6CAJZSu8thVdlQXgIbwB",
-	"SRfYVXBodcekcThyVBmFqNJ8wpaYf0YQXUlOxHweLQO4ZhKKzZiUVCk7yKlZFqxx7tGLJ6enp8Nsi4iv",
-	"AWu3ePULf1Mv7skJNrFfXPExW7NhL/APgf6uprp9Nr9LXK4C7D8qUDrFYvGDjQlHw7C5123111CpeEq+",
-	"wxRphtAbVQpQKeqTPDfTklZlIWg+xrzUl9+cvSZ2VttHAqIOq88uUAPYPCJJI8/wNK0+BVxP+qzh42zP",
-	"3mNWrfQk1IVNJXM0LepytqzliYW6wRg7U/LKqmWDP4+dhGB2c7mCPCpDa9UASBzmP1rTbIn6zuloq0q5",
-	"pyDR8CrKngPW5qIo9DbU7EIObpbhCinbOspjIvQS5C1TgKkv4AaaOSNDwlWnkPc5JJurlRXnlnCme0iv",
-	"oULXvrvggbOir3erSELW2od72/7qZCJYZ33fetMX2CsdOtQqXt1yd7BVO9a+7seU/OCMHRnlgrMM612k",
-	"RHDMBjnMrDqgNEja3qlG7iwnjmGyZHaIkXdY7C2i7VmmQ1zXqSH6avbbEo79U8Pa1SFcgFaOB0I+9hXs",
-	"nYGOcQWuBpuhr5ijCpnw+EqG6ATPkSO6x49HmNCtR9f6rfn2o9PNY9qaa8ZR5+aQ6l6C1sBWKIZ2dk6Y",
-	"JgsByq22GZqm3ps+08s1RxA+TF+LBcsu2ALHsB6IBinWI7k71Jn3T3b+wKbtS9PWlU8IPzc86eykft0f",
-	"kixEhf1PlX3vRX/K5ctHyEXIDePHo20hxq1hB3gvGzKEG3T4gxLv8w7ZhAr6zVG+MU9WS2/Ygtjg4WTm",
-	"YsYTYLxm3Bt806m4suRdghuDp7mnn8ok1fbRMYjjXQItekJzMK7fegzcd6h2MQiDElyjn6N/G+vi/z1s",
-	"JTSoXxeUb4g/FIa6I6HkJS2CY36ilD9KZ04Ysz7CreL+KbZi2PrERwc30LUzFjV0x4Is+95TfQlPZ1W+",
-	"AD2heZ5Kffc1fiX41Qc3whqyKtQhC6GuzYzxXWpzE2WCq2q1ZS7f4J7T5UxRpWA1KxIet6/CR8jDDmMu",
-	"rNkG/00V4erfGeeAv3cAuve2z/crk9ANqE9Jz4amJ4otJsMxgXfK/dFRT30Yodf9j0rpPvb8DxFa3uJy",
-	"8R6l+Ns35uKIM4V3XPvt1RISeaMbvcDvPiVZSCbb5Ep4lXVKzaFHBm5eYstawPuGScBvaNGT9CG22tj7",
-	"1Voy+lI/ZL2ZTah2CfQ0JTVPGKLC6E9BZh2vW5ahrnmzz7XaelZ/SuOJw8dWpPdbGr9v2BWt11vNUHrt",
-	"iYeZ/Goi2Nfm56pBdPWltChENpgzuGHOTKf+bMFitXLJ9xNeeTcrkcdnIfbmAkgzNuuwnIiowIdt8hs+",
-	"rZJf5G16tIZ+JBDN0MRpiEa3hLENEvXgeWDs1PFEkcrWYZZ8ywqsT/XvF29+HPVvZLQD3S112buTKuy+",
-	"jQlRc23yWIgGPrbwAMGLtP5b9ajUMT1V+jS4AsnJD99aBeEQkGyqpn1avx46eIcAFsIWpkqV7ugmyBnV",
-	"2+GRH1FDvb2Wo8TUkaKKdsGnxNvHKj3rJiTUQh1UG7UhIw2pL5UqZeReCl4Day8alxLP1nfqlIbqMNBX",
-	"Q4TDDj7uxqPzfC/xKVUOa2RHSTHY12yx1F8XIrv+G9AcpC1pknpO2oImKzDPULVkJb5/SqFYXZK4MIO5",
-	"XOJLHG46NCLncgkuMY1PWNAZyztQ30CmsUR17QYqAYb7OZTpJRoIvEERm/wOriASIIdSL7cKS9a5u9TL",
-	"unIpuIAzpsgMnOniBviYsClM2zFqeZ2XihRA514JK4XQA0r7em2LRWMMdIq+OmWit4uBnbRzUVZFW813",
-	"OrwOzFmICbDxlbdU1cmrWikdBoeOz+eQYc79rRkA/2MJPEoJN/aqO4RlHiUEZCFKEKtGHFWjXcO6LRff",
-	"VlCjslifEtK+5BzXsHmgSIOGkkWJQ2DtIUnoETnWjuvrGvSZNpxjJFOBnhBB3g/e1QCoyzwdUocgSpB5",
-	"IBiexs31VCfNPAwaL9EcAIbpuuekvRn5UDDtSzDYLfDe/1J+hfX0lXMqpSHjfaxPIufditC3LmM+5noM",
-	"1kKfOx+U/83niLWzFOzaFclBhFnb7C2VuW9xlEx99t5kaaDnYWZWB0Z1vXz29cuxEYpZIYwANOkLDG1G",
-	"KgUX3gfK+lrXCdQQ6jlICXmwCRZCwUQLH2a1R/5RFz65BXvWy/wgvLU8+veIFLYr6i3j8K6uZYEVKSmW",
-	"baDO+TzGCpGwogZ6GdWXSKtBd+3QS/vd5zfxFQa3q1f78B7Oxe4i3T70jqkO5uPTNSdOONibezWSohyg",
-	"mWWcg5x4I267ugRvZurE1M55lVlRJT6bQXs9OAXaFm6WVGpm3VW2nlBRMo5r2JxYtY8vfO53PAbaypAW",
-	"9CindYsojqqrVim4F0cB7/fNIFoKUUx6LIPn3ZIY7cNwzbJrwNywITLFSMEPmsfGTEIeokEq+IzcLje+",
-	"4ENZAof80ZSQM26jA737SLMIamty/kBvm3+Ns+aVLXLjNNDTK54Os8JiM/Ke3M8Ps4Xn9fEmBYZf3nN+",
-	"O8gBs+s17/ORu8WqNM1SxdOh6o2uf0dLhIrIz0KREqAurCH4JbKExDuKYFKWKHsQ+gdQ4gzIRBUi5YV/",
-	"SOIYM1QaU/FkCJAGPuC5WkPhBk8iwDnZ7cgQ6z77HKhiTiTUvhmHJoN1+VUtE1d9qpH2zGGWJmecCwnx",
-	"jOhnanNFh8g2TLWM/5kxLancHJKytYmqlBqqF8s7vSWDo2S9kNpZsovDohC3E2Rrk1DgKaUOMO1U89r2",
-	"pVLrfuaozyByu6TKiYgbsqQ5yYSUkMU90iHeFqqVkDApBHphphw75to8ElYY18lJIRZElJnIwdZiS1NQ",
-	"31wV5xRlL4hc2ZIosLSDKQNsn4iOB05pbl9rnp2gvLaz1off/EvTx6avqFPx2UVPrItAT3wBKJcMzmHI",
-	"Nu7Ca9PGYSKmtlI2LSLP2RrpBmTqyM+JlhWMiWthBZKYhPDgUwlkxZSyoARaumVFgdkj2DpyaAj+QGnU",
-	"9sjO5+gHfcPQ4a2ZScSK1KW5HUP6lZgHXMSJ2IheSlEtllGJggCnf7rLyj3s41F+UhX6JGKIqJniGVkJ",
-	"pd2z2I5UL7l2AX2YCa6lKIqmIs/K+Qtn9P2Brs+yTL8W4npGs+tH+AjnQoeV5mOfUqHtu1vPJFv5IIe9",
-	"FPSaT5A81O5M77YderU6eh7MO1vcr2N42KXJj8D8sJu57rZrnHUX1l5Xk8+m30JnnFAtVixLH7c/l/dr",
-	"r89qinslEyzaQsg2Cw02Qz4Q32PBnQm5ZxfNwGmykusZcTzCuXUgJzL/RTG+PS6Zg+NBPXdol+84AWuS",
-	"9YqBLQAQUpsIQVfSVk+OhbTAcMTCJk5Bp5Q2oAMvHPT9ux9sZoSjA6XhXkB1vJEDgA+tBmNsE2Faz+aZ",
-	"WPvvj+pMmQcBf7edyhvMo8+p8qImLWndKn0iqx6OkC6GsNUD8RKTYMyG+iGGavgDL/8IgH7PxAYMg/wT",
-	"9wVjTlkB+SRVKPk86MDG0XPdxVhGo/uSkpaTZ7TyxYjN2JUEl1jJSv+yaU4sqSElEZp3NeI8hzXYGK3f",
-	"QApbSngcmbOgsJWGWxoFUU4KuIGGw6bL9lShFMpuwPdVoTPJAUq0+LYVbSlPxLhQYUv74tY+iXzZhmA3",
-	"qY6xiLU7RXboWpKaoTWf2GOihh4lA9ENyyvawJ/aV+Ro6hLNUU6gqvN8mPgn5tBpfrIjvPMDnPn+KVHG",
-	"Y+LDMD60NwtKo24bA9rpmVypvlPP047JcSqzYCjC2fJg17YkXvMNVdJb3q/V7JJ8/RIbuE9M8Aix36wh",
-	"Q6nGPYUgd4+hHsuJy4GE1M4BcvtgMF0S2vwlcMJFVHb5lqrwiqmTufof7MTYiHH30D7ARl/7D99/ZwkO",
-	"RlQr2WK6Smog6/vp+H+Xk7j1IPaOl6IRBS6Ud4tqzFO3e3ZgA1EVOeFmP43sj2WK3S3muPiYzCo/UFGI",
-	"W1tHOX6ivgJvz7XU501MTixn4Vr2ftJjl2e4rQVhUYTIim6IkPiPeZD+o6IFm2+Qz1jwfTeiltSQkDMg",
-	"Wy8K53dtJt4uXo09YF4RI/xUdt1s6JjRcBszSgS0uch95ThBVvQa4m1ABxHLPzNtGKeqZqjUMFd2azu7",
-	"WHCL9+mZVjSPlQCYaHbT4A4+z7np/b/qsNV4Kp//sSxo5qtmu/p3TT6DhfU9ceklrLaHOXf5mieBUKy/",
-	"Jlrp02TkB2hT92RdqZifvkJdDbA7Vcg7NcrutYyBSuFWvaUtAeKDlnLsXThODGdnSXG14V2Li4svf57d",
-	"SWaI7lvGEPD/QLvScK/oRLali7jH67H12j/DLjQS8SRgtWrwmVhPJMzVLkcaqwefiXUNsAq6W8YzCVRZ",
-	"v6PzN+7ZWidAZtw8o63XbjCrhlFymDNes1rGy0onXkGYB5lvIoTF1gREa49trk/GMKLoDS3e3ICULO/b",
-	"OHN6bHXiuGCQt6C4vgkFSLiRuwMwVb8AMZ661s/Hzcz1b4sdWt9ZpSnPqczj5oyTDKSRGsgt3ajDTVXB",
-	"6rDLWEUjWaiZLSQyWyFpW0CKjbM239OQFACkR7QoDbAEoZN2wgpkFUNa9Bh+ujD8KSxBK7qeFGKBUb89",
-	"B8LluUbToX1ACo5KdCvdDVu3n0ex32D7NFiBxDEiLXDWIVNsP/dvcCvxEfoTZ3rrybcaznYYtvV0tgfT",
-	"I5Uv6vAMSyzd85iKnHeJmeLoeS+q+jQlnvYg2sSkS3RHq96zi+hf4dIuxCr04YUzmy4cqfh8q1eYoL5B",
-	"bQnAAFXHFdDMeYh1FXEdRYVFythlN9hTT2e1+/5e6gEPFSnKnfXmtMFBx4yzT7XR7fkMJqUoJ9kQ31Zb",
-	"pCh3RgYHaRPGHvqITAg96w5+NyqU7WrkRGvU79q34Gpv/bBdtrIy26Yy6FMy9XD0pgFDzJGX4RG2qjWM",
-	"tQqqmLF/nHtjd1OJFpgEoURCVklUMt/Sze4ilD3Z5y/+dvb8ydNfnj7/kpgGJGcLUHVNg1YRx9o1kfG2",
-	"1ujzOiN2lqfTm+CzhVjEeeulD3sLm+LOmuW2qk5G3ClhuY92OnEBpIJzu5XxDtorHKcOi/hjbVdqkUff",
-	"sRQKPv2eSVEU6ZoyQa5KmF9SuxUZYMwLpASpmNKGETbtp0zXTtlqicpFzBp+Y3NDCZ6B1z47KmC6x5cr",
-	"tZA+n17kZ5iLwdmcCKzLwvEqayfati73TrP6PRQa0d1mBqQUpRPt2ZykIMKYLVlB0Ks7tSnq0yM33cBs",
-	"rcNuihCd83ua9M64ewmLOdnO7ZtlwXWa05tNTIgX/lAeQJp91o3+PCOHcJLaMPCH4R+JxClH4xphuZ+C",
-	"VyTfB1uiws86XhMhacgg0LoJMhLkgQD0xEM3glajILsoN7m0Nga0Rnjzc1v8+KE2S++MTEFIfIcd4MWx",
-	"zHW7EEzhwPmdE3v/EJASLeVDHyU0lr8rPNqz3nCRRFvklCZag7JsSXTFwiggXr0MceY9r5JOOLoUQhPz",
-	"Mi2KRBi71ePgmYoJxzwJ5A0tPj/X+JZJpc8QH5C/6w/cisOWYyRbVKqjJ+R8TQeBFYUofxao+FuMrf8P",
-	"MDubvB3dLM7w37kDUSVEC+vtPQ8WcODkFse0jl1PviQzV+6nlJAx1XYouPUiTYi3Bcnmzr8W1rod+3vv",
-	"MkE/C32P4zD3/kDkx8jIFjwHHMz1Uf+dmVMPB0ielhSpdgglgb8Ur4sLvO+4du5ZGuawVE5R4sY9Uzl1",
-	"S9cPXR6uAy+vSkF3nYNv/QZuExd+vbahucoGV5i5unqvZ0MSiqWrwZjumOPsKGVh7l8U5rMkOLOodGM4",
-	"SJKEVYvcu7LXtPwlozwNzV004n5P3filRb8ZDR8F84rb8UIBVIwV92xdzMfBi0Fw0+0FueKPiVpS/7Zw",
-	"fz59/uVoPAJerczi6++j8ch9/ZB6qeXrZFxpnUin4yPqqgk8UKSkmyHB7DtT5yTxW2cK+vwijdJsln7T",
-	"/c3sGT5cXQDCOUdWj+zF3qAuf87/JADaSgytwxpOjCXJOj1Q2IpdmYJ+7kuLb1O/91T7aHHfihU7neQa",
-	"hVjuxqOFTVKG1Ul+cbXqPu+2ewh68gW6pd8nDZhFTGKtjcmjqaKkbgMKsrhuiQoZGHmdVZLpzYXBv1e7",
-	"s1+uU8mgvgvpmVzOr2CBd7KvFtfAvY9ZncypUl66/k7QAqVP6xjAjcwpiin5xlYIcdfiXx/M/hW++Muz",
-	"/PSLJ/86+8vp89MMnj3/6vSUfvWMPvnqiyfw9C/Pn53Ck/mXX82e5k+fPZ09e/rsy+dfZV88ezJ79uVX",
-	"//rAULoB2QLqK/+8GP2fyVmxEJOzt+eTSwNsjRNasu/B7A1q2OaYoBCRmuEVCyvKitEL/9P/9hflNBOr",
-	"enj/68jVgxwttS7Vi5OT29vbadzlZIE5UCZaVNnyxM+DuSwb75W35yEuyPr+4Y7WNifc1JDfz3x7983F",
-	"JTl7ez6tCWb0YnQ6PZ0+wXyKJXBastGL0Rf4E56eJe77CWbRPlGuGM9JHTqatPa/wzAZ/6SXC8jJwxAE",
-	"+C/B30M98rGEc5eF8u/KEmNYxXmOxOXqpo+w7is6gCJYT09P/V64d00kXp5gxNmLjyPLP1LpcDtIvawB",
-	"TkJWV53uLvonfs3FLSeY8tceoGq1onJjV9DARjQ4bhNdKDTNSXaDmRlN7zbOy9KVQOpDOVbVbJ5y3xkJ",
-	"JNTHMSfMls1xhYxUCuXd8kv3xP7WFNCdyRK7g43eGph9mrOQNtndhA5n6GliERbOiFVWdhA9HpVVAp3f",
-	"YDCf2oazcVSyx0IjijxgvIPRt9V/E4wa0l2E9L/mryXQAkUj88fKEGrmP0mg+cb9X93SxQLk1K3T/HTz",
-	"9MTrHE4+unxSd9u+ncReqCcfG0m58h09vR/lriYnH12eqh0DxmaRE+ffHnUYCOi2ZiczrLc5tCnEq+tf",
-	"CtK8OvmIurne30+cnJ7+iOpTe8Oe+MdHT0ubQyj9sYHCj3ptFrJ9ONMmGi+jOltW5clH/A+S7Z097QWk",
-	"EuTZgl6U1M3HhGlCZ0JqZX813MAXn2cqatk58mem10sLAd6m3ilx9OJ9N+YUByJ+JBRRzP1bSxCNmWoh",
-	"EY2wEVMIInCjfS0Ivz+dfPXh45Pxk9O7fzKCrvvz+Rd3AyN2XoZxyUWQYgc2/HBPjtfR2daLtJsUGFj3",
-	"keFooT+m0G1VayASkLGjnnRr+ERaZtPl2RF5fLO6QIK/f01z4rO24NxPPt/c59zGpRhB1QrUd+PR88+5",
-	"+nNuSJ4WXiQ7UHg7s4c/ZgrEbXZKeBuPuOBRPly+sGKGSGXO6eE3StMD+M2F6fU//KbRsOMbgLG/1tri",
-	"agFGKhZ7mYRCuOAzh3tNIM1vKM98AGgdkYX7ZSVvRxjBbb9SMK8KnxWpLJyiyjxu/USqKkvDceZUBcpy",
-	"YWDmwWyTuoShScUzwa3DJUbcebcRTM6CrifqmpWNLmxuqApzwPnoz6nf9H9UIDf1rq+Yefl23kzDUrL0",
-	"f/uUjN9i/wiMvznQkRn/0z2Z759/xf+9r7pnp3/5fBD4DGyXbAWi0n/Wq/bC3nv3umqd5G9rc53oNT/B",
-	"UJKTj41HjvvceeQ0f6+7xy2wpIx/eIj5XKFCZtvnk4/232giWJcg2Qq4xrTl7ld735yYG6HYdH/e8Cz5",
-	"Y3cdjYT8PT+feD1s6m3dbPmx8WfzvaiWlc7Fra0Mk5Ry8NKlBVlRThc23UhQXZrb0w1Q1wogb8pwvbks",
-	"A4RiiV9R6Vq3bMPmXOqR4DOE92DwHF0wjhOgGwfOQuemK42ufVeJu6t5vHCQ/Shy6EpUqevTwdi4QsNR",
-	"OE1E2Hw4jk4zYrx3+x0UdDexHlZdMjIfK9X+++SWMm3kLpd+HzHa7ayBFieuxGvr17puWucLFoOLfozz",
-	"pyR/PaHNc9HUu5gt6+vYUcqkvjq9Q08jH7jnP9cmn9iEguQSjCfvP5hdVyBvPCXVFoEXJycYB74USp+g",
-	"/Nq0FsQfP4SN/ujJz2+4+baeCMkWjNNi4lRrdQnr0dPp6eju/wcAAP//js+ZkOkTAQA=",
+	"H4sIAAAAAAAC/+x9a3Mbt5LoX0Fxt8qPJSnZsbMnPnVqrxLnoY0Tuywl5+6NfRNwBiRxNATmABiJjK/+",
+	"+61uPAYzgyGHFC0ntfvJFgePRqPRaPTzwyiTq1IKJowevfgwKqmiK2aYwr9onium8b8505nipeFSjF6M",
+	"zgShWSYrYUhZzQqekSu2mY7GIw5fS2qWo/FI0BUbvQiDjEeK/bPiiuWjF0ZVbDzS2ZKtqJ3WGKag7y9n",
+	"k/9zOvni/Yfnf7kdjUdmU8IY2iguFqPxaD1ZyIn7cUY1z/T0zI1/u+srLcuCZxSWMOF5elF1E8JzJgyf",
+	"c6b6FtYcb9v6VlzwVbUavTgNS+LCsAVTPWsqy3ORs/XodudnqjUzveuBjwNW4sc46hpg0K2raDTIqMmW",
+	"peTCJFZC8Cuxn5NLiLpvW8RcqhU17fYR+SHtPRk/Ob39l0CKT8bPP0sTIy0WUlGRT8K4X4VxyYVtd7tH",
+	"Q/+1jYCvpJjzRaWYJjdLZpZMEbNkRDFdSqEZkbN/sMwQrsl/Xrz+kUhFfmBa0wV7Q7MrwkQmc5ZPyfmc",
+	"CGlIqeQ1z1k+Jjmb06owmhiJPQN9/LNialNj18EVY5IJoIVfRv/QUozGo5VelDS7Gr1vo+n2djwq+Ion",
+	"VvUDXQNFEVGtZkwROYcFeXAUM5USfQDZEWN4tpJkxYX5/Nnotu/XFV13wbtUlcioYXkEoFFUaJpBC4Qy",
+	"57os6AZRu6Lrv52OHeCa0KIgJRM5Fwti1kL3LQXmPtpCBFsnEH25ZAS+kJIuWITnKflJM2L8VyOvmAjU",
+	"QWYb/FQqds1lpUOnnnXg1ImFRHSgZCVSjIrgB4fmHh5l+x6TQb3FEW+3f9N84T61ob7gi8tNycicF3Bf",
+	"kn9U2gQCrjRu+5IRXbIMeG9OYBhAvuYLQU2l2It34jH8RSbkwlCRU5XDLyv70w9VYfgFX8BPhf3plVzw",
+	"7IIvenYgwJo6pxq7rew/MF76qJp18i55JeVVVcYLyuKzALRy/rKPMuyY/aSRZpBnQW7A/XFjXa7PX45u",
+	"D+lh1mEje4DsxV1JoeEV2ygG0NJsjv+s50hadK5+H1nxAnqbcp5CLZC/Y9coUJ1Z+emsFiLeus/wNZPC",
+	"MHsVRmLGCTLbFx9iyUnJkinD7aC0LCeFzGgx0YYaHOlfFZuPXoz+5aQW9E5sd30STf4Kel1gJ7iMFQPG",
+	"N6FluccYb0B4RFGr56ADH8JPZC4VuVnybEnMkmvChd1ElLuA0xTsmgozHe11km9j7vCLA6LeCntJ2q1o",
+	"MaDevSC24YxppH0n9D7QDUkRMU4Q44SKnCwKOQs/PDwryxq5+P2sLC2qxoTPCeN4n7M110Y/QszQ+pDF",
+	"85y/nJJv47FveFEQKYoNmTF377AcxrR82/FxJ4ADYnEN9YgPNMGdlmoKu+bRoDUzxyBGlCqXsoArcCcZ",
+	"QePvXNuYAuH3QZ3/9NQXo72f7qAVcUhFarK/1A838rBFVF2awh5ATWftvodRFIyyhZb0eY3gY9MV/sIN",
+	"W+mdRBJBFBGa2x6qFN14CWqCklCXgn7SzBJPSRdcILRjEMgFWdErux8S8Q6EwHSQtC2Z4aDkhptlLXIF",
+	"1E8774s/NyGn9pzAhlMuNKGk4NqAMISbqcmSFShw0qBYiKnoIKIZQAtbFhFgvlG0tGTuvlg5jgtCw/vL",
+	"wnrHm3zgJZuEuf4c0wBCdTAz38lwk5BoVDg0YfiykNnVd1Qvj3D4Z36s7rHAaciS0ZwpsqR6mThTLdqu",
+	"RxtC39AQaZbMoqmmYYmv5EIfYYmF3IerleVXtChg6i43a60WBx50kIuCQGPCVtzAA5gLPAELfs2EZT1T",
+	"8jXNliBMkIwWxbjWS8hyUrBrVhCpCBeCqTExS2rqw48j+4cSniPNgA8aRqLVOJ3GlFwumWJzqfChqhhZ",
+	"UbycVvA8Kotmn8BcNV2xluyEl6WsDFONl8v5S786ds0E8qQwNIIf1ogP/njwKTkLn3BmIe3iqGKoaOEi",
+	"K6q8xl/gFw2goXV91Yp6CqlyVPRQA79xRTKp7BD28neTw38YVXVnS50PS8UmbghFr5nStIDVtRb1KJDv",
+	"GyVLqZn6O+OL5TEEwBktqMjYZMs15pq46+xmKYHj4vyaaFawDKgP1pTJFdIi07CGsMoH2pHjaM83f+kW",
+	"mwZLKg4XfEF8s8akY0I1gWcjXlncaJLBoueAF3bYRR4Pv/dajDS0mFi0pSfBFoStDVOwKtuUUEMaWwQT",
+	"h8e00yl1QLkdj7bN1J5DBtHR4vGwOZMiSbSJ4xatBRhbyBnC/FKraCwhvgFoXrP/Y91uO262nBo6HbUX",
+	"ktaI2JsX++GjiqnEtr3G/9AipuKI+3KU8/FNEPgZyryAFDsTNNDMECPJyuqdCSiD94Lyq3ry9DU9aPO+",
+	"tqputz1uEWGHLtc818faJhysb6+aN4zVmfrrvMMdtl7a0VyDqFeWxF6/LRDsTesYDCBEro8uFn4p1ymY",
+	"vpTrjkgo1+woOyHX9j+DhKUv5fqlg0yq3ZjHsYcgHRYI9wHeTbRhRoRZalPP2Uyqw27WjmmvNmARCqNG",
+	"j5FxC0nYtCon7mwmzEu2QWsgEtSz24Xo9vApjDWwcGHoR8CCNjQC/g5YaA50bCzIVckLdgTSXyYfQTOq",
+	"2WdPycV3Z8+fPP316fPPgSRLJReKrshsY5gmD52enGizKdijpLyC0nl69M+feYNic9zUOFpWKmMrWnaH",
+	"soZKKx3YZgTadbHWRDOuOgA4iCMyuNos2slb2+92PHrJZtXighnDxUK/UXJ+dG7YmSEFHTZ6UyoQzHXT",
+	"qOteGyc5NDlha6PoSYktmciR5nEdXFOt2Wp2FKLq2/i8niUnDqM523ko9t2meppNvFVqo6pjaA6ZUlIl",
+	"r+BSSSMzWUzgncRlQvf3xrUgroXfrrL9u4WW3FBNYG40IFci71HxgWV48P1lh75cixo3W28wu97E6ty8",
+	"Q/alifz6FV8yNTFrQZA6G5rHuZIrQkmOHVHW+JYZK3/xFbswdFW+ns+PY2OQOFDiKcJXTMNMxLYgXBDN",
+	"MilyvfN55a3pLWS6qYbgrI0tbws2/VA5NF1sRIbvumOc5f5HpzOVE70RWaRKBhgLli+Y2omkI6mM+zBl",
+	"oXigE5ACpl7hZ7SovWSFod9IdVmLu98qWZVHZ+ftOYcuh7rFOJtdDn29RYaLRcEakvoCYJ+m1vhJFvRV",
+	"UNrZNSD0SKyv4GEcvS/fKPkR7tDkLClA8YN9mhfQp6ui/VHmwHxMpY8getaD1RwR6Dbmg3QmK0MoETJn",
+	"uPmVTgulPV5vcFCzSikmTCznoj6QazJjQF0ZrWC1VUmMTN0vdccJzewJnSBqdHrC2tXJtrLTLek1I7RQ",
+	"jOagfGWCyBksuvYSwkVSTUqqgtLHicRD+W0D2FLJjGkNFmBrdtkJr29n7x+zBXm4GlxFmIVoSeZUfZwV",
+	"XF3vBP6KbSbXtKhAPP/+Z/3oj7IIqz3bvgXYJrURbfV3dyl3gGkbEbchiknZatvtSSBG4sugYIb1Ifvu",
+	"2Ovd/jaYHSL4SAi8Zgo90j7q0fKTfASiDPB/5IP1UZZQlRMQA3vVDyC5wn4LKqSXDXfMECbwamun7p7o",
+	"jBZsF4bauu5KcGuXk4KR9ojwcUwoKeWN7W2YmJLXzkwY1MJS0awAWScrqGKaCCnYUOtCasr0GuALTucg",
+	"d7NSfaVRwGqtDO13M+Z9dvFYjYmuwIyJzis5B5fYv7vGwCgcWIiOTArNhK70X52zhZ3EjYYK8j0w0bmo",
+	"C6rNZJc4AI0aOi8g0+gG7h245y3wimqDIjzhIkfduxVjcB7sg1Psa5DCKXtf0jDpz/4R3Z024Dm8qHVV",
+	"llIZlqeWh/46vXP9yNZhLjmPxg7PdiNJpdmukfsQGI3v8GhXYnFHTfDOcf4+3cWhxxWInpt9sdyAr8bR",
+	"NhgvfKsI8XFAQQ+MXNd7YMmN6xa9zaQsGEV1tzayLOF2gbMb+vVh8MK2PjM/1W27JGlN4DgnySWeI+Nh",
+	"cpDfWKRrtPMvqSYODu+bhcpK6x7chRlY8kRzsCBuOy+owIBW8cE5iFVb0aDF67aJCfWuRDbJJuNTDNBh",
+	"2ZI1nvvndNd67gh0bpgi9YpbDCzq5l0aglF+tmnz16EMvioXiuZskrOCbhJOdvYzsZ/3PBN+bDwbtdpL",
+	"GjaZoRNJ+njU7MDbjw+bVeJUCaHkR0nwC8moNrhB9X663odPmjOcNkU97pw+CLMgGMkj4MdDZNmjlBgR",
+	"afFaImXZRnY1Tpi641p6sBdm/SgIxHEntf6qPft/Me3m9m2OO/+G6b6F11Mfb9lOrLI8w1rjuVmu+u/Q",
+	"y5rRhNb11eB8p1pCZE7ZCv3L8Uq3AYhmQ6jQN0wlxKQmY680I1T0DEokevcRIdvjWtmem6R81Vx2RrMl",
+	"myz57rePX7nIHSN2PxQYEaNr6C0MLPdQwJ5lS5YSsd1CbA9N8N4h3JkwWf5X603WhBjCVTjTY3v5giee",
+	"dq8QmGTJDVHgfXQn1O7nABRwCRSZVYZfs8mc8qJSTO/76HAYWTJamCXJliy812FEljscBSFRCmY/6yrL",
+	"GMPoxrDyLvJQ9XrY6nZbhvB8cJUwBd3xcHTX0TmtA0jdEc6uHXEu6xFBayaMN0L27Febcj8F+YVIp8Rz",
+	"CfxVmQ5CT88q5igLRc+oB7pJiS/cnxuImEWmmwMXyuWNuDsvCy1xLmQcdrYBe9sWWy0JpeXW6Aj1AIPk",
+	"GNgY9SQxbsm4d18yN46DR9Nt2O44hbRhrqFWabx3Wy/R1mMx+cLrfVbteNf0PSF63EDeUGV4xku0FHzP",
+	"Nkc3nLQnSLp5k5wZy16jD9aIUsb9iY2gbI95mCFlkKW7C37H1J1Yjg8qaQJ/xTZosXpjg7EjQ+ExLEGJ",
+	"UQnXQP8IqA/4ZXkzdpytaWaKDaHIcDcEDgHR1cyerK73kpHlJB4gne6hf0bnC5n0RNzqnHmBQ0XLSwVN",
+	"2dfsdvguW9ruBjrcTVlKWUx3+/92kJGEYFCkAykl7DqnRbEhJkT8e0pqAOkeOcXGg+vuihjNuALyX7Ii",
+	"GcUHdFkZFnQsUiHTM3g3aTTj1XO6KLsaQ6xgK2YNKfjl8eP2wh8/dnsOykh2Y6MFBDZso+PxY7SCvpHa",
+	"NA7XERwN4LidJx5O6CYGD0XvBN7iKbvjc9zIQ3byTWtwPymeKa0d4cLy78wAWidzPWTtMY0Mi00y64Er",
+	"v2xGs3TWjft+wVdVQc0xfMTYNS0m8popxXO2k5O7ibkUX1/T4nXohpp+lgGNZviGmPPFwLHYJfSxOVFg",
+	"HC44HGArCQ4FiJ3bXhe20w5FeR2pwVcrlnNqWLEhpWIZy62SjWuiw1KnBIcl2ZKKBT7UlKwWLkrTxboA",
+	"w6+0NUKqSnSG2DsKZC0m6D2ikxk20GPM3QLWOkJBMd12PbGvqhsaQGF548oYuD1tV5ykt9p4tPVNdV3r",
+	"7S3emtluDvXjasiHEdJqaAY6LiE+QVbqIjHeRjh8QAwfx0GmHjoFZXfiKJ61/tgX0grmgmJzBCHJDkQU",
+	"KxXTeKXFFlhtv8o5+YFnSp4VCxnuPL3Rhq26fjO26689x/XtIVpcKQou2GQlBUuopV/j1x/w42CLr72G",
+	"e0ZEgWivAdsPnwYSWgtoTj6EpO+6SUgy7bPfdjLT30h1LAdHO+DgN8UAp8GdHrVuykNdGyFas+sNaFXo",
+	"HS6ixyGelStCtZYZR0HxPNdje1qdA6GLpGui/03I6nCEA9wet+X2FmWQsD4UrCgJJVnB0cNCCm1UlZl3",
+	"gqKhLlpqIk7DK7j7NW5f+SZpM3LCyuuGeicoKoOC+S6pY5mzhD7pG8a8cVdXiwXTpvXAmjP2TrhWXDg3",
+	"CogmhuMyseelZAqDJaa2JYQyz4EmjCS/MyXJrDLNJ8eq0oZoAzZi64MH0xA5fyeoIQWj2pAfOHiEw3De",
+	"hdcfWcHMjVRXAQvT4YxrwQTTXE/SQSbf2q8YD+9wsnSx8fB/17mOZg2PSVh7I9/e/334Hy8gzx6d/H46",
+	"+eLfTt5/eHb76HHnx6e3f/vb/2v+9Nnt3x79x7+mts/DzvNeyM9fujf6+Ut8iEUh7m3Y/wj+FCsuJkmi",
+	"jH25W7RIHmKqP0dwj5q2K7Nk7wR47xtJrmnBc2qOSD7ta6pzoO0Ra1FZY+NaajyPgD2fQ3dgVSTBqVr8",
+	"9aPIc+0Jtvo6x1veCu90nFEfHUA3cAqu9pypiKYH3359SU4cIegHSCxu6CgrWuIFYz80Haxhl+KcFO/E",
+	"O/GSzfE9KMWLdyKnhp7Y03RSaaa+tFHi04UkL3w+l5fU0Heicw315r6N8jFFyW9TnIKu0mt59+4X0LO9",
+	"e/e+4wLala3cVANNI3bKCcgNsjITl39yotgNVSl7vs9OaDfK9t4Kh5VJZOVi4u34xI0/1IBDy1K389R1",
+	"UVSWBaAoIlXtUq3BthJtZMh5wXVIGwQ08KN0/ryK3vgnb6WZJr+taPkLF+Y9mbyrTk8/Y6SRne03xwOB",
+	"bjclG/zw7c2j137v4sKD64+ik5IuUqa5d+9+MYyWSCEocKzwpVlgDgdFY5yEIEwcql6Ax8c+W2Ih2zsl",
+	"ES73wvbyGYnTi8JPuKnNtE932sEoodfBG7gjKRitzHICHCG5Kg3HwO+V4xuELigX2jsAar7AB4BeygqW",
+	"zKy1zyXlZavSbMaN7nLeuIs9w+EadUYuL8OcA/4yKmDAqsypE2So2LSzc2obh4qDvmVXbHMpbffpwMTG",
+	"USLtKDuk7ju6SLvRXQvkGx9kN0Z7853Lu09v4zIpYsoLTxYvAl34Pv1H2woARzjWKaJopCjsQwRVCURg",
+	"hz4UHLBQGO9OpJ9a3iDfxAFeiVQD8TfyGO7toYjLkoL91T6T+LwxWkaFkAYdpex1QA25P3dGLjIm0PmF",
+	"FXzBZymP+7937T8R8ESxjPFrn7gpDKhhodzokCXJviwVFQtGqHEZaWiBcaXTpFMfStFLRpWZMWq26rVF",
+	"nEnQQwf9yQ0Thljl0pigXz2cC25QWSTYjU3RxJVr42Ldpgd5jds1sfxAUH33O2RTgreGQ3giZbmXi8Ke",
+	"hHeV8x+JT/HlMnxfAQ4XSt7AbgKA0mfnxxye0X1eaboY7KvUMKkNzHrYsJThILukxKRcCHb2pvjXkcUG",
+	"LsJ2nwBeklyUwRdgo2guaUXh+LmtqdVZX15DtiKH1FmBD48Qw2RJBxhAhDyx2A/YNLtnStRCvQesibX4",
+	"6C+p9kc/H0c334FS9afJFrotRfp5FGRATTcBuhdn2lfg2Oq9Zgz9/OTcJ0r32dF9SvTReK/05uOR5UzJ",
+	"vZMCXxs5K9jC4sQ29nRWp+CtdxPgeD2fI9ObpOIVIqVtJMG5ORg8WB8TYi0LZPAIqVMQgY0eCDgw+VHG",
+	"h10s9gFSuBTC1I+Nd1f0dzqMyQWMwmtCliAdcdEnOTiW4jIY1qJhKwoPh8FQLeCk17SI3BLrQTrpuPGN",
+	"2Eq+7XxgHvW9HQceNLdGlOL2WiX2OGh98QPFLyP9etprDTO5ntjkPckn6Gw9gzORDKmFXsnDa5OjP9Bk",
+	"Jtfoe4U3nI3B3Bu6fsg8YLFPMddI5divT7y24O0HyPYHT4qaNXkYnh812fVJ/IcB0/Ps6CO7h1GW9COB",
+	"1FL01pWenOZrpz6qKW11JZH6uh2HpI8hk0KK1fQdzuRO9mC0q2RupjP/rs5o35//2jW6nzzuXeXlXVLv",
+	"284IiN4r836bHBpAbMHqm7YQm0Rro1ULrxHWUiypEb7bsGc00eaepFJMGnL15Ipt0oofhjLDhe8W6YNx",
+	"96jYPIq8BhVbcG1YbYThUfba+7WRodoVHlty3r86U6o5rO+tlEHQwI4EOzaWee8rwDC1OVfg3w0WrOQS",
+	"oNE3GjWO30DTtCDc2GzCtTWJ7S0HI0SQbyDnRZUmZQfS9y8Boh/DzaWrGV6UXFivrBlWO0s6Mu9hw0V4",
+	"rAP8VgS9sgh6Re8DP8MOFjQFmBRQXnP6P8kRa/HCbZwlQcspYupuaC9Kt/DaKN1Tl9FGQnTknjLdZhvr",
+	"nMvcj73Ta80nneoTIuxIybVESe/TgT9ysYDIb5uL0+UtoSJkPSe0kGJRp4uH37dkiJ9C5S3t8qxvSdHu",
+	"3PhZnxN/o2IkFj5MQh81s5DXSQQwvTxOsmDCJhcc7V9SspCLHQEE2CLSIN8vb++EFyRdrC9bbtW177Pd",
+	"w7DZuD0Fo7l7Vmnm17f90Ha3y6Fu3Oec3agCsv2A4YBIcdzoSIDpEE0P56ZlyfN1y0BqR50eQBIDxb1u",
+	"sa8WzvhOtDT9rndUYX2gifPudragE3zdn8Db0rp7O4dlOBI0c3mw8kqhsa3hTN2tlBbelwOX/P3PF0Yq",
+	"umDOYDqxIN1pCFzOPmiIio1pYrj1H8/5fM5iQ6E+xMjVAK59BpMVZgdSXteaGJ6UW8lyb9qqV7AboWl6",
+	"SlBKn0vKZddc69rGKrVwx0Qbd4DNNZnq6nu2mfwMihVSUq507brr7KfN23wPmrhefc82OPJOj1gAbMeu",
+	"oAbuLUMKTRlVwicd1X96oGOM2advYwv32Kmz9C4daWtckcT+o1FfTPGKWkv5eMem9iACSIfs1UXaKQfO",
+	"FmtuS5vQd20Rz3eLPNHLI57K5ts65G4LOeB2Ot8xWnjCx8WObseju7nDdFlYGHHHTrwJN3JyF9BZ1bpH",
+	"NHzi9twQWkKNOlpMnBtRn6yh5LWTNbC59zq652dV+lRcfn326o0DH/wyCkbVJGg4eleF7co/zapsccXt",
+	"15AttOVUulYDFm1+KOYSOxrdYFGtlhKtU8W0diurx/OOR/O0I/1Ovuk84OwSt3jCsTI4wtWGaOzc8n2j",
+	"15QX3t7roR2qXLfLHVY3N8kn4gHu7EMXOUfeeSzNf2cT9MCVPf5rOuDX3YzOYxecWXwmRUU9ltu08cOX",
+	"b/ff/N7YDtD+eHBq2451bgsV2BL+j/pA7/QOA0wzkPoA7mDbiPzXmPg//QYUriwAcmvnJEiPLpx+I1Xj",
+	"9nSRqEknw48ntcILx+Ix7SBw6TwCOrLqlFi59rfFb4Rr8vhxTHGPH4/Jb4X7EAGIv8/c7/i4e/y4C7QV",
+	"CNJ8FLWKgq7YoxDL0rsR96sSEexmmAxzdr0KgrvsJ8NAodZb0KP7xmHvRnGHz9z9AlZ/+Gk6RG0Sb7pF",
+	"dwzMkBN00RdJGhzWV3QNcS+aSNFiFjayGUgL70NXMNLa/LtHSFQrtIFPdMGztAOSmCGHFNYNGxoTbDzY",
+	"ng1zVLwnFkBUPBodmumDzK+thUSzJhGuk4UzavzOpGMBleD/rJjLUzXnTOEV0JIY/PsMR+1I/WldpxsY",
+	"+0TDD5Xwodu++qst5koLZC+qeq2+L4Ml0q8/Vc14z9CUeMYOz98SVuIIyd+agjs3kUEEtfXNGQzDSUWQ",
+	"s0R7rumMvv2PNeCxvt/5yyEbzPVkruTvLC0yoJ0ykWXFAYKPR+ydcpNt86/gvODXG8++i0CG6zn6SOXO",
+	"eg2/6FCb/ZCbO80e9tvoPRUY0X73qzB0ugjPeBSf7TTc9iNpxjz18DA8sJEHPzqQe487KuwJtSlIGkGC",
+	"6XMetdAndvz6nDuY27ueFfRmRrOr9NsVYIq2v+EbaCTxnf0G6ZBFw85OorCT0JbblGwlU7UBq1tQ5MB3",
+	"qJ128Au0fnBCx8ZTc2zdZQotE8NU4oYKw7w7jeWArrdm1hUEet1IhanUddqNMWcZXyUV8+/e/ZJnXeez",
+	"nC+4zTCP2e9c1AIjbiBi87UjFbmM8SFtjEPN+Zycjusz63cj59ccH2LY4oltMaPapb+X82YXWB4TZqmx",
+	"+dMBzZeVyBXLzVJbxGpJgq4AJc7gjDtj5oYxQU6x3ZMvyEP0Wdb8mj1KXzBORhu9ePIFunrZP05TIlLO",
+	"5rQqzDYmnyOX97EUacpGx247BrBVN2o6OGKuGPud9d8nW86X7TrkdGFLdwXtPl0rKuiCpZ/pqx0w2b64",
+	"v+hN0sILJrAlOdNGyU1fltwVMxQ4Vk/gPzBEC4YLplk5Z1UtV0BhnrX64+eHm+JpsfQR4PIf0Qu8TDzt",
+	"P8Eri67S9EDRsf9HNPnHaMVS4JgahdchII5FTsm5r9+C1ZNDtkyLG5gLlo5iKmwhFurkwqAGqzLzyV/g",
+	"1a5oBgxx2gfuZPb5s0QV4mahTrEf4PeOd8U0U9dp1KsesvdSjusL+Q7EZMWB+T+qs29Ep7LXXT05renz",
+	"fO4Z+s7SNYw76SXAqkGANOLmdyJFsWXAOxJnWM9eFLr3yu6dViuVJhhawQ799PaVk0RWUqXqwdUMwEkl",
+	"ihnF2TXLezcJxrzjXqhi0C7cBfpP62DnxdJIdPOnO/lYiCzciXdayIAFkv7PP9RVpNDQbkOsW0pLqRLq",
+	"WadovGfP2P3UhG17vvVIxG89mBuMNhyli5WeiBP8ue7zKVzO2iDZPW9oSJ/8RhS841HWf/wYgQZFqW36",
+	"29PmZ8veHz8e7rWbVhPCrwnUHHbXtHYc+6a2Gsr5v/jQU+s+uK65rDLdbU7fZXClztwYY9IsKH7/csdx",
+	"Qib39oROHyCPGvzcxs0n5q+4mXUQTj9/+FKuX7pVSZUmnzx8j8I4KPlSrocSUeva8vT0B0BRD0oGagVx",
+	"JXLeul2SXhs7XY4isoVRZwxcnnWjTOxgD5o/0S4AasZb9qLiRf5zbXxu3UyKimyZ9GufQcdf7TMgahBp",
+	"MMDEKliR7G1fy7/6V3Xi3f8P2TPsiov0p9bCHewtSGuwmkD4Kf34gCtuCpggRlEzd1rIRlMsZE5wnqjW",
+	"SWCN01EC8S+hTvyFzUKj3yg579KTHXZVGecYjfkbXOm2OS/gfz1mcGw5Ub2FQBRG/87rEdk1AzMbPvDs",
+	"6EwRyld4bWsKJWHxEF4zRRfYVQrW6o7J9XDkqDgG0SV8wpaYp0cSUykBBd+jZTBhuGLFZkxKqrUd5BSW",
+	"xdY49+jFk9PT02G2RcTXgLVbvPqFv64X9+QEm9gvrj6urW2xF/iHQH9bU90+m98lLrVRlXhr65WkWCx+",
+	"sDHh0NlW7sROhIkclbNT8i2mkgNCb1RzAGhCMuxm+taqLCTNx5i/G/y1iJ3V9lEMUZcD4S8A/tYRSRp5",
+	"hqez9anyetKMDR9ne5YjWLU2WFxFG7oqU0kvocWlb0B4yxMLdYMxdqbkpVXLBn8eOwnBLPBqxXISpnNq",
+	"ACQO+I8xNFtCAzkdbVUp99Rd3F3O6Y1r4TlgbS6KQm+v/Ufk4LAM697ASCVypsZEgo76hmuGqS/YNWvm",
+	"1vRgeIW8z7XZXK2qhLCEM91Deg2FSPfdBQ8cjhvcKpKQtfbhzra/OpmIrFTGhlOvPfkX2CsdOiSag7Xc",
+	"HWx1k7WvjzIlPzhjR0aFFDzDuiApERyzZg4zqw4ooZK2d+qRO8uJY5gg5ShG3mHRrf99L8t0iOs6NURf",
+	"Yb8t4dg/DVu7UtkLZrTjgSwfo4KKF8wZ6LjQzJWaBfqKOapUCY+vZIhO8Bw5onv8eISJ73p0rd/Atx+d",
+	"bh7OLrniAnVuDqm++jMa2ArN0c4uCDdkIZl2q22GpulfoM/0ci0QhPfTV3LBswu+wDGsByIgxXokd4c6",
+	"8/7Jzh8Y2n4FbV2ZifBzw5POTurX/T7JQnTY/84nKI3Qh/6Uy5ePkIuQG8aPR9tCjFvDDvBeBjKE+iNE",
+	"G1bifd4hG6ZU6uEJ1UcqS2/Ygtjg4RRSCi4SYLziwht806m4suRdghuDp7mnn84UNdmywaR2OR/3hOZg",
+	"XH92dYyhWhuMKME1+jn6t/FyLVzFjx62EhrUrwsqNsQfCqDuSCiBSN/g6I3CVFMvDdKZE8asj7AN9nXi",
+	"XZqtAFuf+OjgBrp2xqKG7li4Zt97qi8x7KzKF8xAitFU6rsv8SvBrz64EYrnVKFeWwh1bWbW71KbmyiT",
+	"QlerLXP5BnecLueaas1WsyLhcfsyfGR52GGgNLDxwL+pYmX9O+Mc8PcOQPfe9vl+5SS6AfUp6RloeqL5",
+	"YjIcE3in3B0d9dSHEXrd/6iU7mPP/xCh5S0uF+9Rir99DRdHnFG949pvr5aQ8Bzd6CV+9ynJQtLdJleC",
+	"b92SfOiRgZuX2LIW8L5hEvBrWvQkfYitNvZ+tZaMvtQPWW9mE2pcAj1DSc0Thqgw+lOQWcfrlmWoa97s",
+	"c622ntUf03ji8LEV6f2Wxu8bdkXr9VYzlF574mEmv5oI9rX5uaoZXX0pLQqZDeYMbpgz6NSfVVmuVq5I",
+	"QcIr73ol8/gsRN5crXzFSV9t7zsWPWwh2MbCVT/KbbYWHScMdl4HrRzB1iGlmfB4CmkXG8kZ/figZezx",
+	"RGMszZR5nvzZwZ78hqtIflE36dEaup1A8DvrL9+1dHwoHMzWJaI7FE5vVoUeis9OkeSBpbvjzesp5P2x",
+	"QG0zEzxOjhzGNljYb7XfWLuN8aZFqnt3wsg3vGCEC/KfF69/HPUf6Ogkdo+2y3afNGX0HdAQPdlmEwvZ",
+	"oK0td4EURdoOontMK5imLM0VpWG9H77RZihINmXXPq1fDR28QwALaQu5pUrddBMljert8MiPqKHeXnuz",
+	"xNSRoop2gbTEGxhbRFeUU5t1RutRhDVk5SH12FKlv9yL0WvircDhUiPaemidUmqdi/TlkEdCBx+349F5",
+	"vpcYnSofN7KjpC7aV8BMvgTLx3eM5kzZEkAptYItALRioI7QS17iO7iUmtclvAsYzOWUX+Jw06GRWcAX",
+	"8VNIXNEZyzvSX7PMSNVwB1aMDfd3KdNLBAi8YRmbfAKXIMVYzkqz3Co0Wyf/0izrSr/MBR6C5Z05E9Y1",
+	"E2PCp2zajlXM6/xkpGB07pXxSkozoBS217pZNMZAp+irU1Z9+3Ogk34wyq5pL8Tp8LpJZyE2xMbZ3lBd",
+	"JzFrpfYYnEJgPmcZ1l7Ymgny70smotSAY6/CRVjmUWJIHqJFscrKUS0bNawFPRDUgt4PpB+h8scV2zzQ",
+	"d6j/4W2EY1v8g2ukIYcXKViEMyxq52qBcONKhgR73P3UBMl748sfaNI4hcky6CFE/ZByDkhe1iPCVwjp",
+	"MxI6F2Ouw4mEziGixHZndWG5Qyp6RKlmDwTDcwlC4/Szh0HjZcIDwICue09aJ/78aIcJLc4B7geahDnh",
+	"7/GdDlsipLb33P21mb0LY5Acj8KlsLzOU1ds7uEc9mYWxYdVX6LUNzYHeSSK9mv8XjJDeaGdczwNlTti",
+	"vTiY+Fr2QHLjKn8AImqvB18DhGn/m891bWcp+BWLdwt9TCA9um9xlIyj2IzwNNDzMDOvAzy73or7+hfa",
+	"SOuskCDAT/oC3JsRlyEU4YG2MSN1IkiEes6UuwyQ5Aup2cRIHy66Rx5lC9w27GmMljkIb63IpD0yHtgV",
+	"9ZajeVvX5MEKxBTLz1AXRBNjhSi2otwyERlHoSdcHHfs0Ff2u8/T5CvKbjcT9eE9nIvJTjdtH0LMdQfz",
+	"8emaEyfc7s3GG8mdDrAwcSGYmnhnlHaVHNHMOIyayLzKLOuLz2awwg1O5biFmyWNM1l3lS0VQJRU6Ipt",
+	"Tqz62qUXCjseA23fQBb0KDd/iyiOanPTKbgXRwHv02ZCLqUsJj0eDufd0j7tw3DFwSuVwGXlI+zgFfeg",
+	"eWxgEvIQDevB9+1mufGFa8qSCZY/mhJyJmyUs3eDaxa9bk0uHpht869x1ryyxbqcJW36TqTDRbFolroj",
+	"9/PDbOF5fbxJM5HfeX47yAGzm7Xo8/W9wepazdL006Hqua6fWkuEisjPQpESoC6sQ8tXyBISegCCyaWi",
+	"LGjo50SJc4QhupCpaKJDEmDBUGlMxZMhQIaJAeqWGgo3eBIBzll4R6Zr99nncpZzoljtY3ZoUmuXJ9oy",
+	"cd2n2mvPHGZpcsa5VCyeEf3lbc57f36RNaFLqJpxo6jaHJJ6uomqlBq1F8s7vb6Dw3e9kNrpu4vDopA3",
+	"E2Rrk1CoLqXOgna6eW370th1PzjqMxa5j1PtRMQNWdKcZFIplsU90gZCC9VKKjaB2gZJ4+YrPjfwSFhh",
+	"fLoghVwQWYIK1daUTFNQ31yVEBRlLxa55CZRYGkHVur6RHQ8cEq4fa2byQTltZ01i/zmX0Ifm4anTilq",
+	"Fz2xrk49cVJMu6SWDkO2cRdeJBybUK5tVEiLyHO+RrphKnXk58QoiO1zLXD0BgnhwaeKkRXX2oISaOmG",
+	"FwVmweHrmh+w4NeYRm2P7HyO8RzXHB13mxmRsAdIyhkLaaRiHnARJ5QkZqlktVhGpVYCnF5xoiqnVolH",
+	"+UlX6FuNoe4wxTOyktq4Z7EdqV5y7cr+MJPCKFkUTUW0lfMXznnlB7o+yzLzSsoryGz0CB/hqFNwK83H",
+	"PjVMOwahnkm18toOeymAoyuSh95dscK2AwA8gxjMO1vcr2M422WJisB8v5u57rbLnXUX1l5Xk8+m30Jn",
+	"glAjVzxLH7c/lxd/r+99inulUGF7uGxa2Az5QHyPBbdM5J5dNDNBkxWpz4jjEc49DTkR/BfF+Pa4ZM6o",
+	"6cwd3aFdvuMErEnWKwa2AEBIbUIXUylbLT8W0gLDkQurk0TnujagAy8c9GG+G2wwwtGBMuxOQHWiKgKA",
+	"D60GY2wT+toIDQjYdd8f1Rl/DwL+djuVN5hHn3P4RU1aCpuEhHw9HCFd1GWrJ/UlJvOZDfWn1t7KPfDy",
+	"jwDo97BuwDDIz3pfMOYUwnAm1PTc+6gDG0fPdRcrHo3uS+PiLCSjlS+qDmNXirkEcVb6V01zeEnN0t+q",
+	"0LyrEQcdptP0o3UOS6KPI3MsK2zF9JZGQZaTgl2zhuO5pWVdoRTKr5nvq0NnkjNWosdCW9GW8qiO8NjW",
+	"vri1TyKf3CHYTapjLGLtTpEdupakZmgtJvaY6KFHCSC65nlFG/jT+4ocTV0iHOUEqjrPh4l/Yg6d5ic7",
+	"wls/wJnvnxJlPCbeD+NDe7OgNOq2MaCdERaV7jv1Ih1gEadkDIYinC0PfhmWxGu+oUt6I/q1ml2Sr19i",
+	"A/eJSxEh9us1y1CqcU8hlrvHUI/lxJkwkdoFY7l9MECXhDZ/yQQRsn4RoUrTv2LqpNT+BzsxNuLCPbQP",
+	"8DGp4yDuvrMEByO6lTQ2uRM1Wd9Nx/9JTuLWg9g7XopGNHMpCbaoxjx1u2cHNpBVkRMB+wmyP5Zbd7eY",
+	"4+JjMqv8QKDIsPXg4yfqS+btuVLEJia7Ip9tFRXJFt32ButqQXgU6QYWcanwHyEN+WdFCz7fIJ+x4Ptu",
+	"RC8pkJAzIFsvIBc/AhNvF6/GHjCviJF+KrtuPnTMaLgNjBIBDRe5r4ApyYpesXgb0MHJ8s/MAOPU1QyV",
+	"GnBlt7aziwW3eJ9mbkXzWAmACbM3De7gHa6h919rd594Kp/HtixoxvJGHc8mnwFhKBCXWbLV9nQNXb7m",
+	"ScC3iohW+XQ/+QHa1D1ZVyp2sa/gYAPs6BnRrDd4nGUMVAq36sZtSXQxaCnH3oXjxKJ3lhRXTd+1uLiI",
+	"/P3sTjLTfd8yhoD/B9qVhntFJ0LXFwvtXw82uY9daCQUS8Bq1eAzuZ4oNte7HGmwNQBfA6yD7paLTDGq",
+	"rd/R+Wv3bK0TuXMBz2jrdR7MqmGUnM25qFktF2VlEq8gzOcuNhHCYmsCorXHNtcnY4Aoek2L19dMKZ73",
+	"bRycHjmP084DJN6C4vomFCDhRu4OwHX9AsS8ELV+Pm4G1791hrO+39pQkVOVx825IBlThnIwn2/04aaq",
+	"YHXYZayikSzUzHoUma2QtC0gxcZZm+9oSAoA0iNalAZYgi6XLGkFsoohI3sMP10Y/hSWoBVdg/EQsxf0",
+	"HAiXrx9Nh9gMXTwzKqx0N2zdfh6oO7d9Gqyk5BiRkTjrkCm2n/vXuJX4CP1JcLP15FsNZzudhPXUtwfT",
+	"I1Us6vAiSyzd81hm6cnKZhYQL6r6dEue9li0iUmX/o5WvWcX0b/CpY+JVejDCwA3XTgSN4zTK0xQ36C3",
+	"BBDVLsaIa+0UUR2Pt7aiwiJl7LK07Kmns9p9fy/1gAeIZtqd9ea0wUEHxtmnavL2vCyTUpaTbIhvqy22",
+	"llsAPKRNGHvoIzIh9Kw7+N3oUH4wpsZmHcJ9C0f31kHcZSsrs20qgz4lUw9Hbxow5Bx5GR5hq1qTKlbF",
+	"jP3j3Bu7m0q0wCQIJYpllUIl8w3d7C6m21NF4+K7s+dPnv769PnnBBqQnC+YrmuztIrR1q6JXLS1Rvfr",
+	"jNhZnklvgs96hJ+D9dKHbYZNcWfNcltdJ1XvlOLdRzuduAASxzFR4fOgvcJx6qCUP9Z2pRZ59B1LoeDj",
+	"7xn4f6RrYwW5KmF+Se1WZICBF0jJlObaMGFa9lNuaqdsvUTlIlY/uLY57qTImNc+OyrgpseXK7WQPp9e",
+	"5GfwiTibE2QgKByvsnaibety7zSr30OhEd1tQAcmSyfa8zlJQYQxPqpiQa/u1KaoT4/cdAOztQ67KUJ0",
+	"zu9p0gOPD3wJyznZzu1rM6Nn1AlOD5uYEC/8oTyANPusG/35kg7hJLVh4A/DPxIJoI7GNcJyPwavSL4P",
+	"tmQ1OOt4TYTkR4NA6yb6SZAHAtATz98Iuo5CHKMaC8raGNAa4c3PbfHjh9osvTMyBSHxHXaAF8fi1+1C",
+	"MIUD5xMXKPghICVayvs+Smgsf1d4v2e94SKJtsgpTYxh2rIl2RULo4QO+quQJ6HnVdJJp6CkNARepkWR",
+	"SMNg9Th4pmLC4cIwdU2L++ca33ClzRnig+Vv+wO34rD7GMkWlfroiYVf0UFgFfR+oRJvMDfE33uCg88E",
+	"cbM4w3/nDkSVEC2st/c8WMCZ8PHD6Nj15HMyc2XLSsUyrtsOBTdepAnRzkyBRQ6ngIS/rcjrO5c7+1ma",
+	"OxyHufcHIj9GRrbgOeBgro/6J2ZOPRwgeVpSpNohlAT+UrwOErwOq3N11xJXh6WkixLQ7pmSLl4ZJgge",
+	"vDxcB15elWbddQ6+9Ru4TVz49dqG5lwcXCkLyhPOhiRGTFe1gu6Yq/Eo5a3uXtzqXhI1WlS6MRwkScKq",
+	"Re5d2Zda/pJRlozmLoK4n94JDAiA8CQ5t4+CeSXseKGQM8aKe7Yu5+PgxSAFdHtB3onH4C3h3xbuz6fP",
+	"Px+NR0xUK1h8/X00Hrmv71MvtXydjCutE0F1fERdVZQHmpR0MySYfWfqpyR+60xX9y/SaMNn6Tfdd7Bn",
+	"+HB1AQjnAlk9shd7g7r8T/+TwGorMbQOazgxliTr9FZhK3Zluvq5L+WjLWHRU7WoxX2hwNFOW3xcUOp2",
+	"PHIZH7HK0q+u5ub9bruHoCd3qFv6XdLYWcQk1tqYPJoqSko4oLCU65ao9AOHEVTw3GwuAP9e7c5/vUol",
+	"M/s2pBdzOeuCBd7JvkZeMeF9zOpkZJX20vW3khYofVrHAMGIkbKYkq9tpSN3Lf7twezf2Wd/eZaffvbk",
+	"32d/OX1+mrFnz784PaVfPKNPvvjsCXv6l+fPTtmT+edfzJ7mT589nT17+uzz519knz17Mnv2+Rf//mA0",
+	"HnEA2QLqK5i9GP3vyVmxkJOzN+eTSwC2xgktOWRwu71FDdscE2wiUjO8YtmK8mL0wv/0v/xFOc3kqh7e",
+	"/zpydW1HS2NK/eLk5ObmZhp3OVlgDpSJkVW2PPHz3I5bGD97cx7igqzvH+5obXOajmpSOMNvb7++uCRn",
+	"b86nNcGMXoxOp6fTJzC+LJmgJR+9GH2GP+HpWeK+n2A1gBPtioqd1KGjSWv/WwyT8U96BW7TD0MQ4L8F",
+	"fw/9yMcSzl0WVQgSA+jCKs5zJC7jQrfGI6uc0ZYcn56e+r1w75pIvDyBweA3yz9Sab1vxwkpwQGchKyu",
+	"nt9d9E/iSsgbQTB1uT1A1WpF1cauoIGNaHDcJrrQaJpT/Bozi0LvNs7BXDPfhnKsDtw85b4zEkio80WF",
+	"L//lCrLpFMq7ZeTuiP2tqew7kyV2Bxu9AZh9WiwPj78JHc7Q08QiLJwR3JEuosejskqg82sM5tPbcDaO",
+	"So9ZaGSRB4x3MPqm+m+CUSDdRUhjDn8tGS3M0v2xAkLN/CfFaL5x/9c3dLFgaurWCT9dPz3xOoeTDy6f",
+	"1O22bycRwuDn+q8Jz3f09H6Uu5qcfMB/dw4Ym0VOnH971GEgoNuanczkeo+mLF5d/1KQ5vXJB9TN9f5+",
+	"4uT09EdUn9ob9sQ/Pnpa2hxC6Y8uLaKqU/ql2zVQ/cGseX67Y1poE82bgRNOVZ58wP8ged9arlCwVBpD",
+	"W8CQkrr5mHAD2emU0fZX4Bo2NBt9SeqWHdZwBr2+shDgreudF0cvfunGpuJAxI+Eogzc07Wk0ZipFibR",
+	"WBsxjyAqN9rXAvMvp5Mv3n94Mn5yevsvIBC7P59/djswsuerMC65CNLuwIbv78gZO7rdepF2kwKj6z5G",
+	"HC30xx66rWoNRAIydtTPbw2fSD8OXZ4d8S5oVlNJ3ANf0pz47C4495P7m/tc2PgVEGit4H07Hj2/z9Wf",
+	"C5fy0oluBwp5Z/bwx0yBuM1OCXnjkZAiyvssFlYckdoM5jfa0AP4zQX0+h9+02jY8SHAGGFrlXG1TyNV",
+	"jL1MQuFv5jPke40hza+pyHygaB25hfuFHTxhBPf+SrN5VfjsSWXhFFrwCPYT6aosgePMqQ6U5cLF4GFt",
+	"k7+EoUklMimsYyZG5nn3Ekzigi4q+oqXjS58DlSFueJ8lOjUb/o/K6Y29a6vuBiNu2+rYalb+r99TMZv",
+	"sX8Ext8c6MiM/+mezPfPv+L/3lfds9O/3B8EbuUEakjLyvxZr9oLe+/d6ap1kr+tRXhi1uIEQ05OPjQe",
+	"Q+5z5zHU/L3uHrfAElr+4SHnc83Mjs8nH+y/0URQB0nxFROGFvWv9r45gRuh2HR/3ogs+WN3HY3CEz0/",
+	"n3h9beoN3mz5ofFn812pl5XJ5Q3M0iPl4KVLC7Kigi5sWpKg4oTb0w1Q18Qgr8twvblsBIRiSXNZmVoH",
+	"TYwMXqq1bxHeg8HDdMEFToDuHjiLzdFOo2tfM7hRdVdDeeEg+1HmrCtRpa5PB2PjCg1H4TQRifP+OLrP",
+	"iPHe7ndQ0C3FemJ1yQg+Vrr998kN5QbkLlckATHa7WwYLU5cSevWr3WdyM4XLH4Z/RjnWUn+ekKb56Lx",
+	"Dbesr2NHeZP66vQOPY18gJ//XJuGYlMLkkswsvzyHnZdM3XtKam2HLw4OcF48aXU5gTl16ZVIf74Pmz0",
+	"B09+fsPh23oiFV9wKEPiVHB1GbfR0+np6Pb/DwDqizhKvCYBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
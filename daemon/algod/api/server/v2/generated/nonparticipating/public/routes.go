@@ -55,6 +55,9 @@ type ServerInterface interface {
 	// Get all of the logs from outer and inner app calls in the given round
 	// (GET /v2/blocks/{round}/logs)
 	GetBlockLogs(ctx echo.Context, round basics.Round) error
+	// Get the external weight of the proposer of the block on the given round.
+	// (GET /v2/blocks/{round}/proposer-weight)
+	GetBlockProposerWeight(ctx echo.Context, round basics.Round) error
 	// Get a proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetTransactionProof(ctx echo.Context, round basics.Round, txid string, params GetTransactionProofParams) error
@@ -392,6 +395,24 @@ func (w *ServerInterfaceWrapper) GetBlockLogs(ctx echo.Context) error {
 	return err
 }
 
+// GetBlockProposerWeight converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockProposerWeight(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round basics.Round
+
+	err = runtime.BindStyledParameterWithOptions("simple", "round", ctx.Param("round"), &round, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBlockProposerWeight(ctx, round)
+	return err
+}
+
 // GetTransactionProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTransactionProof(ctx echo.Context) error {
 	var err error
@@ -732,6 +753,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/blocks/:round/hash", wrapper.GetBlockHash, m...)
 	router.GET(baseURL+"/v2/blocks/:round/lightheader/proof", wrapper.GetLightBlockHeaderProof, m...)
 	router.GET(baseURL+"/v2/blocks/:round/logs", wrapper.GetBlockLogs, m...)
+	router.GET(baseURL+"/v2/blocks/:round/proposer-weight", wrapper.GetBlockProposerWeight, m...)
 	router.GET(baseURL+"/v2/blocks/:round/transactions/:txid/proof", wrapper.GetTransactionProof, m...)
 	router.GET(baseURL+"/v2/blocks/:round/txids", wrapper.GetBlockTxids, m...)
 	router.GET(baseURL+"/v2/deltas/txn/group/:id", wrapper.GetLedgerStateDeltaForTransactionGroup, m...)
@@ -754,307 +776,326 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3fbtrIo/lWwdM9aeRxRdtKkZ9e/1XV+zqv1aV4rdrvv3nVuC5GQhG0K4AZAWWqu",
-	"v/tdGDwIkqBEybKTtP4rsYjHYDAYDOb5aZDyecEZYUoOjj4NCizwnCgi4C+cZYJI+G9GZCpooShng6PB",
-	"MUM4TXnJFCrKcU5TdEFWo8FwQPXXAqvZYDhgeE4GR36Q4UCQf5dUkGxwpERJhgOZzsgcm2mVIkL3/fU4",
-	"+edh8t3HT0//djUYDtSq0GNIJSibDoaDZTLlif1xjCVN5ejYjn+16SsuipymWC8hoVl8UVUTRDPCFJ1Q",
-	"IroWVh9v3frmlNF5OR8cHfolUabIlIiONRXFCcvIsmtRwWcsJVGd69Efe6zEjbHXNehB166i1iDFKp0V",
-	"nDIVWQmCr8h8ji4h6L5uERMu5lg12wfkB7T3aPjo8Op/eVJ8NHz6TZwYcT7lArMs8eM+9+OiU9PuaouG",
-	"7msTAc85m9BpKYhElzOiZkQgNSNIEFlwJgni43+RVCEq0f+cvnuLuEBviJR4St7j9AIRlvKMZCN0MkGM",
-	"K1QIvqAZyYYoIxNc5koixaGnp49/l0SsKuxauEJMEqZp4dfBvyRng+FgLqcFTi8GH5touroaDnI6p5FV",
-	"vcFLTVGIlfMxEYhP9IIcOIKoUrAugMyIITxrSbKkTH37pEmH1a9zvGyDdyZKlmJFsgBAJTCTONUtAMqM",
-	"yiLHK0DtHC+/PxxawCXCeY4KwjLKpkgtmexaip57bwthZBlB9NmMIP0FFXhKAjyP0M+SACXBV8UvCPPU",
-	"gcYr+FQIsqC8lL5Txzpg6shCAjoQvGQxRoXgg0VzB48yfffJoD7AiFfrv0k6tZ+aUJ/S6dmqIGhCc31f",
-	"on+VUnkCLiVs+4wgWZBU894M6WE08iWdMqxKQY7O2UP9F0rQqcIswyLTv8zNT2/KXNFTOtU/5ean13xK",
-	"01M67dgBD2vsnEroNjf/6PHiR1Uto3fJa84vyiJcUBqeBU0rJy+6KMOM2U0acQZ57OUG2B871tny5EUX",
-	"S13fQy39RnYA2Ym7AuuGF2QliIYWpxP4ZzkB0sIT8cfAiBe6tyomMdRq8rfsGgSqYyM/HVdCxAf7WX9N",
-	"OVPEXIWBmHEAzPboUyg5CV4QoagZFBdFkvMU54lUWMFI/yHIZHA0+F8HlaB3YLrLg2Dy17rXKXTSl7Eg",
-	"mvEluCi2GOO9Fh5B1Oo46JoPmaM+4QJdzmg6Q2pGJaLMbCLIXZrT5GSBmRoNtjrJVyF3+NUCUW2FuSTN",
-	"VjQYUOdeINNwTCTQvhV678mapAgYR4BxhFmGpjkf+x/uHxdFhVz4flwUBlVDRCeIULjPyZJKJR8AZnB1",
-	"yMJ5Tl6M0A/h2Jc0zxFn+QqNib13SKbHNHzb8nErgGvEwhqqEe9JBDvNxUjvmkODlsv2QYwgVc54rq/A",
-	"jWSkG/9o24YUqH/v1fmrp74Q7d10BxK9RSpQk/mlerih+w2iatMU9NDUdNzsuxtF6VHW0JI8qRC8b7qC",
-	"X6gic7mRSAKIAkKz24OFwCsnQSUgCbUp6GdJDPEUeEoZQDvUAjlDc3xh9oMD3jUhEOklbUNmRry6pGpW",
-	"iVwe9aPW++LrJuTYniO94Zhq2RjlVCotDMFmSjQjOQic2CsWQiraiWh60MKaRXiYLwUuDJnbL0aOowxh",
-	"//4ysF7zJu95yUZhDtUWFd4Bqp2Z+UaGG4XEKBzqMDzLeXrxI5azPRz+sRurfSxgGjQjOCMCzbCcRc5U",
-	"g7ar0frQt24INIvGwVQjv8TXfCr3sMScb8PViuI5znM9dZubNVYLA/c6yHmOdGNE5lTpBzBlcAKmdEGY",
-	"YT0j9BKnMy1MoBTn+bDSS/AiycmC5IgLRBkjYojUDKvq8MPI7qEE50gSzQcVQcFqrE5jhM5mRJAJF/BQ",
-	"FQTNMVxOc/08KvJ6H89cJZ6ThuwElyUvlYYxeLmcvHCrIwvCgCf5oQF8v0Z48IeDj/Tc9hPMzLhZHBYE",
-	"FC2UpXmZVfjz/KIGtG5dXbWsmoKLDBQ9WOnfqEApF2YIc/nbyfV/CBZVZ0Od9wtBEjuEwAsiJM716hqL",
-	"euDJd1+nc8PJzLDCwcm0VBh/0RnOAf1AKCQiot14B//BOdKftYCjKamiHgpyCsg0fj/gztaoMjPpBppv",
-	"KY7mRm+GCpxebAXl82ryOJvpdfJeGlWd3UK7CL9DZ0uayX1tEwzWtVf1E2J0Po4dtcSUtUwnmKsPAs54",
-	"gQz7aIBgOAWMZhDCl3u/1p7xZQymZ3zZutL4kuxlJ/Q4vZn9M758YSHjYjPmYew+SNcLZHhOJNxuNTOI",
-	"nqVSVR+PudhNmmiZJioFPMJ61ECYGjaQBE3LIrFnM6IeNw0aAyGvXlovBDSHj2GshoVThW8AC1KPug8s",
-	"1AfaNxb4vKA52QPpz6JC3BhL8s1jdPrj8dNHj397/PRbTZKF4FOB52i8UkSi+1bPh6Ra5eRB9OEE0kV8",
-	"9G+fOINIfdzYOJKXIiVzXLSHMoYW8zA2zZBu18ZaHc2wag9gL45I9NVm0I4+mH5Xw8ELMi6np0Qp/Qh+",
-	"L/hk79ywNUMMOmj0vhBasJB1o5SVlg4y3eSALJXABwW0JCwzpje9Dir1G3A+3gtRdW18Vs2SIYvRjGw8",
-	"FNtuUzXNKtwqsRLlPjQfRAguoldwIbjiKc8TLedRHtFdvLctkG3htqto/m6gRZdYIj03GMBKlnWoKNSS",
-	"9b+/zNBnS1bhZu0NZtYbWZ2dt8++1JFfvUIKIhK1ZAios6Y5mQg+Rxhl0BFkjR+IMvIXnZNThefFu8lk",
-	"PzpSDgNFVDx0TqSeCZkWWvqRJOUskxu1Oc4a2ECmnaoPzprYcrYs1Q2VRdPpiqWgRtrHWe7WfllTH5Ir",
-	"lgaqMA1jTrJpjVZvVOXVhSkDxT0ZgVRj6jV8BovAC5Ir/IqLs0rc/UHwstg7O2/O2Xc52C7G2hwy3ddp",
-	"lCmb5qQmqU817KPYGj/Lgp57pYNZA0APxPqaTmcqeF++F/wG7tDoLDFA4YNRLuW6T1vF9JZnmvmoUu5B",
-	"9KwGqziiptuQD+IxLxXCiPGMwOaXMi6Udnjt6IOalkIQpkI5F/QZVKIx0dSV4lKvtiyQ4rH7peqY4NSc",
-	"0ARQIzvcHLyrhmllppvhBUE4FwRnKzQmhCE+1ouuvBxgkViiQsvOVqyzInFfflsDthA8JVKSLLH67I3w",
-	"unbm/lFrkAergVX4WZDkaILFzazgYrER+AuyShY4L7V4/tMv8sGXsgjFFc43bAG0iW1EU33XXso1YFpH",
-	"xE2IQlI22kJzErSIrZlOThTpQvb1sde5/U0wW0RwQwhcEAEeNTd6tNwkN0CUHv4bPlg3soSySLQY2Kl+",
-	"0JKr3m+GGXey4YYZ/AQ5lirZdKXoRjW9iV5qwMVjtwgM3CFPvsZSgRiIKMtAf2uuQpjHyJZ6isGWTmUw",
-	"ZedrTE/6i3uItadN9fXOZCn9q0yWRcGFIllseWCz7pzrLVn6ufgkGNs//RRHpSSbRu5CYDC+xaNVBMAf",
-	"WHkLtbV5txcHXgdafFlti+UafBWO1sF46loFiA+dajtgpLLaA0NuVDbobcx5TjCoTKXiRaE5lEpK5vt1",
-	"YfDUtD5WP1dt2yRpzEBGUsk4kWBisu0t5JcG6RJsXTMskYXD+SeAwsu4yLVh1sc6kZSlJFl3XuARrFuF",
-	"B2en414WU4EzkmQkx6uIt4X5jMznLQnDjQ0EUukPuCLJGKyJcRqpzoTzN91tVg5TyZjgjeALSvU518+o",
-	"itRs790nzQhMG+Oblljv+VkAjCgduPEAWYaeIiPC3b/gSpOVJTpYjb2VrrmWDuz5WW8EgTBuUikCmrP/",
-	"g0g7txfA9jr/isiuhVdT72vZHep/uNtrF2bjKmvcNtEropMvb2CMXTyowxbxHgtFU1rAc/Unstr76705",
-	"QdRXAmVEYZqTDAUfzEu+CPsj44bcHHO313wvdWsb/Ja+NbIc55lVB/6CrEBt8t5ENATaqn2oIyKj6gsX",
-	"MwSAOq95/eIJm5AlTlW+0oKtmpEVuiSCIFmOjddK24SmeJGEA8RjprpntAb5qDl8rYfAKQwVLC/meWhe",
-	"W+vhO2s8uWrosK+sgvM8ov9snvgWMqIQ9HIXQgXXu05xnq+Q8mEzjpJqQNoLArwxvDxzT9bQDCtA/+Al",
-	"SjGDF26piBfSuADJB4RlPYMWN/2c1lW1whDJyZyY1zx8efiwufCHD+2eU4km5NK43DBo2ETHw4eginvP",
-	"paodrj1ou/VxO4lcOmCr1JesfbU1ecpmJzc7cp+dfN8Y3Bs49ZmS0hKuXv61GUDjZC77rD2kkX4OfjBu",
-	"L/Nd3SWstW7Y91M6L3Os9mGoJAucJ3xBhKAZ2cjJ7cSUs5cLnL/z3a6GA7IkqabRlCQpRAn2HIuc6T4m",
-	"sFCPQxnVB9gEjvQFiJyYXqem04aXduW3TOdzklGsSL5ChSApMVFyWkqVfqkjZEIm0hlmU3gBCV5Orauz",
-	"GQcYfimNJkyUrDXEtqKYWrIETBgyGqYGZksXbamFMIL1y7Zp/zCPtUvsQTGXUa9LO9iepj0oajIdDjof",
-	"/hrfi+rhb/BWDxnd1ZhYkw8DpFXQ9LSeAT61rNRGYriN+vBpYrgZK001dAzK9sSBU3j1scsv/LQsiny1",
-	"ByHJDIQEKQSRcKWFakBpvvIJekNTwY/zKfd3nlxJReZt443p+lvHcf2wywuYs5wyksw5I5En/Tv4+gY+",
-	"9lY7mmu4Y0QQiLYasPnwqSGhsYD65H1I+rqbBCTTPPtNS6d8xcW+rOxmwN5vih6W641uHXbKXe3rOM8j",
-	"JmmjfmhxETn0TuFUICwlTykIiieZHFrvc2PFNm7tDfS/96FRezjAzXEbttcgDMso8kleIIzSnIKanzOp",
-	"RJmqc4ZB0xcsNeIs6JQD3Wrh565JXA8dURPboc4ZBkdRr/+LOgZNSEQP9YoQpx2W5XRKpGo8sCaEnDPb",
-	"ijJUMqpgrrk+Lok5LwUR4LE3Mi3neIUmmiYUR38QwdG4VPUnx7yUCklF89wagvU0iE/OGVYoJ1gq9Iay",
-	"syUM5/xI3JFlRF1yceGxMOrPuKaEEUllEvd0/MF8haASi5OZDTCBWAvz2Xk8V7khBnrttaQV/+f+fx/9",
-	"epz8Eyd/HCbf/efBx09Prh48bP34+Or77/9v/advrr5/8N//Eds+B3ssGNxCfvLCvtFPXsBDLIgTacL+",
-	"JRhk5pQlUaIMHYoatIjuQ74MS3AP6no/NSPnTC2ZJrwFzmmmedHeyKd5TbUOtDliDSqrbVxDjecQsOVz",
-	"6BqsCkU4VYO/3og815xgrcNNuOWNGAPLGeXeAbQDx+Bqzhlzq733w8szdGAJQd4DYrFDB6kFIi8YG8FY",
-	"8/LRuxQGdp2zc/aCTOA9yNnROcuwwgfmNB2UkohnOMcsJaMpR0cuKPIFVvicta6hzgRSQVBzkEEqxinw",
-	"PL6W8/NfcT7l5+cfW34IbdnKThVyUXvO2moyN2Wi5QZeqsQmcUkEucQiZgtxKT5sNDT0XguHkUl4aZRY",
-	"LkmMHX/UF8qikM1kD20UFUWuURSQqrT5CvS2Iqm4DxzTzNzG3moaeMutU4nAl+7JW0oi0e9zXPxKmfqI",
-	"kvPy8PAbCMGrUhz8bnmgpttVQXo/fDuTUTTfu7BwI5eDU3lS4GnMZnJ+/qsiuAAKAYFjDi/NPEfQrRYe",
-	"6CIBYKhqAT4WeYstMZBtHdcLyz01vVxar/ii4BNsaj12+lo7GETF77yBGyLrcalmieYI0VVJfQzcXrkE",
-	"A3iqrxznQSDpFB4AcsZLvWSC0hlJL2xmKzIv1GpY6+4cXexd7BgOlaAzssGBE6rxl2KmByyLDFtBBrNV",
-	"M8WNNMEQMOgHckFWZ9x0H/XMDhZkowtSrMiuowu0G9y1mnzDg2zHaG6+9btyMaI2HQnEXTqyOPJ04fp0",
-	"H20jAOzhWMeIopbnowsRWEQQYYi/AwU7LFSPdy3Sjy2PspQwRRckITmd0nEeYdN/b9s1HKyaKgVJCV24",
-	"qF4/oER0gvTraGyuY/tiEphNib7U9UXMJc7BaX8UNfSDdDgjWKgxwWqtvpaFaSYcdCCQX0LQNChNhnoJ",
-	"ZKn3mypQgjByqR948PY2bawj8WgndyqzJpLtCKrrXgVJj3Z5RFiER/LZufve74l/L1j/tJA6AWTzfa5x",
-	"OBX8Uu+mBpC71I2Q4CW4p0qJp6TvdVQzFfVMiVGzAMEgm6SfqLzDJ02xpiVj9FyE6Z5ovES5A9FfNHsA",
-	"M0DDxdHNbUyI1qrwjuUrh9RxDgK1dxA1pINFzc7GptsBG2djRLBKWHWA1bEWHv0Zlu7oZ8OAo+8oLX6e",
-	"VDLr8uedBN53WLWz47lrusnah0afMyaIM93DZdFzqfNcvrzBcKvcd8OBDXGI7R1nIEVnJCdTgxPT2NFZ",
-	"lZ+p2k0Nx7vJBJheEnPkC5SRgWRi5yD6IfYQIaMxR71HiJ2CAGywrMPA6C0PDzubbgMks/mlsBsb7q7g",
-	"bxIPFjTe+FpK5oW+9WmH1Sp1LMWmt6hEnoaLMwyDKBsizUkXONec1AaeVoO0crXB26eRmc36djzoehP1",
-	"PGh2jSCdbLVKI8/ssr5Q8HbLiL8KtlrDmC8TExkdfVqNl2N9JqLxChCnHTu8JnPePYnGfAk+RXDDGQf3",
-	"raHrhswBFriBLKkEKod+XWKjAW87QNYL8jFqlkB6Vq/mya5Lkt0NmA5xuovs7gcp9PYEUkOBWaUBtxqd",
-	"jXqWurTVlkSq63bos8P6MLUYq+k6nNGd7MBoW3laz3X3Y5XusDs5mjurt5Lkr62Uu05eRtO5MLkWt0nL",
-	"2CSHGhBrsPq+KcRG0Vp3XKrjNcBajCVpRt82drXRJklOQBOQ1OTq5CJmlj4//1USkBlOXbdAzwm7h9nq",
-	"QeANJ8iUSkUq44Jzcrl92w+oE/Vji0+6V6cKMdHr+8C5FzSMORY61pZ56ysA1/UJFVIlYJmJLkE3eiVB",
-	"k/ZKN40LwnV/OyqNqWdrORgguiCrJKN5GSdlC9JPLzREb/3NJcsxXJSUGW+jMaTCjzrobmGbBHiMY/da",
-	"BL02CHqNbwM//Q6WbqphEpry6tN/JUeswQvXcZYILceIqb2hnShdw2uDWPo2ow2E6MDtYrTO5tM6l5kb",
-	"e6M3lovo7xIizEjRtQQZEeMBhHw6JZnL9GaDQk3WK5tPL+dsWuUS1L+vSR84QiaLHyThW5O/z7qnky7n",
-	"9Fo5EaiKEYU+fMwA5FV0HeQehEmmhJnMLYPt643kUcSFjvHQItCM3i5vb7nNR12HzxruwpVPr9lDv9mw",
-	"PTnBmX1WSeLWt/7QtrfLom7Y5XRcSxG7/oDBgEBxVMlAgGkRTQfnxkVBs2XD8GdGHe1AEj3FvXYm+AbO",
-	"6Ea01P2JN5TouacvRWhvbRwH8Lo/0G9L48ZsHXH1kcCpTTKQlQKMSDUn4XYaff++7Lnkn345VVzgKbGG",
-	"wMSAdK0hYDnboCHIRC+RosYvOqOTCQkNYHIX400NuJaZI+tBzx2U17aS+SflWrLcmraqFWxGaJyeIpTS",
-	"5Wpx1jZDuvdGoFLzd0ywcTvYEqN5BH4iq+QXnJf6AUSFrFxSrV2wfptvQROL+U9kBSNv9PTUgG3YFdDA",
-	"fSBAoTGjiv8kg+Tg92St6AI8fWtbuMVOHcd3aU9bYytodB+N6mKqlZGoL+Xmjk3lGaMh7bNXp3FnE322",
-	"SH1bmoS+aYtotlnkCV4e4VQUnDZ2udt8go2NTmUE547wYbGDq+Hgem4ebRbmR9ywE+/9jRzdBXDCNGb/",
-	"mq/XlhuCi0LwBc4T6x7TJWsIvrCyBjR33jS3/KyKn4qzl8ev31vwr4aDNCdYJF7D0bkqaFd8NasylTfW",
-	"X0MmC7tV6RoNWLD5PlN26EBzCRnXG0q0Vombyl0qOKjWoWYSdxDfyDetZ5dZ4hoPL1J4B6/KEG38u+o+",
-	"XXiBae7svQ7avsp1s9x+RZWifCIc4Nq+YYHT37XHkvQPkoBnKe/wy5Iev/ZmtJ6oVOMSYuAMtg2Wm7Tx",
-	"5tmH7Te/M2bh/PzXhQOnsu0Ypy2fnj/i1yd39LpuMcA4A6kO4Aa2Dch/B1lV429AZnOuAre2zm9478Lp",
-	"Ky5qt6eNsIw6z92c1KpfOAaPcQeBM+sR0JJVR8jItb9Pf9cM6+HDkOIePhyi33P7IQAQfh/b3+Fx9/Bh",
-	"1EgdVTtqPgpaRYbn5IGP0ejciNtViTBy2U+GOV7MveDOu8nQU6jxgnPovrTYuxTU4jOzv2QkJ/qnUR+1",
-	"SbjpBt0hMH1O0GlXhKR3xJ6b0qIScdbMBwARu5q04D601USMzb99hFg5Bxt4InOaxh2Q2Bg4JDPuxbox",
-	"gsa97dl6jpJ2+Lizkgaj62ZyJ/NrYyHBrFGEy2hW4gq/Y25ZQMnov0sSlBiGK6AhMbj3GYzakvrjuk47",
-	"cLOC8WCX4sPXN1caIDtR1Wn1feEtkW79sVJXW4ZchDO2eP6acAlLSO7WhNi6mfVe3khQa9+c6+tQW0u0",
-	"45rW6Nv9WLMVOc0evuizwVQmE8H/IHGRAeyUkewhzsBOwQbwB2ExN9km//LOC1XN7Gr2TQTSX8/RRSrX",
-	"1mu4RfvCfbvc3HH2sN1Gb6nACPa7W4Uh4xnO7SZ0PZpD35d6LE8HD4MDG3imQzkh53GHmTmhJrVGLfgt",
-	"fs7DWNUDM351zi3MrfjeHF+OcazWkn67apiC7a/5BiqOXGe3QdJnhzCzoyCcwrelJt9gQURlwGpna97x",
-	"HWqm7f0CrR6cQHHhU3No3GVyySPDlOwSM3BlhH6GA9rekhhXEN3rkgvIMSrjbowZSek8qpg/P/81S9vO",
-	"ZxmdUlPVvJQE4YmyqSbtQKauvaEiW1Dcp0OxqDmZoMNhdWbdbmR0QeEhBi0emRZjLOFe9m4ZvoteHmFq",
-	"JqH54x7NZyXLBMnUTBrESo68rgAkTu+MOybqkhCGDqHdo+/QffBZlnRBHsQvGCujDY4efTdcV7wbMA51",
-	"6tcx+Qy4vIuliFM2OHabMTRbtaPGgyMmgpA/SPd9suZ8ma59The0tFfQ5tM1xwxrhMRgmm+AyfSF/QVv",
-	"kgZemLEUEakEXyGq4vMThTXH6gho1wzRgIFSPp9TNbfOqpLPNYVVldDNpG44KPHnKrE5uNxH8AIvIk/7",
-	"z/DKwvOOIEtw7H8LJv8QrUOETdLYnFYhIK5ILjpxybGhNJ2vSGdwo+fSSwcxFSJCJqgQlCnQYJVqkvxN",
-	"v9oFTjVDHHWBm4y/fRIp8VavgsS2A/zW8S6IJGIRR73oIHsn5di+6D7jLJlrjpI9qLJKBKey01097mLc",
-	"5fncMfS1pWs9btJJgGWNAHHAza9FimzNgNckTr+erSh065XdOq2WIk4wuNQ79POH11YSmXMRK7ZRMQAr",
-	"lQiiBCULCHGNb5Ie85p7IfJeu3Ad6D+vg50TSwPRzZ3u6GMhsHBH3mk+s5OW9H95U6XoB0O7CR1uKC25",
-	"iKhnraLxlj1jt1MTNu35xiMRvnVgrjfaYJQ2VjoiTkxIie/zOVzOmiCZPa9pSB/9joR+x4Os//AhAP3w",
-	"4dCKyr8/rn827P3hw/5eu3E1of41gprd7ppmAk3dN7bVz3hEaecKiXrXNZstJaJYjd5l+kod2zGGqF6t",
-	"8fbljv2ETG7tCR0/QA418LmJm8/MX2EzqyCcbv5QL2AbJZ/Mfw/CODB6xpd9iahxbTl6+gJQ1IGSnlpB",
-	"WEmrQG/Ua2Ojy1FAtnrUMcm5fqmGNbh6e9B8RbugUTNcsxclzbNfKuNz42YSmKWzqF/7WHf8zTwDggaB",
-	"BiOdYcZIHu1tXsu/uVd15N3/L94x7Jyy+KdmLWgDewPSCqw6EG5KN77GFVW5niBEUT0nmM+ykk95hmCe",
-	"qnhKxRrbRdVjxWwjaQZg2HmprGM05G+wNU0mNAeX3rgZHFomAqsOriog+ndSjUgWWk4xagkzOhEI0zlc",
-	"2xLPi5zAIVwQgafQlTPS6A5J42DkoDIKkoX+BC0h/wxHqhQM8ckkWAZhigqSr4aowFKaQQ71ssgS5h4c",
-	"PTo8POxnWwR89Vi7watb+LtqcY8OoIn5YouPmZoNW4G/C/RXFdVts/lt4rIVYP9dEqliLBY+mJhwMAzr",
-	"e91Uf/WVikfoB0iRpgm9VqUAlKIuyXM9LWlZ5BxnQ8hLffby+DUys5o+ggDqoPrsFDSA9SMSNfL0T9Pq",
-	"UsB1pM/qP8767D161VIlvi5sLJmjblGVs6UNTyzQDYbYGaEXRi3r/XnMJAiym4s5yYIytEYNAMSh/6MU",
-	"Tmeg7xwN1qqUOwoS9a+i7DhgZS4KQm99zS7g4HoZtpCyqaM8RFzNiLikkkDqC7Ig9ZyRPuGqVci7HJL1",
-	"1YqSMUM4oy2kV1+ha9tdcMAZ0de5VUQha+zDtW1/VTIRqLO+bb3pU+gVDx1qFK9uuDuYqh1LV/djhN5Y",
-	"Y0eKGWc0hXoXMREcskH2M6v2KA0St3fKgT3LkWMYLZntY+QtFjuLaDuWaRHXdmoIvur9NoRj/lRkaesQ",
-	"TomSlgeSbOgq2FsDHWWS2Bpsmr5CjspFxOMrGqLjPUf26B4/HEBCtw5d6yv97a3VzUPamgvKQOdmkWpf",
-	"gsbAlksKdnaGqEJTTqRdbT00Tf6q+4zOlgxA+Dh6zac0PaVTGMN4IGqkGI/k9lDHzj/Z+gPrts91W1s+",
-	"wf9c86Qzk7p1f4yyEOn3P1b2vRP9MZcvFyEXINePH462hhjXhh3AvazJkCzA4Y8UcJ+3yMZX0K+P8lI/",
-	"WQ29QQtkgoejmYspi4DxmjJn8I2n4kqjdwlsDJzmjn4yFViZR0cvjndGcN4RmgNx/cZj4LpDNYtBaJTA",
-	"Gt0c3dtYFf/vYCu+QfW6wGyF3KHQ1B0IJc9x7h3zI6X8QTqzwpjxEW4U94+xFc3WExcdXEPXxlhU3x0K",
-	"smx7T3UlPB2X2ZSoBGdZLPXdM/iK4KsLbiRLkpa+DpkPda1njG9Tm50o5UyW8zVzuQbXnC6jEktJ5uM8",
-	"4nH7wn8kmd9hyIU1XsG/sSJc3TtjHfC3DkB33vbZdmUS2gH1MelZ03Qi6TTpjwm4U66Pjmrq3Qi96r9X",
-	"Snex519EaHmDy4V7FONvL/XFEWYKb7n2m6vFJ/IGN3oO311KMp9Mts6V4CprlZoDjwzYvMiWNYB3DaOA",
-	"L3DekfQhtNqY+9VYMrpSP6SdmU2wsgn0FEYVT+ijwuhOQWYcrxuWobZ5s8u12nhW36TxxOJjLdK7LY0/",
-	"1eyKxuutYiid9sTdTH4VEWxr87PVINr6UpznPO3NGewwx7pTd7ZgPp/b5PsRr7zFnGfhWQi9uQiJMzbj",
-	"sByJqICHbfQbPK2iX8RlfLSafsQTTd/EaYBGu4ShCRJ14DlgzNThRIHK1mIWvaI51Kf6n9N3bwfdGxns",
-	"QHtLbfbuqAq7a2N81FyTPKa8ho81PICzPK7/lh0qdUhPFT8NtkBy9MMroyDsA5JJ1bRN69d9B28RwJSb",
-	"wlSx0h3tBDmDajsc8gNqqLbXcJSQOmJU0Sz4FHn7GKVn1QT5Wqi9aqPWZKQ+9aVipYzsS8FpYM1FY1Pi",
-	"mfpOrdJQLQb6oo9w2MLH1XBwkm0lPsXKYQ3MKDEG+5pOZ+pZztOLHwnOiDAlTWLPSVPQZE70M1TOaAHv",
-	"n4JLWpUkzvVgNpf4DIYb9Y3IOZsRm5jGJSxojeUcqBckVVCiunIDFYT093Mo4kvUEDiDIjT5DK4ggpCM",
-	"FGq2Vlgyzt2FmlWVS4kNOKMSjYk1XSwIGyI6IqNmjFpW5aVCOcETp4QVnKsepX2dtsWgMQQ6Rl+tMtHr",
-	"xcBW2rkgq6Kp5jvqXwfm2McEmPjKSyyr5FWNlA69Q8cnE5JCzv21GQD/PiMsSAk3dKo7gGUSJASkPkoQ",
-	"qkbsVaNdwbouF99aUIOyWDcJaVdyjguyuidRjYaiRYl9YO0uSegBOcaO6+oadJk2rGMklZ6eAEHOD97W",
-	"AKjKPO1ShyBIkLkjGI7G9fVUJc3cDRon0ewAhu665aSdGflAMO1KMNgu8N79Un4B9fSldSrFPuN9qE9C",
-	"J+2K0Jc2Yz7kevTWQpc7n0j3m8sRa2bJ6YUtkgMIM7bZSywy12IvmfrMvUnjQE/8zLQKjGp7+Wzrl2Mi",
-	"FNOcawEo6QoMrUcqeRfee9L4WlcJ1ADqCRGCZN4mmHNJEsVdmNUW+Udt+OQa7Bkv853w1vDo3yJS2Kyo",
-	"s4zDh6qWBVSkxFC2AVvn8xArSJA51tCLoL5EXA26aYeem+8uv4mrMLhevdqFd38uNhfpdqF3VLYwH56u",
-	"CbLCwdbcq5YUZQfNLGWMiMQZcZvVJVg9Uyekds7K1Igq4dn02uveKdDWcLOoUjNtr7LxhAqScVyQ1YFR",
-	"+7jC527HQ6CNDGlAD3JaN4hir7pqGYN7uhfwPm8G0YLzPOmwDJ60S2I0D8MFTS8I5Ib1kSlaCr5XPzZ6",
-	"EnQfDFLeZ+RytnIFH4qCMJI9GCF0zEx0oHMfqRdBbUzO7ql18y9h1qw0RW6sBnp0zuJhVlBsRlyT+7lh",
-	"1vC8Lt4kieaX15zfDLLD7GrJunzkLqEqTb1U8aiveqPt39EQoQLyM1DEBKhTYwh+Diwh8o5CkJQlyB4E",
-	"/gEYWQMykjmPeeHvkjhGDxXHVDgZAKQI6/FcraCwg0cRYJ3sNmSItZ9dDlQ+QYJUvhm7JoO1+VUNE5dd",
-	"qpHmzH6WOmeccEHCGcHP1OSK9pFtkGoZ/jOmSmCx2iVlax1VMTVUJ5Y3ekt6R8lqIZWzZBuHec4vE2Br",
-	"iS/wFFMH6Hayfm27UqlVP33UxyRwu8TSiogrNMMZSrkQJA17xEO8DVRzLkiSc/DCjDl2TJR+JMwhrpOh",
-	"nE8RL1KeEVOLLU5BXXOVjGGQvUjgyhZFgaEdSBlg+gR03HNKffsa82wC8trGWh9u8890H5O+okrFZxad",
-	"GBeBjvgCIm0yOIsh07gNr0kbB4mYmkrZuIg8oUugGyJiR36ClCjJENkWRiAJSQgOPhYEzamUBhRPS5c0",
-	"zyF7BF0GDg3eHyiO2g7Z+QT8oBcUHN7qmUSMSF3o29GnXwl5wGmYiA2pmeDldBaUKPBwuqe7KO3DPhzl",
-	"Z1mCTyKEiOopnqA5l8o+i81I1ZIrF9D7KWdK8DyvK/KMnD+1Rt83eHmcpuo15xdjnF48gEc448qvNBu6",
-	"lApN391qJtHIB9nvpaCWLAHykJszvZt24NVq6bk372xwv5bhYZMmPwDz42bmutmucdxeWHNddT4bfwsd",
-	"M4QVn9M0fty+Lu/XTp/VGPeKJlg0hZBNFhpoBnwgvMe8OxNwzzaaCcPRSq7HyPII69YBnEj/F8T45rho",
-	"QiwP6rhD23zHClhJ2ikGNgAASE0iBFUKUz05FNI8w+FTkzgFnFKagPa8cMD373qw6RH2DpQi1wKq5Y3s",
-	"AbxvNBhDkwjTeDaP+dJ9f1BlytwJ+Kv1VF5jHl1OlacVaQnjVukSWXVwhHgxhLUeiGeQBGPc1w/RV8Pv",
-	"efkHAHR7JtZg6OWfuC0YE0xzkiWxQsknXgc2DJ7rNsYyGN2VlDScPMWlK0asxy4FsYmVjPQv6ubEAmtS",
-	"4r55WyPOMrIkJkbrDyK4KSU8DMxZJDeVhhsaBV4kOVmQmsOmzfZUghRKF8T1lb4zyggpwOLbVLTFPBHD",
-	"QoUN7YtdexL4svXBblQdYxBrdgpt0LVENUNLlphjIvseJQ3RgmYlruFPbity1HWJ+ihHUNV6PiTuidl3",
-	"mp/NCB/cAMeuf0yUcZj42I8Pbc2C4qhbx4A2eiaXsuvUs7hjcpjKzBuKYLbM27UNiVd8Qxb4knVrNdsk",
-	"X73Eeu4T5SxA7MslSUGqsU8hktnHUIflxOZAAmpnhGTmwaC7RLT5M8IQ40HZ5Uss/SumSubqfjATQyPK",
-	"7EN7Bxt95T98/Z1FMBiSjWSL8Sqpnqyvp+P/LCdx7UHsHC9GI5LYUN41qjFH3fbZAQ14mWeI6f3Usj+U",
-	"Kba3mOXiQzQu3UB5zi9NHeXwifqCOHuuoT5nYrJiOfXXsvOTHto8w00tCA0iROZ4hbiAf/SD9N8lzulk",
-	"BXzGgO+6ITnDmoSsAdl4UVi/az3xevFq6ABzihjupjLrpn3HDIZb6VECoPVF7irHcTTHFyTcBnAQMfwz",
-	"VZpxynIMSg19ZTe2s40Fu3iXnmmOs1AJAIlmVzXu4PKc697/XxW2Gk7l8j8WOU5d1Wxb/67OZ6CwviMu",
-	"NSPz9WHObb7mSMAX66+IVrg0GdkO2tQtWVcs5qerUFcN7FYV8laNsmsto6dSuFFvaU2AeK+l7HsX9hPD",
-	"2VpSWG140+LC4su3szvRDNFdy+gD/he0KzX3ilZkW7yIe7geU6/9FnahlognAqtRg4/5MhFkIjc50hg9",
-	"+JgvK4Cl191SlgqCpfE7Onlnn61VAmTK9DPaeO16s6ofJSMTyipWS1lRqsgrCPIgs1WAsNCaAGjtsM11",
-	"yRhaFF3g/N2CCEGzro3Tp8dUJw4LBjkLiu0bUYD4G7k9AJXVCxDiqSv9fNhMX/+m2KHxnZUKswyLLGxO",
-	"GUqJ0FIDusQrubupylsdNhmrcCAL1bOFBGYrIG0DSL6y1uZrGpI8gHiPFqUeliBw0o5YgYxiSPEOw08b",
-	"hq/CEjTHyyTnU4j67TgQNs81mA7NA5IzUKIb6a7fut08kv5B1k8DFUgsI1IcZu0zxfpz/w62Eh6hPzOq",
-	"1p58o+FshmEbT2dzMB1S2bQKzzDE0j6Psch5m5gpjJ53oqpLU+JojwSbGHWJbmnVO3YR/Cts2oVQhd6/",
-	"cGbdhSMWn2/0CgnoG+SaAAwiq7gCnFoPsbYirqWoMEgZ2uwGW+rpjHbf3Usd4IEiRdqzXp/WO+jocbap",
-	"Nro+n0FS8CJJ+/i2miJFmTUyWEjrMHbQR2BC6Fi397uRvmxXLSdarX7XtgVXO+uHbbKVFek6lUGXkqmD",
-	"o9cNGHwCvAyOsFGtQayVV8UM3ePcGbvrSjTPJBBGgqSlACXzJV5tLkLZkX3+9Mfjp48e//b46bdIN0AZ",
-	"nRJZ1TRoFHGsXBMpa2qNbtcZsbU8Fd8Ely3EIM5ZL13Ym98Ue9YMt5VVMuJWCctttNORCyAWnNuujLfT",
-	"XsE4VVjEl7VdsUXufcdiKLj5PRM8z+M1ZbxcFTG/xHYrMMDoF0hBhKRSaUZYt59SVTllyxkoFyFr+MLk",
-	"huIsJU77bKmAqg5frthCunx6gZ9BLgZrc0JkWeSWVxk70bp12Xea0e+B0AjuNmOCCl5Y0Z5OUAwiiNkS",
-	"JfF6das2BX164Kbrma1x2I0RonV+j5PeMbMvYT5B67l9vSy4inN6vYkR8cIdyh1Is8u60Z1nZBdOUhkG",
-	"vhj+EUmcsjeu4Zd7E7wi+j5YExV+3PKa8ElDeoHWTpARIQ8AoCMeuha0GgTZBbnJhbExgDXCmZ+b4seb",
-	"yiy9MTIFIHEdNoAXxjJX7XwwhQXnMyf2fuOREizlYxcl1Ja/KTzasV5/kQRbZJUmShFp2BJvi4VBQLx8",
-	"7uPMO14lrXB0wblC+mWa55EwdqPHgTMVEo5+EogFzm+fa7yiQqpjwAfJPnQHboVhyyGSDSrl3hNyvsa9",
-	"wApClG8FKvYeYuv/TvTORm9HO4s1/LfuQFAJ4dx4e0+8BZwwdAljGseuR9+isS33UwiSUtl0KLh0Io2P",
-	"tyWCTqx/LVmqZuzvtcsE/cLVNY7DxPkDobeBkc17DliYq6P+mZlTBweInpYYqbYIJYK/GK8LC7xvuHau",
-	"WRpmt1ROQeLGLVM5tUvX910erAMur1KS9jp73/o13EYu/GptfXOV9a4wc37+qxr3SSgWrwaju0OOs72U",
-	"hbl+UZhbSXBmUGnHsJBECasSuTdlr2n4SwZ5Guq7qMX9jrrxM4N+PRo8CiYlM+P5AqgQK+7YOp8MvRcD",
-	"Z7rbETpnD5GcYfe2sH8+fvrtYDggrJzrxVffB8OB/fox9lLLltG40iqRTstH1FYTuCdRgVd9gtk3ps6J",
-	"4rfKFHT7Io1UdBx/0/2o9wwerjYA4YQBqwf2Ym5Qmz/nLgHQWmJoHFZ/YgxJVumB/FZsyhT0S1dafJP6",
-	"vaPaR4P7ljTf6CRXK8RyNRxMTZIyqE7ym61Vd7vb7iDoyBdol36dNGAGMZG11iYPpgqSuvUoyGK7RSpk",
-	"QOR1WgqqVqca/07tTn+7iCWD+sGnZ7I5v7wF3sq+il8Q5nzMqmROpXTS9Q8c5yB9GscApmVOno/QS1Mh",
-	"xF6L398b/xf55m9PssNvHv3X+G+HTw9T8uTpd4eH+Lsn+NF33zwij//29MkheTT59rvx4+zxk8fjJ4+f",
-	"fPv0u/SbJ4/GT7797r/uaUrXIBtAXeWfo8H/To7zKU+O358kZxrYCie4oD8RvTegYZtAgkJAagpXLJlj",
-	"mg+O3E//v7soRymfV8O7Xwe2HuRgplQhjw4OLi8vR2GXgynkQEkUL9PZgZsHclnW3ivvT3xckPH9gx2t",
-	"bE6wqT6/n/724eXpGTp+fzKqCGZwNDgcHY4eQT7FgjBc0MHR4Bv4CU7PDPb9ALJoH0hbjOfAh45eDVvf",
-	"isKU6tGfpj4NqP5rRnAOLFL/MSdK0NR9EgRnK/t/eYmnUyJGEDFmflo8PnBvj4NPNq/MlQYs6mxgqrIE",
-	"tTec83NRjnOaagnVZssCq5MJ6pFhQXxrjyvlEI1xjllKXOAAy8At0qRd0VKOR/hJphFt+p9UzA7Q6LxR",
-	"Bke/xrSyLfBGjkj1DgQ05PMqVTwCdPADwyPBNO45nuZih8l3Hz89/dtV1Bm77ZdVOTSu/drKlL8EH3nv",
-	"n4RzBPzOXFYBXkfoZ0nQ7zjPfwenD9ev5l037PKKHFb5fKBDhVcTmeK/Bt2rNnZu2yjBRSET+CprsPgo",
-	"2cCHiE9iY0t035EOdMK1amryQXNC8MHbZUrjvNeYDDBgpnlT5op6HumL1wMzTSTRo+px7pPRdDSMYmAY",
-	"B/LBCL3lihzZHdM4/p1xRn7XUzCu7Cxj8DwzmashNM/AUS8XYzqailVFDjl5JziXxBL6v0siVhWlW9QM",
-	"Qsr2V6iTtXGe6x4cCg1EVhX+Gq4rKpC3/QhWwDr1QY6Q/PMqSPTSlpcP/dMDz/X/OX33FnGBrA70PU4v",
-	"fICsC5auAsTDWGnd03OCBoKsoBPix6HFRtrO5bSol13wKpOPUD8bAAWEPj48dHea1Q8FtHxg+XAwU68i",
-	"U8aM7Udx4OwwUPvuM58++KTpAheGfx+7MBf91LOOBKbRSG/qkz0utJ7a/drLbQ7XWvQznCFhM3DAUh59",
-	"tUs5YSZkQcswRta6Gg6efsV7c8L0nYtzBC2NsAbnuC2c/MwuGL9krqXmNOV8jsUKpGjlhYFmdUQ8leC9",
-	"AzKCYXtB0lU2HXy86pSUDsJb6+BTLVVhdi05ypjzaxffZtGq43qHsUzwtP3h/nFRQGjCqf9+XBTvzZWF",
-	"6AQRCpyXLKlU+ib8Iexds8IbSIwRvha7ZnHkEqfWnbKCkvFROa+WV+cvJfId11XWNCNM0Qk1maNj66jR",
-	"3Nrl9C7RF4nxWP/57hIPqaYVTxvkJNw2dsgXbwnEsC3GMEd6j/W1r5e/1gARzau/8R65Q+v2aO0S8IKl",
-	"eFmvqv99O5eKqxfg78DaZXeDV85XLq6+wbkmoWC5jRqOJy/uxNi/lBjrc3dPjVxZFHsQbF3w46YmB59s",
-	"cul9yLtW99JD0g0VW0HfQNFzv8FxHozQcbPNbmzFZuzeKMOaYMy/nPRqUolvlFst1exXYq3Fv25qcCe1",
-	"dotXYQj3NhHVNZlK/96r859XTL3D41ZyqV7EZol0B+bfkjbtVXNjl8KfUsq0SLuTL//S8qUv+HEtCTMM",
-	"bjmwWYoCefNaitWm4pQqL0fW68QETA/SkUG+HnOEh1UgH9hAIULJxibJoXv6glOFeRWbzRq2HsZtAfEH",
-	"Er7An61OXvSRDb82reCNGsOqntHrJL7JN82Uo6alD7djWurH5J4cPrk9CMJdeMsVeuVCBp7e5h7skzfG",
-	"yWpbXriOtR2M+XITe2MN/uYz4erDX2N2Phf6MPiuWxunr/uQHGSMJfn2iXu/PBihZ7ZplW7MuslOueZ4",
-	"Lqgci6nppJmmRga65/48gvHvjdArSJWg5BA81SEGExpSpo4ePf7miW0i8KVxBG+2G3/75Oj4++9ts0JQ",
-	"psBNyDx7Ws2lEkczkufcdrCXTXtc/eHof//jn6PR6N5G/syXz1ZvNV/9EzLpYSxHs6ekrm3/ync79vhm",
-	"ZoO7t+A2fT2e8WX0OuHLu+vss11nGvt/imtsXCcj+zT2yuNaFcQ9XmvmmGxzsQ3tRQaBo/5WGqG33NbF",
-	"LXMsTE46SPov0bTEAjNFSDZylApR/9Kk3k1zCumKBJJELIhIJPV1N0pBfOK0QpAFROpVaelrEGy+MSBQ",
-	"589/W7zByyCQYuwFB8Ut7kAdOsdLBHXSFJJEDU3y2CX6/nt0OKweZnmuB0g8hmNceo6XgwhT3hSmE/t1",
-	"vwpTT999sx++sHjkYnOsAozdR41WSW4+CXf1TPqrXxZf7avDHAy7sXti1lvb7irbXKhMsWVj16pRjCyp",
-	"oGSELIsiX1XFArRg6aS2OFfVM/TVkHwtlqcb1YyAsSD2Gm/u1R1HuNOGXIsvNQlqSx4EQbfy4BMoKEIG",
-	"1GICEJC6kQFYw5YRRzrOvrC5CPZ38H0ejDXfOjN8+QCVMB8Kug9BGpCjDzLzriDVp4BUunQCKcEeQPrd",
-	"sa+iAamWKo/8uPBkhk/0pDEhKqiEdGcZ7xb0gBbbdTPCDcywSb3Up2JtkFcDbL5ERI7iu8JGfwUk4AvF",
-	"uTzWQEyeHuC941QgJhBak5HiPiFMYTOD9obyeTV5W0YFtOzDZH6H4O0Q3GLxL22eK8NT7CL+DEE67kGf",
-	"oLe8Sipk+P2f0iR9k/LJTS/oLWfE+F7ox4ChxTszuxeeqkvf5aAzT7qqYuuugtSBy/OxVpr60WSg+Eol",
-	"qhu40n+MZkep3ToasaONibKq0fowa5d+BddEwNHnfJt9Fv76BT7YPgcHux2WY/I0Wb5jxQS2XyYEaR4N",
-	"MR/4JEldHOm1bhzIae9tqpy/KHdaRzBxVEUIx6egwpGUm6O/4HF+bsvpKZeQzKQZlZSlBEk+J/Cq0GK8",
-	"rVZiIPzb7UGo6JxkiJeQKzWISP/MDOfp4Te3N/0pEQuaEnRG5gUXWNB8hX5mvmzedRigRNjueahDbx8O",
-	"RBmYBevpaNMw5+U1+CKfrjGDWm1/lVDbZnrhpSLCpFJuVEelLb4d06IDw3itp74T+aC324a+JUGe4zwH",
-	"/G2y1cHAvTze89xsMJlTpaoCY+ENjF7idOY3e1jp3nwRaVeJZtjIXQ4j24rCJl2HS1eDgtUEGg4iyIRD",
-	"dVAiiFMuzl3+m7CPr7IOVScjnmiGWMPMhycv3OqMWZ1PqqGbBO3q1tjBR3pu+wlmZtwsDgsCzDxUgIY6",
-	"yVENaFN/1bnyB1Uzbe1Pmxabikae8srrqSgIFlVnwzDuF4IkdgiBF0RIDKe3sagHd+L8lyHOL21hjC9E",
-	"mI+aeq/L/He/m2oe+Z/UkmZXm2X3VrLZP4+Z5qyRLPbkRRg1xX22RSdXdCxGI3LLQM3/jGkZbjvzbtSE",
-	"VGU1bZti+qXovbMu9WYorbO17p3Xlcr5tq+eKnIsPOiIN0WCz3oFqc91BSWNO6iOls93I0Hpo2HgvlMI",
-	"rnjKc+O1VxYFF8ongpajXg8x0nXN1d5h3TnIr3GVLWkmNyrBz6DV3ZOo0oKfObzF1OD18yvXlHXf6NFY",
-	"zdXnrXTGC2TeOw0QPiuju5OxYwyuoTH/2hXmqpP09qw/T7FKZ2Vx8An+A9mnr6pwWKjmJQ/Ukh1A/eaD",
-	"T2t9NoHH5iTTxAhdayqvVjXoqOfla+heFR17xUUgj/yg+21mnXWkDZtSgKlFDc6dEaZ6M2LznbTZZVpo",
-	"bPj1DeqREVvn1Wd7CCrYetoNStm5BA6mfnWEhO8cQL6sBVX2lgllGcLBNjYe1VxUjOCGbS43vejPYcK5",
-	"fa+Xp1/xOXvLFTqZFzmZE6ZIdj0PaNTkcO72WHvdbicY2Ku/7SbdvvPDG99FinhZZOMF/yfS3N3d8V/U",
-	"Hf/cm6VCAr27sb+eG1u4Q3h3OX/5l/M3X+1qbtD7o+dlvYMVrX5BV2/0La/qlphgtVsNlcI6Axw8ypur",
-	"lK+4cCVY7+73P108ktnj3r4sfbQ6m7S3dsp9BPt8UdD3003keUQ70XWEh95dhkL6RJ5SKBl1ksmh9csx",
-	"Cg17vu9Eoi9aJAr2+k4iulNXfGXqig75x2oK8ryPCLKtaLSY84w46yyfTGwm4y65qF5LVZOnVHheINNz",
-	"1Onbekbn5FS3fGem2OsVW4HdMEs2wNPIkiTlLJO7Vg22U+16OYHFqhuqWzeR+m1xsNgUQKOd6fhDkNmw",
-	"RR6ouSOmZKPL5WyRkZEF0lQ52gMtH3wy/4JeruAysppTR9Wtjblvt8Ukpzbj1gBE70EyNVmuXS8+QYcm",
-	"R3XJIOB4Rm39fPARVGKlpVeXAE8QnKO0Fmjo4Wgfp9PO47T25XAWW13HmuLPCl4d22u/K3ZK+9QIB//p",
-	"1o/Kc1P4E3a0iUrFEUaMTLGiC+K8DEZ3WZV2vgxtTqM1rHKIcJaZc1ttAlkQsUKyHEstKrF62Mg9WT9Z",
-	"W7AWsiyIoPqGx3ll8zevjAOTMmmdL9OpaXHNO6/BtUyiJlEvsu8uZpvGiU/QG5oKfpxPufdGliupyLxV",
-	"id52/a2jMIHTUGylMeAsp4wkc85ipdPfwdc38LE3y4A0VV0jnumPWw3YuN7rSGgsoD55HxHgupv0hbCQ",
-	"aznoNFYrSMGFfmGPTWIdc4i2PI/u5K1Y2j6OK5YGxjj7MRgorK1e+/nA+YvXKq1HW36q/Wnzs9mWclaq",
-	"jF8Gs4Aewvhl9smmBA+AuxDbTiIO8BM7c/5rpEpy9bG7UPJfNOjWmpTCkEobsrYgQjYemXeRt3+qyNve",
-	"+74Vl9ZDlnITpyvlfgWjtzwjZtwq2lIf/Vi9FMYzgqQDoiEPeTfPeJUmd69V7QzeqERjAvk1cTmdKVQW",
-	"SPFBpO5+1THBqWHNiXmPxScM0viaVxtMN8MLgnAuCM70G5owxMd60dUNC4vEEjIyu+A168zaX+wKgC0E",
-	"T4mUJEtc0ZhN8Lp2JlxOrUEerAZW4WdBkqMJFjezgovFRuAvyCqB17tE93/6RT74UhZhZNH1W2ByukY2",
-	"ohmU217KNWBaR8RNiEJSNjHA5iRAdByfFzmx8XERZF8fe53b3wSzRQQ3hMAFEXRCb/houUlugCg9/Dd8",
-	"sG5kCWWRaDmjDfdz8/WMzkFiZJhxp7DdMIOfIMdSJZuuFN0oXLTUSw24eOwWgYE73uyvsVQgjyPKMsha",
-	"aK5CmMe8HPQU277qYUotHJinVGTSX8zH2LSpvuaZLCWyI7jYNZLFlsfIcs1cb8nSzwUpQNzYPjjOaFo3",
-	"jdyFwGB8i8egZA/CyhdoJEgPF1kc6IGxVf9sheUafBWO1sF46loFiA/dLzpgpLLaA0NuUAsgpDefenY4",
-	"kIoXheZQKimZ79eFwVPT+lj9XLVtk6RJ7mAklYwTGcY0WsgvDdIl6NBnWCILB5rjCxv2OLUVd9sw62Od",
-	"QCKhZN15Aa26bhUenJ2Oe1lMBc5IkpEcR/RUP5vPyHzekjDc2EAgjtCTBVckGUOOkDiNVGdC7KLK87Ny",
-	"mErGBG8EX1Cqz/mEi4DUbO/dJ80ITBvjm5ZY7/lZAIwoHbjxAFmGnjqUiHoMTVaW6GA19la65lo6sOdn",
-	"vREEwrhJpQFqzv4PIu3cXgDb6/wrIrsWXk29r2U3dbrh3V67MBtXWeO2iV4RnXx5A2Ps4kExLfJXaTZq",
-	"OtHdYNxnXYsevOFHu+gnDi4xVcmEC/NuSfBEEbExmuPvmDq/DGtkUtzmIEIwgpUR7Dhwa4VF/yzHMiAg",
-	"e/9pErG5nvSljNEjNKesVOYLL9XQJLUWBKcz/UYK1etmJCgNbdMoCTLFIsuhNvDECwJcmLRMqiHMANCR",
-	"ENm60kav+xUXX3nC/493Gqc7jdOdxulO43SncbrTON1pnO40TncapzuN053G6U7jdKdxutM4/VU1Tp8r",
-	"M1viJDSX+5RxljSdqe98qf9Uif793esUYKB9usQUWGCQGKVbL7WFok8RnAMOaE6640CM0/nZy+PXSPJS",
-	"pASlGkLKUJFj/egiS+ULno+xJN8+cZHKRhbAczReabaiBQbd4JvH6PTHY5e7d2YrCdXb3j82rqZIqlVO",
-	"HthidoRlRiB3Ve0I00i3Re2wu35cYXRbJp7mEEMj0Uto/YIsSM4LIkxCVShp2dbonRGcP7e42aDQ+7ue",
-	"3Lra/65H+31YU2patM1x4Z5Fbq1YImwCttGLIIT79wnOJfm9K4rbjDfHxfpqmB8N9yVSPePZqnFC9K4d",
-	"wAbWz4Yv7DemDItVJDFdO1iqSRqKa3ZlCautxLzaa5DbLFr/qk1mmygs9jIxhQjio3dReWycasNaQ5k4",
-	"/0mDTgaxEPXwKp2ZMmgWwF65SCGgyuwJ+mD6fd7MowCRPWIVM/9iHI3rLT3TgLb6VWRZz9caS+QQHz29",
-	"cPaHmrCzMiWIKoksxfW4XrREqEeaEpZYBpSMebZKauxrULuFMiqxlGQ+3nwThfwTTpy/fPSX9ffU57lG",
-	"XgSLW8eTQ6JZJpYBd3DnlSK9ebPHFoxo2XOA8Ztm0V1sNAQBWf4U0601eN+2TK+aZnXH+O4YX3AaGxIB",
-	"ZbaIT5OJjG6Q8YmVKFk3z3u5JGmpgQtP8n2we4BVlSxVzYiekXE5nerXQtvMCoWMYDzK2WdihWa5fbng",
-	"dhRkBv/gwmCum+OiOVybuwRpJ+67ZLAPYDswW4FFaF5gttK7AXEkiaTzMjc4NKXA98toTd2CWFb7SjvZ",
-	"pcF/75SSgTLaXrX13w1a0CWWyOwvyVDJMhus2Eqnv2T90ySZoc+WrGLTa1MimfVGVmfn7XNFuF2uJ6WQ",
-	"qCAiUUtmDlTtMIF1DCNzcj9r+v67a+P2rg2T0oJ0MNh2RZCKIezp9hABX4PrI6h6VcXU1mph4XokcO0b",
-	"aDS6o9DCEj6m5V59g1rD112EKnWLtTeTvEAYpTkFazRnUokyVecMg0EqWNio7T7kdNjdvO+5axI3l0as",
-	"mXaoc4bBicybqaI8cEIi5pJXhDgWK8vplEjNR0MCmhByzmwrylDJ9CuMT9CcpoInJipeny8tu4xMyzle",
-	"oQkkROLoDyI4GutbP9h1o0uWiua59VfS0yA+OWdYoZxgqdAbqjmwHs4lXvEuhURdcnHhsTDqb9afEkYk",
-	"lUlcW/OD+Qo1xS1OnFYQNJzmc1Vfp/kMqioq/J/7/33063HyT5z8cZh8958HHz89uXrwsPXj46vvv/+/",
-	"9Z++ufr+wX//R2z7HOw064T85AU4JkJW+JzKsCxmE/YvwW9gTlkSJcqzGUHWr7BJi+g+pJy0BPegbp5S",
-	"M3LO9G2pOIIbAqs9kk/TjNQ60OaINaistnENa5NDQK835F5YFYpwqjvbzZ8oVDygA2c5hY03dUEae7+l",
-	"naZ2bxOo8Np1q5uvtgpmRyP7Cqlp2hr5tGyLsxrIa40gX39q2/0/SB0a9/YkbQ/YZlf14p+AN7fhQ4Rz",
-	"zqYmt6t+onLYJ8qKUkGUwE1qAckC5wlfECFoRmTPlVLOXi5w/s53uxoOyJKkiRI4JYlRS/TF2pnuY+hU",
-	"j0MZVRTnCTzN+wJETkyvU9Npw/195l3U6HxOMooVyVeoECQlmcl7SCWqlAIjk4gFpTPMpnDVC15OZ6aZ",
-	"GeeSCOLrpOp3eHOIbWUBtWSJyZnZBv/YluIOE44TnM4itbDg7rvEHhSS1crs9dyeWkbkLiXAcNApyGt8",
-	"Lyo3RIO3OgfaVeqoyQ8B0ipo9pFX+u6Q3B2Sv9ohiWWIBXxOGioVg8RwG29Y93bTSZJvUZX3WTKo3xUo",
-	"+bMXKHFsSSKMBK69ceI1M7FEVKFLSK82JkjfdyWYEGwhUqskgHDP4KjbxMHSli1NZ5gym5vLB6sAHPrJ",
-	"PZ9TpVwd7xvRvhpmBmpXjQ6SloKqFbyKcEF/uyD6/x/1s0ISsXAPplLkg6PBTKni6OAg5ynOZ1yqA6gT",
-	"Un2TjY8fPfyf3FunEHSh329XADYXdEqZvqMv8XRKRKXnHDweHQ6u/l8AAAD//0CgK4e+ywEA",
+	"H4sIAAAAAAAC/+y9e3cbN5Io/lVwePccP5akbMfJTjwnZ3+KnYfvOImPpWTu3rFvAnYXSaybQA+AlsjJ",
+	"6rv/DgqPRnejySZFyXaiv2yx8SgUCoVCPX8fZWJVCg5cq9Gz30cllXQFGiT+RfNcgsL/5qAyyUrNBB89",
+	"G51yQrNMVFyTspoVLCPvYTMdjUfMfC2pXo7GI05XMHoWBhmPJPyzYhLy0TMtKxiPVLaEFbXTag3S9P3H",
+	"6eT/Ppp8+e73z/9yNRqP9KY0YygtGV+MxqP1ZCEm7scZVSxT01M3/tWur7QsC5ZRs4QJy9OLqpsQlgPX",
+	"bM5A9i2sOd629a0YZ6tqNXr2KCyJcQ0LkD1rKsuXPIf16GrnZ6oU6N71mI8DVuLHOOoazKBbV9FokFGd",
+	"LUvBuE6shOBXYj8nlxB137aIuZArqtvtI/JD2ns8fvzo6n8FUnw8/vyzNDHSYiEk5fkkjPs8jEvObLur",
+	"PRr6r20EPBd8zhaVBEUul6CXIIleApGgSsEVEDH7b8g0YYr877OffiRCkh9AKbqA1zR7T4BnIod8Sl7O",
+	"CRealFJcsBzyMclhTqtCK6IF9gz08c8K5KbGroMrxiRwQwv/GP23Enw0Hq3UoqTZ+9G7Npqursajgq1Y",
+	"YlU/0LWhKMKr1QwkEXOzIA+OBF1J3geQHTGGZytJVozrL56Orvp+XdF1F7xzWfGMasgjALWkXNHMtEAo",
+	"c6bKgm4QtSu6/urR2AGuCC0KUgLPGV8Qveaqbylm7qMthMM6gejzJRDzhZR0ARGep+RnBUT7r1q8Bx6o",
+	"g8w2+KmUcMFEpUKnnnXg1ImFRHQgRcVTjIrgB4fmHh5l+x6TQb3BEa+2f1Ns4T61oT5ji/NNCWTOCnNf",
+	"kv+ulA4EXCnc9iUQVUJmeG9OzDAG+YotONWVhGdv+UPzF5mQM015TmVuflnZn36oCs3O2ML8VNifXokF",
+	"y87YomcHAqypc6qw28r+Y8ZLH1W9Tt4lr4R4X5XxgrL4LBhaefmijzLsmP2kkWaQp0FuwP1xY52vX74Y",
+	"XR3SQ6/DRvYA2Yu7kpqG72EjwUBLszn+s54jadG5/NfIihemty7nKdQa8nfsGgWqUys/ndZCxBv32XzN",
+	"BNdgr8JIzDhBZvvs91hykqIEqZkdlJblpBAZLSZKU40j/ZuE+ejZ6H+d1ILeie2uTqLJX5leZ9jJXMYS",
+	"DOOb0LLcY4zXRnhEUavnoBs+hJ/IXEhyuWTZkuglU4Rxu4kodxlOU8AF5Xo62uskX8Xc4R8OiHor7CVp",
+	"t6LFgHr3gtiGM1BI+07ovacakiJinCDGCeU5WRRiFn64f1qWNXLx+2lZWlSNCZsTYHifw5oprR4gZmh9",
+	"yOJ5Xr6Yku/isS9ZURDBiw2Zgbt3IDdjWr7t+LgTwA1icQ31iPcUwZ0Wcmp2zaNBKdDHIEaUKpeiMFfg",
+	"TjIyjb93bWMKNL8P6vzJU1+M9n66M62IQypSk/2lfriR+y2i6tIU9jDUdNruexhFmVG20JJ6WSP42HSF",
+	"vzANK7WTSCKIIkJz20OlpBsvQU1QEupS0M8KLPGUdME4Qjs2AjknK/re7odAvBtCABUkbUtmOCi5ZHpZ",
+	"i1wB9dPO++LTJuTUnhOz4ZRxRSgpmNJGGMLNVGQJBQqcNCgWYio6iGgG0MKWRQSYLyUtLZm7L1aOY5zQ",
+	"8P6ysF7zJh94ySZhrj/HNIBQHczMdzLcJCQKFQ5NGL4uRPb+e6qWRzj8Mz9W91jgNGQJNAdJllQtE2eq",
+	"Rdv1aEPo2zREmiWzaKppWOIrsVBHWGIh9uFqZfmcFoWZusvNWqvFgQcd5KIgpjGBFdPmAcw4noAFuwBu",
+	"Wc+UfEOzpREmSEaLYlzrJUQ5KeACCiIkYZyDHBO9pLo+/DiyfyjhOVJg+KAGEq3G6TSm5HwJEuZC4kNV",
+	"AllRvJxW5nlUFs0+gbkquoKW7ISXpag0yMbL5eULvzq4AI48KQyN4Ic14oM/HnxKTsMnnJkLuzgqARUt",
+	"jGdFldf4C/yiAbRpXV+1vJ5CyBwVPVSb35gkmZB2CHv5u8nNf4DKurOlzvulhIkbQtILkIoWZnWtRT0I",
+	"5PtailIokH8HtlgeQwCc0YLyDCZbrjHXxF1nl0thOC7Or4iCAjJDfWZNmVghLYIyawirvKccOY72fPOX",
+	"brFpsIRk5oIviG/WmHRMqCLm2YhXFtOKZGbRc4MXOOwij4ffey1aaFpMLNrSk2ALAmsN0qzKNiVUk8YW",
+	"mYnDY9rplDqgXI1H22ZqzyGC6GjxeNicSZEk2sRxi9YCjC3kDGF+qVU0lhDfADSv2f+xbrcdN1tONZ2O",
+	"2gtJa0TszYv98FEFMrFtP+F/aBFTccR9Gcr5+CYI/AxlXoMUO5NpoEATLcjK6p2JUQbvBeXzevL0NT1o",
+	"876xqm63PW4RYYfO1yxXx9omHKxvr5o3jNWZ+uu8wx22XtrRXIOoV5TEXr8tEOxN6xiMQYhYH10s/Fqs",
+	"UzB9LdYdkVCs4Sg7Idb2P4OEpa/F+oWDTMjdmMexhyDdLNDcB3g30YYZ0cxSm3pOZ0IedrN2THu1AYtQ",
+	"M2r0GBm3kIRNq3LizmbCvGQbtAYiQT27XYhuD5/CWAMLZ5reABaUphHw18BCc6BjY0GsSlbAEUh/mXwE",
+	"zaiCz56Qs+9PP3/85Ncnn39hSLKUYiHpisw2GhS57/TkROlNAQ+S8gpK5+nRv3jqDYrNcVPjKFHJDFa0",
+	"7A5lDZVWOrDNiGnXxVoTzbjqAOAgjgjmarNoJ29sv6vx6AXMqsUZaM34Qr2WYn50btiZIQUdNnpdSiOY",
+	"q6ZR1702TnLT5ATWWtKTElsCz5HmcR1MUaVgNTsKUfVtfF7PkhOH0Rx2Hop9t6meZhNvldzI6hiaQ5BS",
+	"yOQVXEqhRSaKiXknMZHQ/b12LYhr4berbP9uoSWXVBEzNxqQK573qPiMZXjw/WWHPl/zGjdbbzC73sTq",
+	"3LxD9qWJ/PoVX4Kc6DUnSJ0NzeNcihWhJMeOKGt8B9rKX2wFZ5quyp/m8+PYGAQOlHiKsBUoMxOxLQjj",
+	"REEmeK52Pq+8Nb2FTDfVEJy1seVtwbofKoemsw3P8F13jLPc/+h0pnKiNjyLVMkGxgLyBcidSDqSyrgP",
+	"UxaKeyoBqcHUK/yMFrUXUGj6rZDntbj7nRRVeXR23p5z6HKoW4yz2eWmr7fIML4ooCGpLwzs09QaP8iC",
+	"ngelnV0DQo/E+so8jKP35WspbuAOTc6SAhQ/2Kd5Yfp0VbQ/itwwH12pI4ie9WA1RzR0G/NBOhOVJpRw",
+	"kQNufqXSQmmP15s5qFklJXAdy7moD2SKzMBQV0Yrs9qqJFqk7pe644Rm9oROEDUqPWHt6mRb2emW9AII",
+	"LSTQ3ChfgRMxM4uuvYRwkVSRksqg9HEi8VB+2wC2lCIDpYwF2JpddsLr29n7R29BHq4GVxFmIUqQOZU3",
+	"s4L3FzuBfw+byQUtKiOe/+0X9eBjWYTVnm3fAmyT2oi2+ru7lGvAtI2I2xDFpGy17fYkEC3wZVCAhj5k",
+	"Xx97vdvfBrNDBDeEwAuQ6JF2o0fLT3IDRBngv+GDdSNLqMqJEQN71Q9GcjX7zSkXXjbcMUOYwKutnbp7",
+	"ojJawC4MtXXdFWfWLic4kPaI5uOYUFKKS9tbA5+Sn5yZMKiFhaRZYWSdrKASFOGCw1DrQmrK9BrMF5zO",
+	"Qe5mpeq9QgGrtTK0383A++zisRoTVRkzJjqv5My4xP7dNTaMwoGF6MgEV8BVpf7qnC3sJG40VJDvgYnO",
+	"RV1QpSe7xAHTqKHzMmQa3cC9A/e8BV5RpVGEJ4znqHu3YgzOg31win0NUjhl70vaTPqLf0R3pw14Di9q",
+	"VZWlkBry1PLQX6d3rh9hHeYS82js8GzXglQKdo3ch8BofIdHuxKLO6qDd47z9+kuDj2ujOi52RfLDfhq",
+	"HG2D8cy3ihAfBxT0wMhUvQeW3Jhq0dtMiAIoqruVFmVpbhdzdkO/Pgye2dan+ue6bZckrQkc5yS5wHOk",
+	"PUwO8kuLdIV2/iVVxMHhfbNQWWndg7swG5Y8UcxYELedF1RgmFbxwTmIVVvRoMXrtokJ9a5ENskm45Ng",
+	"0GHZkjWe++d013ruCHSuQZJ6xS0GFnXzLg3BKD/btPnrUAZflQtJc5jkUNBNwsnOfib2855nwo+NZ6NW",
+	"ewkNkxk6kaSPR80OvP34sFkFTpUQSn4UBL+QjCqNG1Tvp+t9+KQ54LQp6nHn9F6YBcFIHgE/HiLLHqXE",
+	"iEiLFwIpyzayq3HC1DXX0oO9MOuNIBDHndT6q/bs/wXKze3bHHf+Dai+hddTH2/ZTqyyPMNa45lervrv",
+	"0POa0YTW9dXgfKdaQmROYYX+5Xil2wBEvSGUq0uQCTGpydgrBYTynkGJQO8+wkV7XCvbM52Ur5rLzmi2",
+	"hMmS7X77+JXz3DFi90OBETGqht7CALmHwuxZtoSUiO0WYnsogvcOYc6ECflfrTdZE2ITrsJAje3lazzx",
+	"lHuFmEmWTBNpvI+uhdr9HIACLg1FZpVmFzCZU1ZUEtS+jw6HkSXQQi9JtoTwXjcjQu5wFIREwcF+VlWW",
+	"AWB0Y1h5F3moej1sdbstQ3g+mEyYgq55OLrr6JzWAaTuCGfXjjiX9YigFXDtjZA9+9Wm3A9BfiHSKfFc",
+	"Mv6qoILQ07OKOcpC0TPqnmpS4jP358ZEzCLTzQ0XysUlvz4vCy1xLmQcdrYBe9sWWy0JpeXW6Aj1AIPk",
+	"GNgY9SQxbsm4118y046DR9NtYHecQtow11CrNN67rZdo67GYfOH1Pqt2vGv6nhA9biCvqdQsYyVaCv4G",
+	"m6MbTtoTJN28SQ7astfogzWilHF/YiMo22MeZkgZZOnugt8xdSeW44NKmsC/hw1arF7bYOzIUHgMS1Bi",
+	"VMKUoX8E1Af8Qt6MHYc1zXSxIRQZ7oaYQ0BUNbMnq+u9pEU5iQdIp3von9H5QiY9Ebc6Z57hUNHyUkFT",
+	"9jW7Hb7zlra7gQ53U5ZCFNPd/r8dZCQhGBTpQEphdp3RotgQHSL+PSU1gHSPnGLjwXV3RYxmXAH5L1GR",
+	"jOIDuqw0BB2LkMj0NN5NCs149Zwuyq7GEBSwAmtIwS8PH7YX/vCh23OjjIRLGy3AsWEbHQ8fohX0tVC6",
+	"cbiO4GhgjtvLxMMJ3cTMQ9E7gbd4yu74HDfykJ183RrcT4pnSilHuGb512YArZO5HrL2mEaGxSbp9cCV",
+	"nzejWTrrxn0/Y6uqoPoYPmJwQYuJuAApWQ47ObmbmAn+zQUtfgrdUNMPmaHRDN8Qc7YYOBacmz42J4oZ",
+	"h3FmDrCVBIcCBC9trzPbaYeivI7UYKsV5IxqKDaklJBBbpVsTBEVljolOCzJlpQv8KEmRbVwUZou1sUw",
+	"/EpZI6SseGeIvaNA1nyC3iMqmWEDPcbcLWCtI9QoptuuJ/ZVdUkDKJA3royB29N2xUl6q41HW99UF7Xe",
+	"3uKtme3mUD+uhnwYIa2GZqDjEuLTyEpdJMbbaA6fIYabcZCph05B2Z04imetP/aFtBpzQbE5gpBkByIS",
+	"SgkKr7TYAqvsVzEnP7BMitNiIcKdpzZKw6rrN2O7/tpzXN8cosUVvGAcJivBIaGW/gm//oAfB1t87TXc",
+	"MyIKRHsN2H74NJDQWkBz8iEkfd1NQpJpn/22k5n6VshjOTjaAQe/KQY4De70qHVTHuraaKI1u96AVoXe",
+	"4SJqHOJZmSRUKZExFBRf5mpsT6tzIHSRdE30vw5ZHY5wgNvjttzeogwS1ocCipJQkhUMPSwEV1pWmX7L",
+	"KRrqoqUm4jS8grtf4/bcN0mbkRNWXjfUW05RGRTMd0kdyxwS+qRvAbxxV1WLBSjdemDNAd5y14px50Zh",
+	"oonNcZnY81KCxGCJqW1pQpnnhia0IP8CKcis0s0nx6pSmihtbMTWB89MQ8T8LaeaFECVJj8w4xFuhvMu",
+	"vP7IctCXQr4PWJgOZ1wL4KCYmqSDTL6zXzEe3uFk6WLjzf9d5zqaNTwmzdob+fb+3/3/fGby7NHJvx5N",
+	"vvz3k3e/P7168LDz45Orr776n+ZPn1199eA//y21fR52lvdC/vKFe6O/fIEPsSjEvQ37x+BPsWJ8kiTK",
+	"2Je7RYvkPqb6cwT3oGm70kt4y433vhbkghYsp/qI5NO+pjoH2h6xFpU1Nq6lxvMI2PM5dA1WRRKcqsVf",
+	"b0Sea0+w1dc53vJWeKfjjOroALqBU3C150xFNN377ptzcuIIQd1DYnFDR1nREi8Y+6HpYG12Kc5J8Za/",
+	"5S9gju9BwZ+95TnV9MSeppNKgfzaRolPF4I88/lcXlBN3/LONdSb+zbKxxQlv01xCrpKr+Xt238YPdvb",
+	"t+86LqBd2cpNNdA0YqecGLlBVHri8k9OJFxSmbLn++yEdqNs761wWJlEVC4m3o5P3PhDDTi0LFU7T10X",
+	"RWVZGBRFpKpcqjWzrURpEXJeMBXSBhka+FE4f15JL/2Tt1KgyG8rWv6Dcf2OTN5Wjx59BqSRne03xwMN",
+	"3W5KGPzw7c2j137v4sKD64+kk5IuUqa5t2//oYGWSCEocKzwpVlgDgdJY5yEIEwcql6Ax8c+W2Ih2zsl",
+	"ES73zPbyGYnTi8JPuKnNtE/X2sEoodfBG7gjKRit9HJiOEJyVcocA79Xjm8QuqCMK+8AqNgCHwBqKSqz",
+	"ZLDWPpeUF1al3owb3cW8cRd7hsMU6oxcXoY5M/jLKDcDVmVOnSBD+aadnVPZOFQc9A28h825sN2nAxMb",
+	"R4m0o+yQqu/oIu1Gd60h3/gguzHam+9c3n16G5dJEVNeeLJ4FujC9+k/2lYAOMKxThFFI0VhHyKoTCAC",
+	"O/Sh4ICFmvGuRfqp5Q3yTRzglUiVIf5GHsO9PRRxWYLDX+0zic0bo2WUc6HRUcpeB1ST23NnZDwDjs4v",
+	"ULAFm6U87v/etf9EwBMJGbALn7gpDKjMQplWIUuSfVlKyhdAqHYZaWiBcaXTpFMfStFLoFLPgOqtem0e",
+	"ZxL00Jn+5BK4Jla5NCboV2/OBdOoLOJwaVM0MenauFi36UFe43ZNkB8Iqu9+jWxK5q3hEJ5IWe7lorAn",
+	"4V3l/EfiU3y+DN9XBocLKS7NbhoAhc/Ojzk8o/u8UnQx2FepYVIbmPWwYSnDQXZJiUm50NjZm+JfRxYb",
+	"uAjbfWLwkuSiYL4YNormklYUjp/bmlqd9eUnk63IIXVW4MMjxDBZ0jEMIEIeX+wHbJrdg+S1UO8Ba2It",
+	"PvpLqvzRz8fRzXegVP1hsoVuS5H+MgoyoLqbAN2LM+0rcGz1XjNAPz8x94nSfXZ0nxJ9NN4rvfl4ZDlT",
+	"cu8Ex9dGDgUsLE5sY09ndQreejcNHD/N58j0Jql4hUhpG0lwbg4wD9aHhFjLAhk8QuoURGCjBwIOTH4U",
+	"8WHni32A5C6FMPVj490V/Z0OY3IBo+Y1IUojHTHeJzk4luIyGNaiYSsKD4fBUC3DSS9oEbkl1oN00nHj",
+	"G7GVfNv5wDzoezsOPGhujSjF7bVK7HHQ+uIHil9G+vW01xpmYj2xyXuST9DZembORDKk1vRKHl6bHP2e",
+	"IjOxRt8rvOFsDObe0PVD5gGLfYqZQirHfn3itQVvP0C2P3hS1KzI/fD8qMmuT+I/DJieZ0cf2d2PsqQf",
+	"CaSWoreu9OQ0Xzv1UU1pqyuJ1NftOCR9DJkUUqym73Amd7IHo10lczOd+fd1Rvv+/Neu0e3kce8qL6+T",
+	"et92RkDUXpn32+TQAGILVl+3hdgkWhutWniNsJZiSY3w3YY9o4k29yQVfNKQqyfvYZNW/ADKDGe+W6QP",
+	"xt2jfPMg8hqUsGBKQ22EYVH22tu1kaHa1Ty2xLx/dbqUc7O+N0IEQQM7EuzYWOatrwDD1OZMGv9uY8FK",
+	"LsE0+lahxvFb0zQtCDc2mzBlTWJ7y8EIkck3kLOiSpOyA+lvLwxEP4abS1UzvCgZt15ZM6x2lnRk3sOG",
+	"i/BYB/itCHplEfSK3gZ+hh0s09TAJA3lNaf/RI5Yixdu4ywJWk4RU3dDe1G6hddG6Z66jDYSoiP3lOk2",
+	"21jnXOZ+7J1eaz7pVJ8QYUdKriVKep8O/BGLhYn8trk4Xd4SykPWc0ILwRd1unjz+5YM8VNTeUu5POtb",
+	"UrQ7N37oc+JvVIzEwodJ6KNmFvI6iQCml8dJFsBtcsHR/iUlC7HYEUCALSIN8u3y9k54QdLF+rzlVl37",
+	"Pts9DJuN21MAzd2zSoFf3/ZD290uh7pxn3N2owrI9gOGAyLFMa0iAaZDND2cm5Yly9ctA6kddXoASQwU",
+	"97rFvlo4YzvR0vS73lGF9Z4izrvb2YJO8HV/Yt6W1t3bOSybI0EzlwcrryQa2xrO1N1KaeF9OXDJf/vl",
+	"TAtJF+AMphML0rWGwOXsg4ao2Jgimln/8ZzN5xAbCtUhRq4GcO0zmKwwO5DyutbE8KTcSpZ701a9gt0I",
+	"TdNTglL6XFLOu+Za1zZWqYU7Jtq4A2yuyVRXf4PN5BejWCElZVLVrrvOftq8zfegiYvV32CDI+/0iDWA",
+	"7dgV1MC9AaTQlFElfFJR/ad7KsaYffo2tnCPnTpN79KRtsYVSew/GvXFFK+otZSbOza1B5GBdMhenaWd",
+	"cszZgua2tAl91xaxfLfIE7084qlsvq1D7raQA26n8x3QwhM+LnZ0NR5dzx2my8LCiDt24nW4kZO7gM6q",
+	"1j2i4RO354bQ0tSoo8XEuRH1yRpSXDhZA5t7r6NbflalT8X5N6evXjvwjV9GAVROgoajd1XYrvxkVmWL",
+	"K26/hmyhLafStRqwaPNDMZfY0egSi2q1lGidKqa1W1k9nnc8mqcd6XfyTecBZ5e4xRMOyuAIVxuisXPL",
+	"941eUFZ4e6+Hdqhy3S53WN3cJJ+IB7i2D13kHHntsRT7F0zQA1f0+K+pgF93MzqPXePM4jMpSuqx3KaN",
+	"H75+s//m98Z2GO2PB6e27VjntlCBLeH/qA70Tu8wwDQDqQ/gDraNyP8JE/+n34DclQVAbu2cBOnRhdNv",
+	"hWzcni4SNelkeHNSq3nhWDymHQTOnUdAR1adEivX/rb4jTBFHj6MKe7hwzH5rXAfIgDx95n7HR93Dx92",
+	"gbYCQZqPolaR0xU8CLEsvRtxuyoRDpfDZJjTi1UQ3EU/GQYKtd6CHt2XDnuXkjl85u4XY/U3P02HqE3i",
+	"TbfojoEZcoLO+iJJg8P6iq5N3IsigreYhY1sNqSF96ErGGlt/t0jxKsV2sAnqmBZ2gGJz5BDcuuGbRoT",
+	"bDzYnm3mqFhPLACvWDS6aaYOMr+2FhLNmkS4ShbOqPE7E44FVJz9swKXp2rOQOIV0JIY/PsMR+1I/Wld",
+	"pxsY+0TDD5XwTbd99VdbzJUWyF5U9Vp9XwRLpF9/qprxnqEp8Ywdnr8lrMQRkr81OXNuIoMIauubMxiG",
+	"k4ogZ4n2XNMZffsfa4bH+n4vXwzZYKYmcyn+BWmRAe2UiSwrDhB8PGLvlJtsm38F5wW/3nj2XQQyXM/R",
+	"RyrX1mv4RYfa7Ifc3Gn2sN9G76nAiPa7X4Wh0kV4xqP4bKfhth9JM+aph4fhgY08+NGB3HvcUW5PqE1B",
+	"0ggSTJ/zqIU6sePX59zB3N71rKCXM5q9T79dDUzR9jd8A7UgvrPfIBWyaNjZSRR2Etoym5KtBFkbsLoF",
+	"RQ58h9ppB79A6wen6dh4ao6tu0yhRGKYil9SrsG701gO6HorsK4gptelkJhKXaXdGHPI2CqpmH/79h95",
+	"1nU+y9mC2QzzmP3ORS0AcQMRm68dqchljA9pYxxqXs7Jo3F9Zv1u5OyC4UMMWzy2LWZUufT3Yt7sYpYH",
+	"XC8VNn8yoPmy4rmEXC+VRawSJOgKUOIMzrgz0JcAnDzCdo+/JPfRZ1mxC3iQvmCcjDZ69vhLdPWyfzxK",
+	"iUg5zGlV6G1MPkcu72Mp0pSNjt12DMNW3ajp4Ii5BPgX9N8nW86X7TrkdGFLdwXtPl0ryukC0s/01Q6Y",
+	"bF/cX/QmaeEFE9iSHJSWYtOXJXcFmhqO1RP4bxiiBcMF06ycs6oSK0NhnrX64+eHm+JpsfQR4PIf0Qu8",
+	"TDztP8Ari67S9EDRsf9HNPnHaMVS4JgahdUhII5FTslLX78FqyeHbJkWN2Yus3QUU80WYqFOxjVqsCo9",
+	"n/zFvNolzQxDnPaBO5l98TRRhbhZqJPvB/it412CAnmRRr3sIXsv5bi+Jt8Bn6yYYf4P6uwb0ansdVdP",
+	"Tqv7PJ97hr62dG3GnfQSYNUgQBpx82uRIt8y4DWJM6xnLwrde2W3TquVTBMMrcwO/fzmlZNEVkKm6sHV",
+	"DMBJJRK0ZHABee8mmTGvuReyGLQL14H+wzrYebE0Et386U4+FiILd+KdFjJgGUn/lx/qKlJoaLch1i2l",
+	"pZAJ9axTNN6yZ+x+asK2Pd96JOK3HswNRhuO0sVKT8QJ/lz3+RAuZ22Q7J43NKSPfyPSvONR1n/4EIE2",
+	"ilLb9Lcnzc+WvT98ONxrN60mNL8mUHPYXdPaceyb2mpTzv/Z7z217oPrmssq093m9F1mrtSZG2NMmgXF",
+	"b1/uOE7I5N6e0OkD5FGDn9u4+cD8FTezDsLp5w9fi/ULtyoh0+STh+9RGAclX4v1UCJqXVuenj4CFPWg",
+	"ZKBWEFci5q3bJem1sdPlKCJbM+oMjMuzapSJHexB8wntgkHNeMteVKzIf6mNz62bSVKeLZN+7TPT8Vf7",
+	"DIgaRBoMY2LlUCR729fyr/5VnXj3/7foGXbFePpTa+EO9hakNVhNIPyUfnyDK6YLM0GMombutJCNpliI",
+	"nOA8Ua2TwBqnowTiX5g68Wc2C416LcW8S0922FWlnWM05m9wpdvmrDD/6zGDY8uJ7C0EIjH6d16PCBdg",
+	"zGz4wLOjgySUrfDaVtSUhMVDeAGSLrCr4NDqjsn1cOSoOAZRpfmELTFPjyC6ktwUfI+WAVwzCcVmTEqq",
+	"lB3kkVkWrHHu0bPHjx49GmZbRHwNWLvFq1/4T/XiHp9gE/vF1ce1tS32Av8Q6K9qqttn87vEJTey4m9s",
+	"vZIUi8UPNibcdLaVO7ETAZ6jcnZKvsNUcobQG9UcDDQhGXYzfWtVFoLmY8zfbfy1iJ3V9pGAqMsN4S8M",
+	"/K0jkjTyDE9n61Pl9aQZGz7O9ixHZtVKY3EVpemqTCW9NC3OfQPCWp5YqBuMsTMlL6xaNvjz2EkIZoGX",
+	"K8hJmM6pAZA4zH+0ptnSNBDT0VaVck/dxd3lnF67Fp4D1uaiKPT2wn9EDm6WYd0bgFQ8BzkmwuioL5kC",
+	"TH0BF9DMrenB8Ap5n2uzuVpZcW4JZ7qH9BoKke67Cx44HDe4VSQha+3DtW1/dTIRUckMhlOvPfln2Csd",
+	"OsSbg7XcHWx1k7WvjzIlPzhjR0a54CzDuiApERyzZg4zqw4ooZK2d6qRO8uJY5gg5ShG3mHRrf9dL8t0",
+	"iOs6NURfzX5bwrF/ali7UtkL0MrxQMjHqKBiBTgDHeMKXKlZQ18xRxUy4fGVDNEJniNHdI8fjzDxXY+u",
+	"9Vvz7Uenmzdnl7xnHHVuDqm++jMa2ArF0M7OCdNkIUC51TZD09Q/TJ/p+ZojCO+mr8SCZWdsgWNYD0SD",
+	"FOuR3B3q1PsnO39g0/a5aevKTISfG550dlK/7ndJFqLC/nc+mdIIfehPuXz5CLkIuWH8eLQtxLg17ADv",
+	"ZUOGpv4IURpKvM87ZANSph6epvpIZekNWxAbPJxCSsF4AoxXjHuDbzoVV5a8S3Bj8DT39FOZpDpbNpjU",
+	"LufjntAcjOvP3h9jqNYGI0pwjX6O/m08X3NX8aOHrYQG9euC8g3xh8JQdySUmEjf4OiNwlRTL22kMyeM",
+	"WR9hG+zrxLs0WzFsfeKjgxvo2hmLGrpj4Zp976m+xLCzKl+ANilGU6nvvsavBL/64EZTPKcK9dpCqGsz",
+	"s36X2txEmeCqWm2Zyze45nQ5U1QpWM2KhMfti/AR8rDDhtKMjcf8mypW1r8zzgF/7wB0722f71dOohtQ",
+	"n5KeDU1PFFtMhmMC75Tro6Oe+jBCr/sfldJ97PlHEVre4nLxHqX42zfm4ogzqndc++3VEhKeoxu9wO8+",
+	"JVlIutvkSuZbtyQfemTg5iW2rAW8b5gE/IIWPUkfYquNvV+tJaMv9UPWm9mEapdAT1NS84QhKoz+FGTW",
+	"8bplGeqaN/tcq61n9U0aTxw+tiK939L4t4Zd0Xq91Qyl1554mMmvJoJ9bX6uakZXX0qLQmSDOYMb5tR0",
+	"6s+qLFYrV6Qg4ZV3sRJ5fBYib65WvuKkr7b3HYsetibYxsJVP8ptthYVJwx2XgetHMHWIaWZ8Hhq0i42",
+	"kjP68Y2WsccTDSDNlFme/NnBnvyGq0h+kZfp0Rq6nUDwO+svX7d0fCgcDOsS0R0KpzerQg/FZ6dI8sDS",
+	"3fHm9RTyvilQ28wEj5Mjh7ENFvZb7TfWbmO8aZHq3p0w8i0rgDBO/vfZTz+O+g90dBK7R9tlu0+aMvoO",
+	"aIiebLOJhWjQ1pa7QPAibQdRPaYVTFOW5opCQ++Hb5UeCpJN2bVP61dDB+8QwELYQm6pUjfdREmjejs8",
+	"8iNqqLfX3iwxdaSool0gLfEGxhbRFeXUZp3RehRhDVl5SD22VOkv92L0mngrcLjUiLYeWqeUWucifTHk",
+	"kdDBx9V49DLfS4xOlY8b2VFSF+0rw0y+NpaP74HmIG0JoJRawRYAWoFRR6glK/EdXArF6hLehRnM5ZRf",
+	"4nDToZFZhi/ip5C4ojOWd6S/gEwL2XAHlgDD/V3K9BINBN6wjE0+gEuQBMih1MutQrN18i/1sq70Cy7w",
+	"0FjewZmwLoCPCZvCtB2rmNf5yUgBdO6V8VIIPaAUtte6WTTGQKfoq1NWfftzoJN+MMquaS/E6fC6Sach",
+	"NsTG2V5SVScxa6X2GJxCYD6HDGsvbM0E+fcl8Cg14NircBGWeZQYkoVoUayyclTLRg1rQQ8EtaC3A+kN",
+	"VP54D5t76hr1P7yNcGyLfzCFNOTwIjhEOMOidq4WCNOuZEiwx91OTZC8N778niKNU5gsgx5C1A8p54Dk",
+	"ZT0ifIWQPiOhczFmKpxI0zlElNjuUBeWO6SiR5Rq9kAwPJcgNE4/exg0XiY8AAzTde9J68SfN3aY0OIc",
+	"4L6nSJjT/D2+1mFLhNT2nru/NrN3YQyS41G4FMjrPHXF5hbOYW9mUXxY9SVKfW1zkEeiaL/G7wVoygrl",
+	"nONpqNwR68WNia9lDySXrvKHQUTt9eBrgIDyv/lc13aWgr2HeLfQx8SkR/ctjpJxFJsRlgZ6HmZmdYBn",
+	"11txX/9CG2mdFcII8JO+APdmxGUIRbinbMxInQgSoZ6DdJcBknwhFEy08OGie+RRtsBtw57CaJmD8NaK",
+	"TNoj44FdUW85mjd1TR6sQEyx/Ax1QTQxVoiEFWWWiYg4Cj3h4rhjh57b7z5Pk68ou91M1If3cC4mO920",
+	"fQgxUx3Mx6drTpxwuzcbbyR3OsDCxDgHOfHOKO0qObyZcRg1kXmVWdYXn81ghRucynELN0saZ7LuKlsq",
+	"gCip0HvYnFj1tUsvFHY8Btq+gSzoUW7+FlEc1eamUnAvjgLeh82EXApRTHo8HF52S/u0D8N7ZrxSibms",
+	"fISdecXdax4bMwm5j4b14Pt2udz4wjVlCRzyB1NCTrmNcvZucM2i163J+T29bf41zppXtliXs6RN3/J0",
+	"uCgWzZLX5H5+mC08r483KeD5tee3gxwwu17zPl/fS6yu1SxNPx2qnuv6qbVEqIj8LBQpAerMOrQ8R5aQ",
+	"0AMQTC4VZUFDPydKnCMMUYVIRRMdkgDLDJXGVDwZAqSBD1C31FC4wZMIcM7COzJdu88+l7OYEwm1j9mh",
+	"Sa1dnmjLxFWfaq89c5ilyRnnQkI8I/rL25z3/vwia0KXUDljWlK5OST1dBNVKTVqL5Z3en0Hh+96IbXT",
+	"dxeHRSEuJ8jWJqFQXUqdZdqp5rXtS2PX/cxRn0HkPk6VExE3ZElzkgkpIYt7pA2EFqqVkDAxtQ2Sxs1X",
+	"bK7NI2GF8emcFGJBRGlUqLamZJqC+uaqOKcoe0HkkptEgaUds1LXJ6LjgVOa29e6mUxQXttZs8hv/rnp",
+	"Y9Pw1ClF7aIn1tWpJ04KlEtq6TBkG3fhRcKxCeXaRoW0iDxna6QbkKkjPydamtg+1wJHb5AQHnwqgayY",
+	"UhaUQEuXrCgwCw5b1/wAgl9jGrU9svNLjOe4YOi428yIhD2MpJxBSCMV84CzOKEk0UspqsUyKrUS4PSK",
+	"E1k5tUo8ys+qQt9qDHU3UzwlK6G0exbbkeol167s9zPBtRRF0VREWzl/4ZxXfqDr0yzTr4R4bzIbPcBH",
+	"OOoU3ErzsU8N045BqGeSrby2w14KxtEVyUPtrlhh2xkAPIMYzDtb3K9jONtliYrAfLebue62y512F9Ze",
+	"V5PPpt9Cp5xQLVYsSx+3T8uLv9f3PsW9UqiwPVw2LWyGfCC+x4JbJnLPLpqB02RF6lPieIRzT0NOZP6L",
+	"Ynx7XDIHqjtzR3dol+84AWuS9YqBLQAQUpvQRVfSVsuPhbTAcMTC6iTRua4N6MALB32YrwebGeHoQGm4",
+	"FlCdqIoA4H2rwRjbhL42QsME7LrvD+qMvwcBf7WdyhvMo885/KwmLYlNQkK+Ho6QLuqy1ZP6HJP5zIb6",
+	"Uytv5R54+UcA9HtYN2AY5Ge9LxhzasJwJlT33PuoAxtHz3UXKx6N7kvj4iwko5Uvqm7GriS4BHFW+pdN",
+	"c3hJ9dLfqqZ5VyNudJhO04/WOSyJPo7MsVDYiuktjYIoJwVcQMPx3NKyqlAKZRfg+6rQmeQAJXostBVt",
+	"KY/qCI9t7Ytb+yTyyR2C3aQ6xiLW7hTZoWtJaobWfGKPiRp6lAxEFyyvaAN/al+Ro6lLNEc5garO82Hi",
+	"n5hDp/nZjvDGD3Dq+6dEGY+Jd8P40N4sKI26bQxoZ4RFpfpOPU8HWMQpGYOhCGfLg1+GJfGab6iSXvJ+",
+	"rWaX5OuX2MB9YoJHiP1mDRlKNe4pBLl7DPVYTpwJE6mdA+T2wWC6JLT5S+CEi/pFhCpN/4qpk1L7H+zE",
+	"2Ihx99A+wMekjoO4/s4SHIyoVtLY5E7UZH09Hf8HOYlbD2LveCkaUeBSEmxRjXnqds8ObCCqIifc7KeR",
+	"/bHcurvFHBcfk1nlBzKKDFsPPn6ivgBvzxU8NjHZFflsq6hItui2N1hXC8KiSDdjERcS/+FCk39WtGDz",
+	"DfIZC77vRtSSGhJyBmTrBeTiR8zE28WrsQfMK2KEn8qumw0dMxpuY0aJgDYXua+AKciKvod4G9DByfLP",
+	"TBvGqaoZKjXMld3azi4W3OJ9mrkVzWMlACbM3jS4g3e4Nr3/Wrv7xFP5PLZlQTPIG3U8m3zGCEOBuPQS",
+	"VtvTNXT5micB3yoiWunT/eQHaFP3ZF2p2MW+goMNsKNnRLPe4HGWMVAp3KobtyXRxaClHHsXjhOL3llS",
+	"XDV91+LiIvK3szvJTPd9yxgC/ke0Kw33ik6Eri8W2r8ebHIbu9BIKJaA1arBZ2I9kTBXuxxpsLUBvgZY",
+	"Bd0t45kEqqzf0cuf3LO1TuTOuHlGW6/zYFYNo+QwZ7xmtYyXlU68gjCfO99ECIutCYjWHttcn4xhRNEL",
+	"Wvx0AVKyvG/jzOkR8zjtvIHEW1Bc34QCJNzI3QGYql+AmBei1s/Hzcz1b53hrO+30pTnVOZxc8ZJBlJT",
+	"ZsznG3W4qSpYHXYZq2gkCzWzHkVmKyRtC0ixcdbmaxqSAoD0iBalAZag8yUkrUBWMaRFj+GnC8MnYQla",
+	"0bUxHmL2gp4D4fL1o+kQm6GLZ0a5le6GrdvPY+rObZ8GKyk5RqQFzjpkiu3n/ifcSnyE/syZ3nryrYaz",
+	"nU7Ceurbg+mRyhd1eJEllu55LLP0ZGUzC4gXVX26JU97EG1i0qW/o1Xv2UX0r3DpY2IV+vACwE0XjsQN",
+	"4/QKE9Q3qC0BRLWLMeJaOUVUx+OtraiwSBm7LC176umsdt/fSz3gGUSDcme9OW1w0DHj7FM1eXtelkkp",
+	"ykk2xLfVFlvLLQAe0iaMPfQRmRB61h38blQoPxhTY7MO4b6Fo3vrIO6ylZXZNpVBn5Kph6M3DRhijrwM",
+	"j7BVrQkZq2LG/nHujd1NJVpgEoQSCVklUcl8STe7i+n2VNE4+/7088dPfn3y+RfENCA5W4Cqa7O0itHW",
+	"romMt7VGt+uM2FmeTm+Cz3qEn4P10odthk1xZ81yW1UnVe+U4t1HO524ABLHMVHh86C9wnHqoJSPa7tS",
+	"izz6jqVQcPN7Zvw/0rWxglyVML+kdisywJgXSAlSMaWB65b9lOnaKVstUbmI1Q8ubI47wTPw2mdHBUz3",
+	"+HKlFtLn04v8zHwizuZkMhAUjldZO9G2dbl3mtXvodCI7jZGByZKJ9qzOUlBhDE+soKgV3dqU9SnR266",
+	"gdlah90UITrn9zTpGY8PfAmLOdnO7Wszo2fUCU5vNjEhXvhDeQBp9lk3+vMlHcJJasPAR8M/EgmgjsY1",
+	"wnJvglck3wdbshqcdrwmQvKjQaB1E/0kyAMB6InnbwRdRyGOUY0FaW0MaI3w5ue2+PFDbZbeGZmCkPgO",
+	"O8CLY/HrdiGYwoHzgQsU/BCQEi3lXR8lNJa/K7zfs95wkURb5JQmWoOybEl0xcIooYN6HvIk9LxKOukU",
+	"pBCaCG50I4k0DFaPg2cqJhzGNcgLWtw+1/iWSaVPER+Qv+kP3IrD7mMkW1SqoycWfkUHgVXQ24WKv8bc",
+	"EH/vCQ4+5cTN4gz/nTsQVUK0sN7e82ABB+7jh9Gx6/EXZObKlpUSMqbaDgWXXqQJ0c4gjUUOpzAJf1uR",
+	"19cud/aL0Nc4DnPvD0R+jIxswXPAwVwf9Q/MnHo4QPK0pEi1QygJ/KV4nUnwOqzO1XVLXB2Wki5KQLtn",
+	"Srp4ZZggePDycB14eVUKuuscfOs3cJu48Ou1Dc25OLhSlilPOBuSGDFd1cp0x1yNRylvdf3iVreSqNGi",
+	"0o3hIEkSVi1y78q+1PKXjLJkNHfRiPvpncCAABOeJOb2UTCvuB0vFHLGWHHP1sV8HLwYBDfdnpG3/CFR",
+	"S+rfFu7PJ59/MRqPgFcrs/j6+2g8cl/fpV5q+ToZV1ongur4iLqqKPcUKelmSDD7ztRPSfzWma5uX6RR",
+	"ms3Sb7rvzZ7hw9UFILzkyOqRvdgb1OV/uktgtZUYWoc1nBhLknV6q7AVuzJd/dKX8tGWsOipWtTivqbA",
+	"0U5bfFxQymQKsEn2sMrSr67m5u1uu4egJ3eoW/p10thZxCTW2pg8mipKSjigsJTrlqj0Yw6jUcEzvTkz",
+	"+Pdqd/br+1Qys+9CejGXsy5Y4J3sq8V74N7HrE5GVikvXX8naIHSp3UM4EC0EMWUfGMrHblr8at7s/+A",
+	"z/7yNH/02eP/mP3l0eePMnj6+ZePHtEvn9LHX372GJ785fOnj+Dx/IsvZ0/yJ0+fzJ4+efrF519mnz19",
+	"PHv6xZf/cc9QugHZAuormD0b/Z/JabEQk9PXLyfnBtgaJ7RkJoPb1RVq2OaYYBORmuEVCyvKitEz/9P/",
+	"5y/KaSZW9fD+15Graztaal2qZycnl5eX07jLyQJzoEy0qLLliZ/natzC+OnrlyEuyPr+4Y7WNqfpqCaF",
+	"U/z25puzc3L6+uW0JpjRs9Gj6aPpYzO+KIHTko2ejT7Dn/D0LHHfT7AawIlyRcVOQujo1bjzrSxtyTHz",
+	"aRHSGZu/lkALvXR/rEBLlvlPEmi+cf9Xl3SxADnFiDH708WTE//2OPnd5ZW5MoAlnQ1sdamohpDrS8pq",
+	"VrDM5mpCAx9anWxQjz0edVIlpamulKkNWVCegQ8c4Dm6Rdq0K2o0HgWEv8wNom3/lzWzQzS6s6BGz/6R",
+	"0sp2wJt6IjU7ENFQyKtU8wjUwY8sj0TTeOB4hos9mnz57vfP/3KVdMbu+mXVDo1bv3YqfqzRRz74J9GC",
+	"IL+zl1WEVxOMCuQ3WhS/odOH79fwrhv3eUWO63w+2KHGq41MCV+j7nUbN7drNKFlqSb4VTVgCVGydU+0",
+	"a3bHVuS+Jx3sRBtVIdWD9oQG5oOmxI7tyRADdpofqkKzwCOV57LITCcKzKhmnPswXUzHSQyM00A+wDTL",
+	"8MztmMHxb1xw+M1MwYV2s8zQ88xm4MfQPAtHs+yV7Wgr75UF5haf00KBI/R/ViA3NaU71Ixiyg5XqJe1",
+	"aVGYHoKbdolVxb/G60oK5F0/gg2yTnOQEyT/vA4SvYxSnYd6BLXnuskMTYQkTgf62lh8fICsD5auA8Tj",
+	"WGnTczpKI8gJOjF+PFpcpO1KLcpm+ZigMnk3HnlAEaFPHj3yd5rTD0W0fOL4cDTToGJ5V+PGKB6cAwbq",
+	"3n3205tQ/EHS0vJv98U+9ZwjgW00NZv69IgLbZaouPZy28N1Fv01zYl0GThwKY8/2aW85DZkwcgwVta6",
+	"Go8+/4T35iV3SROxpRXW8Bx3hZOf+XsuLrlvaThNtVpRuUEpWgdhoF3llS4Ueu+gjGDZXpQ0mC9G7656",
+	"JaWT+NY6+b2RqjC/lhxlzfmNi2+3aNVzveNYNnja/XD/tCwxNOEsfD8ty9f2yjLmdGDIeWHNlDY34Xdx",
+	"74YV3kJijfCN2DWHI59os+mUhdeHtbUn5bxGXp0/lch32lRZsxy4NjG1sm8dDZrbupzBpUYTMR7bP99d",
+	"4jHVdOJpo5yE+8YOhSJUkRi2xxj2SG9JolMnoDRP3ajgeuxRzBSRUMAF3TvtZEvrYoFI1oXYeY/coXV/",
+	"tPYJeNFSgqxnG87gti4VX+8i3IGNy+4Gr5xPXFz9gRaGhKLltmrRvnxxJ8b+qcTYkLt7YeXKsjyCYOuD",
+	"H3c1OfndJZc+hrzrdC8DJN1YsRX1jRQ991sc58GUnLbbHMZWXMbunTKsDcb800mviOTdcqujmuNKrI34",
+	"110N7qTWfvEqDuHeJ6K6IVOZ3wd1/uOKqXd43EsuNYvYLZEewPw70qa7am7sUvhDSpkOaXfy5Z9avgwF",
+	"P64lYcbBLScuS1Ekb15LsdpWnDId5Mj4U4PpYToyzNdjj/C4DuRDGyhGKLnYJDX2T1/zyb2K7WaNOw/j",
+	"roD4HcQv8K83L18MkQ0/Na3gjRrD6p7J6yS9yTfNlJOmpTe3Y1oaxuSePnp6exDEu2CqCn/rQwY+v809",
+	"OCZvTJPVvrxwG2s7mYn1LvbGW/wtZMI1h7/B7EIu9HH03bS2Tl/3MTnIjCr44ql/vzyYkq9d0zrdmHOT",
+	"XQha1EHlVC5sJ8M0DTLIPf/nMxz/3pR8i6kStBqjp7oZwzZkXD97/OSzp66JKfqB3s3tdrMvnj47/eor",
+	"16yUjGt0E7LPnk5zpeWzJRSFcB3cZdMd13x49n/+6/9Op9N7O/mzWH+9+dHw1T8gkx6ncjQHSurb9k98",
+	"t1OPb243uH8LbtPX42uxTl4nYn13nX2w68xg/w9xjc2aZOSexkF53KiCeMRrDdS+F9vYXWQYOBpuJeM7",
+	"5+o6VwWVNicdJv1XZFFRSbkGo4dzlIpR/8qV6CwYpiuSRIE01bcUC3U3KgkhcVop4cI0jNLSNyDYfWOA",
+	"+lPcFj/QdRRIMQuCgxYOd6gOXdE1YcpVRNVjmzx2Tb76ijwa1w8zkw9MrCcBwykuvaLrUYIp7wrTSf16",
+	"XIVpoO+h2Q9fODwKuTtWAcceokarJbeQhLt+Jv3ZL4tP9tVhD4bb2CMx671td7VtLlam4I871ChWltRY",
+	"MkJVZVls6mIBtKiltjRXNTMM1ZB8KpanG9WMmHmSr/H2Xt1xhDttyLX4Upug9uRBGHSrTn5HBUXMgDpM",
+	"AANSdzIAZ9iy4kjP2ZcuF8HxDn7Ig7HlW2+GrxCgEudDIfcxSANz9Im5i0k2MlMG0jC2jGp44MvSW35r",
+	"Uy3VHvlp4ckOPzGTpoSoqBLSnWW8X9BDWuzWzYg3MKc29dKQirVRXg20+YJMHMWfShf9FZFAKBTn81gj",
+	"MQV6wPeOV4HYQGhDRlqEhDClyww6GMrn9eRdGbUQDewfbjK/Q/B+CO6w+G/scXM8xS3ijxCk4x/0E/Kj",
+	"qJMKWX7/hzRJ36R8ctML+lFwsL4X5jFgafHOzB6Ep/rS9zno7JOurth6qCB14vN8bJWmTNqPT1eiuoEr",
+	"/ftkdpTGrWMQO92ZKKsebQiz9ulXaEMEnH7It9kH4a8f4YPtQ3Cw22E5Nk+TkNFPgh+XCWGaR0vMJyFJ",
+	"Uh9HemUaR3KazVr1p+VO2wgmjaoE4YQUVDSRcnP6JzzOz105Pe0TkiFZEsV4BkSJFeCrwojxrlqJhfAv",
+	"twehZsbfUlSYKzWKSP/ADOfzR5/d3vRnIC9YBuQcVqWQVLJiQ37moWzedRigItTteaxD7x4OwjiaBZvp",
+	"aLM45+U1+KJYbDGDOm1/nVDbZXoRlQZpUym3qqOyDt9OadGRYbwyU9+JfNjbb8PQkiDPaVEg/nbZ6nDg",
+	"QR7vRWE3GFZMa8gTOzkl3xj/LL/Z41r3FopI+0o041buchzZVRS26Tp8uhoSrSbScICEucDqoCDBKxdX",
+	"Pv9N3CdUWceqkwlPNEuscebDly/86qxZXczrodsErUVj8Ck5DZ9wZi7s4qgEZOaxAjTWSU4bQFMZu/JH",
+	"VTNd7U+XFpvJVp7y2uupLIHKurNlGPdLCRM3hKQXIBXF09ta1IM7cf7jEOfXrjDGRyLMJ02912X+h99N",
+	"hkUKBXJyGVJ5911TyhWvcOuxHXyADA7i1+FfHPk4lOGwpmDbZ0yoe5m4dHD2/qELCbZ8vs8iq6CATDey",
+	"tdorWQOoMaGG3WVCNjSzXOQQWuV1X1vLEr/aTriclS3spZXNoP740aNHtrma9t6pr91qQ07ru9vVKFTs",
+	"Xk62BKE1t/tyKUy2KUSiclvtNqze5AZF3VPRK3UfHHj6TIMlJFswbms3dckYycxV9NwgpUTmgmT26p1h",
+	"ePHwe68FD1J0WruT2KPWPqdUk8YWDUpVfTUebZupPUedyN/i8bA5kwGF0SaOW7QWYGwhZ4hEdj6Ao9Ga",
+	"n93p6D6KS73eHl8sIr4KGkquT115t+uIifkNKvQa4Xq/6zXLr3Yr9jqZ6P84PhznrUzyL1/EIdUipGL2",
+	"SoeexRhE7pnF4d9TJojbTsuf9C+pU553/TSG5e+/cz0ZzH06Z2ubErivzsNtX2F1WHl80Ilo6ws+6FWm",
+	"P9T7dNJ6oDbR8uGuLzAtx5FvbymFFpko8EwZn14hdagSoaaDbjroewM3lLT9BUqucZWtWa52WsjPsdXd",
+	"i642kZ97vKVs5M3zq1LlroeWZqjnGiS2i5JYZWgLhA/K6O5k9RSDa5nTP3WBXPeS3pGN6xnV2bIqT37H",
+	"/2Bpiqs6VwaW+lQnes1PFlKYZlsDOpDHFqbojbRVQhv2sHglOFpS+fUKu9cVSb8VMpJHvjP9drPOJtLG",
+	"bSkAZycvX6SZ6s2IzXfSZp/fQWvDr+9tlxixc179WY7L2wfajercOgo2VvsCUiR85x36cS2odsaYM6Pu",
+	"j7ax9agWsmYEN+yQcdOL/hD+HbfvEvv5J3zOTFzWS1MVawVcQ3698CjS5nD+9th63e4nGLirvxtD1b3z",
+	"4xvfh5EGWWTnBf8H0tzd3fEf1R3/PPisxAR6d2N/Oje29Ifw7nL++C/nzz7Z1dyga+jAy/oAF5vmBV2/",
+	"0fe8qjtigtNutVQK2wxw+Chvr1J9K6Svz353v//hgpXtHg92dB2i1dmlvXVTHiMS+KOCfphuwjj1drQT",
+	"fUd4HHxpGeZWFhnDepIvczW2x9spNNz5vhOJPmqRKNrrO4noTl3xiakreuQfpykoiiEiyL6i0cVK5OCt",
+	"s2I+d2UOtjsd+0LrhjyVpquS2J79TrrnbAVnpuVPdoqjXrE12C2zZAs8gywFmeC52unn6VPUtS4nN9Wh",
+	"l5NBnu6H6tZNpGFbPCwuP+D0YDp+E6U97pAHae+IrefsCz04ZORwQQxVTo9Ayye/239RL1cKlVjNGeg0",
+	"uOS+2xZbucKO2wCQvEbJ1JbA8L3EnDyyBSwqrtBKyZRL9oze93JDtAjZcSXQgmSNLAQBju5xOus9Tltf",
+	"Duep1fWsKf2sEPWxvfa74qCckK1cMX+79aPy3FYFxx1to1ILQgmHBdXsAryXwfQu5eLBl6FLeLiFVY4J",
+	"zW3MSLQJcAFyQ1Q1U0ZU4s2Y0nuqebL2YC2wLkEyc8PTorb521fGic2nuM2X6cy2uOad1+JaOCaRUEpQ",
+	"ZpMaF7OFybCiH1gmxWmxECFUSW2UhtVo3LpEXddfe8IlvIZiL42B4AXjMFkJDpvEIcavP+DHwSzDhhT0",
+	"jHhuPu41YOt6byKhtYDm5ENEgOtu0kfCQq7loNNarYRSSG2jd8xne4j2PI/+5G141j2OG55Fxjj3MRpI",
+	"8J6fT7y/eF0hp6/l740/XfJW11ItK52Ly2gW1ENYv8whqRbxAXCXf6OXiCP8pM5c+BoE8EtJS3v06o/W",
+	"Mx9fT3XOxj9zRg5nUorzLbh49guQqvXIvEvL8YdKyzF43/fi0mbISu3idJU6rmD0o8jBjlunYjBHP1VM",
+	"DcOPlQeiJQ8FN890sKW/1+p2Fm9MkRlg8m1amQixqiRapOJS644TmlnWPLHvsfSEUY5/bGWnW9ILILSQ",
+	"QHPzhgZOxMwsur5hcZFUYbkGH5/mnFmHi10RsKUUGSgF+cRXlNsFr29nY+n1FuThanAVYRaiBJlTeTMr",
+	"eH+xE/j3sJng612R+3/7RT34WBZhZdHtW4BtUhvRztjRXco1YNpGxG2IYlK2CULsScDoOGH0qhp6IDwC",
+	"9nq3vw1mhwhuCIEXIE3a/Js9Wn6SGyDKAP8NH6wbWUJVToyc0YX7uf1qlG5mvznlwitsd8wQJiio0pNd",
+	"V4ppFC9amaVGXDx1i+DAPW/2V1RplMcJ47m5P10ZX5wH++AU+77qcUojHNinVGLSX+zH1LSZ4Aq4qhRx",
+	"I/jYNchTy+Ow3jLXj7AOc4l5NHYIjrOa1l0j9yEwGt/hMarnR6gO1ZuBmOESi0M9MHXqn72w3ICvxtE2",
+	"GM98qwjxsftFD4xM1XtgyY2pFr2FvPTjkdKiLA2H0pOKh359GDyzrU/1z3XbLkm6/DNmTpILUHFMo4P8",
+	"0iJdoQ59SRVxcJAVfe/CHheuHH8XZnOsJ5hlcLLtvKBW3bSKD85Bx70qF5LmMMmhoAk91c/2M7Gf9yQM",
+	"PzYSiCf0yYXQMJlhArE0jdRnQh6iyguzCpwqwd1/FAS/kIwqa12oSc31PnzSHHDaFN90xHovzIJgJOnA",
+	"j4fIsvTUo0Q0Yxiyso3satytdM219GAvzHojCMRxJ7UGqD37f4Fyc/s2x51/A6pv4fXUx1p2W6cb3+2N",
+	"C7N1lbVum+QV0cuXdzDGPh6U0iJ/kmajthPdDcZ9NrXo0Rt+eoh+4uSSMm2KQNh3y4TONcid0Rx/p8z7",
+	"ZTgjkxYuQSHBEZyM4MbBWyuuCOw4lgXB518zJOISQZpLmZLHZMV4pe0XUemxzasmgWZLyBtocCMxVedY",
+	"lLCgMi9AYdouLwgIaXM26pYwg0AnQmSbShuz7m+F/MSrAb270zjdaZzuNE53Gqc7jdOdxulO43SncbrT",
+	"ON1pnO40TncapzuN053G6c+qcfpQmdkmXkLzuU+54JO2M/WdL/UfqgpQuHu9Agy1T0YTZ1hglBilXy+1",
+	"h6JPAy0QB6yA/jgQ63R+/s3pK6JEJTMgmYGQcVIWlHGiYa3HTrtGZlTBF099pLKVBeiKzDYarMBgGnz2",
+	"hJx9f+pz9y5dmcFm2/un1tWUKL0p4IGrdAs8twK5L3kL3CDd1VWg/vrJXJi11THNWYExNIp8g61fmLR4",
+	"ogRpE6piveuuRu8caPHc4WaHQu/vZnLnav+bGe23cUOp6dC2omWUFxvXShWhNmCbvIhCuH+b00LBb31R",
+	"3Ha8FS23l8p+Z7kvKP21yDetE2J27QQ3sHk2Qt77GeNUbhKJ6brBUm3S0MKwK0dYXSXm1VGD3JbJ4phd",
+	"MttFYckSCVilKD16H5Wnxqk3rDOUjfOft+hklApRj6/Spa2R6gAclIsUA6rsnpA3tt8Hvd8IQuSOWM3M",
+	"PxpH42bLwDSwLRfas55PNZbIIz55evHsjw1h51UGWE7EUdyA68VIhGakBfCJY0CTmcg3kwb7GjVuoZwp",
+	"qhSsZrtvoph/4okLl49eJpbTuKc+zDXyIlrcNp4cE8164hhwD3feaBjMmwO2cETHniOM3zSL7mOjMQjE",
+	"8aeUbq3F+/ZlevU0mzvGd8f4otPYkggYdxX+2kxkeoOMT25kxft53jdryCoDXHyS76PdA62qRp8UG9Fz",
+	"mFWLhXktdM2stlCLGY8J/oFYoV3uUC64HwXZwd/4MJjr5rhoD9flLlHaifs+GewD3A7KN2gRWpWUb8xu",
+	"YBzJRLFVVVgc5lTT6ei4jNbWLUhlta+1k30a/NeuRayMdldt83eLFqxlZPcXclLx3AUrtifWaz48TZId",
+	"+nzNaza9NSWSXW9idW7eIVeE3+VmUgpFSpATveb2QDUOE1rHKLEn94Om77+7Nm7v2rApLaCHwXYrgtQM",
+	"4Ui3h4z4Gl4f9WSqjqmNfz2hzUjgxjfUaPRHocUlfGzLo/oGdYZvugjV6hZnb4aiJJRkBUNrtOBKyyrT",
+	"bzlFg1S0sGnXfcjrsPt533PfJG0uTVgz3VBvOUUnsmCmSvLAOSTMJd8CeBarqsUClOGjMQHNAd5y14px",
+	"UnGmca4Vy6SY2Kh4c76M7DK1LU1t5DkmRBLkXyAFmVU6HlNZXbLSxhZq/ZXMNETM33KqSQFUafIDMxzY",
+	"DOcTrwSXQtCXQr4PWJgON+svgINiapLW1nxnv35vNIEOJ14raP7vOtf1ddrPoLqiwv+7/5/PTFUFOvnX",
+	"o8mX/37y7venVw8edn58cvXVV//T/Omzq68e/Oe/pbbPw87yXshNFWlFKGaFL5iKa2a3Yf8Y/AZWjE+S",
+	"RGl8H5xfYZsWyX1MOekI7kHTPKWX8Jab21ILgjcE1Uckn7YZqXOg7RFrUVlj41rWJo+AQW/Io7AqkuBU",
+	"d7abP1CoeEQH3nKKG2/rgrT2fk87TePeBiz/3ner26+uCmZPI/cKaWjaWvm0XIvzBshbjSCffmrb4z9I",
+	"PRqP9iTtDpgsFdy48rUgfsPHhBaCL2xuV/NEFbhPjJeVxiiBm9QCwgUtJuICpGQ5qIErZYJ/c0GLn0K3",
+	"q/HIqDAmWtIMJlYtMRRr56aPpVMzDuNMM1pM8Gk+FCB4aXud2U477u+6mDZbrSBnVEOxIaWEDHKb95Ap",
+	"UisFpjYRC8mWlC/wqpeiWixtM1eOHCSEOqnmHd4eYu9C3Ws+sTkzu+CfEqtrjROOmxiLRC0svPsuaQAF",
+	"8kaZvYHb08iI3KcEGI96BXmD74vaDdHircmBDpU6GvJDhLQammPklb47JHeH5M92SFIZYhGf85ZKxSIx",
+	"3sYb1r3ddJLkW1TlfZAM6ncFSv7oBUo8W1KEEkkbb5x0zUyqCNPkEtOrzYCY+65CE4IrROqUBBjuGR11",
+	"lzhYubKl2ZIy7nJzhWAVhEOTTKxWTGtfx/tGtK+WmaHa1aADskoyvcFXES3Zr+/B/P+deVYokBf+wVTJ",
+	"YvRstNS6fHZyUoiMFkuh9AnWCam/qdbHdwH+3/1bp5TsgmrAb+uJkGzBuLmjL+liAbLWc46eTB+Nrv7/",
+	"AQCiCMtUhuYBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -249,235 +249,249 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3Mbt5Io/lVQ3K3yY0lKduzsiX91an9ynORo48QuS8neXcs3AWeaJI6GwATASGR8",
-	"/d1vofEYzAyGHFK0ndTNX7Y4eDQajUajn+9HmViVggPXavTs/aikkq5Ag8S/aJ5LUPjfHFQmWamZ4KNn",
-	"ozNOaJaJimtSVrOCZeQaNtPReMTM15Lq5Wg84nQFo2dhkPFIwm8Vk5CPnmlZwXiksiWsqJ1Wa5Cm79uz",
-	"yf+cTr569/7p3z6MxiO9Kc0YSkvGF6PxaD1ZiIn7cUYVy9T0zI3/YddXWpYFy6hZwoTl6UXVTQjLgWs2",
-	"ZyD7FtYcb9v6VoyzVbUaPTsNS2JcwwJkz5rK8pznsO5bVPSZKgW6dz3m44CV+DGOugYz6NZVNBpkVGfL",
-	"UjCuEysh+JXYz8klRN23LWIu5IrqdvuI/JD2Ho0fnX74l0CKj8ZPv0gTIy0WQlKeT8K4X4dxyYVt92GP",
-	"hv5rGwFfCz5ni0qCIrdL0EuQRC+BSFCl4AqImP0TMk2YIv958epHIiT5AZSiC3hNs2sCPBM55FNyPidc",
-	"aFJKccNyyMckhzmtCq2IFtgz0MdvFchNjV0HV4xJ4IYW3o7+qQQfjUcrtShpdj1610bThw/jUcFWLLGq",
-	"H+jaUBTh1WoGkoi5WZAHR4KuJO8DyI4Yw7OVJCvG9ZdP2nRY/7qi6y54l7LiGdWQRwBqSbmimWmBUOZM",
-	"lQXdIGpXdP3307EDXBFaFKQEnjO+IHrNVd9SzNxHWwiHdQLRl0sg5gsp6QIiPE/JTwqQkvCrFtfAA3WQ",
-	"2QY/lRJumKhU6NSzDpw6sZCIDqSoeIpREfzg0NzDo2zfYzKoNzjih+3fFFu4T22oL9jiclMCmbPC3Jfk",
-	"n5XSgYArhdu+BKJKyAzvzYkZxiBfsQWnupLw7Io/NH+RCbnQlOdU5uaXlf3ph6rQ7IItzE+F/emlWLDs",
-	"gi16diDAmjqnCrut7D9mvPRR1evkXfJSiOuqjBeUxWfB0Mr5iz7KsGP2k0aaQZ4FuQH3x411uT5/0cdS",
-	"t/fQ67CRPUD24q6kpuE1bCQYaGk2x3/WcyQtOpe/j6x4YXrrcp5CrSF/x65RoDqz8tNZLUS8cZ/N10xw",
-	"DfYqjMSME2S2z97HkpMUJUjN7KC0LCeFyGgxUZpqHOlfJcxHz0b/clILeie2uzqJJn9pel1gJ3MZSzCM",
-	"b0LLco8xXhvhEUWtnoNu+JA96nMhye2SZUuil0wRxu0motxlOE0BN5Tr6Wivk/wh5g5vHRD1VthL0m5F",
-	"iwH17gWxDWegkPad0HtPNSRFxDhBjBPKc7IoxCz8cP+sLGvk4vezsrSoGhM2J8DwPoc1U1o9QMzQ+pDF",
-	"85y/mJLv4rFvWVEQwYsNmYG7dyA3Y1q+7fi4E8ANYnEN9Yj3FMGdFnJqds2jwchlxyBGlCqXojBX4E4y",
-	"Mo3/4drGFGh+H9T5T099Mdr76Q4leodUpCb7S/1wI/dbRNWlKexhqOms3fcwijKjbKEldV4j+Nh0hb8w",
-	"DSu1k0giiCJCc9tDpaQbL0FNUBLqUtBPCizxlHTBOEI7NgI5Jyt6bfdDIN4NIYAKkrYlMyte3TK9rEWu",
-	"gPpp533x5ybk1J4Ts+GUGdmYFExpIwzhZiqyhAIFThoUCzEVHUQ0A2hhyyICzLeSlpbM3RcrxzFOaHh/",
-	"WVjveJMPvGSTMMdqixrvCNXBzHwnw01CYhUOTRieFyK7/gdVyyMc/pkfq3sscBqyBJqDJEuqlokz1aLt",
-	"erQh9G0aIs2SWTTVNCzxpVioIyyxEPtwtbL8mhaFmbrLzVqrxYEHHeSiIKYxgRXT5gHMOJ6ABbsBblnP",
-	"lHxDs6URJkhGi2Jc6yVEOSngBgoiJGGcgxwTvaS6Pvw4sn8o4TlSYPigBhKtxuk0puRyCRLmQuJDVQJZ",
-	"UbycVuZ5VBbNPoG5KrqCluyEl6WotIExermcv/CrgxvgyJPC0Ah+WCM++OPBp2Zu9wln5sIujkpARQvj",
-	"WVHlNf4Cv2gAbVrXVy2vpxAyR0UP1eY3JkkmpB3CXv5ucvMfoLLubKnzfilh4oaQ9AakooVZXWtRDwL5",
-	"Hut07jiZOdU0OpmOCtMvOss5sB8KhSAT2o1X+B9aEPPZCDiGkmrqYSinoEwT9gPvbIMqO5NpYPiWFmRl",
-	"9WakpNn1XlB+XU+eZjODTt43VlXnttAtIuzQ5Zrl6ljbhIP17VXzhFidj2dHHTFlK9OJ5hqCgEtREss+",
-	"WiBYToGjWYSI9dGvtedinYLpuVh3rjSxhqPshBlnMLN/LtYvHGRC7sY8jj0E6WaBnK5A4e3WMIOYWWpV",
-	"9dlMyMOkiY5polbAE2pGjYSpcQtJ2LQqJ+5sJtTjtkFrIBLUS9uFgPbwKYw1sHCh6UfAgjKjHgMLzYGO",
-	"jQWxKlkBRyD9ZVKIm1EFXzwmF/84e/ro8S+Pn35pSLKUYiHpisw2GhS57/R8ROlNAQ+SDyeULtKjf/nE",
-	"G0Sa46bGUaKSGaxo2R3KGlrsw9g2I6ZdF2tNNOOqA4CDOCKYq82inbyx/T6MRy9gVi0uQGvzCH4txfzo",
-	"3LAzQwo6bPS6lEawUE2jlJOWTnLT5ATWWtKTElsCz63pzayDKfMGXM2OQlR9G5/Xs+TEYTSHnYdi322q",
-	"p9nEWyU3sjqG5gOkFDJ5BZdSaJGJYmLkPCYSuovXrgVxLfx2le3fLbTklipi5kYDWMXzHhWFXvPh95cd",
-	"+nLNa9xsvcHsehOrc/MO2Zcm8utXSAlyotecIHU2NCdzKVaEkhw7oqzxHWgrf7EVXGi6Kl/N58fRkQoc",
-	"KKHiYStQZiZiWxjpR0EmeK52anO8NbCFTDfVEJy1seVtWbofKoemiw3PUI10jLPcr/1ypj6iNjyLVGEG",
-	"xgLyRYNWP6rKqw9TFop7KgGpwdRL/IwWgRdQaPqtkJe1uPudFFV5dHbennPocqhbjLM55Kav1ygzviig",
-	"IakvDOzT1Bo/y4K+DkoHuwaEHon1JVssdfS+fC3FR7hDk7OkAMUPVrlUmD5dFdOPIjfMR1fqCKJnPVjN",
-	"EQ3dxnyQzkSlCSVc5ICbX6m0UNrjtWMOalZJCVzHci7qM5giMzDUldHKrLYqiRap+6XuOKGZPaETRI3q",
-	"cXMIrhq2lZ1uSW+A0EICzTdkBsCJmJlF114OuEiqSGlkZyfWOZF4KL9tAFtKkYFSkE+cPnsnvL6dvX/0",
-	"FuThanAVYRaiBJlT+XFWcH2zE/hr2ExuaFEZ8fz7n9WDP8oitNC02LEF2Ca1EW31XXcpd4BpGxG3IYpJ",
-	"2WoL7UkwIrZhOgVo6EP23bHXu/1tMDtE8JEQeAMSPWo+6tHyk3wEogzwf+SD9VGWUJUTIwb2qh+M5Gr2",
-	"m1MuvGy4Y4YwQUGVnuy6Ukyjht7ELDXi4qlbBAfukSdfUqVRDCSM56i/tVchzmNlSzPFaE+nMpyy9zVm",
-	"Jv3ZP8S602bmeueqUuFVpqqyFFJDnloe2qx75/oR1mEuMY/GDk8/LUilYNfIfQiMxnd4dIoA/IPqYKF2",
-	"Nu/u4tDrwIgvm32x3ICvxtE2GC98qwjxsVNtD4xM1XtgyY2pFr3NhCiAospUaVGWhkPpScVDvz4MXtjW",
-	"Z/qnum2XJK0ZyEoquQCFJibX3kF+a5Gu0Na1pIo4OLx/Aiq8rItcF2ZzrCeK8Qwm284LPoJNq/jgHHTc",
-	"q3IhaQ6THAq6SXhb2M/Eft6TMPzYSCC1/kBomMzQmpimkfpMeH/Tw2YVOJVKCd4Ev5DMnHPzjKpJzfU+",
-	"fNIccNoU33TEei/MgmAk6cCPh8iy9JQYEe/+G6ENWTmiw9W4W+mOa+nBXpj1oyAQx53UioD27P8Nys0d",
-	"BLCjzr8B1bfweupjLbtH/Y93e+PCbF1lrdsmeUX08uUdjLGPB/XYIl5TqVnGSnyufg+bo7/e2xMkfSVI",
-	"DpqyAnISfbAv+TLuT6wbcnvMw17zg9StXfA7+tbEcrxnVhP4a9ig2uS1jWiItFXHUEckRjUXLuUEAfVe",
-	"8+bFEzeBNc10sTGCrV7ChtyCBKKqmfVa6ZrQtCgn8QDpmKn+GZ1BPmkO3+ohcIFDRctLeR7a19Z2+C5b",
-	"T64GOtwrqxSiSOg/2ye+g4wkBIPchUgpzK4zWhQbokPYjKekBpDugkBvjCDP3FMNNOMKyH+LimSU4wu3",
-	"0hCENCFR8kFh2cxgxM0wp3NVrTEEBazAvubxy8OH7YU/fOj2nCkyh1vrcsOxYRsdDx+iKu61ULpxuI6g",
-	"7TbH7Txx6aCt0lyy7tXW5im7ndzcyEN28nVr8GDgNGdKKUe4Zvl3ZgCtk7kesvaYRoY5+OG4g8x3TZew",
-	"zrpx3y/YqiqoPoahEm5oMRE3ICXLYScndxMzwb+5ocWr0O3DeARryAyNZjDJMEpw4FhwafrYwEIzDuPM",
-	"HGAbODIUIDi3vS5spx0v7dpvma1WkDOqodiQUkIGNkrOSKkqLHVKbMhEtqR8gS8gKaqFc3W24yDDr5TV",
-	"hMmKd4bYVxTTaz5BE4ZKhqmh2dJHWxohDKh52bbtH/axdksDKPYyGnRpR9vTtgclTabjUe/D3+D7pn74",
-	"W7w1Q0YPNSY25MMIaTU0A61niE8jK3WRGG+jOXyGGD6OlaYeOgVld+LIKbz+2OcXflGVZbE5gpBkByIS",
-	"SgkKr7RYDajsVzEnP7BMirNiIcKdpzZKw6prvLFdf+k5rm8OeQELXjAOk5XgkHjSv8KvP+DHwWpHew33",
-	"jIgC0V4Dth8+DSS0FtCcfAhJ33WTkGTaZ79t6VTfCnksK7sdcPCbYoDleqdbh5vyUPs6LYqESdqqHzpc",
-	"RI2DUziThColMoaC4nmuxs773FqxrVt7C/2vQ2jUEQ5we9yW7TUKw7KKfChKQklWMFTzC660rDJ9xSlq",
-	"+qKlJpwFvXKgXy38tW+S1kMn1MRuqCtO0VE06P+SjkFzSOihvgXw2mFVLRagdOuBNQe44q4V46TiTONc",
-	"K3NcJva8lCDRY29qW67ohswNTWhBfgcpyKzSzSfHqlKaKM2KwhmCzTREzK841aQAqjT5gfHLNQ7n/Uj8",
-	"keWgb4W8DliYDmdcC+CgmJqkPR2/s18xqMThZOkCTDDWwn72Hs91boiRWXsjacX/vv8fz96eTf6HTn4/",
-	"nXz1byfv3j/58OBh58fHH/7+9//T/OmLD39/8B//mto+D3sqGNxBfv7CvdHPX+BDLIoTacP+RzDIrBif",
-	"JIkydihq0SK5j/kyHME9aOr99BKuuF5zQ3g3tGC54UVHI5/2NdU50PaItaissXEtNZ5HwJ7PoTuwKpLg",
-	"VC3++lHkufYEWx1u4i1vxRg4zqiODqAbOAVXe86UW+297765JCeOENQ9JBY3dJRaIPGCcRGMDS8fs0tx",
-	"YNcVv+IvYI7vQcGfXfGcanpiT9NJpUA+pwXlGUwXgjzzQZEvqKZXvHMN9SaQioKaowxSKU5BV+m1XF29",
-	"pcVCXF296/ghdGUrN1XMRd0566rJ/JQTIzeISk9cEpeJhFsqU7YQn+LDRUNj761wWJlEVFaJ5ZPEuPGn",
-	"Q6EsS9VO9tBFUVkWBkURqSqXr8BsK1FahMAxw8xd7K2hgR+FcyqR9NY/eSsFivy6ouVbxvU7MrmqTk+/",
-	"wBC8OsXBr44HGrrdlDD44dubjKL93sWFW7kcnconJV2kbCZXV2810BIpBAWOFb40i4Jgt0Z4oI8EwKHq",
-	"BYRY5D22xEK2d1wvLvfC9vJpvdKLwk+4qc3Y6TvtYBQVf/AG7oisp5VeTgxHSK5KmWPg98onGKALc+V4",
-	"DwLFFvgAUEtRmSUDyZaQXbvMVrAq9Wbc6O4dXdxd7BkOU6gzcsGBc2bwl1FuBqzKnDpBhvJNO8WNssEQ",
-	"OOgbuIbNpbDdpwOzg0XZ6KIUK6rv6CLtRnetId/4ILsx2pvv/K58jKhLR4Jxl54sngW68H36j7YVAI5w",
-	"rFNE0cjz0YcIKhOIsMTfg4IDFmrGuxPpp5bHeAZcsxuYQMEWbFYk2PR/de0aHlZDlRIyYDc+qjcMqAib",
-	"E/M6mtnr2L2YJOULMJe6uYiFogU67U+Thn6UDpdApZ4B1Vv1tTxOM+GhQ4H8FoOmUWkyNkuAtdlvplEJ",
-	"wuHWPPDw7W3bOEfi6UHuVHZNkB8Iqu9eB0lPD3lEOIQn8tn5+z7sSXgvOP+0mDoRZPt9ZXC4kOLW7KYB",
-	"UPjUjZjgJbqnKkUXMPQ6apiKBqbEaFiAcJBd0k9S3hHztljTkTEGLsJ2nxi8JLkDmC+GPaAZoOXi6Oe2",
-	"JkRnVXjFi41H6qxAgTo4iFrSobJhZ+OL/YBNszGQvBZWPWBNrMVHf0mVP/r5OOLoB0qLnyeVzLb8eeeR",
-	"9x3V3ex4/ppus/ax1efMgAhuevgsej51ns+XNxrvlftuPHIhDqm9Exyl6BwKWFic2Maezur8TPVuGjhe",
-	"zefI9CYpR75IGRlJJm4OMA+xh4RYjTkZPELqFERgo2UdByY/iviw88U+QHKXX4r6sfHuiv6GdLCg9cY3",
-	"UrIoza3PeqxWmWcpLr1FLfK0XJxxGML4mBhOekMLw0ld4Gk9SCdXG759WpnZnG/Hg7430cCD5taI0sle",
-	"q7TyzCHriwVvv4z0q2CvNczEemIjo5NPq9l6Zs5EMl4B47RTh9dmzrunyEys0acIbzjr4L43dP2QecAi",
-	"N5A1U0jl2K9PbLTg7QfIdkE+Rc0KSc/p1QLZ9UmyhwHTI073kd39KIXekUBqKTDrNOBOo7NTz9KUtrqS",
-	"SH3djkN22BCmlmI1fYczuZM9GO0qT5u57v5RpzvsT47mz+onSfLXVcrdJS+j7VzaXIv7pGVsk0MDiC1Y",
-	"fd0WYpNobTouNfEaYS3Fkgyj7xq7umhTUABqAiYNuXpynTJLX129VYAyw4XvFuk5cfco3zyIvOEkLJjS",
-	"UBsXvJPLp7f9oDrRPLbEvH91upRzs743QgRBw5pjsWNjmZ98Bei6PmdS6QlaZpJLMI2+VahJ+9Y0TQvC",
-	"TX87pqypZ285GCG6hs0kZ0WVJmUH0vcvDEQ/hptLVTO8KBm33kYzTIWfdNDdwzaJ8FjH7q0IemkR9JJ+",
-	"CvwMO1imqYFJGsprTv8nOWItXriNsyRoOUVM3Q3tRekWXhvF0ncZbSRER24X0202n865zP3YO72xfER/",
-	"nxBhR0quJcqImA4gFIsF5D7TmwsKtVmvXD69QvBFnUvQ/L4lfeCU2Cx+mIRvS/4+554Ofc7pjXIiWBUj",
-	"CX38mEHI6+g6zD2IkyyA28wto/3rjRRJxMWO8dgi0ox+Wt7ecZtPug5fttyFa59eu4dhs3F7CqC5e1Yp",
-	"8Ovbfmi72+VQN+5zOm6kiN1+wHBApDimVSTAdIimh3PTsmT5umX4s6NODyCJgeJeNxN8C2dsJ1qa/sQ7",
-	"SvTcM5citnc2jhN83Z+Yt6V1Y3aOuOZI0MwlGcgriUakhpNwN41+eF8OXPL3P19oIekCnCFwYkG60xC4",
-	"nH3QEGWiV0Qz6xeds/kcYgOYOsR40wCuY+bIB9BzD+V1rWThSbmVLPemrXoFuxGapqcEpfS5Wlx2zZD+",
-	"vRGp1MIdE23cAbbEZB6B72Ez+ZkWlXkAMalql1RnF2ze5nvQxM3qe9jgyDs9PQ1gO3YFNXBvACk0ZVQJ",
-	"n1SUHPyeahRdwKdvYwv32Kmz9C4daWtcBY3+o1FfTI0yEs2lfLxjU3vGGEiH7NVF2tnEnC1obkub0Hdt",
-	"Ect3izzRyyOeiqHTxiF3W0iwsdOpDGjhCR8XO/owHt3NzaPLwsKIO3bidbiRk7uATpjW7N/w9dpzQ2hZ",
-	"SnFDi4lzj+mTNaS4cbIGNvfeNJ/4WZU+FZffnL187cD/MB5lBVA5CRqO3lVhu/JPsypbeWP7NWSzsDuV",
-	"rtWARZsfMmXHDjS3mHG9pUTrlLip3aWig+ocauZpB/GdfNN5dtklbvHwgjI4eNWGaOvf1fTpojeUFd7e",
-	"66Edqly3yx1WVCnJJ+IB7uwbFjn93XksxX6HCXqWih6/LBXw625G54nKDC4xBs5i22K5TRs/PH+z/+b3",
-	"xixcXb298eDUth3rtBXS8yf8+tSBXtcdBphmIPUB3MG2EfmvMKtq+g3IXc5V5NbO+Y0eXTj9VsjG7eki",
-	"LJPOcx9PajUvHIvHtIPApfMI6MiqU2Ll2l8XvxqG9fBhTHEPH47Jr4X7EAGIv8/c7/i4e/gwaaROqh0N",
-	"H0WtIqcreBBiNHo34tOqRDjcDpNhzm5WQXAX/WQYKNR6wXl03zrs3Urm8Jm7X3IowPw0HaI2iTfdojsG",
-	"ZsgJuuiLkAyO2CtbWlQRwdv5ADBi15AW3oeumoi1+XePEK9WaAOfqIJlaQckPkMOya17sWlMsPFge7aZ",
-	"o2I9Pu68YtHoppk6yPzaWkg0axLhKpmVuMbvTDgWUHH2WwVRiWG8AloSg3+f4agdqT+t63QDtysYjw4p",
-	"Pnx3c6UFshdVvVbfF8ES6defKnW1Z8hFPGOH528Jl3CE5G9NjK1bOu/lnQS19c25vQ61s0R7rumMvv2P",
-	"NVeR0+7hiyEbzNRkLsXvkBYZ0E6ZyB7iDewMbQC/A0+5ybb5V3BeqGtm17PvIpDheo4+UrmzXsMvOhTu",
-	"O+TmTrOH/TZ6TwVGtN/9KgyVznDuNqHv0Rz7vjRjeXp4GB7YyDMdywl5jzvK7Qm1qTUawW/pcx7Hqp7Y",
-	"8etz7mDuxPcW9HZGU7WWzNvVwBRtf8M3UAviO/sNUiE7hJ2dROEUoS2z+QZLkLUBq5ut+cB3qJ128Au0",
-	"fnAixcVPzbF1lymUSAxT8VvK0ZUR+1kO6HorsK4gptetkJhjVKXdGHPI2CqpmL+6eptnXeeznC2YrWpe",
-	"KSB0rl2qSTeQrWtvqcgVFA/pUBxqzufkdFyfWb8bObth+BDDFo9sixlVeC8Ht4zQxSwPuF4qbP54QPNl",
-	"xXMJuV4qi1glSNAVoMQZnHFnoG8BODnFdo++IvfRZ1mxG3iQvmCcjDZ69uir8bbi3YhxrFO/jcnnyOV9",
-	"LEWastGx245h2KobNR0cMZcAv0P/fbLlfNmuQ04XtnRX0O7TtaKcGoSkYFrtgMn2xf1Fb5IWXri1FIHS",
-	"UmwI0+n5QVPDsXoC2g1DtGCQTKxWTK+cs6oSK0NhdSV0O6kfDkv8+UpsHi7/Eb3Ay8TT/jO8suiqJ8gS",
-	"Hft/RJN/jNYxoTZpbMHqEBBfJJec++TYWJouVKSzuDFzmaWjmIoRIXNSSsY1arAqPZ/8zbzaJc0MQ5z2",
-	"gTuZffkkUeKtWQWJ7wf4J8e7BAXyJo162UP2Xspxfcl9LvhkZThK/qDOKhGdyl539bSLcZ/nc8/Qd5au",
-	"zbiTXgKsGgRII25+J1LkWwa8I3GG9exFoXuv7JPTaiXTBEMrs0M/vXnpJJGVkKliGzUDcFKJBC0Z3GCI",
-	"a3qTzJh33AtZDNqFu0D/eR3svFgaiW7+dCcfC5GFO/FOC5mdjKT/8w91in40tNvQ4ZbSUsiEetYpGj+x",
-	"Z+x+asK2Pd96JOK3HswNRhuO0sVKT8SJDSkJfT6Hy1kbJLvnDQ3po1+JNO94lPUfPkSgHz4cO1H518fN",
-	"z5a9P3w43Gs3rSY0vyZQc9hd006gafqmtvq5SCjtfCHR4LrmsqUkFKvJu8xcqTM3xpg0qzV+ernjOCGT",
-	"e3tCpw+QRw1+buPmM/NX3Mw6CKefPzQL2CbJJw/fozAOSp6L9VAial1bnp7+ACjqQclArSCupFOgN+m1",
-	"sdPlKCJbM+oMCmFeqnENrsEeNH+iXTCoGW/Zi4oV+c+18bl1M0nKs2XSr31mOv5inwFRg0iDkS0p51Ak",
-	"e9vX8i/+VZ149/9T9Ay7Yjz9qV0L2sLegrQGqwmEn9KPb3DFdGEmiFHUzAkWsqwUC5ETnKcunlKzxm5R",
-	"9VQx20SaARx2VWnnGI35G1xNkzkr0KU3bQbHlhNJdQ9XlRj9O69HhBsjp1i1hB0dJKFshde2oquyADyE",
-	"NyDpArsKDq3umDQOR44qoxBVmk/YEvPPCKIryYmYz6NlANdMQrEZk5IqZQc5NcuCNc49evbo9PR0mG0R",
-	"8TVg7RavfuGv6sU9OsEm9osrPmZrNuwF/iHQf6ipbp/N7xKXqwD7WwVKp1gsfrAx4WgYNve6rf4aKhVP",
-	"yXeYIs0QeqNKASpFfZLnZlrSqiwEzceYl/rym7OXxM5q+0hA1GH12QVqAJtHJGnkGZ6m1aeA60mfNXyc",
-	"7dl7zKqVnoS6sKlkjqZFXc6WtTyxUDcYY2dKXli1bPDnsZMQzG4uV5BHZWitGgCJw/xHa5otUd85HW1V",
-	"KfcUJBpeRdlzwNpcFIXehppdyMHNMlwhZVtHeUyEXoK8ZQow9QXcQDNnZEi46hTyPodkc7Wy4twSznQP",
-	"6TVU6Np3FzxwVvT1bhVJyFr7cGfbX51MBOus71tv+gJ7pUOHWsWrW+4OtmrH2tf9mJIfnLEjo1xwlmG9",
-	"i5QIjtkgh5lVB5QGSds71cid5cQxTJbMDjHyDou9RbQ9y3SI6zo1RF/NflvCsX9qWLs6hAvQyvFAyMe+",
-	"gr0z0DGuwNVgM/QVc1QhEx5fyRCd4DlyRPf48QgTuvXoWr813350unlMW3PNOOrcHFLdS9Aa2ArF0M7O",
-	"CdNkIUC51TZD09Rb02d6ueYIwrvpS7Fg2QVb4BjWA9EgxXokd4c68/7Jzh/YtP3atHXlE8LPDU86O6lf",
-	"97skC1Fh/1Nl33vRn3L58hFyEXLD+PFoW4hxa9gB3suGDOEGHf6gxPu8Qzahgn5zlG/Mk9XSG7YgNng4",
-	"mbmY8QQYLxn3Bt90Kq4seZfgxuBp7umnMkm1fXQM4niXQIue0ByM67ceA3cdql0MwqAE1+jn6N/Guvh/",
-	"D1sJDerXBeUb4g+Foe5IKPmaFsExP1HKH6UzJ4xZH+FWcf8UWzFsfeKjgxvo2hmLGrpjQZZ976m+hKez",
-	"Kl+AntA8T6W+e45fCX71wY2whqwKdchCqGszY3yX2txEmeCqWm2Zyze443Q5U1QpWM2KhMfti/AR8rDD",
-	"mAtrtsF/U0W4+nfGOeDvHYDuve3z/cokdAPqU9KzoemJYovJcEzgnXJ3dNRTH0bodf+jUrqPPf9DhJa3",
-	"uFy8Ryn+9o25OOJM4R3Xfnu1hETe6EYv8LtPSRaSyTa5El5lnVJz6JGBm5fYshbwvmES8Bta9CR9iK02",
-	"9n61loy+1A9Zb2YTql0CPU1JzROGqDD6U5BZx+uWZahr3uxzrbae1R/TeOLwsRXp/ZbG7xt2Rev1VjOU",
-	"XnviYSa/mgj2tfm5ahBdfSktCpEN5gxumDPTqT9bsFitXPL9hFfezUrk8VmIvbkA0ozNOiwnIirwYZv8",
-	"hk+r5Bd5mx6toR8JRDM0cRqi0S1hbINEPXgeGDt1PFGksnWYJd+yAutT/efFqx9H/RsZ7UB3S1327qQK",
-	"u29jQtRcmzwWooGPLTxA8CKt/1Y9KnVMT5U+Da5AcvLDt1ZBOAQkm6ppn9Yvhw7eIYCFsIWpUqU7ugly",
-	"RvV2eORH1FBvr+UoMXWkqKJd8Cnx9rFKz7oJCbVQB9VGbchIQ+pLpUoZuZeC18Dai8alxLP1nTqloToM",
-	"9MUQ4bCDjw/j0Xm+l/iUKoc1sqOkGOxLtljq54XIrv8BNAdpS5qknpO2oMkKzDNULVmJ759SKFaXJC7M",
-	"YC6X+BKHmw6NyLlcgktM4xMWdMbyDtQ3kGksUV27gUqA4X4OZXqJBgJvUMQmn8EVRALkUOrlVmHJOneX",
-	"ellXLgUXcMYUmYEzXdwAHxM2hWk7Ri2v81KRAujcK2GlEHpAaV+vbbFojIFO0VenTPR2MbCTdi7Kqmir",
-	"+U6H14E5CzEBNr7ylqo6eVUrpcPg0PH5HDLMub81A+B/LYFHKeHGXnWHsMyjhIAsRAli1YijarRrWLfl",
-	"4tsKalQW62NC2pec4xo29xRp0FCyKHEIrD0kCT0ix9pxfV2DPtOGc4xkKtATIsj7wbsaAHWZp0PqEEQJ",
-	"Mg8Ew9O4uZ7qpJmHQeMlmgPAMF33nLQ3Ix8Kpn0JBrsF3vtfyi+wnr5yTqU0ZLyP9UnkvFsR+tZlzMdc",
-	"j8Fa6HPng/K/+RyxdpaCXbsiOYgwa5u9pTL3LY6Sqc/emywN9DzMzOrAqK6Xz75+OTZCMSuEEYAmfYGh",
-	"zUil4MJ7T1lf6zqBGkI9BykhDzbBQiiYaOHDrPbIP+rCJ7dgz3qZH4S3lkf/HpHCdkW9ZRze1LUssCIl",
-	"xbIN1Dmfx1ghElbUQC+j+hJpNeiuHfrafvf5TXyFwe3q1T68h3Oxu0i3D71jqoP5+HTNiRMO9uZejaQo",
-	"B2hmGecgJ96I264uwZuZOjG1c15lVlSJz2bQXg9OgbaFmyWVmll3la0nVJSM4xo2J1bt4wuf+x2PgbYy",
-	"pAU9ymndIoqj6qpVCu7FUcD7vBlESyGKSY9l8LxbEqN9GK5Zdg2YGzZEphgp+F7z2JhJyH00SAWfkdvl",
-	"xhd8KEvgkD+YEnLGbXSgdx9pFkFtTc7v6W3zr3HWvLJFbpwGenrF02FWWGxG3pH7+WG28Lw+3qTA8Ms7",
-	"zm8HOWB2veZ9PnK3WJWmWap4OlS90fXvaIlQEflZKFIC1IU1BH+NLCHxjiKYlCXKHoT+AZQ4AzJRhUh5",
-	"4R+SOMYMlcZUPBkCpIEPeK7WULjBkwhwTnY7MsS6zz4HqpgTCbVvxqHJYF1+VcvEVZ9qpD1zmKXJGedC",
-	"Qjwj+pnaXNEhsg1TLeN/ZkxLKjeHpGxtoiqlhurF8k5vyeAoWS+kdpbs4rAoxO0E2dokFHhKqQNMO9W8",
-	"tn2p1LqfOeoziNwuqXIi4oYsaU4yISVkcY90iLeFaiUkTAqBXpgpx465No+EFcZ1clKIBRFlJnKwtdjS",
-	"FNQ3V8U5RdkLIle2JAos7WDKANsnouOBU5rb15pnJyiv7az14Tf/0vSx6SvqVHx20RPrItATXwDKJYNz",
-	"GLKNu/DatHGYiKmtlE2LyHO2RroBmTryc6JlBWPiWliBJCYhPPhUAlkxpSwogZZuWVFg9gi2jhwagj9Q",
-	"GrU9svM5+kHfMHR4a2YSsSJ1aW7HkH4l5gEXcSI2opdSVItlVKIgwOmf7rJyD/t4lJ9UhT6JGCJqpnhC",
-	"VkJp9yy2I9VLrl1A72eCaymKoqnIs3L+whl9f6DrsyzTL4W4ntHs+gE+wrnQYaX52KdUaPvu1jPJVj7I",
-	"YS8FveYTJA+1O9O7bYderY6eB/POFvfrGB52afIjMN/tZq677Rpn3YW119Xks+m30BknVIsVy9LH7c/l",
-	"/drrs5riXskEi7YQss1Cg82QD8T3WHBnQu7ZRTNwmqzkekYcj3BuHciJzH9RjG+PS+bgeFDPHdrlO07A",
-	"mmS9YmALAITUJkLQlbTVk2MhLTAcsbCJU9AppQ3owAsHff/uBpsZ4ehAabgTUB1v5ADgfavBGNtEmNaz",
-	"eSbW/vuDOlPmQcB/2E7lDebR51R5UZOWtG6VPpFVD0dIF0PY6oF4iUkwZkP9EEM1/IGXfwRAv2diA4ZB",
-	"/on7gjGnrIB8kiqUfB50YOPoue5iLKPRfUlJy8kzWvlixGbsSoJLrGSlf9k0J5bUkJIIzbsacZ7DGmyM",
-	"1u8ghS0lPI7MWVDYSsMtjYIoJwXcQMNh02V7qlAKZTfg+6rQmeQAJVp824q2lCdiXKiwpX1xa59EvmxD",
-	"sJtUx1jE2p0iO3QtSc3Qmk/sMVFDj5KB6IblFW3gT+0rcjR1ieYoJ1DVeT5M/BNz6DQ/2RHe+AHOfP+U",
-	"KOMx8W4YH9qbBaVRt40B7fRMrlTfqedpx+Q4lVkwFOFsebBrWxKv+YYq6S3v12p2Sb5+iQ3cJyZ4hNhv",
-	"1pChVOOeQpC7x1CP5cTlQEJq5wC5fTCYLglt/hI44SIqu3xLVXjF1Mlc/Q92YmzEuHtoH2Cjr/2H776z",
-	"BAcjqpVsMV0lNZD13XT8n+Ukbj2IveOlaESBC+Xdohrz1O2eHdhAVEVOuNlPI/tjmWJ3izkuPiazyg9U",
-	"FOLW1lGOn6gvwNtzLfV5E5MTy1m4lr2f9NjlGW5rQVgUIbKiGyIk/mMepL9VtGDzDfIZC77vRtSSGhJy",
-	"BmTrReH8rs3E28WrsQfMK2KEn8qumw0dMxpuY0aJgDYXua8cJ8iKXkO8DeggYvlnpg3jVNUMlRrmym5t",
-	"ZxcLbvE+PdOK5rESABPNbhrcwec5N73/vzpsNZ7K538sC5r5qtmu/l2Tz2BhfU9cegmr7WHOXb7mSSAU",
-	"66+JVvo0GfkB2tQ9WVcq5qevUFcD7E4V8k6NsjstY6BSuFVvaUuA+KClHHsXjhPD2VlSXG141+Li4suf",
-	"ZneSGaL7ljEE/D/QrjTcKzqRbeki7vF6bL32T7ALjUQ8CVitGnwm1hMJc7XLkcbqwWdiXQOsgu6W8UwC",
-	"Vdbv6PyVe7bWCZAZN89o67UbzKphlBzmjNeslvGy0olXEOZB5psIYbE1AdHaY5vrkzGMKHpDi1c3ICXL",
-	"+zbOnB5bnTguGOQtKK5vQgESbuTuAEzVL0CMp67183Ezc/3bYofWd1ZpynMq87g54yQDaaQGcks36nBT",
-	"VbA67DJW0UgWamYLicxWSNoWkGLjrM13NCQFAOkRLUoDLEHopJ2wAlnFkBY9hp8uDH8KS9CKrieFWGDU",
-	"b8+BcHmu0XRoH5CCoxLdSnfD1u3nUex32D4NViBxjEgLnHXIFNvP/SvcSnyE/sSZ3nryrYazHYZtPZ3t",
-	"wfRI5Ys6PMMSS/c8piLnXWKmOHrei6o+TYmnPYg2MekS3dGq9+wi+le4tAuxCn144cymC0cqPt/qFSao",
-	"b1BbAjBA1XEFNHMeYl1FXEdRYZEydtkN9tTTWe2+v5d6wENFinJnvTltcNAx4+xTbXR7PoNJKcpJNsS3",
-	"1RYpyp2RwUHahLGHPiITQs+6g9+NCmW7GjnRGvW79i242ls/bJetrMy2qQz6lEw9HL1pwBBz5GV4hK1q",
-	"DWOtgipm7B/n3tjdVKIFJkEokZBVEpXMt3SzuwhlT/b5i3+cPX30+JfHT78kpgHJ2QJUXdOgVcSxdk1k",
-	"vK01+rTOiJ3l6fQm+GwhFnHeeunD3sKmuLNmua2qkxF3Sljuo51OXACp4NxuZbyD9grHqcMi/ljblVrk",
-	"0XcshYKPv2dSFEW6pkyQqxLml9RuRQYY8wIpQSqmtGGETfsp07VTtlqichGzht/Y3FCCZ+C1z44KmO7x",
-	"5UotpM+nF/kZ5mJwNicC67JwvMraibaty73TrH4PhUZ0t5kBKUXpRHs2JymIMGZLVhD06k5tivr0yE03",
-	"MFvrsJsiROf8nia9M+5ewmJOtnP7Zllwneb0ZhMT4oU/lAeQZp91oz/PyCGcpDYM/GH4RyJxytG4Rlju",
-	"x+AVyffBlqjws47XREgaMgi0boKMBHkgAD3x0I2g1SjILspNLq2NAa0R3vzcFj9+qM3SOyNTEBLfYQd4",
-	"cSxz3S4EUzhwPnNi7x8CUqKlvOujhMbyd4VHe9YbLpJoi5zSRGtQli2JrlgYBcSrr0Ocec+rpBOOLoXQ",
-	"xLxMiyIRxm71OHimYsIxTwJ5Q4tPzzW+ZVLpM8QH5G/6A7fisOUYyRaV6ugJOV/SQWBFIcqfBCr+GmPr",
-	"/wvMziZvRzeLM/x37kBUCdHCenvPgwUcOLnFMa1j16MvycyV+yklZEy1HQpuvUgT4m1Bsrnzr4W1bsf+",
-	"3rlM0M9C3+E4zL0/EPkxMrIFzwEHc33UPzNz6uEAydOSItUOoSTwl+J1cYH3HdfOHUvDHJbKKUrcuGcq",
-	"p27p+qHLw3Xg5VUp6K5z8K3fwG3iwq/XNjRX2eAKM1dXb/VsSEKxdDUY0x1znB2lLMzdi8J8kgRnFpVu",
-	"DAdJkrBqkXtX9pqWv2SUp6G5i0bc76kbv7ToN6Pho2BecTteKICKseKerYv5OHgxCG66PSNX/CFRS+rf",
-	"Fu7Px0+/HI1HwKuVWXz9fTQeua/vUi+1fJ2MK60T6XR8RF01gXuKlHQzJJh9Z+qcJH7rTEGfXqRRms3S",
-	"b7p/mD3Dh6sLQDjnyOqRvdgb1OXP+SsB0FZiaB3WcGIsSdbpgcJW7MoU9HNfWnyb+r2n2keL+1as2Okk",
-	"1yjE8mE8WtgkZVid5BdXq+7TbruHoCdfoFv6XdKAWcQk1tqYPJoqSuo2oCCL65aokIGR11klmd5cGPx7",
-	"tTv75TqVDOq7kJ7J5fwKFngn+2pxDdz7mNXJnCrlpevvBC1Q+rSOAdzInKKYkm9shRB3Lf793uzf4Yu/",
-	"PclPv3j077O/nT49zeDJ069OT+lXT+ijr754BI//9vTJKTyaf/nV7HH++Mnj2ZPHT758+lX2xZNHsydf",
-	"fvXv9wylG5AtoL7yz7PR/5qcFQsxOXt9Prk0wNY4oSX7HszeoIZtjgkKEakZXrGwoqwYPfM//f/+opxm",
-	"YlUP738duXqQo6XWpXp2cnJ7ezuNu5wsMAfKRIsqW574eTCXZeO98vo8xAVZ3z/c0drmhJsa8vuZb2++",
-	"ubgkZ6/PpzXBjJ6NTqen00eYT7EETks2ejb6An/C07PEfT/BLNonyhXjOalDR5PW/jcYJuOf9HIBObkf",
-	"ggD/Lfh7qAc+lnDuslD+U1liDKs4z5G4XN30EdZ9RQdQBOvx6anfC/euicTLE4w4e/Z+ZPlHKh1uB6mX",
-	"NcBJyOqq091F/8SvubjlBFP+2gNUrVZUbuwKGtiIBsdtoguFpjnJbjAzo+ndxnlZuhJIfSjHqprNU+47",
-	"I4GE+jjmhNmyOa6QkUqhvFt+6Y7Y35oCujNZYnew0WsDs09zFtImu5vQ4Qw9TSzCwhmxysoOosejskqg",
-	"8xsM5lPbcDaOSvZYaESRB4x3MPq6+n8Eo4Z0FyH9r/lrCbRA0cj8sTKEmvlPEmi+cf9Xt3SxADl16zQ/",
-	"3Tw+8TqHk/cun9SHbd9OYi/Uk/eNpFz5jp7ej3JXk5P3Lk/VjgFjs8iJ82+POgwEdFuzkxnW2xzaFOLV",
-	"9S8FaV6dvEfdXO/vJ05OT39E9am9YU/846Onpc0hlP7YQOF7vTYL2T6caRONl1GdLavy5D3+B8k2WpHN",
-	"33+i1/wE3c1O3jcQ4T53ENH8ve4et8C00x44MZ8rZNrbPp+8t/9GE8G6BMlWwG3JcferzWZ7guWtN92f",
-	"NzxL/thdRyNp547LHLPEKu+D2cz1mbw+2glE1V2Z3bAkXO20pV0BuytJbVvZh/HoyRG5crMeQAKY5zQn",
-	"Ps8Kzv3o0819zm0kiREtrQiMEDz5dBA0to98Dxvyo9DkW6/Df/opd+Kcm5cjLbxAd6DoN+z4tK9RI3uH",
-	"ZnxhBRVhc+80j9pZnneI3r4hQennAm/XPoyt1KJ0vh010uonNONmCeNhYnM3A7BNAekFCS5yGMWPWy0r",
-	"+HBHntDyCqVSnyesTWhRxeAyZ7VpgJpMTNv2mbMjJzKt7yDh8xd+0jom6y+e8hdPCTzl6ekXn276C5A3",
-	"LANyCatSSCpZsSE/8RDsdzCPO8vzZA7w5tHfyePGo/UkEzksgE8cA5vMRL5xdfdGjQmuwWrLOoLMidcu",
-	"NV4MPdzT661S0kodRDJ69jblTOVCqstqVrDMLHjqdUsl1ctI9RPSITe53zjmZEFR+fZs8j+nk6/evX/6",
-	"tw/JGOpuOFUdh7j1a6KWDMlZUYWsNPpWuKwP3Usq0uBoQdRvEi8zPNxMb8gt47m4fRAw8FsFeHc4FPhp",
-	"RuPUTbOl6EW32GLtzGBA7gDaBwF6QWzdgkFWsH4Hgy3fuvVvD1tDQT/XEt59bM1bSHD6nxevfozivq1+",
-	"xTpPYtSxPbAY5CUFBi/dUvSet+Wnv7aar2KD+Qs01ZVqFLad/nX7/nXj3f3G+y6k6rd1azWWnOwyzegG",
-	"nA4S85M32vvGn05bM7KhK6lU+eZ3QskCq5N3r+XZhpy/6LzZbbf2Rfh8g01bd2HikmuDuJVPtdlBD3vZ",
-	"JsiZhSyEDgE8dlF/idZ/idZ3eq4PPjxDXuxJfdp3ODDtvELHvvx/I0gSy12gi0AHlCFat896fI+y8V2N",
-	"XkqDZ8tyQE6iDzYPUBvNf7GIv1jE3VjEd5A4jHhqHdNIEN1+Gr6hDAOT1uUNd3QvdfjmVUFllDxhl+L+",
-	"DEdMP4A/Ctf41GrKJK6slhJjrJgNLkhs4HE1l3+xvL9Y3p+H5Z3tZjRNweTOur5r2KxoGTR8alnpXNxG",
-	"fgEIiw0M6lo27cO//ffJLWV6MhfSVY2jcw2y21kDLRDZDNM5x7/W5b47X7CGefRjnPYz+esJbZpqm+4C",
-	"hvX2dez4EqS+OnN5TyOfb8Z/rj0VY88/ZPvB5+/tO8OyFcgbfyPUjmzPTk4wfdlSKH2CGq+mk1v88V0g",
-	"j/fhHnFk8gHpQki2YJwWE+cRMqmd1R5PT0cf/m8AAAD//y+FiSOgIgEA",
+	"H4sIAAAAAAAC/+y9e3Mbt5Io/lVQ3K1y7CUp2bGzJz51an9ynIc2TuyylJzf3tg3AWeaJI6GwBwAI5HH",
+	"V9/9FhqPwcxgyCFFy0nd/GWLg0ej0Wg0+vlhlIlVKThwrUbPP4xKKukKNEj8i+a5BIX/zUFlkpWaCT56",
+	"PjrjhGaZqLgmZTUrWEauYDMdjUfMfC2pXo7GI05XMHoeBhmPJPyzYhLy0XMtKxiPVLaEFbXTag3S9P3l",
+	"bPK/Tidfvv/w7C+3o/FIb0ozhtKS8cVoPFpPFmLifpxRxTI1PXPj3+76SsuyYBk1S5iwPL2ouglhOXDN",
+	"5gxk38Ka421b34pxtqpWo+enYUmMa1iA7FlTWZ7zHNaj252fqVKge9djPg5YiR/jqGswg25dRaNBRnW2",
+	"LAXjOrESgl+J/ZxcQtR92yLmQq6obrePyA9p7/H48entvwVSfDx+9nmaGGmxEJLyfBLG/SqMSy5su9s9",
+	"GvqvbQR8JficLSoJitwsQS9BEr0EIkGVgisgYvYPyDRhivz3xesfiZDkB1CKLuANza4I8EzkkE/J+Zxw",
+	"oUkpxTXLIR+THOa0KrQiWmDPQB//rEBuauw6uGJMAje08MvoH0rw0Xi0UouSZlej92003d6ORwVbscSq",
+	"fqBrQ1GEV6sZSCLmZkEeHAm6krwPIDtiDM9WkqwY1188Hd32/bqi6y54l7LiGdWQRwBqSbmimWmBUOZM",
+	"lQXdIGpXdP2307EDXBFaFKQEnjO+IHrNVd9SzNxHWwiHdQLRl0sg5gsp6QIiPE/JTwqI9l+1uAIeqIPM",
+	"NviplHDNRKVCp5514NSJhUR0IEXFU4yK4AeH5h4eZfsek0G9xRFvt39TbOE+taG+YIvLTQlkzgpzX5J/",
+	"VEoHAq4UbvsSiCohM7w3J2YYg3zFFpzqSsLzd/yR+YtMyIWmPKcyN7+s7E8/VIVmF2xhfirsT6/EgmUX",
+	"bNGzAwHW1DlV2G1l/zHjpY+qXifvkldCXFVlvKAsPguGVs5f9lGGHbOfNNIM8izIDbg/bqzL9fnL0e0h",
+	"PfQ6bGQPkL24K6lpeAUbCQZams3xn/UcSYvO5b9GVrwwvXU5T6HWkL9j1yhQnVn56awWIt66z+ZrJrgG",
+	"exVGYsYJMtvnH2LJSYoSpGZ2UFqWk0JktJgoTTWO9O8S5qPno387qQW9E9tdnUSTvzK9LrCTuYwlGMY3",
+	"oWW5xxhvjPCIolbPQTd8CD+RuZDkZsmyJdFLpgjjdhNR7jKcpoBryvV0tNdJvo25wy8OiHor7CVpt6LF",
+	"gHr3gtiGM1BI+07ofaAakiJinCDGCeU5WRRiFn747Kwsa+Ti97OytKgaEzYnwPA+hzVTWj1EzND6kMXz",
+	"nL+ckm/jsW9YURDBiw2Zgbt3IDdjWr7t+LgTwA1icQ31iA8UwZ0Wcmp2zaNBKdDHIEaUKpeiMFfgTjIy",
+	"jb9zbWMKNL8P6vyHp74Y7f10Z1oRh1SkJvtL/XAjn7WIqktT2MNQ01m772EUZUbZQkvqvEbwsekKf2Ea",
+	"VmonkUQQRYTmtodKSTdegpqgJNSloJ8UWOIp6YJxhHZsBHJOVvTK7odAvBtCABUkbUtmOCi5YXpZi1wB",
+	"9dPO++KPTcipPSdmwynjilBSMKWNMISbqcgSChQ4aVAsxFR0ENEMoIUtiwgw30haWjJ3X6wcxzih4f1l",
+	"Yb3jTT7wkk3CXH+OaQChOpiZ72S4SUgUKhyaMLwoRHb1HVXLIxz+mR+reyxwGrIEmoMkS6qWiTPVou16",
+	"tCH0bRoizZJZNNU0LPGVWKgjLLEQ+3C1svyKFoWZusvNWqvFgQcd5KIgpjGBFdPmAcw4noAFuwZuWc+U",
+	"fE2zpREmSEaLYlzrJUQ5KeAaCiIkYZyDHBO9pLo+/DiyfyjhOVJg+KAGEq3G6TSm5HIJEuZC4kNVAllR",
+	"vJxW5nlUFs0+gbkquoKW7ISXpag0yMbL5fylXx1cA0eeFIZG8MMa8cEfDz4lZ+ETzsyFXRyVgIoWxrOi",
+	"ymv8BX7RANq0rq9aXk8hZI6KHqrNb0ySTEg7hL383eTmP0Bl3dlS52elhIkbQtJrkIoWZnWtRT0M5PtG",
+	"ilIokH8HtlgeQwCc0YLyDCZbrjHXxF1nN0thOC7Or4iCAjJDfWZNmVghLYIyawirfKAcOY72fPOXbrFp",
+	"sIRk5oIviG/WmHRMqCLm2YhXFtOKZGbRc4MXOOwij4ffey1aaFpMLNrSk2ALAmsN0qzKNiVUk8YWmYnD",
+	"Y9rplDqg3I5H22ZqzyGC6GjxeNicSZEk2sRxi9YCjC3kDGF+qVU0lhDfADSv2f+xbrcdN1tONZ2O2gtJ",
+	"a0TszYv98FEFMrFtr/E/tIipOOK+DOV8fBMEfoYyr0GKnck0UKCJFmRl9c7EKIP3gvKrevL0NT1o8762",
+	"qm63PW4RYYcu1yxXx9omHKxvr5o3jNWZ+uu8wx22XtrRXIOoV5TEXr8tEOxN6xiMQYhYH10sfCHWKZhe",
+	"iHVHJBRrOMpOiLX9zyBh6YVYv3SQCbkb8zj2EKSbBZr7AO8m2jAjmllqU8/ZTMjDbtaOaa82YBFqRo0e",
+	"I+MWkrBpVU7c2UyYl2yD1kAkqGe3C9Ht4VMYa2DhQtOPgAWlaQT8HbDQHOjYWBCrkhVwBNJfJh9BM6rg",
+	"8yfk4ruzZ4+f/Prk2ReGJEspFpKuyGyjQZHPnJ6cKL0p4GFSXkHpPD36F0+9QbE5bmocJSqZwYqW3aGs",
+	"odJKB7YZMe26WGuiGVcdABzEEcFcbRbt5K3tdzsevYRZtbgArRlfqDdSzI/ODTszpKDDRm9KaQRz1TTq",
+	"utfGSW6anMBaS3pSYkvgOdI8roMpqhSsZkchqr6Nz+tZcuIwmsPOQ7HvNtXTbOKtkhtZHUNzCFIKmbyC",
+	"Sym0yEQxMe8kJhK6vzeuBXEt/HaV7d8ttOSGKmLmRgNyxfMeFZ+xDA++v+zQl2te42brDWbXm1idm3fI",
+	"vjSRX7/iS5ATveYEqbOheZxLsSKU5NgRZY1vQVv5i63gQtNV+Xo+P46NQeBAiacIW4EyMxHbgjBOFGSC",
+	"52rn88pb01vIdFMNwVkbW94WrPuhcmi62PAM33XHOMv9j05nKidqw7NIlWxgLCBfgNyJpCOpjPswZaF4",
+	"oBKQGky9ws9oUXsJhabfCHlZi7vfSlGVR2fn7TmHLoe6xTibXW76eosM44sCGpL6wsA+Ta3xkyzoq6C0",
+	"s2tA6JFYX5mHcfS+fCPFR7hDk7OkAMUP9mlemD5dFe2PIjfMR1fqCKJnPVjNEQ3dxnyQzkSlCSVc5ICb",
+	"X6m0UNrj9WYOalZJCVzHci7qA5kiMzDUldHKrLYqiRap+6XuOKGZPaETRI1KT1i7OtlWdrolvQZCCwk0",
+	"N8pX4ETMzKJrLyFcJFWkpDIofZxIPJTfNoAtpchAKWMBtmaXnfD6dvb+0VuQh6vBVYRZiBJkTuXHWcHV",
+	"9U7gr2AzuaZFZcTz739WD38vi7Das+1bgG1SG9FWf3eXcgeYthFxG6KYlK223Z4EogW+DArQ0Ifsu2Ov",
+	"d/vbYHaI4CMh8BokeqR91KPlJ/kIRBng/8gH66MsoSonRgzsVT8YydXsN6dceNlwxwxhAq+2duruicpo",
+	"Absw1NZ1V5xZu5zgQNojmo9jQkkpbmxvDXxKXjszYVALC0mzwsg6WUElKMIFh6HWhdSU6TWYLzidg9zN",
+	"StWVQgGrtTK0383A++zisRoTVRkzJjqv5My4xP7dNTaMwoGF6MgEV8BVpf7qnC3sJG40VJDvgYnORV1Q",
+	"pSe7xAHTqKHzMmQa3cC9A/e8BV5RpVGEJ4znqHu3YgzOg31win0NUjhl70vaTPqzf0R3pw14Di9qVZWl",
+	"kBry1PLQX6d3rh9hHeYS82js8GzXglQKdo3ch8BofIdHuxKLO6qDd47z9+kuDj2ujOi52RfLDfhqHG2D",
+	"8cK3ihAfBxT0wMhUvQeW3Jhq0dtMiAIoqruVFmVpbhdzdkO/Pgxe2NZn+qe6bZckrQkc5yS5wHOkPUwO",
+	"8huLdIV2/iVVxMHhfbNQWWndg7swG5Y8UcxYELedF1RgmFbxwTmIVVvRoMXrtokJ9a5ENskm45Ng0GHZ",
+	"kjWe++d013ruCHSuQZJ6xS0GFnXzLg3BKD/btPnrUAZflQtJc5jkUNBNwsnOfib2855nwo+NZ6NWewkN",
+	"kxk6kaSPR80OvP34sFkFTpUQSn4UBL+QjCqNG1Tvp+t9+KQ54LQp6nHn9EGYBcFIHgE/HiLLHqXEiEiL",
+	"1wIpyzayq3HC1B3X0oO9MOtHQSCOO6n1V+3Z/weUm9u3Oe78G1B9C6+nPt6ynVhleYa1xjO9XPXfoZc1",
+	"owmt66vB+U61hMicwgr9y/FKtwGIekMoVzcgE2JSk7FXCgjlPYMSgd59hIv2uFa2ZzopXzWXndFsCZMl",
+	"2/328SvnuWPE7ocCI2JUDb2FAXIPhdmzbAkpEdstxPZQBO8dwpwJE/K/Wm+yJsQmXIWBGtvL13jiKfcK",
+	"MZMsmSbSeB/dCbX7OQAFXBqKzCrNrmEyp6yoJKh9Hx0OI0ughV6SbAnhvW5GhNzhKAiJgoP9rKosA8Do",
+	"xrDyLvJQ9XrY6nZbhvB8MJkwBd3xcHTX0TmtA0jdEc6uHXEu6xFBK+DaGyF79qtNuZ+C/EKkU+K5ZPxV",
+	"QQWhp2cVc5SFomfUA9WkxOfuz42JmEWmmxsulIsbfndeFlriXMg47GwD9rYttloSSsut0RHqAQbJMbAx",
+	"6kli3JJx775kph0Hj6bbwO44hbRhrqFWabx3Wy/R1mMx+cLrfVbteNf0PSF63EDeUKlZxkq0FHwPm6Mb",
+	"TtoTJN28SQ7astfogzWilHF/YiMo22MeZkgZZOnugt8xdSeW44NKmsBfwQYtVm9sMHZkKDyGJSgxKmHK",
+	"0D8C6gN+IW/GjsOaZrrYEIoMd0PMISCqmtmT1fVe0qKcxAOk0z30z+h8IZOeiFudMy9wqGh5qaAp+5rd",
+	"Dt9lS9vdQIe7KUshiulu/98OMpIQDIp0IKUwu85oUWyIDhH/npIaQLpHTrHx4Lq7IkYzroD8j6hIRvEB",
+	"XVYago5FSGR6Gu8mhWa8ek4XZVdjCApYgTWk4JdHj9oLf/TI7blRRsKNjRbg2LCNjkeP0Ar6RijdOFxH",
+	"cDQwx+088XBCNzHzUPRO4C2esjs+x408ZCfftAb3k+KZUsoRrln+nRlA62Suh6w9ppFhsUl6PXDll81o",
+	"ls66cd8v2KoqqD6Gjxhc02IirkFKlsNOTu4mZoJ/fU2L16EbavohMzSa4RtizhYDx4JL08fmRDHjMM7M",
+	"AbaS4FCA4Nz2urCddijK60gNtlpBzqiGYkNKCRnkVsnGFFFhqVOCw5JsSfkCH2pSVAsXpeliXQzDr5Q1",
+	"QsqKd4bYOwpkzSfoPaKSGTbQY8zdAtY6Qo1iuu16Yl9VNzSAAnnjyhi4PW1XnKS32ni09U11XevtLd6a",
+	"2W4O9eNqyIcR0mpoBjouIT6NrNRFYryN5vAZYvg4DjL10CkouxNH8az1x76QVmMuKDZHEJLsQERCKUHh",
+	"lRZbYJX9KubkB5ZJcVYsRLjz1EZpWHX9ZmzXX3uO69tDtLiCF4zDZCU4JNTSr/HrD/hxsMXXXsM9I6JA",
+	"tNeA7YdPAwmtBTQnH0LSd90kJJn22W87malvhDyWg6MdcPCbYoDT4E6PWjfloa6NJlqz6w1oVegdLqLG",
+	"IZ6VSUKVEhlDQfE8V2N7Wp0DoYuka6L/TcjqcIQD3B635fYWZZCwPhRQlISSrGDoYSG40rLK9DtO0VAX",
+	"LTURp+EV3P0at698k7QZOWHldUO94xSVQcF8l9SxzCGhT/oGwBt3VbVYgNKtB9Yc4B13rRh3bhQmmtgc",
+	"l4k9LyVIDJaY2pYmlHluaEIL8i+Qgswq3XxyrCqlidLGRmx98Mw0RMzfcapJAVRp8gMzHuFmOO/C648s",
+	"B30j5FXAwnQ441oAB8XUJB1k8q39ivHwDidLFxtv/u8619Gs4TFp1t7It/e/P/uv5ybPHp3863Ty5X+c",
+	"vP/w9Pbho86PT27/9rf/0/zp89u/Pfyvf09tn4ed5b2Qn790b/Tzl/gQi0Lc27D/HvwpVoxPkkQZ+3K3",
+	"aJF8hqn+HME9bNqu9BLeceO9rwW5pgXLqT4i+bSvqc6BtkesRWWNjWup8TwC9nwO3YFVkQSnavHXjyLP",
+	"tSfY6uscb3krvNNxRnV0AN3AKbjac6Yimh58+/UlOXGEoB4gsbiho6xoiReM/dB0sDa7FOekeMff8Zcw",
+	"x/eg4M/f8ZxqemJP00mlQL6wUeLThSDPfT6Xl1TTd7xzDfXmvo3yMUXJb1Ocgq7Sa3n37hejZ3v37n3H",
+	"BbQrW7mpBppG7JQTIzeISk9c/smJhBsqU/Z8n53QbpTtvRUOK5OIysXE2/GJG3+oAYeWpWrnqeuiqCwL",
+	"g6KIVJVLtWa2lSgtQs4LpkLaIEMDPwrnzyvpjX/yVgoU+W1Fy18Y1+/J5F11evo5kEZ2tt8cDzR0uylh",
+	"8MO3N49e+72LCw+uP5JOSrpImebevftFAy2RQlDgWOFLs8AcDpLGOAlBmDhUvQCPj322xEK2d0oiXO6F",
+	"7eUzEqcXhZ9wU5tpn+60g1FCr4M3cEdSMFrp5cRwhOSqlDkGfq8c3yB0QRlX3gFQsQU+ANRSVGbJYK19",
+	"LikvrEq9GTe6i3njLvYMhynUGbm8DHNm8JdRbgasypw6QYbyTTs7p7JxqDjoW7iCzaWw3acDExtHibSj",
+	"7JCq7+gi7UZ3rSHf+CC7Mdqb71zefXobl0kRU154snge6ML36T/aVgA4wrFOEUUjRWEfIqhMIAI79KHg",
+	"gIWa8e5E+qnlDfJNHOCVSJUh/kYew709FHFZgsNf7TOJzRujZZRzodFRyl4HVJP7c2dkPAOOzi9QsAWb",
+	"pTzu/961/0TAEwkZsGufuCkMqMxCmVYhS5J9WUrKF0CodhlpaIFxpdOkUx9K0UugUs+A6q16bR5nEvTQ",
+	"mf7kBrgmVrk0JuhXb84F06gs4nBjUzQx6dq4WLfpQV7jdk2QHwiq736HbErmreEQnkhZ7uWisCfhXeX8",
+	"R+JTfLkM31cGhwspbsxuGgCFz86POTyj+7xSdDHYV6lhUhuY9bBhKcNBdkmJSbnQ2Nmb4l9HFhu4CNt9",
+	"YvCS5KJgvhg2iuaSVhSOn9uaWp315bXJVuSQOivw4RFimCzpGAYQIY8v9gM2ze5B8lqo94A1sRYf/SVV",
+	"/ujn4+jmO1Cq/jTZQrelSD+Pggyo7iZA9+JM+wocW73XDNDPT8x9onSfHd2nRB+N90pvPh5ZzpTcO8Hx",
+	"tZFDAQuLE9vY01mdgrfeTQPH6/kcmd4kFa8QKW0jCc7NAebB+ogQa1kgg0dInYIIbPRAwIHJjyI+7Hyx",
+	"D5DcpRCmfmy8u6K/02FMLmDUvCZEaaQjxvskB8dSXAbDWjRsReHhMBiqZTjpNS0it8R6kE46bnwjtpJv",
+	"Ox+Yh31vx4EHza0Rpbi9Vok9Dlpf/EDxy0i/nvZaw0ysJzZ5T/IJOlvPzJlIhtSaXsnDa5OjP1BkJtbo",
+	"e4U3nI3B3Bu6fsg8YLFPMVNI5divT7y24O0HyPYHT4qaFfksPD9qsuuT+A8DpufZ0Ud2n0VZ0o8EUkvR",
+	"W1d6cpqvnfqoprTVlUTq63Yckj6GTAopVtN3OJM72YPRrpK5mc78uzqjfX/+a9fofvK4d5WXd0m9bzsj",
+	"IGqvzPttcmgAsQWrb9pCbBKtjVYtvEZYS7GkRvhuw57RRJt7kgo+acjVkyvYpBU/gDLDhe8W6YNx9yjf",
+	"PIy8BiUsmNJQG2FYlL32fm1kqHY1jy0x71+dLuXcrO+tEEHQwI4EOzaWee8rwDC1OZPGv9tYsJJLMI2+",
+	"Uahx/MY0TQvCjc0mTFmT2N5yMEJk8g3krKjSpOxA+v6lgejHcHOpaoYXJePWK2uG1c6Sjsx72HARHusA",
+	"vxVBryyCXtH7wM+wg2WaGpikobzm9H+QI9bihds4S4KWU8TU3dBelG7htVG6py6jjYToyD1lus021jmX",
+	"uR97p9eaTzrVJ0TYkZJriZLepwN/xGJhIr9tLk6Xt4TykPWc0ELwRZ0u3vy+JUP81FTeUi7P+pYU7c6N",
+	"H/qc+BsVI7HwYRL6qJmFvE4igOnlcZIFcJtccLR/SclCLHYEEGCLSIN8v7y9E16QdLG+bLlV177Pdg/D",
+	"ZuP2FEBz96xS4Ne3/dB2t8uhbtznnN2oArL9gOGASHFMq0iA6RBND+emZcnydctAakedHkASA8W9brGv",
+	"Fs7YTrQ0/a53VGF9oIjz7na2oBN83Z+Yt6V193YOy+ZI0Mzlwcorica2hjN1t1JaeF8OXPL3P19oIekC",
+	"nMF0YkG60xC4nH3QEBUbU0Qz6z+es/kcYkOhOsTI1QCufQaTFWYHUl7XmhielFvJcm/aqlewG6FpekpQ",
+	"Sp9LymXXXOvaxiq1cMdEG3eAzTWZ6up72Ex+NooVUlImVe266+ynzdt8D5q4Xn0PGxx5p0esAWzHrqAG",
+	"7i0ghaaMKuGTiuo/PVAxxuzTt7GFe+zUWXqXjrQ1rkhi/9GoL6Z4Ra2lfLxjU3sQGUiH7NVF2inHnC1o",
+	"bkub0HdtEct3izzRyyOeyubbOuRuCzngdjrfAS084eNiR7fj0d3cYbosLIy4YyfehBs5uQvorGrdIxo+",
+	"cXtuCC1NjTpaTJwbUZ+sIcW1kzWwufc6uudnVfpUXH599uqNA9/4ZRRA5SRoOHpXhe3KP8yqbHHF7deQ",
+	"LbTlVLpWAxZtfijmEjsa3WBRrZYSrVPFtHYrq8fzjkfztCP9Tr7pPODsErd4wkEZHOFqQzR2bvm+0WvK",
+	"Cm/v9dAOVa7b5Q6rm5vkE/EAd/ahi5wj7zyWYv+CCXrgih7/NRXw625G57FrnFl8JkVJPZbbtPHDi7f7",
+	"b35vbIfR/nhwatuOdW4LFdgS/o/qQO/0DgNMM5D6AO5g24j815j4P/0G5K4sAHJr5yRIjy6cfiNk4/Z0",
+	"kahJJ8OPJ7WaF47FY9pB4NJ5BHRk1Smxcu1vi98IU+TRo5jiHj0ak98K9yECEH+fud/xcffoURdoKxCk",
+	"+ShqFTldwcMQy9K7EferEuFwM0yGObteBcFd9JNhoFDrLejRfeOwdyOZw2fufjFWf/PTdIjaJN50i+4Y",
+	"mCEn6KIvkjQ4rK/o2sS9KCJ4i1nYyGZDWngfuoKR1ubfPUK8WqENfKIKlqUdkPgMOSS3btimMcHGg+3Z",
+	"Zo6K9cQC8IpFo5tm6iDza2sh0axJhKtk4YwavzPhWEDF2T8rcHmq5gwkXgEticG/z3DUjtSf1nW6gbFP",
+	"NPxQCd9021d/tcVcaYHsRVWv1fdlsET69aeqGe8ZmhLP2OH5W8JKHCH5W5Mz5yYyiKC2vjmDYTipCHKW",
+	"aM81ndG3/7FmeKzvd/5yyAYzNZlL8S9Iiwxop0xkWXGA4OMRe6fcZNv8Kzgv+PXGs+8ikOF6jj5SubNe",
+	"wy861GY/5OZOs4f9NnpPBUa03/0qDJUuwjMexWc7Dbf9SJoxTz08DA9s5MGPDuTe445ye0JtCpJGkGD6",
+	"nEct1Ikdvz7nDub2rmcFvZnR7Cr9djUwRdvf8A3UgvjOfoNUyKJhZydR2Eloy2xKthJkbcDqFhQ58B1q",
+	"px38Aq0fnKZj46k5tu4yhRKJYSp+Q7kG705jOaDrrcC6gpheN0JiKnWVdmPMIWOrpGL+3btf8qzrfJaz",
+	"BbMZ5jH7nYtaAOIGIjZfO1KRyxgf0sY41JzPyem4PrN+N3J2zfAhhi0e2xYzqlz6ezFvdjHLA66XCps/",
+	"GdB8WfFcQq6XyiJWCRJ0BShxBmfcGegbAE5Osd3jL8ln6LOs2DU8TF8wTkYbPX/8Jbp62T9OUyJSDnNa",
+	"FXobk8+Ry/tYijRlo2O3HcOwVTdqOjhiLgH+Bf33yZbzZbsOOV3Y0l1Bu0/XinK6gPQzfbUDJtsX9xe9",
+	"SVp4wQS2JAelpdj0ZcldgaaGY/UE/huGaMFwwTQr56yqxMpQmGet/vj54aZ4Wix9BLj8R/QCLxNP+0/w",
+	"yqKrND1QdOz/EU3+MVqxFDimRmF1CIhjkVNy7uu3YPXkkC3T4sbMZZaOYqrZQizUybhGDVal55O/mFe7",
+	"pJlhiNM+cCezL54mqhA3C3Xy/QC/d7xLUCCv06iXPWTvpRzX1+Q74JMVM8z/YZ19IzqVve7qyWl1n+dz",
+	"z9B3lq7NuJNeAqwaBEgjbn4nUuRbBrwjcYb17EWhe6/s3mm1kmmCoZXZoZ/evnKSyErIVD24mgE4qUSC",
+	"lgyuIe/dJDPmHfdCFoN24S7Qf1oHOy+WRqKbP93Jx0Jk4U6800IGLCPp//xDXUUKDe02xLqltBQyoZ51",
+	"isZ79ozdT03Ytudbj0T81oO5wWjDUbpY6Yk4wZ/rPp/C5awNkt3zhob08W9Emnc8yvqPHiHQRlFqm/72",
+	"pPnZsvdHj4Z77abVhObXBGoOu2taO459U1ttyvk//9BT6z64rrmsMt1tTt9l5kqduTHGpFlQ/P7ljuOE",
+	"TO7tCZ0+QB41+LmNm0/MX3Ez6yCcfv7wQqxfulUJmSafPHyPwjgoeSHWQ4modW15evodoKgHJQO1grgS",
+	"MW/dLkmvjZ0uRxHZmlFnYFyeVaNM7GAPmj/QLhjUjLfsRcWK/Ofa+Ny6mSTl2TLp1z4zHX+1z4CoQaTB",
+	"MCZWDkWyt30t/+pf1Yl3/z9Ez7ArxtOfWgt3sLcgrcFqAuGn9OMbXDFdmAliFDVzp4VsNMVC5ATniWqd",
+	"BNY4HSUQ/9LUib+wWWjUGynmXXqyw64q7RyjMX+DK902Z4X5X48ZHFtOZG8hEInRv/N6RLgGY2bDB54d",
+	"HSShbIXXtqKmJCwewmuQdIFdBYdWd0yuhyNHxTGIKs0nbIl5egTRleSm4Hu0DOCaSSg2Y1JSpewgp2ZZ",
+	"sMa5R88fn56eDrMtIr4GrN3i1S/8db24xyfYxH5x9XFtbYu9wD8E+tua6vbZ/C5xyY2s+FtbryTFYvGD",
+	"jQk3nW3lTuxEgOeonJ2SbzGVnCH0RjUHA01Iht1M31qVhaD5GPN3G38tYme1fSQg6nJD+AsDf+uIJI08",
+	"w9PZ+lR5PWnGho+zPcuRWbXSWFxFaboqU0kvTYtL34CwlicW6gZj7EzJS6uWDf48dhKCWeDlCnISpnNq",
+	"ACQO8x+tabY0DcR0tFWl3FN3cXc5pzeuheeAtbkoCr299h+Rg5tlWPcGIBXPQY6JMDrqG6YAU1/ANTRz",
+	"a3owvELe59psrlZWnFvCme4hvYZCpPvuggcOxw1uFUnIWvtwZ9tfnUxEVDKD4dRrT/4F9kqHDvHmYC13",
+	"B1vdZO3ro0zJD87YkVEuOMuwLkhKBMesmcPMqgNKqKTtnWrkznLiGCZIOYqRd1h063/fyzId4rpODdFX",
+	"s9+WcOyfGtauVPYCtHI8EPIxKqhYAc5Ax7gCV2rW0FfMUYVMeHwlQ3SC58gR3ePHI0x816Nr/cZ8+9Hp",
+	"5s3ZJVeMo87NIdVXf0YDW6EY2tk5YZosBCi32mZomvrF9JlerjmC8H76SixYdsEWOIb1QDRIsR7J3aHO",
+	"vH+y8wc2bb8ybV2ZifBzw5POTurX/T7JQlTY/84nUxqhD/0ply8fIRchN4wfj7aFGLeGHeC9bMjQ1B8h",
+	"SkOJ93mHbEDK1MPTVB+pLL1hC2KDh1NIKRhPgPGKcW/wTafiypJ3CW4MnuaefiqTVGfLBpPa5XzcE5qD",
+	"cf3Z1TGGam0wogTX6Ofo38bLNXcVP3rYSmhQvy4o3xB/KAx1R0KJifQNjt4oTDX10kY6c8KY9RG2wb5O",
+	"vEuzFcPWJz46uIGunbGooTsWrtn3nupLDDur8gVok2I0lfruBX4l+NUHN5riOVWo1xZCXZuZ9bvU5ibK",
+	"BFfVastcvsEdp8uZokrBalYkPG5fho+Qhx02lGZsPObfVLGy/p1xDvh7B6B7b/t8v3IS3YD6lPRsaHqi",
+	"2GIyHBN4p9wdHfXUhxF63f+olO5jz38XoeUtLhfvUYq/fW0ujjijese1314tIeE5utEL/O5TkoWku02u",
+	"ZL51S/KhRwZuXmLLWsD7hknAr2nRk/QhttrY+9VaMvpSP2S9mU2odgn0NCU1TxiiwuhPQWYdr1uWoa55",
+	"s8+12npWf0zjicPHVqT3Wxq/b9gVrddbzVB67YmHmfxqItjX5ueqZnT1pbQoRDaYM7hhzkyn/qzKYrVy",
+	"RQoSXnnXK5HHZyHy5mrlK076anvfsehha4JtLFz1o9xma1FxwmDnddDKEWwdUpoJj6cm7WIjOaMf32gZ",
+	"ezzRANJMmeXJnx3syW+4iuQXeZMeraHbCQS/s/7yXUvHh8LBsC4R3aFwerMq9FB8dookDyzdHW9eTyHv",
+	"jwVqm5ngcXLkMLbBwn6r/cbabYw3LVLduxNGvmEFEMbJf1+8/nHUf6Cjk9g92i7bfdKU0XdAQ/Rkm00s",
+	"RIO2ttwFghdpO4jqMa1gmrI0VxQaej98o/RQkGzKrn1avxo6eIcAFsIWckuVuukmShrV2+GRH1FDvb32",
+	"ZompI0UV7QJpiTcwtoiuKKc264zWowhryMpD6rGlSn+5F6PXxFuBw6VGtPXQOqXUOhfpyyGPhA4+bsej",
+	"83wvMTpVPm5kR0ldtK8MM3lhLB/fAc1B2hJAKbWCLQC0AqOOUEtW4ju4FIrVJbwLM5jLKb/E4aZDI7MM",
+	"X8RPIXFFZyzvSH8NmRay4Q4sAYb7u5TpJRoIvGEZm3wClyAJkEOpl1uFZuvkX+plXekXXOChsbyDM2Fd",
+	"Ax8TNoVpO1Yxr/OTkQLo3CvjpRB6QClsr3WzaIyBTtFXp6z69udAJ/1glF3TXojT4XWTzkJsiI2zvaGq",
+	"TmLWSu0xOIXAfA4Z1l7Ymgny70vgUWrAsVfhIizzKDEkC9GiWGXlqJaNGtaCHghqQe8H0o9Q+eMKNg/U",
+	"Hep/eBvh2Bb/YAppyOFFcIhwhkXtXC0Qpl3JkGCPu5+aIHlvfPkDRRqnMFkGPYSoH1LOAcnLekT4CiF9",
+	"RkLnYsxUOJGmc4gosd2hLix3SEWPKNXsgWB4LkFonH72MGi8THgAGKbr3pPWiT8/2mFCi3OA+4EiYU7z",
+	"9/hOhy0RUtt77v7azN6FMUiOR+FSIK/z1BWbeziHvZlF8WHVlyj1jc1BHomi/Rq/l6ApK5Rzjqehckes",
+	"FzcmvpY9kNy4yh8GEbXXg68BAsr/5nNd21kKdgXxbqGPiUmP7lscJeMoNiMsDfQ8zMzqAM+ut+K+/oU2",
+	"0jorhBHgJ30B7s2IyxCK8EDZmJE6ESRCPQfpLgMk+UIomGjhw0X3yKNsgduGPYXRMgfhrRWZtEfGA7ui",
+	"3nI0b+uaPFiBmGL5GeqCaGKsEAkryiwTEXEUesLFcccOfWW/+zxNvqLsdjNRH97DuZjsdNP2IcRMdTAf",
+	"n645ccLt3my8kdzpAAsT4xzkxDujtKvk8GbGYdRE5lVmWV98NoMVbnAqxy3cLGmcybqrbKkAoqRCV7A5",
+	"seprl14o7HgMtH0DWdCj3PwtojiqzU2l4F4cBbxPmwm5FKKY9Hg4nHdL+7QPwxUzXqnEXFY+ws684h40",
+	"j42ZhHyGhvXg+3az3PjCNWUJHPKHU0LOuI1y9m5wzaLXrcn5A71t/jXOmle2WJezpE3f8XS4KBbNknfk",
+	"fn6YLTyvjzcp4Pmd57eDHDC7XvM+X98brK7VLE0/Haqe6/qptUSoiPwsFCkB6sI6tHyFLCGhByCYXCrK",
+	"goZ+TpQ4RxiiCpGKJjokAZYZKo2peDIESAMfoG6poXCDJxHgnIV3ZLp2n30uZzEnEmofs0OTWrs80ZaJ",
+	"qz7VXnvmMEuTM86FhHhG9Je3Oe/9+UXWhC6hcsa0pHJzSOrpJqpSatReLO/0+g4O3/VCaqfvLg6LQtxM",
+	"kK1NQqG6lDrLtFPNa9uXxq77maM+g8h9nConIm7IkuYkE1JCFvdIGwgtVCshYWJqGySNm6/YXJtHwgrj",
+	"0zkpxIKI0qhQbU3JNAX1zVVxTlH2gsglN4kCSztmpa5PRMcDpzS3r3UzmaC8trNmkd/8S9PHpuGpU4ra",
+	"RU+sq1NPnBQol9TSYcg27sKLhGMTyrWNCmkRec7WSDcgU0d+TrQ0sX2uBY7eICE8+FQCWTGlLCiBlm5Y",
+	"UWAWHLau+QEEv8Y0antk53OM57hm6LjbzIiEPYyknEFIIxXzgIs4oSTRSymqxTIqtRLg9IoTWTm1SjzK",
+	"T6pC32oMdTdTPCUrobR7FtuR6iXXruyfZYJrKYqiqYi2cv7COa/8QNdnWaZfCXFlMhs9xEc46hTcSvOx",
+	"Tw3TjkGoZ5KtvLbDXgrG0RXJQ+2uWGHbGQA8gxjMO1vcr2M422WJisB8v5u57rbLnXUX1l5Xk8+m30Jn",
+	"nFAtVixLH7c/lhd/r+99inulUGF7uGxa2Az5QHyPBbdM5J5dNAOnyYrUZ8TxCOeehpzI/BfF+Pa4ZA5U",
+	"d+aO7tAu33EC1iTrFQNbACCkNqGLrqStlh8LaYHhiIXVSaJzXRvQgRcO+jDfDTYzwtGB0nAnoDpRFQHA",
+	"z6wGY2wT+toIDROw674/rDP+HgT87XYqbzCPPufwi5q0JDYJCfl6OEK6qMtWT+pLTOYzG+pPrbyVe+Dl",
+	"HwHQ72HdgGGQn/W+YMypCcOZUN1z76MObBw9112seDS6L42Ls5CMVr6ouhm7kuASxFnpXzbN4SXVS3+r",
+	"muZdjbjRYTpNP1rnsCT6ODLHQmErprc0CqKcFHANDcdzS8uqQimUXYPvq0JnkgOU6LHQVrSlPKojPLa1",
+	"L27tk8gndwh2k+oYi1i7U2SHriWpGVrziT0mauhRMhBds7yiDfypfUWOpi7RHOUEqjrPh4l/Yg6d5ic7",
+	"wls/wJnvnxJlPCbeD+NDe7OgNOq2MaCdERaV6jv1PB1gEadkDIYinC0PfhmWxGu+oUp6w/u1ml2Sr19i",
+	"A/eJCR4h9us1ZCjVuKcQ5O4x1GM5cSZMpHYOkNsHg+mS0OYvgRMu6hcRqjT9K6ZOSu1/sBNjI8bdQ/sA",
+	"H5M6DuLuO0twMKJaSWOTO1GT9d10/J/kJG49iL3jpWhEgUtJsEU15qnbPTuwgaiKnHCzn0b2x3Lr7hZz",
+	"XHxMZpUfyCgybD34+In6Erw9V/DYxGRX5LOtoiLZotveYF0tCIsi3YxFXEj8hwtN/lnRgs03yGcs+L4b",
+	"UUtqSMgZkK0XkIsfMRNvF6/GHjCviBF+KrtuNnTMaLiNGSUC2lzkvgKmICt6BfE2oIOT5Z+ZNoxTVTNU",
+	"apgru7WdXSy4xfs0cyuax0oATJi9aXAH73Btev+1dveJp/J5bMuCZpA36ng2+YwRhgJx6SWstqdr6PI1",
+	"TwK+VUS00qf7yQ/Qpu7JulKxi30FBxtgR8+IZr3B4yxjoFK4VTduS6KLQUs59i4cJxa9s6S4avquxcVF",
+	"5O9nd5KZ7vuWMQT839GuNNwrOhG6vlho/3qwyX3sQiOhWAJWqwafifVEwlztcqTB1gb4GmAVdLeMZxKo",
+	"sn5H56/ds7VO5M64eUZbr/NgVg2j5DBnvGa1jJeVTryCMJ8730QIi60JiNYe21yfjGFE0WtavL4GKVne",
+	"t3Hm9Ih5nHbeQOItKK5vQgESbuTuAEzVL0DMC1Hr5+Nm5vq3znDW91tpynMq87g54yQDqSkz5vONOtxU",
+	"FawOu4xVNJKFmlmPIrMVkrYFpNg4a/MdDUkBQHpEi9IAS9DlEpJWIKsY0qLH8NOF4Q9hCVrRtTEeYvaC",
+	"ngPh8vWj6RCboYtnRrmV7oat289j6s5tnwYrKTlGpAXOOmSK7ef+NW4lPkJ/4kxvPflWw9lOJ2E99e3B",
+	"9Ejlizq8yBJL9zyWWXqyspkFxIuqPt2Spz2INjHp0t/RqvfsIvpXuPQxsQp9eAHgpgtH4oZxeoUJ6hvU",
+	"lgCi2sUYca2cIqrj8dZWVFikjF2Wlj31dFa77++lHvAMokG5s96cNjjomHH2qZq8PS/LpBTlJBvi22qL",
+	"reUWAA9pE8Ye+ohMCD3rDn43KpQfjKmxWYdw38LRvXUQd9nKymybyqBPydTD0ZsGDDFHXoZH2KrWhIxV",
+	"MWP/OPfG7qYSLTAJQomErJKoZL6hm93FdHuqaFx8d/bs8ZNfnzz7gpgGJGcLUHVtllYx2to1kfG21uh+",
+	"nRE7y9PpTfBZj/BzsF76sM2wKe6sWW6r6qTqnVK8+2inExdA4jgmKnwetFc4Th2U8vvartQij75jKRR8",
+	"/D0z/h/p2lhBrkqYX1K7FRlgzAukBKmY0sB1y37KdO2UrZaoXMTqB9c2x53gGXjts6MCpnt8uVIL6fPp",
+	"RX5mPhFnczIZCArHq6ydaNu63DvN6vdQaER3G6MDE6UT7dmcpCDCGB9ZQdCrO7Up6tMjN93AbK3DbooQ",
+	"nfN7mvSMxwe+hMWcbOf2tZnRM+oEpzebmBAv/KE8gDT7rBv9+ZIO4SS1YeB3wz8SCaCOxjXCcj8Gr0i+",
+	"D7ZkNTjreE2E5EeDQOsm+kmQBwLQE8/fCLqOQhyjGgvS2hjQGuHNz23x44faLL0zMgUh8R12gBfH4tft",
+	"QjCFA+cTFyj4ISAlWsr7PkpoLH9XeL9nveEiibbIKU20BmXZkuiKhVFCB/VVyJPQ8yrppFOQQmhiXqZF",
+	"kUjDYPU4eKZiwmFcg7ymxf1zjW+YVPoM8QH52/7ArTjsPkayRaU6emLhV3QQWAW9X6j4G8wN8fee4OAz",
+	"TtwszvDfuQNRJUQL6+09DxZw4D5+GB27Hn9BZq5sWSkhY6rtUHDjRZoQ7QzSWORwCpPwtxV5fedyZz8L",
+	"fYfjMPf+QOTHyMgWPAcczPVR/8TMqYcDJE9LilQ7hJLAX4rXmQSvw+pc3bXE1WEp6aIEtHumpItXhgmC",
+	"By8P14GXV6Wgu87Bt34Dt4kLv17b0JyLgytlmfKEsyGJEdNVrUx3zNV4lPJWdy9udS+JGi0q3RgOkiRh",
+	"1SL3ruxLLX/JKEtGcxeNuJ/eCQwIMOFJYm4fBfOK2/FCIWeMFfdsXczHwYtBcNPtOXnHHxlvCf+2cH8+",
+	"efbFaDwCXq3M4uvvo/HIfX2feqnl62RcaZ0IquMj6qqiPFCkpJshwew7Uz8l8Vtnurp/kUZpNku/6b4z",
+	"e4YPVxeAcM6R1SN7sTeoy//0ZwKrrcTQOqzhxFiSrNNbha3Ylenq576Uj7aERU/Vohb3NQWOdtri44JS",
+	"JlOATbKHVZZ+dTU373fbPQQ9uUPd0u+Sxs4iJrHWxuTRVFFSwgGFpVy3RKUfcxiNCp7pzYXBv1e7s1+v",
+	"UsnMvg3pxVzOumCBd7KvFlfAvY9ZnYysUl66/lbQAqVP6xjAgWghiin52lY6ctfi3x7M/hM+/8vT/PTz",
+	"x/85+8vps9MMnj778vSUfvmUPv7y88fw5C/Pnp7C4/kXX86e5E+ePpk9ffL0i2dfZp8/fTx7+sWX//nA",
+	"ULoB2QLqK5g9H/3/k7NiISZnb84nlwbYGie0ZCaD2+0tatjmmGATkZrhFQsryorRc//T/+cvymkmVvXw",
+	"/teRq2s7WmpdqucnJzc3N9O4y8kCc6BMtKiy5Ymf53bcwvjZm/MQF2R9/3BHa5vTdFSTwhl+e/v1xSU5",
+	"e3M+rQlm9Hx0Oj2dPjbjixI4Ldno+ehz/AlPzxL3/QSrAZwoV1TspA4dTVr732KYjH/SS+M2/VkIAvyP",
+	"4O+hHvpYwrnLomqCxAx0YRXnORKXdqFb45FVzihLjk9OT/1euHdNJF6emMHMb5Z/pNJ6344TUoIDOAlZ",
+	"XT2/u+if+BUXN5xg6nJ7gKrVisqNXUEDG9HguE10odA0J9k1ZhY1vds4L0tXyq0P5VgduHnKfWckkFDn",
+	"i3Jf/ssVZFMplHfLyN0R+1tT2XcmS+wONnpjYPZpsTw8/iZ0OENPE4uwcEZwR7qIHo/KKoHOrzGYT23D",
+	"2TgqPWahEUUeMN7B6Jvq/xGMGtJdhDTm5q8l0EIv3R8rQ6iZ/ySB5hv3f3VDFwuQU7dO89P1kxOvczj5",
+	"4PJJ3W77dhIhzPxc/zVh+Y6e3o9yV5OTD/jvzgFjs8iJ82+POgwEdFuzk5lY79EU4tX1LwVpXp18QN1c",
+	"7+8nTk5Pf0T1qb1hT/zjo6elzSGU/ujSIso6pV+6XQPVH/Sa5bc7pjVtonkz44RTlScf8D9I3tHKbb2S",
+	"E73mJ+iWdvKB5d3PHYQ1f6+7xy0wzb4HTsznCvSOzycf7L/RRCZXumQr4JoW9a82a/MJlvPfdH/e8Cz5",
+	"Y3cdjeS0Oy59zIasvK9mM6dt8pppJ8pVd2WKw5J1tWZNCOJdiWvbym7Ho6dH5N7N+icJYF7QnPh8LDj3",
+	"4/ub+5zbiBMjglpRGSF4en8QNLaPfA8bLAfwjdf1P7vPnTjnLmGmE/wOFBGHHZ/2dTseRc34wgo0wubo",
+	"aR61szzvEL19a4LSL0S+2YKxlVqUzgekRlr91GbcLGE8TLzuLIvYVJFe4OAih1H8CNaygts78oSW9yiV",
+	"+jxhlULLKwahOetOA9Rk+uC2b50dOVFRYAcJn7/0k9axW3/ylD95SuApz04/v7/pL0BeswzIJaxKIalk",
+	"xYb8xENQ4ME87izPk7num0d/J48zis1M5LAAPnEMbDIT+cbVGR01JrgCq1XrCDInXgvVeFn0cE+v30pJ",
+	"K3Wwyej5LymnKxd6XVazgmVmwVOvgzIKlkhFFNImN7nfOOZkQaFplJSnky/ff3j2l9tkrHU37KqOV9z6",
+	"NVE7i+SsqEL2Gn0jXHaI7iUVaXq0IOqftkIPHm6mN+SG8VzcPAwY+GcFclOjwE8zGqdumi35p7vFZWun",
+	"BwNyB9A+CNBbYusWDLKW9TsibPnWrfd92BoK+qmW8P5ja+hCIlRTcCmKD7d6GOtk6ctB2fNLSikwyMm4",
+	"UypNbbn9r6yGrNhgngNNdaUahbynf96+f954d7/xvg0FFWydbo0ldrtMM7oBp4PE/OSN9qHxp9PqjGyI",
+	"SyqlvvmdULJgxompey3PNuT8ZefNbru1L8IXm/OX3bswccm1QdzKp9rsoIe9bBPkzEIWQodAH7uoP0Xr",
+	"P0XrOz3XBx+eIS/2pD7tWxyYdl6hY3fXNYMpsSwGuhJ0QBmidfukx/coG9/V6KU0eLZ8h4nkrT/YfEFt",
+	"NP/JIv5kEXdjEd9C4jDiqXVMI0F0+2n4hjIMTG6XN9zWvdThm1cFlVGShV2K+zMcMf0A/ihc477VlElc",
+	"5XmIxWI2CCGxgcfVXP7J8v5keX8clne2m9E0BZM76/quYLOiZdDwqWWlc3ET+Q8gLAhKwkJrH/7tv09u",
+	"KNPGzdnV9sNibd3OGmiByGYFtH7NmaJKwWrW/SI3sorAa6QHTf56Qpum2sY3ZL19HTs+B6mvzlze08jn",
+	"pfGfa4/G2EMQ2X7wDfzlvWHZCuS1vxFqh7fnJyeY5mwplD5BjVfTGS7++D6Qx4dwjzgyuUW6EJItmKme",
+	"6TxH6urjoyfT09Ht/x0AmS9bxnM1AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
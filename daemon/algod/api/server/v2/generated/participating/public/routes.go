@@ -178,238 +178,252 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3Mbt5Io/lXw426VYy1JyY6TPfGvUnvlOA9t7NhlKdmH5ZuAM00SR0NgDoChyPjq",
-	"u99C4zGYGQw5lGQ72Zu/bHHwaDQajUY/348ysSoFB67V6On7UUklXYEGiX/RPJeg8L85qEyyUjPBR09H",
-	"p5zQLBMV16SsZgXLyBVsp6PxiJmvJdXL0XjE6QpGT8Mg45GEf1RMQj56qmUF45HKlrCidlqtQZq+b08n",
-	"/30y+erd+y/+djMaj/S2NGMoLRlfjMajzWQhJu7HGVUsU9NTN/7Nvq+0LAuWUbOECcvTi6qbEJYD12zO",
-	"QPYtrDnervWtGGerajV6ehKWxLiGBcieNZXlGc9h07eo6DNVCnTveszHASvxY9zrGsygO1fRaJBRnS1L",
-	"wbhOrITgV2I/J5cQdd+1iLmQK6rb7SPyQ9p7NH50cvNPgRQfjb/4PE2MtFgISXk+CeN+E8Yl57bdzQEN",
-	"/dc2Ar4RfM4WlQRFrpeglyCJXgKRoErBFRAx+ztkmjBF/v381U9ESPISlKILeE2zKwI8EznkU3I2J1xo",
-	"UkqxZjnkY5LDnFaFVkQL7Bno4x8VyG2NXQdXjEnghhbejv6uBB+NRyu1KGl2NXrXRtPNzXhUsBVLrOol",
-	"3RiKIrxazUASMTcL8uBI0JXkfQDZEWN4dpJkxbj+8kmbDutfV3TTBe9CVjyjGvIIQC0pVzQzLRDKnKmy",
-	"oFtE7Ypuvj4ZO8AVoUVBSuA54wuiN1z1LcXMfW8L4bBJIPpiCcR8ISVdQITnKflZAVISftXiCnigDjLb",
-	"4qdSwpqJSoVOPevAqRMLiehAioqnGBXBDw7NPTzK9r1PBvUGR7zZ/U2xhfvUhvqcLS62JZA5K8x9Sf5e",
-	"KR0IuFK47UsgqoTM8N6cmGEM8hVbcKorCU8v+ZH5i0zIuaY8pzI3v6zsTy+rQrNztjA/FfanF2LBsnO2",
-	"6NmBAGvqnCrstrL/mPHSR1VvknfJCyGuqjJeUBafBUMrZ8/7KMOO2U8aaQZ5GuQG3B831sXm7HkfS93d",
-	"Q2/CRvYA2Yu7kpqGV7CVYKCl2Rz/2cyRtOhc/j6y4oXprct5CrWG/B27RoHq1MpPp7UQ8cZ9Nl8zwTXY",
-	"qzASM46R2T59H0tOUpQgNbOD0rKcFCKjxURpqnGkf5YwHz0d/dNxLegd2+7qOJr8hel1jp3MZSzBML4J",
-	"LcsDxnhthEcUtXoOuuFD9qjPhSTXS5YtiV4yRRi3m4hyl+E0Bawp19PRQSf5JuYObx0Q9VbYS9JuRYsB",
-	"9e4FsQ1noJD2ndD7QDUkRcQ4QYwTynOyKMQs/PDZaVnWyMXvp2VpUTUmbE6A4X0OG6a0eoiYofUhi+c5",
-	"ez4l38djX7OiIIIXWzIDd+9Absa0fNvxcSeAG8TiGuoRHyiCOy3k1OyaR4ORy+6DGFGqXIrCXIF7ycg0",
-	"/sG1jSnQ/D6o85+e+mK099MdSvQOqUhN9pf64UY+axFVl6awh6Gm03bf21GUGWUHLamzGsH3TVf4C9Ow",
-	"UnuJJIIoIjS3PVRKuvUS1AQloS4F/azAEk9JF4wjtGMjkHOyold2PwTi3RACqCBpWzKz4tU108ta5Aqo",
-	"n3beF39uQk7tOTEbTpmRjUnBlDbCEG6mIksoUOCkQbEQU9GtiGYALexYRID5WtLSkrn7YuU4xgkN7y8L",
-	"6x1v8oGXbBLmWG1R4x2hujUz38twk5BYhUMThmeFyK5+oGp5D4d/5sfqHguchiyB5iDJkqpl4ky1aLse",
-	"bQh9m4ZIs2QWTTUNS3whFuoelliIQ7haWX5Di8JM3eVmrdXiwIMOclEQ05jAimnzAGYcT8CCrYFb1jMl",
-	"39JsaYQJktGiGNd6CVFOClhDQYQkjHOQY6KXVNeHH0f2DyU8RwoMH9RAotU4ncaUXCxBwlxIfKhKICuK",
-	"l9PKPI/KotknMFdFV9CSnfCyFJU2MEYvl7PnfnWwBo48KQyN4Ic14oM/Hnxq5nafcGYu7OKoBFS0MJ4V",
-	"VV7jL/CLBtCmdX3V8noKIXNU9FBtfmOSZELaIezl7yY3/wEq686WOj8rJUzcEJKuQSpamNW1FvUwkO99",
-	"nc49JzOnmkYn01Fh+kVnOQf2Q6EQZEK78Qr/QwtiPhsBx1BSTT0M5RSUacJ+4J1tUGVnMg0M39KCrKze",
-	"jJQ0uzoIym/qydNsZtDJ+9aq6twWukWEHbrYsFzd1zbhYH171TwhVufj2VFHTNnJdKK5hiDgQpTEso8W",
-	"CJZT4GgWIWJz79faM7FJwfRMbDpXmtjAveyEGWcws38mNs8dZELuxzyOPQTpZoGcrkDh7dYwg5hZalX1",
-	"6UzI20kTHdNErYAn1IwaCVPjFpKwaVVO3NlMqMdtg9ZAJKiXdgsB7eFTGGtg4VzTD4AFZUa9Dyw0B7pv",
-	"LIhVyQq4B9JfJoW4GVXw+WNy/sPpF48e//r4iy8NSZZSLCRdkdlWgyKfOT0fUXpbwMPkwwmli/ToXz7x",
-	"BpHmuKlxlKhkBitadoeyhhb7MLbNiGnXxVoTzbjqAOAgjgjmarNoJ29sv5vx6DnMqsU5aG0ewa+lmN87",
-	"N+zMkIIOG70upREsVNMo5aSl49w0OYaNlvS4xJbAc2t6M+tgyrwBV7N7Iaq+jc/rWXLiMJrD3kNx6DbV",
-	"02zjrZJbWd2H5gOkFDJ5BZdSaJGJYmLkPCYSuovXrgVxLfx2le3fLbTkmipi5kYDWMXzHhWF3vDh95cd",
-	"+mLDa9zsvMHsehOrc/MO2Zcm8utXSAlyojecIHU2NCdzKVaEkhw7oqzxPWgrf7EVnGu6Kl/N5/ejIxU4",
-	"UELFw1agzEzEtjDSj4JM8Fzt1eZ4a2ALmW6qIThrY8vbsnQ/VA5N51ueoRrpPs5yv/bLmfqI2vIsUoUZ",
-	"GAvIFw1a/aAqrz5MWSgeqASkBlMv8DNaBJ5Doel3Ql7U4u73UlTlvbPz9pxDl0PdYpzNITd9vUaZ8UUB",
-	"DUl9YWCfptb4SRb0TVA62DUg9EisL9hiqaP35WspPsAdmpwlBSh+sMqlwvTpqph+ErlhPrpS9yB61oPV",
-	"HNHQbcwH6UxUmlDCRQ64+ZVKC6U9XjvmoGaVlMB1LOeiPoMpMgNDXRmtzGqrkmiRul/qjhOa2RM6QdSo",
-	"HjeH4KphW9nplnQNhBYSaL4lMwBOxMwsuvZywEVSRUojOzuxzonEQ/ltA9hSigyUgnzi9Nl74fXt7P2j",
-	"dyAPV4OrCLMQJcicyg+zgqv1XuCvYDtZ06Iy4vmPv6iHf5RFaKFpsWcLsE1qI9rqu+5S7gDTLiJuQxST",
-	"stUW2pNgRGzDdArQ0Ifsu2Ovd/vbYHaI4AMhcA0SPWo+6NHyk3wAogzwf+CD9UGWUJUTIwb2qh+M5Gr2",
-	"m1MuvGy4Z4YwQUGVnuy7Ukyjht7ELDXi4qlbBAfukSdfUKVRDCSM56i/tVchzmNlSzPF6ECnMpyy9zVm",
-	"Jv3FP8S602bmeueqUuFVpqqyFFJDnloe2qx75/oJNmEuMY/GDk8/LUilYN/IfQiMxnd4dIoA/IPqYKF2",
-	"Nu/u4tDrwIgv20Ox3ICvxtEuGM99qwjxsVNtD4xM1XtgyY2pFr3NhCiAospUaVGWhkPpScVDvz4MntvW",
-	"p/rnum2XJK0ZyEoquQCFJibX3kF+bZGu0Na1pIo4OLx/Aiq8rItcF2ZzrCeK8Qwmu84LPoJNq/jg3Oq4",
-	"V+VC0hwmORR0m/C2sJ+J/XwgYfixkUBq/YHQMJmhNTFNI/WZ8P6mt5tV4FQqJXgT/EIyc87NM6omNdf7",
-	"9pPmgNOm+KYj1gdhFgQjSQd+PESWpafEiHj3r4U2ZOWIDlfjbqU7rqUHe2HWD4JAHHdSKwLas/8XKDd3",
-	"EMDudf4tqL6F11Pf17J71P94tzcuzNZV1rptkldEL1/ewxj7eFCPLeI1lZplrMTn6o+wvffXe3uCpK8E",
-	"yUFTVkBOog/2JV/G/Yl1Q26PebvX/CB1axf8jr41sRzvmdUE/gq2qDZ5bSMaIm3VfagjEqOaC5dygoB6",
-	"r3nz4ombwIZmutgawVYvYUuuQQJR1cx6rXRNaFqUk3iAdMxU/4zOIJ80h+/0EDjHoaLlpTwP7WtrN3wX",
-	"rSdXAx3ulVUKUST0n+0T30FGEoJB7kKkFGbXGS2KLdEhbMZTUgNId0GgN0aQZx6oBppxBeS/REUyyvGF",
-	"W2kIQpqQKPmgsGxmMOJmmNO5qtYYggJWYF/z+OXoqL3woyO350yROVxblxuODdvoODpCVdxroXTjcN2D",
-	"ttsct7PEpYO2SnPJuldbm6fsd3JzIw/ZydetwYOB05wppRzhmuXfmQG0TuZmyNpjGhnm4IfjDjLfNV3C",
-	"OuvGfT9nq6qg+j4MlbCmxUSsQUqWw15O7iZmgn+7psWr0O1mPIINZIZGM5hkGCU4cCy4MH1sYKEZh3Fm",
-	"DrANHBkKEJzZXue2056Xdu23zFYryBnVUGxJKSEDGyVnpFQVljolNmQiW1K+wBeQFNXCuTrbcZDhV8pq",
-	"wmTFO0McKorpDZ+gCUMlw9TQbOmjLY0QBtS8bNv2D/tYu6YBFHsZDbq0o+1p24OSJtPxqPfhb/C9rh/+",
-	"Fm/NkNHbGhMb8mGEtBqagdYzxKeRlbpIjLfRHD5DDB/GSlMPnYKyO3HkFF5/7PMLP6/Kstjeg5BkByIS",
-	"SgkKr7RYDajsVzEnL1kmxWmxEOHOU1ulYdU13tiuv/Yc1ze3eQELXjAOk5XgkHjSv8KvL/HjYLWjvYZ7",
-	"RkSB6KAB2w+fBhJaC2hOPoSk77pJSDLts9+2dKrvhLwvK7sdcPCbYoDleq9bh5vytvZ1WhQJk7RVP3S4",
-	"iBoHp3AmCVVKZAwFxbNcjZ33ubViW7f2Fvpfh9CoezjA7XFbttcoDMsq8qEoCSVZwVDNL7jSssr0Jaeo",
-	"6YuWmnAW9MqBfrXwN75JWg+dUBO7oS45RUfRoP9LOgbNIaGH+g7Aa4dVtViA0q0H1hzgkrtWjJOKM41z",
-	"rcxxmdjzUoJEj72pbbmiWzI3NKEF+R2kILNKN58cq0ppojQrCmcINtMQMb/kVJMCqNLkJeMXGxzO+5H4",
-	"I8tBXwt5FbAwHc64FsBBMTVJezp+b79iUInDydIFmGCshf3sPZ7r3BAjs/ZG0or//dm/PX17OvlvOvn9",
-	"ZPLVvxy/e//k5uFR58fHN19//X+aP31+8/XDf/vn1PZ52FPB4A7ys+fujX72HB9iUZxIG/Y/gkFmxfgk",
-	"SZSxQ1GLFslnmC/DEdzDpt5PL+GS6w03hLemBcsNL7o38mlfU50DbY9Yi8oaG9dS43kEHPgcugOrIglO",
-	"1eKvH0Sea0+w0+Em3vJWjIHjjOreAXQDp+Bqz5lyq33w/bcX5NgRgnqAxOKGjlILJF4wLoKx4eVjdikO",
-	"7Lrkl/w5zPE9KPjTS55TTY/taTquFMhntKA8g+lCkKc+KPI51fSSd66h3gRSUVBzlEEqxSnoKr2Wy8u3",
-	"tFiIy8t3HT+Ermzlpoq5qDtnXTWZn3Ji5AZR6YlL4jKRcE1lyhbiU3y4aGjsvRMOK5OIyiqxfJIYN/50",
-	"KJRlqdrJHrooKsvCoCgiVeXyFZhtJUqLEDhmmLmLvTU08JNwTiWSXvsnb6VAkd9WtHzLuH5HJpfVycnn",
-	"GIJXpzj4zfFAQ7fbEgY/fHuTUbTfu7hwK5ejU/mkpIuUzeTy8q0GWiKFoMCxwpdmURDs1ggP9JEAOFS9",
-	"gBCLfMCWWMgOjuvF5Z7bXj6tV3pR+Ak3tRk7facdjKLib72BeyLraaWXE8MRkqtS5hj4vfIJBujCXDne",
-	"g0CxBT4A1FJUZslAsiVkVy6zFaxKvR03untHF3cXe4bDFOqMXHDgnBn8ZZSbAasyp06QoXzbTnGjbDAE",
-	"DvoGrmB7IWz36cDsYFE2uijFiuo7uki70V1ryDc+yG6M9uY7vysfI+rSkWDcpSeLp4EufJ/+o20FgHs4",
-	"1imiaOT56EMElQlEWOLvQcEtFmrGuxPpp5bHeAZcszVMoGALNisSbPo/unYND6uhSgkZsLWP6g0DKsLm",
-	"xLyOZvY6di8mSfkCzKVuLmKhaIFO+9OkoR+lwyVQqWdA9U59LY/TTHjoUCC/xqBpVJqMzRJgY/abaVSC",
-	"cLg2Dzx8e9s2zpF4eit3KrsmyG8Jqu9eB0lPb/OIcAhP5LPz933Yk/BecP5pMXUiyPb7yuBwIcW12U0D",
-	"oPCpGzHBS3RPVYouYOh11DAVDUyJ0bAA4SD7pJ+kvCPmbbGmI2MMXITtPjF4SXIHMF8Me0AzQMvF0c9t",
-	"TYjOqvCKF1uP1FmBAnVwELWkQ2XDzsYXhwGbZmMgeS2sesCaWIuP/pIqf/TzccTRbyktfppUMrvy551F",
-	"3ndUd7Pj+Wu6zdrHVp8zAyK46eGz6PnUeT5f3mh8UO678ciFOKT2TnCUonMoYGFxYht7OqvzM9W7aeB4",
-	"NZ8j05ukHPkiZWQkmbg5wDzEjgixGnMyeITUKYjARss6Dkx+EvFh54tDgOQuvxT1Y+PdFf0N6WBB641v",
-	"pGRRmluf9VitMs9SXHqLWuRpuTjjMITxMTGcdE0Lw0ld4Gk9SCdXG759WpnZnG/Hw7430cCD5taI0slB",
-	"q7TyzG3WFwvefhnpV8FBa5iJzcRGRiefVrPNzJyJZLwCxmmnDq/NnPdAkZnYoE8R3nDWwf1g6Poh84BF",
-	"biAbppDKsV+f2GjBOwyQ3YJ8ipoVkp7TqwWy65NkbwdMjzjdR3afRSn07gmklgKzTgPuNDp79SxNaasr",
-	"idTX7Thkhw1hailW03c4kzvZg9Gu8rSZ6+6HOt1hf3I0f1Y/SpK/rlLuLnkZbefS5lo8JC1jmxwaQOzA",
-	"6uu2EJtEa9NxqYnXCGsplmQYfdfY1UWbggJQEzBpyNWTq5RZ+vLyrQKUGc59t0jPibtH+fZh5A0nYcGU",
-	"htq44J1cPr7tB9WJ5rEl5v2r06Wcm/W9ESIIGtYcix0by/zoK0DX9TmTSk/QMpNcgmn0nUJN2nemaVoQ",
-	"bvrbMWVNPQfLwQjRFWwnOSuqNCk7kH58biD6KdxcqprhRcm49TaaYSr8pIPuAbZJhMc6du9E0AuLoBf0",
-	"Y+Bn2MEyTQ1M0lBec/o/yRFr8cJdnCVByyli6m5oL0p38Noolr7LaCMhOnK7mO6y+XTOZe7H3uuN5SP6",
-	"+4QIO1JyLVFGxHQAoVgsIPeZ3lxQqM165fLpFYIv6lyC5vcd6QOnxGbxwyR8O/L3Ofd06HNOb5QTwaoY",
-	"SejjxwxCXkfXYe5BnGQB3GZuGR1eb6RIIi52jMcWkWb04/L2jtt80nX4ouUuXPv02j0Mm43bUwDN3bNK",
-	"gV/f7kPb3S6HunGf03EjRezuA4YDIsUxrSIBpkM0PZybliXLNy3Dnx11eguSGCjudTPBt3DG9qKl6U+8",
-	"p0TPA3MpYntn4zjG1/2xeVtaN2bniGuOBM1ckoG8kmhEajgJd9Poh/flwCX/+Mu5FpIuwBkCJxakOw2B",
-	"yzkEDVEmekU0s37ROZvPITaAqdsYbxrAdcwc+QB67qG8rpUsPCl3kuXBtFWvYD9C0/SUoJQ+V4uLrhnS",
-	"vzcilVq4Y6KNu4UtMZlH4EfYTn6hRWUeQEyq2iXV2QWbt/kBNLFe/QhbHHmvp6cBbM+uoAbuDSCFpowq",
-	"4ZOKkoM/UI2iC/j0bWzhATt1mt6le9oaV0Gj/2jUF1OjjERzKR/u2NSeMQbSIXt1nnY2MWcLmtvSJvR9",
-	"W8Ty/SJP9PKIp2LotHGbuy0k2NjrVAa08ISPix3djEd3c/PosrAw4p6deB1u5OQuoBOmNfs3fL0O3BBa",
-	"llKsaTFx7jF9soYUaydrYHPvTfORn1XpU3Hx7emL1w78m/EoK4DKSdBw9K4K25V/mlXZyhu7ryGbhd2p",
-	"dK0GLNr8kCk7dqC5xozrLSVap8RN7S4VHVTnUDNPO4jv5ZvOs8sucYeHF5TBwas2RFv/rqZPF11TVnh7",
-	"r4d2qHLdLndYUaUkn4gHuLNvWOT0d+exFPsdJuhZKnr8slTAr7sZnScqM7jEGDiLbYvlNm28fPbm8M3v",
-	"jVm4vHy79uDUth3rtBXS8yf8+tQtva47DDDNQOoDuIdtI/JfYVbV9BuQu5yryK2d8xu9d+H0OyEbt6eL",
-	"sEw6z304qdW8cCwe0w4CF84joCOrTomVa39b/GYY1tFRTHFHR2PyW+E+RADi7zP3Oz7ujo6SRuqk2tHw",
-	"UdQqcrqChyFGo3cjPq5KhMP1MBnmdL0KgrvoJ8NAodYLzqP72mHvWjKHz9z9kkMB5qfpELVJvOkW3TEw",
-	"Q07QeV+EZHDEXtnSoooI3s4HgBG7hrTwPnTVRKzNv3uEeLVCG/hEFSxLOyDxGXJIbt2LTWOCjQfbs80c",
-	"FevxcecVi0Y3zdStzK+thUSzJhGuklmJa/zOhGMBFWf/qCAqMYxXQEti8O8zHLUj9ad1nW7gdgXj0W2K",
-	"D9/dXGmB7EVVr9X3ebBE+vWnSl0dGHIRz9jh+TvCJRwh+VsTY+uWznt5L0HtfHPurkPtLNGeazqjb/9j",
-	"zVXktHv4fMgGMzWZS/E7pEUGtFMmsod4AztDG8DvwFNusm3+FZwX6prZ9ez7CGS4nqOPVO6s1/CLDoX7",
-	"bnNzp9nDYRt9oAIj2u9+FYZKZzh3m9D3aI59X5qxPD08DA9s5JmO5YS8xx3l9oTa1BqN4Lf0OY9jVY/t",
-	"+PU5dzB34nsLej2jqVpL5u1qYIq2v+EbqAXxnf0GqZAdws5OonCK0JbZfIMlyNqA1c3WfMt3qJ128Au0",
-	"fnAixcVPzbF1lymUSAxT8WvK0ZUR+1kO6HorsK4gpte1kJhjVKXdGHPI2CqpmL+8fJtnXeeznC2YrWpe",
-	"KSB0rl2qSTeQrWtvqcgVFA/pUBxqzubkZFyfWb8bOVszfIhhi0e2xYwqvJeDW0boYpYHXC8VNn88oPmy",
-	"4rmEXC+VRawSJOgKUOIMzrgz0NcAnJxgu0dfkc/QZ1mxNTxMXzBORhs9ffTVeFfxbsQ41qnfxeRz5PI+",
-	"liJN2ejYbccwbNWNmg6OmEuA36H/PtlxvmzXIacLW7oraP/pWlFODUJSMK32wGT74v6iN0kLL9xaikBp",
-	"KbaE6fT8oKnhWD0B7YYhWjBIJlYrplfOWVWJlaGwuhK6ndQPhyX+fCU2D5f/iF7gZeJp/wleWXTVE2SJ",
-	"jv0/ock/RuuYUJs0tmB1CIgvkkvOfHJsLE0XKtJZ3Ji5zNJRTMWIkDkpJeMaNViVnk/+Zl7tkmaGIU77",
-	"wJ3MvnySKPHWrILEDwP8o+NdggK5TqNe9pC9l3JcX/IZF3yyMhwlf1hnlYhOZa+7etrFuM/zuWfoO0vX",
-	"ZtxJLwFWDQKkETe/EynyHQPekTjDeg6i0INX9tFptZJpgqGV2aGf37xwkshKyFSxjZoBOKlEgpYM1hji",
-	"mt4kM+Yd90IWg3bhLtB/Wgc7L5ZGops/3cnHQmThTrzTQmYnI+n/8rJO0Y+Gdhs63FJaCplQzzpF40f2",
-	"jD1MTdi251uPRPzWg7nBaMNRuljpiTixISWhz6dwOWuDZPe8oSF99BuR5h2Psv7REQJ9dDR2ovJvj5uf",
-	"LXs/OhrutZtWE5pfE6i53V3TTqBp+qa2+plIKO18IdHguuaypSQUq8m7zFypMzfGmDSrNX58ueN+QiYP",
-	"9oROHyCPGvzcxs0n5q+4mXUQTj9/aBawTZJPHr5HYRyUPBOboUTUurY8Pf0BUNSDkoFaQVxJp0Bv0mtj",
-	"r8tRRLZm1BkUwrxU4xpcgz1o/kS7YFAz3rEXFSvyX2rjc+tmkpRny6Rf+8x0/NU+A6IGkQYjW1LOoUj2",
-	"tq/lX/2rOvHu/7voGXbFePpTuxa0hb0FaQ1WEwg/pR/f4IrpwkwQo6iZEyxkWSkWIic4T108pWaN3aLq",
-	"qWK2iTQDOOyq0s4xGvM3uJomc1agS2/aDI4tJ5LqHq4qMfp3Xo8IayOnWLWEHR0koWyF17aiq7IAPIRr",
-	"kHSBXQWHVndMGocjR5VRiCrNJ2yJ+WcE0ZXkRMzn0TKAayah2I5JSZWyg5yYZcEG5x49fXRycjLMtoj4",
-	"GrB2i1e/8Ff14h4dYxP7xRUfszUbDgL/NtDf1FR3yOZ3ictVgP1HBUqnWCx+sDHhaBg297qt/hoqFU/J",
-	"95gizRB6o0oBKkV9kudmWtKqLATNx5iX+uLb0xfEzmr7SEDUYfXZBWoAm0ckaeQZnqbVp4DrSZ81fJzd",
-	"2XvMqpWehLqwqWSOpkVdzpa1PLFQNxhjZ0qeW7Vs8OexkxDMbi5XkEdlaK0aAInD/Edrmi1R3zkd7VQp",
-	"9xQkGl5F2XPA2lwUhd6Gml3Iwc0yXCFlW0d5TIRegrxmCjD1BayhmTMyJFx1CnmfQ7K5WllxbglneoD0",
-	"Gip0HboLHjgr+nq3iiRkrX24s+2vTiaCddYPrTd9jr3SoUOt4tUtdwdbtWPj635MyUtn7MgoF5xlWO8i",
-	"JYJjNshhZtUBpUHS9k41cmc5cQyTJbNDjLzDYm8Rbc8yHeK6Tg3RV7PflnDsnxo2rg7hArRyPBDysa9g",
-	"7wx0jCtwNdgMfcUcVciEx1cyRCd4jtyje/x4hAndenSt35lvPzndPKatuWIcdW4Oqe4laA1shWJoZ+eE",
-	"abIQoNxqm6Fp6q3pM73YcATh3fSFWLDsnC1wDOuBaJBiPZK7Q516/2TnD2zafmPauvIJ4eeGJ52d1K/7",
-	"XZKFqLD/qbLvvehPuXz5CLkIuWH8eLQdxLgz7ADvZUOGsEaHPyjxPu+QTaig3xzlW/NktfSGLYgNHk5m",
-	"LmY8AcYLxr3BN52KK0veJbgxeJp7+qlMUm0fHYM43gXQoic0B+P6rcfAXYdqF4MwKME1+jn6t7Eu/t/D",
-	"VkKD+nVB+Zb4Q2GoOxJKvqFFcMxPlPJH6cwJY9ZHuFXcP8VWDFuf+OjgBrr2xqKG7liQ5dB7qi/h6azK",
-	"F6AnNM9Tqe+e4VeCX31wI2wgq0IdshDq2swY36U2N1EmuKpWO+byDe44Xc4UVQpWsyLhcfs8fIQ87DDm",
-	"wppt8d9UEa7+nXEO+AcHoHtv+/ywMgndgPqU9GxoeqLYYjIcE3in3B0d9dS3I/S6/71Suo89/0OElre4",
-	"XLxHKf72rbk44kzhHdd+e7WERN7oRi/wu09JFpLJNrkSXmWdUnPokYGbl9iyFvC+YRLwNS16kj7EVht7",
-	"v1pLRl/qh6w3swnVLoGepqTmCUNUGP0pyKzjdcsy1DVv9rlWW8/qD2k8cfjYifR+S+OPDbui9XqrGUqv",
-	"PfF2Jr+aCA61+blqEF19KS0KkQ3mDG6YU9OpP1uwWK1c8v2EV956JfL4LMTeXABpxmYdlhMRFfiwTX7D",
-	"p1Xyi7xOj9bQjwSiGZo4DdHoljC2QaIePA+MnTqeKFLZOsyS71iB9an+/fzVT6P+jYx2oLulLnt3UoXd",
-	"tzEhaq5NHgvRwMcOHiB4kdZ/qx6VOqanSp8GVyA5+eE7qyAcApJN1XRI6xdDB+8QwELYwlSp0h3dBDmj",
-	"ejs88iNqqLfXcpSYOlJU0S74lHj7WKVn3YSEWqiDaqM2ZKQh9aVSpYzcS8FrYO1F41Li2fpOndJQHQb6",
-	"fIhw2MHHzXh0lh8kPqXKYY3sKCkG+4ItlvpZIbKrH4DmIG1Jk9Rz0hY0WYF5hqolK/H9UwrF6pLEhRnM",
-	"5RJf4nDToRE5F0twiWl8woLOWN6Beg2ZxhLVtRuoBBju51Cml2gg8AZFbPIJXEEkQA6lXu4Ulqxzd6mX",
-	"deVScAFnTJEZONPFGviYsClM2zFqeZ2XihRA514JK4XQA0r7em2LRWMMdIq+OmWid4uBnbRzUVZFW813",
-	"OrwOzGmICbDxlddU1cmrWikdBoeOz+eQYc79nRkA/2MJPEoJN/aqO4RlHiUEZCFKEKtG3KtGu4Z1Vy6+",
-	"naBGZbE+JKR9yTmuYPtAkQYNJYsSh8Da2yShR+RYO66va9Bn2nCOkUwFekIEeT94VwOgLvN0mzoEUYLM",
-	"W4LhadxcT3XSzNtB4yWaW4Bhuh44aW9GPhRM+xIMdgu897+Un2M9feWcSmnIeB/rk8hZtyL0tcuYj7ke",
-	"g7XQ584H5X/zOWLtLAW7ckVyEGHWNntNZe5b3EumPntvsjTQ8zAzqwOjul4+h/rl2AjFrBBGAJr0BYY2",
-	"I5WCC+8DZX2t6wRqCPUcpIQ82AQLoWCihQ+zOiD/qAuf3IE962V+K7y1PPoPiBS2K+ot4/CmrmWBFSkp",
-	"lm2gzvk8xgqRsKIGehnVl0irQfft0Df2u89v4isM7lav9uE9nIv9Rbp96B1THczHp2tOnHBwMPdqJEW5",
-	"hWaWcQ5y4o247eoSvJmpE1M751VmRZX4bAbt9eAUaDu4WVKpmXVX2XpCRck4rmB7bNU+vvC53/EYaCtD",
-	"WtCjnNYtorhXXbVKwb24F/A+bQbRUohi0mMZPOuWxGgfhiuWXQHmhg2RKUYKftA8NmYS8hkapILPyPVy",
-	"6ws+lCVwyB9OCTnlNjrQu480i6C2JucP9K75NzhrXtkiN04DPb3k6TArLDYj78j9/DA7eF4fb1Jg+OUd",
-	"57eD3GJ2veF9PnLXWJWmWap4OlS90fXvaIlQEflZKFIC1Lk1BH+DLCHxjiKYlCXKHoT+AZQ4AzJRhUh5",
-	"4d8mcYwZKo2peDIESAMf8FytoXCDJxHgnOz2ZIh1n30OVDEnEmrfjNsmg3X5VS0TV32qkfbMYZYmZ5wL",
-	"CfGM6Gdqc0WHyDZMtYz/mTEtqdzeJmVrE1UpNVQvlvd6SwZHyXohtbNkF4dFIa4nyNYmocBTSh1g2qnm",
-	"te1Lpdb9zFGfQeR2SZUTEbdkSXOSCSkhi3ukQ7wtVCshYVII9MJMOXbMtXkkrDCuk5NCLIgoM5GDrcWW",
-	"pqC+uSrOKcpeELmyJVFgaQdTBtg+ER0PnNLcvtY8O0F5bW+tD7/5F6aPTV9Rp+Kzi55YF4Ge+AJQLhmc",
-	"w5Bt3IXXpo3DRExtpWxaRJ6zDdINyNSRnxMtKxgT18IKJDEJ4cGnEsiKKWVBCbR0zYoCs0ewTeTQEPyB",
-	"0qjtkZ3P0A96zdDhrZlJxIrUpbkdQ/qVmAecx4nYiF5KUS2WUYmCAKd/usvKPezjUX5WFfokYoiomeIJ",
-	"WQml3bPYjlQvuXYB/SwTXEtRFE1FnpXzF87o+5JuTrNMvxDiakazq4f4COdCh5XmY59Soe27W88kW/kg",
-	"h70U9IZPkDzU/kzvth16tTp6Hsw7W9yvY3jYp8mPwHy3n7nut2ucdhfWXleTz6bfQqecUC1WLEsftz+X",
-	"92uvz2qKeyUTLNpCyDYLDTZDPhDfY8GdCblnF83AabKS6ylxPMK5dSAnMv9FMb49LpmD40E9d2iX7zgB",
-	"a5L1ioEtABBSmwhBV9JWT46FtMBwxMImTkGnlDagAy8c9P27G2xmhHsHSsOdgOp4IwcAP7MajLFNhGk9",
-	"m2di478/rDNl3gr4m91U3mAefU6V5zVpSetW6RNZ9XCEdDGEnR6IF5gEYzbUDzFUwx94+UcA9HsmNmAY",
-	"5J94KBhzygrIJ6lCyWdBBzaOnusuxjIa3ZeUtJw8o5UvRmzGriS4xEpW+pdNc2JJDSmJ0LyrEec5bMDG",
-	"aP0OUthSwuPInAWFrTTc0iiIclLAGhoOmy7bU4VSKFuD76tCZ5IDlGjxbSvaUp6IcaHClvbFrX0S+bIN",
-	"wW5SHWMRa3eK7NG1JDVDGz6xx0QNPUoGojXLK9rAnzpU5GjqEs1RTqCq83yY+Cfm0Gl+tiO88QOc+v4p",
-	"UcZj4t0wPnQwC0qjbhcD2uuZXKm+U8/TjslxKrNgKMLZ8mDXtiRe8w1V0mver9Xsknz9Ehu4T0zwCLHf",
-	"biBDqcY9hSB3j6Eey4nLgYTUzgFy+2AwXRLa/CVwwkVUdvmaqvCKqZO5+h/sxNiIcffQvoWNvvYfvvvO",
-	"EhyMqFayxXSV1EDWd9Pxf5KTuPMg9o6XohEFLpR3h2rMU7d7dmADURU54WY/jeyPZYrdLea4+JjMKj9Q",
-	"UYhrW0c5fqI+B2/PtdTnTUxOLGfhWvZ+0mOXZ7itBWFRhMiKbomQ+I95kP6jogWbb5HPWPB9N6KW1JCQ",
-	"MyBbLwrnd20m3i1ejT1gXhEj/FR23WzomNFwWzNKBLS5yH3lOEFW9AribUAHEcs/M20Yp6pmqNQwV3Zr",
-	"O7tYcIv36ZlWNI+VAJhodtvgDj7Puen9/9dhq/FUPv9jWdDMV8129e+afAYL63vi0ktY7Q5z7vI1TwKh",
-	"WH9NtNKnychvoU09kHWlYn76CnU1wO5UIe/UKLvTMgYqhVv1lnYEiA9ayn3vwv3EcHaWFFcb3re4uPjy",
-	"x9mdZIbovmUMAf8PtCsN94pOZFu6iHu8Hluv/SPsQiMRTwJWqwafic1Ewlztc6SxevCZ2NQAq6C7ZTyT",
-	"QJX1Ozp75Z6tdQJkxs0z2nrtBrNqGCWHOeM1q2W8rHTiFYR5kPk2QlhsTUC09tjm+mQMI4quafFqDVKy",
-	"vG/jzOmx1YnjgkHeguL6JhQg4UbuDsBU/QLEeOpaPx83M9e/LXZofWeVpjynMo+bM04ykEZqINd0q25v",
-	"qgpWh33GKhrJQs1sIZHZCknbAlJsnbX5joakACC9R4vSAEsQOmknrEBWMaRFj+GnC8OfwhK0optJIRYY",
-	"9dtzIFyeazQd2gek4KhEt9LdsHX7eRT7HXZPgxVIHCPSAmcdMsXuc/8KtxIfoT9zpneefKvhbIdhW09n",
-	"ezA9UvmiDs+wxNI9j6nIeZeYKY6e96KqT1PiaQ+iTUy6RHe06j27iP4VLu1CrEIfXjiz6cKRis+3eoUJ",
-	"6hvUjgAMUHVcAc2ch1hXEddRVFikjF12gwP1dFa77++lHvBQkaLcWW9OGxx0zDiHVBvdnc9gUopykg3x",
-	"bbVFinJnZHCQNmHsoY/IhNCz7uB3o0LZrkZOtEb9rkMLrvbWD9tnKyuzXSqDPiVTD0dvGjDEHHkZHmGr",
-	"WsNYq6CKGfvHuTd2N5VogUkQSiRklUQl8zXd7i9C2ZN9/vyH0y8ePf718RdfEtOA5GwBqq5p0CriWLsm",
-	"Mt7WGn1cZ8TO8nR6E3y2EIs4b730YW9hU9xZs9xW1cmIOyUsD9FOJy6AVHButzLerfYKx6nDIv5Y25Va",
-	"5L3vWAoFH37PpCiKdE2ZIFclzC+p3YoMMOYFUoJUTGnDCJv2U6Zrp2y1ROUiZg1f29xQgmfgtc+OCpju",
-	"8eVKLaTPpxf5GeZicDYnApuycLzK2ol2rcu906x+D4VGdLeZASlF6UR7NicpiDBmS1YQ9OpObYr69MhN",
-	"NzBb67CbIkTn/J4mvVPuXsJiTnZz+2ZZcJ3m9GYTE+KFP5S3IM0+60Z/npHbcJLaMPCH4R+JxCn3xjXC",
-	"cj8Er0i+D3ZEhZ92vCZC0pBBoHUTZCTIAwHoiYduBK1GQXZRbnJpbQxojfDm57b48bI2S++NTEFIfIc9",
-	"4MWxzHW7EEzhwPnEib1fBqRES3nXRwmN5e8Lj/asN1wk0RY5pYnWoCxbEl2xMAqIV9+EOPOeV0knHF0K",
-	"oYl5mRZFIozd6nHwTMWEY54Eck2Lj881vmNS6VPEB+Rv+gO34rDlGMkWlereE3K+oIPAikKUPwpU/DXG",
-	"1v8HmJ1N3o5uFmf479yBqBKihfX2ngcLOHByjWNax65HX5KZK/dTSsiYajsUXHuRJsTbgmRz518LG92O",
-	"/b1zmaBfhL7DcZh7fyDyU2RkC54DDub6qH9i5tTDAZKnJUWqHUJJ4C/F6+IC73uunTuWhrldKqcoceOB",
-	"qZy6peuHLg/XgZdXpaC7zsG3fgO3iQu/XtvQXGWDK8xcXr7VsyEJxdLVYEx3zHF2L2Vh7l4U5qMkOLOo",
-	"dGM4SJKEVYvc+7LXtPwlozwNzV004n5P3filRb8ZDR8F84rb8UIBVIwV92xdzMfBi0Fw0+0pueRHRC2p",
-	"f1u4Px9/8eVoPAJerczi6++j8ch9fZd6qeWbZFxpnUin4yPqqgk8UKSk2yHB7HtT5yTxW2cK+vgijdJs",
-	"ln7T/WD2DB+uLgDhjCOrR/Zib1CXP+evBEA7iaF1WMOJsSRZpwcKW7EvU9AvfWnxber3nmofLe5bsWKv",
-	"k1yjEMvNeLSwScqwOsmvrlbdx912D0FPvkC39LukAbOISay1MXk0VZTUbUBBFtctUSEDI6+zSjK9PTf4",
-	"92p39utVKhnU9yE9k8v5FSzwTvbV4gq49zGrkzlVykvX3wtaoPRpHQO4kTlFMSXf2goh7lr8+sHsX+Hz",
-	"vz3JTz5/9K+zv518cZLBky++OjmhXz2hj776/BE8/tsXT07g0fzLr2aP88dPHs+ePH7y5RdfZZ8/eTR7",
-	"8uVX//rAULoB2QLqK/88Hf3n5LRYiMnp67PJhQG2xgkt2Y9g9gY1bHNMUIhIzfCKhRVlxeip/+l/+Yty",
-	"molVPbz/deTqQY6WWpfq6fHx9fX1NO5yvMAcKBMtqmx57OfBXJaN98rrsxAXZH3/cEdrmxNuasjvZ769",
-	"+fb8gpy+PpvWBDN6OjqZnkwfYT7FEjgt2ejp6HP8CU/PEvf9GLNoHytXjOc4hI7ejDvfytKW6jGfFiEN",
-	"qPlrCbRAFmn+WIGWLPOfJNB86/6vruliAXKKEWP2p/XjY//2OH7v8src7Pp2HHujHb9vJOfJ9/T0/lT7",
-	"mhy/9+X+dw/YKOXu/FwNUpOOEt+Ddmn0rMYykecI7ZNu9DFRWHfH/FRKJsx5HRtxIQf0JkKXWon1RbSs",
-	"eGZdTOwUwPG/L0//E91sXp7+J/manIxdkJNCNUhqepsqIhDaWW7B7vpGq2fb05AJqnbJGT19m1JNOwf1",
-	"spoVLDOviKk/qYYMo4MUhqwZJRoiRvaiQP+AwPYNKz+ZfPXu/Rd/u0l6pHed02qvzp1fOxJmwG+UOanh",
-	"ZiJ8IXfE94puvu7D9sYFzJhx/1GB3NbLX1FzS9dLHSgBJn9NpMz0kY/XrmZ67HQduWP/+/mrn4iQxCn2",
-	"XtPsKkR9+gjgOuo5DgA2PfvW5m7veHlernbhoyu1KJu1BIIe4B0WhUZAkWc9PjnxjNopPSIucOyYSzRT",
-	"SzTuUjN6EEY2lG76D0VgQzNdbAlVkQsXumP7Au6t2FxRThoRQjutNt0Z3ZYkw6sOzUCSKIUjNC32wHfR",
-	"KnbdQId7t5TmJt+f8qODjCQEyXS38dZ6Gvlrd/9n7G5X9CGlMGeaYcBJfa/5O7MBpBN4i60HtycN05T8",
-	"l6hQQDVPj0pDYIFCIjsLt7I117o5XRa66JVWx0Til6Oj9sKPjmqP5DlcI5OlHBu20XF0NDU79eRAVrbT",
-	"hNaoSDDo7BwyXGezXtJNUKRQwgWfcFhQzdZAIm+qJyeP/rQrPOM2AMdI5PblcDMeffEn3rIzboQnWhBs",
-	"aVfz+Z92Necg1ywDcgGrUkgqWbElP/MQ4WRfViifdNnfz/yKi2vuEWEexdVqReXWSeo08JyKR6UId/Kf",
-	"Tv63WppHLkoXCt30UA62grPPe8sXo3c3/qEx8IGzq9nxDOt1D20K8auo/wmEWkp1/B5te72/Hzs9X/oj",
-	"ml/tC/3YKy97WtochOmPjafXe70xC9k9nGkTjZdRnS2r8vg9/gcf29GKbP2fY73hx+iufvy+gQj3uYOI",
-	"5u9197gFlq3wwIn5XOFjcdfn4/f232gi2JQgmbmOMDWy+9Vmwz9WVVkW2+7PW54lf+yuo5H0u+fnY6/r",
-	"Sb3bmy3fN/5s0pRaVjoX19EsaCW1jgFdyMzHSrX/Pr6mTBshyWWNpnMNsttZAy2OXWXC1q91uZ/OF6xh",
-	"FP3YEqtKYROnNZ/Nb+j1RSPIXdosQM8EakP6GO5mMmMcuVDMJWvdp/3YfSJ1eCPWj9/q2ukkIYNqQWZS",
-	"0DyjSps/6jIjzff3zR3fX+2kRWcJvwEEE3UaXRuJ4SfTvfZhHHeIkBntCzl77iesI2s/uGDWgegZzYnP",
-	"tDchL2lhNhxycurE/wY2PrRQ9emloE8stnw0OeOZP3yKUEw72nggynQ2sKgO7xChwrwiDQNYAJ84FjSZ",
-	"iXzrKp6OJL3WG5t8qM3cjmnzxmhqO6mkK9X38R5UoX9s/ec+tedfKsO/VIZ/KZX+Uhn+tbt/qQzvXWX4",
-	"l0LtL4Xa/5MKtUO0aCmB1CmK+uVStgZuw25aL0RalxoKLL6ZQJHpIL01YuGxqhHTU0IuMPsVNbcErEHS",
-	"gmRUWenKZWpbobM5pmGE/OklnzQgsQ7cZuLP6v9aN/vL6uTkcyAnD9t9lGZFEfPmbl+UjPGTDZL7mlyO",
-	"LkedkSSsxBpy66UW15mwvfYO+/+FcV91CtpgKg9ML+azNRJVzecsYxblheALQheijh7BdNVc4BeQBjjr",
-	"20iYHrtoO+ZSPNhdaZXDaMr4XQngrN7CvR4OLXJJ+zYYwjvQseFfUiqPv6T0gcxuV0K/uzLSnWN3uOpf",
-	"XOVjcJVPzlf+7ObcSAn5P1LMfHLy5E+7oFhl/ZPQ5Dsf+3YHcczlSs6SBRdvK2j5bFdeMVj7Scd+x3iL",
-	"Bo/jt+/MRaBArv0FW7vRPj0+xuSJS6H0MSqpmi628cd3Aeb3/nYqJVsbaG5QDyokWzBOi4nzQ53UrrKP",
-	"pyejm/8bAAD//xGb44EeJwEA",
+	"H4sIAAAAAAAC/+y9e3cbN5Io/lXw4+45jr0kJTt2duI5OfuT4zy0sWMfS8ns3tg3AbtBEqMm0AOgJXJ8",
+	"9d3vqcKj0d1osknRdrI3f9li41EoFAqFer4fZXJVSsGE0aOn70clVXTFDFP4F81zxTT+N2c6U7w0XIrR",
+	"09GZIDTLZCUMKatZwTNyxTbT0XjE4WtJzXI0Hgm6YqOnYZDxSLF/VFyxfPTUqIqNRzpbshW10xrDFPT9",
+	"5Wzyv04nX757/+Qvt6PxyGxKGEMbxcViNB6tJws5cT/OqOaZnp658W93faVlWfCMwhImPE8vqm5CeM6E",
+	"4XPOVN/CmuNtW9+KC76qVqOnp2FJXBi2YKpnTWV5LnK2Ht3u/Ey1ZqZ3PfBxwEr8GEddAwy6dRWNBhk1",
+	"2bKUXJjESgh+JfZzcglR922LmEu1oqbdPiI/pL2H44ent/8SSPHh+MnnaWKkxUIqKvJJGPfrMC65sO1u",
+	"92jov7YR8LUUc76oFNPkZsnMkililowopkspNCNy9neWGcI1+c+LVz8SqchLpjVdsNc0uyJMZDJn+ZSc",
+	"z4mQhpRKXvOc5WOSszmtCqOJkdgz0Mc/KqY2NXYdXDEmmQBa+GX0dy3FaDxa6UVJs6vRuzaabm/Ho4Kv",
+	"eGJVL+kaKIqIajVjisg5LMiDo5iplOgDyI4Yw7OVJCsuzBePR7d9v67ougvepapERg3LIwCNokLTDFog",
+	"lDnXZUE3iNoVXX91OnaAa0KLgpRM5FwsiFkL3bcUmPtoCxFsnUD05ZIR+EJKumARnqfkJ82I8V+NvGIi",
+	"UAeZbfBTqdg1l5UOnXrWgVMnFhLRgZKVSDEqgh8cmnt4lO17TAb1Bke83f5N84X71Ib6gi8uNyUjc17A",
+	"fUn+XmkTCLjSuO1LRnTJMuC9OYFhAPmaLwQ1lWJP34oH8BeZkAtDRU5VDr+s7E8vq8LwC76Anwr70wu5",
+	"4NkFX/TsQIA1dU41dlvZf2C89FE16+Rd8kLKq6qMF5TFZwFo5fx5H2XYMftJI80gz4LcgPvjxrpcnz8f",
+	"3R7Sw6zDRvYA2Yu7kkLDK7ZRDKCl2Rz/Wc+RtOhc/XNkxQvobcp5CrVA/o5do0B1ZuWns1qIeOM+w9dM",
+	"CsPsVRiJGSfIbJ++jyUnJUumDLeD0rKcFDKjxUQbanCkf1VsPno6+peTWtA7sd31STT5C+h1gZ3gMlYM",
+	"GN+EluUeY7wG4RFFrZ6DDnwIP5G5VORmybMlMUuuCRd2E1HuAk5TsGsqzHS010m+jbnDLw6IeivsJWm3",
+	"osWAeveC2IYzppH2ndB7TzckRcQ4QYwTKnKyKOQs/PDZWVnWyMXvZ2VpUTUmfE4Yx/ucrbk2+j5ihtaH",
+	"LJ7n/PmUfBePfcOLgkhRbMiMuXuH5TCm5duOjzsBHBCLa6hHvKcJ7rRUU9g1jwatmTkGMaJUuZQFXIE7",
+	"yQgaf+/axhQIvw/q/Ienvhjt/XQHrYhDKlKT/aV+uJHPWkTVpSnsAdR01u57GEXBKFtoSZ/XCD42XeEv",
+	"3LCV3kkkEUQRobntoUrRjZegJigJdSnoJ80s8ZR0wQVCOwaBXJAVvbL7IRHvQAhMB0nbkhkOSm64WdYi",
+	"V0D9tPO++GMTcmrPCWw45UITSgquDQhDuJmaLFmBAicNioWYig4imgG0sGURAeYbRUtL5u6LleO4IDS8",
+	"vyysd7zJB16ySZjrzzENIFQHM/OdDDcJiUaFQxOGZ4XMrr6nenmEwz/zY3WPBU5DlozmTJEl1cvEmWrR",
+	"dj3aEPqGhkizZBZNNQ1LfCEX+ghLLOQ+XK0sv6ZFAVN3uVlrtTjwoINcFAQaE7biBh7AXOAJWPBrJizr",
+	"mZJvaLYEYYJktCjGtV5ClpOCXbOCSEW4EEyNiVlSUx9+HNk/lPAcaQZ80DASrcbpNKbkcskUm0uFD1XF",
+	"yIri5bSC51FZNPsE5qrpirVkJ7wsZWWYarxczp/71bFrJpAnhaER/LBGfPDHg0/JWfiEMwtpF0cVQ0UL",
+	"F1lR5TX+Ar9oAA2t66tW1FNIlaOihxr4jSuSSWWHsJe/mxz+w6iqO1vq/KxUbOKGUPSaKU0LWF1rUfcD",
+	"+b5WspSaqb8xvlgeQwCc0YKKjE22XGOuibvObpYSOC7Or4lmBcuA+mBNmVwhLTINawirvKcdOY72fPOX",
+	"brFpsKTicMEXxDdrTDomVBN4NuKVxY0mGSx6Dnhhh13k8fB7r8VIQ4uJRVt6EmxB2NowBauyTQk1pLFF",
+	"MHF4TDudUgeU2/Fo20ztOWQQHS0eD5szKZJEmzhu0VqAsYWcIcwvtYrGEuIbgOY1+z/W7bbjZsupodNR",
+	"eyFpjYi9ebEfPqqYSmzbK/wPLWIqjrgvRzkf3wSBn6HMC0ixM0EDzQwxkqys3pmAMngvKL+uJ09f04M2",
+	"7xur6nbb4xYRduhyzXN9rG3Cwfr2qnnDWJ2pv8473GHrpR3NNYh6ZUns9dsCwd60jsEAQuT66GLhM7lO",
+	"wfRMrjsioVyzo+yEXNv/DBKWnsn1cweZVLsxj2MPQTosEO4DvJtow4wIs9SmnrOZVIfdrB3TXm3AIhRG",
+	"jR4j4xaSsGlVTtzZTJiXbIPWQCSoZ7cL0e3hUxhrYOHC0A+ABW1oBPwdsNAc6NhYkKuSF+wIpL9MPoJm",
+	"VLPPH5GL78+ePHz066MnXwBJlkouFF2R2cYwTT5zenKizaZg95PyCkrn6dG/eOwNis1xU+NoWamMrWjZ",
+	"HcoaKq10YJsRaNfFWhPNuOoA4CCOyOBqs2gnb2y/2/HoOZtViwtmDBcL/VrJ+dG5YWeGFHTY6HWpQDDX",
+	"TaOue22c5NDkhK2NoicltmQiR5rHdXBNtWar2VGIqm/j83qWnDiM5mznodh3m+ppNvFWqY2qjqE5ZEpJ",
+	"lbyCSyWNzGQxgXcSlwnd32vXgrgWfrvK9u8WWnJDNYG50YBcibxHxQeW4cH3lx36ci1q3Gy9wex6E6tz",
+	"8w7Zlyby61d8ydTErAVB6mxoHudKrgglOXZEWeM7Zqz8xVfswtBV+Wo+P46NQeJAiacIXzENMxHbgnBB",
+	"NMukyPXO55W3preQ6aYagrM2trwt2PRD5dB0sREZvuuOcZb7H53OVE70RmSRKhlgLFi+YGonko6kMu7D",
+	"lIXink5ACph6gZ/RovacFYZ+K9VlLe5+p2RVHp2dt+ccuhzqFuNsdjn09RYZLhYFa0jqC4B9mlrjJ1nQ",
+	"10FpZ9eA0COxvoCHcfS+fK3kB7hDk7OkAMUP9mleQJ+uivZHmQPzMZU+guhZD1ZzRKDbmA/SmawMoUTI",
+	"nOHmVzotlPZ4vcFBzSqlmDCxnIv6QK7JjAF1ZbSC1VYlMTJ1v9QdJzSzJ3SCqNHpCWtXJ9vKTrek14zQ",
+	"QjGag/KVCSJnsOjaSwgXSTUpqQpKHycSD+W3DWBLJTOmNViArdllJ7y+nb1/zBbk4WpwFWEWoiWZU/Vh",
+	"VnB1vRP4K7aZXNOiAvH8h5/1/d/LIqz2bPsWYJvURrTV392l3AGmbUTchigmZatttyeBGIkvg4IZ1ofs",
+	"u2Ovd/vbYHaI4AMh8Jop9Ej7oEfLT/IBiDLA/4EP1gdZQlVOQAzsVT+A5Ar7LaiQXjbcMUOYwKutnbp7",
+	"ojNasF0Yauu6K8GtXU4KRtojwscxoaSUN7a3YWJKXjkzYVALS0WzAmSdrKCKaSKkYEOtC6kp02uALzid",
+	"g9zNSvWVRgGrtTK0382Y99nFYzUmugIzJjqv5BxcYv/mGgOjcGAhOjIpNBO60n91zhZ2EjcaKsj3wETn",
+	"oi6oNpNd4gA0aui8gEyjG7h34J63wAuqDYrwhIscde9WjMF5sA9Osa9BCqfsfUnDpD/7R3R32oDn8KLW",
+	"VVlKZVieWh766/TO9SNbh7nkPBo7PNuNJJVmu0buQ2A0vsOjXYnFHTXBO8f5+3QXhx5XIHpu9sVyA74a",
+	"R9tgvPCtIsTHAQU9MHJd74ElN65b9DaTsmAU1d3ayLKE2wXObujXh8EL2/rM/FS37ZKkNYHjnCSXeI6M",
+	"h8lBfmORrtHOv6SaODi8bxYqK617cBdmYMkTzcGCuO28oAIDWsUH5yBWbUWDFq/bJibUuxLZJJuMTzFA",
+	"h2VL1njun9Nd67kj0LlhitQrbjGwqJt3aQhG+dmmzV+HMviqXCias0nOCrpJONnZz8R+3vNM+LHxbNRq",
+	"L2nYZIZOJOnjUbMDbz8+bFaJUyWEkh8lwS8ko9rgBtX76XofPmnOcNoU9bhzei/MgmAkj4AfD5Flj1Ji",
+	"RKTFa4mUZRvZ1Thh6o5r6cFemPWDIBDHndT6q/bs/820m9u3Oe78G6b7Fl5PfbxlO7HK8gxrjedmueq/",
+	"Qy9rRhNa11eD851qCZE5ZSv0L8cr3QYgmg2hQt8wlRCTmoy90oxQ0TMokejdR4Rsj2tle26S8lVz2RnN",
+	"lmyy5LvfPn7lIneM2P1QYESMrqG3MLDcQwF7li1ZSsR2C7E9NMF7h3BnwmT5X603WRNiCFfhTI/t5Que",
+	"eNq9QmCSJTdEgffRnVC7nwNQwCVQZFYZfs0mc8qLSjG976PDYWTJaGGWJFuy8F6HEVnucBSERCmY/ayr",
+	"LGMMoxvDyrvIQ9XrYavbbRnC88FVwhR0x8PRXUfntA4gdUc4u3bEuaxHBK2ZMN4I2bNfbcr9FOQXIp0S",
+	"zyXwV2U6CD09q5ijLBQ9o+7pJiU+dX9uIGIWmW4OXCiXN+LuvCy0xLmQcdjZBuxtW2y1JJSWW6Mj1AMM",
+	"kmNgY9STxLgl4959ydw4Dh5Nt2G74xTShrmGWqXx3m29RFuPxeQLr/dZteNd0/eE6HEDeU2V4Rkv0VLw",
+	"A9sc3XDSniDp5k1yZix7jT5YI0oZ9yc2grI95mGGlEGW7i74HVN3Yjk+qKQJ/BXboMXqtQ3GjgyFx7AE",
+	"JUYlXAP9I6A+4JflzdhxtqaZKTaEIsPdEDgERFcze7K63ktGlpN4gHS6h/4ZnS9k0hNxq3PmBQ4VLS8V",
+	"NGVfs9vhu2xpuxvocDdlKWUx3e3/20FGEoJBkQ6klLDrnBbFhpgQ8e8pqQGke+QUGw+uuytiNOMKyH/L",
+	"imQUH9BlZVjQsUiFTM/g3aTRjFfP6aLsagyxgq2YNaTglwcP2gt/8MDtOSgj2Y2NFhDYsI2OBw/QCvpa",
+	"atM4XEdwNIDjdp54OKGbGDwUvRN4i6fsjs9xIw/Zydetwf2keKa0doQLy78zA2idzPWQtcc0Miw2yawH",
+	"rvyyGc3SWTfu+wVfVQU1x/ARY9e0mMhrphTP2U5O7ibmUnxzTYtXoRtq+lkGNJrhG2LOFwPHYpfQx+ZE",
+	"gXG44HCArSQ4FCB2bntd2E47FOV1pAZfrVjOqWHFhpSKZSy3SjauiQ5LnRIclmRLKhb4UFOyWrgoTRfr",
+	"Agy/0tYIqSrRGWLvKJC1mKD3iE5m2ECPMXcLWOsIBcV02/XEvqpuaACF5Y0rY+D2tF1xkt5q49HWN9V1",
+	"rbe3eGtmuznUj6shH0ZIq6EZ6LiE+ARZqYvEeBvh8AExfBgHmXroFJTdiaN41vpjX0grmAuKzRGEJDsQ",
+	"UaxUTOOVFltgtf0q5+Qlz5Q8KxYy3Hl6ow1bdf1mbNdfe47rm0O0uFIUXLDJSgqWUEu/wq8v8eNgi6+9",
+	"hntGRIForwHbD58GEloLaE4+hKTvuklIMu2z33Yy099KdSwHRzvg4DfFAKfBnR61bspDXRshWrPrDWhV",
+	"6B0uoschnpUrQrWWGUdB8TzXY3tanQOhi6Rrov91yOpwhAPcHrfl9hZlkLA+FKwoCSVZwdHDQgptVJWZ",
+	"t4KioS5aaiJOwyu4+zVuX/smaTNywsrrhnorKCqDgvkuqWOZs4Q+6VvGvHFXV4sF06b1wJoz9la4Vlw4",
+	"NwqIJobjMrHnpWQKgyWmtiWEMs+BJowk/2RKklllmk+OVaUN0QZsxNYHD6Yhcv5WUEMKRrUhLzl4hMNw",
+	"3oXXH1nBzI1UVwEL0+GMa8EE01xP0kEm39mvGA/vcLJ0sfHwf9e5jmYNj0lYeyPf3v/+7D+eQp49Ovnn",
+	"6eTLfzt59/7x7f0HnR8f3X711f9p/vT57Vf3/+NfU9vnYed5L+Tnz90b/fw5PsSiEPc27L8Hf4oVF5Mk",
+	"Uca+3C1aJJ9hqj9HcPebtiuzZG8FeO8bSa5pwXNqjkg+7Wuqc6DtEWtRWWPjWmo8j4A9n0N3YFUkwala",
+	"/PWDyHPtCbb6Osdb3grvdJxRHx1AN3AKrvacqYime999c0lOHCHoe0gsbugoK1riBWM/NB2sYZfinBRv",
+	"xVvxnM3xPSjF07cip4ae2NN0Ummmntko8elCkqc+n8tzauhb0bmGenPfRvmYouS3KU5BV+m1vH37C+jZ",
+	"3r5913EB7cpWbqqBphE75QTkBlmZics/OVHshqqUPd9nJ7QbZXtvhcPKJLJyMfF2fOLGH2rAoWWp23nq",
+	"uigqywJQFJGqdqnWYFuJNjLkvOA6pA0CGvhROn9eRW/8k7fSTJPfVrT8hQvzjkzeVqennzPSyM72m+OB",
+	"QLebkg1++Pbm0Wu/d3HhwfVH0UlJFynT3Nu3vxhGS6QQFDhW+NIsMIeDojFOQhAmDlUvwONjny2xkO2d",
+	"kgiXe2F7+YzE6UXhJ9zUZtqnO+1glNDr4A3ckRSMVmY5AY6QXJWGY+D3yvENQheUC+0dADVf4ANAL2UF",
+	"S2bW2ueS8rJVaTbjRnc5b9zFnuFwjTojl5dhzgF/GRUwYFXm1AkyVGza2Tm1jUPFQd+wK7a5lLb7dGBi",
+	"4yiRdpQdUvcdXaTd6K4F8o0PshujvfnO5d2nt3GZFDHlhSeLp4EufJ/+o20FgCMc6xRRNFIU9iGCqgQi",
+	"sEMfCg5YKIx3J9JPLW+Qb+IAr0SqgfgbeQz39lDEZUnB/mqfSXzeGC2jQkiDjlL2OqCGfDx3Ri4yJtD5",
+	"hRV8wWcpj/u/de0/EfBEsYzxa5+4KQyoYaHc6JAlyb4sFRULRqhxGWlogXGl06RTH0rRS0aVmTFqtuq1",
+	"RZxJ0EMH/ckNE4ZY5dKYoF89nAtuUFkk2I1N0cSVa+Ni3aYHeY3bNbH8QFB99ztkU4K3hkN4ImW5l4vC",
+	"noR3lfMfiU/x5TJ8XwEOF0rewG4CgNJn58ccntF9Xmm6GOyr1DCpDcx62LCU4SC7pMSkXAh29qb415HF",
+	"Bi7Cdp8AXpJclMEXYKNoLmlF4fi5ranVWV9eQbYih9RZgQ+PEMNkSQcYQIQ8sdgP2DS7Z0rUQr0HrIm1",
+	"+OgvqfZHPx9HN9+BUvWnyRa6LUX6eRRkQE03AboXZ9pX4NjqvWYM/fzk3CdK99nRfUr00Xiv9ObjkeVM",
+	"yb2TAl8bOSvYwuLENvZ0VqfgrXcT4Hg1nyPTm6TiFSKlbSTBuTkYPFgfEGItC2TwCKlTEIGNHgg4MPlR",
+	"xoddLPYBUrgUwtSPjXdX9Hc6jMkFjMJrQpYgHXHRJzk4luIyGNaiYSsKD4fBUC3gpNe0iNwS60E66bjx",
+	"jdhKvu18YO73vR0HHjS3RpTi9lol9jhoffEDxS8j/Xraaw0zuZ7Y5D3JJ+hsPYMzkQyphV7Jw2uTo9/T",
+	"ZCbX6HuFN5yNwdwbun7IPGCxTzHXSOXYr0+8tuDtB8j2B0+KmjX5LDw/arLrk/gPA6bn2dFHdp9FWdKP",
+	"BFJL0VtXenKar536qKa01ZVE6ut2HJI+hkwKKVbTdziTO9mD0a6SuZnO/Ps6o31//mvX6OPkce8qL++S",
+	"et92RkD0Xpn32+TQAGILVl+3hdgkWhutWniNsJZiSY3w3YY9o4k29ySVYtKQqydXbJNW/DCUGS58t0gf",
+	"jLtHxeZ+5DWo2IJrw2ojDI+y135cGxmqXeGxJef9qzOlmsP63kgZBA3sSLBjY5kffQUYpjbnCvy7wYKV",
+	"XAI0+lajxvFbaJoWhBubTbi2JrG95WCECPIN5Lyo0qTsQPrhOUD0Y7i5dDXDi5IL65U1w2pnSUfmPWy4",
+	"CI91gN+KoBcWQS/ox8DPsIMFTQEmBZTXnP4PcsRavHAbZ0nQcoqYuhvai9ItvDZK99RltJEQHbmnTLfZ",
+	"xjrnMvdj7/Ra80mn+oQIO1JyLVHS+3Tgj1wsIPLb5uJ0eUuoCFnPCS2kWNTp4uH3LRnip1B5S7s861tS",
+	"tDs3ftbnxN+oGImFD5PQR80s5HUSAUwvj5MsmLDJBUf7l5Qs5GJHAAG2iDTIH5e3d8ILki7Wly236tr3",
+	"2e5h2GzcnoLR3D2rNPPr235ou9vlUDfuc85uVAHZfsBwQKQ4bnQkwHSIpodz07Lk+bplILWjTg8giYHi",
+	"XrfYVwtnfCdamn7XO6qw3tPEeXc7W9AJvu5P4G1p3b2dwzIcCZq5PFh5pdDY1nCm7lZKC+/LgUv+4ecL",
+	"IxVdMGcwnViQ7jQELmcfNETFxjQx3PqP53w+Z7GhUB9i5GoA1z6DyQqzAymva00MT8qtZLk3bdUr2I3Q",
+	"ND0lKKXPJeWya651bWOVWrhjoo07wOaaTHX1A9tMfgbFCikpV7p23XX20+ZtvgdNXK9+YBsceadHLAC2",
+	"Y1dQA/eGIYWmjCrhk47qP93TMcbs07exhXvs1Fl6l460Na5IYv/RqC+meEWtpXy4Y1N7EAGkQ/bqIu2U",
+	"A2eLNbelTei7tojnu0We6OURT2XzbR1yt4UccDud7xgtPOHjYke349Hd3GG6LCyMuGMnXocbObkL6Kxq",
+	"3SMaPnF7bggtoUYdLSbOjahP1lDy2ska2Nx7HX3kZ1X6VFx+c/bitQMf/DIKRtUkaDh6V4Xtyj/Mqmxx",
+	"xe3XkC205VS6VgMWbX4o5hI7Gt1gUa2WEq1TxbR2K6vH845H87Qj/U6+6Tzg7BK3eMKxMjjC1YZo7Nzy",
+	"faPXlBfe3uuhHapct8sdVjc3ySfiAe7sQxc5R955LM3/ySbogSt7/Nd0wK+7GZ3HLjiz+EyKinost2nj",
+	"5bM3+29+b2wHaH88OLVtxzq3hQpsCf9HfaB3eocBphlIfQB3sG1E/itM/J9+AwpXFgC5tXMSpEcXTr+V",
+	"qnF7ukjUpJPhh5Na4YVj8Zh2ELh0HgEdWXVKrFz72+I3wjV58CCmuAcPxuS3wn2IAMTfZ+53fNw9eNAF",
+	"2goEaT6KWkVBV+x+iGXp3YiPqxIR7GaYDHN2vQqCu+wnw0Ch1lvQo/vGYe9GcYfP3P0CVn/4aTpEbRJv",
+	"ukV3DMyQE3TRF0kaHNZXdA1xL5pI0WIWNrIZSAvvQ1cw0tr8u0dIVCu0gU90wbO0A5KYIYcU1g0bGhNs",
+	"PNieDXNUvCcWQFQ8Gh2a6YPMr62FRLMmEa6ThTNq/M6kYwGV4P+omMtTNedM4RXQkhj8+wxH7Uj9aV2n",
+	"Gxj7RMMPlfCh2776qy3mSgtkL6p6rb7PgyXSrz9VzXjP0JR4xg7P3xJW4gjJ35qCOzeRQQS19c0ZDMNJ",
+	"RZCzRHuu6Yy+/Y814LG+3/nzIRvM9WSu5D9ZWmRAO2Uiy4oDBB+P2DvlJtvmX8F5wa83nn0XgQzXc/SR",
+	"yp31Gn7RoTb7ITd3mj3st9F7KjCi/e5XYeh0EZ7xKD7babjtR9KMeerhYXhgIw9+dCD3HndU2BNqU5A0",
+	"ggTT5zxqoU/s+PU5dzC3dz0r6M2MZlfptyvAFG1/wzfQSOI7+w3SIYuGnZ1EYSehLbcp2UqmagNWt6DI",
+	"ge9QO+3gF2j94ISOjafm2LrLFFomhqnEDRWGeXcaywFdb82sKwj0upEKU6nrtBtjzjK+Sirm3779Jc+6",
+	"zmc5X3CbYR6z37moBUbcQMTma0cqchnjQ9oYh5rzOTkd12fW70bOrzk+xLDFQ9tiRrVLfy/nzS6wPCbM",
+	"UmPzRwOaLyuRK5abpbaI1ZIEXQFKnMEZd8bMDWOCnGK7h1+Sz9BnWfNrdj99wTgZbfT04Zfo6mX/OE2J",
+	"SDmb06ow25h8jlzex1KkKRsdu+0YwFbdqOngiLli7J+s/z7Zcr5s1yGnC1u6K2j36VpRQRcs/Uxf7YDJ",
+	"9sX9RW+SFl4wgS3JmTZKbvqy5K6YocCxegL/gSFaMFwwzco5q2q5AgrzrNUfPz/cFE+LpY8Al/+IXuBl",
+	"4mn/CV5ZdJWmB4qO/T+iyT9GK5YCx9QovA4BcSxySs59/RasnhyyZVrcwFywdBRTYQuxUCcXBjVYlZlP",
+	"/gKvdkUzYIjTPnAnsy8eJ6oQNwt1iv0A/+h4V0wzdZ1Gveohey/luL6Q70BMVhyY//06+0Z0Knvd1ZPT",
+	"mj7P556h7yxdw7iTXgKsGgRII25+J1IUWwa8I3GG9exFoXuv7KPTaqXSBEMr2KGf3rxwkshKqlQ9uJoB",
+	"OKlEMaM4u2Z57ybBmHfcC1UM2oW7QP9pHey8WBqJbv50Jx8LkYU78U4LGbBA0v/5ZV1FCg3tNsS6pbSU",
+	"KqGedYrGj+wZu5+asG3Ptx6J+K0Hc4PRhqN0sdITcYI/130+hctZGyS75w0N6cPfiIJ3PMr6Dx4g0KAo",
+	"tU1/e9T8bNn7gwfDvXbTakL4NYGaw+6a1o5j39RWQzn/p+97at0H1zWXVaa7zem7DK7UmRtjTJoFxT++",
+	"3HGckMm9PaHTB8ijBj+3cfOJ+StuZh2E088fnsn1c7cqqdLkk4fvURgHJc/keigRta4tT0+/AxT1oGSg",
+	"VhBXIuet2yXptbHT5SgiWxh1xsDlWTfKxA72oPkD7QKgZrxlLype5D/XxufWzaSoyJZJv/YZdPzVPgOi",
+	"BpEGA0ysghXJ3va1/Kt/VSfe/X+XPcOuuEh/ai3cwd6CtAarCYSf0o8PuOKmgAliFDVzp4VsNMVC5gTn",
+	"iWqdBNY4HSUQ/xzqxF/YLDT6tZLzLj3ZYVeVcY7RmL/BlW6b8wL+12MGx5YT1VsIRGH077wekV0zMLPh",
+	"A8+OzhShfIXXtqZQEhYP4TVTdIFdpWCt7phcD0eOimMQXcInbIl5eiQxlRJQ8D1aBhOGK1ZsxqSkWttB",
+	"TmFZbI1zj54+PD09HWZbRHwNWLvFq1/4q3pxD0+wif3i6uPa2hZ7gX8I9Lc11e2z+V3iUhtViTe2XkmK",
+	"xeIHGxMOnW3lTuxEmMhROTsl32EqOSD0RjUHgCYkw26mb63KQtJ8jPm7wV+L2FltH8UQdTkQ/gLgbx2R",
+	"pJFneDpbnyqvJ83Y8HG2ZzmCVWuDxVW0oasylfQSWlz6BoS3PLFQNxhjZ0qeW7Vs8OexkxDMAq9WLCdh",
+	"OqcGQOKA/xhDsyU0kNPRVpVyT93F3eWcXrsWngPW5qIo9Pbaf0QODsuw7g2MVCJnakwk6KhvuGaY+oJd",
+	"s2ZuTQ+GV8j7XJvN1apKCEs40z2k11CIdN9d8MDhuMGtIglZax/ubPurk4nISmVsOPXak3+BvdKhQ6I5",
+	"WMvdwVY3Wfv6KFPy0hk7Miqk4BnWBUmJ4Jg1c5hZdUAJlbS9U4/cWU4cwwQpRzHyDotu/e96WaZDXNep",
+	"IfoK+20Jx/5p2NqVyl4wox0PZPkYFVS8YM5Ax4VmrtQs0FfMUaVKeHwlQ3SC58gR3ePHI0x816Nr/Ra+",
+	"/eh083B2yRUXqHNzSPXVn9HAVmiOdnZBuCELybRbbTM0Tf8CfaaXa4EgvJu+kAueXfAFjmE9EAEp1iO5",
+	"O9SZ9092/sDQ9mto68pMhJ8bnnR2Ur/ud0kWosP+dz5BaYQ+9KdcvnyEXITcMH482hZi3Bp2gPcykCHU",
+	"HyHasBLv8w7ZMKVSD0+oPlJZesMWxAYPp5BScJEA4wUX3uCbTsWVJe8S3Bg8zT39dKaoyZYNJrXL+bgn",
+	"NAfj+rOrYwzV2mBECa7Rz9G/jZdr4Sp+9LCV0KB+XVCxIf5QAHVHQglE+gZHbxSmmnppkM6cMGZ9hG2w",
+	"rxPv0mwF2PrERwc30LUzFjV0x8I1+95TfYlhZ1W+YAZSjKZS3z3DrwS/+uBGKJ5ThXptIdS1mVm/S21u",
+	"okwKXa22zOUb3HG6nGuqNVvNioTH7fPwkeVhh4HSwMYD/6aKlfXvjHPA3zsA3Xvb5/uVk+gG1KekZ6Dp",
+	"ieaLyXBM4J1yd3TUUx9G6HX/o1K6jz3/XYSWt7hcvEcp/vYNXBxxRvWOa7+9WkLCc3Sjl/jdpyQLSXeb",
+	"XAm+dUvyoUcGbl5iy1rA+4ZJwK9p0ZP0Ibba2PvVWjL6Uj9kvZlNqHEJ9AwlNU8YosLoT0FmHa9blqGu",
+	"ebPPtdp6Vn9I44nDx1ak91saf2jYFa3XW81Qeu2Jh5n8aiLY1+bnqmZ09aW0KGQ2mDO4Yc6gU39WZbla",
+	"uSIFCa+865XM47MQeXO18hUnfbW971j0sIVgGwtX/Si32Vp0nDDYeR20cgRbh5RmwuMppF1sJGf044OW",
+	"sccTjbE0U+Z58mcHe/IbriL5Rd2kR2vodgLB76y/fNfS8aFwMFuXiO5QOL1ZFXooPjtFkgeW7o43r6eQ",
+	"94cCtc1M8Dg5chjbYGG/1X5j7TbGmxap7t0JI9/yghEuyH9evPpx1H+go5PYPdou233SlNF3QEP0ZJtN",
+	"LGSDtrbcBVIUaTuI7jGtYJqyNFeUhvV++FaboSDZlF37tH4xdPAOASykLeSWKnXTTZQ0qrfDIz+ihnp7",
+	"7c0SU0eKKtoF0hJvYGwRXVFObdYZrUcR1pCVh9RjS5X+ci9Gr4m3AodLjWjroXVKqXUu0udDHgkdfNyO",
+	"R+f5XmJ0qnzcyI6SumhfADN5BpaP7xnNmbIlgFJqBVsAaMVAHaGXvMR3cCk1r0t4FzCYyym/xOGmQyOz",
+	"gC/ip5C4ojOWd6S/ZpmRquEOrBgb7u9SppcIEHjDMjb5BC5BirGclWa5VWi2Tv6lWdaVfpkLPATLO3Mm",
+	"rGsmxoRP2bQdq5jX+clIwejcK+OVlGZAKWyvdbNojIFO0VenrPr250An/WCUXdNeiNPhdZPOQmyIjbO9",
+	"obpOYtZK7TE4hcB8zjKsvbA1E+TflkxEqQHHXoWLsMyjxJA8RItilZWjWjZqWAt6IKgF/TiQfoDKH1ds",
+	"c0/fof6HtxGObfEPrpGGHF6kYBHOsKidqwXCjSsZEuxxH6cmSN4bX35Pk8YpTJZBDyHqh5RzQPKyHhG+",
+	"QkifkdC5GHMdTiR0DhEltjurC8sdUtEjSjV7IBieSxAap589DBovEx4ABnTde9I68ecHO0xocQ5w39Mk",
+	"zAl/j+902BIhtb3n7q/N7F0Yg+R4FC6F5XWeumLzEc5hb2ZRfFj1JUp9bXOQR6Jov8bvOTOUF9o5x9NQ",
+	"uSPWi4OJr2UPJDeu8gcgovZ68DVAmPa/+VzXdpaCX7F4t9DHBNKj+xZHyTiKzQhPAz0PM/M6wLPrrbiv",
+	"f6GNtM4KCQL8pC/AvRlxGUIR7mkbM1IngkSo50y5ywBJvpCaTYz04aJ75FG2wG3DnsZomYPw1opM2iPj",
+	"gV1RbzmaN3VNHqxATLH8DHVBNDFWiGIryi0TkXEUesLFcccOfW2/+zxNvqLsdjNRH97DuZjsdNP2IcRc",
+	"dzAfn645ccLt3my8kdzpAAsTF4KpiXdGaVfJEc2Mw6iJzKvMsr74bAYr3OBUjlu4WdI4k3VX2VIBREmF",
+	"rtjmxKqvXXqhsOMx0PYNZEGPcvO3iOKoNjedgntxFPA+bSbkUspi0uPhcN4t7dM+DFccvFIJXFY+wg5e",
+	"cfeaxwYmIZ+hYT34vt0sN75wTVkywfL7U0LOhI1y9m5wzaLXrcnFPbNt/jXOmle2WJezpE3finS4KBbN",
+	"Unfkfn6YLTyvjzdpJvI7z28HOWB2sxZ9vr43WF2rWZp+OlQ91/VTa4lQEflZKFIC1IV1aPkaWUJCD0Aw",
+	"uVSUBQ39nChxjjBEFzIVTXRIAiwYKo2peDIEyDAxQN1SQ+EGTyLAOQvvyHTtPvtcznJOFKt9zA5Nau3y",
+	"RFsmrvtUe+2ZwyxNzjiXisUzor+8zXnvzy+yJnQJVTNuFFWbQ1JPN1GVUqP2Ynmn13dw+K4XUjt9d3FY",
+	"FPJmgmxtEgrVpdRZ0E43r21fGrvuB0d9xiL3caqdiLghS5qTTCrFsrhH2kBooVpJxSZQ2yBp3HzB5wYe",
+	"CSuMTxekkAsiS1Ch2pqSaQrqm6sSgqLsxSKX3CQKLO3ASl2fiI4HTgm3r3UzmaC8trNmkd/8S+hj0/DU",
+	"KUXtoifW1aknToppl9TSYcg27sKLhGMTyrWNCmkRec7XSDdMpY78nBgFsX2uBY7eICE8+FQxsuJaW1AC",
+	"Ld3wosAsOHxd8wMW/BrTqO2Rnc8xnuOao+NuMyMS9gBJOWMhjVTMAy7ihJLELJWsFsuo1EqA0ytOVOXU",
+	"KvEoP+kKfasx1B2meExWUhv3LLYj1UuuXdk/y6QwShZFUxFt5fyFc155SddnWWZeSHkFmY3u4yMcdQpu",
+	"pfnYp4ZpxyDUM6lWXtthLwVwdEXy0LsrVth2AIBnEIN5Z4v7dQxnuyxREZjvdjPX3Xa5s+7C2utq8tn0",
+	"W+hMEGrkimfp4/bH8uLv9b1Pca8UKmwPl00LmyEfiO+x4JaJ3LOLZiZosiL1GXE8wrmnISeC/6IY3x6X",
+	"zBk1nbmjO7TLd5yANcl6xcAWAAipTehiKmWr5cdCWmA4cmF1kuhc1wZ04IWDPsx3gw1GODpQht0JqE5U",
+	"RQDwM6vBGNuEvjZCAwJ23ff7dcbfg4C/3U7lDebR5xx+UZOWwiYhIV8PR0gXddnqSX2JyXxmQ/2ptbdy",
+	"D7z8IwD6PawbMAzys94XjDmFMJwJNT33PurAxtFz3cWKR6P70rg4C8lo5Yuqw9iVYi5BnJX+VdMcXlKz",
+	"9LcqNO9qxEGH6TT9aJ3DkujjyBzLClsxvaVRkOWkYNes4XhuaVlXKIXya+b76tCZ5IyV6LHQVrSlPKoj",
+	"PLa1L27tk8gndwh2k+oYi1i7U2SHriWpGVqLiT0meuhRAoiueV7RBv70viJHU5cIRzmBqs7zYeKfmEOn",
+	"+cmO8MYPcOb7p0QZj4l3w/jQ3iwojbptDGhnhEWl+069SAdYxCkZg6EIZ8uDX4Yl8Zpv6JLeiH6tZpfk",
+	"65fYwH3iUkSI/WbNMpRq3FOI5e4x1GM5cSZMpHbBWG4fDNAloc1fMkGErF9EqNL0r5g6KbX/wU6Mjbhw",
+	"D+0DfEzqOIi77yzBwYhuJY1N7kRN1nfT8X+Sk7j1IPaOl6IRzVxKgi2qMU/d7tmBDWRV5ETAfoLsj+XW",
+	"3S3muPiYzCo/ECgybD34+In6nHl7rhSxicmuyGdbRUWyRbe9wbpaEB5FuoFFXCr8R0hD/lHRgs83yGcs",
+	"+L4b0UsKJOQMyNYLyMWPwMTbxauxB8wrYqSfyq6bDx0zGm4Do0RAw0XuK2BKsqJXLN4GdHCy/DMzwDh1",
+	"NUOlBlzZre3sYsEt3qeZW9E8VgJgwuxNgzt4h2vo/dfa3SeeyuexLQuasbxRx7PJZ0AYCsRllmy1PV1D",
+	"l695EvCtIqJVPt1PfoA2dU/WlYpd7Cs42AA7ekY06w0eZxkDlcKtunFbEl0MWsqxd+E4seidJcVV03ct",
+	"Li4i/3F2J5npvm8ZQ8D/He1Kw72iE6Hri4X2rwebfIxdaCQUS8Bq1eAzuZ4oNte7HGmwNQBfA6yD7paL",
+	"TDGqrd/R+Sv3bK0TuXMBz2jrdR7MqmGUnM25qFktF2VlEq8gzOcuNhHCYmsCorXHNtcnY4Aoek2LV9dM",
+	"KZ73bRycHjmP084DJN6C4vomFCDhRu4OwHX9AsS8ELV+Pm4G1791hrO+39pQkVOVx825IBlThnIwn2/0",
+	"4aaqYHXYZayikSzUzHoUma2QtC0gxcZZm+9oSAoA0iNalAZYgi6XLGkFsoohI3sMP10Y/hCWoBVdg/EQ",
+	"sxf0HAiXrx9Nh9gMXTwzKqx0N2zdfh6oO7d9Gqyk5BiRkTjrkCm2n/tXuJX4CP1JcLP15FsNZzudhPXU",
+	"twfTI1Us6vAiSyzd81hm6cnKZhYQL6r6dEue9li0iUmX/o5WvWcX0b/CpY+JVejDCwA3XTgSN4zTK0xQ",
+	"36C3BBDVLsaIa+0UUR2Pt7aiwiJl7LK07Kmns9p9fy/1gAeIZtqd9ea0wUEHxtmnavL2vCyTUpaTbIhv",
+	"qy22llsAPKRNGHvoIzIh9Kw7+N3oUH4wpsZmHcJ9C0f31kHcZSsrs20qgz4lUw9Hbxow5Bx5GR5hq1qT",
+	"KlbFjP3j3Bu7m0q0wCQIJYpllUIl8w3d7C6m21NF4+L7sycPH/366MkXBBqQnC+YrmuztIrR1q6JXLS1",
+	"Rh/XGbGzPJPeBJ/1CD8H66UP2wyb4s6a5ba6TqreKcW7j3Y6cQEkjmOiwudBe4Xj1EEpv6/tSi3y6DuW",
+	"QsGH3zPw/0jXxgpyVcL8ktqtyAADL5CSKc21YcK07Kfc1E7ZeonKRax+cG1z3EmRMa99dlTATY8vV2oh",
+	"fT69yM/gE3E2J8hAUDheZe1E29bl3mlWv4dCI7rbgA5Mlk6053OSgghjfFTFgl7dqU1Rnx656QZmax12",
+	"U4TonN/TpAceH/gSlnOyndvXZkbPqBOcHjYxIV74Q3kAafZZN/rzJR3CSWrDwO+GfyQSQB2Na4Tlfghe",
+	"kXwfbMlqcNbxmgjJjwaB1k30kyAPBKAnnr8RdB2FOEY1FpS1MaA1wpuf2+LHy9osvTMyBSHxHXaAF8fi",
+	"1+1CMIUD5xMXKHgZkBIt5V0fJTSWvyu837PecJFEW+SUJsYwbdmS7IqFUUIH/XXIk9DzKumkU1BSGiIF",
+	"6EYSaRisHgfPVEw4XBimrmnx8bnGt1xpc4b4YPmb/sCtOOw+RrJFpT56YuEXdBBYBf24UInXmBvibz3B",
+	"wWeCuFmc4b9zB6JKiBbW23seLOBM+PhhdOx6+AWZubJlpWIZ122Hghsv0oRoZ6bAIodTQMLfVuT1ncud",
+	"/SzNHY7D3PsDkR8jI1vwHHAw10f9EzOnHg6QPC0pUu0QSgJ/KV4HCV6H1bm6a4mrw1LSRQlo90xJF68M",
+	"EwQPXh6uAy+vSrPuOgff+g3cJi78em1Dcy4OrpQF5QlnQxIjpqtaQXfM1XiU8lZ3L271URI1WlS6MRwk",
+	"ScKqRe5d2Zda/pJRlozmLoK4n94JDAiA8CQ5t4+CeSXseKGQM8aKe7Yu5+PgxSAFdHtK3ooHRC+pf1u4",
+	"Px89+WI0HjFRrWDx9ffReOS+vku91PJ1Mq60TgTV8RF1VVHuaVLSzZBg9p2pn5L4rTNdfXyRRhs+S7/p",
+	"voc9w4erC0A4F8jqkb3YG9Tlf/ozgdVWYmgd1nBiLEnW6a3CVuzKdPVzX8pHW8Kip2pRi/tCgaOdtvi4",
+	"oBRkCrBJ9rDK0q+u5ubH3XYPQU/uULf0u6Sxs4hJrLUxeTRVlJRwQGEp1y1R6QcOI6jgudlcAP692p3/",
+	"epVKZvZdSC/mctYFC7yTfY28YsL7mNXJyCrtpevvJC1Q+rSOAYIRI2UxJd/YSkfuWvzq3uzf2ed/eZyf",
+	"fv7w32d/OX1ymrHHT748PaVfPqYPv/z8IXv0lyePT9nD+Rdfzh7ljx4/mj1+9PiLJ19mnz9+OHv8xZf/",
+	"fg8oHUC2gPoKZk9H/zU5KxZycvb6fHIJwNY4oSWHDG63t6hhm2OCTURqhlcsW1FejJ76n/5/f1FOM7mq",
+	"h/e/jlxd29HSmFI/PTm5ubmZxl1OFpgDZWJklS1P/Dy34xbGz16fh7gg6/uHO1rbnKajmhTO8Nubby4u",
+	"ydnr82lNMKOno9Pp6fQhjC9LJmjJR09Hn+NPeHqWuO8nWA3gRLuiYichdPR23PlWlrbkGHxahHTG8NeS",
+	"0cIs3R8rZhTP/CfFaL5x/9c3dLFgaooRY/an60cn/u1x8t7llbnd9u0k9kY7ed9IzpPv6On9qXY1OXnv",
+	"8tXsGDBWj544P1dAatJR4jtmXBpIq7FM5DlC+6QbfUw01g+Dn0rFJZxX0MiTnKE3EXRFZ6gxqJhFhofO",
+	"6VaYwP++PPsvdLN5efZf5CtyOnZBThrVIKnpbaqIQGjnuQW76xutn23OQiao2iVn9PSXlGraIo6U1azg",
+	"Gbwipv6kAhlGBykMWTNKNESM7EUBOK3ZPrDy08mX794/+ctt0iO965xWe3Vu/dqRMAN+6yu7sWtGkpzr",
+	"sqAbxPeKrr/qw/baBczAuP+omNrUy1/R9She6kAJMPlrIuWrj3y8ifJ3hyT7tTs2pDsmUhGn2HsNZgwf",
+	"9ekjgOuo5zgAGHr2rc3d3vHyvFztwkdXelE2a6IEPcC78cgDijzr0empZ9RO6RFxgRPHXKKZWqJxl5ph",
+	"3TSyoXTTf4D1g2aQaYzqyIUL3bGNs4m0YnNlOYkH2G616c7otiQZXrVvBpLuwxqreu+A77JVtL+BDvdu",
+	"KeEm353yo4OMJATJdM3x1noa+XN3/2fsblf0IaWEM80x4KS+1/yd2QDSCbzFxoPbk4ZpSv5bViigwtOj",
+	"MiyVkRFn4Dqa02WhqzEUxUTilwcP2gt/8KD2SJ6zG2SyVGDDNjoePJjCTj3ek5VtNaE1KqsMOjv7DNfZ",
+	"rJd0HRQplAgpJoItqAHPnsib6vHpwz/sCs+FDcABidy+HG7Hoyd/4C07Fy4FKLa0q/n8D7uaC6auecbI",
+	"JVuVUlHFiw35SYQIJ/uyQvmky/5+EldC3giPCHgUV6sVVRsnqdPAcyoRlVTdyn86+d9qaR65KF1odNND",
+	"OdgKzj7rsFiM3t36h8bAB862Ziczud6jKYtfRf1PINRS6pP3aNvr/f3E6fnSH9H8al/oJ1552dPS5iBM",
+	"f3RplVWdEjjdrvFEe2/WPL/dMS20iebNqMmWVXnyHv+Dj/Jo5bbe2YlZixN0az95z/Pu5w7Cmr/X3eMW",
+	"WKbHAyfnc83Mjs8n7+2/0URQa0VxuLZoUf9qqz6c6Kosi033543Ikj9219FIbt/z84nXCaXe982W7xt/",
+	"NmlPLyuTy5toFrSm4l4mMAwfK93+++SGcgPClMvtjcmau50No8WJq8Ta+rUub9b5gjXboh9b4lcpbYK1",
+	"5vP6Db25bATDK5st6JnMN1sY83oy4wK5VcxNax2p/dh9St2OE9YTDCHwzikJWdVIMlOS5hnVBv6oy+k0",
+	"3+m3d3yntZMbnSf8CxBM1H10bSnAd3YXCMJxhwij0b6Q8+d+wjoC94MLcB2IntGc+Ix8E/KSFrDhLCdn",
+	"7pnQwMaHFr4+vbT0icWbjyaPPPOHTxOK6UkbD0mVzhoWlWcbInzAaxMYwIKJiWNBk5nMN67C80jRG7O2",
+	"SYrazO2ENm+MxjdUDuq+j0dQmf6+9aS71KN/qhb/VC3+qXz6U7X45+7+qVo8umrxT8Xbn4q3/ycVb/to",
+	"21ICqVMU9culHHzDKTGdFyKtSxIFFt9MtMhNkN4aMfNY/YibKYE0J8om7NLsmilwnqHaSlcuo9sKndIx",
+	"XSPLn74VkwYkvgxUTj6r/2vd8d9Wp6efM3J6v91HGwhsi3hzty9KxvjJBtN9Rd6O3o46Iym2ktcst95s",
+	"cT0K22vnsP9fGPdVp/ANpvzANGQ+qyPR1XzOM25RXkixIHQh6ygTTGstJH5hCoCzPpCEm7GLyuMuFYTd",
+	"lVbZjKaM35UAzust3OkJ0SKXtA8EEN6eDhD/llJ5/CmlD2R22xL/3ZWRbh37dvwnV/kEXOWT85U/utk3",
+	"UkL+jxQzH58+/sMuKFZZQxn9b32M3B3EMZdTOUsWZjxU0PJZsbxisPanjv2T8RYNnsm/vIOLQDN17S/Y",
+	"2t326ckJJllcSm1OUEnVdMWNP74LML/3t1Op+DVAc4t6UKn4gkPtXuevOqldah9NT0e3/3cA7toTQPE5",
+	"AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -251,8 +251,15 @@ func (v2 *Handlers) GetParticipationKeys(ctx echo.Context) error {
 
 	var response []model.ParticipationKey
 
+	myLedger := v2.Node.LedgerForAPI()
+	weights, weighted := weightsForRound(myLedger, myLedger.Latest()+1)
 	for _, participationRecord := range partKeys {
-		response = append(response, convertParticipationRecord(participationRecord))
+		participationKey := convertParticipationRecord(participationRecord)
+		if weighted {
+			participationKey.ExternalWeight = v2.optionalWeight(weights.key(participationRecord))
+			participationKey.SelectionWeight = v2.optionalWeight(weights.selection(participationRecord))
+		}
+		response = append(response, participationKey)
 	}
 
 	return ctx.JSON(http.StatusOK, response)
@@ -369,6 +376,11 @@ func (v2 *Handlers) GetParticipationKeyByID(ctx echo.Context, participationID st
 	}
 
 	response := convertParticipationRecord(participationRecord)
+	myLedger := v2.Node.LedgerForAPI()
+	if weights, ok := weightsForRound(myLedger, myLedger.Latest()+1); ok {
+		response.ExternalWeight = v2.optionalWeight(weights.key(participationRecord))
+		response.SelectionWeight = v2.optionalWeight(weights.selection(participationRecord))
+	}
 
 	return ctx.JSON(http.StatusOK, response)
 }
@@ -500,6 +512,9 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address basics.Address,
 	if err != nil {
 		return internalError(ctx, err, errInternalFailure, v2.Log)
 	}
+	if weights, ok := weightsForRound(myLedger, lastRound+1); ok {
+		account.ExternalWeight = v2.optionalWeight(weights.account(address))
+	}
 
 	response := model.AccountResponse(account)
 	return ctx.JSON(http.StatusOK, response)
@@ -574,6 +589,9 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 		LastProposed:        omitEmpty(record.LastProposed),
 		LastHeartbeat:       omitEmpty(record.LastHeartbeat),
 	}
+	if weights, ok := weightsForRound(myLedger, lastRound+1); ok {
+		account.ExternalWeight = v2.optionalWeight(weights.account(addr))
+	}
 	response := model.AccountResponse(account)
 	return ctx.JSON(http.StatusOK, response)
 }
@@ -878,6 +896,32 @@ func (v2 *Handlers) GetBlockHash(ctx echo.Context, round basics.Round) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetBlockProposerWeight returns the external weight the proposer of a block
+// had, for indexers and explorers showing weighted consensus.
+// (GET /v2/blocks/{round}/proposer-weight)
+func (v2 *Handlers) GetBlockProposerWeight(ctx echo.Context, round basics.Round) error {
+	weigher, ok := v2.Node.(proposerWeigher)
+	if !ok {
+		err := node.ErrProposerWeightUnavailable
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	pw, err := weigher.BlockProposerWeight(round)
+	if errors.Is(err, node.ErrProposerWeightUnavailable) {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+	response := model.BlockProposerWeightResponse{
+		Round:        pw.Round,
+		Proposer:     pw.Proposer.String(),
+		BalanceRound: pw.BalanceRound,
+		Weight:       pw.Weight,
+		TotalWeight:  pw.TotalWeight,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetTransactionProof generates a Merkle proof for a transaction in a block.
 // (GET /v2/blocks/{round}/transactions/{txid}/proof)
 func (v2 *Handlers) GetTransactionProof(ctx echo.Context, round basics.Round, txid string, params model.GetTransactionProofParams) error {
@@ -1033,6 +1077,14 @@ func (v2 *Handlers) GetStatus(ctx echo.Context) error {
 		response.UpgradeVoteRounds = &upgradeVoteRounds
 	}
 
+	if weights, ok := weightsForRound(v2.Node.LedgerForAPI(), stat.LastRound+1); ok {
+		response.TotalExternalWeight = v2.optionalWeight(weights.total())
+		display := weights.display()
+		response.ExternalWeightUnit = omitEmpty(display.Unit)
+		response.ExternalWeightScale = omitEmpty(display.Scale)
+	}
+	setWeightOracleStatus(&response, stat)
+
 	return ctx.JSON(http.StatusOK, response)
 }
 
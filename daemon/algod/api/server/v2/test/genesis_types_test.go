@@ -44,6 +44,16 @@ func getJSONTag(field reflect.StructField) string {
 	return strings.Split(tag, ",")[0]
 }
 
+// apiOnlyFields are the fields of model types that the API adds to what it
+// serves, and that therefore have no bookkeeping counterpart.
+var apiOnlyFields = map[string]map[string]bool{
+	"Genesis": {
+		"external-weighting":       true,
+		"weight-algorithm-version": true,
+		"weight-protocol-version":  true,
+	},
+}
+
 // TestGenesisTypeCompatibility verifies that model.Genesis matches the field structure
 // of bookkeeping.Genesis, using the codec tags from bookkeeping as the source of truth.
 func TestGenesisTypeCompatibility(t *testing.T) {
@@ -127,6 +137,9 @@ func verifyStructCompatibility(t *testing.T, bkType, modelType reflect.Type) {
 
 	// Verify no extra tags in model that aren't in bookkeeping
 	for jsonTag := range actualFields {
+		if apiOnlyFields[modelType.Name()][jsonTag] {
+			continue
+		}
 		_, exists := expectedFields[jsonTag]
 		require.True(t, exists, "%s: model type has extra field with json tag %q that doesn't exist in bookkeeping",
 			modelType.Name(), jsonTag)
@@ -723,6 +723,13 @@ func TestGetStatus(t *testing.T) {
 		CatchpointProcessedKvs:      &stat.CatchpointCatchupProcessedKVs,
 		CatchpointVerifiedKvs:       &stat.CatchpointCatchupVerifiedKVs,
 	}
+	// The status reports the total weight for the next round.
+	proto := config.Consensus[stat.LastVersion]
+	voteRound := stat.LastRound + 1
+	totalWeight, err := handler.Node.LedgerForAPI().(*data.Ledger).TotalExternalWeight(agreement.BalanceRound(voteRound, proto), voteRound)
+	require.NoError(t, err)
+	expectedResult.TotalExternalWeight = &totalWeight
+
 	actualResult := model.NodeStatusResponse{}
 	err = protocol.DecodeJSON(rec.Body.Bytes(), &actualResult)
 	require.NoError(t, err)
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node"
+)
+
+// weightedLedger is implemented by ledgers that can look up the external
+// weights committees are selected by.
+type weightedLedger interface {
+	WeightOracle() ledgercore.WeightOracle
+	LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error)
+	ExternalWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error)
+	TotalExternalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error)
+}
+
+// proposerWeigher is implemented by nodes that record the weights of the
+// proposers of recent blocks.
+type proposerWeigher interface {
+	BlockProposerWeight(basics.Round) (node.ProposerWeight, error)
+}
+
+// externalWeights looks up the weights that select the committees of
+// voteRound.
+type externalWeights struct {
+	l            weightedLedger
+	balanceRound basics.Round
+	voteRound    basics.Round
+}
+
+// weightsForRound returns the external weights for voteRound, and false when
+// the committees of voteRound are not selected by external weight or the
+// ledger cannot look weights up, in which case the API omits them.
+func weightsForRound(myLedger LedgerForAPI, voteRound basics.Round) (externalWeights, bool) {
+	l, ok := myLedger.(weightedLedger)
+	if !ok || l.WeightOracle() == nil {
+		return externalWeights{}, false
+	}
+	params, err := myLedger.ConsensusParams(agreement.ParamsRound(voteRound))
+	if err != nil || !params.EnableExternalWeightOracle {
+		return externalWeights{}, false
+	}
+	return externalWeights{
+		l:            l,
+		balanceRound: agreement.BalanceRound(voteRound, params),
+		voteRound:    voteRound,
+	}, true
+}
+
+// voter returns the online account data of addr at the balance round, and
+// false if addr was not online then or its key does not cover the vote round.
+func (w externalWeights) voter(addr basics.Address) (basics.OnlineAccountData, bool, error) {
+	data, err := w.l.LookupAgreement(w.balanceRound, addr)
+	if err != nil {
+		return basics.OnlineAccountData{}, false, err
+	}
+	return data, !data.VoteID.IsEmpty() && ledgercore.VoteKeyEligible(data, w.voteRound), nil
+}
+
+// account returns the weight of addr, which is zero if addr cannot vote.
+func (w externalWeights) account(addr basics.Address) (uint64, error) {
+	data, ok, err := w.voter(addr)
+	if !ok {
+		return 0, err
+	}
+	return w.l.ExternalWeight(w.balanceRound, addr, data.SelectionID)
+}
+
+// key returns the weight of the account of record if record is the key the
+// account registered to vote with, and zero otherwise.
+func (w externalWeights) key(record account.ParticipationRecord) (uint64, error) {
+	if record.VRF == nil {
+		return 0, nil
+	}
+	data, ok, err := w.voter(record.Account)
+	if !ok || data.SelectionID != record.VRF.PK {
+		return 0, err
+	}
+	return w.l.ExternalWeight(w.balanceRound, record.Account, data.SelectionID)
+}
+
+// selection returns the weight of the account of record under the selection
+// key of record, whether or not it is the key the account registered: a
+// daemon may weigh an account's keys differently.
+func (w externalWeights) selection(record account.ParticipationRecord) (uint64, error) {
+	if record.VRF == nil {
+		return 0, nil
+	}
+	return w.l.ExternalWeight(w.balanceRound, record.Account, record.VRF.PK)
+}
+
+// total returns the total weight.
+func (w externalWeights) total() (uint64, error) {
+	return w.l.TotalExternalWeight(w.balanceRound, w.voteRound)
+}
+
+// display returns how the weight oracle asks for weights to be shown.
+func (w externalWeights) display() ledgercore.WeightDisplay {
+	return ledgercore.WeightDisplayOf(w.l.WeightOracle())
+}
+
+// optionalWeight returns weight for an optional response field. Failed
+// lookups leave the field out: the weight oracle being unreachable should not
+// fail requests that only include weights for context.
+func (v2 *Handlers) optionalWeight(weight uint64, err error) *uint64 {
+	if err != nil {
+		v2.Log.Debugf("external weight lookup failed: %v", err)
+		return nil
+	}
+	return &weight
+}
+
+// setWeightOracleStatus fills in the weight daemon fields of a node status
+// response; they are left out when the node does not use an external daemon.
+func setWeightOracleStatus(response *model.NodeStatusResponse, stat node.StatusReport) {
+	if stat.WeightOracle != nil {
+		state := stat.WeightOracle.State.String()
+		failures := uint64(stat.WeightOracle.ConsecutiveFailures)
+		response.WeightOracleState = &state
+		response.WeightOracleConsecutiveFailures = &failures
+	}
+	if stat.WeightOracleStats != nil {
+		response.WeightOracleQueries = &stat.WeightOracleStats.Queries
+		response.WeightOracleCacheHits = &stat.WeightOracleStats.CacheHits
+		response.WeightOracleCacheMisses = &stat.WeightOracleStats.CacheMisses
+	}
+	if !stat.WeightOracleLastAnswer.IsZero() {
+		since := time.Since(stat.WeightOracleLastAnswer).Nanoseconds()
+		response.WeightOracleTimeSinceLastAnswer = &since
+	}
+	if stat.WeightOracleIdentity != nil {
+		response.WeightOracleAlgorithmVersion = &stat.WeightOracleIdentity.WeightAlgorithmVersion
+		response.WeightOracleProtocolVersion = &stat.WeightOracleIdentity.WeightProtocolVersion
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type weightTestLedger struct {
+	LedgerForAPI
+	oracle   ledgercore.WeightOracle
+	params   config.ConsensusParams
+	accounts map[basics.Address]basics.OnlineAccountData
+	weights  map[crypto.VRFVerifier]uint64
+}
+
+func (l weightTestLedger) ConsensusParams(basics.Round) (config.ConsensusParams, error) {
+	return l.params, nil
+}
+
+func (l weightTestLedger) WeightOracle() ledgercore.WeightOracle { return l.oracle }
+
+func (l weightTestLedger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	return l.accounts[addr], nil
+}
+
+func (l weightTestLedger) ExternalWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	return l.weights[selectionID], nil
+}
+
+func (l weightTestLedger) TotalExternalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	return 1000, nil
+}
+
+func TestWeightsForRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	vrf := crypto.GenerateVRFSecrets()
+	voter := basics.Address{1}
+	expired := basics.Address{3}
+	l := weightTestLedger{
+		oracle: mock.New(),
+		params: config.Consensus[protocol.ConsensusCurrentVersion],
+		accounts: map[basics.Address]basics.OnlineAccountData{
+			voter:   {VotingData: basics.VotingData{VoteID: crypto.OneTimeSignatureVerifier{1}, SelectionID: vrf.PK}},
+			expired: {VotingData: basics.VotingData{VoteID: crypto.OneTimeSignatureVerifier{1}, SelectionID: vrf.PK, VoteLastValid: 10}},
+		},
+		weights: map[crypto.VRFVerifier]uint64{vrf.PK: 10},
+	}
+	l.params.EnableExternalWeightOracle = true
+
+	w, ok := weightsForRound(l, 1000)
+	require.True(t, ok)
+	require.Equal(t, agreement.BalanceRound(1000, l.params), w.balanceRound)
+	weight, err := w.account(voter)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, weight)
+	// Offline accounts and expired keys have no weight.
+	weight, err = w.account(basics.Address{2})
+	require.NoError(t, err)
+	require.Zero(t, weight)
+	weight, err = w.account(expired)
+	require.NoError(t, err)
+	require.Zero(t, weight)
+	total, err := w.total()
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, total)
+
+	// A key has the account's weight only if it is the registered key.
+	weight, err = w.key(account.ParticipationRecord{Account: voter, VRF: vrf})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, weight)
+	weight, err = w.key(account.ParticipationRecord{Account: voter, VRF: crypto.GenerateVRFSecrets()})
+	require.NoError(t, err)
+	require.Zero(t, weight)
+	weight, err = w.key(account.ParticipationRecord{Account: voter})
+	require.NoError(t, err)
+	require.Zero(t, weight)
+
+	// Under its own selection key, each key has the weight the oracle gives
+	// it, registered or not.
+	next := crypto.GenerateVRFSecrets()
+	l.weights[next.PK] = 4
+	weight, err = w.selection(account.ParticipationRecord{Account: voter, VRF: vrf})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, weight)
+	weight, err = w.selection(account.ParticipationRecord{Account: voter, VRF: next})
+	require.NoError(t, err)
+	require.EqualValues(t, 4, weight)
+	weight, err = w.selection(account.ParticipationRecord{Account: voter})
+	require.NoError(t, err)
+	require.Zero(t, weight)
+
+	// Weights are omitted without an oracle, without external weights and
+	// for ledgers that cannot look them up.
+	noOracle := l
+	noOracle.oracle = nil
+	_, ok = weightsForRound(noOracle, 1000)
+	require.False(t, ok)
+	stake := l
+	stake.params.EnableExternalWeightOracle = false
+	_, ok = weightsForRound(stake, 1000)
+	require.False(t, ok)
+	_, ok = weightsForRound(struct{ LedgerForAPI }{l}, 1000)
+	require.False(t, ok)
+
+	v2 := Handlers{Log: logging.TestingLog(t)}
+	require.Equal(t, uint64(7), *v2.optionalWeight(7, nil))
+	require.Nil(t, v2.optionalWeight(7, errors.New("daemon down")))
+}
+
+func TestSetWeightOracleStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var response model.NodeStatusResponse
+	setWeightOracleStatus(&response, node.StatusReport{})
+	require.Equal(t, model.NodeStatusResponse{}, response)
+
+	stat := node.StatusReport{
+		WeightOracle:           &node.WeightOracleStatus{State: node.WeightOracleDegraded, ConsecutiveFailures: 2},
+		WeightOracleStats:      &weightoracle.QueryStats{Queries: 10, CacheHits: 30, CacheMisses: 6},
+		WeightOracleLastAnswer: time.Now().Add(-time.Minute),
+		WeightOracleIdentity:   &ledgercore.DaemonIdentity{WeightAlgorithmVersion: "1.0", WeightProtocolVersion: "2"},
+	}
+	setWeightOracleStatus(&response, stat)
+	require.Equal(t, "degraded", *response.WeightOracleState)
+	require.Equal(t, uint64(2), *response.WeightOracleConsecutiveFailures)
+	require.Equal(t, uint64(10), *response.WeightOracleQueries)
+	require.Equal(t, uint64(30), *response.WeightOracleCacheHits)
+	require.Equal(t, uint64(6), *response.WeightOracleCacheMisses)
+	require.GreaterOrEqual(t, *response.WeightOracleTimeSinceLastAnswer, time.Minute.Nanoseconds())
+	require.Equal(t, "1.0", *response.WeightOracleAlgorithmVersion)
+	require.Equal(t, "2", *response.WeightOracleProtocolVersion)
+}
+
+// proposerWeightTestNode records the proposer weight of round 7, and fails
+// unexpectedly for round 13.
+type proposerWeightTestNode struct {
+	NodeInterface
+}
+
+func (proposerWeightTestNode) BlockProposerWeight(rnd basics.Round) (node.ProposerWeight, error) {
+	switch rnd {
+	case 7:
+		return node.ProposerWeight{Round: 7, Proposer: basics.Address{1}, BalanceRound: 3, Weight: 10, TotalWeight: 100}, nil
+	case 13:
+		return node.ProposerWeight{}, errors.New("broken")
+	}
+	return node.ProposerWeight{}, fmt.Errorf("%w: not recorded", node.ErrProposerWeightUnavailable)
+}
+
+func TestGetBlockProposerWeight(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	get := func(n NodeInterface, rnd basics.Round) *httptest.ResponseRecorder {
+		v2 := Handlers{Node: n, Log: logging.TestingLog(t)}
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		require.NoError(t, v2.GetBlockProposerWeight(ctx, rnd))
+		return rec
+	}
+
+	rec := get(proposerWeightTestNode{}, 7)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.BlockProposerWeightResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.BlockProposerWeightResponse{Round: 7, Proposer: basics.Address{1}.String(), BalanceRound: 3, Weight: 10, TotalWeight: 100}, response)
+
+	require.Equal(t, http.StatusNotFound, get(proposerWeightTestNode{}, 8).Code)
+	require.Equal(t, http.StatusInternalServerError, get(proposerWeightTestNode{}, 13).Code)
+	// Nodes that record no proposer weights have none for any block.
+	require.Equal(t, http.StatusNotFound, get(struct{ NodeInterface }{}, 7).Code)
+}
@@ -82,12 +82,15 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 	// set up node
 	s.log = logging.Base()
 
-	lib.GenesisJSONText = genesisText
+	genesisJSON, err := lib.GenesisWithWeightMetadata(genesisText, s.Genesis)
+	if err != nil {
+		return fmt.Errorf("Initialize() failed to add weight metadata to the genesis file: %w", err)
+	}
+	lib.GenesisJSONText = genesisJSON
 
 	liveLog, archive := cfg.ResolveLogPaths(s.RootPath)
 
 	var maxLogAge time.Duration
-	var err error
 	if cfg.LogArchiveMaxAge != "" {
 		maxLogAge, err = time.ParseDuration(cfg.LogArchiveMaxAge)
 		if err != nil {
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A WeightStatement is the claim a weight daemon signs when it attests to an
+// account's external weight: that Addr, voting with the selection key
+// SelectionID, had Weight at BalanceRound on the network whose genesis hash is
+// GenesisHash. The key and the network keep an attestation from vouching for
+// the weight of a rotated key or on another network.
+type WeightStatement struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	BalanceRound basics.Round       `codec:"rnd"`
+	Addr         basics.Address     `codec:"addr"`
+	SelectionID  crypto.VRFVerifier `codec:"sel"`
+	Weight       uint64             `codec:"wt"`
+	GenesisHash  crypto.Digest      `codec:"gh"`
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (s WeightStatement) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.ExternalWeightStatement, protocol.Encode(&s)
+}
+
+// A WeightAttestation is a weight daemon's signature over a WeightStatement.
+//
+// The Signer is the daemon's attestation key. Verify accepts only attestations
+// by the key the consensus protocol trusts, so that a valid signature by any
+// other key does not vouch for a weight.
+type WeightAttestation struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Signer crypto.PublicKey `codec:"s"`
+	Sig    crypto.Signature `codec:"sig"`
+}
+
+// Verify checks that the attestation is a valid signature over stmt by the
+// trusted key. A zero trusted key trusts no attestation.
+func (a WeightAttestation) Verify(stmt WeightStatement, trusted crypto.PublicKey) error {
+	if trusted == (crypto.PublicKey{}) {
+		return fmt.Errorf("no weight attestation key is trusted")
+	}
+	if a.Signer != trusted {
+		return fmt.Errorf("weight attestation by untrusted key %v", a.Signer)
+	}
+	if !a.Signer.Verify(stmt, a.Sig) {
+		return fmt.Errorf("weight attestation by %v does not sign %+v", a.Signer, stmt)
+	}
+	return nil
+}
+
+// verifyAttestation checks the Membership's attestation against its
+// ExternalWeight and the trusted key. It is only called when the protocol
+// requires attestations.
+func (m Membership) verifyAttestation(trusted crypto.PublicKey) error {
+	if m.Attestation == nil {
+		return fmt.Errorf("missing weight attestation for %v at round %d", m.Record.Addr, m.BalanceRound)
+	}
+	stmt := WeightStatement{
+		BalanceRound: m.BalanceRound,
+		Addr:         m.Record.Addr,
+		SelectionID:  m.Record.SelectionID,
+		Weight:       m.ExternalWeight,
+		GenesisHash:  m.GenesisHash,
+	}
+	return m.Attestation.Verify(stmt, trusted)
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestWeightAttestationVerify checks that Credential.Verify enforces weight
+// attestations only when the protocol requires them.
+func TestWeightAttestationVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	selParams, _, round, addresses, _, vrfSecrets := testingenv(t, 10, 2000, nil)
+	ok, record, selectionSeed, totalMoney := selParams(addresses[0])
+	require.True(t, ok)
+
+	sel := AgreementSelector{Seed: selectionSeed, Round: round, Period: 0, Step: Propose}
+	balanceRound := round - 1
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	daemonKey := crypto.GenerateSignatureSecrets(seed)
+
+	weight := totalMoney.Raw / 2
+	genesisHash := crypto.Hash([]byte("attestation test network"))
+	statement := func(w uint64) WeightStatement {
+		return WeightStatement{BalanceRound: balanceRound, Addr: record.Addr, SelectionID: record.SelectionID, Weight: w, GenesisHash: genesisHash}
+	}
+	sign := func(stmt WeightStatement) *WeightAttestation {
+		return &WeightAttestation{Signer: daemonKey.SignatureVerifier, Sig: daemonKey.Sign(stmt)}
+	}
+
+	m := Membership{
+		Record:              record,
+		Selector:            sel,
+		TotalMoney:          totalMoney,
+		ExternalWeight:      weight,
+		TotalExternalWeight: totalMoney.Raw,
+		BalanceRound:        balanceRound,
+		GenesisHash:         genesisHash,
+	}
+	u := MakeCredential(vrfSecrets[0], sel)
+
+	signedProto := proto
+	signedProto.ExternalWeightAttestation = true
+	signedProto.ExternalWeightAttestationKey = daemonKey.SignatureVerifier
+
+	// Without the protocol flag, a missing attestation is fine.
+	_, err := u.Verify(proto, m)
+	require.NoError(t, err)
+
+	// With the flag, a missing attestation is rejected.
+	_, err = u.Verify(signedProto, m)
+	require.ErrorContains(t, err, "missing weight attestation")
+
+	// A valid attestation over the membership's weight is accepted.
+	m.Attestation = sign(statement(weight))
+	cred, err := u.Verify(signedProto, m)
+	require.NoError(t, err)
+	require.Greater(t, cred.Weight, uint64(0))
+
+	// An attestation for a different weight is rejected.
+	m.Attestation = sign(statement(weight + 1))
+	_, err = u.Verify(signedProto, m)
+	require.ErrorContains(t, err, "does not sign")
+
+	// So is one for another selection key of the account, or for another
+	// network.
+	stmt := statement(weight)
+	stmt.SelectionID[0]++
+	m.Attestation = sign(stmt)
+	_, err = u.Verify(signedProto, m)
+	require.ErrorContains(t, err, "does not sign")
+	stmt = statement(weight)
+	stmt.GenesisHash[0]++
+	m.Attestation = sign(stmt)
+	_, err = u.Verify(signedProto, m)
+	require.ErrorContains(t, err, "does not sign")
+
+	// A valid attestation by a key other than the trusted one is rejected.
+	crypto.RandBytes(seed[:])
+	otherKey := crypto.GenerateSignatureSecrets(seed)
+	m.Attestation = &WeightAttestation{Signer: otherKey.SignatureVerifier, Sig: otherKey.Sign(statement(weight))}
+	_, err = u.Verify(signedProto, m)
+	require.ErrorContains(t, err, "untrusted key")
+
+	// Without a trusted key, no attestation is accepted.
+	m.Attestation = sign(statement(weight))
+	untrustingProto := signedProto
+	untrustingProto.ExternalWeightAttestationKey = [32]byte{}
+	_, err = u.Verify(untrustingProto, m)
+	require.ErrorContains(t, err, "no weight attestation key")
+
+	// An attestation for a different balance round is rejected.
+	m.BalanceRound++
+	_, err = u.Verify(signedProto, m)
+	require.ErrorContains(t, err, "does not sign")
+}
@@ -53,6 +53,19 @@ type Membership struct {
 	TotalMoney          basics.MicroAlgos
 	ExternalWeight      uint64 // Individual account's external consensus weight
 	TotalExternalWeight uint64 // Total network external consensus weight
+
+	// BalanceRound is the round whose weight snapshot ExternalWeight was taken from.
+	BalanceRound basics.Round
+
+	// Attestation optionally carries the weight daemon's signature over
+	// (BalanceRound, Record.Addr, Record.SelectionID, ExternalWeight,
+	// GenesisHash). It is only checked when the consensus protocol enables
+	// ExternalWeightAttestation.
+	Attestation *WeightAttestation
+
+	// GenesisHash is the genesis hash of the network the membership is
+	// checked on, which Attestation must be for.
+	GenesisHash crypto.Digest
 }
 
 // A Seed contains cryptographic entropy which can be used to determine a
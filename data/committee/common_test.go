@@ -175,3 +175,90 @@ func (s Step) CommitteeSize(proto config.ConsensusParams) uint64 {
 		return proto.NextCommitteeSize
 	}
 }
+
+// weightDistribution assigns external consensus weights to numAccounts
+// accounts, independently of their stake.
+type weightDistribution func(numAccounts int, gen *rand.Rand) []uint64
+
+// uniformWeights gives every account the same weight.
+func uniformWeights(weight uint64) weightDistribution {
+	return func(numAccounts int, gen *rand.Rand) []uint64 {
+		weights := make([]uint64, numAccounts)
+		for i := range weights {
+			weights[i] = weight
+		}
+		return weights
+	}
+}
+
+// zipfWeights draws each account's weight from a Zipf distribution with
+// exponent s over [1, maxWeight], so a few accounts hold most of the weight.
+func zipfWeights(s float64, maxWeight uint64) weightDistribution {
+	return func(numAccounts int, gen *rand.Rand) []uint64 {
+		zipf := rand.NewZipf(gen, s, 1, maxWeight-1)
+		weights := make([]uint64, numAccounts)
+		for i := range weights {
+			weights[i] = zipf.Uint64() + 1
+		}
+		return weights
+	}
+}
+
+// whaleWeights gives the first account whaleShare of totalWeight and splits
+// the rest evenly among the other accounts.
+func whaleWeights(whaleShare float64, totalWeight uint64) weightDistribution {
+	return func(numAccounts int, gen *rand.Rand) []uint64 {
+		weights := make([]uint64, numAccounts)
+		weights[0] = uint64(whaleShare * float64(totalWeight))
+		for i := 1; i < numAccounts; i++ {
+			weights[i] = (totalWeight - weights[0]) / uint64(numAccounts-1)
+		}
+		return weights
+	}
+}
+
+// weightedEnv is a testingenv whose accounts carry external consensus
+// weights drawn from a weightDistribution rather than mirroring their stake.
+type weightedEnv struct {
+	selParams   selectionParameterFn
+	round       basics.Round
+	addrs       []basics.Address
+	vrfSecrets  []*crypto.VrfPrivkey
+	weights     map[basics.Address]uint64
+	totalWeight uint64
+}
+
+// testingenvWeighted creates numAccounts participating accounts, as
+// testingenv does, and assigns them weights from dist.
+func testingenvWeighted(t testing.TB, numAccounts int, dist weightDistribution, seedGen io.Reader) weightedEnv {
+	selParams, _, round, addrs, _, vrfSecrets := testingenv(t, numAccounts, 0, seedGen)
+	env := weightedEnv{
+		selParams:  selParams,
+		round:      round,
+		addrs:      addrs,
+		vrfSecrets: vrfSecrets,
+		weights:    make(map[basics.Address]uint64, numAccounts),
+	}
+	for i, w := range dist(numAccounts, rand.New(rand.NewSource(3))) {
+		env.weights[addrs[i]] = w
+		env.totalWeight += w
+	}
+	return env
+}
+
+// membership returns the Membership of the i-th account for the given step
+// of the environment's round, carrying its external weight.
+func (env weightedEnv) membership(t testing.TB, i int, period Period, step Step) (Membership, AgreementSelector) {
+	ok, record, seed, total := env.selParams(env.addrs[i])
+	if !ok {
+		t.Fatalf("can't read selection params of account %d", i)
+	}
+	sel := AgreementSelector{Seed: seed, Round: env.round, Period: period, Step: step}
+	return Membership{
+		Record:              record,
+		Selector:            sel,
+		TotalMoney:          total,
+		ExternalWeight:      env.weights[env.addrs[i]],
+		TotalExternalWeight: env.totalWeight,
+	}, sel
+}
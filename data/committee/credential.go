@@ -99,15 +99,22 @@ func (cred UnauthenticatedCredential) Verify(proto config.ConsensusParams, m Mem
 	userMoney := m.Record.VotingStake()
 	expectedSelection := float64(m.Selector.CommitteeSize(proto))
 
-	// Stake is no longer used for gating or selection; suppress unused-variable error
-	_ = userMoney
-
-	// Weight determines both eligibility and selection probability.
-	// ExternalWeight == 0 means either:
-	//   (a) The account had invalid vote keys (membership() left weights at zero), or
-	//   (b) An invariant violation (should have been caught in membership()).
-	// In case (a), vote.verify rejects the message immediately afterward.
-	if m.ExternalWeight > 0 {
+	if !proto.EnableExternalWeightOracle {
+		// Stake-based sortition, for protocol versions that predate the
+		// switch to external weights.
+		if m.TotalMoney.Raw < userMoney.Raw {
+			logging.Base().Panicf("UnauthenticatedCredential.Verify: total money = %v, but user money = %v", m.TotalMoney, userMoney)
+		} else if m.TotalMoney.IsZero() || expectedSelection == 0 || expectedSelection > float64(m.TotalMoney.Raw) {
+			logging.Base().Panicf("UnauthenticatedCredential.Verify: m.TotalMoney %v, expectedSelection %v", m.TotalMoney.Raw, expectedSelection)
+		} else if !userMoney.IsZero() {
+			weight = sortition.Select(userMoney.Raw, m.TotalMoney.Raw, expectedSelection, sortition.Digest(h))
+		}
+	} else if m.ExternalWeight > 0 {
+		// Weight determines both eligibility and selection probability.
+		// ExternalWeight == 0 means either:
+		//   (a) The account had invalid vote keys (membership() left weights at zero), or
+		//   (b) An invariant violation (should have been caught in membership()).
+		// In case (a), vote.verify rejects the message immediately afterward.
 		// Population alignment check: TotalExternalWeight must be >= ExternalWeight
 		// Note: This also catches TotalExternalWeight == 0 when ExternalWeight > 0
 		if m.TotalExternalWeight < m.ExternalWeight {
@@ -121,6 +128,15 @@ func (cred UnauthenticatedCredential) Verify(proto config.ConsensusParams, m Mem
 				m.TotalExternalWeight, expectedSelection)
 		}
 
+		// When the network requires signed weights, the weight fed into
+		// sortition must be backed by a daemon attestation.
+		if proto.ExternalWeightAttestation {
+			if err = m.verifyAttestation(crypto.PublicKey(proto.ExternalWeightAttestationKey)); err != nil {
+				err = fmt.Errorf("UnauthenticatedCredential.Verify: %w", err)
+				return
+			}
+		}
+
 		// Weight passed directly to sortition.Select
 		weight = sortition.Select(m.ExternalWeight, m.TotalExternalWeight, expectedSelection, sortition.Digest(h))
 	}
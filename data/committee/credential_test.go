@@ -722,3 +722,97 @@ func BenchmarkSortition(b *testing.B) {
 		credentials[i], _ = MakeCredential(vrfSecrets[0], sel).Verify(proto, m)
 	}
 }
+
+// TestStakeSortitionWhenExternalWeightDisabled checks that protocols without
+// external weighting select by voting stake and ignore ExternalWeight.
+func TestStakeSortitionWhenExternalWeightDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	stakeProto := proto
+	stakeProto.EnableExternalWeightOracle = false
+
+	selParams, _, round, addresses, _, vrfSecrets := testingenv(t, 10, 2000, nil)
+	ok, record, selectionSeed, totalMoney := selParams(addresses[0])
+	require.True(t, ok)
+
+	sel := AgreementSelector{
+		Seed:   selectionSeed,
+		Round:  round,
+		Period: Period(0),
+		Step:   Soft,
+	}
+	u := MakeCredential(vrfSecrets[0], sel)
+
+	// External weights are ignored: a zero weight still yields a credential
+	// when the account holds stake.
+	m := Membership{
+		Record:     record,
+		Selector:   sel,
+		TotalMoney: totalMoney,
+	}
+	cred, err := u.Verify(stakeProto, m)
+	require.NoError(t, err)
+	require.NotZero(t, cred.Weight)
+
+	// Without stake, the account cannot be selected.
+	record.MicroAlgosWithRewards.Raw = 0
+	m.Record = record
+	m.ExternalWeight = totalMoney.Raw / 2
+	m.TotalExternalWeight = totalMoney.Raw
+	_, err = u.Verify(stakeProto, m)
+	require.Error(t, err)
+}
+
+// TestWeightedDistributionsSelectCommittee runs cert-step sortition over
+// accounts whose external weights follow distributions seen on weighted
+// networks, and checks that the committee has the expected size and that each
+// account's share of it follows its weight, not its stake.
+func TestWeightedDistributionsSelectCommittee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	distributions := []struct {
+		name string
+		dist weightDistribution
+	}{
+		{"uniform", uniformWeights(1000)},
+		{"zipf", zipfWeights(1.2, 1000000)},
+		{"single whale", whaleWeights(0.4, 1000000)},
+	}
+	const numAccounts = 100
+	const seeds = 10
+	step := Cert
+	expected := float64(step.CommitteeSize(proto))
+
+	for _, d := range distributions {
+		t.Run(d.name, func(t *testing.T) {
+			seedGen := rand.New(rand.NewSource(1))
+			var committee, whaleVotes uint64
+			var whaleShare float64
+			for i := 0; i < seeds; i++ {
+				env := testingenvWeighted(t, numAccounts, d.dist, seedGen)
+				whaleShare = float64(env.weights[env.addrs[0]]) / float64(env.totalWeight)
+				for j := range env.addrs {
+					m, sel := env.membership(t, j, 0, step)
+					credential, err := MakeCredential(env.vrfSecrets[j], sel).Verify(proto, m)
+					if env.weights[env.addrs[j]] == 0 {
+						require.Error(t, err)
+						continue
+					}
+					if err == nil {
+						committee += credential.Weight
+						if j == 0 {
+							whaleVotes += credential.Weight
+						}
+					}
+				}
+			}
+
+			avg := float64(committee) / seeds
+			require.InDelta(t, expected, avg, 0.1*expected, "average committee size")
+			// The first account's expected share of the committee is its
+			// share of the weight, whatever its stake.
+			require.InDelta(t, whaleShare, float64(whaleVotes)/float64(committee), 0.05,
+				"first account holds %.3f of the weight", whaleShare)
+		})
+	}
+}
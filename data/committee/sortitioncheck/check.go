@@ -0,0 +1,331 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package sortitioncheck validates weighted sortition statistically. It runs
+// many credential verifications for accounts whose external weights follow a
+// chosen distribution and checks that the observed selections match the
+// binomial model sortition is built on, within confidence bounds.
+package sortitioncheck
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sync"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A Distribution assigns external weights to accounts, independently of
+// their stake.
+type Distribution struct {
+	Name    string
+	Weights func(numAccounts int, rng *rand.Rand) []uint64
+}
+
+// Uniform gives every account the same weight.
+func Uniform(weight uint64) Distribution {
+	return Distribution{
+		Name: fmt.Sprintf("uniform(%d)", weight),
+		Weights: func(numAccounts int, _ *rand.Rand) []uint64 {
+			weights := make([]uint64, numAccounts)
+			for i := range weights {
+				weights[i] = weight
+			}
+			return weights
+		},
+	}
+}
+
+// Zipf draws each account's weight from a Zipf distribution with exponent s
+// over [1, maxWeight].
+func Zipf(s float64, maxWeight uint64) Distribution {
+	return Distribution{
+		Name: fmt.Sprintf("zipf(%g,%d)", s, maxWeight),
+		Weights: func(numAccounts int, rng *rand.Rand) []uint64 {
+			zipf := rand.NewZipf(rng, s, 1, maxWeight-1)
+			weights := make([]uint64, numAccounts)
+			for i := range weights {
+				weights[i] = zipf.Uint64() + 1
+			}
+			return weights
+		},
+	}
+}
+
+// Whale gives the first account share of totalWeight and splits the rest
+// evenly among the other accounts.
+func Whale(share float64, totalWeight uint64) Distribution {
+	return Distribution{
+		Name: fmt.Sprintf("whale(%g,%d)", share, totalWeight),
+		Weights: func(numAccounts int, _ *rand.Rand) []uint64 {
+			weights := make([]uint64, numAccounts)
+			weights[0] = uint64(share * float64(totalWeight))
+			for i := 1; i < numAccounts; i++ {
+				weights[i] = (totalWeight - weights[0]) / uint64(numAccounts-1)
+			}
+			return weights
+		},
+	}
+}
+
+// DefaultDistributions returns the distributions checked when none are
+// chosen: equal weights, a heavy-tailed population and a single whale.
+func DefaultDistributions() []Distribution {
+	return []Distribution{
+		Uniform(1000),
+		Zipf(1.2, 1000000),
+		Whale(0.4, 1000000),
+	}
+}
+
+// Config controls a validation run.
+type Config struct {
+	// Proto must enable external weights.
+	Proto config.ConsensusParams
+
+	// CommitteeSize is the expected committee size, such as
+	// Proto.SoftCommitteeSize.
+	CommitteeSize uint64
+
+	// Accounts is the number of accounts, and Trials the number of
+	// independent selection seeds each account is verified against.
+	Accounts int
+	Trials   int
+
+	// Confidence is the probability that a correct implementation passes
+	// all checks of a run together, such as 0.999.
+	Confidence float64
+
+	// Seed makes the accounts, weights and selection seeds reproducible.
+	Seed int64
+
+	// Workers is the number of verifications run in parallel; 0 means one
+	// per CPU.
+	Workers int
+}
+
+// Result is the outcome of a validation run.
+type Result struct {
+	Distribution  string
+	Verifications int
+
+	// MaxZ is the largest absolute z-score of any check, and Critical the
+	// z-score a check must stay below to pass.
+	MaxZ     float64
+	Critical float64
+
+	// Failures describes every check that fell outside its bound.
+	Failures []string
+}
+
+// Run verifies cfg.Trials credentials for each of cfg.Accounts accounts with
+// weights from dist, and checks the selections against the binomial model.
+func Run(cfg Config, dist Distribution) (Result, error) {
+	if !cfg.Proto.EnableExternalWeightOracle {
+		return Result{}, fmt.Errorf("consensus parameters do not enable external weights")
+	}
+	if cfg.Accounts < 2 || cfg.Trials < 2 {
+		return Result{}, fmt.Errorf("need at least 2 accounts and 2 trials")
+	}
+	if cfg.Confidence <= 0 || cfg.Confidence >= 1 {
+		return Result{}, fmt.Errorf("confidence must be between 0 and 1")
+	}
+	// Weights are not signed here.
+	proto := cfg.Proto
+	proto.ExternalWeightAttestation = false
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	weights := dist.Weights(cfg.Accounts, rng)
+	var total uint64
+	for _, w := range weights {
+		if w == 0 {
+			return Result{}, fmt.Errorf("distribution %s gave an account zero weight", dist.Name)
+		}
+		total += w
+	}
+	committeeSize := cfg.CommitteeSize
+	if committeeSize == 0 || committeeSize > total {
+		return Result{}, fmt.Errorf("committee size %d must be positive and at most the total weight %d", committeeSize, total)
+	}
+
+	records := make([]committee.BalanceRecord, cfg.Accounts)
+	keys := make([]*crypto.VrfPrivkey, cfg.Accounts)
+	for i := range records {
+		var seed crypto.Seed
+		rng.Read(seed[:])
+		pk, sk := crypto.VrfKeygenFromSeed(seed)
+		keys[i] = &sk
+		rng.Read(records[i].Addr[:])
+		records[i].SelectionID = pk
+	}
+	seeds := make([]committee.Seed, cfg.Trials)
+	for i := range seeds {
+		rng.Read(seeds[i][:])
+	}
+
+	obs := newObservations(cfg.Accounts, cfg.Trials)
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	trials := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for trial := range trials {
+				sel := selector{Seed: seeds[trial], Trial: uint64(trial), Size: committeeSize}
+				for i := range records {
+					m := committee.Membership{
+						Record:              records[i],
+						Selector:            sel,
+						ExternalWeight:      weights[i],
+						TotalExternalWeight: total,
+					}
+					// Verify reports unselected accounts as errors with zero
+					// weight. A broken VRF proof looks the same, and fails the
+					// mean checks below.
+					cred, _ := committee.MakeCredential(keys[i], sel).Verify(proto, m)
+					obs.record(trial, i, cred.Weight)
+				}
+			}
+		}()
+	}
+	for trial := 0; trial < cfg.Trials; trial++ {
+		trials <- trial
+	}
+	close(trials)
+	wg.Wait()
+
+	res := analyze(obs, weights, float64(committeeSize), cfg.Confidence)
+	res.Distribution = dist.Name
+	res.Verifications = cfg.Accounts * cfg.Trials
+	return res, nil
+}
+
+// selector draws a committee of a fixed expected size. Each trial hashes
+// differently, so the trials' VRF outputs are independent.
+type selector struct {
+	Seed  committee.Seed `codec:"seed"`
+	Trial uint64         `codec:"trial"`
+	Size  uint64         `codec:"size"`
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (sel selector) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.AgreementSelector, protocol.EncodeReflect(&sel)
+}
+
+// CommitteeSize implements the committee.Selector interface.
+func (sel selector) CommitteeSize(config.ConsensusParams) uint64 {
+	return sel.Size
+}
+
+// observations holds the selection weight of every account in every trial.
+type observations struct {
+	selected [][]uint64 // [trial][account]
+}
+
+func newObservations(accounts, trials int) *observations {
+	obs := &observations{selected: make([][]uint64, trials)}
+	for i := range obs.selected {
+		obs.selected[i] = make([]uint64, accounts)
+	}
+	return obs
+}
+
+// record stores one verification result; each (trial, account) cell is
+// written by a single worker.
+func (o *observations) record(trial, account int, weight uint64) {
+	o.selected[trial][account] = weight
+}
+
+// analyze compares obs with the binomial model: an account with weight w out
+// of total weight W is selected Binomial(w, tau/W) times for a committee of
+// expected size tau. It checks, with a Bonferroni correction over all checks:
+//   - each account's mean selection count;
+//   - how often each account is selected at all, where the normal
+//     approximation holds;
+//   - the mean committee size.
+func analyze(obs *observations, weights []uint64, tau float64, confidence float64) Result {
+	var total float64
+	for _, w := range weights {
+		total += float64(w)
+	}
+	p := tau / total
+	trials := float64(len(obs.selected))
+
+	type check struct {
+		name          string
+		observed      float64
+		expected, std float64
+	}
+	var checks []check
+	var committeeSum float64
+	for i, w := range weights {
+		var sum, hits float64
+		for _, trial := range obs.selected {
+			sum += float64(trial[i])
+			if trial[i] > 0 {
+				hits++
+			}
+		}
+		committeeSum += sum
+		wf := float64(w)
+		checks = append(checks, check{
+			name:     fmt.Sprintf("account %d (weight %d) mean selections", i, w),
+			observed: sum / trials,
+			expected: wf * p,
+			std:      math.Sqrt(wf * p * (1 - p) / trials),
+		})
+		q := 1 - math.Pow(1-p, wf)
+		if trials*q*(1-q) >= 10 {
+			checks = append(checks, check{
+				name:     fmt.Sprintf("account %d (weight %d) selection frequency", i, w),
+				observed: hits / trials,
+				expected: q,
+				std:      math.Sqrt(q * (1 - q) / trials),
+			})
+		}
+	}
+	checks = append(checks, check{
+		name:     "mean committee size",
+		observed: committeeSum / trials,
+		expected: tau,
+		std:      math.Sqrt(tau * (1 - p) / trials),
+	})
+
+	alpha := (1 - confidence) / float64(len(checks))
+	res := Result{Critical: math.Sqrt2 * math.Erfinv(1-alpha)}
+	for _, c := range checks {
+		if c.std == 0 {
+			continue
+		}
+		z := (c.observed - c.expected) / c.std
+		res.MaxZ = math.Max(res.MaxZ, math.Abs(z))
+		if math.Abs(z) > res.Critical {
+			res.Failures = append(res.Failures, fmt.Sprintf("%s: observed %.6g, expected %.6g (z = %.2f, bound %.2f)",
+				c.name, c.observed, c.expected, z, res.Critical))
+		}
+	}
+	return res
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sortitioncheck
+
+import (
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testConfig() Config {
+	proto := config.Consensus[protocol.ConsensusFuture]
+	return Config{
+		Proto:         proto,
+		CommitteeSize: proto.SoftCommitteeSize,
+		Accounts:      50,
+		Trials:        100,
+		Confidence:    0.9999,
+		Seed:          1,
+	}
+}
+
+func TestDistributionsMatchBinomial(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := testConfig()
+	if testing.Short() {
+		cfg.Trials = 20
+	}
+	for _, dist := range DefaultDistributions() {
+		res, err := Run(cfg, dist)
+		require.NoError(t, err)
+		require.Empty(t, res.Failures, "%s: max z %.2f", dist.Name, res.MaxZ)
+		require.Equal(t, cfg.Accounts*cfg.Trials, res.Verifications)
+	}
+}
+
+func TestLongDistributionsMatchBinomial(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// This test runs a million verifications per distribution, and is meant
+	// to be run manually, as:
+	//
+	//   SORTITIONCHECK=x go test -v . -run=Long -count=1 -timeout=0
+
+	if os.Getenv("SORTITIONCHECK") == "" {
+		t.Skip("Skipping; SORTITIONCHECK not set")
+	}
+
+	cfg := testConfig()
+	cfg.Accounts = 1000
+	cfg.Trials = 1000
+	cfg.Seed = 2
+	for _, dist := range DefaultDistributions() {
+		res, err := Run(cfg, dist)
+		require.NoError(t, err)
+		require.Empty(t, res.Failures, "%s: max z %.2f", dist.Name, res.MaxZ)
+	}
+}
+
+func TestAnalyzeDetectsBias(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const accounts, trials, tau = 50, 1000, 100
+	weights := Uniform(1000).Weights(accounts, nil)
+	p := float64(tau) / float64(accounts*1000)
+
+	// Sample the binomial model directly, then select the first account
+	// 10% more often than it should be.
+	rng := rand.New(rand.NewSource(1))
+	obs := newObservations(accounts, trials)
+	for trial := 0; trial < trials; trial++ {
+		for i, w := range weights {
+			var selected uint64
+			for j := uint64(0); j < w; j++ {
+				if rng.Float64() < p {
+					selected++
+				}
+			}
+			obs.record(trial, i, selected)
+		}
+	}
+	res := analyze(obs, weights, tau, 0.999)
+	require.Empty(t, res.Failures)
+
+	for trial := 0; trial < trials; trial += 5 {
+		obs.selected[trial][0] += 1
+	}
+	res = analyze(obs, weights, tau, 0.999)
+	require.NotEmpty(t, res.Failures)
+	require.Contains(t, res.Failures[0], "account 0 ")
+}
+
+func TestRunRejectsBadConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := testConfig()
+	cfg.Proto.EnableExternalWeightOracle = false
+	_, err := Run(cfg, Uniform(1000))
+	require.ErrorContains(t, err, "do not enable external weights")
+
+	cfg = testConfig()
+	_, err = Run(cfg, Uniform(0))
+	require.ErrorContains(t, err, "zero weight")
+
+	cfg = testConfig()
+	cfg.CommitteeSize = 1000 * 1000
+	_, err = Run(cfg, Uniform(1))
+	require.ErrorContains(t, err, "at most the total weight")
+}
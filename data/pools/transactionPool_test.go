@@ -46,6 +46,7 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	oraclemock "github.com/algorand/go-algorand/node/weightoracle/mock"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/stateproof/verify"
@@ -55,46 +56,6 @@ import (
 
 var proto = config.Consensus[protocol.ConsensusCurrentVersion]
 
-// testWeightOracle is a mock implementation of WeightOracle for pool tests.
-// It returns account stake as weight (simple passthrough behavior for testing).
-type testWeightOracle struct {
-	ledger *ledger.Ledger
-}
-
-func (m *testWeightOracle) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
-	// Return the account's stake as weight for testing purposes.
-	// If the account has zero stake or doesn't exist, return a default weight of 1.
-	acctData, _, _, _ := m.ledger.LookupLatest(addr)
-	if acctData.MicroAlgos.Raw == 0 {
-		return 1, nil
-	}
-	return acctData.MicroAlgos.Raw, nil
-}
-
-func (m *testWeightOracle) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
-	// Return the online circulation as total weight for testing purposes
-	circulation, err := m.ledger.OnlineCirculation(balanceRound, voteRound)
-	if err != nil {
-		return 0, err
-	}
-	return circulation.Raw, nil
-}
-
-func (m *testWeightOracle) Ping() error {
-	return nil
-}
-
-func (m *testWeightOracle) Identity() (ledgercore.DaemonIdentity, error) {
-	return ledgercore.DaemonIdentity{
-		GenesisHash:            m.ledger.GenesisHash(),
-		WeightAlgorithmVersion: "1.0",
-		WeightProtocolVersion:  "1.0",
-	}, nil
-}
-
-// Compile-time check that testWeightOracle implements WeightOracle
-var _ ledgercore.WeightOracle = (*testWeightOracle)(nil)
-
 func keypair() *crypto.SignatureSecrets {
 	var seed crypto.Seed
 	crypto.RandBytes(seed[:])
@@ -147,8 +108,7 @@ func mockLedger(t TestingT, initAccounts map[basics.Address]basics.AccountData,
 	require.NoError(t, err)
 
 	// Set up mock weight oracle for pool tests
-	mockOracle := &testWeightOracle{ledger: l}
-	l.SetWeightOracle(mockOracle)
+	l.SetWeightOracle(oraclemock.NewStakeOracle(l))
 
 	return l
 }
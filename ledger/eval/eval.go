@@ -1652,33 +1652,41 @@ func (eval *BlockEvaluator) generateKnockOfflineAccountsList(participating []bas
 		return
 	}
 
-	// Type-assert to ExternalWeighter to get weight-based absence data
-	ew, ok := eval.l.(ledgercore.ExternalWeighter)
-	if !ok {
-		logging.Base().Panicf("generateKnockOfflineAccountsList: ledger does not implement ExternalWeighter")
-	}
+	// Protocols without external weighting judge absence by online stake.
+	weighted := eval.proto.EnableExternalWeightOracle
+	var ew ledgercore.ExternalWeighter
+	var balanceRound basics.Round
+	var totalWeight uint64
+	if weighted {
+		// Type-assert to ExternalWeighter to get weight-based absence data
+		var ok bool
+		ew, ok = eval.l.(ledgercore.ExternalWeighter)
+		if !ok {
+			logging.Base().Panicf("generateKnockOfflineAccountsList: ledger does not implement ExternalWeighter")
+		}
 
-	balanceRound, err := eval.state.balanceRound()
-	if err != nil {
-		logging.Base().Errorf("unable to compute balance round, no knockoffs: %v", err)
-		return
-	}
+		balanceRound, err = eval.state.balanceRound()
+		if err != nil {
+			logging.Base().Errorf("unable to compute balance round, no knockoffs: %v", err)
+			return
+		}
 
-	totalWeight, err := ew.TotalExternalWeight(balanceRound, current)
-	if err != nil {
-		var de *ledgercore.DaemonError
-		if errors.As(err, &de) && de.Code != "internal" {
-			// Non-internal daemon errors are invariant violations
-			logging.Base().Panicf("generateKnockOfflineAccountsList: TotalExternalWeight returned non-internal daemon error: %v", err)
+		totalWeight, err = ew.TotalExternalWeight(balanceRound, current)
+		if err != nil {
+			var de *ledgercore.DaemonError
+			if errors.As(err, &de) && de.Code != "internal" {
+				// Non-internal daemon errors are invariant violations
+				logging.Base().Panicf("generateKnockOfflineAccountsList: TotalExternalWeight returned non-internal daemon error: %v", err)
+			}
+			// Internal daemon errors or network/timeout errors: log and return with no knockoffs
+			logging.Base().Errorf("unable to fetch total external weight, no knockoffs: %v", err)
+			return
 		}
-		// Internal daemon errors or network/timeout errors: log and return with no knockoffs
-		logging.Base().Errorf("unable to fetch total external weight, no knockoffs: %v", err)
-		return
-	}
 
-	// Cross-check: if we have online stake but no weight, something is wrong
-	if !onlineStake.IsZero() && totalWeight == 0 {
-		logging.Base().Panicf("generateKnockOfflineAccountsList: onlineStake non-zero (%v) but totalWeight is zero", onlineStake)
+		// Cross-check: if we have online stake but no weight, something is wrong
+		if !onlineStake.IsZero() && totalWeight == 0 {
+			logging.Base().Panicf("generateKnockOfflineAccountsList: onlineStake non-zero (%v) but totalWeight is zero", onlineStake)
+		}
 	}
 
 	// Make a set of candidate addresses to check for expired or absentee status.
@@ -1737,8 +1745,26 @@ func (eval *BlockEvaluator) generateKnockOfflineAccountsList(participating []bas
 		}
 	}
 
+	// Visit the candidates in a fixed order, so that the weights of those
+	// whose absence is judged below can be looked up in batches ahead of the
+	// loop, rather than asking the oracle about each in turn. A batch holds
+	// no more accounts than can still be suspended.
+	order := make([]basics.Address, 0, len(candidates))
+	for accountAddr := range candidates {
+		order = append(order, accountAddr)
+	}
+	weighable := func(accountAddr basics.Address) bool {
+		acctData := candidates[accountAddr]
+		expiring := !acctData.VoteID.IsEmpty() && acctData.VoteLastValid < current
+		return acctData.Status == basics.Online && acctData.IncentiveEligible && !expiring &&
+			!acctData.MicroAlgosWithRewards.IsZero() && !partAddrs.Contains(accountAddr)
+	}
+	var weights map[ledgercore.WeightQuery]ledgercore.WeightResult
+	batched := 0 // the candidates in order[:batched] were considered for a batch
+
 	// Now, check these candidate accounts to see if they are expired or absent.
-	for accountAddr, acctData := range candidates {
+	for i, accountAddr := range order {
+		acctData := candidates[accountAddr]
 		if acctData.MicroAlgosWithRewards.IsZero() {
 			continue // don't check accounts that are being closed
 		}
@@ -1777,26 +1803,41 @@ func (eval *BlockEvaluator) generateKnockOfflineAccountsList(participating []bas
 				continue
 			}
 
-			// Fetch the account's external weight for absence calculation
-			accountWeight, wErr := ew.ExternalWeight(balanceRound, accountAddr, oad.SelectionID)
-			if wErr != nil {
-				var de *ledgercore.DaemonError
-				if errors.As(wErr, &de) && de.Code != "internal" {
-					// Non-internal daemon errors are invariant violations
-					logging.Base().Panicf("generateKnockOfflineAccountsList: ExternalWeight returned non-internal daemon error for %v: %v", accountAddr, wErr)
+			var absent bool
+			if weighted {
+				if i >= batched {
+					room := maxSuspensions - len(updates.AbsentParticipationAccounts)
+					var batch []basics.Address
+					for batched = i; batched < len(order) && len(batch) < room; batched++ {
+						if weighable(order[batched]) {
+							batch = append(batch, order[batched])
+						}
+					}
+					weights = eval.externalWeights(balanceRound, batch)
+				}
+				// Fetch the account's external weight for absence calculation
+				accountWeight, wErr := eval.externalWeight(ew, weights, balanceRound, accountAddr, oad.SelectionID)
+				if wErr != nil {
+					var de *ledgercore.DaemonError
+					if errors.As(wErr, &de) && de.Code != "internal" {
+						// Non-internal daemon errors are invariant violations
+						logging.Base().Panicf("generateKnockOfflineAccountsList: ExternalWeight returned non-internal daemon error for %v: %v", accountAddr, wErr)
+					}
+					// Internal daemon errors or network/timeout errors: skip this account
+					logging.Base().Errorf("unable to fetch external weight for %v, skipping absenteeism check: %v", accountAddr, wErr)
+					continue
 				}
-				// Internal daemon errors or network/timeout errors: skip this account
-				logging.Base().Errorf("unable to fetch external weight for %v, skipping absenteeism check: %v", accountAddr, wErr)
-				continue
-			}
 
-			// Account weight must be positive for circulation-population participants
-			if accountWeight == 0 {
-				logging.Base().Panicf("generateKnockOfflineAccountsList: ExternalWeight returned zero for online account %v", accountAddr)
+				// Account weight must be positive for circulation-population participants
+				if accountWeight == 0 {
+					logging.Base().Panicf("generateKnockOfflineAccountsList: ExternalWeight returned zero for online account %v", accountAddr)
+				}
+				absent = isAbsentByWeight(totalWeight, accountWeight, lastSeen, current)
+			} else {
+				absent = isAbsent(onlineStake, oad.VotingStake(), lastSeen, current)
 			}
 
-			if isAbsentByWeight(totalWeight, accountWeight, lastSeen, current) ||
-				ch.Failed(accountAddr, lastSeen) {
+			if absent || ch.Failed(accountAddr, lastSeen) {
 				updates.AbsentParticipationAccounts = append(
 					updates.AbsentParticipationAccounts,
 					accountAddr,
@@ -1806,6 +1847,76 @@ func (eval *BlockEvaluator) generateKnockOfflineAccountsList(participating []bas
 	}
 }
 
+// externalWeights looks up the external weights of the given accounts at
+// balanceRound in one batch, so that judging the absence of many accounts, as
+// when validating blocks while catching up, does not ask the weight oracle
+// about each in turn. The weights are keyed by account and selection ID as of
+// the evaluator's state. It returns nil unless the ledger implements
+// ledgercore.DaemonExternalWeighter or ledgercore.ExternalWeightBatcher and
+// there are at least two accounts, or if the batch failed as a whole;
+// externalWeight then asks about each account.
+func (eval *BlockEvaluator) externalWeights(balanceRound basics.Round, addrs []basics.Address) map[ledgercore.WeightQuery]ledgercore.WeightResult {
+	lookup := eval.daemonExternalWeights()
+	if lookup == nil || len(addrs) < 2 {
+		return nil
+	}
+	queries := make([]ledgercore.WeightQuery, 0, len(addrs))
+	for _, addr := range addrs {
+		oad, err := eval.state.lookupAgreement(addr)
+		if err != nil {
+			// Reported when the account itself is checked.
+			continue
+		}
+		queries = append(queries, ledgercore.WeightQuery{Addr: addr, SelectionID: oad.SelectionID})
+	}
+	results, err := lookup(context.Background(), balanceRound, queries)
+	if err != nil || len(results) != len(queries) {
+		return nil
+	}
+	weights := make(map[ledgercore.WeightQuery]ledgercore.WeightResult, len(queries))
+	for i, q := range queries {
+		weights[q] = results[i]
+	}
+	return weights
+}
+
+// externalWeight returns the external weight of an account from weights, the
+// result of externalWeights, or asks the ledger for it if weights does not
+// have it: without the weight oracle's local overrides if the ledger is a
+// ledgercore.DaemonExternalWeighter, and from ew otherwise.
+func (eval *BlockEvaluator) externalWeight(ew ledgercore.ExternalWeighter, weights map[ledgercore.WeightQuery]ledgercore.WeightResult, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	q := ledgercore.WeightQuery{Addr: addr, SelectionID: selectionID}
+	if res, ok := weights[q]; ok {
+		return res.Weight, res.Err
+	}
+	dw, ok := eval.l.(ledgercore.DaemonExternalWeighter)
+	if !ok {
+		return ew.ExternalWeight(balanceRound, addr, selectionID)
+	}
+	results, err := dw.DaemonExternalWeights(context.Background(), balanceRound, []ledgercore.WeightQuery{q})
+	if err != nil {
+		return 0, err
+	}
+	if len(results) != 1 {
+		return 0, fmt.Errorf("weight lookup for %v returned %d answers", addr, len(results))
+	}
+	return results[0].Weight, results[0].Err
+}
+
+// daemonExternalWeights returns the ledger's batched weight lookup, which
+// answers without the weight oracle's local overrides if the ledger is a
+// ledgercore.DaemonExternalWeighter, or nil if the ledger cannot batch.
+// Whether a block is valid must not depend on one node's overrides.
+func (eval *BlockEvaluator) daemonExternalWeights() func(context.Context, basics.Round, []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	if dw, ok := eval.l.(ledgercore.DaemonExternalWeighter); ok {
+		return dw.DaemonExternalWeights
+	}
+	if batcher, ok := eval.l.(ledgercore.ExternalWeightBatcher); ok {
+		return batcher.ExternalWeights
+	}
+	return nil
+}
+
 const absentFactor = 20
 
 // Compile-time check that absentFactor matches ledgercore.AbsenteeismMultiplier
@@ -1933,25 +2044,37 @@ func (eval *BlockEvaluator) validateAbsentOnlineAccounts() error {
 		}
 	}
 
-	// Type-assert to ExternalWeighter to get weight-based absence data
-	ew, ok := eval.l.(ledgercore.ExternalWeighter)
-	if !ok {
-		return fmt.Errorf("validateAbsentOnlineAccounts: ledger does not implement ExternalWeighter")
-	}
+	weighted := eval.proto.EnableExternalWeightOracle
+	var ew ledgercore.ExternalWeighter
+	var balanceRound basics.Round
+	var totalWeight uint64
+	if weighted {
+		// Type-assert to ExternalWeighter to get weight-based absence data
+		var ok bool
+		ew, ok = eval.l.(ledgercore.ExternalWeighter)
+		if !ok {
+			return fmt.Errorf("validateAbsentOnlineAccounts: ledger does not implement ExternalWeighter")
+		}
 
-	balanceRound, err := eval.state.balanceRound()
-	if err != nil {
-		return fmt.Errorf("validateAbsentOnlineAccounts: unable to compute balance round: %w", err)
-	}
+		balanceRound, err = eval.state.balanceRound()
+		if err != nil {
+			return fmt.Errorf("validateAbsentOnlineAccounts: unable to compute balance round: %w", err)
+		}
 
-	totalWeight, err := ew.TotalExternalWeight(balanceRound, eval.Round())
-	if err != nil {
-		return fmt.Errorf("validateAbsentOnlineAccounts: unable to fetch total external weight: %w", err)
+		totalWeight, err = ew.TotalExternalWeight(balanceRound, eval.Round())
+		if err != nil {
+			return fmt.Errorf("validateAbsentOnlineAccounts: unable to fetch total external weight: %w", err)
+		}
+
+		// Cross-check: if we have online stake but no weight, something is wrong
+		if !totalOnlineStake.IsZero() && totalWeight == 0 {
+			return fmt.Errorf("validateAbsentOnlineAccounts: totalOnlineStake non-zero (%v) but totalWeight is zero", totalOnlineStake)
+		}
 	}
 
-	// Cross-check: if we have online stake but no weight, something is wrong
-	if !totalOnlineStake.IsZero() && totalWeight == 0 {
-		return fmt.Errorf("validateAbsentOnlineAccounts: totalOnlineStake non-zero (%v) but totalWeight is zero", totalOnlineStake)
+	var weights map[ledgercore.WeightQuery]ledgercore.WeightResult
+	if weighted {
+		weights = eval.externalWeights(balanceRound, eval.block.ParticipationUpdates.AbsentParticipationAccounts)
 	}
 
 	for _, accountAddr := range eval.block.ParticipationUpdates.AbsentParticipationAccounts {
@@ -1980,18 +2103,24 @@ func (eval *BlockEvaluator) validateAbsentOnlineAccounts() error {
 			return fmt.Errorf("unable to check absent account: %v", accountAddr)
 		}
 
-		// Fetch the account's external weight for absence calculation
-		accountWeight, wErr := ew.ExternalWeight(balanceRound, accountAddr, oad.SelectionID)
-		if wErr != nil {
-			return fmt.Errorf("validateAbsentOnlineAccounts: unable to fetch external weight for %v: %w", accountAddr, wErr)
-		}
+		var absent bool
+		if weighted {
+			// Fetch the account's external weight for absence calculation
+			accountWeight, wErr := eval.externalWeight(ew, weights, balanceRound, accountAddr, oad.SelectionID)
+			if wErr != nil {
+				return fmt.Errorf("validateAbsentOnlineAccounts: unable to fetch external weight for %v: %w", accountAddr, wErr)
+			}
 
-		// Account weight must be positive for circulation-population participants
-		if accountWeight == 0 {
-			return fmt.Errorf("validateAbsentOnlineAccounts: ExternalWeight returned zero for online account %v", accountAddr)
+			// Account weight must be positive for circulation-population participants
+			if accountWeight == 0 {
+				return fmt.Errorf("validateAbsentOnlineAccounts: ExternalWeight returned zero for online account %v", accountAddr)
+			}
+			absent = isAbsentByWeight(totalWeight, accountWeight, acctData.LastSeen(), eval.Round())
+		} else {
+			absent = isAbsent(totalOnlineStake, oad.VotingStake(), acctData.LastSeen(), eval.Round())
 		}
 
-		if isAbsentByWeight(totalWeight, accountWeight, acctData.LastSeen(), eval.Round()) {
+		if absent {
 			continue // ok. it's "normal absent"
 		}
 		if ch.Failed(accountAddr, acctData.LastSeen()) {
@@ -2234,3 +2234,203 @@ func TestWeightAbsenteeismGenerationValidationConsistency(t *testing.T) {
 	require.Error(t, err, "validation should reject adding a non-absent account")
 	require.ErrorContains(t, err, "not absent")
 }
+
+// batchingTestLedger adds ledgercore.ExternalWeightBatcher to an
+// evalTestLedger, counting the batches and single weight lookups made.
+type batchingTestLedger struct {
+	*evalTestLedger
+	batches [][]ledgercore.WeightQuery
+	singles int
+	// If set, ExternalWeights fails as a whole with this error
+	batchError error
+}
+
+func (l *batchingTestLedger) ExternalWeights(_ context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	l.batches = append(l.batches, queries)
+	if l.batchError != nil {
+		return nil, l.batchError
+	}
+	results := make([]ledgercore.WeightResult, len(queries))
+	for i, q := range queries {
+		results[i].Weight, results[i].Err = l.evalTestLedger.ExternalWeight(balanceRound, q.Addr, q.SelectionID)
+	}
+	return results, nil
+}
+
+func (l *batchingTestLedger) ExternalWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	l.singles++
+	return l.evalTestLedger.ExternalWeight(balanceRound, addr, selectionID)
+}
+
+// TestWeightAbsenteeismBatchedWeights checks that generating and validating
+// a block look up the weights of the accounts judged for absence in one batch
+// when the ledger supports batches, and one at a time if the batch fails.
+func TestWeightAbsenteeismBatchedWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.GenesisWithProto(10, protocol.ConsensusFuture)
+	for i, addr := range addrs {
+		tmp := genesisInitState.Accounts[addr]
+		tmp.Status = basics.Online
+		tmp.MicroAlgos = basics.MicroAlgos{Raw: 1_000_000_000}
+		crypto.RandBytes(tmp.SelectionID[:])
+		crypto.RandBytes(tmp.VoteID[:])
+		tmp.IncentiveEligible = true
+		tmp.VoteFirstValid = 1
+		tmp.VoteLastValid = 1500
+		tmp.LastHeartbeat = 1200
+		if i < 3 {
+			tmp.LastHeartbeat = 1
+		}
+		genesisInitState.Accounts[addr] = tmp
+	}
+	l := newTestLedger(t, bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+	})
+	// See TestWeightAbsenteeismGenerationValidationConsistency for why the
+	// first three accounts are absent from round 202.
+	for i := 0; i < 201; i++ {
+		l.endBlock(t, l.nextBlock(t))
+	}
+
+	bl := &batchingTestLedger{evalTestLedger: l}
+	hdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	blkEval, err := StartEvaluator(bl, bookkeeping.MakeBlock(hdr).BlockHeader, EvaluatorOptions{Generate: true})
+	require.NoError(t, err)
+	unfinishedBlock, err := blkEval.GenerateBlock(nil)
+	require.NoError(t, err)
+	absent := unfinishedBlock.UnfinishedBlock().AbsentParticipationAccounts
+	require.Len(t, absent, 3)
+	require.Len(t, bl.batches, 1)
+	require.GreaterOrEqual(t, len(bl.batches[0]), len(absent))
+	require.Zero(t, bl.singles)
+
+	// No more accounts are batched than can still be suspended.
+	bl.batches = nil
+	blkEval, err = StartEvaluator(bl, bookkeeping.MakeBlock(hdr).BlockHeader, EvaluatorOptions{Generate: true})
+	require.NoError(t, err)
+	blkEval.proto.Payouts.MaxMarkAbsent = 2
+	limited, err := blkEval.GenerateBlock(nil)
+	require.NoError(t, err)
+	require.Len(t, limited.UnfinishedBlock().AbsentParticipationAccounts, 2)
+	require.NotEmpty(t, bl.batches)
+	for _, batch := range bl.batches {
+		require.LessOrEqual(t, len(batch), 2)
+	}
+
+	seed := committee.Seed{}
+	crypto.RandBytes(seed[:])
+	block := unfinishedBlock.UnfinishedBlock().WithProposer(seed, testPoolAddr, true)
+	bl.batches, bl.singles = nil, 0
+	_, err = Eval(context.Background(), bl, block, true, verify.GetMockedCache(true), nil, l.tracer)
+	require.NoError(t, err)
+	require.Len(t, bl.batches, 1)
+	require.Len(t, bl.batches[0], len(absent))
+	require.Zero(t, bl.singles)
+
+	// A failed batch falls back to looking each weight up on its own.
+	bl.batches = nil
+	bl.batchError = errors.New("daemon unreachable")
+	_, err = Eval(context.Background(), bl, block, true, verify.GetMockedCache(true), nil, l.tracer)
+	require.NoError(t, err)
+	require.Len(t, bl.batches, 1)
+	require.Equal(t, len(absent), bl.singles)
+}
+
+// excludingTestLedger is an evalTestLedger whose weight oracle excludes some
+// accounts by a local override.
+type excludingTestLedger struct {
+	*evalTestLedger
+	excluded map[basics.Address]bool
+}
+
+func (l *excludingTestLedger) ExternalWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	if l.excluded[addr] {
+		return 0, ledgercore.ErrWeightExcluded
+	}
+	return l.evalTestLedger.ExternalWeight(balanceRound, addr, selectionID)
+}
+
+// daemonTestLedger adds ledgercore.DaemonExternalWeighter to an
+// excludingTestLedger, answering without its overrides.
+type daemonTestLedger struct {
+	*excludingTestLedger
+}
+
+func (l daemonTestLedger) DaemonExternalWeights(_ context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	results := make([]ledgercore.WeightResult, len(queries))
+	for i, q := range queries {
+		results[i].Weight, results[i].Err = l.evalTestLedger.ExternalWeight(balanceRound, q.Addr, q.SelectionID)
+	}
+	return results, nil
+}
+
+// TestWeightAbsenteeismIgnoresOverrides checks that judging absent accounts
+// does not depend on the node's local weight overrides: the daemon's answers
+// are used when the ledger can give them, and a block is rejected rather
+// than accepted unchecked when it cannot.
+func TestWeightAbsenteeismIgnoresOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.GenesisWithProto(10, protocol.ConsensusFuture)
+	for i, addr := range addrs {
+		tmp := genesisInitState.Accounts[addr]
+		tmp.Status = basics.Online
+		tmp.MicroAlgos = basics.MicroAlgos{Raw: 1_000_000_000}
+		crypto.RandBytes(tmp.SelectionID[:])
+		crypto.RandBytes(tmp.VoteID[:])
+		tmp.IncentiveEligible = true
+		tmp.VoteFirstValid = 1
+		tmp.VoteLastValid = 1500
+		tmp.LastHeartbeat = 1200
+		if i < 3 {
+			tmp.LastHeartbeat = 1
+		}
+		genesisInitState.Accounts[addr] = tmp
+	}
+	l := newTestLedger(t, bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+	})
+	// See TestWeightAbsenteeismGenerationValidationConsistency for why the
+	// first three accounts are absent from round 202.
+	for i := 0; i < 201; i++ {
+		l.endBlock(t, l.nextBlock(t))
+	}
+
+	// The proposer has no overrides; the first absent account and one that
+	// is not absent are excluded by the validator's.
+	hdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	blkEval, err := StartEvaluator(l, bookkeeping.MakeBlock(hdr).BlockHeader, EvaluatorOptions{Generate: true})
+	require.NoError(t, err)
+	unfinishedBlock, err := blkEval.GenerateBlock(nil)
+	require.NoError(t, err)
+	seed := committee.Seed{}
+	crypto.RandBytes(seed[:])
+	block := unfinishedBlock.UnfinishedBlock().WithProposer(seed, testPoolAddr, true)
+	absent := block.AbsentParticipationAccounts
+	require.Len(t, absent, 3)
+	excluded := &excludingTestLedger{evalTestLedger: l, excluded: map[basics.Address]bool{absent[0]: true, addrs[3]: true}}
+	validator := daemonTestLedger{excluded}
+
+	_, err = Eval(context.Background(), validator, block, true, verify.GetMockedCache(true), nil, l.tracer)
+	require.NoError(t, err)
+
+	// Suspending the excluded account that is not absent is still refused.
+	bad := block
+	bad.AbsentParticipationAccounts = append(append([]basics.Address(nil), absent...), addrs[3])
+	_, err = Eval(context.Background(), validator, bad, true, verify.GetMockedCache(true), nil, l.tracer)
+	require.ErrorContains(t, err, "not absent")
+
+	// A ledger that can only answer with the overrides rejects the block
+	// instead of taking the suspension on trust.
+	_, err = Eval(context.Background(), excluded, bad, true, verify.GetMockedCache(true), nil, l.tracer)
+	require.ErrorIs(t, err, ledgercore.ErrWeightExcluded)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/verify"
@@ -49,6 +50,9 @@ import (
 
 // Compile-time interface check: Ledger must implement ExternalWeighter
 var _ ledgercore.ExternalWeighter = (*Ledger)(nil)
+var _ ledgercore.ContextExternalWeighter = (*Ledger)(nil)
+var _ ledgercore.WeightedAgreementLookup = (*Ledger)(nil)
+var _ ledgercore.ContextWeightedAgreementLookup = (*Ledger)(nil)
 
 // Ledger is a database storing the contents of the ledger.
 type Ledger struct {
@@ -651,6 +655,24 @@ func (l *Ledger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.
 	return data, err
 }
 
+// LookupAgreementWeighted returns the account data used by agreement together
+// with the account's external weights, so that agreement needs a single call
+// into the ledger per membership check. The weights normally come from the
+// oracle client's cache.
+func (l *Ledger) LookupAgreementWeighted(balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+	return l.LookupAgreementWeightedContext(context.Background(), balanceRound, voteRound, addr)
+}
+
+// LookupAgreementWeightedContext is LookupAgreementWeighted, with the weight
+// lookups abandoned once ctx is done.
+func (l *Ledger) LookupAgreementWeightedContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+	data, err := l.LookupAgreement(balanceRound, addr)
+	if err != nil {
+		return ledgercore.WeightedAgreementData{}, err
+	}
+	return ledgercore.AttachExternalWeightsContext(ctx, data, l, balanceRound, voteRound, addr)
+}
+
 // GetKnockOfflineCandidates retrieves a list of online accounts who will be
 // checked to a recent proposal or heartbeat. Large accounts are the ones worth checking.
 func (l *Ledger) GetKnockOfflineCandidates(rnd basics.Round, proto config.ConsensusParams) (map[basics.Address]basics.OnlineAccountData, error) {
@@ -772,6 +794,77 @@ func (l *Ledger) TotalExternalWeight(balanceRound basics.Round, voteRound basics
 	return l.weightOracle.TotalWeight(balanceRound, voteRound)
 }
 
+// ExternalWeightContext is ExternalWeight, with the oracle query abandoned
+// once ctx is done if the oracle is a ledgercore.ContextWeightOracle.
+func (l *Ledger) ExternalWeightContext(ctx context.Context, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	if co, ok := l.weightOracle.(ledgercore.ContextWeightOracle); ok {
+		return co.WeightContext(ctx, balanceRound, addr, selectionID)
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return l.ExternalWeight(balanceRound, addr, selectionID)
+}
+
+// TotalExternalWeightContext is TotalExternalWeight, with the oracle query
+// abandoned once ctx is done if the oracle is a ledgercore.ContextWeightOracle.
+func (l *Ledger) TotalExternalWeightContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	if co, ok := l.weightOracle.(ledgercore.ContextWeightOracle); ok {
+		return co.TotalWeightContext(ctx, balanceRound, voteRound)
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return l.TotalExternalWeight(balanceRound, voteRound)
+}
+
+// ExternalWeights returns the external consensus weights for a batch of
+// accounts, in one interaction with the oracle if it supports batches and
+// one query per account otherwise.
+//
+// The lookups are abandoned once ctx is done, as by ExternalWeightContext.
+// Like ExternalWeight, it panics if no oracle is configured.
+func (l *Ledger) ExternalWeights(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	if l.weightOracle == nil {
+		logging.Base().Panicf("ExternalWeights called but no oracle configured")
+	}
+	if bo, ok := l.weightOracle.(ledgercore.BatchWeightOracle); ok {
+		return bo.Weights(ctx, balanceRound, queries)
+	}
+	results := make([]ledgercore.WeightResult, len(queries))
+	for i, q := range queries {
+		results[i].Weight, results[i].Err = l.ExternalWeightContext(ctx, balanceRound, q.Addr, q.SelectionID)
+	}
+	return results, nil
+}
+
+// DaemonExternalWeights is ExternalWeights, answered without the weight
+// oracle's local overrides if it is a ledgercore.OverridableWeightOracle.
+func (l *Ledger) DaemonExternalWeights(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	if oo, ok := l.weightOracle.(ledgercore.OverridableWeightOracle); ok {
+		return oo.DaemonWeights(ctx, balanceRound, queries)
+	}
+	return l.ExternalWeights(ctx, balanceRound, queries)
+}
+
+// ExternalWeightAttestation returns the weight daemon's attestation of the
+// weight ExternalWeight returns for the same arguments. It fails if the
+// configured weight oracle is not a ledgercore.AttestingWeightOracle.
+func (l *Ledger) ExternalWeightAttestation(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (committee.WeightAttestation, error) {
+	ao, ok := l.weightOracle.(ledgercore.AttestingWeightOracle)
+	if !ok {
+		return committee.WeightAttestation{}, fmt.Errorf("weight oracle %T cannot attest weights", l.weightOracle)
+	}
+	return ao.WeightAttestation(balanceRound, addr, selectionID)
+}
+
+// ExternalWeightSaturated reports whether the configured weight oracle is not
+// keeping up with weight lookups. It is false if the oracle cannot tell.
+func (l *Ledger) ExternalWeightSaturated() bool {
+	bp, ok := l.weightOracle.(ledgercore.ExternalWeightBackpressure)
+	return ok && bp.ExternalWeightSaturated()
+}
+
 // CheckDup return whether a transaction is a duplicate one.
 func (l *Ledger) CheckDup(currentProto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
 	return l.txTail.checkDup(currentProto, current, firstValid, lastValid, txid, txl)
@@ -49,6 +49,7 @@ import (
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
+	oraclemock "github.com/algorand/go-algorand/node/weightoracle/mock"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/db"
@@ -3648,33 +3649,6 @@ func TestTotalExternalWeightPanicsWithoutOracle(t *testing.T) {
 	}, "TotalExternalWeight should panic when no oracle is configured")
 }
 
-// mockTestWeightOracle is a simple mock implementation of WeightOracle for testing.
-type mockTestWeightOracle struct {
-	weight      uint64
-	totalWeight uint64
-	weightErr   error
-	totalErr    error
-}
-
-func (m *mockTestWeightOracle) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
-	return m.weight, m.weightErr
-}
-
-func (m *mockTestWeightOracle) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
-	return m.totalWeight, m.totalErr
-}
-
-func (m *mockTestWeightOracle) Ping() error {
-	return nil
-}
-
-func (m *mockTestWeightOracle) Identity() (ledgercore.DaemonIdentity, error) {
-	return ledgercore.DaemonIdentity{}, nil
-}
-
-// Compile-time check that mockTestWeightOracle implements WeightOracle
-var _ ledgercore.WeightOracle = (*mockTestWeightOracle)(nil)
-
 // TestExternalWeightWithOracle verifies that ExternalWeight correctly forwards
 // calls to the configured weight oracle.
 func TestExternalWeightWithOracle(t *testing.T) {
@@ -3689,7 +3663,8 @@ func TestExternalWeightWithOracle(t *testing.T) {
 	defer l.Close()
 
 	// Set up a custom mock oracle for this test
-	mockOracle := &mockTestWeightOracle{weight: 12345}
+	mockOracle := oraclemock.New()
+	mockOracle.SetDefaultWeight(12345)
 	l.SetWeightOracle(mockOracle)
 	require.NotNil(t, l.WeightOracle())
 
@@ -3699,7 +3674,7 @@ func TestExternalWeightWithOracle(t *testing.T) {
 	require.Equal(t, uint64(12345), weight)
 
 	// Test error propagation
-	mockOracle.weightErr = &ledgercore.DaemonError{Code: "not_found", Msg: "test error"}
+	mockOracle.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "test error"})
 	_, err = l.ExternalWeight(basics.Round(100), basics.Address{1, 2, 3}, crypto.VRFVerifier{})
 	require.Error(t, err)
 	var daemonErr *ledgercore.DaemonError
@@ -3707,6 +3682,86 @@ func TestExternalWeightWithOracle(t *testing.T) {
 	require.Equal(t, "not_found", daemonErr.Code)
 }
 
+// saturatedOracle is a weight oracle that reports itself saturated.
+type saturatedOracle struct {
+	ledgercore.WeightOracle
+}
+
+func (saturatedOracle) ExternalWeightSaturated() bool { return true }
+
+// TestExternalWeightSaturated verifies that ExternalWeightSaturated asks the
+// configured weight oracle, if it can tell.
+func TestExternalWeightSaturated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, _, _ := ledgertesting.NewTestGenesis()
+	var genHash crypto.Digest
+	crypto.RandBytes(genHash[:])
+	cfg := config.GetDefaultLocal()
+	l := newSimpleLedgerFull(t, genBalances, protocol.ConsensusCurrentVersion, genHash, cfg)
+	defer l.Close()
+
+	require.False(t, l.ExternalWeightSaturated())
+	mockOracle := oraclemock.New()
+	l.SetWeightOracle(mockOracle)
+	require.False(t, l.ExternalWeightSaturated())
+	l.SetWeightOracle(saturatedOracle{mockOracle})
+	require.True(t, l.ExternalWeightSaturated())
+}
+
+// batchOracle is a weight oracle that answers batches itself, counting them.
+type batchOracle struct {
+	ledgercore.WeightOracle
+	batches int
+}
+
+func (o *batchOracle) Weights(_ context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	o.batches++
+	results := make([]ledgercore.WeightResult, len(queries))
+	for i := range queries {
+		results[i].Weight = uint64(i + 1)
+	}
+	return results, nil
+}
+
+// TestExternalWeights verifies that ExternalWeights hands batches to oracles
+// that support them and looks weights up one at a time otherwise.
+func TestExternalWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, _, _ := ledgertesting.NewTestGenesis()
+	var genHash crypto.Digest
+	crypto.RandBytes(genHash[:])
+	cfg := config.GetDefaultLocal()
+	l := newSimpleLedgerFull(t, genBalances, protocol.ConsensusCurrentVersion, genHash, cfg)
+	defer l.Close()
+
+	queries := []ledgercore.WeightQuery{{Addr: basics.Address{1}}, {Addr: basics.Address{2}}}
+
+	mockOracle := oraclemock.New()
+	mockOracle.SetDefaultWeight(12345)
+	l.SetWeightOracle(mockOracle)
+	results, err := l.ExternalWeights(context.Background(), basics.Round(100), queries)
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Weight: 12345}, {Weight: 12345}}, results)
+
+	mockOracle.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "test error"})
+	results, err = l.ExternalWeights(context.Background(), basics.Round(100), queries)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Error(t, results[0].Err)
+	require.Error(t, results[1].Err)
+
+	bo := &batchOracle{WeightOracle: mockOracle}
+	l.SetWeightOracle(bo)
+	results, err = l.ExternalWeights(context.Background(), basics.Round(100), queries)
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Weight: 1}, {Weight: 2}}, results)
+	require.Equal(t, 1, bo.batches)
+}
+
 // TestTotalExternalWeightWithOracle verifies that TotalExternalWeight correctly
 // forwards calls to the configured weight oracle.
 func TestTotalExternalWeightWithOracle(t *testing.T) {
@@ -3721,7 +3776,8 @@ func TestTotalExternalWeightWithOracle(t *testing.T) {
 	defer l.Close()
 
 	// Set up a custom mock oracle for this test
-	mockOracle := &mockTestWeightOracle{totalWeight: 999999}
+	mockOracle := oraclemock.New()
+	mockOracle.SetTotalWeight(999999)
 	l.SetWeightOracle(mockOracle)
 	require.NotNil(t, l.WeightOracle())
 
@@ -3731,7 +3787,7 @@ func TestTotalExternalWeightWithOracle(t *testing.T) {
 	require.Equal(t, uint64(999999), totalWeight)
 
 	// Test error propagation
-	mockOracle.totalErr = &ledgercore.DaemonError{Code: "internal", Msg: "test error"}
+	mockOracle.SetTotalWeightError(&ledgercore.DaemonError{Code: "internal", Msg: "test error"})
 	_, err = l.TotalExternalWeight(basics.Round(100), basics.Round(110))
 	require.Error(t, err)
 	var daemonErr *ledgercore.DaemonError
@@ -17,8 +17,12 @@
 package ledgercore
 
 import (
+	"context"
+	"errors"
+
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
 )
 
 // ExternalWeighter defines the interface that the Ledger implements to provide
@@ -35,3 +39,191 @@ type ExternalWeighter interface {
 	// in the given vote round.
 	TotalExternalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error)
 }
+
+// ContextExternalWeighter is optionally implemented by ledgers whose external
+// weight lookups can be abandoned once the caller no longer needs them (see
+// ContextWeightOracle). AttachExternalWeightsContext uses it (via type
+// assertion) in preference to the ExternalWeighter methods.
+type ContextExternalWeighter interface {
+	// ExternalWeightContext is ExternalWeight, abandoned once ctx is done.
+	ExternalWeightContext(ctx context.Context, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error)
+
+	// TotalExternalWeightContext is TotalExternalWeight, abandoned once ctx
+	// is done.
+	TotalExternalWeightContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round) (uint64, error)
+}
+
+// ExternalWeightAttester is optionally implemented by ledgers whose weight oracle
+// signs the weights it returns. Agreement uses it (via type assertion) to attach
+// attestations to committee memberships on networks that enable
+// ExternalWeightAttestation.
+type ExternalWeightAttester interface {
+	// ExternalWeightAttestation returns the daemon's signature over the weight
+	// reported by ExternalWeight for the same arguments, on the network
+	// GenesisHash names. It fails if the weight oracle cannot attest weights.
+	ExternalWeightAttestation(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (committee.WeightAttestation, error)
+
+	// GenesisHash returns the genesis hash of the ledger's network.
+	GenesisHash() crypto.Digest
+}
+
+// ExternalWeightBackpressure is optionally implemented by ledgers, and by the
+// weight oracles behind them, that can tell when weight lookups are backing
+// up. Agreement uses it (via type assertion) to shed low-value vote
+// verification while the oracle is saturated.
+type ExternalWeightBackpressure interface {
+	// ExternalWeightSaturated reports whether the oracle has not been
+	// keeping up with the lookups asked of it.
+	ExternalWeightSaturated() bool
+}
+
+// ExternalWeightBatcher is optionally implemented by ledgers that can resolve
+// many external weights at once. Agreement uses it (via type assertion) to
+// resolve the weights a batch of votes needs before verifying any of them.
+type ExternalWeightBatcher interface {
+	// ExternalWeights returns the consensus weights for a batch of accounts
+	// at the specified balance round, in the same order as queries. A non-nil
+	// error means the batch as a whole failed; per-entry failures are
+	// reported in the corresponding WeightResult. Lookups are abandoned once
+	// ctx is done, as far as the ledger's oracle allows.
+	ExternalWeights(ctx context.Context, balanceRound basics.Round, queries []WeightQuery) ([]WeightResult, error)
+}
+
+// DaemonExternalWeighter is optionally implemented by ledgers whose weight
+// oracle may answer with the node operator's local overrides. Block
+// evaluation uses it in preference to ExternalWeighter and
+// ExternalWeightBatcher, so that nodes with different overrides agree on
+// which blocks are valid.
+type DaemonExternalWeighter interface {
+	// DaemonExternalWeights is ExternalWeightBatcher.ExternalWeights,
+	// answered as the weight daemon answers, without the overrides.
+	DaemonExternalWeights(ctx context.Context, balanceRound basics.Round, queries []WeightQuery) ([]WeightResult, error)
+}
+
+// ErrWeightExcluded is returned by weight oracles, in place of a zero weight,
+// for accounts the node's operator has excluded from committees, such as an
+// account whose keys are compromised. The daemon never reports a zero weight
+// for an eligible account, so a zero is an invariant violation; an exclusion
+// instead makes the account not eligible (see AttachExternalWeightsContext).
+// Overrides are local, so block evaluation does not see them (see
+// DaemonExternalWeighter).
+var ErrWeightExcluded = errors.New("account is excluded from committees by a weight override")
+
+// VoteKeyEligible reports whether an account's vote key is valid for voting in
+// voteRound. External weights are only looked up for eligible accounts; the
+// weight daemon is not required to answer for anyone else.
+func VoteKeyEligible(data basics.OnlineAccountData, voteRound basics.Round) bool {
+	return voteRound >= data.VoteFirstValid && (data.VoteLastValid == 0 || voteRound <= data.VoteLastValid)
+}
+
+// WeightedAgreementData is an account's agreement data together with the
+// external weights needed to verify its credentials.
+type WeightedAgreementData struct {
+	basics.OnlineAccountData
+
+	// KeyEligible is VoteKeyEligible for the vote round of the lookup, and
+	// false for accounts the weight oracle excludes (see ErrWeightExcluded).
+	// If it is false, ExternalWeight and TotalExternalWeight are zero.
+	KeyEligible bool
+
+	// ExternalWeight is the account's consensus weight at the balance round.
+	ExternalWeight uint64
+
+	// TotalExternalWeight is the total consensus weight at the balance round
+	// for voting in the vote round.
+	TotalExternalWeight uint64
+}
+
+// Verify ExternalWeightError implements the error interface.
+var _ error = (*ExternalWeightError)(nil)
+
+// ExternalWeightError wraps a failure to obtain an external weight, so that
+// callers of a combined lookup can tell it apart from a failure to look up the
+// account's agreement data.
+type ExternalWeightError struct {
+	// Total is true if the total weight lookup failed, false if the
+	// account's own weight lookup failed.
+	Total bool
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface for ExternalWeightError.
+func (e *ExternalWeightError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ExternalWeightError) Unwrap() error {
+	return e.Err
+}
+
+// AttachExternalWeights looks up the external weights for an account whose
+// agreement data has already been obtained. Weights are only queried if the
+// account's vote key is eligible at voteRound, and an account the oracle
+// excludes is returned as not eligible. Weight failures are returned as
+// *ExternalWeightError.
+func AttachExternalWeights(data basics.OnlineAccountData, ew ExternalWeighter, balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (WeightedAgreementData, error) {
+	return AttachExternalWeightsContext(context.Background(), data, ew, balanceRound, voteRound, addr)
+}
+
+// AttachExternalWeightsContext is AttachExternalWeights, with lookups that are
+// abandoned once ctx is done if ew is a ContextExternalWeighter. Otherwise ctx
+// is only checked before each lookup.
+func AttachExternalWeightsContext(ctx context.Context, data basics.OnlineAccountData, ew ExternalWeighter, balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (WeightedAgreementData, error) {
+	wd := WeightedAgreementData{
+		OnlineAccountData: data,
+		KeyEligible:       VoteKeyEligible(data, voteRound),
+	}
+	if !wd.KeyEligible {
+		return wd, nil
+	}
+
+	cw, abandonable := ew.(ContextExternalWeighter)
+	var err error
+	if abandonable {
+		wd.ExternalWeight, err = cw.ExternalWeightContext(ctx, balanceRound, addr, data.SelectionID)
+	} else if err = ctx.Err(); err == nil {
+		wd.ExternalWeight, err = ew.ExternalWeight(balanceRound, addr, data.SelectionID)
+	}
+	if errors.Is(err, ErrWeightExcluded) {
+		wd.KeyEligible, wd.ExternalWeight = false, 0
+		return wd, nil
+	}
+	if err != nil {
+		return wd, &ExternalWeightError{Err: err}
+	}
+	if abandonable {
+		wd.TotalExternalWeight, err = cw.TotalExternalWeightContext(ctx, balanceRound, voteRound)
+	} else if err = ctx.Err(); err == nil {
+		wd.TotalExternalWeight, err = ew.TotalExternalWeight(balanceRound, voteRound)
+	}
+	if err != nil {
+		return wd, &ExternalWeightError{Total: true, Err: err}
+	}
+	return wd, nil
+}
+
+// WeightedAgreementLookup is optionally implemented by ledgers that can return
+// an account's agreement data and its external weights in a single call.
+// Agreement uses it (via type assertion) in preference to separate
+// LookupAgreement, ExternalWeight and TotalExternalWeight calls.
+type WeightedAgreementLookup interface {
+	// LookupAgreementWeighted returns the account data used by agreement at
+	// balanceRound together with the account's external weights for voting in
+	// voteRound. Failures to obtain weights are returned as *ExternalWeightError.
+	LookupAgreementWeighted(balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (WeightedAgreementData, error)
+}
+
+// ContextWeightedAgreementLookup is optionally implemented by ledgers whose
+// combined lookups can be abandoned. Agreement uses it (via type assertion) in
+// preference to WeightedAgreementLookup, with a context that is done once the
+// round and period the lookup serves are no longer of use, so that a slow
+// weight daemon does not hold up verification with answers that would be
+// thrown away.
+type ContextWeightedAgreementLookup interface {
+	// LookupAgreementWeightedContext is LookupAgreementWeighted, with weight
+	// lookups abandoned once ctx is done.
+	LookupAgreementWeightedContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (WeightedAgreementData, error)
+}
@@ -17,6 +17,7 @@
 package ledgercore
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -58,3 +59,119 @@ func TestExternalWeighterInterface(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), totalWeight)
 }
+
+// fixedWeighter returns fixed weights or errors and counts calls.
+type fixedWeighter struct {
+	weight, total       uint64
+	weightErr, totalErr error
+	calls               int
+}
+
+func (w *fixedWeighter) ExternalWeight(basics.Round, basics.Address, crypto.VRFVerifier) (uint64, error) {
+	w.calls++
+	return w.weight, w.weightErr
+}
+
+func (w *fixedWeighter) TotalExternalWeight(basics.Round, basics.Round) (uint64, error) {
+	w.calls++
+	return w.total, w.totalErr
+}
+
+func TestAttachExternalWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	data := basics.OnlineAccountData{
+		VotingData: basics.VotingData{VoteFirstValid: 10, VoteLastValid: 20},
+	}
+
+	w := &fixedWeighter{weight: 5, total: 100}
+	wd, err := AttachExternalWeights(data, w, 1, 15, basics.Address{})
+	require.NoError(t, err)
+	require.True(t, wd.KeyEligible)
+	require.Equal(t, uint64(5), wd.ExternalWeight)
+	require.Equal(t, uint64(100), wd.TotalExternalWeight)
+	require.Equal(t, data, wd.OnlineAccountData)
+
+	// Ineligible keys are not queried.
+	w = &fixedWeighter{weight: 5, total: 100}
+	for _, voteRound := range []basics.Round{9, 21} {
+		wd, err = AttachExternalWeights(data, w, 1, voteRound, basics.Address{})
+		require.NoError(t, err)
+		require.False(t, wd.KeyEligible)
+		require.Zero(t, wd.ExternalWeight)
+	}
+	require.Zero(t, w.calls)
+
+	// Failures are wrapped and identify which lookup failed.
+	daemonErr := &DaemonError{Code: "internal", Msg: "x"}
+	var we *ExternalWeightError
+
+	_, err = AttachExternalWeights(data, &fixedWeighter{weightErr: daemonErr}, 1, 15, basics.Address{})
+	require.ErrorAs(t, err, &we)
+	require.False(t, we.Total)
+	require.True(t, IsDaemonError(err, "internal"))
+
+	_, err = AttachExternalWeights(data, &fixedWeighter{weight: 5, totalErr: daemonErr}, 1, 15, basics.Address{})
+	require.ErrorAs(t, err, &we)
+	require.True(t, we.Total)
+}
+
+// contextWeighter is a fixedWeighter whose lookups can be abandoned, and
+// records the contexts they were given.
+type contextWeighter struct {
+	fixedWeighter
+	contexts []context.Context
+}
+
+func (w *contextWeighter) ExternalWeightContext(ctx context.Context, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	w.contexts = append(w.contexts, ctx)
+	return w.ExternalWeight(balanceRound, addr, selectionID)
+}
+
+func (w *contextWeighter) TotalExternalWeightContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	w.contexts = append(w.contexts, ctx)
+	return w.TotalExternalWeight(balanceRound, voteRound)
+}
+
+func TestAttachExternalWeightsContext(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	data := basics.OnlineAccountData{
+		VotingData: basics.VotingData{VoteFirstValid: 10, VoteLastValid: 20},
+	}
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, 1)
+
+	// Weighters that can abandon lookups are given the context.
+	cw := &contextWeighter{fixedWeighter: fixedWeighter{weight: 5, total: 100}}
+	wd, err := AttachExternalWeightsContext(ctx, data, cw, 1, 15, basics.Address{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), wd.TotalExternalWeight)
+	require.Equal(t, []context.Context{ctx, ctx}, cw.contexts)
+
+	// Others are not asked once the context is done.
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	w := &fixedWeighter{weight: 5, total: 100}
+	_, err = AttachExternalWeightsContext(cancelled, data, w, 1, 15, basics.Address{})
+	var we *ExternalWeightError
+	require.ErrorAs(t, err, &we)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, w.calls)
+}
+
+func TestVoteKeyEligible(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	data := basics.OnlineAccountData{VotingData: basics.VotingData{VoteFirstValid: 10}}
+	require.False(t, VoteKeyEligible(data, 9))
+	require.True(t, VoteKeyEligible(data, 10))
+	require.True(t, VoteKeyEligible(data, 1000000)) // perpetual key
+
+	data.VoteLastValid = 20
+	require.True(t, VoteKeyEligible(data, 20))
+	require.False(t, VoteKeyEligible(data, 21))
+}
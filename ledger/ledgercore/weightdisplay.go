@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"strconv"
+	"strings"
+)
+
+// WeightDisplay is how the weights of a daemon are shown to people. Weights
+// are integers in consensus; a daemon whose weights count small units, such
+// as micro-credits, can declare the unit people think in and how many weight
+// units make one of it.
+type WeightDisplay struct {
+	// Unit names the display unit, e.g. "credits". Empty if undeclared.
+	Unit string
+
+	// Scale is the number of weight units in one display unit, a power of
+	// ten. Zero or one leaves weights unscaled.
+	Scale uint64
+}
+
+// Format renders weight in the display unit, e.g. "1234.5 credits" for a
+// weight of 1234500000 at scale 1000000. Without a declared display it
+// renders the plain integer.
+func (d WeightDisplay) Format(weight uint64) string {
+	text := strconv.FormatUint(weight, 10)
+	if decimals := len(strconv.FormatUint(d.Scale, 10)) - 1; d.Scale > 1 && decimals > 0 {
+		if len(text) <= decimals {
+			text = strings.Repeat("0", decimals-len(text)+1) + text
+		}
+		whole, frac := text[:len(text)-decimals], strings.TrimRight(text[len(text)-decimals:], "0")
+		text = whole
+		if frac != "" {
+			text += "." + frac
+		}
+	}
+	if d.Unit != "" {
+		text += " " + d.Unit
+	}
+	return text
+}
+
+// identifiedOracle is implemented by weight oracles that remember the
+// identity their daemon last reported.
+type identifiedOracle interface {
+	LastIdentity() (DaemonIdentity, bool)
+}
+
+// WeightDisplayOf returns the display declared by the daemon behind oracle in
+// its latest identity, or the zero WeightDisplay if it declared none or oracle
+// does not remember identities.
+func WeightDisplayOf(oracle WeightOracle) WeightDisplay {
+	if o, ok := oracle.(identifiedOracle); ok {
+		if identity, ok := o.LastIdentity(); ok {
+			return identity.Display
+		}
+	}
+	return WeightDisplay{}
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWeightDisplayFormat(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		display WeightDisplay
+		weight  uint64
+		text    string
+	}{
+		{WeightDisplay{}, 1234500000, "1234500000"},
+		{WeightDisplay{Scale: 1}, 42, "42"},
+		{WeightDisplay{Unit: "credits"}, 42, "42 credits"},
+		{WeightDisplay{Unit: "credits", Scale: 1000000}, 1234500000, "1234.5 credits"},
+		{WeightDisplay{Unit: "credits", Scale: 1000000}, 7000000, "7 credits"},
+		{WeightDisplay{Unit: "credits", Scale: 1000000}, 25, "0.000025 credits"},
+		{WeightDisplay{Unit: "credits", Scale: 1000000}, 0, "0 credits"},
+		{WeightDisplay{Scale: 1000}, 18446744073709551615, "18446744073709551.615"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.text, test.display.Format(test.weight), "%+v %d", test.display, test.weight)
+	}
+}
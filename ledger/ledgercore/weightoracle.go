@@ -17,11 +17,14 @@
 package ledgercore
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
 )
 
 // ExpectedWeightAlgorithmVersion is the weight algorithm version that nodes expect
@@ -49,6 +52,51 @@ type DaemonIdentity struct {
 
 	// WeightProtocolVersion identifies the wire protocol version.
 	WeightProtocolVersion string
+
+	// Display is how the daemon asks for its weights to be shown to people.
+	// It is not checked by ValidateIdentity and has no part in consensus.
+	Display WeightDisplay
+
+	// Capabilities lists the optional parts of the protocol the daemon
+	// serves, such as "batch" or "msgpack", as the daemon gave them: names the
+	// node does not know are kept, and ignored. It is nil if the daemon does
+	// not list its capabilities, and empty if it serves none of them.
+	Capabilities []string
+}
+
+// Supports reports whether the daemon may be asked for the optional part of
+// the protocol named capability: whether it lists it, or does not list its
+// capabilities at all, in which case only asking tells.
+func (id DaemonIdentity) Supports(capability string) bool {
+	return id.Capabilities == nil || slices.Contains(id.Capabilities, capability)
+}
+
+// LookbackParams describes the node's balance lookback schedule. The node sends
+// it to the daemon at handshake so that the daemon can check its snapshot
+// schedule against consensus and refuse to serve a misconfigured node.
+type LookbackParams struct {
+	// BalanceLookback is the distance between a vote round and the balance
+	// round whose weights are used for it.
+	BalanceLookback basics.Round
+
+	// SeedLookback is the consensus SeedLookback parameter.
+	SeedLookback uint64
+
+	// SeedRefreshInterval is the consensus SeedRefreshInterval parameter.
+	SeedRefreshInterval uint64
+}
+
+// WeightTableEntry is one row of a daemon's per-round weight table.
+type WeightTableEntry struct {
+	// Addr is the account the weight belongs to.
+	Addr basics.Address
+
+	// SelectionID is the VRF public key the weight is keyed by. It is the zero
+	// value if the daemon does not key its table by selection ID.
+	SelectionID crypto.VRFVerifier
+
+	// Weight is the account's consensus weight at the table's balance round.
+	Weight uint64
 }
 
 // Verify DaemonError implements the error interface.
@@ -79,6 +127,82 @@ func IsDaemonError(err error, code string) bool {
 	return false
 }
 
+// IdentityField names a DaemonIdentity field checked by ValidateIdentity.
+type IdentityField string
+
+const (
+	// IdentityFieldGenesisHash is the daemon's genesis hash.
+	IdentityFieldGenesisHash IdentityField = "genesis hash"
+
+	// IdentityFieldAlgorithmVersion is the daemon's weight algorithm version.
+	IdentityFieldAlgorithmVersion IdentityField = "algorithm version"
+
+	// IdentityFieldProtocolVersion is the daemon's wire protocol version.
+	IdentityFieldProtocolVersion IdentityField = "protocol version"
+)
+
+// Verify IdentityMismatchError implements the error interface.
+var _ error = (*IdentityMismatchError)(nil)
+
+// IdentityMismatchError is returned by ValidateIdentity when a field of the
+// daemon's identity does not match what this node expects.
+type IdentityMismatchError struct {
+	// Field identifies which part of the identity mismatched.
+	Field IdentityField
+
+	// Got is the value reported by the daemon.
+	Got string
+
+	// Expected is the value the node requires.
+	Expected string
+}
+
+// Error implements the error interface for IdentityMismatchError.
+func (e *IdentityMismatchError) Error() string {
+	return fmt.Sprintf("weight daemon %s mismatch: got %s, expected %s", e.Field, e.Got, e.Expected)
+}
+
+// IsIdentityMismatch checks if err is an IdentityMismatchError for the specified field.
+// It handles wrapped errors using errors.As.
+func IsIdentityMismatch(err error, field IdentityField) bool {
+	var ime *IdentityMismatchError
+	if errors.As(err, &ime) {
+		return ime.Field == field
+	}
+	return false
+}
+
+// ValidateIdentity checks a daemon identity against the node's genesis hash and
+// the expected algorithm and protocol versions, which the daemon's versions must
+// follow as closely as policy requires unless policy lists them. It returns an *IdentityMismatchError
+// for the first field that does not match, or nil if the identity is acceptable.
+// Startup validation and any later re-verification of the daemon must both go
+// through this function so that the checks cannot drift apart.
+func ValidateIdentity(got DaemonIdentity, wantGenesis crypto.Digest, policy VersionPolicy) error {
+	if got.GenesisHash != wantGenesis {
+		return &IdentityMismatchError{
+			Field:    IdentityFieldGenesisHash,
+			Got:      got.GenesisHash.String(),
+			Expected: wantGenesis.String(),
+		}
+	}
+	if !versionAccepted(got.WeightAlgorithmVersion, ExpectedWeightAlgorithmVersion, policy.Algorithm, policy.AlgorithmVersions) {
+		return &IdentityMismatchError{
+			Field:    IdentityFieldAlgorithmVersion,
+			Got:      got.WeightAlgorithmVersion,
+			Expected: versionRange(ExpectedWeightAlgorithmVersion, policy.Algorithm, policy.AlgorithmVersions),
+		}
+	}
+	if !versionAccepted(got.WeightProtocolVersion, ExpectedWeightProtocolVersion, policy.Protocol, policy.ProtocolVersions) {
+		return &IdentityMismatchError{
+			Field:    IdentityFieldProtocolVersion,
+			Got:      got.WeightProtocolVersion,
+			Expected: versionRange(ExpectedWeightProtocolVersion, policy.Protocol, policy.ProtocolVersions),
+		}
+	}
+	return nil
+}
+
 // WeightOracle defines the interface for communicating with an external weight daemon.
 // It provides methods to query individual account weights and total network weight,
 // as well as health check and identity verification.
@@ -97,3 +221,80 @@ type WeightOracle interface {
 	// Identity returns metadata about the daemon including genesis hash and version information.
 	Identity() (DaemonIdentity, error)
 }
+
+// ContextWeightOracle is optionally implemented by weight oracles whose
+// lookups can be abandoned. Once ctx is done, a lookup still waiting on the
+// daemon gives up and returns an error wrapping ctx's, and its abandonment
+// counts against neither the daemon's health nor the caches.
+type ContextWeightOracle interface {
+	// WeightContext is Weight, abandoned once ctx is done.
+	WeightContext(ctx context.Context, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error)
+
+	// TotalWeightContext is TotalWeight, abandoned once ctx is done.
+	TotalWeightContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round) (uint64, error)
+}
+
+// AttestingWeightOracle is optionally implemented by weight oracles whose
+// daemon signs the weights it answers, for networks that enable
+// ExternalWeightAttestation.
+type AttestingWeightOracle interface {
+	// WeightAttestation returns the daemon's signature over the
+	// committee.WeightStatement of the weight Weight answers for the same
+	// arguments, on the daemon's network.
+	WeightAttestation(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (committee.WeightAttestation, error)
+}
+
+// WeightQuery identifies a single account weight lookup within a batch.
+type WeightQuery struct {
+	Addr        basics.Address
+	SelectionID crypto.VRFVerifier
+}
+
+// WeightResult is the answer to one WeightQuery. If Err is non-nil, Weight is
+// meaningless; other entries of the same batch may still have succeeded.
+type WeightResult struct {
+	Weight uint64
+	Err    error
+}
+
+// BatchWeightOracle is optionally implemented by weight oracles that can look
+// up many account weights in one interaction with the daemon.
+type BatchWeightOracle interface {
+	// Weights returns the consensus weights for a batch of accounts at the
+	// specified balance round, in the same order as queries. A non-nil error
+	// means the batch as a whole failed; per-entry failures are reported in
+	// the corresponding WeightResult. Lookups still waiting on the daemon
+	// once ctx is done are abandoned, as by ContextWeightOracle.
+	Weights(ctx context.Context, balanceRound basics.Round, queries []WeightQuery) ([]WeightResult, error)
+}
+
+// OverridableWeightOracle is optionally implemented by weight oracles whose
+// answers the node's operator can override locally (see ErrWeightExcluded).
+// Block evaluation uses it, so that whether a block is valid never depends on
+// one node's overrides.
+type OverridableWeightOracle interface {
+	// DaemonWeights is BatchWeightOracle.Weights, answered as the daemon
+	// answers, without the overrides.
+	DaemonWeights(ctx context.Context, balanceRound basics.Round, queries []WeightQuery) ([]WeightResult, error)
+}
+
+// KeyRotation is an account's switch to a new selection ID by key
+// registrations committed in Round. The account's weight at balance rounds
+// from Round on is asked for under New, and at earlier ones under Old, which is
+// zero if the account was not online before.
+type KeyRotation struct {
+	Addr  basics.Address
+	Round basics.Round
+	Old   crypto.VRFVerifier
+	New   crypto.VRFVerifier
+}
+
+// KeyRotationNotifier is optionally implemented by weight oracles whose daemon
+// can be told of the key rotations the node observes. A daemon keying weights
+// by selection ID must answer for New with the weight Old would have had, from
+// Round on; being told ahead of the first query for New lets it do so without
+// a window in which the account has no weight.
+type KeyRotationNotifier interface {
+	// NotifyKeyRotations passes rotations, in round order, to the daemon.
+	NotifyKeyRotations(rotations []KeyRotation) error
+}
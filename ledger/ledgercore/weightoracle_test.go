@@ -190,3 +190,99 @@ func TestDaemonIdentityFields(t *testing.T) {
 	require.Equal(t, "1.0", identity.WeightAlgorithmVersion)
 	require.Equal(t, "1.0", identity.WeightProtocolVersion)
 }
+
+func TestDaemonIdentitySupports(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// A daemon that does not list its capabilities may support any of them.
+	require.True(t, DaemonIdentity{}.Supports("batch"))
+	require.False(t, DaemonIdentity{Capabilities: []string{}}.Supports("batch"))
+	listed := DaemonIdentity{Capabilities: []string{"msgpack", "not-yet-invented"}}
+	require.True(t, listed.Supports("msgpack"))
+	require.True(t, listed.Supports("not-yet-invented"))
+	require.False(t, listed.Supports("batch"))
+}
+
+func TestValidateIdentity(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis := crypto.Digest{1, 2, 3}
+	valid := DaemonIdentity{
+		GenesisHash:            genesis,
+		WeightAlgorithmVersion: ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ExpectedWeightProtocolVersion,
+	}
+
+	t.Run("matching identity", func(t *testing.T) {
+		require.NoError(t, ValidateIdentity(valid, genesis, DefaultVersionPolicy))
+	})
+
+	t.Run("genesis hash mismatch", func(t *testing.T) {
+		err := ValidateIdentity(valid, crypto.Digest{9}, DefaultVersionPolicy)
+		require.Error(t, err)
+		require.True(t, IsIdentityMismatch(err, IdentityFieldGenesisHash))
+		require.Contains(t, err.Error(), "genesis hash mismatch")
+
+		var ime *IdentityMismatchError
+		require.ErrorAs(t, err, &ime)
+		require.Equal(t, genesis.String(), ime.Got)
+		require.Equal(t, crypto.Digest{9}.String(), ime.Expected)
+	})
+
+	t.Run("algorithm version mismatch", func(t *testing.T) {
+		id := valid
+		id.WeightAlgorithmVersion = "2.0"
+		err := ValidateIdentity(id, genesis, DefaultVersionPolicy)
+		require.True(t, IsIdentityMismatch(err, IdentityFieldAlgorithmVersion))
+		require.Equal(t, "weight daemon algorithm version mismatch: got 2.0, expected 1.0.x", err.Error())
+	})
+
+	t.Run("protocol version mismatch", func(t *testing.T) {
+		id := valid
+		id.WeightProtocolVersion = "2.0"
+		err := ValidateIdentity(id, genesis, DefaultVersionPolicy)
+		require.True(t, IsIdentityMismatch(err, IdentityFieldProtocolVersion))
+		require.False(t, IsIdentityMismatch(err, IdentityFieldGenesisHash))
+	})
+
+	t.Run("compatible versions", func(t *testing.T) {
+		id := valid
+		id.WeightAlgorithmVersion = "1.0.3"
+		id.WeightProtocolVersion = "1.4"
+		require.NoError(t, ValidateIdentity(id, genesis, DefaultVersionPolicy))
+
+		err := ValidateIdentity(id, genesis, VersionPolicy{Protocol: VersionMatchMajor, Algorithm: VersionMatchExact})
+		require.True(t, IsIdentityMismatch(err, IdentityFieldAlgorithmVersion))
+		err = ValidateIdentity(id, genesis, VersionPolicy{Protocol: VersionMatchMinor, Algorithm: VersionMatchMinor})
+		require.Equal(t, "weight daemon protocol version mismatch: got 1.4, expected 1.0.x", err.Error())
+	})
+
+	t.Run("listed versions", func(t *testing.T) {
+		id := valid
+		id.WeightAlgorithmVersion = "1.1"
+		id.WeightProtocolVersion = "2.0"
+		policy := DefaultVersionPolicy
+		err := ValidateIdentity(id, genesis, policy)
+		require.True(t, IsIdentityMismatch(err, IdentityFieldAlgorithmVersion))
+
+		policy.AlgorithmVersions = []string{"1.1"}
+		err = ValidateIdentity(id, genesis, policy)
+		require.Equal(t, "weight daemon protocol version mismatch: got 2.0, expected 1.x", err.Error())
+
+		policy.ProtocolVersions = []string{"2.0"}
+		require.NoError(t, ValidateIdentity(id, genesis, policy))
+	})
+
+	t.Run("genesis checked first", func(t *testing.T) {
+		err := ValidateIdentity(DaemonIdentity{}, genesis, DefaultVersionPolicy)
+		require.True(t, IsIdentityMismatch(err, IdentityFieldGenesisHash))
+	})
+
+	t.Run("wrapped mismatch", func(t *testing.T) {
+		err := fmt.Errorf("re-verification: %w", ValidateIdentity(DaemonIdentity{}, genesis, DefaultVersionPolicy))
+		require.True(t, IsIdentityMismatch(err, IdentityFieldGenesisHash))
+		require.False(t, IsIdentityMismatch(errors.New("other"), IdentityFieldGenesisHash))
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// VersionMatch is how closely a version reported by the weight daemon must
+// follow the version the node expects. Versions are major.minor, optionally
+// followed by .patch.
+type VersionMatch string
+
+const (
+	// VersionMatchMajor accepts any version with the expected major version.
+	VersionMatchMajor VersionMatch = "major"
+
+	// VersionMatchMinor accepts any patch of the expected major.minor version.
+	VersionMatchMinor VersionMatch = "minor"
+
+	// VersionMatchExact accepts only the expected version.
+	VersionMatchExact VersionMatch = "exact"
+)
+
+// VersionPolicy sets how closely the daemon's versions must follow
+// ExpectedWeightProtocolVersion and ExpectedWeightAlgorithmVersion.
+type VersionPolicy struct {
+	// Protocol is the match required of the wire protocol version.
+	Protocol VersionMatch
+
+	// Algorithm is the match required of the weight algorithm version.
+	Algorithm VersionMatch
+
+	// ProtocolVersions lists further wire protocol versions accepted
+	// whatever the match.
+	ProtocolVersions []string
+
+	// AlgorithmVersions lists further weight algorithm versions accepted
+	// whatever the match, such as both sides of an algorithm upgrade.
+	AlgorithmVersions []string
+}
+
+// DefaultVersionPolicy accepts any 1.x wire protocol, since later minor
+// versions only add to it, and any patch of the weight algorithm, since
+// patches must not change the weights it derives.
+var DefaultVersionPolicy = VersionPolicy{
+	Protocol:  VersionMatchMajor,
+	Algorithm: VersionMatchMinor,
+}
+
+// parseVersion splits a major.minor[.patch] version into its major and minor
+// numbers.
+func parseVersion(v string) (major, minor uint64, ok bool) {
+	parts := strings.Split(v, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, false
+	}
+	var nums [3]uint64
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+		nums[i] = n
+	}
+	return nums[0], nums[1], true
+}
+
+// VersionCompatible reports whether the daemon version got is accepted for
+// the expected version under match. Versions that do not parse are accepted
+// only if they equal the expected version.
+func VersionCompatible(got, expected string, match VersionMatch) bool {
+	if got == expected {
+		return true
+	}
+	gotMajor, gotMinor, ok := parseVersion(got)
+	if !ok {
+		return false
+	}
+	wantMajor, wantMinor, ok := parseVersion(expected)
+	if !ok {
+		return false
+	}
+	switch match {
+	case VersionMatchMajor:
+		return gotMajor == wantMajor
+	case VersionMatchMinor:
+		return gotMajor == wantMajor && gotMinor == wantMinor
+	}
+	return false
+}
+
+// versionAccepted reports whether the daemon version got is compatible with
+// expected under match or is one of the listed versions.
+func versionAccepted(got, expected string, match VersionMatch, listed []string) bool {
+	return VersionCompatible(got, expected, match) || slices.Contains(listed, got)
+}
+
+// versionRange describes the versions versionAccepted accepts, for error
+// messages.
+func versionRange(expected string, match VersionMatch, listed []string) string {
+	accepted := expected
+	if major, minor, ok := parseVersion(expected); ok {
+		switch match {
+		case VersionMatchMajor:
+			accepted = fmt.Sprintf("%d.x", major)
+		case VersionMatchMinor:
+			accepted = fmt.Sprintf("%d.%d.x", major, minor)
+		}
+	}
+	if len(listed) == 0 {
+		return accepted
+	}
+	return fmt.Sprintf("%s or one of %s", accepted, strings.Join(listed, ", "))
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestVersionCompatible(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		got      string
+		expected string
+		match    VersionMatch
+		ok       bool
+	}{
+		{"1.0", "1.0", VersionMatchExact, true},
+		{"1.0.1", "1.0", VersionMatchExact, false},
+		{"1.0.0", "1.0", VersionMatchMinor, true},
+		{"1.0.7", "1.0", VersionMatchMinor, true},
+		{"1.1", "1.0", VersionMatchMinor, false},
+		{"1.1", "1.0", VersionMatchMajor, true},
+		{"1.12.3", "1.0", VersionMatchMajor, true},
+		{"0.9", "1.0", VersionMatchMajor, false},
+		{"2.0", "1.0", VersionMatchMajor, false},
+		{"1", "1.0", VersionMatchMajor, false},
+		{"1.0.0.1", "1.0", VersionMatchMajor, false},
+		{"1.0-rc1", "1.0", VersionMatchMajor, false},
+		{"1.x", "1.0", VersionMatchMajor, false},
+		{"", "1.0", VersionMatchMajor, false},
+		// Versions that do not parse only match themselves.
+		{"dev", "dev", VersionMatchMajor, true},
+		{"1.0", "dev", VersionMatchMajor, false},
+		// An unknown match accepts only the expected version.
+		{"1.0.1", "1.0", "", false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.ok, VersionCompatible(test.got, test.expected, test.match),
+			"%q against %q with %q", test.got, test.expected, test.match)
+	}
+}
+
+func TestVersionRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, "1.x", versionRange("1.0", VersionMatchMajor, nil))
+	require.Equal(t, "1.0.x", versionRange("1.0", VersionMatchMinor, nil))
+	require.Equal(t, "1.0", versionRange("1.0", VersionMatchExact, nil))
+	require.Equal(t, "dev", versionRange("dev", VersionMatchMinor, nil))
+	require.Equal(t, "1.0.x or one of 1.1, 2.0", versionRange("1.0", VersionMatchMinor, []string{"1.1", "2.0"}))
+}
@@ -17,6 +17,7 @@
 package libgoal
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -37,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/nodecontrol"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util"
@@ -1337,3 +1339,42 @@ func (c *Client) BlockLogs(round basics.Round) (resp model.BlockLogsResponse, er
 	}
 	return
 }
+
+// WeightCacheExport returns the weight daemon answers the node has cached for
+// balance rounds from since on
+func (c *Client) WeightCacheExport(since basics.Round) (export []byte, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.WeightCacheExport(context.Background(), since)
+	}
+	return
+}
+
+// ExternalWeight returns the weight the node's weight oracle gives addr for
+// round, or for the round after the latest if round is 0
+func (c *Client) ExternalWeight(round basics.Round, addr basics.Address) (resp node.ExternalWeightLookup, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.ExternalWeight(round, addr)
+	}
+	return
+}
+
+// TotalExternalWeight returns the total weight the node's weight oracle gives
+// for balanceRound and voteRound
+func (c *Client) TotalExternalWeight(balanceRound, voteRound basics.Round) (resp node.TotalExternalWeightLookup, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.TotalExternalWeight(balanceRound, voteRound)
+	}
+	return
+}
+
+// WeightOracleBundle returns an archive of the node's weight daemon diagnostics
+func (c *Client) WeightOracleBundle() (bundle []byte, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.WeightOracleBundle(context.Background())
+	}
+	return
+}
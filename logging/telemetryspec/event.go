@@ -368,3 +368,72 @@ type BalancesAccountVacuumEventDetails struct {
 	// AfterVacuumSpaceBytes is the number of bytes used by the database after running the vacuuming process.
 	AfterVacuumSpaceBytes uint64
 }
+
+// ProposerShareDeviationEvent event
+const ProposerShareDeviationEvent Event = "ProposerShareDeviation"
+
+// ProposerShareDeviationEventDetails is generated when an account has, for a sustained stretch of
+// blocks, proposed a share of the recent blocks far from its share of the external weight.
+type ProposerShareDeviationEventDetails struct {
+	Address string
+	Round   uint64
+	// Blocks is the number of recent blocks the shares are computed over.
+	Blocks uint64
+	// Proposed is how many of them the account proposed, and Expected how many its weight share predicts.
+	Proposed uint64
+	Expected float64
+	// Sigmas is the difference between Proposed and Expected in standard deviations.
+	Sigmas float64
+}
+
+// WeightOracleStateChangedEvent event
+const WeightOracleStateChangedEvent Event = "WeightOracleStateChanged"
+
+// WeightOracleStateChangedEventDetails is generated when the node's health checks find that the weight
+// daemon became healthy, degraded or down.
+type WeightOracleStateChangedEventDetails struct {
+	From string
+	To   string
+	// ConsecutiveFailures is the number of health checks that failed since the last one that succeeded.
+	ConsecutiveFailures uint64
+	// Error is the failed check's error, empty when To is healthy.
+	Error string
+}
+
+// WeightOracleIdentityMismatchEvent event
+const WeightOracleIdentityMismatchEvent Event = "WeightOracleIdentityMismatch"
+
+// WeightOracleIdentityMismatchEventDetails is generated when the weight daemon a running node uses
+// changes its identity to one the node does not accept.
+type WeightOracleIdentityMismatchEventDetails struct {
+	// Field is the part of the identity that mismatched, and Got and Expected its values.
+	Field    string
+	Got      string
+	Expected string
+}
+
+// WeightOracleInvariantViolationEvent event
+const WeightOracleInvariantViolationEvent Event = "WeightOracleInvariantViolation"
+
+// WeightOracleInvariantViolationEventDetails is generated just before agreement panics because an
+// invariant of the weight oracle protocol is violated, by the daemon's answers or the node's setup.
+type WeightOracleInvariantViolationEventDetails struct {
+	Round uint64
+	// Address is the account whose membership was being checked, empty if the violation is not its own.
+	Address string
+	Reason  string
+}
+
+// WeightOracleCacheMissStormEvent event
+const WeightOracleCacheMissStormEvent Event = "WeightOracleCacheMissStorm"
+
+// WeightOracleCacheMissStormEventDetails is generated when most weight lookups have missed the node's
+// weight cache for a sustained stretch of blocks, sending them all to the weight daemon.
+type WeightOracleCacheMissStormEventDetails struct {
+	Round uint64
+	// Blocks is the number of consecutive blocks the storm has lasted.
+	Blocks uint64
+	// CacheMisses and CacheHits are the lookups of the last of them.
+	CacheMisses uint64
+	CacheHits   uint64
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"maps"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
@@ -85,6 +86,21 @@ func OverrideKmdConfig(kmdConfig TemplateKMDConfig) TemplateOverride {
 	}
 }
 
+// OverrideWeightOracleBasePort points every node without its own weight daemon settings at a
+// daemon on 127.0.0.1, using consecutive ports starting at basePort in template node order.
+func OverrideWeightOracleBasePort(basePort uint16) TemplateOverride {
+	return func(template *NetworkTemplate) {
+		template.WeightOracleBasePort = basePort
+	}
+}
+
+// OverrideWeightOracleAutoPorts gives every node without its own weight daemon settings a free
+// port on 127.0.0.1; WeightOracleEndpoint reports the port each node was given.
+func OverrideWeightOracleAutoPorts(template *NetworkTemplate) {
+	template.WeightOracleBasePort = 0
+	template.WeightOracleAutoPorts = true
+}
+
 // CreateNetworkFromTemplate uses the specified template to deploy a new private network
 // under the specified root directory.
 func CreateNetworkFromTemplate(name, rootDir string, templateReader io.Reader, binDir string, importKeys bool, nodeExitCallback nodecontrol.AlgodExitErrorCallback, consensus config.ConsensusProtocols, overrides ...TemplateOverride) (Network, error) {
@@ -220,6 +236,20 @@ func (n Network) GetNodeDir(nodeName string) (string, error) {
 	return "", fmt.Errorf("no node exists that is named '%s'", nodeName)
 }
 
+// WeightOracleEndpoint returns the weight daemon endpoint written into the named node's
+// config, or nil if the node does not use an external weight daemon.
+func (n Network) WeightOracleEndpoint(nodeName string) (*url.URL, error) {
+	nodeDir, err := n.GetNodeDir(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := config.LoadConfigFromDisk(nodeDir)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.ExternalWeightOracleEndpoint()
+}
+
 func isNodeDir(path string) bool {
 	if util.IsDir(path) {
 		if util.FileExists(filepath.Join(path, config.GenesisJSONFile)) {
@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"math"
 	"math/big"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -44,6 +46,14 @@ type NetworkTemplate struct {
 	Nodes     []remote.NodeConfigGoal
 	Consensus config.ConsensusProtocols
 	kmdConfig TemplateKMDConfig // set by OverrideKmdConfig
+
+	// WeightOracleBasePort, if non-zero, gives the i-th node in Nodes a weight daemon on
+	// 127.0.0.1 at WeightOracleBasePort+i, unless the node sets WeightOracleURL or WeightOraclePort.
+	WeightOracleBasePort uint16 `json:",omitempty"`
+
+	// WeightOracleAutoPorts gives every node without its own weight daemon settings a
+	// distinct free port on 127.0.0.1, picked when the node directories are created.
+	WeightOracleAutoPorts bool `json:",omitempty"`
 }
 
 // TemplateKMDConfig is a subset of the kmd configuration that can be overridden in the network template
@@ -86,6 +96,11 @@ func (t NetworkTemplate) createNodeDirectories(targetFolder string, binDir strin
 
 	relaysCount := countRelayNodes(t.Nodes)
 
+	weightOraclePorts, err := t.weightOraclePorts()
+	if err != nil {
+		return
+	}
+
 	for i, cfg := range t.Nodes {
 		nodeDir := filepath.Join(targetFolder, cfg.Name)
 		err = os.Mkdir(nodeDir, os.ModePerm)
@@ -183,7 +198,8 @@ func (t NetworkTemplate) createNodeDirectories(targetFolder string, binDir strin
 		// Create any necessary config.json file for this node
 		nodeCfg := filepath.Join(nodeDir, config.ConfigFilename)
 		var mergedCfg config.Local
-		mergedCfg, err = createConfigFile(cfg, nodeCfg, len(t.Nodes)-1, relaysCount) // minus 1 to avoid counting self
+		cfg.WeightOraclePort = weightOraclePorts[i]
+		mergedCfg, err = createConfigFile(cfg, nodeCfg, len(t.Nodes)-1, relaysCount, t.Genesis.DevMode) // minus 1 to avoid counting self
 		if err != nil {
 			return
 		}
@@ -282,6 +298,10 @@ func (t NetworkTemplate) Validate() error {
 		}
 	}
 
+	if err := t.validateWeightOracles(); err != nil {
+		return err
+	}
+
 	// Follow nodes cannot be relays
 	// Relays cannot have peer list
 	for _, cfg := range t.Nodes {
@@ -308,6 +328,72 @@ func (t NetworkTemplate) Validate() error {
 	return nil
 }
 
+// weightOraclePorts returns the weight daemon port of every node in Nodes. Nodes with their
+// own weight daemon settings keep them; the rest get WeightOracleBasePort+i, or a free port
+// when WeightOracleAutoPorts is set. A zero port means the node has no local daemon.
+func (t NetworkTemplate) weightOraclePorts() ([]uint16, error) {
+	ports := make([]uint16, len(t.Nodes))
+	claimed := make(map[uint16]bool)
+	var unassigned []int
+	for i, node := range t.Nodes {
+		switch {
+		case node.WeightOracleURL != "" || node.WeightOraclePort != 0:
+			ports[i] = node.WeightOraclePort
+		case t.WeightOracleBasePort != 0:
+			ports[i] = t.WeightOracleBasePort + uint16(i)
+		case t.WeightOracleAutoPorts:
+			unassigned = append(unassigned, i)
+			continue
+		}
+		if ports[i] != 0 {
+			claimed[ports[i]] = true
+		}
+	}
+
+	// Keep every probe listener open until all ports are picked so the kernel cannot hand
+	// out the same port twice, and skip ports another node has already been given.
+	var listeners []net.Listener
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+	for _, i := range unassigned {
+		for ports[i] == 0 {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				return nil, fmt.Errorf("unable to allocate weight daemon port for node %s: %w", t.Nodes[i].Name, err)
+			}
+			listeners = append(listeners, l)
+			port := uint16(l.Addr().(*net.TCPAddr).Port)
+			if !claimed[port] {
+				ports[i] = port
+				claimed[port] = true
+			}
+		}
+	}
+	return ports, nil
+}
+
+func (t NetworkTemplate) validateWeightOracles() error {
+	if t.WeightOracleBasePort != 0 && t.WeightOracleAutoPorts {
+		return fmt.Errorf("invalid template: WeightOracleBasePort and WeightOracleAutoPorts are mutually exclusive")
+	}
+	if t.WeightOracleBasePort != 0 && int(t.WeightOracleBasePort)+len(t.Nodes)-1 > math.MaxUint16 {
+		return fmt.Errorf("invalid template: WeightOracleBasePort %d leaves no room for %d nodes", t.WeightOracleBasePort, len(t.Nodes))
+	}
+	for _, cfg := range t.Nodes {
+		if cfg.WeightOracleURL != "" && cfg.WeightOraclePort != 0 {
+			return fmt.Errorf("invalid template: node %s sets both WeightOracleURL and WeightOraclePort", cfg.Name)
+		}
+		local := config.Local{ExternalWeightOracleURL: cfg.WeightOracleURL}
+		if _, err := local.ExternalWeightOracleEndpoint(); err != nil {
+			return fmt.Errorf("invalid template: node %s: %w", cfg.Name, err)
+		}
+	}
+	return nil
+}
+
 func isEnableFollowMode(JSONOverride string) bool {
 	local := config.GetDefaultLocal()
 	// decode error is checked elsewhere
@@ -337,7 +423,7 @@ func decodeJSONOverride[T any](override string, cfg *T) error {
 	return nil
 }
 
-func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes int, relaysCount int) (config.Local, error) {
+func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes int, relaysCount int, devMode bool) (config.Local, error) {
 	cfg := config.GetDefaultLocal()
 	cfg.GossipFanout = numNodes
 	// Override default :8080 REST endpoint, and disable SRV lookup
@@ -349,6 +435,16 @@ func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes in
 	if relaysCount == 0 {
 		cfg.DisableNetworking = true
 	}
+	if devMode {
+		// Dev mode networks run without a weight daemon unless the node's
+		// template entry points at one.
+		cfg.EnableBuiltinStakeWeightOracle = true
+	}
+	if node.WeightOracleURL != "" {
+		cfg.ExternalWeightOracleURL = node.WeightOracleURL
+	} else if node.WeightOraclePort != 0 {
+		cfg.ExternalWeightOracleURL = fmt.Sprintf("http://127.0.0.1:%d", node.WeightOraclePort)
+	}
 
 	if node.IsRelay {
 		// Have relays listen on any localhost port
@@ -369,6 +465,9 @@ func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes in
 	if err != nil {
 		return config.Local{}, err
 	}
+	if devMode && (cfg.ExternalWeightOracleURL != "" || cfg.ExternalWeightOraclePort != 0) {
+		cfg.EnableBuiltinStakeWeightOracle = false
+	}
 
 	return cfg, cfg.SaveToFile(configFile)
 }
@@ -18,6 +18,8 @@ package netdeploy
 
 import (
 	"encoding/json"
+	"math"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -313,3 +315,174 @@ func TestJsonOverlay(t *testing.T) {
 	a.Equal("one", after.A)
 	a.Equal("other", after.B)
 }
+
+// TestCreateConfigFileBuiltinWeightOracle ensures dev mode nodes get the built-in
+// stake weight oracle unless their override configures a weight daemon.
+func TestCreateConfigFileBuiltinWeightOracle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+	configFile := filepath.Join(t.TempDir(), config.ConfigFilename)
+
+	cfg, err := createConfigFile(remote.NodeConfigGoal{Name: "Node"}, configFile, 0, 0, false)
+	a.NoError(err)
+	a.False(cfg.EnableBuiltinStakeWeightOracle)
+
+	cfg, err = createConfigFile(remote.NodeConfigGoal{Name: "Node"}, configFile, 0, 0, true)
+	a.NoError(err)
+	a.True(cfg.EnableBuiltinStakeWeightOracle)
+	a.NoError(cfg.ValidateExternalWeightOracleConfig())
+
+	node := remote.NodeConfigGoal{Name: "Node", ConfigJSONOverride: `{"ExternalWeightOracleURL":"http://127.0.0.1:9876"}`}
+	cfg, err = createConfigFile(node, configFile, 0, 0, true)
+	a.NoError(err)
+	a.False(cfg.EnableBuiltinStakeWeightOracle)
+	a.NoError(cfg.ValidateExternalWeightOracleConfig())
+}
+
+func TestWeightOracleValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis := gen.GenesisData{
+		Wallets: []gen.WalletData{
+			{
+				Stake: 100,
+			},
+		},
+	}
+
+	t.Run("URL and port are exclusive", func(t *testing.T) {
+		t.Parallel()
+		tmpl := NetworkTemplate{
+			Genesis: genesis,
+			Nodes: []remote.NodeConfigGoal{
+				{
+					Name:             "Node",
+					WeightOracleURL:  "http://127.0.0.1:9876",
+					WeightOraclePort: 9876,
+				},
+			},
+		}
+		require.ErrorContains(t, tmpl.Validate(), "sets both WeightOracleURL and WeightOraclePort")
+	})
+
+	t.Run("URL must be valid", func(t *testing.T) {
+		t.Parallel()
+		tmpl := NetworkTemplate{
+			Genesis: genesis,
+			Nodes: []remote.NodeConfigGoal{
+				{
+					Name:            "Node",
+					WeightOracleURL: "ftp://127.0.0.1:9876",
+				},
+			},
+		}
+		require.ErrorContains(t, tmpl.Validate(), "must use the http or https scheme")
+	})
+
+	t.Run("Base port must fit all nodes", func(t *testing.T) {
+		t.Parallel()
+		tmpl := NetworkTemplate{
+			Genesis: genesis,
+			Nodes: []remote.NodeConfigGoal{
+				{IsRelay: true},
+				{},
+			},
+			WeightOracleBasePort: math.MaxUint16,
+		}
+		require.ErrorContains(t, tmpl.Validate(), "leaves no room")
+
+		tmpl.WeightOracleBasePort = math.MaxUint16 - 1
+		require.NoError(t, tmpl.Validate())
+	})
+
+	t.Run("Base port and auto ports are exclusive", func(t *testing.T) {
+		t.Parallel()
+		tmpl := NetworkTemplate{
+			Genesis: genesis,
+			Nodes: []remote.NodeConfigGoal{
+				{IsRelay: true},
+			},
+			WeightOracleBasePort:  9000,
+			WeightOracleAutoPorts: true,
+		}
+		require.ErrorContains(t, tmpl.Validate(), "mutually exclusive")
+	})
+}
+
+// TestWeightOraclePortAssignment ensures nodes without their own weight daemon
+// settings take consecutive ports from WeightOracleBasePort.
+func TestWeightOraclePortAssignment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+	tmpl := NetworkTemplate{
+		Nodes: []remote.NodeConfigGoal{
+			{Name: "Relay", IsRelay: true},
+			{Name: "Node1"},
+			{Name: "Node2", WeightOraclePort: 7000},
+			{Name: "Node3", WeightOracleURL: "https://weights.example.com"},
+		},
+	}
+	ports, err := tmpl.weightOraclePorts()
+	a.NoError(err)
+	a.Equal([]uint16{0, 0, 7000, 0}, ports)
+
+	OverrideWeightOracleBasePort(9000)(&tmpl)
+	ports, err = tmpl.weightOraclePorts()
+	a.NoError(err)
+	a.Equal([]uint16{9000, 9001, 7000, 0}, ports)
+
+	configFile := filepath.Join(t.TempDir(), config.ConfigFilename)
+	node := tmpl.Nodes[1]
+	node.WeightOraclePort = ports[1]
+	cfg, err := createConfigFile(node, configFile, 3, 1, false)
+	a.NoError(err)
+	a.Equal("http://127.0.0.1:9001", cfg.ExternalWeightOracleURL)
+
+	cfg, err = createConfigFile(tmpl.Nodes[3], configFile, 3, 1, true)
+	a.NoError(err)
+	a.Equal("https://weights.example.com", cfg.ExternalWeightOracleURL)
+	a.False(cfg.EnableBuiltinStakeWeightOracle)
+}
+
+// TestWeightOracleAutoPorts ensures auto-assigned weight daemon ports are distinct and
+// never collide with a port a node asked for explicitly.
+func TestWeightOracleAutoPorts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	// Hold a port open so the allocator sees it as taken by another process.
+	busy, err := net.Listen("tcp", "127.0.0.1:0")
+	a.NoError(err)
+	defer busy.Close()
+	busyPort := uint16(busy.Addr().(*net.TCPAddr).Port)
+
+	tmpl := NetworkTemplate{
+		Nodes: []remote.NodeConfigGoal{
+			{Name: "Relay", IsRelay: true},
+			{Name: "Node1"},
+			{Name: "Node2", WeightOraclePort: 7000},
+			{Name: "Node3", WeightOracleURL: "https://weights.example.com"},
+			{Name: "Node4"},
+		},
+	}
+	OverrideWeightOracleAutoPorts(&tmpl)
+	ports, err := tmpl.weightOraclePorts()
+	a.NoError(err)
+	a.Len(ports, len(tmpl.Nodes))
+	a.Equal(uint16(7000), ports[2])
+	a.Equal(uint16(0), ports[3])
+
+	seen := map[uint16]bool{7000: true, busyPort: true}
+	for _, i := range []int{0, 1, 4} {
+		a.NotZero(ports[i])
+		a.False(seen[ports[i]], "port %d assigned twice", ports[i])
+		seen[ports[i]] = true
+	}
+}
@@ -64,4 +64,6 @@ type NodeConfigGoal struct {
 	DeadlockDetection  int    `json:"-"`
 	ConfigJSONOverride string `json:",omitempty"` // Raw json to merge into config.json after other modifications are complete
 	PeerList           string `json:",omitempty"` // Semicolon separated list of peers to connect to. Only applicable for non-relays
+	WeightOracleURL    string `json:",omitempty"` // Base URL of this node's weight daemon (ExternalWeightOracleURL)
+	WeightOraclePort   uint16 `json:",omitempty"` // Port of a weight daemon on 127.0.0.1; shorthand for WeightOracleURL
 }
@@ -20,11 +20,11 @@ package node
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/algorand/go-deadlock"
 
-	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
@@ -72,7 +72,13 @@ type AlgorandFollowerNode struct {
 
 	cryptoPool                        execpool.ExecutionPool
 	lowPriorityCryptoVerificationPool execpool.BacklogPool
-	catchupBlockAuth                  blockAuthenticatorImpl
+	catchupBlockAuth                  catchup.BlockAuthenticator
+
+	// proposerWeightRounds passes committed rounds to proposerWeightThread,
+	// which records their proposer weights in proposerWeights.
+	proposerWeightRounds    chan basics.Round
+	proposerWeights         proposerWeightLog
+	proposerWeightWaitGroup sync.WaitGroup
 }
 
 // MakeFollower sets up an Algorand data node
@@ -126,6 +132,7 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 		return nil, err
 	}
 
+	node.proposerWeightRounds = make(chan basics.Round, proposerShareRoundsQueue)
 	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node})
 
 	if cfg.IsListenServer() {
@@ -133,7 +140,11 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 	}
 
 	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
-	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
+	node.catchupBlockAuth, err = node.initializeWeightOracle(rootDir)
+	if err != nil {
+		log.Errorf("Cannot initialize weight oracle: %v", err)
+		return nil, err
+	}
 	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, make(chan catchup.PendingUnmatchedCertificate), node.lowPriorityCryptoVerificationPool)
 
 	// Initialize sync round to the latest db round + 1 so that nothing falls out of the cache on Start
@@ -173,6 +184,8 @@ func (node *AlgorandFollowerNode) Start() error {
 
 	// Set up a context we can use to cancel goroutines on Stop()
 	node.ctx, node.cancelCtx = context.WithCancel(context.Background())
+	node.proposerWeightWaitGroup.Add(1)
+	go node.proposerWeightThread(node.ctx.Done())
 
 	// The start network is being called only after the various services start up.
 	// We want to do so in order to let the services register their callbacks with the
@@ -230,6 +243,7 @@ func (node *AlgorandFollowerNode) Stop() {
 	node.lowPriorityCryptoVerificationPool.Shutdown()
 	node.cryptoPool.Shutdown()
 	node.cancelCtx()
+	node.proposerWeightWaitGroup.Wait()
 }
 
 // Ledger exposes the node's ledger handle to the algod API code
@@ -341,6 +355,13 @@ func (node *AlgorandFollowerNode) OnNewBlock(block bookkeeping.Block, _ ledgerco
 	node.lastRoundTimestamp = time.Now()
 	node.hasSyncedSinceStartup = true
 	node.syncStatusMu.Unlock()
+
+	if node.ledger.WeightOracle() != nil {
+		select {
+		case node.proposerWeightRounds <- block.Round():
+		default:
+		}
+	}
 }
 
 // StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/weightoracletest"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -82,13 +83,11 @@ func setupFollowNode(t *testing.T) *AlgorandFollowerNode {
 
 	// Set up a mock weight oracle for the follower node's ledger.
 	// This is needed for simulation operations that call TotalExternalWeight.
-	mockWeightSrv := newMockWeightServer(t)
-	mockWeightSrv.genesisHash = genesis.Hash()
-	mockWeightSrv.defaultWeight = 1000000 // Non-zero weight for all accounts
-	t.Cleanup(func() { mockWeightSrv.Close() })
+	mockWeightSrv := weightoracletest.NewDaemon(t)
+	mockWeightSrv.SetGenesisHash(genesis.Hash()).SetDefaultWeight(1000000) // Non-zero weight for all accounts
 
 	// Create oracle client and set on ledger
-	oracle := weightoracle.NewClient(mockWeightSrv.port)
+	oracle := weightoracle.NewClient(mockWeightSrv.Port())
 	node.ledger.Ledger.SetWeightOracle(oracle)
 
 	return node
@@ -151,15 +150,55 @@ func TestDevModeWarning(t *testing.T) {
 	require.NoError(t, err)
 
 	// check for the warning
-	var foundEntry *logrus.Entry
+	var warnings []string
 	entries := hook.AllEntries()
 	for i := range entries {
 		if entries[i].Level == logrus.WarnLevel {
-			foundEntry = entries[i]
+			warnings = append(warnings, entries[i].Message)
 		}
 	}
-	require.NotNil(t, foundEntry)
-	require.Contains(t, foundEntry.Message, "Follower running on a devMode network. Must submit txns to a different node.")
+	require.Contains(t, warnings, "Follower running on a devMode network. Must submit txns to a different node.")
+}
+
+func TestFollowerWithoutWeightOracle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	genesis := followNodeDefaultGenesis()
+	node, err := MakeFollower(logging.Base(), t.TempDir(), cfg, []string{}, genesis)
+	require.NoError(t, err)
+	require.IsType(t, certificateClaimsAuthenticator{}, node.catchupBlockAuth)
+
+	// Re-validating blocks needs weights.
+	cfg.CatchupBlockValidateMode = 4
+	_, err = MakeFollower(logging.Base(), t.TempDir(), cfg, []string{}, genesis)
+	require.ErrorContains(t, err, "needs a weight oracle")
+}
+
+func TestFollowerWithWeightOracle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis := followNodeDefaultGenesis()
+	mockWeightSrv := weightoracletest.NewDaemon(t)
+	mockWeightSrv.SetGenesisHash(genesis.Hash())
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	cfg.ExternalWeightOracleURL = mockWeightSrv.URL().String()
+	node, err := MakeFollower(logging.Base(), t.TempDir(), cfg, []string{}, genesis)
+	require.NoError(t, err)
+	defer node.catchupBlockAuth.Quit()
+	require.IsType(t, blockAuthenticatorImpl{}, node.catchupBlockAuth)
+	require.NotNil(t, node.ledger.Ledger.WeightOracle())
+
+	// A daemon serving another network is rejected.
+	other := weightoracletest.NewDaemon(t)
+	cfg.ExternalWeightOracleURL = other.URL().String()
+	_, err = MakeFollower(logging.Base(), t.TempDir(), cfg, []string{}, genesis)
+	require.Error(t, err)
 }
 
 func TestFastCatchupResume(t *testing.T) {
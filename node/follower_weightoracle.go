@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// initializeWeightOracle sets up the weight oracle a follower uses to verify
+// block certificates, and returns the block authenticator for catchup.
+//
+// A follower does not vote, so unlike a full node it needs no oracle: without
+// one it accepts each block whose certificate names it, trusting its peers for
+// the votes. A configured oracle is validated as on a full node, but the
+// participation key checks do not apply.
+func (node *AlgorandFollowerNode) initializeWeightOracle(dataDir string) (catchup.BlockAuthenticator, error) {
+	if !node.config.ExternalWeightOracleConfigured() {
+		// Re-validating blocks checks absent online accounts, which is
+		// done by weight.
+		if node.config.CatchupVerifyTransactionSignatures() || node.config.CatchupVerifyApplyData() {
+			return nil, errors.New("CatchupBlockValidateMode re-validates blocks, which needs a weight oracle; configure one or disable re-validation")
+		}
+		node.log.Warnf("No weight oracle configured: block certificates are accepted without verifying their votes")
+		return certificateClaimsAuthenticator{}, nil
+	}
+	if err := node.config.ValidateExternalWeightOracleConfig(); err != nil {
+		return nil, err
+	}
+
+	auth := blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
+	if node.config.EnableBuiltinStakeWeightOracle {
+		node.log.Warnf("Using the built-in stake weight oracle: account weights mirror online stake. This is intended for development networks only")
+		node.ledger.Ledger.SetWeightOracle(weightoracle.NewStakeOracle(node.ledger.Ledger, node.genesisHash))
+		return auth, nil
+	}
+
+	endpoint, err := node.config.ExternalWeightOracleEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := node.config.LoadExternalWeightOracleSecrets(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	clientConfig := weightoracle.MakeClientConfig(node.config)
+	clientConfig.AuthToken = secrets.AuthToken
+	clientConfig.SigningKey = secrets.SigningKey
+	clientConfig.TLSRootCAs = secrets.TLSRootCAs
+	clientConfig.TLSClientCertificate = secrets.TLSClientCertificate
+	oracle := weightoracle.NewClientWithConfig(endpoint, clientConfig)
+
+	if err := oracle.Ping(); err != nil {
+		var pinErr *weightoracle.CertificatePinError
+		if errors.As(err, &pinErr) {
+			return nil, fmt.Errorf("weight daemon at %s failed certificate pinning; check ExternalWeightOracleTLSPins: %w", endpoint, err)
+		}
+		return nil, fmt.Errorf("weight daemon not reachable at %s: %w", endpoint, err)
+	}
+	cparams, err := node.ledger.ConsensusParams(node.ledger.Latest())
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine consensus parameters for weight daemon handshake: %w", err)
+	}
+	identity, err := oracle.Handshake(ledgercore.LookbackParams{
+		BalanceLookback:     agreement.BalanceLookback(cparams),
+		SeedLookback:        cparams.SeedLookback,
+		SeedRefreshInterval: cparams.SeedRefreshInterval,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("weight daemon identity query failed: %w", err)
+	}
+	if err := ledgercore.ValidateIdentity(identity, node.genesisHash, weightVersionPolicy(node.config, cparams)); err != nil {
+		return nil, err
+	}
+	node.log.Infof("Weight daemon identity validated: genesis=%v, algorithm=%s, protocol=%s",
+		identity.GenesisHash, identity.WeightAlgorithmVersion, identity.WeightProtocolVersion)
+
+	node.ledger.Ledger.SetWeightOracle(oracle)
+	return auth, nil
+}
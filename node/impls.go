@@ -49,6 +49,17 @@ func (i blockAuthenticatorImpl) Quit() {
 	i.AsyncVoteVerifier.Quit()
 }
 
+// certificateClaimsAuthenticator accepts a block whose certificate names it
+// without verifying the certificate's votes. Follower nodes without a weight
+// oracle use it, as they cannot look up the weights of the votes.
+type certificateClaimsAuthenticator struct{}
+
+func (certificateClaimsAuthenticator) Authenticate(block *bookkeeping.Block, cert *agreement.Certificate) error {
+	return cert.CheckClaims(*block)
+}
+
+func (certificateClaimsAuthenticator) Quit() {}
+
 type blockValidatorImpl struct {
 	l                *data.Ledger
 	verificationPool execpool.BacklogPool
@@ -127,3 +138,23 @@ func (l agreementLedger) LookupAgreement(rnd basics.Round, addr basics.Address)
 	}
 	return record, err
 }
+
+// LookupAgreementWeighted wraps the ledger's combined lookup the same way as
+// LookupAgreement, so dropped rounds are reported as LedgerDroppedRoundError.
+func (l agreementLedger) LookupAgreementWeighted(balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+	return l.LookupAgreementWeightedContext(context.Background(), balanceRound, voteRound, addr)
+}
+
+// LookupAgreementWeightedContext is LookupAgreementWeighted, with the weight
+// lookups abandoned once ctx is done.
+func (l agreementLedger) LookupAgreementWeightedContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round, addr basics.Address) (ledgercore.WeightedAgreementData, error) {
+	wd, err := l.Ledger.LookupAgreementWeightedContext(ctx, balanceRound, voteRound, addr)
+	var e *ledger.RoundOffsetError
+	var we *ledgercore.ExternalWeightError
+	if errors.As(err, &e) && !errors.As(err, &we) {
+		err = &agreement.LedgerDroppedRoundError{
+			Err: err,
+		}
+	}
+	return wd, err
+}
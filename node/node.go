@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -48,6 +49,7 @@ import (
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/network/p2p"
@@ -99,6 +101,18 @@ type StatusReport struct {
 	UpgradeDelay                       basics.Round
 	NextProtocolVoteBefore             basics.Round
 	NextProtocolApprovals              basics.Round
+
+	// WeightOracle is the liveness of the external weight daemon, nil when
+	// the node does not use one or does not check its health.
+	WeightOracle *WeightOracleStatus
+
+	// WeightOracleStats counts the requests to the external weight daemon
+	// and the lookups answered from cache, nil when the node does not use
+	// one. WeightOracleLastAnswer is when the daemon last answered, and
+	// WeightOracleIdentity its last identity answer, if any.
+	WeightOracleStats      *weightoracle.QueryStats
+	WeightOracleLastAnswer time.Time
+	WeightOracleIdentity   *ledgercore.DaemonIdentity
 }
 
 // TimeSinceLastRound returns the time since the last block was approved (locally), or 0 if no blocks seen
@@ -162,6 +176,54 @@ type AlgorandFullNode struct {
 	partHandles      []db.Accessor
 
 	heartbeatService *heartbeat.Service
+
+	// weightOracle is the external weight daemon client, set by initializeWeightOracle.
+	weightOracle *weightoracle.Client
+
+	// weightOracleHealth records the outcomes of weightOracleHealthThread's pings,
+	// and weightOracleMonitor the daemon liveness they show; nil when
+	// ExternalWeightOracleHealthCheckInterval is 0.
+	weightOracleHealth  weightoracle.HealthLog
+	weightOracleMonitor *WeightOracleMonitor
+
+	// weightCrossCheckDaemons are the daemons weightCrossCheckThread compares
+	// with weightOracle, and weightParticipationHalted is set while it has
+	// stopped the node's participation over a disagreement.
+	weightCrossCheckDaemons   []crossCheckDaemon
+	weightParticipationHalted atomic.Bool
+
+	// weightIdentityLog records every change in the daemon identity the node
+	// has seen, at startup and in weightOracleHealthThread.
+	weightIdentityLog *weightoracle.IdentityLog
+
+	// weightOverrides are the weights of the ExternalWeightOracleOverrideFile,
+	// nil unless they were in force at startup.
+	weightOverrides *weightoracle.Overrides
+
+	// keyRotations passes the key rotations of committed blocks to
+	// keyRotationThread.
+	keyRotations chan []ledgercore.KeyRotation
+
+	// weightPrefetcher looks up the weights of upcoming rounds as blocks are
+	// committed; nil when ExternalWeightOraclePrefetchRounds is 0.
+	weightPrefetcher *weightoracle.Prefetcher
+
+	// weightSubscriber caches the weight tables the daemon pushes for
+	// upcoming rounds; nil when ExternalWeightOracleSubscribeRounds is 0.
+	weightSubscriber *weightoracle.Subscriber
+
+	// weightImporter imports the daemon's full weight tables of upcoming
+	// rounds; nil when ExternalWeightOracleImportRounds is 0.
+	weightImporter *weightoracle.Importer
+
+	// proposerShareRounds passes committed rounds to proposerShareThread.
+	proposerShareRounds chan basics.Round
+
+	// proposerWeights holds the proposer weights of the recent blocks.
+	proposerWeights proposerWeightLog
+
+	// weightAccounting tracks weight oracle usage per block.
+	weightAccounting weightQueryAccounting
 }
 
 // TxnWithStatus represents information about a single transaction,
@@ -256,6 +318,12 @@ recreateNetwork:
 		DBFilePrefix:        config.LedgerFilenamePrefix,
 		ResolvedGenesisDirs: node.genesisDirs,
 	}
+	// Reach the weight daemon while the ledger opens; the rest of its
+	// validation needs the ledger and the participation keys.
+	weightStartup, err := node.startWeightOracle(rootDir)
+	if err != nil {
+		return nil, err
+	}
 	node.ledger, err = data.LoadLedger(node.log, ledgerPaths, false, genesis.Proto, genalloc, node.genesisID, node.genesisHash, cfg)
 	if err != nil {
 		log.Errorf("Cannot initialize ledger (%v): %v", ledgerPaths, err)
@@ -278,11 +346,25 @@ recreateNetwork:
 	// Validate and configure the external weight oracle before consensus starts.
 	// This ensures the daemon is reachable and compatible before we allow
 	// participation in weighted consensus.
-	if err = node.initializeWeightOracle(); err != nil {
+	if err = node.initializeWeightOracle(weightStartup); err != nil {
 		return nil, err
 	}
 
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
+	node.proposerShareRounds = make(chan basics.Round, proposerShareRoundsQueue)
+	node.keyRotations = make(chan []ledgercore.KeyRotation, keyRotationQueue)
+	if node.weightOracle != nil && cfg.ExternalWeightOraclePrefetchRounds > 0 {
+		node.weightPrefetcher = weightoracle.NewPrefetcher(node.weightOracle, node.weightPrefetchPlan)
+	}
+	if node.weightOracle != nil && cfg.ExternalWeightOracleSubscribeRounds > 0 {
+		node.weightSubscriber = weightoracle.NewSubscriber(node.weightOracle, node.weightSubscribeHorizon)
+	}
+	if node.weightOracle != nil && cfg.ExternalWeightOracleImportRounds > 0 {
+		node.weightImporter = weightoracle.NewImporter(node.weightOracle, node.weightImportHorizon)
+	}
+	if node.weightOracle != nil && cfg.ExternalWeightOracleHealthCheckInterval > 0 {
+		node.weightOracleMonitor = makeWeightOracleMonitor(weightOracleDownAfterFailures, time.Now())
+	}
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log, node)
 
@@ -464,6 +546,47 @@ func (node *AlgorandFullNode) startMonitoringRoutines() {
 	// Delete old participation keys
 	go node.oldKeyDeletionThread(node.ctx.Done())
 
+	if node.weightOracle != nil {
+		node.monitoringRoutinesWaitGroup.Add(1)
+		go node.weightConsistencyThread(node.ctx.Done())
+		if len(node.weightCrossCheckDaemons) > 0 {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightCrossCheckThread(node.ctx.Done())
+		}
+		if node.config.ExternalWeightOracleHealthCheckInterval > 0 {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightOracleHealthThread(node.ctx.Done())
+		}
+		if node.config.ExternalWeightOracleWarmConnections > 0 {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightConnectionWarmThread(node.ctx.Done())
+		}
+		if node.weightOverrides != nil {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightOverrideThread(node.ctx.Done())
+		}
+		node.monitoringRoutinesWaitGroup.Add(1)
+		go node.proposerShareThread(node.ctx.Done())
+		node.monitoringRoutinesWaitGroup.Add(1)
+		go node.keyRotationThread(node.ctx.Done())
+		if node.weightPrefetcher != nil {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightPrefetchThread(node.ctx.Done())
+		}
+		if node.weightSubscriber != nil {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightSubscribeThread(node.ctx.Done())
+		}
+		if node.weightImporter != nil {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightImportThread(node.ctx.Done())
+		}
+		if node.config.ExternalWeightOraclePersistCache {
+			node.monitoringRoutinesWaitGroup.Add(1)
+			go node.weightCacheSaveThread(node.ctx.Done())
+		}
+	}
+
 	if node.config.EnableUsageLog {
 		node.monitoringRoutinesWaitGroup.Add(1)
 		go logging.UsageLogThread(node.ctx, node.log, 100*time.Millisecond, &node.monitoringRoutinesWaitGroup)
@@ -499,6 +622,10 @@ func (node *AlgorandFullNode) Stop() {
 
 		// oldKeyDeletionThread uses accountManager registry so must be stopped before accountManager is closed
 		node.accountManager.Registry().Close()
+		// weightOracleHealthThread appends to the identity log
+		if node.weightIdentityLog != nil {
+			node.weightIdentityLog.Close()
+		}
 		for h := range node.partHandles {
 			node.partHandles[h].Close()
 		}
@@ -790,6 +917,18 @@ func (node *AlgorandFullNode) Status() (StatusReport, error) {
 
 	s.LastRoundTimestamp = lastRoundTimestamp
 	s.HasSyncedSinceStartup = hasSyncedSinceStartup
+	if node.weightOracleMonitor != nil {
+		status := node.weightOracleMonitor.Status()
+		s.WeightOracle = &status
+	}
+	if node.weightOracle != nil {
+		stats := node.weightOracle.Stats()
+		s.WeightOracleStats = &stats
+		s.WeightOracleLastAnswer = node.weightOracle.LastAnswer()
+		if identity, ok := node.weightOracle.LastIdentity(); ok {
+			s.WeightOracleIdentity = &identity
+		}
+	}
 
 	return s, err
 }
@@ -1111,177 +1250,145 @@ func insertStateProofToRegistry(part account.PersistedParticipation, node *Algor
 
 }
 
-// initializeWeightOracle validates and configures the external weight oracle.
-// This function performs the following validation sequence:
-// 1. Validates that ExternalWeightOraclePort is configured (> 0)
-// 2. Creates the oracle client and pings the daemon
-// 3. Validates the daemon's identity (genesis hash, algorithm version, protocol version)
-// 4. Injects the oracle into the ledger
-// 5. Validates that all eligible participation keys have non-zero weight
-func (node *AlgorandFullNode) initializeWeightOracle() error {
-	port := node.config.ExternalWeightOraclePort
-	if port == 0 {
-		return fmt.Errorf("ExternalWeightOraclePort must be configured (required for weighted consensus)")
-	}
-
-	// Create the oracle client
-	oracle := weightoracle.NewClient(port)
-
-	// Ping the daemon to verify it's reachable
-	if err := oracle.Ping(); err != nil {
-		return fmt.Errorf("weight daemon not reachable at port %d: %w", port, err)
-	}
-	node.log.Infof("Weight daemon reachable at port %d", port)
-
-	// Get and validate daemon identity
-	identity, err := oracle.Identity()
-	if err != nil {
-		return fmt.Errorf("weight daemon identity query failed: %w", err)
-	}
-
-	// Validate genesis hash
-	if identity.GenesisHash != node.genesisHash {
-		return fmt.Errorf("weight daemon genesis hash mismatch: got %v, expected %v",
-			identity.GenesisHash, node.genesisHash)
-	}
-
-	// Validate algorithm version
-	if identity.WeightAlgorithmVersion != ledgercore.ExpectedWeightAlgorithmVersion {
-		return fmt.Errorf("weight daemon algorithm version mismatch: got %s, expected %s",
-			identity.WeightAlgorithmVersion, ledgercore.ExpectedWeightAlgorithmVersion)
-	}
-
-	// Validate protocol version
-	if identity.WeightProtocolVersion != ledgercore.ExpectedWeightProtocolVersion {
-		return fmt.Errorf("weight daemon protocol version mismatch: got %s, expected %s",
-			identity.WeightProtocolVersion, ledgercore.ExpectedWeightProtocolVersion)
-	}
-
-	node.log.Infof("Weight daemon identity validated: genesis=%v, algorithm=%s, protocol=%s",
-		identity.GenesisHash, identity.WeightAlgorithmVersion, identity.WeightProtocolVersion)
-
-	// Inject the oracle into the ledger
-	node.ledger.Ledger.SetWeightOracle(oracle)
-
-	// Validate participation key weights
-	if err := node.validateParticipationKeyWeights(oracle); err != nil {
-		return fmt.Errorf("participation key weight validation failed: %w", err)
+// hasParticipationKey reports whether the node holds a participation key for addr.
+func (node *AlgorandFullNode) hasParticipationKey(addr basics.Address) bool {
+	for _, part := range node.accountManager.Registry().GetAll() {
+		if part.Account == addr {
+			return true
+		}
 	}
-
-	return nil
+	return false
 }
 
-// validateParticipationKeyWeights validates that all eligible participation keys
-// have non-zero weight assigned by the external weight daemon.
-// A key is "eligible" if:
-// 1. It's valid for the current vote round (FirstValid <= voteRound <= LastValid)
-// 2. It has a VRF key
-// 3. The account is online in the balance snapshot
-// 4. The key's SelectionID matches the snapshot's SelectionID
-// 5. The key passes key-validity gating (VoteFirstValid/VoteLastValid)
-func (node *AlgorandFullNode) validateParticipationKeyWeights(oracle ledgercore.WeightOracle) error {
-	// Compute the vote round (next round to be agreed upon)
-	voteRound := node.ledger.Latest() + 1
-
-	// Get consensus params for the vote round
-	paramsRound := agreement.ParamsRound(voteRound)
-	cparams, err := node.ledger.ConsensusParams(paramsRound)
-	if err != nil {
-		// If we can't get params, it might be too early in the chain
-		node.log.Warnf("Cannot get consensus params for round %d (params round %d): %v; skipping participation key validation",
-			voteRound, paramsRound, err)
-		return nil
-	}
-
-	// Compute the balance round
-	balanceRound := agreement.BalanceRound(voteRound, cparams)
-
-	// Get all participation records
-	records := node.accountManager.Registry().GetAll()
-	if len(records) == 0 {
-		node.log.Infof("No participation keys registered; skipping weight validation")
-		return nil
-	}
-
-	node.log.Infof("Validating %d participation key(s) for vote round %d (balance round %d)",
-		len(records), voteRound, balanceRound)
-
-	validatedCount := 0
-	skippedCount := 0
+var txPoolGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_tx_pool_count", Description: "current number of available transactions in pool"})
 
-	for _, record := range records {
-		// Skip if key is not valid for this round
-		if voteRound < record.FirstValid || voteRound > record.LastValid {
-			node.log.Debugf("Skipping key %s for account %s: not valid for round %d (valid %d-%d)",
-				record.ParticipationID, record.Account, voteRound, record.FirstValid, record.LastValid)
-			skippedCount++
-			continue
+// weightOracleHealthThread pings the weight daemon every
+// ExternalWeightOracleHealthCheckInterval, feeds the outcomes to
+// weightOracleMonitor and logs its state changes, so that outages show up
+// before they cost the node votes.
+func (node *AlgorandFullNode) weightOracleHealthThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	ticker := time.NewTicker(node.config.ExternalWeightOracleHealthCheckInterval)
+	defer ticker.Stop()
+	// validated by initializeWeightOracle
+	endpoint, _ := node.config.ExternalWeightOracleEndpoint()
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
 		}
 
-		// A nil VRF key indicates a corrupted or malformed participation record.
-		// Under normal operation, all participation keys have VRF secrets generated
-		// during key creation. A nil VRF suggests database corruption or a serious bug.
-		// We fail startup to surface this data integrity issue rather than silently
-		// ignoring the key.
-		if record.VRF == nil {
-			return fmt.Errorf("participation key %s for account %s has nil VRF (corrupted or malformed record)",
-				record.ParticipationID, record.Account)
+		err := node.weightOracle.Ping()
+		now := time.Now()
+		node.weightOracleHealth.Record(now, err)
+		from, to := node.weightOracleMonitor.Record(now, err)
+		switch {
+		case to == from:
+		case to == WeightOracleHealthy:
+			node.log.Infof("weight daemon %s: health check succeeded after earlier failures", to)
+			node.weightOracle.ReportHealth(weightoracle.HealthTransition{Time: now, Healthy: true})
+		case to == WeightOracleDown:
+			node.log.Errorf("weight daemon %s: %d consecutive health checks failed: %v", to, weightOracleDownAfterFailures, err)
+		default:
+			node.log.Warnf("weight daemon %s: health check failed: %v", to, err)
 		}
-
-		// Look up the account in the balance snapshot
-		snapshotData, err := node.ledger.LookupAgreement(balanceRound, record.Account)
-		if err != nil {
-			// Account not online in snapshot, skip
-			node.log.Debugf("Skipping key %s for account %s: not found in balance snapshot at round %d: %v",
-				record.ParticipationID, record.Account, balanceRound, err)
-			skippedCount++
-			continue
+		if from == WeightOracleHealthy && to != WeightOracleHealthy {
+			node.weightOracle.ReportHealth(weightoracle.HealthTransition{Time: now, Error: err.Error()})
 		}
-
-		// Skip if SelectionID doesn't match
-		if snapshotData.SelectionID != record.VRF.PK {
-			node.log.Debugf("Skipping key %s for account %s: SelectionID mismatch (key: %v, snapshot: %v)",
-				record.ParticipationID, record.Account, record.VRF.PK, snapshotData.SelectionID)
-			skippedCount++
-			continue
+		if to != from {
+			status := node.weightOracleMonitor.Status()
+			node.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.WeightOracleStateChangedEvent, telemetryspec.WeightOracleStateChangedEventDetails{
+				From:                from.String(),
+				To:                  to.String(),
+				ConsecutiveFailures: uint64(status.ConsecutiveFailures),
+				Error:               status.LastError,
+			})
 		}
-
-		// Apply key-validity gating per DD §4.11
-		// The key is eligible only if:
-		// - voteRound >= snapshotData.VoteFirstValid
-		// - AND (snapshotData.VoteLastValid == 0 OR voteRound <= snapshotData.VoteLastValid)
-		keyEligible := (voteRound >= snapshotData.VoteFirstValid) &&
-			(snapshotData.VoteLastValid == 0 || voteRound <= snapshotData.VoteLastValid)
-		if !keyEligible {
-			node.log.Debugf("Skipping key %s for account %s: key-validity gating (vote round %d, key valid %d-%d)",
-				record.ParticipationID, record.Account, voteRound, snapshotData.VoteFirstValid, snapshotData.VoteLastValid)
-			skippedCount++
+		if err != nil {
 			continue
 		}
 
-		// Query weight from the oracle
-		weight, err := oracle.Weight(balanceRound, record.Account, snapshotData.SelectionID)
+		identity, err := node.weightOracle.Identity()
 		if err != nil {
-			return fmt.Errorf("failed to query weight for account %s: %w", record.Account, err)
+			node.log.Warnf("weight daemon identity check failed: %v", err)
+			continue
 		}
-
-		if weight == 0 {
-			return fmt.Errorf("participation key %s for account %s has zero weight at balance round %d; "+
-				"this key cannot participate in consensus",
-				record.ParticipationID, record.Account, balanceRound)
+		if node.recordWeightOracleIdentity(endpoint.String(), identity) {
+			cparams, err := node.ledger.ConsensusParams(node.ledger.Latest())
+			if err != nil {
+				node.log.Warnf("cannot determine consensus parameters to check the weight daemon identity: %v", err)
+				continue
+			}
+			if err := ledgercore.ValidateIdentity(identity, node.genesisHash, weightVersionPolicy(node.config, cparams)); err != nil {
+				node.log.Errorf("weight daemon identity changed and is no longer valid: %v", err)
+				var mismatch *ledgercore.IdentityMismatchError
+				if errors.As(err, &mismatch) {
+					node.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.WeightOracleIdentityMismatchEvent, telemetryspec.WeightOracleIdentityMismatchEventDetails{
+						Field:    string(mismatch.Field),
+						Got:      mismatch.Got,
+						Expected: mismatch.Expected,
+					})
+				}
+			}
 		}
+	}
+}
 
-		node.log.Infof("Validated participation key %s for account %s: weight=%d",
-			record.ParticipationID, record.Account, weight)
-		validatedCount++
+// recordWeightOracleIdentity appends identity to the identity log if it
+// differs from the last one recorded, and reports whether it did.
+func (node *AlgorandFullNode) recordWeightOracleIdentity(daemon string, identity ledgercore.DaemonIdentity) bool {
+	entry, err := node.weightIdentityLog.Observe(time.Now(), daemon, identity)
+	if err != nil {
+		node.log.Errorf("cannot record weight daemon identity: %v", err)
+		return false
 	}
+	if entry == nil {
+		return false
+	}
+	if entry.Seq == 0 {
+		node.log.Infof("weight daemon identity recorded in new identity log: daemon=%s genesis=%s algorithm=%s protocol=%s",
+			entry.Daemon, entry.GenesisHash, entry.AlgorithmVersion, entry.ProtocolVersion)
+		return true
+	}
+	node.log.Warnf("weight daemon identity changed: daemon=%s genesis=%s algorithm=%s protocol=%s (identity log entry %d, chain hash %s)",
+		entry.Daemon, entry.GenesisHash, entry.AlgorithmVersion, entry.ProtocolVersion, entry.Seq, node.weightIdentityLog.LastHash())
+	return true
+}
 
-	node.log.Infof("Participation key validation complete: %d validated, %d skipped (not eligible)",
-		validatedCount, skippedCount)
+// RegisterWeightOracleObserver registers o to receive the events of the
+// node's weight daemon client: its queries, cache decisions and, with
+// ExternalWeightOracleHealthCheckInterval set, health transitions. It fails
+// when the node does not use an external daemon.
+func (node *AlgorandFullNode) RegisterWeightOracleObserver(o weightoracle.Observer) error {
+	if node.weightOracle == nil {
+		return fmt.Errorf("node does not use an external weight daemon")
+	}
+	node.weightOracle.AddObserver(o)
 	return nil
 }
 
-var txPoolGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_tx_pool_count", Description: "current number of available transactions in pool"})
+// ExportWeightCache returns the weight daemon answers the node has cached for
+// balance rounds from since on, to seed a replacement daemon with. It fails
+// when the node does not use an external daemon.
+func (node *AlgorandFullNode) ExportWeightCache(since basics.Round) (weightoracle.CacheExport, error) {
+	if node.weightOracle == nil {
+		return weightoracle.CacheExport{}, fmt.Errorf("node does not use an external weight daemon")
+	}
+	return node.weightOracle.ExportCache(since), nil
+}
+
+// WeightOracleDiagnostics gathers the state of the node's weight daemon client
+// for a bug report. It fails when the node does not use an external daemon.
+func (node *AlgorandFullNode) WeightOracleDiagnostics() (*weightoracle.Diagnostics, error) {
+	if node.weightOracle == nil {
+		return nil, fmt.Errorf("node does not use an external weight daemon")
+	}
+	d := node.weightOracle.MakeDiagnostics(node.config)
+	d.Version = config.GetCurrentVersion().String()
+	d.HealthChecks = node.weightOracleHealth.Snapshot()
+	return d, nil
+}
 
 func (node *AlgorandFullNode) txPoolGaugeThread(done <-chan struct{}) {
 	defer node.monitoringRoutinesWaitGroup.Done()
@@ -1307,6 +1414,31 @@ func (node *AlgorandFullNode) OnNewBlock(block bookkeeping.Block, delta ledgerco
 	node.hasSyncedSinceStartup = true
 	node.syncStatusMu.Unlock()
 
+	if node.weightOracle != nil {
+		if node.weightPrefetcher != nil {
+			node.weightPrefetcher.Advance(block.Round())
+		}
+		if node.weightSubscriber != nil {
+			node.weightSubscriber.Advance(block.Round())
+		}
+		if node.weightImporter != nil {
+			node.weightImporter.Advance(block.Round())
+		}
+		node.weightAccounting.blockDone(block.Round(), node.weightOracle.Stats(), node.log)
+		node.evictWeightCache(block.Round())
+		select {
+		case node.proposerShareRounds <- block.Round():
+		default:
+		}
+		if rotations := blockKeyRotations(block, delta); len(rotations) > 0 {
+			select {
+			case node.keyRotations <- rotations:
+			default:
+				node.log.Warnf("weight daemon not told of %d key rotations in round %d: notifications are backed up", len(rotations), block.Round())
+			}
+		}
+	}
+
 	// Wake up oldKeyDeletionThread(), non-blocking.
 	select {
 	case node.oldKeyDeletionNotify <- struct{}{}:
@@ -1579,6 +1711,14 @@ func (node *AlgorandFullNode) VotingKeys(votingRound, keysRound basics.Round) []
 	if node.devMode {
 		return []account.ParticipationRecordForRound{}
 	}
+	// the weight daemons disagree, so our weights may be wrong (see weightCrossCheckThread).
+	if node.weightParticipationHalted.Load() {
+		return []account.ParticipationRecordForRound{}
+	}
+	// the weight daemon is unreachable and our weights are those of earlier rounds.
+	if node.config.ExternalWeightOracleFailureMode == config.WeightOracleFailureObserveOnly && node.weightOracle != nil && node.weightOracle.Stale() {
+		return []account.ParticipationRecordForRound{}
+	}
 
 	parts := node.accountManager.Keys(votingRound)
 	participations := make([]account.ParticipationRecordForRound, 0, len(parts))
@@ -44,6 +44,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/p2p"
+	"github.com/algorand/go-algorand/node/weightoracle/weightoracletest"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -129,8 +130,7 @@ func setupFullNodesEx(
 	util.SetFdSoftLimit(1000)
 
 	// Start a shared mock weight server for all nodes
-	mockWeightSrv := newMockWeightServer(t)
-	t.Cleanup(func() { mockWeightSrv.Close() })
+	mockWeightSrv := weightoracletest.NewDaemon(t)
 
 	if lp == nil {
 		lp = &singleFileFullNodeLoggerProvider{t: t}
@@ -169,7 +169,7 @@ func setupFullNodesEx(
 		ni, cfg := configHook(nodeInfos[i], defaultConfig)
 		nodeInfos[i] = ni
 		// Configure the weight oracle port to use our shared mock server
-		cfg.ExternalWeightOraclePort = mockWeightSrv.port
+		cfg.ExternalWeightOraclePort = mockWeightSrv.Port()
 		cfg.SaveToDisk(rootDirectory)
 
 		t.Logf("Root directory of node %d (%s): %s\n", i, ni.wsNetAddr(), rootDirectory)
@@ -250,19 +250,16 @@ func setupFullNodesEx(
 	// Set the mock weight server's genesis hash to match the test genesis (after allocations)
 	// Configure weights proportional to stake. Only accounts with stake (and thus participation
 	// keys) get non-zero weight. Total weight is the sum of all account weights.
-	mockWeightSrv.genesisHash = g.Hash()
-	mockWeightSrv.mu.Lock()
+	mockWeightSrv.SetGenesisHash(g.Hash())
 	var totalWeight uint64
 	for addr, data := range genesis {
 		if data.MicroAlgos.Raw > 0 && data.Status == basics.Online {
 			// Weight = stake for Online accounts
-			mockWeightSrv.weights[addr.String()] = data.MicroAlgos.Raw
+			mockWeightSrv.SetWeight(addr, data.MicroAlgos.Raw)
 			totalWeight += data.MicroAlgos.Raw
 		}
 	}
-	mockWeightSrv.totalWeight = totalWeight
-	mockWeightSrv.defaultWeight = 0 // Accounts without explicit weight get 0
-	mockWeightSrv.mu.Unlock()
+	mockWeightSrv.SetTotal(totalWeight) // Accounts without explicit weight keep the default of 0
 
 	nodes := make([]*AlgorandFullNode, numAccounts)
 	for i := range nodes {
@@ -684,13 +681,11 @@ func TestDefaultResourcePaths(t *testing.T) {
 	}
 
 	// Set up mock weight server
-	mockWeightSrv := newMockWeightServer(t)
-	mockWeightSrv.genesisHash = genesis.Hash()
-	mockWeightSrv.defaultWeight = 1000000
-	t.Cleanup(func() { mockWeightSrv.Close() })
+	mockWeightSrv := weightoracletest.NewDaemon(t)
+	mockWeightSrv.SetGenesisHash(genesis.Hash()).SetDefaultWeight(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = mockWeightSrv.port
+	cfg.ExternalWeightOraclePort = mockWeightSrv.Port()
 
 	// the logger is set up by the server, so we don't test this here
 	log := logging.Base()
@@ -735,13 +730,11 @@ func TestConfiguredDataDirs(t *testing.T) {
 	}
 
 	// Set up mock weight server
-	mockWeightSrv := newMockWeightServer(t)
-	mockWeightSrv.genesisHash = genesis.Hash()
-	mockWeightSrv.defaultWeight = 1000000
-	t.Cleanup(func() { mockWeightSrv.Close() })
+	mockWeightSrv := weightoracletest.NewDaemon(t)
+	mockWeightSrv.SetGenesisHash(genesis.Hash()).SetDefaultWeight(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = mockWeightSrv.port
+	cfg.ExternalWeightOraclePort = mockWeightSrv.Port()
 
 	cfg.HotDataDir = testDirHot
 	cfg.ColdDataDir = testDirCold
@@ -802,13 +795,11 @@ func TestConfiguredResourcePaths(t *testing.T) {
 	}
 
 	// Set up mock weight server
-	mockWeightSrv := newMockWeightServer(t)
-	mockWeightSrv.genesisHash = genesis.Hash()
-	mockWeightSrv.defaultWeight = 1000000
-	t.Cleanup(func() { mockWeightSrv.Close() })
+	mockWeightSrv := weightoracletest.NewDaemon(t)
+	mockWeightSrv.SetGenesisHash(genesis.Hash()).SetDefaultWeight(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = mockWeightSrv.port
+	cfg.ExternalWeightOraclePort = mockWeightSrv.Port()
 
 	cfg.HotDataDir = testDirHot
 	cfg.ColdDataDir = testDirCold
@@ -1218,11 +1209,9 @@ func TestNodeSetCatchpointCatchupMode(t *testing.T) {
 	cfg := config.GetDefaultLocal()
 
 	// Set up mock weight server
-	mockWeightSrv := newMockWeightServer(t)
-	mockWeightSrv.genesisHash = genesis.Hash()
-	mockWeightSrv.defaultWeight = 1000000
-	t.Cleanup(func() { mockWeightSrv.Close() })
-	cfg.ExternalWeightOraclePort = mockWeightSrv.port
+	mockWeightSrv := weightoracletest.NewDaemon(t)
+	mockWeightSrv.SetGenesisHash(genesis.Hash()).SetDefaultWeight(1000000)
+	cfg.ExternalWeightOraclePort = mockWeightSrv.Port()
 
 	tests := []struct {
 		name      string
@@ -1508,15 +1497,13 @@ func TestNodeMakeFullHybrid(t *testing.T) {
 	log.SetOutput(&buf)
 
 	// Set up mock weight server
-	mockWeightSrv := newMockWeightServer(t)
-	mockWeightSrv.genesisHash = genesis.Hash()
-	mockWeightSrv.defaultWeight = 1000000
-	t.Cleanup(func() { mockWeightSrv.Close() })
+	mockWeightSrv := weightoracletest.NewDaemon(t)
+	mockWeightSrv.SetGenesisHash(genesis.Hash()).SetDefaultWeight(1000000)
 
 	cfg := config.GetDefaultLocal()
 	cfg.EnableP2PHybridMode = true
 	cfg.NetAddress = ":0"
-	cfg.ExternalWeightOraclePort = mockWeightSrv.port
+	cfg.ExternalWeightOraclePort = mockWeightSrv.Port()
 
 	node, err := MakeFull(log, testDirectory, cfg, []string{}, genesis)
 	require.NoError(t, err)
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"math"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+const (
+	// proposerShareWindow is the number of recent blocks whose proposers
+	// are compared with their weight shares.
+	proposerShareWindow = 1000
+
+	// proposerShareMinExpected is the fewest blocks an account must be
+	// expected to propose in the window for its share to be judged; fewer
+	// are too noisy.
+	proposerShareMinExpected = 10
+
+	// proposerShareSigmas is how many standard deviations a proposer's count
+	// must be from its expected count to deviate.
+	proposerShareSigmas = 4
+
+	// proposerShareSustain is how many consecutive blocks a proposer must
+	// deviate for before it is flagged, so that a lucky or unlucky streak
+	// the window is about to forget is not.
+	proposerShareSustain = 100
+)
+
+var proposerShareMaxDeviationGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_weight_proposer_share_max_deviation_percent", Description: "largest difference, among accounts judged, between the blocks an account proposed recently and the blocks its weight share predicts, in percent of the prediction"})
+var proposerShareDeviatingGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_weight_proposer_share_deviating_accounts", Description: "accounts currently proposing a share of blocks far from their weight share"})
+var proposerShareFlagged = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_proposer_share_deviation_total", Description: "number of sustained deviations of a proposer's share of blocks from its weight share"})
+
+// proposerShareDeviation is a proposer flagged by proposerShares.
+type proposerShareDeviation struct {
+	addr     basics.Address
+	proposed int
+	expected float64
+	sigmas   float64
+}
+
+// proposerShares keeps rolling statistics of who proposed the recent blocks,
+// against what their weight shares predict: an account with share s of the
+// total weight should propose about s of the blocks. Sustained deviations
+// suggest that weight daemons disagree or that proposer selection is broken.
+// It is the production counterpart of the weighted consensus e2e test's
+// ratio check.
+type proposerShares struct {
+	// window holds the proposers of the last proposerShareWindow blocks
+	// recorded, as a ring whose oldest entry is at next once full.
+	window []basics.Address
+	next   int
+
+	// counts is how many blocks of the window each account proposed, share
+	// its weight share when it last proposed, and deviating for how many
+	// consecutive blocks it has deviated.
+	counts    map[basics.Address]int
+	share     map[basics.Address]float64
+	deviating map[basics.Address]int
+}
+
+func makeProposerShares() proposerShares {
+	return proposerShares{
+		counts:    make(map[basics.Address]int),
+		share:     make(map[basics.Address]float64),
+		deviating: make(map[basics.Address]int),
+	}
+}
+
+// add records the proposer of a block and returns the proposers that have
+// now deviated for proposerShareSustain blocks. Shares are judged once the
+// window is full.
+func (s *proposerShares) add(pw ProposerWeight) []proposerShareDeviation {
+	if pw.TotalWeight == 0 {
+		return nil
+	}
+	if len(s.window) < proposerShareWindow {
+		s.window = append(s.window, pw.Proposer)
+	} else {
+		old := s.window[s.next]
+		s.window[s.next] = pw.Proposer
+		s.next = (s.next + 1) % proposerShareWindow
+		if s.counts[old]--; s.counts[old] == 0 {
+			delete(s.counts, old)
+			delete(s.share, old)
+			delete(s.deviating, old)
+		}
+	}
+	s.counts[pw.Proposer]++
+	s.share[pw.Proposer] = float64(pw.Weight) / float64(pw.TotalWeight)
+	if len(s.window) < proposerShareWindow {
+		return nil
+	}
+
+	var flagged []proposerShareDeviation
+	var maxDeviation float64
+	blocks := float64(len(s.window))
+	for addr, proposed := range s.counts {
+		share := s.share[addr]
+		expected := share * blocks
+		if expected < proposerShareMinExpected || share >= 1 {
+			delete(s.deviating, addr)
+			continue
+		}
+		diff := float64(proposed) - expected
+		maxDeviation = max(maxDeviation, math.Abs(diff)/expected)
+		sigmas := diff / math.Sqrt(expected*(1-share))
+		if math.Abs(sigmas) < proposerShareSigmas {
+			delete(s.deviating, addr)
+			continue
+		}
+		if s.deviating[addr]++; s.deviating[addr] == proposerShareSustain {
+			flagged = append(flagged, proposerShareDeviation{addr: addr, proposed: proposed, expected: expected, sigmas: sigmas})
+		}
+	}
+	proposerShareMaxDeviationGauge.Set(uint64(math.Round(maxDeviation * 100)))
+	proposerShareDeviatingGauge.Set(uint64(len(s.deviating)))
+	return flagged
+}
+
+// proposerShareRoundsQueue bounds the committed rounds waiting for
+// proposerShareThread; rounds committed faster, as in catchup, are skipped.
+const proposerShareRoundsQueue = 64
+
+// proposerShareThread records the proposer weights of the blocks OnNewBlock
+// hands it, tracks their proposer shares, and reports sustained deviations
+// from the weight shares.
+func (node *AlgorandFullNode) proposerShareThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	shares := makeProposerShares()
+	for {
+		var rnd basics.Round
+		select {
+		case rnd = <-node.proposerShareRounds:
+		case <-done:
+			return
+		}
+		pw, err := blockProposerWeight(node.ledger, rnd)
+		if err != nil {
+			node.log.Debugf("proposer share not recorded for round %d: %v", rnd, err)
+			continue
+		}
+		node.proposerWeights.record(pw)
+		for _, d := range shares.add(pw) {
+			logProposerShareDeviation(node.log, rnd, d)
+		}
+	}
+}
+
+func logProposerShareDeviation(log logging.Logger, rnd basics.Round, d proposerShareDeviation) {
+	proposerShareFlagged.Inc(nil)
+	log.Warnf("account %s proposed %d of the last %d blocks up to round %d, but its weight share predicts %.1f (%.1f standard deviations); "+
+		"weight daemons may disagree or proposer selection may be broken",
+		d.addr, d.proposed, proposerShareWindow, rnd, d.expected, d.sigmas)
+	log.EventWithDetails(telemetryspec.Agreement, telemetryspec.ProposerShareDeviationEvent, telemetryspec.ProposerShareDeviationEventDetails{
+		Address:  d.addr.String(),
+		Round:    uint64(rnd),
+		Blocks:   proposerShareWindow,
+		Proposed: uint64(d.proposed),
+		Expected: d.expected,
+		Sigmas:   d.sigmas,
+	})
+}
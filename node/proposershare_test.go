@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProposerSharesBalanced(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a, b := basics.Address{1}, basics.Address{2}
+	s := makeProposerShares()
+	for i := 0; i < 3*proposerShareWindow; i++ {
+		p := a
+		if i%4 == 3 {
+			p = b
+		}
+		// a holds 3/4 of the weight and proposes 3 of every 4 blocks.
+		w := uint64(300)
+		if p == b {
+			w = 100
+		}
+		require.Empty(t, s.add(ProposerWeight{Round: basics.Round(i), Proposer: p, Weight: w, TotalWeight: 400}))
+	}
+	require.Equal(t, proposerShareWindow, s.counts[a]+s.counts[b])
+	require.Empty(t, s.deviating)
+}
+
+func TestProposerSharesSustainedDeviation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a, b := basics.Address{1}, basics.Address{2}
+	s := makeProposerShares()
+	var flagged []proposerShareDeviation
+	rounds := 0
+	// a and b have equal weight, but a proposes 9 of every 10 blocks.
+	for ; rounds < proposerShareWindow+2*proposerShareSustain; rounds++ {
+		p := a
+		if rounds%10 == 9 {
+			p = b
+		}
+		flagged = append(flagged, s.add(ProposerWeight{Round: basics.Round(rounds), Proposer: p, Weight: 100, TotalWeight: 200})...)
+	}
+	// Each is flagged once, after deviating for proposerShareSustain blocks.
+	require.Len(t, flagged, 2)
+	for _, d := range flagged {
+		require.InDelta(t, proposerShareWindow/2, d.expected, 1e-9)
+		if d.addr == a {
+			require.Equal(t, 900, d.proposed)
+			require.Greater(t, d.sigmas, float64(proposerShareSigmas))
+		} else {
+			require.Equal(t, b, d.addr)
+			require.Equal(t, 100, d.proposed)
+			require.Less(t, d.sigmas, -float64(proposerShareSigmas))
+		}
+	}
+}
+
+func TestProposerSharesSmallShareIgnored(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a, b := basics.Address{1}, basics.Address{2}
+	s := makeProposerShares()
+	// b is expected to propose 5 blocks of the window: too few to judge,
+	// however many it proposes.
+	for i := 0; i < proposerShareWindow+2*proposerShareSustain; i++ {
+		pw := ProposerWeight{Proposer: a, Weight: 995, TotalWeight: 1000}
+		if i%2 == 0 {
+			pw = ProposerWeight{Proposer: b, Weight: 5, TotalWeight: 1000}
+		}
+		for _, d := range s.add(pw) {
+			require.NotEqual(t, b, d.addr)
+		}
+	}
+	_, judged := s.deviating[b]
+	require.False(t, judged)
+}
@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// ProposerWeight is the external weight the proposer of a block had when the
+// block was agreed upon.
+type ProposerWeight struct {
+	Round        basics.Round   `json:"round"`
+	Proposer     basics.Address `json:"proposer"`
+	BalanceRound basics.Round   `json:"balance-round"`
+	Weight       uint64         `json:"weight"`
+	TotalWeight  uint64         `json:"total-weight"`
+}
+
+// ErrProposerWeightUnavailable is returned, wrapped, when the node cannot tell
+// the weight of a block's proposer at all, as opposed to failing to reach the
+// weight oracle.
+var ErrProposerWeightUnavailable = errors.New("proposer weight unavailable")
+
+// proposerWeightHistory is the number of recent blocks whose proposer weights
+// are kept.
+const proposerWeightHistory = proposerShareWindow
+
+// proposerWeightLog keeps the proposer weights of the recent blocks, looked up
+// as each block is committed. Agreement has just weighed the proposer at the
+// same balance round, and the weight cache keeps its answers for
+// weightCacheEvictionLag rounds after, so the weight kept is the one agreement
+// used, whatever the daemon, the overrides or the cache answer later. The log
+// lives in memory only: it starts empty each time the node starts, and get
+// says which of those limits a round it has no weight for falls under.
+type proposerWeightLog struct {
+	mu      deadlock.Mutex
+	weights [proposerWeightHistory]ProposerWeight
+	// first and last are the first and the last rounds recorded since the
+	// node started, or zero before any is.
+	first basics.Round
+	last  basics.Round
+}
+
+// record keeps the proposer weight of block pw.Round, replacing that of the
+// block proposerWeightHistory rounds before.
+func (l *proposerWeightLog) record(pw ProposerWeight) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.weights[pw.Round%proposerWeightHistory] = pw
+	if l.first == 0 || pw.Round < l.first {
+		l.first = pw.Round
+	}
+	if pw.Round > l.last {
+		l.last = pw.Round
+	}
+}
+
+// get returns the proposer weight kept for block rnd.
+func (l *proposerWeightLog) get(rnd basics.Round) (ProposerWeight, error) {
+	l.mu.Lock()
+	pw := l.weights[rnd%proposerWeightHistory]
+	first, last := l.first, l.last
+	l.mu.Unlock()
+	if rnd != 0 && pw.Round == rnd {
+		return pw, nil
+	}
+	switch {
+	case last == 0:
+		return ProposerWeight{}, fmt.Errorf("%w: no proposer weight has been recorded since the node started",
+			ErrProposerWeightUnavailable)
+	case rnd > last:
+		return ProposerWeight{}, fmt.Errorf("%w: round %d is past round %d, the last one whose proposer weight was recorded",
+			ErrProposerWeightUnavailable, rnd, last)
+	case rnd+proposerWeightHistory <= last:
+		return ProposerWeight{}, fmt.Errorf("%w: round %d is older than the last %d rounds, %d to %d, whose proposer weights the node keeps",
+			ErrProposerWeightUnavailable, rnd, proposerWeightHistory, last-proposerWeightHistory+1, last)
+	case rnd < first:
+		return ProposerWeight{}, fmt.Errorf("%w: round %d was committed before the node last started; proposer weights are recorded from round %d on",
+			ErrProposerWeightUnavailable, rnd, first)
+	default:
+		return ProposerWeight{}, fmt.Errorf("%w: no proposer weight was recorded for round %d; it was committed without a weight oracle answer or skipped while catching up",
+			ErrProposerWeightUnavailable, rnd)
+	}
+}
+
+// blockProposerWeight looks up the weight of the proposer of block rnd: the
+// original proposer named by the block's certificate, weighed at the balance
+// round agreement used for rnd. It is meant to be called as the block is
+// committed, for proposerWeightLog; the ledger keeps the online account
+// history this needs only for the most recent rounds, and the weight oracle
+// answers for the balance rounds it still serves.
+func blockProposerWeight(l *data.Ledger, rnd basics.Round) (ProposerWeight, error) {
+	if l.WeightOracle() == nil {
+		return ProposerWeight{}, fmt.Errorf("%w: no weight oracle configured", ErrProposerWeightUnavailable)
+	}
+	params, err := l.ConsensusParams(agreement.ParamsRound(rnd))
+	if err != nil {
+		return ProposerWeight{}, fmt.Errorf("%w: %v", ErrProposerWeightUnavailable, err)
+	}
+	if !params.EnableExternalWeightOracle {
+		return ProposerWeight{}, fmt.Errorf("%w: round %d does not use external weights", ErrProposerWeightUnavailable, rnd)
+	}
+	_, cert, err := l.BlockCert(rnd)
+	if err != nil {
+		return ProposerWeight{}, fmt.Errorf("%w: %v", ErrProposerWeightUnavailable, err)
+	}
+	if rnd == 0 || cert.Round != rnd {
+		return ProposerWeight{}, fmt.Errorf("%w: no certificate for round %d", ErrProposerWeightUnavailable, rnd)
+	}
+
+	pw := ProposerWeight{
+		Round:        rnd,
+		Proposer:     cert.Proposal.OriginalProposer,
+		BalanceRound: agreement.BalanceRound(rnd, params),
+	}
+	acct, err := l.LookupAgreement(pw.BalanceRound, pw.Proposer)
+	if err != nil {
+		return ProposerWeight{}, fmt.Errorf("%w: %v", ErrProposerWeightUnavailable, err)
+	}
+	pw.Weight, err = l.ExternalWeight(pw.BalanceRound, pw.Proposer, acct.SelectionID)
+	if err != nil {
+		return ProposerWeight{}, err
+	}
+	pw.TotalWeight, err = l.TotalExternalWeight(pw.BalanceRound, rnd)
+	if err != nil {
+		return ProposerWeight{}, err
+	}
+	return pw, nil
+}
+
+// BlockProposerWeight returns the weight the proposer of block rnd had, as
+// recorded when the block was committed.
+func (node *AlgorandFullNode) BlockProposerWeight(rnd basics.Round) (ProposerWeight, error) {
+	return node.proposerWeights.get(rnd)
+}
+
+// BlockProposerWeight returns the weight the proposer of block rnd had, as
+// recorded when the block was committed, if the follower has a weight oracle.
+func (node *AlgorandFollowerNode) BlockProposerWeight(rnd basics.Round) (ProposerWeight, error) {
+	return node.proposerWeights.get(rnd)
+}
+
+// proposerWeightThread records the proposer weights of the blocks OnNewBlock
+// hands it, as proposerShareThread does for a full node.
+func (node *AlgorandFollowerNode) proposerWeightThread(done <-chan struct{}) {
+	defer node.proposerWeightWaitGroup.Done()
+	for {
+		var rnd basics.Round
+		select {
+		case rnd = <-node.proposerWeightRounds:
+		case <-done:
+			return
+		}
+		pw, err := blockProposerWeight(node.ledger, rnd)
+		if err != nil {
+			node.log.Debugf("proposer weight not recorded for round %d: %v", rnd, err)
+			continue
+		}
+		node.proposerWeights.record(pw)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockProposerWeight(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	node := setupFollowNode(t)
+	b := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: 1}}
+	b.CurrentProtocol = protocol.ConsensusCurrentVersion
+	cert := agreement.Certificate{Round: 1}
+	cert.Proposal.OriginalProposer = testAddr
+	require.NoError(t, node.Ledger().AddBlock(b, cert))
+
+	pw, err := blockProposerWeight(node.ledger, 1)
+	require.NoError(t, err)
+	require.Equal(t, ProposerWeight{
+		Round:        1,
+		Proposer:     testAddr,
+		BalanceRound: 0,
+		Weight:       1000000,
+		TotalWeight:  1000000,
+	}, pw)
+
+	// The genesis block has no certificate, and later blocks do not exist yet.
+	_, err = blockProposerWeight(node.ledger, 0)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	_, err = blockProposerWeight(node.ledger, 2)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+
+	// The node answers with the weight recorded when the block was
+	// committed, even once the oracle can no longer be asked.
+	_, err = node.BlockProposerWeight(1)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	require.ErrorContains(t, err, "since the node started")
+	node.proposerWeights.record(pw)
+	node.ledger.Ledger.SetWeightOracle(nil)
+	_, err = blockProposerWeight(node.ledger, 1)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	got, err := node.BlockProposerWeight(1)
+	require.NoError(t, err)
+	require.Equal(t, pw, got)
+
+	_, err = node.BlockProposerWeight(2)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	require.ErrorContains(t, err, "the last one whose proposer weight was recorded")
+
+	// Only the last proposerWeightHistory blocks are kept.
+	later := pw
+	later.Round += proposerWeightHistory
+	node.proposerWeights.record(later)
+	_, err = node.BlockProposerWeight(1)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	require.ErrorContains(t, err, "older than the last 1000 rounds")
+	_, err = node.BlockProposerWeight(0)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	_, err = node.BlockProposerWeight(later.Round - 1)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	require.ErrorContains(t, err, "without a weight oracle answer")
+}
+
+// TestProposerWeightLogRestart checks that a log started after some blocks
+// were committed says so for those blocks, rather than only missing them.
+func TestProposerWeightLogRestart(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var l proposerWeightLog
+	l.record(ProposerWeight{Round: 500, Proposer: testAddr, Weight: 1, TotalWeight: 2})
+	l.record(ProposerWeight{Round: 501, Proposer: testAddr, Weight: 1, TotalWeight: 2})
+
+	pw, err := l.get(501)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(501), pw.Round)
+	_, err = l.get(499)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+	require.ErrorContains(t, err, "before the node last started")
+	_, err = l.get(502)
+	require.ErrorIs(t, err, ErrProposerWeightUnavailable)
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// ExternalWeightLookup is the external weight the node's weight oracle gives
+// an account for selecting the committees of a round. Eligible is false, and
+// the weight zero, if the account cannot vote in the round. SelectionID is the
+// selection key the account is weighed under.
+type ExternalWeightLookup struct {
+	Round        basics.Round   `json:"round"`
+	Address      basics.Address `json:"address"`
+	BalanceRound basics.Round   `json:"balance-round"`
+	Eligible     bool           `json:"eligible"`
+	SelectionID  []byte         `json:"selection-id,omitempty"`
+	Weight       uint64         `json:"weight"`
+}
+
+// TotalExternalWeightLookup is the total external weight the node's weight
+// oracle gives for a balance round and vote round.
+type TotalExternalWeightLookup struct {
+	BalanceRound basics.Round `json:"balance-round"`
+	VoteRound    basics.Round `json:"vote-round"`
+	TotalWeight  uint64       `json:"total-weight"`
+}
+
+// ErrExternalWeightUnavailable is returned, wrapped, when the node cannot look
+// up an external weight at all, as opposed to failing to reach the weight
+// oracle.
+var ErrExternalWeightUnavailable = errors.New("external weight unavailable")
+
+// lookupExternalWeight looks up the weight of addr for selecting the
+// committees of round rnd, or of the round after the latest if rnd is 0: its
+// weight under the selection key it had registered at the balance round
+// agreement uses for rnd, or zero if it had none covering rnd.
+func lookupExternalWeight(l *data.Ledger, rnd basics.Round, addr basics.Address) (ExternalWeightLookup, error) {
+	if l.WeightOracle() == nil {
+		return ExternalWeightLookup{}, fmt.Errorf("%w: no weight oracle configured", ErrExternalWeightUnavailable)
+	}
+	if rnd == 0 {
+		rnd = l.Latest() + 1
+	}
+	params, err := l.ConsensusParams(agreement.ParamsRound(rnd))
+	if err != nil {
+		return ExternalWeightLookup{}, fmt.Errorf("%w: %v", ErrExternalWeightUnavailable, err)
+	}
+	if !params.EnableExternalWeightOracle {
+		return ExternalWeightLookup{}, fmt.Errorf("%w: round %d does not use external weights", ErrExternalWeightUnavailable, rnd)
+	}
+
+	w := ExternalWeightLookup{
+		Round:        rnd,
+		Address:      addr,
+		BalanceRound: agreement.BalanceRound(rnd, params),
+	}
+	acct, err := l.LookupAgreement(w.BalanceRound, addr)
+	if err != nil {
+		return ExternalWeightLookup{}, fmt.Errorf("%w: %v", ErrExternalWeightUnavailable, err)
+	}
+	if acct.VoteID.IsEmpty() || !ledgercore.VoteKeyEligible(acct, rnd) {
+		return w, nil
+	}
+	w.Eligible = true
+	w.SelectionID = acct.SelectionID[:]
+	w.Weight, err = l.ExternalWeight(w.BalanceRound, addr, acct.SelectionID)
+	if err != nil {
+		return ExternalWeightLookup{}, err
+	}
+	return w, nil
+}
+
+// lookupTotalExternalWeight looks up the total weight for balanceRound and
+// voteRound.
+func lookupTotalExternalWeight(l *data.Ledger, balanceRound, voteRound basics.Round) (TotalExternalWeightLookup, error) {
+	if l.WeightOracle() == nil {
+		return TotalExternalWeightLookup{}, fmt.Errorf("%w: no weight oracle configured", ErrExternalWeightUnavailable)
+	}
+	total, err := l.TotalExternalWeight(balanceRound, voteRound)
+	if err != nil {
+		return TotalExternalWeightLookup{}, err
+	}
+	return TotalExternalWeightLookup{BalanceRound: balanceRound, VoteRound: voteRound, TotalWeight: total}, nil
+}
+
+// LookupExternalWeight returns the weight of addr for round rnd, or for the
+// round after the latest if rnd is 0.
+func (node *AlgorandFullNode) LookupExternalWeight(rnd basics.Round, addr basics.Address) (ExternalWeightLookup, error) {
+	return lookupExternalWeight(node.ledger, rnd, addr)
+}
+
+// LookupTotalExternalWeight returns the total weight for balanceRound and
+// voteRound.
+func (node *AlgorandFullNode) LookupTotalExternalWeight(balanceRound, voteRound basics.Round) (TotalExternalWeightLookup, error) {
+	return lookupTotalExternalWeight(node.ledger, balanceRound, voteRound)
+}
+
+// LookupExternalWeight returns the weight of addr for round rnd, or for the
+// round after the latest if rnd is 0, if the follower has a weight oracle.
+func (node *AlgorandFollowerNode) LookupExternalWeight(rnd basics.Round, addr basics.Address) (ExternalWeightLookup, error) {
+	return lookupExternalWeight(node.ledger, rnd, addr)
+}
+
+// LookupTotalExternalWeight returns the total weight for balanceRound and
+// voteRound, if the follower has a weight oracle.
+func (node *AlgorandFollowerNode) LookupTotalExternalWeight(balanceRound, voteRound basics.Round) (TotalExternalWeightLookup, error) {
+	return lookupTotalExternalWeight(node.ledger, balanceRound, voteRound)
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLookupExternalWeight(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	node := setupFollowNode(t)
+
+	// The genesis accounts are offline, so they have no weight to look up.
+	w, err := node.LookupExternalWeight(0, testAddr)
+	require.NoError(t, err)
+	require.Equal(t, ExternalWeightLookup{Round: 1, Address: testAddr}, w)
+
+	total, err := node.LookupTotalExternalWeight(0, 320)
+	require.NoError(t, err)
+	require.Equal(t, TotalExternalWeightLookup{BalanceRound: 0, VoteRound: 320, TotalWeight: 1000000}, total)
+
+	node.ledger.Ledger.SetWeightOracle(nil)
+	_, err = node.LookupExternalWeight(0, testAddr)
+	require.ErrorIs(t, err, ErrExternalWeightUnavailable)
+	_, err = node.LookupTotalExternalWeight(0, 320)
+	require.ErrorIs(t, err, ErrExternalWeightUnavailable)
+}
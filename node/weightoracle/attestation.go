@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// attestationCacheSize bounds the attestations a client keeps. Agreement asks
+// for one with every vote it verifies, and an account votes several times a
+// round at the same balance round.
+const attestationCacheSize = 4096
+
+// Compile-time interface check
+var _ ledgercore.AttestingWeightOracle = (*Client)(nil)
+
+// attestationRequest is the JSON structure sent for an attestation query: the
+// same account and balance round as a weight query.
+type attestationRequest struct {
+	Address      string `json:"address"`
+	SelectionID  string `json:"selection_id"`
+	BalanceRound string `json:"balance_round"`
+}
+
+// attestationResponse is the expected response from an attestation query:
+// the hex ed25519 public key of the daemon's attestation key, and its hex
+// signature over the committee.WeightStatement of the weight.
+type attestationResponse struct {
+	Signer    string `json:"signer,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Code      string `json:"code,omitempty"`
+}
+
+// parse returns the attestation resp carries.
+func (resp attestationResponse) parse() (committee.WeightAttestation, error) {
+	var att committee.WeightAttestation
+	signer, err := hex.DecodeString(resp.Signer)
+	if err != nil || len(signer) != len(att.Signer) {
+		return committee.WeightAttestation{}, fmt.Errorf("invalid attestation signer %q", truncateText(resp.Signer))
+	}
+	sig, err := hex.DecodeString(resp.Signature)
+	if err != nil || len(sig) != len(att.Sig) {
+		return committee.WeightAttestation{}, fmt.Errorf("invalid attestation signature %q", truncateText(resp.Signature))
+	}
+	copy(att.Signer[:], signer)
+	copy(att.Sig[:], sig)
+	return att, nil
+}
+
+// WeightAttestation asks the daemon, with /attestation, for its signature over
+// the weight it answers for addr under selectionID at balanceRound. Daemons
+// whose identity does not list CapabilityAttestation are not asked. The
+// signature is not checked here: committee.Credential.Verify checks it
+// against the key the consensus protocol trusts, and the weight agreement
+// used. Attestations are cached, as the weights they sign are.
+func (c *Client) WeightAttestation(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (committee.WeightAttestation, error) {
+	if !c.daemonSupports(CapabilityAttestation) {
+		return committee.WeightAttestation{}, &ledgercore.DaemonError{Code: "unsupported", Msg: "the weight daemon does not attest weights"}
+	}
+	key := makeWeightCacheKey(balanceRound, addr, selectionID)
+	if att, ok := c.attestations.Get(key); ok {
+		return att, nil
+	}
+
+	req := attestationRequest{
+		Address:      addr.String(),
+		SelectionID:  hex.EncodeToString(selectionID[:]),
+		BalanceRound: strconv.FormatUint(uint64(balanceRound), 10),
+	}
+	var resp attestationResponse
+	if err := c.doRequest(context.Background(), "/attestation", &addr, req, &resp); err != nil {
+		return committee.WeightAttestation{}, err
+	}
+	if resp.Error != "" {
+		return committee.WeightAttestation{}, daemonError(resp.Code, resp.Error)
+	}
+	att, err := resp.parse()
+	if err != nil {
+		return committee.WeightAttestation{}, fmt.Errorf("weight daemon answered /attestation for %v at balance round %d with %w", addr, balanceRound, err)
+	}
+	c.attestations.Put(key, att)
+	return att, nil
+}
+
+func (h *handler) attestation(w http.ResponseWriter, r *http.Request) {
+	var req attestationRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+	addr, selectionID, err := parseWeightKey(req.Address, req.SelectionID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	balanceRound, ok := parseRound(w, "balance_round", req.BalanceRound)
+	if !ok {
+		return
+	}
+	attester, ok := h.oracle.(ledgercore.AttestingWeightOracle)
+	if !ok {
+		writeError(w, &ledgercore.DaemonError{Code: "unsupported", Msg: "Weights not attested by this oracle"})
+		return
+	}
+
+	att, err := attester.WeightAttestation(balanceRound, addr, selectionID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, attestationResponse{
+		Signer:    hex.EncodeToString(att.Signer[:]),
+		Signature: hex.EncodeToString(att.Sig[:]),
+	})
+}
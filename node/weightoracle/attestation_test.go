@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestWeightAttestation checks that the client fetches the daemon's
+// attestation of a weight, that it signs the weight, account, selection key
+// and network, and that it is cached.
+func TestWeightAttestation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	key := crypto.GenerateSignatureSecrets(crypto.Seed{1})
+	oracle := mock.New()
+	genesisHash := makeTestGenesisHash()
+	oracle.SetIdentity(ledgercore.DaemonIdentity{
+		GenesisHash:            genesisHash,
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	})
+	addr, selectionID := makeTestAddress(1), crypto.VRFVerifier{1}
+	oracle.SetWeight(addr, 500)
+
+	server, err := StartServer("127.0.0.1:0", mock.NewAttester(oracle, key))
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientURL(server.URL())
+	_, err = client.Identity()
+	require.NoError(t, err)
+
+	att, err := client.WeightAttestation(7, addr, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, key.SignatureVerifier, att.Signer)
+	stmt := committee.WeightStatement{BalanceRound: 7, Addr: addr, SelectionID: selectionID, Weight: 500, GenesisHash: genesisHash}
+	require.True(t, att.Signer.Verify(stmt, att.Sig))
+	stmt.SelectionID = crypto.VRFVerifier{2}
+	require.False(t, att.Signer.Verify(stmt, att.Sig))
+
+	again, err := client.WeightAttestation(7, addr, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, att, again)
+	require.Equal(t, 1, oracle.CallCount(mock.MethodWeightAttestation))
+
+	oracle.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "no such account"})
+	_, err = client.WeightAttestation(8, addr, selectionID)
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+}
+
+// TestWeightAttestationUnsupported checks that a daemon whose oracle cannot
+// attest answers "unsupported", and that the client does not ask one whose
+// identity does not list the capability.
+func TestWeightAttestationUnsupported(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientURL(server.URL())
+
+	// Before the identity is known the request is sent.
+	_, err = client.WeightAttestation(1, makeTestAddress(1), crypto.VRFVerifier{})
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"), "%v", err)
+	require.ErrorContains(t, err, "not attested by this oracle")
+
+	_, err = client.Identity()
+	require.NoError(t, err)
+	_, err = client.WeightAttestation(1, makeTestAddress(1), crypto.VRFVerifier{})
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"), "%v", err)
+	require.ErrorContains(t, err, "does not attest weights")
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var saturatedGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_weight_oracle_saturated", Description: "1 while the weight daemon is not keeping up with the node and agreement sheds low-value vote verification"})
+
+const (
+	// pressureRecentWeight is the weight of each attempt's latency in the
+	// recent latency average.
+	pressureRecentWeight = 0.2
+
+	// pressureBaselineTau is the time constant of the usual latency, which
+	// absorbs lasting changes in the daemon's speed over several of them.
+	pressureBaselineTau = 2 * time.Minute
+
+	// pressureMinSamples is how many attempts establish the usual latency
+	// before latency inflation is taken into account.
+	pressureMinSamples = 100
+)
+
+// pressureGauge tells whether the daemon is saturated: whether requests have
+// been piling up, or their latency has been well above its usual level, for
+// a whole window. It changes its mind only once the opposite has held for a
+// window too, so that shedding does not flap.
+type pressureGauge struct {
+	queueDepth    int64
+	latencyFactor float64
+	window        time.Duration
+
+	// pending counts the requests started and not finished, including
+	// those waiting for a rate limit or a slot.
+	pending   atomic.Int64
+	saturated atomic.Bool
+
+	mu sync.Mutex
+	// recent and baseline are the recent and usual attempt latencies, in
+	// seconds, over samples attempts, the last one observed at observed.
+	recent, baseline float64
+	samples          int
+	observed         time.Time
+	// since is when the state opposite to saturated was first seen, or
+	// zero if it is not being seen.
+	since time.Time
+}
+
+// newPressureGauge returns a gauge with the given criteria, either of which
+// may be 0 to disable it, or nil if both are disabled.
+func newPressureGauge(queueDepth int, latencyFactor int, window time.Duration) *pressureGauge {
+	if queueDepth <= 0 && latencyFactor <= 0 {
+		return nil
+	}
+	return &pressureGauge{queueDepth: int64(queueDepth), latencyFactor: float64(latencyFactor), window: window}
+}
+
+// start counts a request as pending until the function it returns is called.
+func (g *pressureGauge) start() (done func()) {
+	if g == nil {
+		return func() {}
+	}
+	g.pending.Add(1)
+	g.update(time.Now())
+	return func() { g.pending.Add(-1) }
+}
+
+// observe records the latency of an attempt that ended at now.
+func (g *pressureGauge) observe(latency time.Duration, now time.Time) {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	sample := latency.Seconds()
+	if g.samples == 0 {
+		g.recent, g.baseline = sample, sample
+	} else {
+		g.recent += pressureRecentWeight * (sample - g.recent)
+		alpha := 1 - math.Exp(-float64(now.Sub(g.observed))/float64(pressureBaselineTau))
+		g.baseline += alpha * (sample - g.baseline)
+	}
+	g.samples++
+	g.observed = now
+	g.mu.Unlock()
+	g.update(now)
+}
+
+// update reconsiders whether the daemon is saturated at now.
+func (g *pressureGauge) update(now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	over := g.queueDepth > 0 && g.pending.Load() >= g.queueDepth
+	if g.latencyFactor > 0 && g.samples >= pressureMinSamples && g.recent > g.latencyFactor*g.baseline {
+		over = true
+	}
+	saturated := g.saturated.Load()
+	if over == saturated {
+		g.since = time.Time{}
+		return
+	}
+	if g.since.IsZero() {
+		g.since = now
+		return
+	}
+	if now.Sub(g.since) < g.window {
+		return
+	}
+	g.since = time.Time{}
+	g.saturated.Store(over)
+	if over {
+		saturatedGauge.Set(1)
+		logging.Base().Warnf("weight daemon is saturated: %d requests pending, recent latency %v against a usual %v; shedding low-value vote verification",
+			g.pending.Load(), seconds(g.recent), seconds(g.baseline))
+	} else {
+		saturatedGauge.Set(0)
+		logging.Base().Infof("weight daemon is keeping up again: %d requests pending, recent latency %v", g.pending.Load(), seconds(g.recent))
+	}
+}
+
+// seconds converts a latency average to a duration for logging.
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second)).Round(time.Microsecond)
+}
+
+// ExternalWeightSaturated reports whether the daemon has not been keeping up
+// with the node's requests, so that agreement can shed low-value work; see
+// ledgercore.ExternalWeightBackpressure.
+func (c *Client) ExternalWeightSaturated() bool {
+	return c.pressure != nil && c.pressure.saturated.Load()
+}
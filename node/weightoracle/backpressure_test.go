@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestPressureGaugeQueueDepth tests that the gauge reports saturation once the
+// pending requests have been at the queue depth for a window, and recovery
+// once they have been below it for a window.
+func TestPressureGaugeQueueDepth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	g := newPressureGauge(2, 0, time.Second)
+	t0 := time.Now()
+	g.pending.Add(2)
+	g.update(t0)
+	g.update(t0.Add(900 * time.Millisecond))
+	require.False(t, g.saturated.Load())
+
+	// A dip below the depth restarts the window.
+	g.pending.Add(-1)
+	g.update(t0.Add(950 * time.Millisecond))
+	g.pending.Add(1)
+	g.update(t0.Add(1200 * time.Millisecond))
+	g.update(t0.Add(2100 * time.Millisecond))
+	require.False(t, g.saturated.Load())
+	g.update(t0.Add(2200 * time.Millisecond))
+	require.True(t, g.saturated.Load())
+
+	g.pending.Add(-2)
+	g.update(t0.Add(3 * time.Second))
+	g.update(t0.Add(3900 * time.Millisecond))
+	require.True(t, g.saturated.Load())
+	g.update(t0.Add(4 * time.Second))
+	require.False(t, g.saturated.Load())
+}
+
+// TestPressureGaugeLatency tests that the gauge reports saturation when the
+// recent latency stays well above its usual level, and that a lasting change
+// in latency becomes the usual level.
+func TestPressureGaugeLatency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	g := newPressureGauge(0, 4, time.Second)
+	now := time.Now()
+	observe := func(latency time.Duration, n int, every time.Duration) {
+		for i := 0; i < n; i++ {
+			now = now.Add(every)
+			g.observe(latency, now)
+		}
+	}
+
+	// Slow attempts before the usual latency is established are ignored.
+	observe(10*time.Millisecond, pressureMinSamples-10, 10*time.Millisecond)
+	observe(time.Second, 5, 10*time.Millisecond)
+	observe(10*time.Millisecond, 5, 10*time.Millisecond)
+	require.False(t, g.saturated.Load())
+
+	observe(100*time.Millisecond, 200, 10*time.Millisecond)
+	require.True(t, g.saturated.Load())
+
+	// Once the daemon has been this slow for long, that is its usual speed.
+	observe(100*time.Millisecond, 1000, time.Second)
+	require.False(t, g.saturated.Load())
+}
+
+// TestPressureGaugeDisabled tests that a client with both criteria disabled
+// never reports saturation.
+func TestPressureGaugeDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	g := newPressureGauge(0, 0, time.Second)
+	require.Nil(t, g)
+	g.start()()
+	g.observe(time.Second, time.Now())
+
+	client := NewClient(1)
+	require.False(t, client.ExternalWeightSaturated())
+}
+
+// TestMakeClientConfigBackpressure tests that the saturation criteria are
+// taken from config.Local.
+func TestMakeClientConfigBackpressure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleBackpressureQueueDepth = 32
+	local.ExternalWeightOracleBackpressureLatencyFactor = 8
+	local.ExternalWeightOracleBackpressureWindow = 5 * time.Second
+	cfg := MakeClientConfig(local)
+	require.Equal(t, 32, cfg.BackpressureQueueDepth)
+	require.Equal(t, 8, cfg.BackpressureLatencyFactor)
+	require.Equal(t, 5*time.Second, cfg.BackpressureWindow)
+}
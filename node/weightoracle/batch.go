@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// MaxWeightsBatch is the most accounts one /weights request may ask about.
+// Weights splits larger batches, and the Server rejects larger requests.
+const MaxWeightsBatch = 256
+
+// Compile-time interface checks
+var _ ledgercore.BatchWeightOracle = (*Client)(nil)
+var _ ledgercore.OverridableWeightOracle = (*Client)(nil)
+
+// Weights returns the consensus weights of a batch of accounts at the
+// specified balance round, in the same order as queries. Cached weights and
+// not_found answers are served from the caches and the rest are asked of the
+// daemon together, in /weights requests of at most MaxWeightsBatch accounts,
+// whose answers are bounds-checked and cached as Weight's are. Daemons that do not serve
+// /weights, or do not list CapabilityBatch, are asked about each account on its own. Overridden accounts are
+// answered as by Weight.
+//
+// A non-nil error means a request failed as a whole; the daemon's answers
+// about individual accounts are reported in their WeightResult. Once ctx is
+// done, requests still waiting on the daemon are abandoned, as by
+// WeightContext, and return ErrRequestAbandoned.
+func (c *Client) Weights(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	return c.weights(ctx, balanceRound, queries, true)
+}
+
+// DaemonWeights is Weights, answered as the daemon answers whatever the
+// overrides set with SetOverrides. Block evaluation uses it, so that the node's
+// overrides do not decide which blocks it accepts.
+func (c *Client) DaemonWeights(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery) ([]ledgercore.WeightResult, error) {
+	return c.weights(ctx, balanceRound, queries, false)
+}
+
+// weights is Weights, answered without the overrides unless overrides is set.
+func (c *Client) weights(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery, overrides bool) ([]ledgercore.WeightResult, error) {
+	results := make([]ledgercore.WeightResult, len(queries))
+	keys := make([]weightCacheKey, len(queries))
+	missing := make([]int, 0, len(queries))
+	for i, q := range queries {
+		if weight, ok, err := c.overriddenWeight(balanceRound, q.Addr); ok && overrides {
+			results[i].Weight, results[i].Err = weight, err
+			continue
+		}
+		keys[i] = makeWeightCacheKey(balanceRound, q.Addr, q.SelectionID)
+		if weight, ok := c.cachedWeight(balanceRound, keys[i]); ok {
+			results[i].Weight = weight
+			continue
+		}
+		if err := c.notFound.get(keys[i], c.now()); err != nil {
+			results[i].Err = err
+			continue
+		}
+		missing = append(missing, i)
+	}
+
+	for len(missing) > 0 {
+		batch := missing[:min(len(missing), MaxWeightsBatch)]
+		missing = missing[len(batch):]
+		if c.noBatches.Load() || !c.daemonSupports(CapabilityBatch) {
+			c.fetchEach(ctx, balanceRound, queries, batch, overrides, results)
+			continue
+		}
+		err := c.fetchBatch(ctx, balanceRound, queries, keys, batch, results)
+		var de *ledgercore.DaemonError
+		if errors.As(err, &de) && (de.Code == "not_found" || de.Code == "unsupported") {
+			// Only an unknown endpoint makes the whole batch not found.
+			c.noBatches.Store(true)
+			c.fetchEach(ctx, balanceRound, queries, batch, overrides, results)
+			continue
+		}
+		if err != nil && !(unreachable(err) && !errors.Is(err, ErrRequestAbandoned) && c.staleWeights(balanceRound, queries, batch, results)) {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// fetchBatch asks the daemon about the queries at the given indices in one
+// /weights request and records the answers in results.
+func (c *Client) fetchBatch(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery, keys []weightCacheKey, indices []int, results []ledgercore.WeightResult) error {
+	req := weightsRequest{
+		BalanceRound: strconv.FormatUint(uint64(balanceRound), 10),
+		Queries:      make([]weightsQuery, len(indices)),
+	}
+	for j, i := range indices {
+		req.Queries[j] = weightsQuery{
+			Address:     queries[i].Addr.String(),
+			SelectionID: hex.EncodeToString(queries[i].SelectionID[:]),
+		}
+	}
+
+	var resp weightsResponse
+	if err := c.doRequest(ctx, "/weights", nil, req, &resp); err != nil {
+		return err
+	}
+	if resp.Error != "" {
+		return daemonError(resp.Code, resp.Error)
+	}
+	if len(resp.Weights) != len(indices) {
+		return fmt.Errorf("weights response has %d answers for %d queries", len(resp.Weights), len(indices))
+	}
+	for j, i := range indices {
+		results[i].Weight, results[i].Err = c.acceptWeight(balanceRound, queries[i], keys[i], resp.Weights[j])
+	}
+	return nil
+}
+
+// fetchEach looks up the queries at the given indices with concurrent
+// WeightContext calls, which the client's concurrency limits still apply to,
+// and records the answers in results. The overrides apply only if overrides
+// is set.
+func (c *Client) fetchEach(ctx context.Context, balanceRound basics.Round, queries []ledgercore.WeightQuery, indices []int, overrides bool, results []ledgercore.WeightResult) {
+	var wg sync.WaitGroup
+	for _, i := range indices {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i].Weight, results[i].Err = c.loggedWeight(ctx, balanceRound, queries[i].Addr, queries[i].SelectionID, overrides)
+		}(i)
+	}
+	wg.Wait()
+}
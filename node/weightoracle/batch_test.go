@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeTestQueries returns n weight queries for distinct test accounts.
+func makeTestQueries(n int) []ledgercore.WeightQuery {
+	queries := make([]ledgercore.WeightQuery, n)
+	for i := range queries {
+		queries[i] = ledgercore.WeightQuery{Addr: makeTestAddress(i + 1), SelectionID: makeTestSelectionID(i + 1)}
+	}
+	return queries
+}
+
+// answerWeights answers a /weights request for accounts made by
+// makeTestAddress(i) with a weight of 10*i, failing account fail.
+func answerWeights(req map[string]interface{}, fail int) interface{} {
+	queries := req["queries"].([]interface{})
+	weights := make([]map[string]string, len(queries))
+	for i, q := range queries {
+		addr, err := basics.UnmarshalChecksumAddress(q.(map[string]interface{})["address"].(string))
+		if err != nil {
+			panic(err)
+		}
+		n := int(addr[0]) | int(addr[1])<<8
+		if n == fail {
+			weights[i] = map[string]string{"error": "unknown account", "code": "not_found"}
+			continue
+		}
+		weights[i] = map[string]string{"weight": strconv.Itoa(n * 10)}
+	}
+	return map[string]interface{}{"weights": weights}
+}
+
+// TestWeightsBatch checks that Weights asks the daemon about uncached
+// accounts in one request, reports per-account errors and caches the rest.
+func TestWeightsBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var batches atomic.Int32
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		require.Equal(t, "/weights", path)
+		require.Equal(t, "100", req["balance_round"])
+		batches.Add(1)
+		return answerWeights(req, 2)
+	})
+	defer server.Close()
+	client := NewClient(server.port)
+
+	queries := makeTestQueries(3)
+	results, err := client.Weights(context.Background(), 100, queries)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, ledgercore.WeightResult{Weight: 10}, results[0])
+	require.True(t, ledgercore.IsDaemonError(results[1].Err, "not_found"), "%v", results[1].Err)
+	require.Equal(t, ledgercore.WeightResult{Weight: 30}, results[2])
+	require.EqualValues(t, 1, batches.Load())
+
+	// The cached weights are served without asking again, and Weight
+	// finds them too.
+	results, err = client.Weights(context.Background(), 100, queries[2:])
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Weight: 30}}, results)
+	require.EqualValues(t, 1, batches.Load())
+	w, err := client.Weight(100, queries[0].Addr, queries[0].SelectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), w)
+	require.EqualValues(t, 1, batches.Load())
+}
+
+// TestWeightsSplitsBatches checks that batches larger than MaxWeightsBatch
+// are sent in several requests.
+func TestWeightsSplitsBatches(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sizes []int
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		sizes = append(sizes, len(req["queries"].([]interface{})))
+		return answerWeights(req, 0)
+	})
+	defer server.Close()
+	client := NewClient(server.port)
+
+	results, err := client.Weights(context.Background(), 5, makeTestQueries(2*MaxWeightsBatch+1))
+	require.NoError(t, err)
+	require.Equal(t, []int{MaxWeightsBatch, MaxWeightsBatch, 1}, sizes)
+	for i, r := range results {
+		require.NoError(t, r.Err)
+		require.Equal(t, uint64(i+1)*10, r.Weight)
+	}
+}
+
+// TestWeightsFallback checks that a daemon without /weights is asked about
+// each account on its own, and is not offered batches again.
+func TestWeightsFallback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var batches, singles atomic.Int32
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		if path == "/weights" {
+			batches.Add(1)
+			return map[string]string{"error": "Unknown endpoint: /weights", "code": "not_found"}
+		}
+		singles.Add(1)
+		return map[string]string{"weight": "7"}
+	})
+	defer server.Close()
+	client := NewClient(server.port)
+
+	results, err := client.Weights(context.Background(), 1, makeTestQueries(3))
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Weight: 7}, {Weight: 7}, {Weight: 7}}, results)
+	_, err = client.Weights(context.Background(), 2, makeTestQueries(2))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, batches.Load())
+	require.EqualValues(t, 5, singles.Load())
+}
+
+// TestWeightsRequestFailure checks that a failure of a whole request fails
+// the batch.
+func TestWeightsRequestFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		return map[string]interface{}{"weights": []map[string]string{{"weight": "1"}}}
+	})
+	defer server.Close()
+	client := NewClient(server.port)
+
+	_, err := client.Weights(context.Background(), 1, makeTestQueries(2))
+	require.ErrorContains(t, err, "1 answers for 2 queries")
+}
+
+// TestWeightsAbandoned checks that a batch whose context is done stops
+// waiting on the daemon, without serving earlier rounds' weights in its place.
+func TestWeightsAbandoned(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	clientConfig := testClientConfig()
+	clientConfig.QueryTimeout = 10 * time.Second
+	clientConfig.StaleRounds = 8
+	client := NewClientWithConfig(server.URL(), clientConfig)
+	queries := makeTestQueries(2)
+	_, err = client.Weights(context.Background(), 9, queries)
+	require.NoError(t, err)
+
+	oracle.SetLatency(5 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = client.Weights(ctx, 10, queries)
+	require.ErrorIs(t, err, ErrRequestAbandoned)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 2*time.Second)
+}
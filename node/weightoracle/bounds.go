@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var weightBoundsViolations = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_out_of_bounds_total", Description: "number of weight daemon answers rejected for exceeding the configured weight bounds"})
+
+// WeightBounds are sanity limits on the weights accepted from the daemon.
+// A zero field means no limit.
+type WeightBounds struct {
+	// MaxWeight is the largest account weight accepted.
+	MaxWeight uint64
+
+	// MaxTotalWeight is the largest total weight accepted.
+	MaxTotalWeight uint64
+
+	// MaxRatioPPM is the largest share of the total weight, in parts per
+	// million, that one account may hold.
+	MaxRatioPPM uint64
+}
+
+// WeightBoundsError is returned when the daemon answers with a weight outside
+// the client's WeightBounds. The answer is not cached. Unlike a
+// ledgercore.DaemonError it says nothing about the daemon's view of the
+// account, so callers treat it as an operational failure.
+type WeightBoundsError struct {
+	// Quantity is the bounded quantity: "weight", "total weight" or "weight share".
+	Quantity     string
+	BalanceRound basics.Round
+	Value        uint64
+	Limit        uint64
+}
+
+func (e *WeightBoundsError) Error() string {
+	return fmt.Sprintf("weight daemon returned %s %d for balance round %d, above the limit of %d",
+		e.Quantity, e.Value, e.BalanceRound, e.Limit)
+}
+
+// roundWeights records, for one balance round, the largest account weight and
+// the smallest total weight accepted so far. The ratio bound compares the two,
+// so a violation is caught by whichever of the pair arrives second.
+type roundWeights struct {
+	maxWeight uint64
+	minTotal  uint64
+}
+
+// boundsChecker validates daemon answers against WeightBounds.
+type boundsChecker struct {
+	bounds WeightBounds
+
+	// mu serializes updates to observed; observed is nil when the ratio
+	// bound is disabled.
+	mu       deadlock.Mutex
+	observed *lruCache[basics.Round, roundWeights]
+}
+
+func makeBoundsChecker(bounds WeightBounds, rounds int) *boundsChecker {
+	bc := &boundsChecker{bounds: bounds}
+	if bounds.MaxRatioPPM > 0 {
+		bc.observed = newLRUCache[basics.Round, roundWeights](max(rounds, 1))
+	}
+	return bc
+}
+
+// shareLimit is the largest account weight allowed against the given total.
+func (bc *boundsChecker) shareLimit(total uint64) uint64 {
+	// MaxRatioPPM is at most one million, so the result cannot overflow.
+	limit, _ := basics.Muldiv(total, bc.bounds.MaxRatioPPM, 1_000_000)
+	return limit
+}
+
+// checkWeight validates an account weight at balanceRound.
+func (bc *boundsChecker) checkWeight(balanceRound basics.Round, weight uint64) error {
+	if bc.bounds.MaxWeight != 0 && weight > bc.bounds.MaxWeight {
+		return bc.violation(&WeightBoundsError{Quantity: "weight", BalanceRound: balanceRound, Value: weight, Limit: bc.bounds.MaxWeight})
+	}
+	if bc.observed == nil {
+		return nil
+	}
+
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	obs, _ := bc.observed.Get(balanceRound)
+	if obs.minTotal != 0 {
+		if limit := bc.shareLimit(obs.minTotal); weight > limit {
+			return bc.violation(&WeightBoundsError{Quantity: "weight share", BalanceRound: balanceRound, Value: weight, Limit: limit})
+		}
+	}
+	obs.maxWeight = max(obs.maxWeight, weight)
+	bc.observed.Put(balanceRound, obs)
+	return nil
+}
+
+// checkTotalWeight validates a total weight at balanceRound.
+func (bc *boundsChecker) checkTotalWeight(balanceRound basics.Round, total uint64) error {
+	if bc.bounds.MaxTotalWeight != 0 && total > bc.bounds.MaxTotalWeight {
+		return bc.violation(&WeightBoundsError{Quantity: "total weight", BalanceRound: balanceRound, Value: total, Limit: bc.bounds.MaxTotalWeight})
+	}
+	if bc.observed == nil {
+		return nil
+	}
+
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	obs, _ := bc.observed.Get(balanceRound)
+	if limit := bc.shareLimit(total); obs.maxWeight > limit {
+		return bc.violation(&WeightBoundsError{Quantity: "weight share", BalanceRound: balanceRound, Value: obs.maxWeight, Limit: limit})
+	}
+	if obs.minTotal == 0 || total < obs.minTotal {
+		obs.minTotal = total
+	}
+	bc.observed.Put(balanceRound, obs)
+	return nil
+}
+
+// violation counts and logs an out-of-bounds answer and returns err.
+func (bc *boundsChecker) violation(err *WeightBoundsError) error {
+	weightBoundsViolations.Inc(nil)
+	logging.Base().Errorf("rejecting weight daemon answer: %v", err)
+	return err
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBoundsChecker_Disabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bc := makeBoundsChecker(WeightBounds{}, 16)
+	require.Nil(t, bc.observed)
+	require.NoError(t, bc.checkWeight(1, ^uint64(0)))
+	require.NoError(t, bc.checkTotalWeight(1, 1))
+}
+
+func TestBoundsChecker_Limits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bc := makeBoundsChecker(WeightBounds{MaxWeight: 100, MaxTotalWeight: 1000}, 16)
+	require.NoError(t, bc.checkWeight(1, 100))
+	require.NoError(t, bc.checkTotalWeight(1, 1000))
+
+	err := bc.checkWeight(1, 101)
+	require.Equal(t, &WeightBoundsError{Quantity: "weight", BalanceRound: 1, Value: 101, Limit: 100}, err)
+	err = bc.checkTotalWeight(1, 1001)
+	require.Equal(t, &WeightBoundsError{Quantity: "total weight", BalanceRound: 1, Value: 1001, Limit: 1000}, err)
+	require.EqualError(t, err, "weight daemon returned total weight 1001 for balance round 1, above the limit of 1000")
+}
+
+func TestBoundsChecker_Ratio(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Weight first: the total that would make it too large a share is rejected.
+	bc := makeBoundsChecker(WeightBounds{MaxRatioPPM: 100_000}, 16)
+	require.NoError(t, bc.checkWeight(1, 50))
+	err := bc.checkTotalWeight(1, 400)
+	require.Equal(t, &WeightBoundsError{Quantity: "weight share", BalanceRound: 1, Value: 50, Limit: 40}, err)
+	require.NoError(t, bc.checkTotalWeight(1, 500))
+
+	// Total first: a weight above the share is rejected.
+	require.NoError(t, bc.checkTotalWeight(2, 1000))
+	require.NoError(t, bc.checkWeight(2, 100))
+	err = bc.checkWeight(2, 101)
+	require.Equal(t, &WeightBoundsError{Quantity: "weight share", BalanceRound: 2, Value: 101, Limit: 100}, err)
+
+	// The smallest total seen for the round is the one that counts.
+	require.NoError(t, bc.checkTotalWeight(2, 2000))
+	require.Error(t, bc.checkWeight(2, 150))
+
+	// Rounds are tracked independently.
+	require.NoError(t, bc.checkWeight(3, 150))
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// ErrCircuitOpen is returned without contacting the daemon for requests made
+// while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("weight daemon circuit breaker is open after repeated failures")
+
+var weightOracleBreakerOpened = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_breaker_opened_total", Description: "number of times the weight daemon circuit breaker opened"})
+var weightOracleBreakerRejected = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_breaker_rejected_total", Description: "number of weight daemon requests failed by the open circuit breaker without being sent"})
+
+// circuitBreaker fails requests fast while the daemon keeps failing them, so
+// that each vote verified meanwhile does not wait out the query timeout.
+//
+// The breaker keeps the outcomes of the last window requests sent. Once at
+// least threshold percent of them failed in one of the failoverClasses, it
+// opens for cooldown, during which requests fail with ErrCircuitOpen. The
+// first request after that is sent as a trial: if it succeeds the breaker
+// closes and starts counting afresh, and if it fails the breaker opens again.
+type circuitBreaker struct {
+	mu        deadlock.Mutex
+	threshold int
+	cooldown  time.Duration
+
+	// outcomes is a ring of the last requests' outcomes, true for a
+	// failure, next indexes the oldest once it is full, and failures
+	// counts the failures in it.
+	outcomes []bool
+	next     int
+	failures int
+
+	// openUntil is when the open breaker lets a trial request through, zero
+	// while it is closed, and trial is set while that request is in flight.
+	openUntil time.Time
+	trial     bool
+}
+
+// newCircuitBreaker returns a breaker that opens for cooldown when threshold
+// percent of the last window requests failed, or nil if threshold is 0. A
+// nil breaker lets every request through.
+func newCircuitBreaker(threshold, window int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 || window <= 0 {
+		return nil
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		outcomes:  make([]bool, 0, window),
+	}
+}
+
+// allow returns ErrCircuitOpen if a request may not be sent at now, and
+// whether it is the trial request otherwise. The caller reports the outcome
+// of a request allowed with record, or with abandon if it was not sent.
+func (b *circuitBreaker) allow(now time.Time) (trial bool, err error) {
+	if b == nil {
+		return false, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.openUntil.IsZero() {
+		return false, nil
+	}
+	if b.trial || now.Before(b.openUntil) {
+		weightOracleBreakerRejected.Inc(nil)
+		return false, ErrCircuitOpen
+	}
+	b.trial = true
+	return true, nil
+}
+
+// record adds the outcome of a request sent at now. Outcomes of requests
+// other than the trial one are ignored while the breaker is open.
+func (b *circuitBreaker) record(now time.Time, trial, failed bool) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if trial {
+		b.trial = false
+		if failed {
+			b.openUntil = now.Add(b.cooldown)
+			return
+		}
+		logging.Base().Infof("weight daemon answered again; closing the circuit breaker")
+		b.openUntil = time.Time{}
+		b.outcomes = b.outcomes[:0]
+		b.next = 0
+		b.failures = 0
+		return
+	}
+	if !b.openUntil.IsZero() {
+		return
+	}
+
+	if len(b.outcomes) < cap(b.outcomes) {
+		b.outcomes = append(b.outcomes, failed)
+	} else {
+		if b.outcomes[b.next] {
+			b.failures--
+		}
+		b.outcomes[b.next] = failed
+		b.next = (b.next + 1) % len(b.outcomes)
+	}
+	if failed {
+		b.failures++
+	}
+	if len(b.outcomes) == cap(b.outcomes) && b.failures*100 >= b.threshold*len(b.outcomes) {
+		logging.Base().Warnf("%d of the last %d weight daemon requests failed; failing requests for %v before trying again", b.failures, len(b.outcomes), b.cooldown)
+		weightOracleBreakerOpened.Inc(nil)
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+// abandon releases the trial of a request allow let through but that was not
+// sent after all.
+func (b *circuitBreaker) abandon(trial bool) {
+	if b == nil || !trial {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	b := newCircuitBreaker(50, 4, time.Second)
+	send := func(failed bool) error {
+		trial, err := b.allow(now)
+		if err != nil {
+			return err
+		}
+		b.record(now, trial, failed)
+		return nil
+	}
+
+	// The window must fill before the breaker opens.
+	require.NoError(t, send(true))
+	require.NoError(t, send(true))
+	require.NoError(t, send(false))
+	require.NoError(t, send(false))
+	require.ErrorIs(t, send(false), ErrCircuitOpen)
+
+	// The trial after the cool-down fails and opens it again.
+	now = now.Add(time.Second)
+	require.NoError(t, send(true))
+	require.ErrorIs(t, send(false), ErrCircuitOpen)
+
+	// Only one trial is let through at a time, and one not sent is released.
+	now = now.Add(time.Second)
+	trial, err := b.allow(now)
+	require.NoError(t, err)
+	require.True(t, trial)
+	_, err = b.allow(now)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	b.abandon(trial)
+
+	// A successful trial closes it and forgets the earlier failures.
+	require.NoError(t, send(false))
+	require.NoError(t, send(true))
+	require.NoError(t, send(false))
+	require.NoError(t, send(false))
+	require.NoError(t, send(false))
+	require.NoError(t, send(true))
+	require.NoError(t, send(false))
+
+	var disabled *circuitBreaker
+	trial, err = disabled.allow(now)
+	require.NoError(t, err)
+	require.False(t, trial)
+	disabled.record(now, trial, true)
+	disabled.abandon(trial)
+}
+
+// TestClientCircuitBreaker checks that a client stops contacting a failing
+// daemon until the cool-down is over, and that daemon error answers do not
+// count as failures.
+func TestClientCircuitBreaker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	var down atomic.Bool
+	var requests atomic.Int32
+	handler := NewHandler(oracle)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if down.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	clientConfig := testClientConfig()
+	clientConfig.BreakerThreshold = 100
+	clientConfig.BreakerWindow = 3
+	clientConfig.BreakerCooldown = 100 * time.Millisecond
+	client := NewClientWithConfig(u, clientConfig)
+	addr, selectionID := basics.Address{1}, crypto.VRFVerifier{9}
+
+	oracle.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "unknown account"})
+	for rnd := basics.Round(1); rnd <= 3; rnd++ {
+		_, err := client.Weight(rnd, addr, selectionID)
+		require.Error(t, err)
+	}
+	oracle.SetWeightError(nil)
+	_, err = client.Weight(4, addr, selectionID)
+	require.NoError(t, err)
+
+	down.Store(true)
+	for rnd := basics.Round(5); rnd <= 7; rnd++ {
+		_, err := client.Weight(rnd, addr, selectionID)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+	sent := requests.Load()
+	_, err = client.Weight(8, addr, selectionID)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, sent, requests.Load())
+
+	down.Store(false)
+	require.Eventually(t, func() bool {
+		_, err := client.Weight(9, addr, selectionID)
+		return err == nil
+	}, 5*time.Second, 20*time.Millisecond)
+}
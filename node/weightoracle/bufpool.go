@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/algorand/go-codec/codec"
+)
+
+// maxPooledBufferSize is the largest buffer returned to a pool; the rare
+// larger ones, such as weight tables, are left to the garbage collector.
+const maxPooledBufferSize = MaxResponseSize
+
+// bufferPool holds the buffers responses are read into.
+var bufferPool = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
+
+// requestBody is the encoded body of a request, shared by its attempts.
+// requestBodies are pooled along with their encoder and GetBody function. The
+// transport may still be sending a body after the response arrives, so a body
+// goes back to the pool only once every reader of it is closed.
+type requestBody struct {
+	buf         bytes.Buffer
+	enc         *json.Encoder
+	contentType string
+	getBody     func() (io.ReadCloser, error)
+	open        atomic.Int32
+}
+
+var requestBodyPool = sync.Pool{New: func() any {
+	b := new(requestBody)
+	b.enc = json.NewEncoder(&b.buf)
+	b.getBody = func() (io.ReadCloser, error) { return b.reader(), nil }
+	return b
+}}
+
+// encodeRequest encodes v as json.Marshal would into a pooled body.
+func encodeRequest(v interface{}) (*requestBody, error) {
+	b := requestBodyPool.Get().(*requestBody)
+	if err := b.enc.Encode(v); err != nil {
+		b.buf.Reset()
+		requestBodyPool.Put(b)
+		return nil, err
+	}
+	// Encode ends the value with a newline that Marshal does not add.
+	b.buf.Truncate(b.buf.Len() - 1)
+	b.contentType = "application/json"
+	return b, nil
+}
+
+// encodeMsgpackRequest encodes v in msgpack into a pooled body.
+func encodeMsgpackRequest(v interface{}) (*requestBody, error) {
+	b := requestBodyPool.Get().(*requestBody)
+	if err := codec.NewEncoder(&b.buf, msgpackHandle).Encode(v); err != nil {
+		b.buf.Reset()
+		requestBodyPool.Put(b)
+		return nil, err
+	}
+	b.contentType = msgpackContentType
+	return b, nil
+}
+
+func (b *requestBody) bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// reader returns a new reader of the body for the transport.
+func (b *requestBody) reader() io.ReadCloser {
+	b.open.Add(1)
+	r := &requestBodyReader{body: b}
+	r.Reset(b.buf.Bytes())
+	return r
+}
+
+// release returns the body to the pool if no reader of it is still open. The
+// body must not be used afterwards.
+func (b *requestBody) release() {
+	if b.open.Load() != 0 || b.buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	b.buf.Reset()
+	requestBodyPool.Put(b)
+}
+
+type requestBodyReader struct {
+	bytes.Reader
+	body   *requestBody
+	closed atomic.Bool
+}
+
+func (r *requestBodyReader) Close() error {
+	if r.closed.CompareAndSwap(false, true) {
+		r.body.open.Add(-1)
+	}
+	return nil
+}
+
+// readResponse reads at most limit+1 bytes of a response body into a pooled
+// buffer, sized up front from the response's Content-Length when it has one.
+// The caller returns the buffer with putBuffer once done with its bytes.
+func readResponse(body io.Reader, contentLength, limit int64) (*bytes.Buffer, error) {
+	buf := getBuffer()
+	if contentLength > 0 {
+		// ReadFrom wants MinRead bytes of room even for the final, empty read.
+		buf.Grow(int(min(contentLength, limit)) + bytes.MinRead)
+	}
+	if _, err := buf.ReadFrom(io.LimitReader(body, limit+1)); err != nil {
+		putBuffer(buf)
+		return nil, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestEncodeRequest tests that pooled request bodies are byte for byte what
+// json.Marshal produces, which request signatures and recorded sessions see.
+func TestEncodeRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, v := range []interface{}{
+		emptyRequest{},
+		weightRequest{Address: "<addr&>", SelectionID: "00ff", BalanceRound: "12"},
+		totalWeightRequest{BalanceRound: "1", VoteRound: "2"},
+	} {
+		want, err := json.Marshal(v)
+		require.NoError(t, err)
+		body, err := encodeRequest(v)
+		require.NoError(t, err)
+		require.Equal(t, string(want), string(body.bytes()))
+		body.release()
+	}
+
+	_, err := encodeRequest(func() {})
+	require.Error(t, err)
+}
+
+// TestRequestBodyOutlivesRelease tests that a body still being read by the
+// transport is not handed out again when its request is over.
+func TestRequestBodyOutlivesRelease(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	body, err := encodeRequest(totalWeightRequest{BalanceRound: "1", VoteRound: "2"})
+	require.NoError(t, err)
+	want := string(body.bytes())
+	r := body.reader()
+	body.release()
+
+	for i := 0; i < 10; i++ {
+		other, err := encodeRequest(weightTableRequest{BalanceRound: strings.Repeat("9", len(want))})
+		require.NoError(t, err)
+		require.NotSame(t, body, other)
+		other.release()
+	}
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, want, string(got))
+	require.NoError(t, r.Close())
+	require.NoError(t, r.Close())
+	require.Zero(t, body.open.Load())
+}
+
+// TestReadResponse tests that response bodies are read whole up to one byte
+// past the limit, whatever length the response claims.
+func TestReadResponse(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, tc := range []struct {
+		body          string
+		contentLength int64
+		want          string
+	}{
+		{`{"pong":true}`, 13, `{"pong":true}`},
+		{`{"pong":true}`, -1, `{"pong":true}`},
+		{`{"pong":true}`, 1 << 40, `{"pong":true}`},
+		{strings.Repeat("a", 20), 20, strings.Repeat("a", 17)},
+	} {
+		buf, err := readResponse(strings.NewReader(tc.body), tc.contentLength, 16)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, buf.String())
+		putBuffer(buf)
+	}
+
+	_, err := readResponse(io.MultiReader(strings.NewReader("{"), errReader{io.ErrUnexpectedEOF}), 2, 16)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	large := getBuffer()
+	large.Grow(2 * maxPooledBufferSize)
+	putBuffer(large)
+	require.NotSame(t, large, bufferPool.Get())
+}
+
+func BenchmarkRequest(b *testing.B) {
+	client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1}, respond(http.StatusOK, `{"total_weight":"1000"}`))
+	req := totalWeightRequest{BalanceRound: "100", VoteRound: "420"}
+	var resp totalWeightResponse
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := client.doRequest(context.Background(), "/total_weight", nil, req, &resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
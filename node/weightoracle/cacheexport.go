@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// weightAnswer is a weight cache entry: the daemon's answer to a query, kept
+// with the query so that the cache can be exported.
+type weightAnswer struct {
+	balanceRound basics.Round
+	query        ledgercore.WeightQuery
+	weight       uint64
+}
+
+// weightAnswerRound is the balance round of a weight cache entry.
+func weightAnswerRound(_ weightCacheKey, a weightAnswer) basics.Round { return a.balanceRound }
+
+// CacheExport holds the weight and total weight answers in a client's caches,
+// in the format of the test daemon's --weight-file. Seeding a replacement
+// daemon with it makes the daemon give the answers the node, and so the
+// network, has been using.
+type CacheExport struct {
+	// Weights maps "address:selection_id:balance_round" keys, with the
+	// selection ID in hex, to weights.
+	Weights map[string]uint64 `json:"weights"`
+
+	// TotalWeights maps "balance_round:vote_round" keys to total weights.
+	TotalWeights map[string]uint64 `json:"total_weights"`
+}
+
+// ExportCache returns the cached answers for balance rounds from since on.
+// Overridden weights (see SetOverrides) are not cached, so they are not
+// exported either.
+func (c *Client) ExportCache(since basics.Round) CacheExport {
+	export := CacheExport{
+		Weights:      make(map[string]uint64),
+		TotalWeights: make(map[string]uint64),
+	}
+	c.weightCache.Range(func(_ weightCacheKey, a weightAnswer) {
+		if a.balanceRound >= since {
+			key := fmt.Sprintf("%s:%s:%d", a.query.Addr, hex.EncodeToString(a.query.SelectionID[:]), a.balanceRound)
+			export.Weights[key] = a.weight
+		}
+	})
+	c.totalWeightCache.Range(func(k totalWeightCacheKey, total uint64) {
+		if k.balanceRound >= since {
+			export.TotalWeights[fmt.Sprintf("%d:%d", k.balanceRound, k.voteRound)] = total
+		}
+	})
+	return export
+}
+
+// ImportCache puts the answers of export for balance rounds from since on in
+// the caches, as if the daemon had given them, and returns how many it
+// imported. Answers outside the configured WeightBounds are skipped, and a
+// malformed key fails the import, leaving the answers before it imported.
+func (c *Client) ImportCache(export CacheExport, since basics.Round) (int, error) {
+	imported := 0
+	// Totals go first, so that the weight share bound applies to the weights.
+	for key, total := range export.TotalWeights {
+		balanceRound, voteRound, err := ParseTotalWeightExportKey(key)
+		if err != nil {
+			return imported, err
+		}
+		k := totalWeightCacheKey{balanceRound: balanceRound, voteRound: voteRound}
+		if k.balanceRound < since || c.bounds.checkTotalWeight(k.balanceRound, total) != nil {
+			continue
+		}
+		c.totalWeightCache.Put(k, total)
+		imported++
+	}
+	for key, weight := range export.Weights {
+		balanceRound, q, err := ParseWeightExportKey(key)
+		if err != nil {
+			return imported, err
+		}
+		if balanceRound < since || c.bounds.checkWeight(balanceRound, weight) != nil {
+			continue
+		}
+		cacheKey := makeWeightCacheKey(balanceRound, q.Addr, q.SelectionID)
+		c.weightCache.Put(cacheKey, weightAnswer{balanceRound: balanceRound, query: q, weight: weight})
+		c.hotWeights.put(balanceRound, cacheKey, weight)
+		imported++
+	}
+	return imported, nil
+}
+
+// ParseWeightExportKey parses an "address:selection_id:balance_round" key of
+// CacheExport.Weights.
+func ParseWeightExportKey(key string) (basics.Round, ledgercore.WeightQuery, error) {
+	var q ledgercore.WeightQuery
+	parts := strings.Split(key, ":")
+	if len(parts) != 3 {
+		return 0, q, fmt.Errorf("weight key %q is not address:selection_id:balance_round", key)
+	}
+	addr, err := basics.UnmarshalChecksumAddress(parts[0])
+	if err != nil {
+		return 0, q, fmt.Errorf("weight key %q: %w", key, err)
+	}
+	selectionID, err := hex.DecodeString(parts[1])
+	if err != nil || len(selectionID) != len(q.SelectionID) {
+		return 0, q, fmt.Errorf("weight key %q: selection ID is not %d hex bytes", key, len(q.SelectionID))
+	}
+	balanceRound, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return 0, q, fmt.Errorf("weight key %q: %w", key, err)
+	}
+	q.Addr = addr
+	copy(q.SelectionID[:], selectionID)
+	return basics.Round(balanceRound), q, nil
+}
+
+// ParseTotalWeightExportKey parses a "balance_round:vote_round" key of
+// CacheExport.TotalWeights.
+func ParseTotalWeightExportKey(key string) (balanceRound, voteRound basics.Round, err error) {
+	balance, vote, ok := strings.Cut(key, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("total weight key %q is not balance_round:vote_round", key)
+	}
+	b, err := strconv.ParseUint(balance, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("total weight key %q: %w", key, err)
+	}
+	v, err := strconv.ParseUint(vote, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("total weight key %q: %w", key, err)
+	}
+	return basics.Round(b), basics.Round(v), nil
+}
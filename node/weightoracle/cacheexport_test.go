@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestExportCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	oracle.SetTotalWeight(100)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+	a, b := basics.Address{1}, basics.Address{2}
+	selectionID := crypto.VRFVerifier{9}
+	_, err = client.Weight(10, a, selectionID)
+	require.NoError(t, err)
+	_, err = client.Weights(context.Background(), 20, []ledgercore.WeightQuery{{Addr: b, SelectionID: selectionID}})
+	require.NoError(t, err)
+	_, err = client.TotalWeight(10, 330)
+	require.NoError(t, err)
+	_, err = client.TotalWeight(20, 340)
+	require.NoError(t, err)
+
+	weightKey := func(addr basics.Address, balanceRound int) string {
+		return fmt.Sprintf("%s:%s:%d", addr, hex.EncodeToString(selectionID[:]), balanceRound)
+	}
+	require.Equal(t, CacheExport{
+		Weights:      map[string]uint64{weightKey(a, 10): 5, weightKey(b, 20): 5},
+		TotalWeights: map[string]uint64{"10:330": 100, "20:340": 100},
+	}, client.ExportCache(0))
+	require.Equal(t, CacheExport{
+		Weights:      map[string]uint64{weightKey(b, 20): 5},
+		TotalWeights: map[string]uint64{"20:340": 100},
+	}, client.ExportCache(11))
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// weightCacheKey is the key for the weight LRU cache: a 128-bit digest of
+// the balance round, address and selection ID that identify a weight query,
+// computed once per lookup so that the cache's map stores and compares 16
+// bytes instead of the 72-byte tuple.
+//
+// The digest is two 64-bit hashes under seeds chosen at random when the
+// process starts. Distinct queries share a key with probability about
+// n²/2¹²⁹ for n cached weights, and since the seeds are never revealed a
+// colliding pair cannot be prepared in advance.
+type weightCacheKey [16]byte
+
+var weightCacheKeySeeds = [2]maphash.Seed{maphash.MakeSeed(), maphash.MakeSeed()}
+
+// makeWeightCacheKey returns the weight cache key of a query.
+func makeWeightCacheKey(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) weightCacheKey {
+	var tuple [8 + len(addr) + len(selectionID)]byte
+	binary.LittleEndian.PutUint64(tuple[:8], uint64(balanceRound))
+	copy(tuple[8:], addr[:])
+	copy(tuple[8+len(addr):], selectionID[:])
+
+	var key weightCacheKey
+	binary.LittleEndian.PutUint64(key[:8], maphash.Bytes(weightCacheKeySeeds[0], tuple[:]))
+	binary.LittleEndian.PutUint64(key[8:], maphash.Bytes(weightCacheKeySeeds[1], tuple[:]))
+	return key
+}
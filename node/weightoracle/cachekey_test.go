@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"testing"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestWeightCacheKey tests that every part of a weight query changes its key.
+func TestWeightCacheKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var addr basics.Address
+	var sel crypto.VRFVerifier
+	crypto.RandBytes(addr[:])
+	crypto.RandBytes(sel[:])
+	key := makeWeightCacheKey(100, addr, sel)
+	require.Equal(t, key, makeWeightCacheKey(100, addr, sel))
+
+	otherAddr, otherSel := addr, sel
+	otherAddr[31] ^= 1
+	otherSel[0] ^= 1
+	seen := map[weightCacheKey]bool{key: true}
+	for _, k := range []weightCacheKey{
+		makeWeightCacheKey(101, addr, sel),
+		makeWeightCacheKey(100, otherAddr, sel),
+		makeWeightCacheKey(100, addr, otherSel),
+		// The round's bytes are not confused with the address's.
+		makeWeightCacheKey(100<<8, addr, sel),
+	} {
+		require.False(t, seen[k])
+		seen[k] = true
+	}
+}
+
+// tupleWeightCacheKey is the key the weight cache used before compact keys,
+// kept to compare the two in benchmarks.
+type tupleWeightCacheKey struct {
+	balanceRound basics.Round
+	addr         basics.Address
+	selectionID  crypto.VRFVerifier
+}
+
+// benchmarkQueries returns n distinct weight queries.
+func benchmarkQueries(n int) []tupleWeightCacheKey {
+	queries := make([]tupleWeightCacheKey, n)
+	for i := range queries {
+		queries[i].balanceRound = basics.Round(1000 + i%16)
+		crypto.RandBytes(queries[i].addr[:])
+		crypto.RandBytes(queries[i].selectionID[:])
+	}
+	return queries
+}
+
+func BenchmarkWeightCacheGet(b *testing.B) {
+	deadlock.Opts.Disable = true
+	queries := benchmarkQueries(WeightCacheCapacity)
+	b.Run("compact", func(b *testing.B) {
+		cache := newLRUCache[weightCacheKey, uint64](WeightCacheCapacity)
+		for _, q := range queries {
+			cache.Put(makeWeightCacheKey(q.balanceRound, q.addr, q.selectionID), 1)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q := &queries[i%len(queries)]
+			cache.Get(makeWeightCacheKey(q.balanceRound, q.addr, q.selectionID))
+		}
+	})
+	b.Run("tuple", func(b *testing.B) {
+		cache := newLRUCache[tupleWeightCacheKey, uint64](WeightCacheCapacity)
+		for _, q := range queries {
+			cache.Put(q, 1)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			cache.Get(queries[i%len(queries)])
+		}
+	})
+}
+
+func BenchmarkWeightCachePut(b *testing.B) {
+	deadlock.Opts.Disable = true
+	// Twice the capacity, so that every Put evicts.
+	queries := benchmarkQueries(2 * WeightCacheCapacity)
+	b.Run("compact", func(b *testing.B) {
+		cache := newLRUCache[weightCacheKey, uint64](WeightCacheCapacity)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			q := &queries[i%len(queries)]
+			cache.Put(makeWeightCacheKey(q.balanceRound, q.addr, q.selectionID), 1)
+		}
+	})
+	b.Run("tuple", func(b *testing.B) {
+		cache := newLRUCache[tupleWeightCacheKey, uint64](WeightCacheCapacity)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cache.Put(queries[i%len(queries)], 1)
+		}
+	})
+}
+
+// BenchmarkWeightCacheMemory reports the heap held by a full weight cache.
+func BenchmarkWeightCacheMemory(b *testing.B) {
+	deadlock.Opts.Disable = true
+	queries := benchmarkQueries(WeightCacheCapacity)
+	b.Run("compact", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cache := newLRUCache[weightCacheKey, uint64](WeightCacheCapacity)
+			for _, q := range queries {
+				cache.Put(makeWeightCacheKey(q.balanceRound, q.addr, q.selectionID), 1)
+			}
+		}
+	})
+	b.Run("tuple", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cache := newLRUCache[tupleWeightCacheKey, uint64](WeightCacheCapacity)
+			for _, q := range queries {
+				cache.Put(q, 1)
+			}
+		}
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"sync/atomic"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+)
+
+const (
+	// workingSetRounds is how many balance rounds of working set sizes an
+	// adaptive weight cache is sized by.
+	workingSetRounds = 8
+
+	// workingSetHeadroom is the weight cache capacity kept for each weight in
+	// the largest recent working set. Around a round change votes are verified
+	// against two balance rounds, so both rounds' weights must fit.
+	workingSetHeadroom = 2
+
+	// sketchPrecision is the number of hash bits that pick a sketch register.
+	// 4096 registers estimate within about 2%.
+	sketchPrecision = 12
+)
+
+// distinctSketch estimates how many distinct weight cache keys were added to
+// it, in fixed space and without locking. It is a HyperLogLog sketch; the
+// keys are already uniform hashes, so their bits are used directly.
+type distinctSketch struct {
+	registers [1 << sketchPrecision]atomic.Uint32
+}
+
+func (s *distinctSketch) add(key weightCacheKey) {
+	h := binary.LittleEndian.Uint64(key[:8])
+	// The set bit bounds the rank by the bits left after the register index.
+	rank := uint32(bits.LeadingZeros64(h<<sketchPrecision|1<<(sketchPrecision-1))) + 1
+	r := &s.registers[h>>(64-sketchPrecision)]
+	for {
+		old := r.Load()
+		if rank <= old || r.CompareAndSwap(old, rank) {
+			return
+		}
+	}
+}
+
+// estimate returns the approximate number of distinct keys added.
+func (s *distinctSketch) estimate() int {
+	m := float64(len(s.registers))
+	sum := 0.0
+	zeros := 0
+	for i := range s.registers {
+		r := s.registers[i].Load()
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	e := 0.7213 / (1 + 1.079/m) * m * m / sum
+	// Small sets are counted better by the registers still empty.
+	if e <= 2.5*m && zeros > 0 {
+		e = m * math.Log(m/float64(zeros))
+	}
+	return int(e + 0.5)
+}
+
+func (s *distinctSketch) reset() {
+	for i := range s.registers {
+		s.registers[i].Store(0)
+	}
+}
+
+// weightCacheSizer tracks the working set of the weight cache, the distinct
+// weights looked up while each balance round was the newest, and resizes the
+// cache to hold workingSetHeadroom times the largest of the last
+// workingSetRounds of them, within [min, max]. The cache grows as soon as
+// that is more than its capacity, but shrinks only once it is under half, so
+// that it does not churn on ordinary variation.
+type weightCacheSizer struct {
+	min, max int
+	resize   func(capacity int)
+
+	// round is the newest balance round looked up, whose working set the
+	// sketch is counting.
+	round  atomic.Uint64
+	sketch distinctSketch
+
+	mu       deadlock.Mutex
+	capacity int
+	recent   [workingSetRounds]int
+	next     int
+}
+
+func newWeightCacheSizer(capacity, min, max int, resize func(capacity int)) *weightCacheSizer {
+	return &weightCacheSizer{min: min, max: max, resize: resize, capacity: capacity}
+}
+
+// observe counts a lookup of key at balanceRound. The first lookup of a newer
+// round closes the working set of the previous one.
+func (s *weightCacheSizer) observe(balanceRound basics.Round, key weightCacheKey) {
+	if cur := s.round.Load(); uint64(balanceRound) > cur && s.round.CompareAndSwap(cur, uint64(balanceRound)) {
+		// Lookups for the new round made while this runs may be lost to
+		// the reset; the working set is an estimate either way.
+		s.closeRound(cur != 0)
+	}
+	s.sketch.add(key)
+}
+
+func (s *weightCacheSizer) closeRound(record bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	workingSet := s.sketch.estimate()
+	s.sketch.reset()
+	if !record {
+		return
+	}
+	s.recent[s.next] = workingSet
+	s.next = (s.next + 1) % workingSetRounds
+
+	peak := 0
+	for _, n := range s.recent {
+		peak = max(peak, n)
+	}
+	target := min(max(peak*workingSetHeadroom, s.min), s.max)
+	if target > s.capacity || target <= s.capacity/2 {
+		if target != s.capacity {
+			logging.Base().Infof("resizing weight cache from %d to %d entries for a working set of up to %d weights per round",
+				s.capacity, target, peak)
+			s.capacity = target
+			s.resize(target)
+		}
+	}
+}
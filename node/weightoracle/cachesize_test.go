@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestDistinctSketch tests that the sketch estimates distinct keys within a
+// few percent, ignoring repeats, across the range of cache sizes.
+func TestDistinctSketch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var s distinctSketch
+	require.Zero(t, s.estimate())
+	for _, n := range []int{10, 1_000, 50_000, 1_000_000} {
+		s.reset()
+		for i := 0; i < n; i++ {
+			key := makeWeightCacheKey(basics.Round(i), makeTestAddress(n), makeTestSelectionID(n))
+			s.add(key)
+			s.add(key)
+		}
+		require.InEpsilon(t, n, s.estimate(), 0.05, "%d keys", n)
+	}
+}
+
+// sizerRound looks up n distinct weights at balanceRound.
+func sizerRound(s *weightCacheSizer, balanceRound basics.Round, n int) {
+	for i := 0; i < n; i++ {
+		s.observe(balanceRound, makeWeightCacheKey(balanceRound, makeTestAddress(i), makeTestSelectionID(i)))
+	}
+}
+
+// TestWeightCacheSizer tests that the cache grows with the working set, stays
+// within its bounds, and shrinks only once the working set is well below it.
+func TestWeightCacheSizer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var resizes []int
+	s := newWeightCacheSizer(1000, 500, 8000, func(capacity int) { resizes = append(resizes, capacity) })
+
+	// A working set that fits leaves the cache alone.
+	sizerRound(s, 1, 300)
+	sizerRound(s, 2, 300)
+	require.Empty(t, resizes)
+
+	// More traffic grows the cache at the next round.
+	sizerRound(s, 3, 2000)
+	sizerRound(s, 4, 10)
+	require.Len(t, resizes, 1)
+	require.InEpsilon(t, 4000, resizes[0], 0.05)
+
+	// Growth stops at the maximum.
+	sizerRound(s, 5, 6000)
+	sizerRound(s, 6, 10)
+	require.Equal(t, 8000, resizes[len(resizes)-1])
+
+	// The cache shrinks to the minimum once the busy rounds are forgotten,
+	// and not before.
+	resizes = nil
+	for r := basics.Round(7); r < 7+workingSetRounds; r++ {
+		sizerRound(s, r, 10)
+	}
+	require.Equal(t, []int{500}, resizes)
+
+	// Lookups of older rounds count toward the newest round's working set.
+	sizerRound(s, 20, 10)
+	sizerRound(s, 19, 2000)
+	sizerRound(s, 21, 10)
+	require.InEpsilon(t, 4000, resizes[len(resizes)-1], 0.05)
+}
+
+// TestClientAdaptiveWeightCache tests that a client with an adaptive weight
+// cache resizes it to the weights looked up per round.
+func TestClientAdaptiveWeightCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		return map[string]interface{}{"weight": "1"}
+	})
+	defer server.Close()
+
+	cfg := testClientConfig()
+	cfg.WeightCacheCapacity = 100
+	cfg.WeightCacheMinCapacity = 100
+	cfg.WeightCacheMaxCapacity = 1000
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+
+	lookup := func(balanceRound basics.Round, n int) {
+		for i := 0; i < n; i++ {
+			_, err := client.Weight(balanceRound, makeTestAddress(i), makeTestSelectionID(i))
+			require.NoError(t, err)
+		}
+	}
+	lookup(1, 200)
+	require.Equal(t, 100, client.weightCache.Len())
+	lookup(2, 1)
+	capacity := client.weightCache.(*lruCache[weightCacheKey, weightAnswer]).capacity
+	require.InEpsilon(t, 400, capacity, 0.05)
+	require.Equal(t, capacity/hotWeightShare, int(client.hotWeights.limit.Load()))
+
+	// The second pass over the round fits in the grown cache.
+	lookup(2, 200)
+	before := client.Stats()
+	lookup(2, 200)
+	require.Equal(t, QueryStats{CacheHits: 200}, client.Stats().Sub(before))
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// The capabilities a daemon may list in its identity answer, one for each
+// optional part of the protocol. A daemon that lists its capabilities is not
+// asked for the parts it leaves out; one that does not list them is asked,
+// and the client falls back when it answers not_found or unsupported.
+// Capabilities the client does not know are ignored, so that daemons can
+// list those of later clients.
+const (
+	// CapabilityBatch is listed by daemons that answer /weights.
+	CapabilityBatch = "batch"
+
+	// CapabilityMsgpack is listed by daemons that speak the msgpack encoding
+	// (see msgpack.go). The client sends msgpack only to daemons that list
+	// it.
+	CapabilityMsgpack = "msgpack"
+
+	// CapabilityPush is listed by daemons that push weight tables over
+	// /subscribe.
+	CapabilityPush = "push"
+
+	// CapabilityAttestation is listed by daemons that sign the weights they
+	// answer when asked to over /attestation (see attestation.go).
+	CapabilityAttestation = "attestation"
+)
+
+// maxCapabilities bounds the capabilities of an identity response.
+const maxCapabilities = 32
+
+// checkCapabilities checks the capabilities of an identity response, which
+// are kept as they are.
+func checkCapabilities(capabilities []string) error {
+	if len(capabilities) > maxCapabilities {
+		return fmt.Errorf("identity response lists %d capabilities, more than %d", len(capabilities), maxCapabilities)
+	}
+	for _, capability := range capabilities {
+		if capability == "" {
+			return fmt.Errorf("identity response lists an empty capability")
+		}
+		if err := checkVersion("capability", capability); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// daemonSupports reports whether the client may ask the daemon for the
+// optional part of the protocol named capability: whether the daemon's last
+// identity answer lists it, or there has been none, or it lists no
+// capabilities at all.
+func (c *Client) daemonSupports(capability string) bool {
+	identity := c.lastIdentity.Load()
+	return identity == nil || identity.Supports(capability)
+}
+
+// handlerCapabilities returns the capabilities a handler serving oracle
+// lists in its identity answers.
+func handlerCapabilities(oracle interface{}) []string {
+	capabilities := []string{CapabilityBatch, CapabilityMsgpack}
+	_, publisher := oracle.(WeightTablePublisher)
+	_, tabler := oracle.(WeightTabler)
+	if publisher && tabler {
+		capabilities = append(capabilities, CapabilityPush)
+	}
+	if _, attester := oracle.(ledgercore.AttestingWeightOracle); attester {
+		capabilities = append(capabilities, CapabilityAttestation)
+	}
+	return capabilities
+}
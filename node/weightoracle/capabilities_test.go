@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// capabilityServer is a daemon whose identity lists the capabilities set, or
+// none if they are nil, and that counts the requests to each endpoint.
+type capabilityServer struct {
+	*testServer
+
+	mu           sync.Mutex
+	capabilities interface{}
+	requests     map[string]int
+}
+
+func newCapabilityServer(t *testing.T, capabilities interface{}) *capabilityServer {
+	testHash := makeTestGenesisHash()
+	s := &capabilityServer{capabilities: capabilities, requests: make(map[string]int)}
+	s.testServer = newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests[path]++
+		switch path {
+		case "/identity":
+			resp := map[string]interface{}{
+				"genesis_hash":      base64.StdEncoding.EncodeToString(testHash[:]),
+				"protocol_version":  "1.0",
+				"algorithm_version": "1.0",
+			}
+			if s.capabilities != nil {
+				resp["capabilities"] = s.capabilities
+			}
+			return resp
+		case "/weights":
+			queries := req["queries"].([]interface{})
+			weights := make([]map[string]string, len(queries))
+			for i := range weights {
+				weights[i] = map[string]string{"weight": "100"}
+			}
+			return map[string]interface{}{"weights": weights}
+		case "/subscribe":
+			return map[string]interface{}{}
+		}
+		return map[string]interface{}{"weight": "100"}
+	})
+	return s
+}
+
+func (s *capabilityServer) requestCount(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[endpoint]
+}
+
+func TestIdentityCapabilities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Capabilities the client does not know are kept, so that daemons can
+	// list those of later clients.
+	server := newCapabilityServer(t, []string{"batch", "teleport", "msgpack"})
+	defer server.Close()
+	identity, err := NewClient(server.port).Identity()
+	require.NoError(t, err)
+	require.Equal(t, []string{"batch", "teleport", "msgpack"}, identity.Capabilities)
+	require.True(t, identity.Supports(CapabilityBatch))
+	require.True(t, identity.Supports("teleport"))
+	require.False(t, identity.Supports(CapabilityPush))
+
+	// A daemon that does not list its capabilities may support any of them,
+	// and one that lists none supports none.
+	unlisted := newCapabilityServer(t, nil)
+	defer unlisted.Close()
+	identity, err = NewClient(unlisted.port).Identity()
+	require.NoError(t, err)
+	require.Nil(t, identity.Capabilities)
+	require.True(t, identity.Supports(CapabilityPush))
+
+	none := newCapabilityServer(t, []string{})
+	defer none.Close()
+	identity, err = NewClient(none.port).Identity()
+	require.NoError(t, err)
+	require.NotNil(t, identity.Capabilities)
+	require.False(t, identity.Supports(CapabilityBatch))
+
+	for _, bad := range []interface{}{
+		[]string{""},
+		[]string{"bad\x00capability"},
+		[]string{strings.Repeat("c", maxVersionLength+1)},
+		make([]string, maxCapabilities+1),
+		"batch",
+	} {
+		server := newCapabilityServer(t, bad)
+		_, err := NewClient(server.port).Identity()
+		require.Error(t, err, "%v", bad)
+		server.Close()
+	}
+}
+
+func TestCapabilitiesGateOptionalRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	queries := []ledgercore.WeightQuery{
+		{Addr: makeTestAddress(1), SelectionID: crypto.VRFVerifier{1}},
+		{Addr: makeTestAddress(2), SelectionID: crypto.VRFVerifier{2}},
+	}
+
+	// Before the identity is known, and with a daemon that does not list its
+	// capabilities, optional requests are tried.
+	server := newCapabilityServer(t, nil)
+	defer server.Close()
+	client := NewClient(server.port)
+	_, err := client.Weights(context.Background(), 1, queries)
+	require.NoError(t, err)
+	require.Equal(t, 1, server.requestCount("/weights"))
+	_, err = client.Identity()
+	require.NoError(t, err)
+	_, err = client.Weights(context.Background(), 2, queries)
+	require.NoError(t, err)
+	require.Equal(t, 2, server.requestCount("/weights"))
+	_, _, err = client.NextWeightTable(context.Background(), 1, time.Millisecond)
+	require.ErrorIs(t, err, ErrNoNewWeightTable)
+	require.Equal(t, 1, server.requestCount("/subscribe"))
+
+	// Once the daemon lists capabilities without batch and push, those
+	// requests are not sent.
+	server.mu.Lock()
+	server.capabilities = []string{"teleport"}
+	server.mu.Unlock()
+	_, err = client.Identity()
+	require.NoError(t, err)
+	results, err := client.Weights(context.Background(), 3, queries)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), results[1].Weight)
+	require.Equal(t, 2, server.requestCount("/weights"))
+	require.Equal(t, 2, server.requestCount("/weight"))
+	_, _, err = client.NextWeightTable(context.Background(), 1, time.Millisecond)
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"), "%v", err)
+	require.Equal(t, 1, server.requestCount("/subscribe"))
+}
+
+func TestHandlerCapabilities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, test := range []struct {
+		oracle ledgercore.WeightOracle
+		want   []string
+	}{
+		{mock.New(), []string{CapabilityBatch, CapabilityMsgpack}},
+		{newPublishingOracle(), []string{CapabilityBatch, CapabilityMsgpack, CapabilityPush}},
+		{mock.NewAttester(mock.New(), crypto.GenerateSignatureSecrets(crypto.Seed{1})), []string{CapabilityBatch, CapabilityMsgpack, CapabilityAttestation}},
+	} {
+		server, err := StartServer("127.0.0.1:0", test.oracle)
+		require.NoError(t, err)
+		identity, err := NewClientURL(server.URL()).Identity()
+		require.NoError(t, err)
+		require.Equal(t, test.want, identity.Capabilities)
+		server.Close()
+	}
+}
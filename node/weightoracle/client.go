@@ -17,21 +17,33 @@
 package weightoracle
 
 import (
-	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"math/rand/v2"
 	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
 )
 
 const (
@@ -46,14 +58,332 @@ const (
 
 	// TotalWeightCacheCapacity is the maximum number of total weight query results to cache.
 	TotalWeightCacheCapacity = 1000
+
+	// MaxResponseSize is the largest response body accepted from the daemon.
+	// Weight, total weight, ping and identity answers are a few hundred bytes.
+	MaxResponseSize = 64 << 10
+
+	// MaxWeightTableResponseSize is the largest /weight_table response body
+	// accepted from the daemon, which lists every account it knows.
+	MaxWeightTableResponseSize = 256 << 20
+
+	// maxErrorTextSize bounds how much daemon-supplied text is kept in errors,
+	// which end up in logs.
+	maxErrorTextSize = 512
+
+	// maxVersionLength bounds the version strings of an identity response.
+	maxVersionLength = 64
 )
 
-// weightCacheKey is the key for the weight LRU cache.
-// It combines all parameters that uniquely identify a weight query.
-type weightCacheKey struct {
-	balanceRound basics.Round
-	addr         basics.Address
-	selectionID  crypto.VRFVerifier
+// ClientConfig holds the tunable settings of a Client.
+type ClientConfig struct {
+	// DialTimeout bounds connection establishment.
+	DialTimeout time.Duration
+
+	// SocketOwner is the uid that must own the unix socket of a daemon
+	// reached on one (see checkSocket).
+	SocketOwner int
+
+	// QueryTimeout bounds a single request attempt.
+	QueryTimeout time.Duration
+
+	// Retry controls which failed requests are sent again.
+	Retry RetryPolicy
+
+	// WeightCacheCapacity and TotalWeightCacheCapacity size the result caches,
+	// and CacheEviction chooses which entry they drop when full.
+	WeightCacheCapacity      int
+	TotalWeightCacheCapacity int
+	CacheEviction            EvictionPolicy
+
+	// When WeightCacheMaxCapacity is nonzero, the weight cache starts at
+	// WeightCacheCapacity and is resized to follow the number of distinct
+	// weights recently looked up per round, between WeightCacheMinCapacity
+	// and WeightCacheMaxCapacity.
+	WeightCacheMinCapacity int
+	WeightCacheMaxCapacity int
+
+	// NotFoundTTL is how long a not_found answer to a weight lookup is
+	// reused for the same query; 0 asks the daemon every time.
+	NotFoundTTL time.Duration
+
+	// StaleRounds, when nonzero, answers a lookup the daemon cannot be
+	// reached for with the cached answer to the same query for the newest of
+	// the StaleRounds balance rounds before, if there is one (see
+	// config.Local.ExternalWeightOracleFailureMode).
+	StaleRounds uint64
+
+	// When BreakerThreshold is nonzero, requests fail with ErrCircuitOpen for
+	// BreakerCooldown once that percentage of the last BreakerWindow requests
+	// failed (see circuitBreaker).
+	BreakerThreshold int
+	BreakerWindow    int
+	BreakerCooldown  time.Duration
+
+	// MaxConcurrentRequests limits the requests in flight; 0 means unlimited.
+	MaxConcurrentRequests int
+
+	// MaxConnections limits the connections to the daemon, idle or busy; 0
+	// means unlimited. An attempt holds a connection while it runs, and with
+	// RejectConnectionOverflow fails with ErrConnectionLimit instead of
+	// waiting when none is free.
+	MaxConnections           int
+	RejectConnectionOverflow bool
+
+	// WarmConnections is how many connections WarmConnections opens and the
+	// transport keeps idle; 0 means none are opened ahead of need.
+	WarmConnections int
+
+	// ProfileHook, if not nil, is called around every request; see TraceHook.
+	ProfileHook ProfileHook
+
+	// LookupLogSampling logs one in every that many Weight and TotalWeight
+	// lookups at debug level (see LookupLogMessage); 0 logs none.
+	LookupLogSampling uint64
+
+	// BackpressureQueueDepth and BackpressureLatencyFactor are the criteria
+	// of ExternalWeightSaturated, which must hold for BackpressureWindow; 0
+	// disables a criterion.
+	BackpressureQueueDepth    int
+	BackpressureLatencyFactor int
+	BackpressureWindow        time.Duration
+
+	// HTTP2 sends requests over HTTP/2: negotiated for https daemons, and
+	// without negotiation (h2c) for http ones. Attempts then share the
+	// MaxConnections connections rather than holding one each.
+	HTTP2 bool
+
+	// GRPC sends requests as calls to the gRPC service of weightoracle.proto
+	// instead of JSON over HTTP. Calls share one HTTP/2 connection, so
+	// MaxConnections, RejectConnectionOverflow, HTTP2, StrictResponses,
+	// SigningKey and Transport do not apply; requests to endpoints outside the
+	// service are answered as unsupported.
+	GRPC bool
+
+	// Msgpack sends weight, total weight, weight table and subscribe
+	// requests in msgpack instead of JSON to daemons whose identity lists
+	// CapabilityMsgpack (see msgpack.go).
+	Msgpack bool
+
+	// EndpointConcurrency further limits the requests in flight to
+	// individual endpoints, keyed by endpoint path such as "/weight".
+	EndpointConcurrency map[string]int
+
+	// RateLimits limits the requests per second sent to individual endpoints,
+	// keyed by endpoint path (see Client.SetOwnAccounts for which requests
+	// are dropped rather than delayed).
+	RateLimits map[string]int
+
+	// Bounds are the limits that weight answers must satisfy to be accepted.
+	Bounds WeightBounds
+
+	// StrictResponses rejects successful responses with unknown or repeated
+	// fields, or strings longer than MaxResponseStringLength.
+	StrictResponses bool
+
+	// AuthToken, when not empty, is sent with every request as a bearer token.
+	AuthToken config.Secret
+
+	// SigningKey, when not empty, signs every request (see RequireSignatures).
+	SigningKey config.Secret
+
+	// SignedResponses rejects responses that are not signed with SigningKey
+	// for the request they answer (see ResponseSignatureHeader).
+	SignedResponses bool
+
+	// TLSPins, when not empty, are the SHA-256 hashes of the certificate keys
+	// accepted from an https daemon, replacing verification against the
+	// system CA store (see config.Local.ExternalWeightOracleTLSPins).
+	TLSPins [][]byte
+
+	// TLSRootCAs, when not nil, replaces the system CA store for the
+	// certificate of an https daemon. TLSClientCertificate, when not nil, is
+	// presented to a daemon that authenticates its clients.
+	TLSRootCAs           *x509.CertPool
+	TLSClientCertificate *tls.Certificate
+
+	// Transport, when not nil, carries the requests instead of a direct
+	// connection, and DialTimeout and the TLS settings are ignored. Tests use it to record or
+	// replay daemon sessions (see RecordingTransport and ReplayTransport).
+	Transport http.RoundTripper
+
+	// Failover are the base URLs of standby daemons, in order of preference,
+	// that requests move to when the daemon in use fails (see failover.go).
+	// They are reached with the same settings as the main daemon.
+	Failover []*url.URL
+}
+
+// ErrorClass is a set of request failure classes.
+type ErrorClass uint8
+
+const (
+	// RetryConnect is a failure to send the request or read the response.
+	RetryConnect ErrorClass = 1 << iota
+	// RetryTimeout is an attempt that exceeded the query timeout.
+	RetryTimeout
+	// RetryInternal is a daemon error response with code "internal".
+	RetryInternal
+	// RetryUnavailable is an HTTP 5xx response without a daemon error body.
+	RetryUnavailable
+)
+
+var errorClassNames = map[string]ErrorClass{
+	config.WeightOracleRetryConnect:     RetryConnect,
+	config.WeightOracleRetryTimeout:     RetryTimeout,
+	config.WeightOracleRetryInternal:    RetryInternal,
+	config.WeightOracleRetryUnavailable: RetryUnavailable,
+}
+
+// RetryPolicy describes how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a request is sent before a
+	// retryable failure is returned. Values below 1 mean 1.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry. It doubles on
+	// each further retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// Retryable is the set of failure classes that are retried.
+	Retryable ErrorClass
+
+	// Jitter is the percentage, from 0 to 100, by which each backoff is
+	// shortened at random, so that requests failing together are not retried
+	// together.
+	Jitter int
+
+	// Budget, when nonzero, bounds the time from a request's first attempt to
+	// the end of its last, backoffs included. Attempts are cut short to fit,
+	// and a retry whose backoff would use up the budget is not made.
+	Budget time.Duration
+}
+
+// backoff returns the delay before the given retry, counting from 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, max(p.MaxBackoff, p.InitialBackoff))
+}
+
+// delay returns the backoff before the given retry with the jitter applied.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	d := p.backoff(retry)
+	if p.Jitter > 0 && d > 0 {
+		d -= time.Duration(rand.Int64N(int64(d)*int64(p.Jitter)/100 + 1))
+	}
+	return d
+}
+
+// DefaultClientConfig returns the settings used by NewClient and NewClientURL.
+func DefaultClientConfig() ClientConfig {
+	return ClientConfig{
+		DialTimeout:              DefaultDialTimeout,
+		SocketOwner:              os.Getuid(),
+		QueryTimeout:             DefaultQueryTimeout,
+		Retry:                    RetryPolicy{MaxAttempts: 1},
+		WeightCacheCapacity:      WeightCacheCapacity,
+		TotalWeightCacheCapacity: TotalWeightCacheCapacity,
+		Msgpack:                  true,
+	}
+}
+
+// MakeClientConfig returns the client settings configured in cfg. The values
+// are expected to have passed config.Local.ValidateExternalWeightOracleConfig.
+func MakeClientConfig(cfg config.Local) ClientConfig {
+	var retryable ErrorClass
+	classes, _ := cfg.ExternalWeightOracleRetryableErrorClasses()
+	for _, class := range classes {
+		retryable |= errorClassNames[class]
+	}
+	var endpointConcurrency map[string]int
+	limits, _ := cfg.ExternalWeightOracleEndpointConcurrencyLimits()
+	for endpoint, limit := range limits {
+		if endpointConcurrency == nil {
+			endpointConcurrency = make(map[string]int, len(limits))
+		}
+		endpointConcurrency["/"+endpoint] = int(limit)
+	}
+	var rateLimits map[string]int
+	rates, _ := cfg.ExternalWeightOracleEndpointRateLimits()
+	for endpoint, rate := range rates {
+		if rateLimits == nil {
+			rateLimits = make(map[string]int, len(rates))
+		}
+		rateLimits["/"+endpoint] = int(rate)
+	}
+	pins, _ := cfg.ExternalWeightOracleTLSPinHashes()
+	failover, _ := cfg.ExternalWeightOracleFailoverEndpoints()
+	minWeightCache := cfg.ExternalWeightOracleWeightCacheMinSize
+	if minWeightCache == 0 {
+		minWeightCache = cfg.ExternalWeightOracleWeightCacheSize
+	}
+	eviction := EvictLRU
+	if cfg.ExternalWeightOracleCacheEviction == config.WeightOracleCacheEvictionRound {
+		eviction = EvictOldestRound
+	}
+	var staleRounds uint64
+	if cfg.ExternalWeightOracleFailureMode != config.WeightOracleFailureHalt {
+		staleRounds = cfg.ExternalWeightOracleStaleRounds
+	}
+	socketOwner := int(cfg.ExternalWeightOracleSocketOwner)
+	if socketOwner == -1 {
+		socketOwner = os.Getuid()
+	}
+	var profileHook ProfileHook
+	if cfg.ExternalWeightOracleTraceRequests {
+		profileHook = TraceHook
+	}
+	return ClientConfig{
+		DialTimeout:  cfg.ExternalWeightOracleDialTimeout,
+		SocketOwner:  socketOwner,
+		QueryTimeout: cfg.ExternalWeightOracleQueryTimeout,
+		Retry: RetryPolicy{
+			MaxAttempts:    int(cfg.ExternalWeightOracleMaxAttempts),
+			InitialBackoff: cfg.ExternalWeightOracleRetryInitialBackoff,
+			MaxBackoff:     cfg.ExternalWeightOracleRetryMaxBackoff,
+			Retryable:      retryable,
+			Jitter:         int(cfg.ExternalWeightOracleRetryJitter),
+			Budget:         cfg.ExternalWeightOracleRetryBudget,
+		},
+		WeightCacheCapacity:      int(cfg.ExternalWeightOracleWeightCacheSize),
+		TotalWeightCacheCapacity: int(cfg.ExternalWeightOracleTotalWeightCacheSize),
+		CacheEviction:            eviction,
+		WeightCacheMinCapacity:   int(minWeightCache),
+		WeightCacheMaxCapacity:   int(cfg.ExternalWeightOracleWeightCacheMaxSize),
+		NotFoundTTL:              cfg.ExternalWeightOracleNotFoundCacheTTL,
+		StaleRounds:              staleRounds,
+		BreakerThreshold:         int(cfg.ExternalWeightOracleCircuitBreakerThreshold),
+		BreakerWindow:            int(cfg.ExternalWeightOracleCircuitBreakerWindow),
+		BreakerCooldown:          cfg.ExternalWeightOracleCircuitBreakerCooldown,
+		MaxConcurrentRequests:    int(cfg.ExternalWeightOracleMaxConcurrentRequests),
+		MaxConnections:           int(cfg.ExternalWeightOracleMaxConnections),
+		RejectConnectionOverflow: cfg.ExternalWeightOracleConnectionOverflow == config.WeightOracleConnectionOverflowReject,
+		WarmConnections:          int(cfg.ExternalWeightOracleWarmConnections),
+		HTTP2:                    cfg.ExternalWeightOracleHTTP2,
+		GRPC:                     cfg.ExternalWeightOracleProtocol == config.WeightOracleProtocolGRPC,
+		Msgpack:                  cfg.ExternalWeightOracleEncoding == config.WeightOracleEncodingAuto,
+		EndpointConcurrency:      endpointConcurrency,
+		RateLimits:               rateLimits,
+		Bounds: WeightBounds{
+			MaxWeight:      cfg.ExternalWeightOracleMaxWeight,
+			MaxTotalWeight: cfg.ExternalWeightOracleMaxTotalWeight,
+			MaxRatioPPM:    cfg.ExternalWeightOracleMaxWeightRatioPPM,
+		},
+		StrictResponses: cfg.ExternalWeightOracleStrictResponses,
+		SignedResponses: cfg.ExternalWeightOracleSignedResponses,
+		TLSPins:         pins,
+		ProfileHook:     profileHook,
+		Failover:        failover,
+
+		LookupLogSampling: cfg.ExternalWeightOracleLookupLogSampling,
+
+		BackpressureQueueDepth:    int(cfg.ExternalWeightOracleBackpressureQueueDepth),
+		BackpressureLatencyFactor: int(cfg.ExternalWeightOracleBackpressureLatencyFactor),
+		BackpressureWindow:        cfg.ExternalWeightOracleBackpressureWindow,
+	}
 }
 
 // totalWeightCacheKey is the key for the total weight LRU cache.
@@ -63,45 +393,358 @@ type totalWeightCacheKey struct {
 	voteRound    basics.Round
 }
 
+// totalWeightRound is the balance round of a total weight cache entry.
+func totalWeightRound(k totalWeightCacheKey, _ uint64) basics.Round { return k.balanceRound }
+
 // Client implements ledgercore.WeightOracle by communicating with an external
-// weight daemon over HTTP REST.
+// weight daemon over HTTP REST, or over gRPC (see ClientConfig.GRPC).
 type Client struct {
-	baseURL      string
-	httpClient   *http.Client
+	// daemons are the daemons requests can be sent to: the main one, then
+	// the failover daemons in order of preference. failover tracks which one
+	// is in use.
+	daemons  []*daemon
+	failover failoverState
+
 	queryTimeout time.Duration
+	retry        RetryPolicy
+
+	// strict selects decodeStrict for successful responses.
+	strict bool
+
+	// msgpack allows requests in msgpack to daemons that speak it.
+	msgpack bool
+
+	// authorization is the Authorization header value, or empty when the
+	// daemon requires no credentials.
+	authorization string
+
+	// signingKey signs each request attempt when not empty. With
+	// signedResponses the answers must be signed with it too, and their
+	// nonces, held in responseNonces, must keep to the daemon's counter.
+	signingKey      []byte
+	signedResponses bool
+	responseNonces  nonceMarks
+
+	// requestSlots limits the requests in flight when non-nil; a request
+	// holds one slot for all of its attempts. endpointSlots holds the
+	// per-endpoint limits, taken after the overall one.
+	requestSlots  chan struct{}
+	endpointSlots map[string]chan struct{}
+
+	// connSlots limits the attempts in flight, and so the connections in
+	// use, when non-nil; rejectOverflow fails attempts that find it full.
+	connSlots      chan struct{}
+	rejectOverflow bool
+
+	// warmConnections is how many pings WarmConnections sends at once.
+	warmConnections int
+
+	// starting labels requests with PhaseStartup; profileHook, if not nil,
+	// runs around each request.
+	starting    atomic.Bool
+	profileHook ProfileHook
+
+	// lookupLog logs a sample of the weight and total weight lookups.
+	lookupLog lookupLogger
+
+	// noBatches is set once the daemon has turned down a /weights request,
+	// after which Weights looks each weight up on its own.
+	noBatches atomic.Bool
+
+	// noKeyRotations is set once the daemon has turned down a /key_rotations
+	// notification, after which NotifyKeyRotations sends no more.
+	noKeyRotations atomic.Bool
+
+	// breaker fails requests fast while the daemon keeps failing them; nil
+	// when disabled.
+	breaker *circuitBreaker
+
+	// pressure tells whether the daemon is saturated; nil if neither
+	// criterion is enabled.
+	pressure *pressureGauge
+
+	// rateLimits holds the per-endpoint rate limits, and ownAccounts the
+	// accounts whose weight lookups they never drop.
+	rateLimits  map[string]*tokenBucket
+	ownAccounts atomic.Pointer[func(basics.Address) bool]
 
 	// weightCache caches weight query results to reduce daemon queries.
-	// Key: (balanceRound, addr, selectionID), Value: weight (uint64)
-	weightCache *lruCache[weightCacheKey, uint64]
+	// Key: digest of (balanceRound, addr, selectionID), Value: the weight and
+	// the query it answers, for ExportCache
+	weightCache answerCache[weightCacheKey, weightAnswer]
+
+	// attestations caches the daemon's attestations of weights, under the
+	// keys of the weights they attest.
+	attestations *lruCache[weightCacheKey, committee.WeightAttestation]
+
+	// hotWeights serves weight cache hits for the newest balance round
+	// without locking, before weightCache is consulted.
+	hotWeights *hotWeightFront
+
+	// weightCacheSizer resizes weightCache and hotWeights to the observed
+	// working set when the cache is adaptive, and is nil otherwise.
+	weightCacheSizer *weightCacheSizer
+
+	// notFound caches not_found answers to weight queries for a while; nil
+	// when they are not cached.
+	notFound *notFoundCache
+
+	// staleRounds is ClientConfig.StaleRounds, and stale is set while
+	// lookups are answered with the weights of earlier rounds (see Stale).
+	staleRounds basics.Round
+	stale       atomic.Bool
 
 	// totalWeightCache caches total weight query results to reduce daemon queries.
 	// Key: (balanceRound, voteRound), Value: totalWeight (uint64)
-	totalWeightCache *lruCache[totalWeightCacheKey, uint64]
+	totalWeightCache answerCache[totalWeightCacheKey, uint64]
+
+	// bounds rejects out-of-range answers before they are cached.
+	bounds *boundsChecker
+
+	// queries counts requests sent to the daemon; cacheHits and cacheMisses
+	// count weight and total weight lookups answered from the caches or not.
+	queries     atomic.Uint64
+	cacheHits   atomic.Uint64
+	cacheMisses atomic.Uint64
+
+	// recent remembers the latest requests for diagnostics.
+	recent queryLog
+
+	// observers receive the client's events; see AddObserver.
+	observers observerList
+
+	// overrides, if not nil, replace the daemon's answers for some accounts;
+	// see SetOverrides.
+	overrides atomic.Pointer[Overrides]
+
+	// sleep waits out the backoff between attempts, and now tells the time
+	// not_found answers expire against; tests replace them.
+	sleep func(time.Duration)
+	now   func() time.Time
+
+	// lastIdentity is the most recent valid identity answer, nil before the
+	// first one.
+	lastIdentity atomic.Pointer[ledgercore.DaemonIdentity]
+
+	// lastAnswer is when a daemon last answered a request, in Unix
+	// nanoseconds, 0 before the first answer.
+	lastAnswer atomic.Int64
+}
+
+// QueryStats holds cumulative counts of the client's oracle lookups.
+type QueryStats struct {
+	// Queries is the number of requests sent to the daemon.
+	Queries uint64
+
+	// CacheHits is the number of Weight and TotalWeight lookups answered from cache.
+	CacheHits uint64
+
+	// CacheMisses is the number of Weight and TotalWeight lookups the cache
+	// could not answer.
+	CacheMisses uint64
+}
+
+// Sub returns the counts accumulated since an earlier snapshot.
+func (s QueryStats) Sub(earlier QueryStats) QueryStats {
+	return QueryStats{
+		Queries:     s.Queries - earlier.Queries,
+		CacheHits:   s.CacheHits - earlier.CacheHits,
+		CacheMisses: s.CacheMisses - earlier.CacheMisses,
+	}
+}
+
+// ErrUnauthorized is returned when the daemon rejects the client's credentials.
+var ErrUnauthorized = errors.New("weight daemon rejected the request credentials")
+
+// ErrUntrustedSocket is returned when the unix socket of a daemon is not a
+// socket, is owned by a user other than ClientConfig.SocketOwner, or can be
+// written by its group or others.
+var ErrUntrustedSocket = errors.New("weight daemon socket is not trusted")
+
+// ErrRequestAbandoned is returned, together with the context's error, for a
+// request whose context was done before the daemon answered it (see
+// WeightContext). An abandoned request does not count against the daemon: it
+// neither trips the circuit breaker nor fails over, and nothing is cached or
+// served stale in its place.
+var ErrRequestAbandoned = errors.New("weight daemon request abandoned")
+
+// abandoned returns the error of a request to endpoint abandoned because ctx
+// is done.
+func abandoned(ctx context.Context, endpoint string) error {
+	return fmt.Errorf("%w (%s): %w", ErrRequestAbandoned, endpoint, context.Cause(ctx))
 }
 
+// ErrBadResponseSignature is returned when signed responses are required and
+// a response is unsigned, altered, or signed for a different request.
+var ErrBadResponseSignature = errors.New("weight daemon response signature does not match the request")
+
+// ErrReplayedResponse is returned when signed responses are required and a
+// response carries a nonce already used, or one below the daemon's high-water
+// mark.
+var ErrReplayedResponse = errors.New("weight daemon response nonce was already used")
+
 // Compile-time interface check
 var _ ledgercore.WeightOracle = (*Client)(nil)
+var _ ledgercore.ContextWeightOracle = (*Client)(nil)
 
 // NewClient creates a new weight oracle client that connects to the daemon
 // at 127.0.0.1 on the specified port.
 func NewClient(port uint16) *Client {
-	return &Client{
-		baseURL: fmt.Sprintf("http://127.0.0.1:%d", port),
-		httpClient: &http.Client{
-			// Note: Timeout is not set here; we use per-request context for dynamic timeouts
-			Transport: &http.Transport{
-				MaxIdleConns:        10,
-				MaxIdleConnsPerHost: 10,
-				IdleConnTimeout:     90 * time.Second,
-				DialContext: (&net.Dialer{
-					Timeout: DefaultDialTimeout,
-				}).DialContext,
-			},
-		},
-		queryTimeout:     DefaultQueryTimeout,
-		weightCache:      newLRUCache[weightCacheKey, uint64](WeightCacheCapacity),
-		totalWeightCache: newLRUCache[totalWeightCacheKey, uint64](TotalWeightCacheCapacity),
+	return NewClientURL(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", port)})
+}
+
+// NewClientUnix creates a new weight oracle client that connects to the
+// daemon listening on the unix domain socket at path.
+func NewClientUnix(path string) *Client {
+	return NewClientURL(&url.URL{Scheme: "unix", Path: path})
+}
+
+// NewClientURL creates a new weight oracle client for the daemon at baseURL.
+// Endpoint paths are appended to baseURL, so it may carry a path prefix; it
+// should not end in a slash (see config.Local.ExternalWeightOracleEndpoint).
+// A unix URL names the daemon's unix domain socket by its path instead, and
+// the daemon is spoken to over HTTP on the socket.
+func NewClientURL(baseURL *url.URL) *Client {
+	return NewClientWithConfig(baseURL, DefaultClientConfig())
+}
+
+// NewClientWithConfig creates a new weight oracle client for the daemon at
+// baseURL with the given settings.
+func NewClientWithConfig(baseURL *url.URL, cfg ClientConfig) *Client {
+	daemons := []*daemon{newDaemon(baseURL, cfg)}
+	for _, u := range cfg.Failover {
+		daemons = append(daemons, newDaemon(u, cfg))
+	}
+	c := &Client{
+		daemons:          daemons,
+		queryTimeout:     cfg.QueryTimeout,
+		retry:            cfg.Retry,
+		strict:           cfg.StrictResponses,
+		msgpack:          cfg.Msgpack,
+		weightCache:      newAnswerCache(cfg.WeightCacheCapacity, cfg.CacheEviction, weightAnswerRound),
+		hotWeights:       newHotWeightFront(cfg.WeightCacheCapacity / hotWeightShare),
+		attestations:     newLRUCache[weightCacheKey, committee.WeightAttestation](attestationCacheSize),
+		notFound:         newNotFoundCache(cfg.WeightCacheCapacity, cfg.NotFoundTTL),
+		staleRounds:      basics.Round(cfg.StaleRounds),
+		totalWeightCache: newAnswerCache(cfg.TotalWeightCacheCapacity, cfg.CacheEviction, totalWeightRound),
+		bounds:           makeBoundsChecker(cfg.Bounds, cfg.TotalWeightCacheCapacity),
+		signingKey:       cfg.SigningKey.Bytes(),
+		signedResponses:  cfg.SignedResponses && !cfg.SigningKey.IsEmpty(),
+		profileHook:      cfg.ProfileHook,
+		breaker:          newCircuitBreaker(cfg.BreakerThreshold, cfg.BreakerWindow, cfg.BreakerCooldown),
+		pressure:         newPressureGauge(cfg.BackpressureQueueDepth, cfg.BackpressureLatencyFactor, cfg.BackpressureWindow),
+		sleep:            time.Sleep,
+		now:              time.Now,
+	}
+	c.lookupLog.log = logging.Base()
+	c.lookupLog.every = cfg.LookupLogSampling
+	if !cfg.AuthToken.IsEmpty() {
+		c.authorization = "Bearer " + string(cfg.AuthToken.Bytes())
+	}
+	if cfg.WeightCacheMaxCapacity > 0 {
+		c.weightCacheSizer = newWeightCacheSizer(cfg.WeightCacheCapacity, max(cfg.WeightCacheMinCapacity, 1), cfg.WeightCacheMaxCapacity, func(capacity int) {
+			c.weightCache.Resize(capacity)
+			c.hotWeights.setLimit(capacity / hotWeightShare)
+		})
+	}
+	if cfg.MaxConcurrentRequests > 0 {
+		c.requestSlots = make(chan struct{}, cfg.MaxConcurrentRequests)
+	}
+	c.warmConnections = cfg.WarmConnections
+	if cfg.HTTP2 || cfg.GRPC {
+		c.warmConnections = min(c.warmConnections, 1)
+	}
+	if cfg.MaxConnections > 0 && !cfg.HTTP2 && !cfg.GRPC {
+		c.connSlots = make(chan struct{}, cfg.MaxConnections)
+		c.rejectOverflow = cfg.RejectConnectionOverflow
+	}
+	for endpoint, limit := range cfg.EndpointConcurrency {
+		if limit > 0 {
+			if c.endpointSlots == nil {
+				c.endpointSlots = make(map[string]chan struct{}, len(cfg.EndpointConcurrency))
+			}
+			c.endpointSlots[endpoint] = make(chan struct{}, limit)
+		}
+	}
+	now := time.Now()
+	for endpoint, rate := range cfg.RateLimits {
+		if rate > 0 {
+			if c.rateLimits == nil {
+				c.rateLimits = make(map[string]*tokenBucket, len(cfg.RateLimits))
+			}
+			c.rateLimits[endpoint] = newTokenBucket(rate, now)
+		}
+	}
+	return c
+}
+
+// daemon is one of the weight daemons a Client sends its requests to.
+type daemon struct {
+	// url is the daemon's base URL as configured, for logs.
+	url string
+
+	baseURL    string
+	httpClient *http.Client
+
+	// grpc, if not nil, carries the requests instead of httpClient.
+	grpc *grpcTransport
+
+	// msgpack is set while the daemon's last identity answer listed
+	// CapabilityMsgpack and the client may send msgpack.
+	msgpack atomic.Bool
+}
+
+// newDaemon sets up the transport to the daemon at baseURL.
+func newDaemon(baseURL *url.URL, cfg ClientConfig) *daemon {
+	d := &daemon{url: baseURL.String()}
+	if cfg.GRPC {
+		d.grpc = newGRPCTransport(baseURL, cfg)
+	}
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout}
+	dial := dialer.DialContext
+	if baseURL.Scheme == "unix" {
+		// Requests go to a placeholder host, whose connections are all to the socket.
+		socketPath := baseURL.Path
+		dial = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			if err := checkSocket(socketPath, cfg.SocketOwner); err != nil {
+				return nil, err
+			}
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}
+		baseURL = &url.URL{Scheme: "http", Host: "localhost"}
 	}
+	transport := cfg.Transport
+	if transport == nil {
+		httpTransport := &http.Transport{
+			MaxIdleConns:        10,
+			MaxIdleConnsPerHost: 10,
+			IdleConnTimeout:     90 * time.Second,
+			DialContext:         dial,
+		}
+		if cfg.WarmConnections > httpTransport.MaxIdleConnsPerHost {
+			httpTransport.MaxIdleConns = cfg.WarmConnections
+			httpTransport.MaxIdleConnsPerHost = cfg.WarmConnections
+		}
+		if cfg.MaxConnections > 0 {
+			httpTransport.MaxConnsPerHost = cfg.MaxConnections
+			httpTransport.MaxIdleConnsPerHost = min(httpTransport.MaxIdleConnsPerHost, cfg.MaxConnections)
+		}
+		httpTransport.TLSClientConfig = daemonTLSConfig(cfg)
+		if cfg.HTTP2 {
+			httpTransport.Protocols = new(http.Protocols)
+			if baseURL.Scheme == "https" {
+				httpTransport.Protocols.SetHTTP1(true)
+				httpTransport.Protocols.SetHTTP2(true)
+			} else {
+				httpTransport.Protocols.SetUnencryptedHTTP2(true)
+			}
+		}
+		transport = httpTransport
+	}
+	d.baseURL = baseURL.String()
+	// Note: Timeout is not set here; we use per-request context for dynamic timeouts
+	d.httpClient = &http.Client{Transport: transport}
+	return d
 }
 
 // SetTimeouts configures custom query timeout for the client.
@@ -116,6 +759,15 @@ func (c *Client) SetTimeouts(dialTimeout, queryTimeout time.Duration) {
 	}
 }
 
+// Stats returns the cumulative query and cache-hit counts since the client was created.
+func (c *Client) Stats() QueryStats {
+	return QueryStats{
+		Queries:     c.queries.Load(),
+		CacheHits:   c.cacheHits.Load(),
+		CacheMisses: c.cacheMisses.Load(),
+	}
+}
+
 // emptyRequest is used for endpoints that don't require request parameters.
 type emptyRequest struct{}
 
@@ -141,6 +793,27 @@ type weightResponse struct {
 	Code   string `json:"code,omitempty"`
 }
 
+// weightsRequest is the JSON structure sent for a batched weights query.
+// The endpoint path (/weights) identifies the request type.
+type weightsRequest struct {
+	BalanceRound string         `json:"balance_round"`
+	Queries      []weightsQuery `json:"queries"`
+}
+
+// weightsQuery is a single account of a weights query.
+type weightsQuery struct {
+	Address     string `json:"address"`
+	SelectionID string `json:"selection_id"`
+}
+
+// weightsResponse is the expected response from a weights query. Weights
+// answers the queries in order, each as a weight query would be answered.
+type weightsResponse struct {
+	Weights []weightResponse `json:"weights"`
+	Error   string           `json:"error,omitempty"`
+	Code    string           `json:"code,omitempty"`
+}
+
 // totalWeightRequest is the JSON structure sent for a total_weight query.
 // The endpoint path (/total_weight) identifies the request type.
 type totalWeightRequest struct {
@@ -155,46 +828,310 @@ type totalWeightResponse struct {
 	Code        string `json:"code,omitempty"`
 }
 
+// weightTableRequest is the JSON structure sent for a weight_table query.
+// The endpoint path (/weight_table) identifies the request type.
+type weightTableRequest struct {
+	BalanceRound string `json:"balance_round"`
+}
+
+// weightTableEntry is a single row of a weight_table response.
+// SelectionID may be omitted by daemons that key weights by address only.
+type weightTableEntry struct {
+	Address     string `json:"address"`
+	SelectionID string `json:"selection_id,omitempty"`
+	Weight      string `json:"weight"`
+}
+
+// weightTableResponse is the expected response from a weight_table query.
+type weightTableResponse struct {
+	Weights []weightTableEntry `json:"weights"`
+	Error   string             `json:"error,omitempty"`
+	Code    string             `json:"code,omitempty"`
+}
+
+// handshakeRequest is an identity request that also carries the node's
+// lookback parameters.
+type handshakeRequest struct {
+	BalanceLookback     string `json:"balance_lookback"`
+	SeedLookback        string `json:"seed_lookback"`
+	SeedRefreshInterval string `json:"seed_refresh_interval"`
+}
+
 // identityResponse is the expected response from an identity query.
 type identityResponse struct {
 	GenesisHash      string `json:"genesis_hash,omitempty"`
 	ProtocolVersion  string `json:"protocol_version,omitempty"`
 	AlgorithmVersion string `json:"algorithm_version,omitempty"`
+	WeightUnit       string `json:"weight_unit,omitempty"`
+	WeightScale      string `json:"weight_scale,omitempty"`
 	Error            string `json:"error,omitempty"`
 	Code             string `json:"code,omitempty"`
+
+	// Capabilities lists the optional parts of the protocol the daemon
+	// speaks, such as CapabilityMsgpack.
+	Capabilities []string `json:"capabilities,omitempty"`
 }
 
-// doRequest sends an HTTP POST request to the daemon and decodes the response.
+// sendRequest sends an HTTP POST request to the daemon and decodes the response.
 // It uses Go's http.Client which maintains a connection pool for efficiency.
-// The response is decoded into the provided result struct.
-func (c *Client) doRequest(endpoint string, reqBody interface{}, result interface{}) error {
+// The response is decoded into the provided result struct. Failures in the
+// retry policy's retryable classes are retried up to its MaxAttempts.
+//
+// account is the account a /weight request looks up, which decides whether the
+// request may be dropped by the endpoint's rate limit; it is nil otherwise.
+// The request is abandoned once ctx is done.
+func (c *Client) sendRequest(ctx context.Context, endpoint string, account *basics.Address, reqBody interface{}, result interface{}) (err error) {
 	// Marshal request body
-	bodyBytes, err := json.Marshal(reqBody)
+	body, err := encodeRequest(reqBody)
 	if err != nil {
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
+	defer body.release()
+	defer c.pressure.start()()
+
+	start := time.Now()
+	var class ErrorClass
+	defer func() {
+		r := QueryRecord{Time: start, Endpoint: endpoint, Request: truncateText(string(body.bytes())), Duration: time.Since(start)}
+		if err != nil {
+			r.Error = truncateText(err.Error())
+		}
+		c.recent.add(r)
+		c.observeQuery(r)
+		observeRequest(endpoint, r.Duration, err, class)
+	}()
+
+	if err := c.waitRate(endpoint, account); err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		return abandoned(ctx, endpoint)
+	}
+	trial, err := c.breaker.allow(time.Now())
+	if err != nil {
+		return err
+	}
+	sent := false
+	defer func() {
+		if !sent {
+			c.breaker.abandon(trial)
+			return
+		}
+		c.breaker.record(time.Now(), trial, err != nil && class&failoverClasses != 0)
+	}()
+	release, err := c.acquireSlots(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// The budget starts with the first attempt, once the request has its
+	// slots, and bounds the attempts on every daemon.
+	var deadline time.Time
+	if c.retry.Budget > 0 {
+		deadline = time.Now().Add(c.retry.Budget)
+	}
+	failovers := 0
+	for attempt := 1; ; attempt++ {
+		timeout := c.queryTimeout
+		if !deadline.IsZero() {
+			timeout = min(timeout, time.Until(deadline))
+			if timeout <= 0 {
+				return err
+			}
+		}
+		releaseConn, connErr := c.acquireConnection(ctx, endpoint)
+		if connErr != nil {
+			return connErr
+		}
+		d := c.activeDaemon()
+		attemptStart := time.Now()
+		class, err = c.attempt(ctx, d, endpoint, reqBody, body, result, timeout)
+		releaseConn()
+		if err != nil && ctx.Err() != nil {
+			// The failure is the caller's doing, not the daemon's.
+			sent, class = false, 0
+			return abandoned(ctx, endpoint)
+		}
+		sent = true
+		now := time.Now()
+		c.pressure.observe(now.Sub(attemptStart), now)
+		if err != nil {
+			observeAttempt(endpoint, class)
+		}
+		if err != nil && class&failoverClasses != 0 && failovers < len(c.daemons)-1 && c.failOver(d, err) {
+			// The next daemon is asked at once, without using up an attempt.
+			failovers++
+			attempt--
+			continue
+		}
+		if err == nil || class&c.retry.Retryable == 0 || attempt >= c.retry.MaxAttempts {
+			return err
+		}
+		backoff := c.retry.delay(attempt)
+		if !deadline.IsZero() && time.Until(deadline) <= backoff {
+			return err
+		}
+		observeRetry(endpoint)
+		c.sleep(backoff)
+		if ctx.Err() != nil {
+			sent = false
+			return abandoned(ctx, endpoint)
+		}
+	}
+}
+
+// attempt makes a single attempt at a request to the daemon d, over its
+// protocol, which fails with RetryTimeout if it takes longer than timeout, and
+// is abandoned if ctx is done.
+func (c *Client) attempt(ctx context.Context, d *daemon, endpoint string, reqBody interface{}, body *requestBody, result interface{}, timeout time.Duration) (ErrorClass, error) {
+	if d.grpc != nil {
+		return d.grpc.attempt(ctx, c, endpoint, reqBody, result, timeout)
+	}
+	return c.attemptHTTP(ctx, d, endpoint, reqBody, body, result, timeout)
+}
+
+// attemptHTTP makes a single attempt at a request to the daemon d over HTTP,
+// as attemptRequest does, sending it in msgpack if the daemon speaks it (see
+// msgpack.go), and in JSON otherwise. An identity answer decides which
+// encoding the following requests to d use.
+func (c *Client) attemptHTTP(parent context.Context, d *daemon, endpoint string, reqBody interface{}, body *requestBody, result interface{}, timeout time.Duration) (ErrorClass, error) {
+	if d.msgpack.Load() {
+		mpBody, mpResult, err := encodeMsgpackCall(endpoint, reqBody, result)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create request: %w", err)
+		}
+		if mpBody != nil {
+			defer mpBody.release()
+			return c.attemptRequest(parent, d, endpoint, mpBody, mpResult, timeout)
+		}
+	}
+	class, err := c.attemptRequest(parent, d, endpoint, body, result, timeout)
+	if resp, ok := result.(*identityResponse); ok && err == nil && resp.Error == "" {
+		d.msgpack.Store(c.msgpack && resp.hasCapability(CapabilityMsgpack))
+	}
+	return class, err
+}
+
+// acquireSlots takes a slot from each concurrency limit that applies to
+// endpoint, waiting at most the query timeout for all of them, and returns a
+// function that gives the slots back.
+func (c *Client) acquireSlots(ctx context.Context, endpoint string) (release func(), err error) {
+	limits := make([]chan struct{}, 0, 2)
+	if c.requestSlots != nil {
+		limits = append(limits, c.requestSlots)
+	}
+	if slots := c.endpointSlots[endpoint]; slots != nil {
+		limits = append(limits, slots)
+	}
+	if len(limits) == 0 {
+		return func() {}, nil
+	}
 
+	timer := time.NewTimer(c.queryTimeout)
+	defer timer.Stop()
+	held := 0
+	release = func() {
+		for _, slots := range limits[:held] {
+			<-slots
+		}
+	}
+	for _, slots := range limits {
+		select {
+		case slots <- struct{}{}:
+			held++
+		case <-timer.C:
+			release()
+			return nil, fmt.Errorf("no free weight daemon request slot for %s within %v", endpoint, c.queryTimeout)
+		case <-ctx.Done():
+			release()
+			return nil, abandoned(ctx, endpoint)
+		}
+	}
+	return release, nil
+}
+
+// attemptRequest makes a single attempt at a request to the daemon d over
+// HTTP, which is abandoned if parent is done. On failure it also returns the
+// failure's class, or 0 if the failure is never retried.
+func (c *Client) attemptRequest(parent context.Context, d *daemon, endpoint string, body *requestBody, result interface{}, timeout time.Duration) (ErrorClass, error) {
 	// Create HTTP request with timeout context
-	ctx, cancel := context.WithTimeout(context.Background(), c.queryTimeout)
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+endpoint, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", d.baseURL+endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	bodyBytes := body.bytes()
+	req.ContentLength = int64(len(bodyBytes))
+	req.Body = body.reader()
+	req.GetBody = body.getBody
+	req.Header.Set("Content-Type", body.contentType)
+	stream, streaming := result.(streamingResult)
+	if streaming {
+		req.Header.Set("Accept", ndjsonContentType+", application/json")
+	}
+	if c.authorization != "" {
+		req.Header.Set("Authorization", c.authorization)
+	}
+	if len(c.signingKey) > 0 {
+		signRequest(req, c.signingKey, endpoint, bodyBytes, time.Now())
 	}
-	req.Header.Set("Content-Type", "application/json")
 
 	// Execute request
-	resp, err := c.httpClient.Do(req)
+	c.queries.Add(1)
+	resp, err := d.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to connect to weight daemon: %w", err)
+		var pinErr *CertificatePinError
+		if errors.As(err, &pinErr) {
+			// Another attempt would meet the same certificate.
+			return 0, fmt.Errorf("failed to connect to weight daemon: %w", err)
+		}
+		return transportErrorClass(ctx), fmt.Errorf("failed to connect to weight daemon: %w", err)
 	}
 	defer resp.Body.Close()
 
-	// Read full body to enable connection reuse (even for errors)
-	bodyData, err := io.ReadAll(resp.Body)
+	// Read full body to enable connection reuse (even for errors), but no
+	// more than the endpoint's limit: the daemon is an external process.
+	limit := int64(MaxResponseSize)
+	if endpoint == "/weight_table" || endpoint == "/subscribe" {
+		limit = MaxWeightTableResponseSize
+	}
+	// Signed and strictly parsed answers are checked whole before they are
+	// decoded, so only the others can be decoded as they arrive.
+	if streaming && !c.signedResponses && !c.strict && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return streamResponse(ctx, endpoint, resp, limit, stream)
+	}
+	respBuf, err := readResponse(resp.Body, resp.ContentLength, limit)
 	if err != nil {
-		return fmt.Errorf("failed to read response from weight daemon: %w", err)
+		return transportErrorClass(ctx), fmt.Errorf("failed to read response from weight daemon: %w", err)
+	}
+	// Everything kept from the body below is copied out of it.
+	defer putBuffer(respBuf)
+	bodyData := respBuf.Bytes()
+	if int64(len(bodyData)) > limit {
+		return 0, fmt.Errorf("weight daemon response to %s exceeds %d bytes", endpoint, limit)
+	}
+
+	// The daemon's opinion of our credentials is not a statement about the
+	// queried data, so it is never reported as a DaemonError.
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return 0, fmt.Errorf("%w (HTTP %d)", ErrUnauthorized, resp.StatusCode)
+	}
+
+	// Error answers are checked too, so that a forged error cannot stand in
+	// for the daemon's answer.
+	if c.signedResponses {
+		nonce := resp.Header.Get(ResponseNonceHeader)
+		want := responseSignature(c.signingKey, req.Header.Get(SignatureHeader), nonce, resp.StatusCode, bodyData)
+		if !hmac.Equal([]byte(resp.Header.Get(ResponseSignatureHeader)), []byte(want)) {
+			return 0, fmt.Errorf("%w (%s)", ErrBadResponseSignature, endpoint)
+		}
+		now := c.now()
+		if msg := c.responseNonces.accept(nonce, now.Add(MaxSignatureSkew), now); msg != "" {
+			return 0, fmt.Errorf("%w (%s): %s", ErrReplayedResponse, endpoint, msg)
+		}
 	}
 
 	// Handle non-2xx status codes
@@ -205,20 +1142,46 @@ func (c *Client) doRequest(endpoint string, reqBody interface{}, result interfac
 			Code  string `json:"code"`
 		}
 		if json.Unmarshal(bodyData, &errResp) == nil && errResp.Error != "" {
-			return &ledgercore.DaemonError{
-				Code: errResp.Code,
-				Msg:  errResp.Error,
+			var class ErrorClass
+			if errResp.Code == "internal" {
+				class = RetryInternal
 			}
+			return class, daemonError(errResp.Code, errResp.Error)
+		}
+		var class ErrorClass
+		if resp.StatusCode >= 500 {
+			class = RetryUnavailable
 		}
-		return fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(bodyData))
+		return class, fmt.Errorf("HTTP error %d: %s", resp.StatusCode, truncateText(string(bodyData)))
 	}
 
 	// Decode successful response
-	if err := json.Unmarshal(bodyData, result); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+	decode := json.Unmarshal
+	if c.strict {
+		decode = decodeStrict
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if mp, ok := result.(*msgpackResult); ok {
+		err = mp.decode(bodyData, contentType, decode, c.strict)
+	} else if streaming {
+		err = decodeBuffered(stream, bodyData, contentType, c.strict)
+	} else {
+		err = decode(bodyData, result)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return nil
+	return 0, nil
+}
+
+// transportErrorClass classifies a failure to exchange a request with the
+// daemon, given the attempt's context.
+func transportErrorClass(ctx context.Context) ErrorClass {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return RetryTimeout
+	}
+	return RetryConnect
 }
 
 // Ping checks if the daemon is reachable and healthy.
@@ -226,16 +1189,13 @@ func (c *Client) Ping() error {
 	req := emptyRequest{}
 	var resp pingResponse
 
-	if err := c.doRequest("/ping", req, &resp); err != nil {
+	if err := c.doRequest(context.Background(), "/ping", nil, req, &resp); err != nil {
 		return err
 	}
 
 	// Check for error response
 	if resp.Error != "" {
-		return &ledgercore.DaemonError{
-			Code: resp.Code,
-			Msg:  resp.Error,
-		}
+		return daemonError(resp.Code, resp.Error)
 	}
 
 	// Verify we got a pong
@@ -247,17 +1207,51 @@ func (c *Client) Ping() error {
 }
 
 // Weight returns the consensus weight for the given account at the specified balance round.
-// Results are cached using an LRU cache to reduce daemon queries.
+// Results are cached using an LRU cache to reduce daemon queries, and not_found
+// answers for ClientConfig.NotFoundTTL. Answers outside the configured
+// WeightBounds are rejected with a *WeightBoundsError. Accounts with overrides
+// (see SetOverrides) are not looked up at all.
 func (c *Client) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
-	// Check cache first
-	cacheKey := weightCacheKey{
-		balanceRound: balanceRound,
-		addr:         addr,
-		selectionID:  selectionID,
+	return c.WeightContext(context.Background(), balanceRound, addr, selectionID)
+}
+
+// WeightContext is Weight, with the daemon query abandoned, and
+// ErrRequestAbandoned returned, once ctx is done. Agreement passes the
+// context of the vote being verified, which is done once the vote's round and
+// period are no longer of use. Cached weights are answered whatever ctx.
+func (c *Client) WeightContext(ctx context.Context, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	return c.loggedWeight(ctx, balanceRound, addr, selectionID, true)
+}
+
+// loggedWeight is WeightContext, answered without the overrides unless
+// overrides is set.
+func (c *Client) loggedWeight(ctx context.Context, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier, overrides bool) (weight uint64, err error) {
+	if entry := c.lookupLog.start("/weight", balanceRound); entry != nil {
+		entry.set("address", addr.String())
+		entry.set("selection_id", hex.EncodeToString(selectionID[:]))
+		defer func() { entry.finish(c, "weight", weight, err) }()
+		return c.weightContext(ctx, balanceRound, addr, selectionID, overrides, entry)
+	}
+	return c.weightContext(ctx, balanceRound, addr, selectionID, overrides, nil)
+}
+
+// weightContext is loggedWeight, which records how the lookup went in entry.
+func (c *Client) weightContext(ctx context.Context, balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier, overrides bool, entry *lookupLogEntry) (uint64, error) {
+	if weight, ok, err := c.overriddenWeight(balanceRound, addr); ok && overrides {
+		entry.setCache(LookupOverride)
+		return weight, err
 	}
-	if weight, ok := c.weightCache.Get(cacheKey); ok {
+
+	// Check cache first
+	cacheKey := makeWeightCacheKey(balanceRound, addr, selectionID)
+	if weight, ok := c.cachedWeight(balanceRound, cacheKey); ok {
+		entry.setCache(LookupHit)
 		return weight, nil
 	}
+	if err := c.notFound.get(cacheKey, c.now()); err != nil {
+		entry.setCache(LookupNotFound)
+		return 0, err
+	}
 
 	// Build request with wire format:
 	// - address: Base32 encoded (using addr.String())
@@ -270,44 +1264,103 @@ func (c *Client) Weight(balanceRound basics.Round, addr basics.Address, selectio
 	}
 
 	var resp weightResponse
-	if err := c.doRequest("/weight", req, &resp); err != nil {
+	if err := c.doRequest(ctx, "/weight", &addr, req, &resp); err != nil {
+		if unreachable(err) && !errors.Is(err, ErrRequestAbandoned) {
+			if weight, ok := c.staleWeight(balanceRound, addr, selectionID); ok {
+				entry.setStale()
+				return weight, nil
+			}
+		}
+		c.notFound.put(cacheKey, err, c.now())
 		return 0, err
 	}
 
+	return c.acceptWeight(balanceRound, ledgercore.WeightQuery{Addr: addr, SelectionID: selectionID}, cacheKey, resp)
+}
+
+// cachedWeight returns the cached weight for key at balanceRound, if any.
+func (c *Client) cachedWeight(balanceRound basics.Round, key weightCacheKey) (uint64, bool) {
+	if c.weightCacheSizer != nil {
+		c.weightCacheSizer.observe(balanceRound, key)
+	}
+	weight, ok := c.hotWeights.get(balanceRound, key)
+	if !ok {
+		var answer weightAnswer
+		answer, ok = c.weightCache.Get(key)
+		weight = answer.weight
+	}
+	if ok {
+		c.cacheHits.Add(1)
+	} else {
+		c.cacheMisses.Add(1)
+	}
+	c.observeCache("/weight", balanceRound, ok)
+	return weight, ok
+}
+
+// acceptWeight checks the daemon's answer to q at balanceRound, and caches the
+// weight under key if it is valid, or the error if it is not_found.
+func (c *Client) acceptWeight(balanceRound basics.Round, q ledgercore.WeightQuery, key weightCacheKey, resp weightResponse) (uint64, error) {
 	// Check for error response
 	if resp.Error != "" {
-		return 0, &ledgercore.DaemonError{
-			Code: resp.Code,
-			Msg:  resp.Error,
-		}
+		err := daemonError(resp.Code, resp.Error)
+		c.notFound.put(key, err, c.now())
+		return 0, err
 	}
 
 	// Parse weight as decimal string
 	if resp.Weight == "" {
 		return 0, fmt.Errorf("weight response missing weight field")
 	}
-	weight, err := strconv.ParseUint(resp.Weight, 10, 64)
+	weight, err := parseDecimal("weight", resp.Weight)
 	if err != nil {
-		return 0, fmt.Errorf("invalid weight value %q: %w", resp.Weight, err)
+		return 0, err
+	}
+	if err := c.bounds.checkWeight(balanceRound, weight); err != nil {
+		return 0, err
 	}
 
 	// Cache the result
-	c.weightCache.Put(cacheKey, weight)
+	c.weightCache.Put(key, weightAnswer{balanceRound: balanceRound, query: q, weight: weight})
+	c.hotWeights.put(balanceRound, key, weight)
 
 	return weight, nil
 }
 
 // TotalWeight returns the total consensus weight at the specified balance round for voting
 // in the given vote round. Results are cached using an LRU cache to reduce daemon queries.
+// Answers outside the configured WeightBounds are rejected with a *WeightBoundsError.
 func (c *Client) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	return c.TotalWeightContext(context.Background(), balanceRound, voteRound)
+}
+
+// TotalWeightContext is TotalWeight, with the daemon query abandoned, and
+// ErrRequestAbandoned returned, once ctx is done.
+func (c *Client) TotalWeightContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round) (totalWeight uint64, err error) {
+	if entry := c.lookupLog.start("/total_weight", balanceRound); entry != nil {
+		entry.set("vote_round", uint64(voteRound))
+		defer func() { entry.finish(c, "total_weight", totalWeight, err) }()
+		return c.totalWeightContext(ctx, balanceRound, voteRound, entry)
+	}
+	return c.totalWeightContext(ctx, balanceRound, voteRound, nil)
+}
+
+// totalWeightContext is TotalWeightContext, which records how the lookup went
+// in entry.
+func (c *Client) totalWeightContext(ctx context.Context, balanceRound basics.Round, voteRound basics.Round, entry *lookupLogEntry) (uint64, error) {
 	// Check cache first
 	cacheKey := totalWeightCacheKey{
 		balanceRound: balanceRound,
 		voteRound:    voteRound,
 	}
-	if totalWeight, ok := c.totalWeightCache.Get(cacheKey); ok {
+	totalWeight, ok := c.totalWeightCache.Get(cacheKey)
+	c.observeCache("/total_weight", balanceRound, ok)
+	if ok {
+		c.cacheHits.Add(1)
+		entry.setCache(LookupHit)
 		return totalWeight, nil
 	}
+	c.cacheMisses.Add(1)
 
 	// Build request with wire format:
 	// - balance_round: decimal string
@@ -318,25 +1371,31 @@ func (c *Client) TotalWeight(balanceRound basics.Round, voteRound basics.Round)
 	}
 
 	var resp totalWeightResponse
-	if err := c.doRequest("/total_weight", req, &resp); err != nil {
+	if err := c.doRequest(ctx, "/total_weight", nil, req, &resp); err != nil {
+		if unreachable(err) && !errors.Is(err, ErrRequestAbandoned) {
+			if total, ok := c.staleTotalWeight(balanceRound, voteRound); ok {
+				entry.setStale()
+				return total, nil
+			}
+		}
 		return 0, err
 	}
 
 	// Check for error response
 	if resp.Error != "" {
-		return 0, &ledgercore.DaemonError{
-			Code: resp.Code,
-			Msg:  resp.Error,
-		}
+		return 0, daemonError(resp.Code, resp.Error)
 	}
 
 	// Parse total_weight as decimal string
 	if resp.TotalWeight == "" {
 		return 0, fmt.Errorf("total_weight response missing total_weight field")
 	}
-	totalWeight, err := strconv.ParseUint(resp.TotalWeight, 10, 64)
+	totalWeight, err := parseDecimal("total_weight", resp.TotalWeight)
 	if err != nil {
-		return 0, fmt.Errorf("invalid total_weight value %q: %w", resp.TotalWeight, err)
+		return 0, err
+	}
+	if err := c.bounds.checkTotalWeight(balanceRound, totalWeight); err != nil {
+		return 0, err
 	}
 
 	// Cache the result
@@ -345,22 +1404,138 @@ func (c *Client) TotalWeight(balanceRound basics.Round, voteRound basics.Round)
 	return totalWeight, nil
 }
 
+// EvictBefore removes the cached weights and total weights for balance rounds
+// before rnd, which the caller will not look up again, so that they do not
+// take the place of live rounds. It returns how many entries it removed.
+func (c *Client) EvictBefore(rnd basics.Round) int {
+	c.hotWeights.evictBefore(rnd)
+	removed := c.weightCache.RemoveIf(func(k weightCacheKey, a weightAnswer) bool {
+		return weightAnswerRound(k, a) < rnd
+	})
+	removed += c.totalWeightCache.RemoveIf(func(k totalWeightCacheKey, total uint64) bool {
+		return totalWeightRound(k, total) < rnd
+	})
+	return removed
+}
+
+// WeightTable returns every weight the daemon knows for the given balance round.
+// Results are not cached; the table is intended for bulk consumers such as
+// consistency checks and audit tooling rather than the agreement hot path.
+func (c *Client) WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	entries := make([]ledgercore.WeightTableEntry, 0)
+	err := c.streamWeightTable(balanceRound, func() {
+		entries = entries[:0]
+	}, func(e ledgercore.WeightTableEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// WarmWeightCache fills the weight cache from the daemon's weight table for
+// the given balance round, so that the round's first Weight queries are
+// answered without waiting on the daemon. The table is decoded as it arrives
+// and is never held in memory. Entries without a selection ID are skipped,
+// since weights are cached per participation key, and entries outside the
+// configured WeightBounds fail the warming with a *WeightBoundsError. It
+// returns the number of weights cached; those cached before a failure stay
+// cached.
+func (c *Client) WarmWeightCache(balanceRound basics.Round) (int, error) {
+	cached := 0
+	err := c.streamWeightTable(balanceRound, func() {
+		cached = 0
+	}, func(e ledgercore.WeightTableEntry) error {
+		ok, err := c.cacheTableEntry(balanceRound, e)
+		if ok {
+			cached++
+		}
+		return err
+	})
+	return cached, err
+}
+
+// cacheTableEntry puts a weight table entry of balanceRound in the weight
+// cache, and reports whether it did: entries without a selection ID are
+// skipped, and those outside the WeightBounds are an error.
+func (c *Client) cacheTableEntry(balanceRound basics.Round, e ledgercore.WeightTableEntry) (bool, error) {
+	if e.SelectionID == (crypto.VRFVerifier{}) {
+		return false, nil
+	}
+	if err := c.bounds.checkWeight(balanceRound, e.Weight); err != nil {
+		return false, err
+	}
+	c.weightCache.Put(makeWeightCacheKey(balanceRound, e.Addr, e.SelectionID), weightAnswer{
+		balanceRound: balanceRound,
+		query:        ledgercore.WeightQuery{Addr: e.Addr, SelectionID: e.SelectionID},
+		weight:       e.Weight,
+	})
+	return true, nil
+}
+
+// streamWeightTable queries the weight table for balanceRound, passing each
+// entry to visit as it is decoded. reset is called whenever decoding starts
+// over, as it does when the query is retried.
+func (c *Client) streamWeightTable(balanceRound basics.Round, reset func(), visit func(ledgercore.WeightTableEntry) error) error {
+	req := weightTableRequest{
+		BalanceRound: strconv.FormatUint(uint64(balanceRound), 10),
+	}
+
+	dec := weightTableDecoder{reset: reset, visit: visit}
+	if err := c.doRequest(context.Background(), "/weight_table", nil, req, &dec); err != nil {
+		return err
+	}
+
+	// Check for error response
+	if dec.errText != "" {
+		return daemonError(dec.code, dec.errText)
+	}
+
+	if !dec.found {
+		return fmt.Errorf("weight_table response missing weights field")
+	}
+	return nil
+}
+
 // Identity returns metadata about the daemon including genesis hash and version information.
 // The genesis hash is returned as base64-encoded in the wire protocol and decoded to a crypto.Digest.
 func (c *Client) Identity() (ledgercore.DaemonIdentity, error) {
-	req := emptyRequest{}
+	return c.identity(emptyRequest{})
+}
+
+// Handshake is Identity with the node's lookback parameters attached to the
+// request. A daemon whose snapshot schedule does not match them answers with a
+// bad_request error, returned as a *ledgercore.DaemonError.
+func (c *Client) Handshake(lp ledgercore.LookbackParams) (ledgercore.DaemonIdentity, error) {
+	return c.identity(handshakeRequest{
+		BalanceLookback:     strconv.FormatUint(uint64(lp.BalanceLookback), 10),
+		SeedLookback:        strconv.FormatUint(lp.SeedLookback, 10),
+		SeedRefreshInterval: strconv.FormatUint(lp.SeedRefreshInterval, 10),
+	})
+}
+
+func (c *Client) identity(req interface{}) (ledgercore.DaemonIdentity, error) {
 	var resp identityResponse
 
-	if err := c.doRequest("/identity", req, &resp); err != nil {
+	if err := c.doRequest(context.Background(), "/identity", nil, req, &resp); err != nil {
 		return ledgercore.DaemonIdentity{}, err
 	}
+	identity, err := parseIdentity(resp)
+	if err != nil {
+		return ledgercore.DaemonIdentity{}, err
+	}
+	c.lastIdentity.Store(&identity)
+	return identity, nil
+}
 
+// parseIdentity checks an answer to an identity request and returns the
+// identity it describes.
+func parseIdentity(resp identityResponse) (ledgercore.DaemonIdentity, error) {
 	// Check for error response
 	if resp.Error != "" {
-		return ledgercore.DaemonIdentity{}, &ledgercore.DaemonError{
-			Code: resp.Code,
-			Msg:  resp.Error,
-		}
+		return ledgercore.DaemonIdentity{}, daemonError(resp.Code, resp.Error)
 	}
 
 	// Validate required fields are present
@@ -373,6 +1548,19 @@ func (c *Client) Identity() (ledgercore.DaemonIdentity, error) {
 	if resp.AlgorithmVersion == "" {
 		return ledgercore.DaemonIdentity{}, fmt.Errorf("identity response missing algorithm_version field")
 	}
+	if err := checkVersion("protocol_version", resp.ProtocolVersion); err != nil {
+		return ledgercore.DaemonIdentity{}, err
+	}
+	if err := checkVersion("algorithm_version", resp.AlgorithmVersion); err != nil {
+		return ledgercore.DaemonIdentity{}, err
+	}
+	display, err := parseWeightDisplay(resp.WeightUnit, resp.WeightScale)
+	if err != nil {
+		return ledgercore.DaemonIdentity{}, err
+	}
+	if err := checkCapabilities(resp.Capabilities); err != nil {
+		return ledgercore.DaemonIdentity{}, err
+	}
 
 	// Decode base64 genesis hash
 	genesisBytes, err := base64.StdEncoding.DecodeString(resp.GenesisHash)
@@ -392,5 +1580,96 @@ func (c *Client) Identity() (ledgercore.DaemonIdentity, error) {
 		GenesisHash:            genesisHash,
 		WeightAlgorithmVersion: resp.AlgorithmVersion,
 		WeightProtocolVersion:  resp.ProtocolVersion,
+		Display:                display,
+		Capabilities:           resp.Capabilities,
 	}, nil
 }
+
+// LastIdentity returns the identity from the daemon's most recent valid
+// Identity or Handshake answer, and whether there has been one.
+func (c *Client) LastIdentity() (ledgercore.DaemonIdentity, bool) {
+	identity := c.lastIdentity.Load()
+	if identity == nil {
+		return ledgercore.DaemonIdentity{}, false
+	}
+	return *identity, true
+}
+
+// LastAnswer returns when a daemon last answered a request, or the zero time
+// if none has.
+func (c *Client) LastAnswer() time.Time {
+	t := c.lastAnswer.Load()
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, t)
+}
+
+// parseDecimal parses a decimal uint64 field of a daemon response.
+func parseDecimal(field, value string) (uint64, error) {
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		// strconv's error repeats the whole input; report it truncated.
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return 0, fmt.Errorf("invalid %s value %q: %w", field, truncateText(value), err)
+	}
+	return v, nil
+}
+
+// checkVersion checks a version string, or another text field, of an identity
+// response. Versions are compared and logged, so they must be short and
+// printable.
+func checkVersion(field, value string) error {
+	if len(value) > maxVersionLength {
+		return fmt.Errorf("identity response %s is longer than %d bytes", field, maxVersionLength)
+	}
+	if !utf8.ValidString(value) || strings.IndexFunc(value, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return fmt.Errorf("identity response %s %q contains unprintable characters", field, value)
+	}
+	return nil
+}
+
+// parseWeightDisplay parses the optional weight_unit and weight_scale fields
+// of an identity response. The scale must be a power of ten so that weights
+// can be shown as decimals.
+func parseWeightDisplay(unit, scale string) (ledgercore.WeightDisplay, error) {
+	var display ledgercore.WeightDisplay
+	if err := checkVersion("weight_unit", unit); err != nil {
+		return display, err
+	}
+	display.Unit = unit
+	if scale == "" {
+		return display, nil
+	}
+	v, err := parseDecimal("weight_scale", scale)
+	if err != nil {
+		return display, err
+	}
+	for p := v; p != 1; p /= 10 {
+		if p == 0 || p%10 != 0 {
+			return display, fmt.Errorf("identity response weight_scale %d is not a power of ten", v)
+		}
+	}
+	display.Scale = v
+	return display, nil
+}
+
+// daemonError builds the error for an error object sent by the daemon, with
+// its text bounded.
+func daemonError(code, msg string) *ledgercore.DaemonError {
+	return &ledgercore.DaemonError{
+		Code: truncateText(code),
+		Msg:  truncateText(msg),
+	}
+}
+
+// truncateText cuts daemon-supplied text to maxErrorTextSize bytes.
+func truncateText(s string) string {
+	if len(s) <= maxErrorTextSize {
+		return s
+	}
+	return strings.ToValidUTF8(s[:maxErrorTextSize], "") + "...(truncated)"
+}
@@ -0,0 +1,258 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// maxFuzzErrorLength bounds the text of any error the client returns for a
+// daemon response, however large or strange the response.
+const maxFuzzErrorLength = 4 * maxErrorTextSize
+
+// cannedTransport answers every request with the same status and body.
+type cannedTransport struct {
+	status int
+	body   []byte
+}
+
+func (c cannedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: c.status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(c.body)),
+		Request:    req,
+	}, nil
+}
+
+// fuzzClient returns a client whose every request gets status and body.
+func fuzzClient(status int, body []byte) *Client {
+	cfg := testClientConfig()
+	cfg.WeightCacheCapacity = 1
+	cfg.TotalWeightCacheCapacity = 1
+	cfg.Transport = cannedTransport{status: status, body: body}
+	return NewClientWithConfig(&url.URL{Scheme: "http", Host: "weights.invalid"}, cfg)
+}
+
+// fuzzStatus maps a fuzzed integer onto a valid HTTP status code.
+func fuzzStatus(status uint16) int {
+	return 100 + int(status)%500
+}
+
+// requireBoundedError checks that a client error, if any, stays small.
+func requireBoundedError(t *testing.T, err error) {
+	if err != nil {
+		require.LessOrEqual(t, len(err.Error()), maxFuzzErrorLength, "error text too long: %.200s", err.Error())
+	}
+}
+
+// addResponseSeeds adds the bodies every response fuzz target starts from.
+func addResponseSeeds(f *testing.F, bodies ...string) {
+	bodies = append(bodies,
+		``, `{}`, `null`, `[]`, `"weight"`, `{"error":"x"}`,
+		`{"error":"account not found","code":"not_found"}`,
+		`{"error":"`+strings.Repeat("e", 2*maxErrorTextSize)+`","code":"internal"}`,
+		`not json at all`,
+	)
+	for _, body := range bodies {
+		for _, status := range []uint16{100, 300, 301, 400, 404, 401} {
+			f.Add(status, []byte(body))
+		}
+	}
+}
+
+func FuzzWeightResponse(f *testing.F) {
+	partitiontest.PartitionTest(f)
+
+	addResponseSeeds(f,
+		`{"weight":"1500"}`, `{"weight":"0"}`, `{"weight":"18446744073709551615"}`,
+		`{"weight":"18446744073709551616"}`, `{"weight":"-1"}`, `{"weight":"+5"}`,
+		`{"weight":"1e6"}`, `{"weight":1500}`, `{"weight":"  7"}`, `{"weight":""}`,
+		`{"weight":"`+strings.Repeat("9", 10000)+`"}`)
+
+	f.Fuzz(func(t *testing.T, status uint16, body []byte) {
+		code := fuzzStatus(status)
+		w, err := fuzzClient(code, body).Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+		requireBoundedError(t, err)
+		if err != nil {
+			return
+		}
+
+		// Success requires a 2xx status and a weight that is exactly the
+		// decimal the daemon sent.
+		require.True(t, code >= 200 && code < 300, "status %d accepted", code)
+		var resp weightResponse
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Empty(t, resp.Error)
+		require.Equal(t, resp.Weight, strconv.FormatUint(w, 10))
+	})
+}
+
+func FuzzTotalWeightResponse(f *testing.F) {
+	partitiontest.PartitionTest(f)
+
+	addResponseSeeds(f,
+		`{"total_weight":"5500000"}`, `{"total_weight":"0"}`, `{"total_weight":"-0"}`,
+		`{"total_weight":"18446744073709551616"}`, `{"total_weight":null}`)
+
+	f.Fuzz(func(t *testing.T, status uint16, body []byte) {
+		code := fuzzStatus(status)
+		total, err := fuzzClient(code, body).TotalWeight(1, 2)
+		requireBoundedError(t, err)
+		if err != nil {
+			return
+		}
+
+		require.True(t, code >= 200 && code < 300, "status %d accepted", code)
+		var resp totalWeightResponse
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Equal(t, resp.TotalWeight, strconv.FormatUint(total, 10))
+	})
+}
+
+func FuzzIdentityResponse(f *testing.F) {
+	partitiontest.PartitionTest(f)
+
+	addResponseSeeds(f,
+		`{"genesis_hash":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=","protocol_version":"1.0","algorithm_version":"1.0"}`,
+		`{"genesis_hash":"AAAA","protocol_version":"1.0","algorithm_version":"1.0"}`,
+		`{"genesis_hash":"!!!!","protocol_version":"1.0","algorithm_version":"1.0"}`,
+		`{"genesis_hash":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=","protocol_version":"1.0\n","algorithm_version":"1.0"}`,
+		`{"genesis_hash":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=","protocol_version":"`+strings.Repeat("1", 100)+`","algorithm_version":"1.0"}`,
+		`{"genesis_hash":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=","protocol_version":"1.0"}`)
+
+	f.Fuzz(func(t *testing.T, status uint16, body []byte) {
+		code := fuzzStatus(status)
+		id, err := fuzzClient(code, body).Identity()
+		requireBoundedError(t, err)
+		if err != nil {
+			return
+		}
+
+		require.True(t, code >= 200 && code < 300, "status %d accepted", code)
+		require.NotEmpty(t, id.WeightProtocolVersion)
+		require.NotEmpty(t, id.WeightAlgorithmVersion)
+		require.LessOrEqual(t, len(id.WeightProtocolVersion), maxVersionLength)
+		require.LessOrEqual(t, len(id.WeightAlgorithmVersion), maxVersionLength)
+		require.NoError(t, checkVersion("protocol_version", id.WeightProtocolVersion))
+		require.NoError(t, checkVersion("algorithm_version", id.WeightAlgorithmVersion))
+	})
+}
+
+func FuzzWeightTableResponse(f *testing.F) {
+	partitiontest.PartitionTest(f)
+
+	addr := makeTestAddress(1).String()
+	addResponseSeeds(f,
+		`{"weights":[]}`, `{"weights":null}`,
+		`{"weights":[{"address":"`+addr+`","weight":"10"}]}`,
+		`{"weights":[{"address":"`+addr+`","selection_id":"`+strings.Repeat("ab", 32)+`","weight":"10"}]}`,
+		`{"weights":[{"address":"`+addr+`","selection_id":"abc","weight":"10"}]}`,
+		`{"weights":[{"address":"nope","weight":"10"}]}`)
+
+	f.Fuzz(func(t *testing.T, status uint16, body []byte) {
+		code := fuzzStatus(status)
+		table, err := fuzzClient(code, body).WeightTable(1)
+		requireBoundedError(t, err)
+		if err != nil {
+			return
+		}
+
+		require.True(t, code >= 200 && code < 300, "status %d accepted", code)
+		var resp weightTableResponse
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Len(t, table, len(resp.Weights))
+		for i, e := range table {
+			require.Equal(t, resp.Weights[i].Weight, strconv.FormatUint(e.Weight, 10))
+			if resp.Weights[i].SelectionID == "" {
+				require.Equal(t, crypto.VRFVerifier{}, e.SelectionID)
+			}
+		}
+	})
+}
+
+func FuzzErrorResponse(f *testing.F) {
+	partitiontest.PartitionTest(f)
+
+	addResponseSeeds(f, `{"error":"","code":"internal"}`, `{"code":"internal"}`, `{"error":null}`)
+
+	f.Fuzz(func(t *testing.T, status uint16, body []byte) {
+		code := fuzzStatus(status)
+		if code >= 200 && code < 300 {
+			code += 200
+		}
+		err := fuzzClient(code, body).Ping()
+		require.Error(t, err)
+		requireBoundedError(t, err)
+		if de, ok := err.(*ledgercore.DaemonError); ok {
+			require.NotEmpty(t, de.Msg)
+			require.LessOrEqual(t, len(de.Msg), maxErrorTextSize+len("...(truncated)"))
+		}
+	})
+}
+
+// TestResponseSizeLimits checks that oversized responses are rejected without
+// being read in full, and that /weight_table is allowed a larger body.
+func TestResponseSizeLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	padding := strings.Repeat(" ", MaxResponseSize)
+	_, err := fuzzClient(http.StatusOK, []byte(`{"weight":"5"}`+padding)).Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.ErrorContains(t, err, "weight daemon response to /weight exceeds 65536 bytes")
+
+	_, err = fuzzClient(http.StatusBadRequest, []byte(padding+padding)).TotalWeight(1, 2)
+	require.ErrorContains(t, err, "exceeds 65536 bytes")
+
+	table, err := fuzzClient(http.StatusOK, []byte(`{"weights":[]}`+padding)).WeightTable(1)
+	require.NoError(t, err)
+	require.Empty(t, table)
+}
+
+// TestResponseTextTruncated checks that daemon-supplied text is cut short in
+// the errors the client returns.
+func TestResponseTextTruncated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	long := strings.Repeat("x", 10*maxErrorTextSize)
+	_, err := fuzzClient(http.StatusOK, []byte(`{"weight":"`+long+`"}`)).Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.ErrorContains(t, err, "invalid weight value")
+	require.ErrorContains(t, err, "(truncated)")
+	require.Less(t, len(err.Error()), 2*maxErrorTextSize)
+
+	err = fuzzClient(http.StatusInternalServerError, []byte(long)).Ping()
+	require.ErrorContains(t, err, "HTTP error 500")
+	require.Less(t, len(err.Error()), 2*maxErrorTextSize)
+
+	_, err = fuzzClient(http.StatusOK, []byte(`{"error":"`+long+`","code":"internal"}`)).TotalWeight(1, 2)
+	require.True(t, ledgercore.IsDaemonError(err, "internal"))
+	require.Less(t, len(err.Error()), 2*maxErrorTextSize)
+}
@@ -17,13 +17,17 @@
 package weightoracle
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -31,9 +35,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -230,8 +236,8 @@ func TestNewClient(t *testing.T) {
 
 	client := NewClient(12345)
 	require.NotNil(t, client)
-	require.Equal(t, "http://127.0.0.1:12345", client.baseURL)
-	require.NotNil(t, client.httpClient)
+	require.Equal(t, "http://127.0.0.1:12345", client.daemons[0].baseURL)
+	require.NotNil(t, client.daemons[0].httpClient)
 }
 
 // TestPingConcurrent tests that multiple concurrent Ping requests work correctly.
@@ -1106,6 +1112,57 @@ func TestIdentitySuccess(t *testing.T) {
 	require.Equal(t, "1.0", identity.WeightAlgorithmVersion)
 }
 
+// TestNewClientURLPathPrefix tests that a path in the base URL is prefixed to every endpoint.
+func TestNewClientURLPathPrefix(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		require.Equal(t, "/oracle/v1/ping", path)
+		return map[string]interface{}{"pong": true}
+	})
+	defer server.Close()
+
+	client := NewClientURL(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port), Path: "/oracle/v1"})
+	require.NoError(t, client.Ping())
+}
+
+// TestHandshakeSendsLookback tests that Handshake sends the lookback parameters
+// as decimal strings and surfaces a daemon rejection as a DaemonError.
+func TestHandshakeSendsLookback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testHash := makeTestGenesisHash()
+	testHashBase64 := base64.StdEncoding.EncodeToString(testHash[:])
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		require.Equal(t, "/identity", path)
+		require.Equal(t, "2", req["seed_lookback"])
+		require.Equal(t, "80", req["seed_refresh_interval"])
+		if req["balance_lookback"] != "320" {
+			return map[string]interface{}{
+				"error": "balance lookback mismatch",
+				"code":  "bad_request",
+			}
+		}
+		return map[string]interface{}{
+			"genesis_hash":      testHashBase64,
+			"protocol_version":  "1.0",
+			"algorithm_version": "1.0",
+		}
+	})
+	defer server.Close()
+
+	client := NewClient(server.port)
+	identity, err := client.Handshake(ledgercore.LookbackParams{BalanceLookback: 320, SeedLookback: 2, SeedRefreshInterval: 80})
+	require.NoError(t, err)
+	require.Equal(t, testHash, identity.GenesisHash)
+
+	_, err = client.Handshake(ledgercore.LookbackParams{BalanceLookback: 160, SeedLookback: 2, SeedRefreshInterval: 80})
+	require.True(t, ledgercore.IsDaemonError(err, "bad_request"))
+}
+
 // TestIdentityDaemonError tests that Identity returns a DaemonError when the daemon
 // returns an error response.
 func TestIdentityDaemonError(t *testing.T) {
@@ -1136,6 +1193,41 @@ func TestIdentityDaemonError(t *testing.T) {
 	require.False(t, ledgercore.IsDaemonError(err, "not_found"))
 }
 
+// TestIdentityDisplay tests that Identity parses the optional weight display
+// and rejects scales that are not powers of ten.
+func TestIdentityDisplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testHash := makeTestGenesisHash()
+	testHashBase64 := base64.StdEncoding.EncodeToString(testHash[:])
+
+	var scale atomic.Value
+	scale.Store("1000000")
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		return map[string]interface{}{
+			"genesis_hash":      testHashBase64,
+			"protocol_version":  "1.0",
+			"algorithm_version": "1.0",
+			"weight_unit":       "credits",
+			"weight_scale":      scale.Load(),
+		}
+	})
+	defer server.Close()
+
+	client := NewClient(server.port)
+	identity, err := client.Identity()
+	require.NoError(t, err)
+	require.Equal(t, ledgercore.WeightDisplay{Unit: "credits", Scale: 1000000}, identity.Display)
+	require.Equal(t, identity.Display, ledgercore.WeightDisplayOf(client))
+
+	for _, bad := range []string{"0", "1500", "12"} {
+		scale.Store(bad)
+		_, err = client.Identity()
+		require.ErrorContains(t, err, "weight_scale", bad)
+	}
+}
+
 // TestIdentityMissingGenesisHash tests that Identity returns an error if genesis_hash is missing.
 func TestIdentityMissingGenesisHash(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -1330,3 +1422,573 @@ func TestIdentityConcurrent(t *testing.T) {
 		require.Equal(t, "1.0", identity.WeightAlgorithmVersion)
 	}
 }
+
+// ============================================================================
+// WeightTable Tests
+// ============================================================================
+
+// TestWeightTableSuccess tests that WeightTable decodes every entry, including
+// entries without a selection_id.
+func TestWeightTableSuccess(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr1, addr2 := makeTestAddress(1), makeTestAddress(2)
+	selID1 := makeTestSelectionID(7)
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		require.Equal(t, "/weight_table", path)
+		require.Equal(t, "500", req["balance_round"])
+		return map[string]interface{}{
+			"weights": []map[string]interface{}{
+				{"address": addr1.String(), "selection_id": hex.EncodeToString(selID1[:]), "weight": "100"},
+				{"address": addr2.String(), "weight": "250"},
+			},
+		}
+	})
+	defer server.Close()
+
+	client := NewClient(server.port)
+	table, err := client.WeightTable(basics.Round(500))
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightTableEntry{
+		{Addr: addr1, SelectionID: selID1, Weight: 100},
+		{Addr: addr2, Weight: 250},
+	}, table)
+}
+
+// TestWeightTableEmpty tests that an empty table is not treated as a missing field.
+func TestWeightTableEmpty(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		return map[string]interface{}{"weights": []interface{}{}}
+	})
+	defer server.Close()
+
+	client := NewClient(server.port)
+	table, err := client.WeightTable(basics.Round(1))
+	require.NoError(t, err)
+	require.Empty(t, table)
+}
+
+// TestWeightTableErrors tests WeightTable's handling of daemon errors and malformed entries.
+func TestWeightTableErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(3).String()
+	tests := []struct {
+		name     string
+		resp     map[string]interface{}
+		contains string
+	}{
+		{"daemon error", map[string]interface{}{"error": "no snapshot", "code": "not_found"}, "not_found"},
+		{"missing weights", map[string]interface{}{}, "missing weights field"},
+		{"bad address", map[string]interface{}{"weights": []map[string]interface{}{{"address": "nope", "weight": "1"}}}, "invalid address"},
+		{"bad selection_id", map[string]interface{}{"weights": []map[string]interface{}{{"address": addr, "selection_id": "zz", "weight": "1"}}}, "invalid selection_id"},
+		{"short selection_id", map[string]interface{}{"weights": []map[string]interface{}{{"address": addr, "selection_id": "00", "weight": "1"}}}, "invalid selection_id length"},
+		{"bad weight", map[string]interface{}{"weights": []map[string]interface{}{{"address": addr, "weight": "-1"}}}, "invalid weight value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, func(req map[string]interface{}) interface{} {
+				return tt.resp
+			})
+			defer server.Close()
+
+			client := NewClient(server.port)
+			_, err := client.WeightTable(basics.Round(1))
+			require.ErrorContains(t, err, tt.contains)
+		})
+	}
+}
+
+// ============================================================================
+// Stats Tests
+// ============================================================================
+
+// TestStatsCountsQueriesAndCacheHits tests that Stats counts daemon requests
+// and cache hits separately.
+func TestStatsCountsQueriesAndCacheHits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		switch path {
+		case "/weight":
+			return map[string]interface{}{"weight": "5"}
+		case "/total_weight":
+			return map[string]interface{}{"total_weight": "50"}
+		default:
+			return map[string]interface{}{"pong": true}
+		}
+	})
+	defer server.Close()
+
+	client := NewClient(server.port)
+	require.Equal(t, QueryStats{}, client.Stats())
+
+	require.NoError(t, client.Ping())
+	_, err := client.Weight(basics.Round(1), makeTestAddress(1), makeTestSelectionID(1))
+	require.NoError(t, err)
+	_, err = client.Weight(basics.Round(1), makeTestAddress(1), makeTestSelectionID(1))
+	require.NoError(t, err)
+	_, err = client.TotalWeight(basics.Round(1), basics.Round(2))
+	require.NoError(t, err)
+	_, err = client.TotalWeight(basics.Round(1), basics.Round(2))
+	require.NoError(t, err)
+
+	before := QueryStats{Queries: 1}
+	now := client.Stats()
+	require.Equal(t, QueryStats{Queries: 3, CacheHits: 2, CacheMisses: 2}, now)
+	require.Equal(t, QueryStats{Queries: 2, CacheHits: 2, CacheMisses: 2}, now.Sub(before))
+}
+
+// ============================================================================
+// Retry and Concurrency Tests
+// ============================================================================
+
+// testClientConfig returns a client configuration suitable for fast tests.
+func testClientConfig() ClientConfig {
+	cfg := DefaultClientConfig()
+	cfg.QueryTimeout = time.Second
+	return cfg
+}
+
+// TestRetryOnConnectionFailure tests that a request whose connection is dropped
+// before the daemon answers is retried.
+func TestRetryOnConnectionFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"pong":true}`))
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := testClientConfig()
+	client := NewClientWithConfig(u, cfg)
+	require.Error(t, client.Ping())
+	require.EqualValues(t, 1, calls.Load())
+
+	cfg.Retry = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, Retryable: RetryConnect}
+	calls.Store(0)
+	client = NewClientWithConfig(u, cfg)
+	require.NoError(t, client.Ping())
+	require.EqualValues(t, 2, calls.Load())
+	require.Equal(t, uint64(2), client.Stats().Queries)
+}
+
+// TestRetryableErrorClasses tests that only failures in the policy's retryable
+// classes are retried.
+func TestRetryableErrorClasses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var calls atomic.Int32
+	var status atomic.Int32
+	var body atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(int(status.Load()))
+		w.Write([]byte(body.Load().(string)))
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		retryable ErrorClass
+		calls     int32
+	}{
+		{"internal not retried", 500, `{"error":"busy","code":"internal"}`, RetryConnect | RetryUnavailable, 1},
+		{"internal retried", 500, `{"error":"busy","code":"internal"}`, RetryInternal, 3},
+		{"not_found never retried", 404, `{"error":"gone","code":"not_found"}`, RetryInternal | RetryUnavailable, 1},
+		{"bare 502 retried", 502, `bad gateway`, RetryUnavailable, 3},
+		{"bare 502 not retried", 502, `bad gateway`, RetryInternal, 1},
+	}
+	for _, test := range tests {
+		calls.Store(0)
+		status.Store(int32(test.status))
+		body.Store(test.body)
+
+		cfg := testClientConfig()
+		cfg.Retry = RetryPolicy{MaxAttempts: 3, Retryable: test.retryable}
+		client := NewClientWithConfig(u, cfg)
+		require.Error(t, client.Ping(), test.name)
+		require.Equal(t, test.calls, calls.Load(), test.name)
+	}
+}
+
+// TestRetryTimeout tests that attempts exceeding the query timeout are retried
+// only if timeouts are retryable.
+func TestRetryTimeout(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		if calls.Add(1) == 1 {
+			time.Sleep(200 * time.Millisecond)
+		}
+		return map[string]interface{}{"pong": true}
+	})
+	defer server.Close()
+
+	cfg := testClientConfig()
+	cfg.QueryTimeout = 50 * time.Millisecond
+	cfg.Retry = RetryPolicy{MaxAttempts: 2, Retryable: RetryConnect}
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+	require.Error(t, client.Ping())
+
+	calls.Store(0)
+	cfg.Retry.Retryable = RetryTimeout
+	client = NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+	require.NoError(t, client.Ping())
+	require.EqualValues(t, 2, calls.Load())
+}
+
+// TestRetryPolicyBackoff tests that the backoff doubles up to the maximum.
+func TestRetryPolicyBackoff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 350 * time.Millisecond}
+	require.Equal(t, 100*time.Millisecond, p.backoff(1))
+	require.Equal(t, 200*time.Millisecond, p.backoff(2))
+	require.Equal(t, 350*time.Millisecond, p.backoff(3))
+	require.Equal(t, 350*time.Millisecond, p.backoff(10))
+
+	p.MaxBackoff = 0
+	require.Equal(t, 100*time.Millisecond, p.backoff(3))
+}
+
+// TestRetryPolicyJitter tests that jitter shortens each backoff by no more
+// than its percentage, and by different amounts.
+func TestRetryPolicyJitter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	require.Equal(t, 200*time.Millisecond, p.delay(2))
+
+	p.Jitter = 25
+	seen := make(map[time.Duration]bool)
+	for i := 0; i < 100; i++ {
+		d := p.delay(2)
+		require.GreaterOrEqual(t, d, 150*time.Millisecond)
+		require.LessOrEqual(t, d, 200*time.Millisecond)
+		seen[d] = true
+	}
+	require.Greater(t, len(seen), 1)
+
+	p.Jitter = 100
+	for i := 0; i < 100; i++ {
+		require.GreaterOrEqual(t, p.delay(1), time.Duration(0))
+	}
+}
+
+// TestRetryBudget tests that a retry budget cuts attempts short and stops
+// retrying once a backoff would not leave time for another attempt.
+func TestRetryBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		calls.Add(1)
+		time.Sleep(time.Second)
+		return map[string]interface{}{"pong": true}
+	})
+	defer server.Close()
+
+	cfg := testClientConfig()
+	cfg.QueryTimeout = 5 * time.Second
+	cfg.Retry = RetryPolicy{MaxAttempts: 10, Retryable: RetryTimeout, Budget: 300 * time.Millisecond}
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+	start := time.Now()
+	require.Error(t, client.Ping())
+	require.Less(t, time.Since(start), time.Second)
+	require.EqualValues(t, 1, calls.Load())
+
+	// Backoffs count against the budget.
+	calls.Store(0)
+	cfg.QueryTimeout = 50 * time.Millisecond
+	cfg.Retry = RetryPolicy{MaxAttempts: 10, InitialBackoff: 100 * time.Millisecond, Retryable: RetryTimeout, Budget: 300 * time.Millisecond}
+	client = NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+	var slept []time.Duration
+	client.sleep = func(d time.Duration) {
+		slept = append(slept, d)
+		time.Sleep(d)
+	}
+	require.Error(t, client.Ping())
+	require.Len(t, slept, 1)
+	require.EqualValues(t, 2, calls.Load())
+}
+
+// TestMakeClientConfigRetryPolicy tests that the retry settings are taken from config.Local.
+func TestMakeClientConfigRetryPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleMaxAttempts = 4
+	local.ExternalWeightOracleRetryableErrors = "timeout, unavailable"
+	local.ExternalWeightOracleRetryBudget = 3 * time.Second
+	cfg := MakeClientConfig(local)
+	require.Equal(t, RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: local.ExternalWeightOracleRetryInitialBackoff,
+		MaxBackoff:     local.ExternalWeightOracleRetryMaxBackoff,
+		Retryable:      RetryTimeout | RetryUnavailable,
+		Jitter:         20,
+		Budget:         3 * time.Second,
+	}, cfg.Retry)
+}
+
+// TestMaxConcurrentRequests tests that requests beyond the concurrency limit wait
+// for a free slot and give up when their query timeout expires.
+func TestMaxConcurrentRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		return map[string]interface{}{"pong": true}
+	})
+	defer server.Close()
+
+	cfg := testClientConfig()
+	cfg.MaxConcurrentRequests = 1
+	cfg.QueryTimeout = 100 * time.Millisecond
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+
+	// Occupy the only slot, as a request in flight would
+	client.requestSlots <- struct{}{}
+	err := client.Ping()
+	require.ErrorContains(t, err, "no free weight daemon request slot")
+	require.Zero(t, client.Stats().Queries)
+
+	<-client.requestSlots
+	require.NoError(t, client.Ping())
+	require.EqualValues(t, 1, client.Stats().Queries)
+}
+
+// TestWeightBoundsRejectNotCached tests that answers outside the configured
+// bounds are rejected with a WeightBoundsError and are not cached.
+func TestWeightBoundsRejectNotCached(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		if path == "/total_weight" {
+			return map[string]interface{}{"total_weight": "1000000"}
+		}
+		return map[string]interface{}{"weight": "5000"}
+	})
+	defer server.Close()
+
+	cfg := testClientConfig()
+	cfg.Bounds = WeightBounds{MaxWeight: 4000, MaxTotalWeight: 1000000}
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+
+	var addr basics.Address
+	for i := 0; i < 2; i++ {
+		_, err := client.Weight(100, addr, crypto.VRFVerifier{})
+		var be *WeightBoundsError
+		require.ErrorAs(t, err, &be)
+		require.Equal(t, "weight", be.Quantity)
+		require.Equal(t, uint64(5000), be.Value)
+		require.Equal(t, uint64(4000), be.Limit)
+	}
+	require.Equal(t, uint64(2), client.Stats().Queries)
+	require.Zero(t, client.Stats().CacheHits)
+
+	total, err := client.TotalWeight(100, 110)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000000), total)
+}
+
+// TestMakeClientConfigWeightBounds tests that the weight bounds are taken from config.Local.
+func TestMakeClientConfigWeightBounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	require.Equal(t, WeightBounds{}, MakeClientConfig(local).Bounds)
+
+	local.ExternalWeightOracleMaxWeight = 10
+	local.ExternalWeightOracleMaxTotalWeight = 100
+	local.ExternalWeightOracleMaxWeightRatioPPM = 250_000
+	require.Equal(t, WeightBounds{MaxWeight: 10, MaxTotalWeight: 100, MaxRatioPPM: 250_000}, MakeClientConfig(local).Bounds)
+}
+
+// TestMakeClientConfigAdaptiveWeightCache tests that the weight cache bounds
+// are taken from config.Local, the minimum defaulting to the configured size.
+func TestMakeClientConfigAdaptiveWeightCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	require.Zero(t, MakeClientConfig(local).WeightCacheMaxCapacity)
+
+	local.ExternalWeightOracleWeightCacheMaxSize = 100_000
+	cfg := MakeClientConfig(local)
+	require.Equal(t, int(local.ExternalWeightOracleWeightCacheSize), cfg.WeightCacheMinCapacity)
+	require.Equal(t, 100_000, cfg.WeightCacheMaxCapacity)
+
+	local.ExternalWeightOracleWeightCacheMinSize = 500
+	require.Equal(t, 500, MakeClientConfig(local).WeightCacheMinCapacity)
+}
+
+// TestAuthToken tests that the configured bearer token is sent with every request
+// and that a rejected token is not reported as a daemon error.
+func TestAuthToken(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"Missing or invalid credentials","code":"unauthorized"}`))
+			return
+		}
+		w.Write([]byte(`{"pong":true}`))
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := testClientConfig()
+	cfg.Retry = RetryPolicy{MaxAttempts: 3, Retryable: RetryConnect | RetryTimeout | RetryInternal | RetryUnavailable}
+	err = NewClientWithConfig(u, cfg).Ping()
+	require.ErrorIs(t, err, ErrUnauthorized)
+	var de *ledgercore.DaemonError
+	require.False(t, errors.As(err, &de))
+	require.EqualValues(t, 1, calls.Load())
+
+	t.Setenv("TEST_WEIGHT_ORACLE_AUTH_TOKEN", "s3cr3t")
+	cfg.AuthToken, err = config.LoadSecret("env:TEST_WEIGHT_ORACLE_AUTH_TOKEN", "")
+	require.NoError(t, err)
+	require.NoError(t, NewClientWithConfig(u, cfg).Ping())
+}
+
+// TestEndpointConcurrency tests that a per-endpoint limit holds back requests to
+// that endpoint without affecting the others.
+func TestEndpointConcurrency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		if path == "/total_weight" {
+			return map[string]interface{}{"total_weight": "1000"}
+		}
+		return map[string]interface{}{"pong": true}
+	})
+	defer server.Close()
+
+	cfg := testClientConfig()
+	cfg.MaxConcurrentRequests = 4
+	cfg.EndpointConcurrency = map[string]int{"/total_weight": 1}
+	cfg.QueryTimeout = 100 * time.Millisecond
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+
+	// Occupy the only total weight slot, as a request in flight would
+	client.endpointSlots["/total_weight"] <- struct{}{}
+	_, err := client.TotalWeight(1, 2)
+	require.ErrorContains(t, err, "no free weight daemon request slot for /total_weight")
+	require.NoError(t, client.Ping())
+	require.EqualValues(t, 1, client.Stats().Queries)
+
+	// The overall slot taken by the failed request was given back
+	require.Zero(t, len(client.requestSlots))
+
+	<-client.endpointSlots["/total_weight"]
+	total, err := client.TotalWeight(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), total)
+}
+
+// TestMakeClientConfigEndpointConcurrency tests that the per-endpoint limits are
+// taken from config.Local.
+func TestMakeClientConfigEndpointConcurrency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	require.Nil(t, MakeClientConfig(local).EndpointConcurrency)
+
+	local.ExternalWeightOracleMaxConcurrentRequestsPerEndpoint = "weight=32, weight_table=1"
+	require.Equal(t, map[string]int{"/weight": 32, "/weight_table": 1}, MakeClientConfig(local).EndpointConcurrency)
+}
+
+// TestWeightContextAbandoned checks that a lookup whose context is done stops
+// waiting on the daemon, and that its abandonment is not held against the
+// daemon.
+func TestWeightContextAbandoned(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	oracle.SetTotalWeight(500)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	clientConfig := testClientConfig()
+	clientConfig.QueryTimeout = 10 * time.Second
+	clientConfig.StaleRounds = 8
+	clientConfig.BreakerThreshold = 100
+	clientConfig.BreakerWindow = 2
+	clientConfig.BreakerCooldown = time.Minute
+	client := NewClientWithConfig(server.URL(), clientConfig)
+	addr, selectionID := basics.Address{1}, crypto.VRFVerifier{9}
+	_, err = client.Weight(9, addr, selectionID)
+	require.NoError(t, err)
+
+	oracle.SetLatency(5 * time.Second)
+	for rnd := basics.Round(10); rnd <= 12; rnd++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		start := time.Now()
+		_, err := client.WeightContext(ctx, rnd, addr, selectionID)
+		// The weight cached for round 9 is not served stale in its place.
+		require.ErrorIs(t, err, ErrRequestAbandoned)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Less(t, time.Since(start), 2*time.Second)
+
+		_, err = client.TotalWeightContext(ctx, rnd, rnd+320)
+		require.ErrorIs(t, err, ErrRequestAbandoned)
+		cancel()
+	}
+
+	// Cached weights are answered whatever the context.
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	w, err := client.WeightContext(cancelled, 9, addr, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), w)
+
+	// The abandoned requests did not open the circuit breaker.
+	oracle.SetLatency(0)
+	w, err = client.Weight(12, addr, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), w)
+}
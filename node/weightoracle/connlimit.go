@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// ErrConnectionLimit is returned when all connections to the daemon are busy
+// and the client is configured to reject rather than queue.
+var ErrConnectionLimit = errors.New("all weight daemon connections are busy")
+
+var connectionQueueDepthGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_weight_oracle_connection_queue_depth", Description: "number of weight daemon request attempts waiting for a free connection"})
+
+// connectionQueueDepth counts the waiting attempts of every client, which
+// share the gauge.
+var connectionQueueDepth atomic.Int64
+
+// acquireConnection takes one of the client's connections for an attempt at a
+// request to endpoint and returns a function that gives it back. When none is
+// free it waits up to the query timeout, or fails at once if the client
+// rejects overflow.
+func (c *Client) acquireConnection(ctx context.Context, endpoint string) (release func(), err error) {
+	if c.connSlots == nil {
+		return func() {}, nil
+	}
+	release = func() { <-c.connSlots }
+	select {
+	case c.connSlots <- struct{}{}:
+		return release, nil
+	default:
+	}
+	if c.rejectOverflow {
+		return nil, fmt.Errorf("%w (%d in use) for %s", ErrConnectionLimit, cap(c.connSlots), endpoint)
+	}
+
+	connectionQueueDepthGauge.Set(uint64(connectionQueueDepth.Add(1)))
+	defer func() { connectionQueueDepthGauge.Set(uint64(connectionQueueDepth.Add(-1))) }()
+	timer := time.NewTimer(c.queryTimeout)
+	defer timer.Stop()
+	select {
+	case c.connSlots <- struct{}{}:
+		return release, nil
+	case <-timer.C:
+		return nil, fmt.Errorf("%w: none free for %s within %v", ErrConnectionLimit, endpoint, c.queryTimeout)
+	case <-ctx.Done():
+		return nil, abandoned(ctx, endpoint)
+	}
+}
+
+// WarmConnections opens the client's warm connections to the daemon ahead of
+// the requests that will need them, by sending that many pings at once; the
+// transport keeps the connections idle afterwards. Over HTTP/2 one connection
+// carries every request, and one ping is sent. Pings take idle connections
+// before opening new ones, so a later call replaces only the connections the
+// daemon has closed since. It returns the first ping failure.
+func (c *Client) WarmConnections() error {
+	errs := make(chan error, c.warmConnections)
+	for i := 0; i < c.warmConnections; i++ {
+		go func() { errs <- c.Ping() }()
+	}
+	var first error
+	for i := 0; i < c.warmConnections; i++ {
+		if err := <-errs; err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
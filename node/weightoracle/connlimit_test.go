@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// heldConnectionClient returns a client limited to one connection whose
+// attempts each block, after announcing themselves on entered, until unblock
+// is closed.
+func heldConnectionClient(reject bool) (c *Client, entered chan struct{}, unblock chan struct{}) {
+	entered = make(chan struct{}, 2)
+	unblock = make(chan struct{})
+	held := func(req *http.Request) (*http.Response, error) {
+		entered <- struct{}{}
+		<-unblock
+		return respond(http.StatusOK, `{"pong":true}`)(req)
+	}
+	cfg := testClientConfig()
+	cfg.MaxConnections = 1
+	cfg.RejectConnectionOverflow = reject
+	cfg.Transport = &faultTransport{script: []fault{held}}
+	return NewClientWithConfig(&url.URL{Scheme: "http", Host: "weights.invalid"}, cfg), entered, unblock
+}
+
+// TestConnectionLimitReject tests that with the reject policy an attempt that
+// finds every connection busy fails at once.
+func TestConnectionLimitReject(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleMaxConnections = 1
+	local.ExternalWeightOracleConnectionOverflow = config.WeightOracleConnectionOverflowReject
+	cfg := MakeClientConfig(local)
+	require.Equal(t, 1, cfg.MaxConnections)
+	require.True(t, cfg.RejectConnectionOverflow)
+
+	c, entered, unblock := heldConnectionClient(true)
+	first := make(chan error, 1)
+	go func() { first <- c.Ping() }()
+	<-entered
+
+	start := time.Now()
+	require.ErrorIs(t, c.Ping(), ErrConnectionLimit)
+	require.Less(t, time.Since(start), c.queryTimeout)
+
+	close(unblock)
+	require.NoError(t, <-first)
+	require.NoError(t, c.Ping())
+}
+
+// TestConnectionLimitQueue tests that with the queue policy an attempt waits
+// for a busy connection, and is counted in the queue depth while it does.
+func TestConnectionLimitQueue(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// Not parallel: the queue depth is shared by every client.
+
+	c, entered, unblock := heldConnectionClient(false)
+	first := make(chan error, 1)
+	go func() { first <- c.Ping() }()
+	<-entered
+
+	second := make(chan error, 1)
+	go func() { second <- c.Ping() }()
+	require.Eventually(t, func() bool { return connectionQueueDepth.Load() == 1 }, time.Second, time.Millisecond)
+
+	close(unblock)
+	require.NoError(t, <-first)
+	require.NoError(t, <-second)
+	require.Zero(t, connectionQueueDepth.Load())
+
+	// A queued attempt gives up when the query timeout expires.
+	c, entered, unblock = heldConnectionClient(false)
+	defer close(unblock)
+	c.queryTimeout = 20 * time.Millisecond
+	go c.Ping()
+	<-entered
+	require.ErrorIs(t, c.Ping(), ErrConnectionLimit)
+}
+
+// TestWarmConnections tests that warming opens the configured number of
+// connections, that they are used by later requests, and that warming again
+// opens only the connections that were closed.
+func TestWarmConnections(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleWarmConnections = 12
+	require.Equal(t, 12, MakeClientConfig(local).WarmConnections)
+
+	// Slow pings keep the warm-up's requests in flight together.
+	handler := NewHandler(mock.New())
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		handler.ServeHTTP(w, r)
+	}))
+	var opened atomic.Int32
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			opened.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := testClientConfig()
+	cfg.WarmConnections = 12
+	c := NewClientWithConfig(u, cfg)
+	require.NoError(t, c.WarmConnections())
+	require.EqualValues(t, 12, opened.Load())
+
+	// All twelve stay idle, above the transport's default of ten.
+	require.NoError(t, c.WarmConnections())
+	require.NoError(t, c.Ping())
+	require.EqualValues(t, 12, opened.Load())
+
+	// A client without warm connections sends nothing.
+	require.NoError(t, NewClientWithConfig(u, testClientConfig()).WarmConnections())
+	require.EqualValues(t, 12, opened.Load())
+}
+
+// TestWarmConnectionsHTTP2 tests that over HTTP/2 warming opens one
+// connection.
+func TestWarmConnectionsHTTP2(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	d := newProtoDaemon(t, false)
+	cfg := testClientConfig()
+	cfg.HTTP2 = true
+	cfg.WarmConnections = 8
+	c := NewClientWithConfig(d.url, cfg)
+	require.NoError(t, c.WarmConnections())
+	require.Equal(t, map[int]int{2: 1}, d.requests())
+	require.EqualValues(t, 1, d.conns.Load())
+}
+
+// TestWarmConnectionsError tests that warming reports a daemon that cannot be
+// reached.
+func TestWarmConnectionsError(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	client, transport, _ := faultClient(RetryPolicy{MaxAttempts: 1}, connectionReset())
+	client.warmConnections = 3
+	require.ErrorContains(t, client.WarmConnections(), "failed to connect")
+	require.Equal(t, 3, transport.attempts)
+}
@@ -0,0 +1,217 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// HealthHistoryCapacity is the number of health checks a HealthLog keeps.
+const HealthHistoryCapacity = 100
+
+// redacted replaces setting values that may carry credentials.
+const redacted = "[redacted]"
+
+// HealthCheck is the outcome of one periodic ping of the daemon.
+type HealthCheck struct {
+	Time  time.Time `json:"time"`
+	Error string    `json:"error,omitempty"`
+}
+
+// HealthLog keeps the latest HealthHistoryCapacity health checks. The zero
+// value is ready to use.
+type HealthLog struct {
+	mu     deadlock.Mutex
+	checks []HealthCheck
+}
+
+// Record appends the outcome of a health check made at t.
+func (h *HealthLog) Record(t time.Time, err error) {
+	check := HealthCheck{Time: t}
+	if err != nil {
+		check.Error = truncateText(err.Error())
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.checks) == HealthHistoryCapacity {
+		copy(h.checks, h.checks[1:])
+		h.checks = h.checks[:len(h.checks)-1]
+	}
+	h.checks = append(h.checks, check)
+}
+
+// Snapshot returns a copy of the recorded checks, oldest first.
+func (h *HealthLog) Snapshot() []HealthCheck {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]HealthCheck(nil), h.checks...)
+}
+
+// Diagnostics is the state of a node's weight oracle client gathered for a
+// bug report.
+type Diagnostics struct {
+	Time    time.Time
+	Version string
+
+	// Settings holds the node's weight oracle configuration, see RedactedSettings.
+	Settings map[string]interface{}
+
+	// Identity is the daemon's last identity answer, nil if it never gave one.
+	Identity *ledgercore.DaemonIdentity
+
+	Stats         QueryStats
+	RecentQueries []QueryRecord
+	HealthChecks  []HealthCheck
+}
+
+// MakeDiagnostics gathers the client's state. The caller fills in Version and
+// HealthChecks, which the client does not track.
+func (c *Client) MakeDiagnostics(cfg config.Local) *Diagnostics {
+	d := &Diagnostics{
+		Time:          time.Now(),
+		Settings:      RedactedSettings(cfg),
+		Stats:         c.Stats(),
+		RecentQueries: c.RecentQueries(),
+	}
+	if identity, ok := c.LastIdentity(); ok {
+		d.Identity = &identity
+	}
+	return d
+}
+
+// RedactedSettings returns the weight oracle fields of cfg by name. Durations
+// are rendered as strings. Credentials in the daemon URLs are redacted, as is an
+// auth token or signing key reference that is not a valid "file:" or "env:"
+// reference, which most likely is the secret itself. Secrets are never loaded.
+func RedactedSettings(cfg config.Local) map[string]interface{} {
+	settings := make(map[string]interface{})
+	v := reflect.ValueOf(cfg)
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		if !strings.HasPrefix(name, "ExternalWeightOracle") && name != "EnableBuiltinStakeWeightOracle" && name != "AllowRemoteWeightOracle" {
+			continue
+		}
+		value := v.Field(i).Interface()
+		if d, ok := value.(time.Duration); ok {
+			value = d.String()
+		}
+		settings[name] = value
+	}
+
+	settings["ExternalWeightOracleURL"] = redactURL(cfg.ExternalWeightOracleURL)
+	crossCheck := strings.Split(cfg.ExternalWeightOracleCrossCheckURLs, ",")
+	for i, raw := range crossCheck {
+		crossCheck[i] = redactURL(strings.TrimSpace(raw))
+	}
+	settings["ExternalWeightOracleCrossCheckURLs"] = strings.Join(crossCheck, ",")
+	for name, ref := range map[string]string{
+		"ExternalWeightOracleAuthToken":  cfg.ExternalWeightOracleAuthToken,
+		"ExternalWeightOracleSigningKey": cfg.ExternalWeightOracleSigningKey,
+	} {
+		if ref != "" && config.ValidateSecretRef(ref) != nil {
+			settings[name] = redacted
+		}
+	}
+	return settings
+}
+
+// redactURL removes the user info and query string of a daemon URL, either of
+// which may hold a credential.
+func redactURL(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redacted
+	}
+	u.User = nil
+	if u.RawQuery != "" {
+		u.RawQuery = redacted
+	}
+	return u.String()
+}
+
+// bundleIdentity is DaemonIdentity with the genesis hash in its usual
+// printed form rather than a byte array.
+type bundleIdentity struct {
+	GenesisHash      string `json:"genesis_hash"`
+	ProtocolVersion  string `json:"protocol_version"`
+	AlgorithmVersion string `json:"algorithm_version"`
+}
+
+// WriteBundle writes the diagnostics to w as a gzipped tar archive of JSON
+// files, one per section.
+func (d *Diagnostics) WriteBundle(w io.Writer) error {
+	var identity *bundleIdentity
+	if d.Identity != nil {
+		identity = &bundleIdentity{
+			GenesisHash:      d.Identity.GenesisHash.String(),
+			ProtocolVersion:  d.Identity.WeightProtocolVersion,
+			AlgorithmVersion: d.Identity.WeightAlgorithmVersion,
+		}
+	}
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{"manifest.json", map[string]interface{}{"time": d.Time, "version": d.Version}},
+		{"settings.json", d.Settings},
+		{"identity.json", identity},
+		{"stats.json", d.Stats},
+		{"recent_queries.json", d.RecentQueries},
+		{"health_checks.json", d.HealthChecks},
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.content, "", "  ")
+		if err != nil {
+			return fmt.Errorf("cannot encode %s: %w", f.name, err)
+		}
+		header := &tar.Header{
+			Name:    f.name,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: d.Time,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
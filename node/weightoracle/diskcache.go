@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// CacheFilename is the name of the file a node keeps its cached weight
+// daemon answers in across restarts, which lives in its hot data directory.
+const CacheFilename = "weightoracle-cache.json"
+
+// ErrCacheIdentityMismatch is returned by LoadCache when the cache file was
+// saved from a daemon for another network or weight algorithm, whose answers
+// must not be used.
+var ErrCacheIdentityMismatch = errors.New("weight cache file was saved from a daemon with a different identity")
+
+// cacheFile is the content of a cache file: the answers, and the identity of
+// the daemon that gave them.
+type cacheFile struct {
+	GenesisHash      string `json:"genesis_hash"`
+	AlgorithmVersion string `json:"algorithm_version"`
+	CacheExport
+}
+
+// SaveCache writes the cached answers for balance rounds from since on to the
+// file at path, with the identity of the daemon they came from. The file is
+// replaced at once, so a crash leaves the previous one whole.
+func (c *Client) SaveCache(path string, since basics.Round, identity ledgercore.DaemonIdentity) error {
+	data, err := json.Marshal(cacheFile{
+		GenesisHash:      identity.GenesisHash.String(),
+		AlgorithmVersion: identity.WeightAlgorithmVersion,
+		CacheExport:      c.ExportCache(since),
+	})
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadCache imports the answers saved by SaveCache at path for balance rounds
+// from since on, and returns how many it imported. A missing file imports
+// nothing. A file saved from a daemon whose genesis hash or weight algorithm
+// version differs from identity is not used, and ErrCacheIdentityMismatch is
+// returned.
+func (c *Client) LoadCache(path string, since basics.Round, identity ledgercore.DaemonIdentity) (int, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var file cacheFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return 0, fmt.Errorf("weight cache file %s: %w", path, err)
+	}
+	if file.GenesisHash != identity.GenesisHash.String() || file.AlgorithmVersion != identity.WeightAlgorithmVersion {
+		return 0, fmt.Errorf("%w: genesis %s, algorithm %s", ErrCacheIdentityMismatch, file.GenesisHash, file.AlgorithmVersion)
+	}
+	n, err := c.ImportCache(file.CacheExport, since)
+	if err != nil {
+		return n, fmt.Errorf("weight cache file %s: %w", path, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestSaveLoadCache checks that answers saved by one client are served from
+// the cache by another, for the balance rounds asked for and only from a
+// daemon with the same identity.
+func TestSaveLoadCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	oracle.SetTotalWeight(100)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	identity := ledgercore.DaemonIdentity{GenesisHash: crypto.Digest{1}, WeightAlgorithmVersion: "1.0"}
+	path := filepath.Join(t.TempDir(), CacheFilename)
+	a, b := basics.Address{1}, basics.Address{2}
+	selectionID := crypto.VRFVerifier{9}
+
+	// A missing file loads nothing.
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+	n, err := client.LoadCache(path, 0, identity)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	_, err = client.Weight(10, a, selectionID)
+	require.NoError(t, err)
+	_, err = client.Weight(20, b, selectionID)
+	require.NoError(t, err)
+	_, err = client.TotalWeight(20, 340)
+	require.NoError(t, err)
+	require.NoError(t, client.SaveCache(path, 11, identity))
+
+	other := identity
+	other.WeightAlgorithmVersion = "2.0"
+	restarted := NewClientWithConfig(server.URL(), testClientConfig())
+	_, err = restarted.LoadCache(path, 0, other)
+	require.ErrorIs(t, err, ErrCacheIdentityMismatch)
+	require.Empty(t, restarted.ExportCache(0).Weights)
+
+	n, err = restarted.LoadCache(path, 0, identity)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	before := restarted.Stats()
+	weight, err := restarted.Weight(20, b, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), weight)
+	total, err := restarted.TotalWeight(20, 340)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), total)
+	require.Equal(t, QueryStats{CacheHits: 2}, restarted.Stats().Sub(before))
+	require.Equal(t, client.ExportCache(11), restarted.ExportCache(0))
+}
+
+// TestLoadCacheMalformed checks that a damaged cache file is reported.
+func TestLoadCacheMalformed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	identity := ledgercore.DaemonIdentity{GenesisHash: crypto.Digest{1}, WeightAlgorithmVersion: "1.0"}
+	dir := t.TempDir()
+	client := NewClient(1)
+	for name, content := range map[string]string{
+		"truncated": `{"genesis_hash":`,
+		"bad key":   `{"genesis_hash":"` + identity.GenesisHash.String() + `","algorithm_version":"1.0","weights":{"nope":1}}`,
+	} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		_, err := client.LoadCache(path, 0, identity)
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// FailbackInterval is how long requests stay on a failover daemon before the
+// main daemon is checked again, and how long they wait between checks while
+// it keeps failing them.
+const FailbackInterval = 30 * time.Second
+
+// failoverClasses are the failures that move requests to the next daemon:
+// those of a daemon that is down, overloaded or broken, rather than of a
+// request it turned down.
+const failoverClasses = RetryConnect | RetryTimeout | RetryInternal | RetryUnavailable
+
+var weightOracleFailovers = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_failovers_total", Description: "number of times weight daemon requests moved from one daemon to another"})
+
+// failoverState tracks which of a Client's daemons requests go to.
+//
+// Requests go to one daemon at a time. When an attempt fails in one of the
+// failoverClasses, the request moves on to the next daemon in the list, and
+// the requests after it stay there. A daemon is only moved to after it has
+// answered an identity request with the identity the client has seen before,
+// or with any valid identity if it has seen none, so that all the daemons in
+// use serve the same network with the same weight algorithm. Once requests
+// have been off the main daemon for FailbackInterval, it is checked the same
+// way in the background and they go back to it if it passes.
+type failoverState struct {
+	// mu serializes moves between daemons.
+	mu deadlock.Mutex
+
+	// active indexes the daemon in use in Client.daemons.
+	active atomic.Int32
+
+	// since is when requests last moved, or when the main daemon last
+	// failed its check, in Unix nanoseconds.
+	since atomic.Int64
+
+	// probing is set while the main daemon is being checked.
+	probing atomic.Bool
+}
+
+// activeDaemon returns the daemon requests go to now, and starts a check of
+// the main daemon if they have been elsewhere for long enough.
+func (c *Client) activeDaemon() *daemon {
+	f := &c.failover
+	i := f.active.Load()
+	if i != 0 && time.Since(time.Unix(0, f.since.Load())) >= FailbackInterval && f.probing.CompareAndSwap(false, true) {
+		go c.failBack()
+	}
+	return c.daemons[i]
+}
+
+// failOver moves requests on from the daemon d, whose attempt failed with err,
+// to the first daemon after it that passes checkDaemon. It reports whether
+// requests now go to another daemon, as they do when a concurrent request has
+// moved them already.
+func (c *Client) failOver(d *daemon, err error) bool {
+	f := &c.failover
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	from := int(f.active.Load())
+	if c.daemons[from] != d {
+		return true
+	}
+	for i := 1; i < len(c.daemons); i++ {
+		next := (from + i) % len(c.daemons)
+		if checkErr := c.checkDaemon(c.daemons[next]); checkErr != nil {
+			logging.Base().Warnf("not failing over to weight daemon %s: %v", c.daemons[next].url, checkErr)
+			continue
+		}
+		logging.Base().Warnf("weight daemon %s failed (%v); failing over to %s", d.url, err, c.daemons[next].url)
+		f.moveTo(next)
+		return true
+	}
+	return false
+}
+
+// failBack moves requests back to the main daemon if it passes checkDaemon.
+func (c *Client) failBack() {
+	f := &c.failover
+	defer f.probing.Store(false)
+	err := c.checkDaemon(c.daemons[0])
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	from := f.active.Load()
+	if from == 0 {
+		return
+	}
+	if err != nil {
+		f.since.Store(time.Now().UnixNano())
+		return
+	}
+	logging.Base().Infof("weight daemon %s answers again; failing back from %s", c.daemons[0].url, c.daemons[from].url)
+	f.moveTo(0)
+}
+
+// moveTo sends the requests from now on to the daemon at index i.
+func (f *failoverState) moveTo(i int) {
+	f.active.Store(int32(i))
+	f.since.Store(time.Now().UnixNano())
+	weightOracleFailovers.Inc(nil)
+}
+
+// checkDaemon asks the daemon d for its identity, and returns an error unless
+// the answer is valid and has the genesis hash and versions of the identity
+// the client has seen before. With none seen yet, the answer becomes it.
+func (c *Client) checkDaemon(d *daemon) error {
+	release, err := c.acquireConnection(context.Background(), "/identity")
+	if err != nil {
+		return err
+	}
+	defer release()
+	body, err := encodeRequest(emptyRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	defer body.release()
+
+	var resp identityResponse
+	if _, err := c.attempt(context.Background(), d, "/identity", emptyRequest{}, body, &resp, c.queryTimeout); err != nil {
+		return err
+	}
+	identity, err := parseIdentity(resp)
+	if err != nil {
+		return err
+	}
+	if c.lastIdentity.CompareAndSwap(nil, &identity) {
+		return nil
+	}
+	want := c.lastIdentity.Load()
+	if identity.GenesisHash != want.GenesisHash || identity.WeightAlgorithmVersion != want.WeightAlgorithmVersion ||
+		identity.WeightProtocolVersion != want.WeightProtocolVersion {
+		return fmt.Errorf("daemon serves genesis %v with algorithm version %s and protocol version %s, not genesis %v with %s and %s",
+			identity.GenesisHash, identity.WeightAlgorithmVersion, identity.WeightProtocolVersion,
+			want.GenesisHash, want.WeightAlgorithmVersion, want.WeightProtocolVersion)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// startFailoverDaemons starts a daemon for each oracle and returns a client
+// using the first as its main daemon and the others as failover daemons.
+func startFailoverDaemons(t *testing.T, cfg ClientConfig, oracles ...*mock.Oracle) (*Client, []*Server) {
+	servers := make([]*Server, len(oracles))
+	for i, oracle := range oracles {
+		server, err := StartServer("127.0.0.1:0", oracle)
+		require.NoError(t, err)
+		t.Cleanup(func() { server.Close() })
+		servers[i] = server
+		if i > 0 {
+			cfg.Failover = append(cfg.Failover, server.URL())
+		}
+	}
+	return NewClientWithConfig(servers[0].URL(), cfg), servers
+}
+
+// TestFailoverOnInternalError checks that a request the main daemon fails
+// with an internal error is answered by a failover daemon, that later
+// requests stay there, and that they go back once the main daemon recovers.
+func TestFailoverOnInternalError(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	primary := mock.New()
+	primary.SetWeight(addr, 10)
+	primary.SetWeightError(errors.New("snapshot missing"))
+	standby := mock.New()
+	standby.SetWeight(addr, 10)
+
+	client, _ := startFailoverDaemons(t, testClientConfig(), primary, standby)
+	w, err := client.Weight(1, addr, makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), w)
+	require.Equal(t, int32(1), client.failover.active.Load())
+	require.Equal(t, 1, standby.CallCount(mock.MethodIdentity))
+
+	_, err = client.Weight(2, addr, makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.CallCount(mock.MethodWeight))
+	require.Equal(t, 2, standby.CallCount(mock.MethodWeight))
+
+	// The main daemon is checked in the background, and used again once it
+	// passes.
+	primary.SetWeightError(nil)
+	require.Eventually(t, func() bool {
+		client.failover.since.Store(0)
+		require.NoError(t, client.Ping())
+		return client.failover.active.Load() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = client.Weight(3, addr, makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, 2, primary.CallCount(mock.MethodWeight))
+}
+
+// TestFailoverOnConnectionFailure checks that requests move on from a main
+// daemon that cannot be reached, and from one that times out.
+func TestFailoverOnConnectionFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	standby := mock.New()
+	standby.SetTotalWeight(500)
+	client, servers := startFailoverDaemons(t, testClientConfig(), mock.New(), standby)
+	require.NoError(t, servers[0].Close())
+	total, err := client.TotalWeight(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), total)
+
+	slow := mock.New()
+	slow.SetLatency(time.Second)
+	cfg := testClientConfig()
+	cfg.QueryTimeout = 100 * time.Millisecond
+	client, _ = startFailoverDaemons(t, cfg, slow, standby)
+	total, err = client.TotalWeight(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), total)
+}
+
+// TestFailoverIdentityCheck checks that requests only move to a daemon with
+// the identity of the daemon they leave, and that answers about the request
+// itself do not move them.
+func TestFailoverIdentityCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	identity := ledgercore.DaemonIdentity{
+		GenesisHash:            crypto.Hash([]byte("genesis")),
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	}
+	primary := mock.New()
+	primary.SetIdentity(identity)
+	primary.SetTotalWeightError(errors.New("snapshot missing"))
+	other := mock.New()
+	other.SetIdentity(ledgercore.DaemonIdentity{
+		GenesisHash:            crypto.Hash([]byte("other network")),
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	})
+	other.SetTotalWeight(100)
+	matching := mock.New()
+	matching.SetIdentity(identity)
+	matching.SetTotalWeight(200)
+
+	client, _ := startFailoverDaemons(t, testClientConfig(), primary, other, matching)
+	_, err := client.Identity()
+	require.NoError(t, err)
+	total, err := client.TotalWeight(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), total)
+	require.Equal(t, int32(2), client.failover.active.Load())
+	require.Zero(t, other.CallCount(mock.MethodTotalWeight))
+
+	// With no daemon to move to, the failure is returned.
+	client, _ = startFailoverDaemons(t, testClientConfig(), primary, other)
+	_, err = client.Identity()
+	require.NoError(t, err)
+	_, err = client.TotalWeight(1, 2)
+	require.True(t, ledgercore.IsDaemonError(err, "internal"), "%v", err)
+	require.Zero(t, client.failover.active.Load())
+
+	// A daemon that does not know the account answers for the network.
+	primary.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "unknown account"})
+	client, _ = startFailoverDaemons(t, testClientConfig(), primary, matching)
+	_, err = client.Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	require.Zero(t, client.failover.active.Load())
+}
+
+// TestMakeClientConfigFailover tests that the failover daemons are taken from
+// config.Local.
+func TestMakeClientConfigFailover(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	require.Empty(t, MakeClientConfig(local).Failover)
+	local.ExternalWeightOracleFailoverURLs = "http://127.0.0.1:9877, unix:///run/weightd.sock"
+	require.Equal(t, []*url.URL{
+		{Scheme: "http", Host: "127.0.0.1:9877"},
+		{Scheme: "unix", Path: "/run/weightd.sock"},
+	}, MakeClientConfig(local).Failover)
+}
@@ -0,0 +1,264 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// fault decides the outcome of one request attempt.
+type fault func(req *http.Request) (*http.Response, error)
+
+// faultTransport answers request attempts with a script of faults, one per
+// attempt, repeating the last one when the script runs out. It remembers
+// every response body so tests can check that the client closed them.
+type faultTransport struct {
+	mu       sync.Mutex
+	script   []fault
+	attempts int
+	bodies   []*trackedBody
+}
+
+func (f *faultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper closes the request body, as the client's body pooling
+	// expects.
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	f.mu.Lock()
+	next := f.script[min(f.attempts, len(f.script)-1)]
+	f.attempts++
+	f.mu.Unlock()
+
+	resp, err := next(req)
+	if resp != nil {
+		body := &trackedBody{Reader: resp.Body}
+		resp.Body = body
+		f.mu.Lock()
+		f.bodies = append(f.bodies, body)
+		f.mu.Unlock()
+	}
+	return resp, err
+}
+
+func (f *faultTransport) requireBodiesClosed(t *testing.T) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, body := range f.bodies {
+		require.True(t, body.closed, "response body %d was not closed", i)
+	}
+}
+
+// trackedBody is a response body that records whether it was closed.
+type trackedBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+// respond answers with status and body.
+func respond(status int, body string) fault {
+	return func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+}
+
+// connectionReset fails the attempt as a connection reset by the daemon.
+func connectionReset() fault {
+	return func(req *http.Request) (*http.Response, error) {
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	}
+}
+
+// partialBody sends a 200 whose body is cut off after n bytes.
+func partialBody(body string, n int) fault {
+	return func(req *http.Request) (*http.Response, error) {
+		r := io.MultiReader(strings.NewReader(body[:n]), errReader{io.ErrUnexpectedEOF})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(r), Request: req}, nil
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// faultClient returns a client whose attempts follow script, and the backoff
+// delays it waited, which it records rather than sleeps.
+func faultClient(retry RetryPolicy, script ...fault) (*Client, *faultTransport, *[]time.Duration) {
+	transport := &faultTransport{script: script}
+	cfg := testClientConfig()
+	cfg.Retry = retry
+	cfg.Transport = transport
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: "weights.invalid"}, cfg)
+	var delays []time.Duration
+	client.sleep = func(d time.Duration) { delays = append(delays, d) }
+	return client, transport, &delays
+}
+
+const allRetryable = RetryConnect | RetryTimeout | RetryInternal | RetryUnavailable
+
+func TestFaultConnectionReset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	client, transport, delays := faultClient(RetryPolicy{MaxAttempts: 1}, connectionReset())
+	err := client.Ping()
+	require.ErrorIs(t, err, syscall.ECONNRESET)
+	require.Contains(t, err.Error(), "failed to connect to weight daemon")
+	require.Equal(t, 1, transport.attempts)
+	require.Empty(t, *delays)
+
+	retry := RetryPolicy{MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond, Retryable: RetryConnect}
+	client, transport, delays = faultClient(retry, connectionReset(), respond(http.StatusOK, `{"pong":true}`))
+	require.NoError(t, client.Ping())
+	require.Equal(t, 2, transport.attempts)
+	require.Equal(t, []time.Duration{10 * time.Millisecond}, *delays)
+	transport.requireBodiesClosed(t)
+}
+
+func TestFaultPartialBody(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const body = `{"total_weight":"1000000"}`
+	client, transport, _ := faultClient(RetryPolicy{MaxAttempts: 1}, partialBody(body, 10))
+	_, err := client.TotalWeight(1, 2)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.Contains(t, err.Error(), "failed to read response")
+	transport.requireBodiesClosed(t)
+
+	// A cut-off body is a connection failure, not a bad answer: it is
+	// retried as one and nothing from it is cached.
+	retry := RetryPolicy{MaxAttempts: 2, Retryable: RetryConnect}
+	client, transport, _ = faultClient(retry, partialBody(body, 10), respond(http.StatusOK, body))
+	total, err := client.TotalWeight(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000000), total)
+	require.Equal(t, 2, transport.attempts)
+	transport.requireBodiesClosed(t)
+}
+
+func TestFaultMalformedBody(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"truncated json", `{"pong":`, "failed to decode response"},
+		{"wrong type", `{"pong":"yes"}`, "failed to decode response"},
+		{"not json", `<html>pong</html>`, "failed to decode response"},
+		{"missing field", `{}`, "pong field is false or missing"},
+	}
+	for _, test := range tests {
+		// Retrying cannot fix a malformed answer, whatever the policy.
+		client, transport, delays := faultClient(RetryPolicy{MaxAttempts: 3, Retryable: allRetryable}, respond(http.StatusOK, test.body))
+		err := client.Ping()
+		require.ErrorContains(t, err, test.want, test.name)
+		require.Equal(t, 1, transport.attempts, test.name)
+		require.Empty(t, *delays, test.name)
+		transport.requireBodiesClosed(t)
+	}
+}
+
+func TestFaultOversizedBody(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	body := `{"pong":true,"padding":"` + strings.Repeat("x", MaxResponseSize) + `"}`
+	client, transport, _ := faultClient(RetryPolicy{MaxAttempts: 3, Retryable: allRetryable}, respond(http.StatusOK, body))
+	err := client.Ping()
+	require.ErrorContains(t, err, "exceeds")
+	require.Equal(t, 1, transport.attempts)
+	transport.requireBodiesClosed(t)
+}
+
+func TestFaultBackoffSchedule(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	retry := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     25 * time.Millisecond,
+		Retryable:      RetryUnavailable,
+	}
+	client, transport, delays := faultClient(retry, respond(http.StatusBadGateway, `bad gateway`))
+	err := client.Ping()
+	require.ErrorContains(t, err, "HTTP error 502")
+	require.Equal(t, 5, transport.attempts)
+	require.Equal(t, []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 25 * time.Millisecond, 25 * time.Millisecond}, *delays)
+	require.Equal(t, uint64(5), client.Stats().Queries)
+
+	// The request is recorded once, with the last attempt's failure.
+	records := client.RecentQueries()
+	require.Len(t, records, 1)
+	require.Contains(t, records[0].Error, "HTTP error 502")
+	transport.requireBodiesClosed(t)
+}
+
+func TestFaultRequestBody(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Every attempt of a retried request carries the whole request body.
+	var bodies []string
+	record := func(req *http.Request) (*http.Response, error) {
+		var buf bytes.Buffer
+		_, err := io.Copy(&buf, req.Body)
+		bodies = append(bodies, buf.String())
+		if err != nil {
+			return nil, err
+		}
+		if len(bodies) == 1 {
+			return nil, errors.New("connection reset by peer")
+		}
+		return respond(http.StatusOK, `{"total_weight":"7"}`)(req)
+	}
+	client, _, _ := faultClient(RetryPolicy{MaxAttempts: 2, Retryable: RetryConnect}, record)
+	total, err := client.TotalWeight(3, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), total)
+	require.Len(t, bodies, 2)
+	require.Equal(t, bodies[0], bodies[1])
+	require.Contains(t, bodies[0], `"balance_round":"3"`)
+}
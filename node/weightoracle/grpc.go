@@ -0,0 +1,354 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// grpcServiceName is the full name of the service in weightoracle.proto.
+const grpcServiceName = "hedgecoin.weightoracle.v1.WeightOracle"
+
+// grpcMethods maps the endpoints served over gRPC to their method names.
+// The other endpoints are answered as unsupported without asking the daemon,
+// so that Weights and NotifyKeyRotations fall back as they would for an HTTP
+// daemon without them.
+var grpcMethods = map[string]string{
+	"/ping":         "Ping",
+	"/identity":     "Identity",
+	"/weight":       "Weight",
+	"/total_weight": "TotalWeight",
+}
+
+// grpcReconnectMaxDelay caps the wait before reconnecting to a daemon that
+// went away; calls fail at once while it lasts.
+const grpcReconnectMaxDelay = time.Second
+
+// grpcTransport carries a Client's requests as calls to a daemon serving the
+// WeightOracle gRPC service. Concurrent calls share one HTTP/2 connection.
+type grpcTransport struct {
+	conn *grpc.ClientConn
+
+	// err, if not nil, is why conn could not be set up, and fails every
+	// attempt.
+	err error
+}
+
+// newGRPCTransport returns the transport to the daemon at baseURL: a host and
+// port, over TLS for https, or a unix socket.
+func newGRPCTransport(baseURL *url.URL, cfg ClientConfig) *grpcTransport {
+	target := "passthrough:///" + baseURL.Host
+	creds := insecure.NewCredentials()
+	var opts []grpc.DialOption
+	switch baseURL.Scheme {
+	case "unix":
+		// Connections go to the socket once it has passed checkSocket.
+		socketPath := baseURL.Path
+		target = "passthrough:///localhost"
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			if err := checkSocket(socketPath, cfg.SocketOwner); err != nil {
+				return nil, err
+			}
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}))
+	case "https":
+		tlsConfig := daemonTLSConfig(cfg)
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		tlsConfig.ServerName = baseURL.Hostname()
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	reconnect := backoff.DefaultConfig
+	reconnect.BaseDelay = 100 * time.Millisecond
+	reconnect.MaxDelay = grpcReconnectMaxDelay
+	opts = append(opts,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: reconnect, MinConnectTimeout: cfg.DialTimeout}),
+		grpc.WithIdleTimeout(90*time.Second),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(protoCodec{}), grpc.MaxCallRecvMsgSize(MaxResponseSize)),
+	)
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return &grpcTransport{err: fmt.Errorf("failed to set up gRPC connection to weight daemon: %w", err)}
+	}
+	return &grpcTransport{conn: conn}
+}
+
+// attempt makes a single attempt at a request, as Client.attemptRequest does
+// over HTTP. The request and result are the JSON protocol's, converted to and
+// from their gRPC messages, so that answers are checked the same way.
+func (t *grpcTransport) attempt(parent context.Context, c *Client, endpoint string, reqBody interface{}, result interface{}, timeout time.Duration) (ErrorClass, error) {
+	if t.err != nil {
+		return 0, t.err
+	}
+	method, ok := grpcMethods[endpoint]
+	if !ok {
+		return 0, daemonError("unsupported", fmt.Sprintf("%s is not part of the gRPC protocol", endpoint))
+	}
+	req, resp, fill, err := makeGRPCCall(reqBody, result)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+	if c.authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", c.authorization)
+	}
+
+	c.queries.Add(1)
+	err = t.conn.Invoke(ctx, "/"+grpcServiceName+"/"+method, req, resp)
+	if err != nil {
+		return grpcErrorClass(ctx, err)
+	}
+	fill()
+	return 0, nil
+}
+
+// makeGRPCCall returns the gRPC messages for a JSON protocol request, and a
+// function that copies the response message into result once it has arrived.
+func makeGRPCCall(reqBody interface{}, result interface{}) (req, resp protoMessage, fill func(), err error) {
+	switch r := reqBody.(type) {
+	case emptyRequest:
+		if res, ok := result.(*pingResponse); ok {
+			m := &pbPingResponse{}
+			return &pbPingRequest{}, m, func() { *res = pingResponse{Pong: m.pong} }, nil
+		}
+		return makeIdentityCall(&pbIdentityRequest{}, result)
+
+	case handshakeRequest:
+		var q pbIdentityRequest
+		if q.balanceLookback, err = parseDecimal("balance_lookback", r.BalanceLookback); err != nil {
+			return nil, nil, nil, err
+		}
+		if q.seedLookback, err = parseDecimal("seed_lookback", r.SeedLookback); err != nil {
+			return nil, nil, nil, err
+		}
+		if q.seedRefreshInterval, err = parseDecimal("seed_refresh_interval", r.SeedRefreshInterval); err != nil {
+			return nil, nil, nil, err
+		}
+		return makeIdentityCall(&q, result)
+
+	case weightRequest:
+		addr, err := basics.UnmarshalChecksumAddress(r.Address)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		var sel crypto.VRFVerifier
+		if _, err := hex.Decode(sel[:], []byte(r.SelectionID)); err != nil {
+			return nil, nil, nil, err
+		}
+		balanceRound, err := parseDecimal("balance_round", r.BalanceRound)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		res := result.(*weightResponse)
+		m := &pbWeightResponse{}
+		return &pbWeightRequest{address: addr[:], selectionID: sel[:], balanceRound: balanceRound}, m, func() {
+			*res = weightResponse{Weight: strconv.FormatUint(m.weight, 10)}
+		}, nil
+
+	case totalWeightRequest:
+		var q pbTotalWeightRequest
+		if q.balanceRound, err = parseDecimal("balance_round", r.BalanceRound); err != nil {
+			return nil, nil, nil, err
+		}
+		if q.voteRound, err = parseDecimal("vote_round", r.VoteRound); err != nil {
+			return nil, nil, nil, err
+		}
+		res := result.(*totalWeightResponse)
+		m := &pbTotalWeightResponse{}
+		return &q, m, func() {
+			*res = totalWeightResponse{TotalWeight: strconv.FormatUint(m.totalWeight, 10)}
+		}, nil
+	}
+	return nil, nil, nil, fmt.Errorf("no gRPC message for %T", reqBody)
+}
+
+func makeIdentityCall(req *pbIdentityRequest, result interface{}) (protoMessage, protoMessage, func(), error) {
+	res := result.(*identityResponse)
+	m := &pbIdentityResponse{}
+	return req, m, func() {
+		*res = identityResponse{
+			GenesisHash:      base64.StdEncoding.EncodeToString(m.genesisHash),
+			ProtocolVersion:  m.protocolVersion,
+			AlgorithmVersion: m.algorithmVersion,
+			WeightUnit:       m.weightUnit,
+		}
+		if m.weightScale != 0 {
+			res.WeightScale = strconv.FormatUint(m.weightScale, 10)
+		}
+	}, nil
+}
+
+// grpcErrorClass converts a failed call into the error the JSON protocol
+// would have reported, and classifies it for retries.
+func grpcErrorClass(ctx context.Context, err error) (ErrorClass, error) {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return 0, daemonError("bad_request", st.Message())
+	case codes.NotFound:
+		return 0, daemonError("not_found", st.Message())
+	case codes.Unimplemented:
+		return 0, daemonError("unsupported", st.Message())
+	case codes.Internal:
+		return RetryInternal, daemonError("internal", st.Message())
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return 0, fmt.Errorf("%w (gRPC %s)", ErrUnauthorized, st.Code())
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return transportErrorClass(ctx), fmt.Errorf("failed to connect to weight daemon: %w", err)
+	}
+	return 0, fmt.Errorf("gRPC error %s: %s", st.Code(), truncateText(st.Message()))
+}
+
+// daemonStatus returns the gRPC status that a daemon error is answered with.
+func daemonStatus(err error) error {
+	de := errorAnswer(err)
+	code := codes.Unknown
+	switch de.Code {
+	case "bad_request":
+		code = codes.InvalidArgument
+	case "not_found":
+		code = codes.NotFound
+	case "unsupported":
+		code = codes.Unimplemented
+	case "unauthorized":
+		code = codes.Unauthenticated
+	case "internal":
+		code = codes.Internal
+	}
+	return status.Error(code, de.Msg)
+}
+
+// grpcServiceDesc describes the WeightOracle service for a grpc.Server, whose
+// implementation is a *grpcHandler.
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcServiceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		grpcMethod("Ping", func() protoMessage { return &pbPingRequest{} }, (*grpcHandler).ping),
+		grpcMethod("Identity", func() protoMessage { return &pbIdentityRequest{} }, (*grpcHandler).identity),
+		grpcMethod("Weight", func() protoMessage { return &pbWeightRequest{} }, (*grpcHandler).weight),
+		grpcMethod("TotalWeight", func() protoMessage { return &pbTotalWeightRequest{} }, (*grpcHandler).totalWeight),
+	},
+	Metadata: "weightoracle.proto",
+}
+
+// grpcMethod describes a unary method that decodes its request into a new
+// message and answers it with serve.
+func grpcMethod(name string, newRequest func() protoMessage, serve func(*grpcHandler, protoMessage) (protoMessage, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := newRequest()
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			handle := func(_ context.Context, req interface{}) (interface{}, error) {
+				resp, err := serve(srv.(*grpcHandler), req.(protoMessage))
+				if err != nil {
+					return nil, daemonStatus(err)
+				}
+				return resp, nil
+			}
+			if interceptor == nil {
+				return handle(ctx, req)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/" + name}
+			return interceptor(ctx, req, info, handle)
+		},
+	}
+}
+
+// grpcHandler serves the WeightOracle gRPC service from an oracle, as handler
+// serves the JSON protocol.
+type grpcHandler struct {
+	oracle ledgercore.WeightOracle
+}
+
+func (h *grpcHandler) ping(protoMessage) (protoMessage, error) {
+	if err := h.oracle.Ping(); err != nil {
+		return nil, err
+	}
+	return &pbPingResponse{pong: true}, nil
+}
+
+func (h *grpcHandler) identity(protoMessage) (protoMessage, error) {
+	// The handshake fields are accepted but not checked, as by handler.
+	id, err := h.oracle.Identity()
+	if err != nil {
+		return nil, err
+	}
+	return &pbIdentityResponse{
+		genesisHash:      id.GenesisHash[:],
+		protocolVersion:  id.WeightProtocolVersion,
+		algorithmVersion: id.WeightAlgorithmVersion,
+		weightUnit:       id.Display.Unit,
+		weightScale:      id.Display.Scale,
+	}, nil
+}
+
+func (h *grpcHandler) weight(m protoMessage) (protoMessage, error) {
+	req := m.(*pbWeightRequest)
+	var addr basics.Address
+	var sel crypto.VRFVerifier
+	if len(req.address) != len(addr) {
+		return nil, badRequest("Invalid address: expected %d bytes, got %d", len(addr), len(req.address))
+	}
+	if len(req.selectionID) != len(sel) {
+		return nil, badRequest("Invalid selection_id: expected %d bytes, got %d", len(sel), len(req.selectionID))
+	}
+	copy(addr[:], req.address)
+	copy(sel[:], req.selectionID)
+	weight, err := h.oracle.Weight(basics.Round(req.balanceRound), addr, sel)
+	if err != nil {
+		return nil, err
+	}
+	return &pbWeightResponse{weight: weight}, nil
+}
+
+func (h *grpcHandler) totalWeight(m protoMessage) (protoMessage, error) {
+	req := m.(*pbTotalWeightRequest)
+	total, err := h.oracle.TotalWeight(basics.Round(req.balanceRound), basics.Round(req.voteRound))
+	if err != nil {
+		return nil, err
+	}
+	return &pbTotalWeightResponse{totalWeight: total}, nil
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testGRPCClientConfig() ClientConfig {
+	cfg := testClientConfig()
+	cfg.GRPC = true
+	return cfg
+}
+
+// TestGRPCRoundTrip checks that a gRPC client talking to a gRPC server gets
+// the answers of the oracle behind it, and that the endpoints outside the
+// service fall back or are unsupported.
+func TestGRPCRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	oracle := mock.New()
+	oracle.SetWeight(addr, 1500)
+	oracle.SetWeight(makeTestAddress(2), 0)
+	oracle.SetTotalWeight(5500)
+	oracle.SetIdentity(ledgercore.DaemonIdentity{
+		GenesisHash:            crypto.Hash([]byte("genesis")),
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+		Display:                ledgercore.WeightDisplay{Unit: "HDG", Scale: 1000},
+	})
+
+	server, err := StartGRPCServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL(), testGRPCClientConfig())
+	require.NoError(t, client.Ping())
+
+	id, err := client.Handshake(ledgercore.LookbackParams{BalanceLookback: 320, SeedLookback: 2, SeedRefreshInterval: 80})
+	require.NoError(t, err)
+	require.Equal(t, crypto.Hash([]byte("genesis")), id.GenesisHash)
+	require.Equal(t, ledgercore.WeightDisplay{Unit: "HDG", Scale: 1000}, id.Display)
+	_, err = client.Identity()
+	require.NoError(t, err)
+
+	w, err := client.Weight(100, addr, makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500), w)
+	w, err = client.Weight(100, makeTestAddress(2), makeTestSelectionID(2))
+	require.NoError(t, err)
+	require.Zero(t, w)
+	total, err := client.TotalWeight(100, 420)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5500), total)
+
+	calls := oracle.Calls()
+	require.Equal(t, mock.Call{Method: mock.MethodWeight, BalanceRound: 100, Address: addr, SelectionID: makeTestSelectionID(1)}, calls[3])
+
+	// Batches are looked up one weight at a time.
+	results, err := client.Weights(context.Background(), 101, []ledgercore.WeightQuery{{Addr: addr, SelectionID: makeTestSelectionID(1)}})
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Weight: 1500}}, results)
+	require.True(t, client.noBatches.Load())
+
+	// Key rotations are not sent, and the weight table is unsupported.
+	require.NoError(t, client.NotifyKeyRotations([]ledgercore.KeyRotation{{Addr: addr, Round: 5, New: makeTestSelectionID(3)}}))
+	_, err = client.WeightTable(100)
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"), "%v", err)
+}
+
+// TestGRPCErrors checks that oracle errors reach a gRPC client with their
+// daemon error codes, and that failed calls are classified for retries.
+func TestGRPCErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "unknown account"})
+	oracle.SetTotalWeightError(errors.New("snapshot missing"))
+	oracle.SetPingError(&ledgercore.DaemonError{Code: "unsupported", Msg: "no ping here"})
+
+	server, err := StartGRPCServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+
+	client := NewClientWithConfig(server.URL(), testGRPCClientConfig())
+
+	_, err = client.Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	require.ErrorContains(t, err, "unknown account")
+	_, err = client.TotalWeight(1, 2)
+	require.True(t, ledgercore.IsDaemonError(err, "internal"), "%v", err)
+	require.ErrorContains(t, err, "snapshot missing")
+	err = client.Ping()
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"), "%v", err)
+
+	// Internal errors are retried like those of the JSON protocol.
+	class, _ := grpcErrorClass(context.Background(), status.Error(codes.Internal, "boom"))
+	require.Equal(t, RetryInternal, class)
+	class, err = grpcErrorClass(context.Background(), status.Error(codes.InvalidArgument, "bad round"))
+	require.Zero(t, class)
+	require.True(t, ledgercore.IsDaemonError(err, "bad_request"), "%v", err)
+
+	// A daemon that has gone away is a connection failure.
+	require.NoError(t, server.Close())
+	_, err = client.TotalWeight(3, 4)
+	require.Error(t, err)
+	require.False(t, ledgercore.IsDaemonError(err, ""), "%v", err)
+}
+
+// TestGRPCAuthorization checks that the auth token is sent as call metadata,
+// and that a daemon refusing it is reported as ErrUnauthorized.
+func TestGRPCAuthorization(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seen atomic.Value
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.ForceServerCodec(protoCodec{}), grpc.UnaryInterceptor(
+		func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			seen.Store(md.Get("authorization"))
+			if len(md.Get("authorization")) != 1 || md.Get("authorization")[0] != "Bearer s3cret" {
+				return nil, status.Error(codes.Unauthenticated, "bad token")
+			}
+			return handler(ctx, req)
+		}))
+	srv.RegisterService(&grpcServiceDesc, &grpcHandler{oracle: mock.New()})
+	go srv.Serve(listener)
+	defer srv.Stop()
+	u := &url.URL{Scheme: "http", Host: listener.Addr().String()}
+
+	cfg := testGRPCClientConfig()
+	t.Setenv("TEST_WEIGHT_ORACLE_GRPC_AUTH_TOKEN", "s3cret")
+	cfg.AuthToken, err = config.LoadSecret("env:TEST_WEIGHT_ORACLE_GRPC_AUTH_TOKEN", "")
+	require.NoError(t, err)
+	require.NoError(t, NewClientWithConfig(u, cfg).Ping())
+	require.Equal(t, []string{"Bearer s3cret"}, seen.Load())
+
+	err = NewClientWithConfig(u, testGRPCClientConfig()).Ping()
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
+
+// TestGRPCUnixSocket checks that a gRPC daemon can be reached on a unix
+// domain socket.
+func TestGRPCUnixSocket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetWeight(makeTestAddress(1), 42)
+	path := filepath.Join(t.TempDir(), "weightd.sock")
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.ForceServerCodec(protoCodec{}))
+	srv.RegisterService(&grpcServiceDesc, &grpcHandler{oracle: oracle})
+	go srv.Serve(listener)
+	defer srv.Stop()
+
+	client := NewClientWithConfig(&url.URL{Scheme: "unix", Path: path}, testGRPCClientConfig())
+	w, err := client.Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), w)
+}
+
+// TestGRPCMessages checks the hand-written encoding of the protocol messages.
+func TestGRPCMessages(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(7)
+	sel := makeTestSelectionID(7)
+	req := &pbWeightRequest{address: addr[:], selectionID: sel[:], balanceRound: 1 << 40}
+	var got pbWeightRequest
+	require.NoError(t, got.unmarshalProto(req.marshalProto(nil)))
+	require.Equal(t, req, &got)
+
+	id := &pbIdentityResponse{genesisHash: []byte{1, 2, 3}, protocolVersion: "1.0", algorithmVersion: "2.1", weightUnit: "HDG", weightScale: 6}
+	var gotID pbIdentityResponse
+	require.NoError(t, gotID.unmarshalProto(id.marshalProto(nil)))
+	require.Equal(t, id, &gotID)
+
+	// Zero values are omitted, and unknown fields of any type are skipped.
+	require.Empty(t, (&pbTotalWeightResponse{}).marshalProto(nil))
+	b := protowire.AppendTag(nil, 9, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, 5)
+	b = protowire.AppendTag(b, 10, protowire.BytesType)
+	b = protowire.AppendString(b, "future")
+	b = (&pbTotalWeightResponse{totalWeight: 77}).marshalProto(b)
+	var total pbTotalWeightResponse
+	require.NoError(t, total.unmarshalProto(b))
+	require.Equal(t, uint64(77), total.totalWeight)
+
+	// Known fields must have their declared type.
+	b = protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendString(b, "77")
+	require.ErrorIs(t, total.unmarshalProto(b), errProtoFieldType)
+	require.Error(t, total.unmarshalProto([]byte{0x08}))
+	b = protowire.AppendTag(nil, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, []byte{0xff})
+	require.Error(t, gotID.unmarshalProto(b))
+}
+
+// TestMakeClientConfigProtocol tests that the protocol is taken from config.Local.
+func TestMakeClientConfigProtocol(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	require.False(t, MakeClientConfig(local).GRPC)
+	local.ExternalWeightOracleProtocol = config.WeightOracleProtocolGRPC
+	require.True(t, MakeClientConfig(local).GRPC)
+}
@@ -0,0 +1,327 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// protoMessage is a message of weightoracle.proto. The messages are encoded
+// by hand, in the protobuf wire format, rather than generated: there are few
+// of them, all flat.
+type protoMessage interface {
+	marshalProto(b []byte) []byte
+	unmarshalProto(b []byte) error
+}
+
+// protoCodec is the gRPC codec of protoMessages. It is named like the
+// standard protobuf codec, whose wire format it shares, so that daemons
+// built from weightoracle.proto see the usual content type.
+type protoCodec struct{}
+
+func (protoCodec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(protoMessage)
+	if !ok {
+		return nil, fmt.Errorf("cannot encode %T as a weight daemon message", v)
+	}
+	return m.marshalProto(nil), nil
+}
+
+func (protoCodec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(protoMessage)
+	if !ok {
+		return fmt.Errorf("cannot decode %T as a weight daemon message", v)
+	}
+	return m.unmarshalProto(data)
+}
+
+func (protoCodec) Name() string {
+	return "proto"
+}
+
+// protoValue is the value of a varint or length-delimited field.
+type protoValue struct {
+	typ    protowire.Type
+	varint uint64
+	bytes  []byte
+}
+
+var errProtoFieldType = errors.New("field has the wrong wire type")
+
+func (v protoValue) uint64() (uint64, error) {
+	if v.typ != protowire.VarintType {
+		return 0, errProtoFieldType
+	}
+	return v.varint, nil
+}
+
+func (v protoValue) bool() (bool, error) {
+	n, err := v.uint64()
+	return n != 0, err
+}
+
+// copyBytes returns a copy of the value, which does not alias the message.
+func (v protoValue) copyBytes() ([]byte, error) {
+	if v.typ != protowire.BytesType {
+		return nil, errProtoFieldType
+	}
+	return append([]byte(nil), v.bytes...), nil
+}
+
+func (v protoValue) string() (string, error) {
+	if v.typ != protowire.BytesType {
+		return "", errProtoFieldType
+	}
+	if !utf8.Valid(v.bytes) {
+		return "", errors.New("string field is not valid UTF-8")
+	}
+	return string(v.bytes), nil
+}
+
+// walkProto calls field with each varint and length-delimited field of the
+// encoded message b, in order. Fields of other wire types are skipped, as
+// unknown fields are by field.
+func walkProto(b []byte, field func(num protowire.Number, v protoValue) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		v := protoValue{typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			v.varint, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			v.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if typ != protowire.VarintType && typ != protowire.BytesType {
+			continue
+		}
+		if err := field(num, v); err != nil {
+			return fmt.Errorf("field %d: %w", num, err)
+		}
+	}
+	return nil
+}
+
+// appendVarint appends a varint field, omitted when zero as proto3 does.
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// appendBytes appends a length-delimited field, omitted when empty.
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+type pbPingRequest struct{}
+
+func (m *pbPingRequest) marshalProto(b []byte) []byte { return b }
+
+func (m *pbPingRequest) unmarshalProto(b []byte) error {
+	return walkProto(b, func(protowire.Number, protoValue) error { return nil })
+}
+
+type pbPingResponse struct {
+	pong bool
+}
+
+func (m *pbPingResponse) marshalProto(b []byte) []byte {
+	if m.pong {
+		b = appendVarint(b, 1, 1)
+	}
+	return b
+}
+
+func (m *pbPingResponse) unmarshalProto(b []byte) (err error) {
+	return walkProto(b, func(num protowire.Number, v protoValue) error {
+		if num == 1 {
+			m.pong, err = v.bool()
+		}
+		return err
+	})
+}
+
+type pbIdentityRequest struct {
+	balanceLookback     uint64
+	seedLookback        uint64
+	seedRefreshInterval uint64
+}
+
+func (m *pbIdentityRequest) marshalProto(b []byte) []byte {
+	b = appendVarint(b, 1, m.balanceLookback)
+	b = appendVarint(b, 2, m.seedLookback)
+	return appendVarint(b, 3, m.seedRefreshInterval)
+}
+
+func (m *pbIdentityRequest) unmarshalProto(b []byte) (err error) {
+	return walkProto(b, func(num protowire.Number, v protoValue) error {
+		switch num {
+		case 1:
+			m.balanceLookback, err = v.uint64()
+		case 2:
+			m.seedLookback, err = v.uint64()
+		case 3:
+			m.seedRefreshInterval, err = v.uint64()
+		}
+		return err
+	})
+}
+
+type pbIdentityResponse struct {
+	genesisHash      []byte
+	protocolVersion  string
+	algorithmVersion string
+	weightUnit       string
+	weightScale      uint64
+}
+
+func (m *pbIdentityResponse) marshalProto(b []byte) []byte {
+	b = appendBytes(b, 1, m.genesisHash)
+	b = appendString(b, 2, m.protocolVersion)
+	b = appendString(b, 3, m.algorithmVersion)
+	b = appendString(b, 4, m.weightUnit)
+	return appendVarint(b, 5, m.weightScale)
+}
+
+func (m *pbIdentityResponse) unmarshalProto(b []byte) (err error) {
+	return walkProto(b, func(num protowire.Number, v protoValue) error {
+		switch num {
+		case 1:
+			m.genesisHash, err = v.copyBytes()
+		case 2:
+			m.protocolVersion, err = v.string()
+		case 3:
+			m.algorithmVersion, err = v.string()
+		case 4:
+			m.weightUnit, err = v.string()
+		case 5:
+			m.weightScale, err = v.uint64()
+		}
+		return err
+	})
+}
+
+// pbWeightRequest keeps the keys as sent; the server checks their lengths.
+type pbWeightRequest struct {
+	address      []byte
+	selectionID  []byte
+	balanceRound uint64
+}
+
+func (m *pbWeightRequest) marshalProto(b []byte) []byte {
+	b = appendBytes(b, 1, m.address)
+	b = appendBytes(b, 2, m.selectionID)
+	return appendVarint(b, 3, m.balanceRound)
+}
+
+func (m *pbWeightRequest) unmarshalProto(b []byte) (err error) {
+	return walkProto(b, func(num protowire.Number, v protoValue) error {
+		switch num {
+		case 1:
+			m.address, err = v.copyBytes()
+		case 2:
+			m.selectionID, err = v.copyBytes()
+		case 3:
+			m.balanceRound, err = v.uint64()
+		}
+		return err
+	})
+}
+
+type pbWeightResponse struct {
+	weight uint64
+}
+
+func (m *pbWeightResponse) marshalProto(b []byte) []byte {
+	return appendVarint(b, 1, m.weight)
+}
+
+func (m *pbWeightResponse) unmarshalProto(b []byte) (err error) {
+	return walkProto(b, func(num protowire.Number, v protoValue) error {
+		if num == 1 {
+			m.weight, err = v.uint64()
+		}
+		return err
+	})
+}
+
+type pbTotalWeightRequest struct {
+	balanceRound uint64
+	voteRound    uint64
+}
+
+func (m *pbTotalWeightRequest) marshalProto(b []byte) []byte {
+	b = appendVarint(b, 1, m.balanceRound)
+	return appendVarint(b, 2, m.voteRound)
+}
+
+func (m *pbTotalWeightRequest) unmarshalProto(b []byte) (err error) {
+	return walkProto(b, func(num protowire.Number, v protoValue) error {
+		switch num {
+		case 1:
+			m.balanceRound, err = v.uint64()
+		case 2:
+			m.voteRound, err = v.uint64()
+		}
+		return err
+	})
+}
+
+type pbTotalWeightResponse struct {
+	totalWeight uint64
+}
+
+func (m *pbTotalWeightResponse) marshalProto(b []byte) []byte {
+	return appendVarint(b, 1, m.totalWeight)
+}
+
+func (m *pbTotalWeightResponse) unmarshalProto(b []byte) (err error) {
+	return walkProto(b, func(num protowire.Number, v protoValue) error {
+		if num == 1 {
+			m.totalWeight, err = v.uint64()
+		}
+		return err
+	})
+}
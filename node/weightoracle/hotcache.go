@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"sync/atomic"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// minHotPublish is the fewest new weights published to the hot front at once.
+const minHotPublish = 16
+
+// hotWeightShare is the weight cache capacity divided by the number of
+// weights the hot front holds, besides the LRU's copies of them.
+const hotWeightShare = 4
+
+// hotWeights is an immutable set of cached weights for one balance round.
+type hotWeights struct {
+	balanceRound basics.Round
+	entries      map[weightCacheKey]uint64
+}
+
+// hotWeightFront answers weight cache hits for the newest balance round
+// without taking a lock, ahead of the weight LRU. Nearly all weight lookups
+// are for the round being voted on, so its weights are copied into an
+// immutable map that readers load through an atomic pointer.
+//
+// New weights wait in pending until there are a quarter as many as already
+// published, at least minHotPublish, and the map is then copied with them;
+// the copying thus costs O(1) per weight. Until then they are served by the
+// LRU. Weights of older rounds are left to the LRU, and a newer round
+// replaces the published map.
+type hotWeightFront struct {
+	current atomic.Pointer[hotWeights]
+	limit   atomic.Int64
+
+	mu      deadlock.Mutex
+	pending map[weightCacheKey]uint64
+}
+
+// newHotWeightFront returns a front that holds at most limit weights; with a
+// limit of 0 it holds none.
+func newHotWeightFront(limit int) *hotWeightFront {
+	f := &hotWeightFront{pending: make(map[weightCacheKey]uint64)}
+	f.limit.Store(int64(limit))
+	return f
+}
+
+// setLimit changes the number of weights the front may hold. Weights already
+// published stay until the next round replaces them.
+func (f *hotWeightFront) setLimit(limit int) {
+	f.limit.Store(int64(limit))
+}
+
+// get returns the published weight for key at balanceRound, if any.
+func (f *hotWeightFront) get(balanceRound basics.Round, key weightCacheKey) (uint64, bool) {
+	h := f.current.Load()
+	if h == nil || h.balanceRound != balanceRound {
+		return 0, false
+	}
+	weight, ok := h.entries[key]
+	return weight, ok
+}
+
+// evictBefore drops the published weights if they are for a balance round
+// before rnd.
+func (f *hotWeightFront) evictBefore(rnd basics.Round) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if h := f.current.Load(); h != nil && h.balanceRound < rnd {
+		f.current.Store(nil)
+		clear(f.pending)
+	}
+}
+
+// put adds a weight fetched from the daemon for balanceRound.
+func (f *hotWeightFront) put(balanceRound basics.Round, key weightCacheKey, weight uint64) {
+	limit := int(f.limit.Load())
+	if limit == 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	h := f.current.Load()
+	switch {
+	case h == nil || balanceRound > h.balanceRound:
+		h = &hotWeights{balanceRound: balanceRound}
+		f.current.Store(h)
+		clear(f.pending)
+	case balanceRound < h.balanceRound:
+		return
+	}
+	if _, ok := h.entries[key]; ok || len(h.entries)+len(f.pending) >= limit {
+		return
+	}
+	f.pending[key] = weight
+	if len(f.pending) < max(minHotPublish, len(h.entries)/4) && len(h.entries)+len(f.pending) < limit {
+		return
+	}
+
+	entries := make(map[weightCacheKey]uint64, len(h.entries)+len(f.pending))
+	for k, w := range h.entries {
+		entries[k] = w
+	}
+	for k, w := range f.pending {
+		entries[k] = w
+	}
+	f.current.Store(&hotWeights{balanceRound: balanceRound, entries: entries})
+	clear(f.pending)
+}
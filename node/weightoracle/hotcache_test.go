@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func hotKey(i int) weightCacheKey {
+	return makeWeightCacheKey(1, makeTestAddress(i), makeTestSelectionID(1))
+}
+
+// TestHotWeightFront tests when weights become visible in the hot front, and
+// that only the newest round is kept.
+func TestHotWeightFront(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	f := newHotWeightFront(100)
+
+	// Weights are published in batches of minHotPublish.
+	for i := 0; i < minHotPublish-1; i++ {
+		f.put(10, hotKey(i), uint64(i))
+	}
+	_, ok := f.get(10, hotKey(0))
+	require.False(t, ok)
+	f.put(10, hotKey(minHotPublish-1), uint64(minHotPublish-1))
+	for i := 0; i < minHotPublish; i++ {
+		w, ok := f.get(10, hotKey(i))
+		require.True(t, ok)
+		require.EqualValues(t, i, w)
+	}
+	_, ok = f.get(11, hotKey(0))
+	require.False(t, ok)
+
+	// Older rounds are ignored; a newer one replaces the published weights.
+	for i := 0; i < minHotPublish; i++ {
+		f.put(9, hotKey(100+i), 1)
+	}
+	_, ok = f.get(9, hotKey(100))
+	require.False(t, ok)
+	for i := 0; i < minHotPublish; i++ {
+		f.put(11, hotKey(i), 2)
+	}
+	_, ok = f.get(10, hotKey(0))
+	require.False(t, ok)
+	w, ok := f.get(11, hotKey(0))
+	require.True(t, ok)
+	require.EqualValues(t, 2, w)
+
+	// The front stops at its limit, publishing what it has when reaching it.
+	small := newHotWeightFront(5)
+	for i := 0; i < 10; i++ {
+		small.put(1, hotKey(i), 1)
+	}
+	require.Len(t, small.current.Load().entries, 5)
+
+	// A front with no room holds nothing.
+	none := newHotWeightFront(0)
+	for i := 0; i < minHotPublish; i++ {
+		none.put(1, hotKey(i), 1)
+	}
+	require.Nil(t, none.current.Load())
+}
+
+// TestHotWeightFrontConcurrent tests readers against a writer publishing
+// new rounds, under the race detector.
+func TestHotWeightFrontConcurrent(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	f := newHotWeightFront(1000)
+	var wg sync.WaitGroup
+	var wrong atomic.Int32
+	done := make(chan struct{})
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				for i := 0; i < 64; i++ {
+					if w, ok := f.get(basics.Round(i%3), hotKey(i)); ok && w != uint64(i) {
+						wrong.Add(1)
+					}
+				}
+			}
+		}()
+	}
+	for round := 0; round < 3; round++ {
+		for i := 0; i < 64; i++ {
+			f.put(basics.Round(round), hotKey(i), uint64(i))
+		}
+	}
+	close(done)
+	wg.Wait()
+	require.Zero(t, wrong.Load())
+}
+
+// TestClientHotWeights tests that repeated weight lookups of the newest round
+// are answered by the hot front.
+func TestClientHotWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	client, transport, _ := faultClient(RetryPolicy{MaxAttempts: 1}, respond(http.StatusOK, `{"weight":"5"}`))
+	for i := 0; i < minHotPublish; i++ {
+		_, err := client.Weight(10, makeTestAddress(i), makeTestSelectionID(1))
+		require.NoError(t, err)
+	}
+	require.Equal(t, minHotPublish, transport.attempts)
+	for i := 0; i < minHotPublish; i++ {
+		key := makeWeightCacheKey(10, makeTestAddress(i), makeTestSelectionID(1))
+		_, ok := client.hotWeights.get(10, key)
+		require.True(t, ok)
+		w, err := client.Weight(10, makeTestAddress(i), makeTestSelectionID(1))
+		require.NoError(t, err)
+		require.EqualValues(t, 5, w)
+	}
+	require.Equal(t, minHotPublish, transport.attempts)
+	require.EqualValues(t, minHotPublish, client.Stats().CacheHits)
+}
+
+func BenchmarkWeightCacheHit(b *testing.B) {
+	deadlock.Opts.Disable = true
+	client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1}, respond(http.StatusOK, `{"weight":"5"}`))
+	const accounts = 256
+	for i := 0; i < accounts; i++ {
+		if _, err := client.Weight(10, makeTestAddress(i), makeTestSelectionID(1)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.Run("hot", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				client.Weight(10, makeTestAddress(i%accounts), makeTestSelectionID(1))
+			}
+		})
+	})
+	b.Run("lru", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				client.weightCache.Get(makeWeightCacheKey(10, makeTestAddress(i%accounts), makeTestSelectionID(1)))
+			}
+		})
+	})
+}
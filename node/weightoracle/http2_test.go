@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// protoDaemon is a daemon that records the HTTP major version of every
+// request and counts the connections it accepted.
+type protoDaemon struct {
+	server *httptest.Server
+	url    *url.URL
+	conns  atomic.Int32
+
+	mu     sync.Mutex
+	protos map[int]int
+}
+
+func newProtoDaemon(t *testing.T, tls bool) *protoDaemon {
+	d := &protoDaemon{protos: make(map[int]int)}
+	handler := NewHandler(mock.New())
+	d.server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d.mu.Lock()
+		d.protos[r.ProtoMajor]++
+		d.mu.Unlock()
+		handler.ServeHTTP(w, r)
+	}))
+	d.server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			d.conns.Add(1)
+		}
+	}
+	if tls {
+		d.server.EnableHTTP2 = true
+		d.server.StartTLS()
+	} else {
+		d.server.Config.Protocols = new(http.Protocols)
+		d.server.Config.Protocols.SetHTTP1(true)
+		d.server.Config.Protocols.SetUnencryptedHTTP2(true)
+		d.server.Start()
+	}
+	t.Cleanup(d.server.Close)
+	var err error
+	d.url, err = url.Parse(d.server.URL)
+	require.NoError(t, err)
+	return d
+}
+
+func (d *protoDaemon) requests() map[int]int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	protos := make(map[int]int, len(d.protos))
+	for proto, n := range d.protos {
+		protos[proto] = n
+	}
+	return protos
+}
+
+// TestHTTP2Multiplexing tests that with HTTP2 concurrent queries to an h2c
+// daemon share a single connection.
+func TestHTTP2Multiplexing(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleHTTP2 = true
+	require.True(t, MakeClientConfig(local).HTTP2)
+
+	d := newProtoDaemon(t, false)
+	cfg := testClientConfig()
+	cfg.HTTP2 = true
+	cfg.MaxConnections = 1
+	cfg.RejectConnectionOverflow = true
+	c := NewClientWithConfig(d.url, cfg)
+	require.NoError(t, c.Ping())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 32)
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(round basics.Round) {
+			defer wg.Done()
+			_, err := c.TotalWeight(round, round+1)
+			errs <- err
+		}(basics.Round(i + 1))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, map[int]int{2: 33}, d.requests())
+	require.EqualValues(t, 1, d.conns.Load())
+
+	// Without the option the client speaks HTTP/1.1 to the same daemon.
+	require.NoError(t, NewClientWithConfig(d.url, testClientConfig()).Ping())
+	require.Equal(t, 1, d.requests()[1])
+}
+
+// TestHTTP2OverTLS tests that HTTP/2 is negotiated with an https daemon even
+// when the client pins its certificate.
+func TestHTTP2OverTLS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	d := newProtoDaemon(t, true)
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleTLSPins = SPKIPin(d.server.Certificate())
+	pins, err := local.ExternalWeightOracleTLSPinHashes()
+	require.NoError(t, err)
+	cfg := testClientConfig()
+	cfg.TLSPins = pins
+	require.NoError(t, NewClientWithConfig(d.url, cfg).Ping())
+	require.Equal(t, map[int]int{1: 1}, d.requests())
+
+	cfg.HTTP2 = true
+	require.NoError(t, NewClientWithConfig(d.url, cfg).Ping())
+	require.Equal(t, map[int]int{1: 1, 2: 1}, d.requests())
+}
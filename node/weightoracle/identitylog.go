@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// IdentityLogFilename is the name of a node's identity log, which lives in its
+// genesis directory.
+const IdentityLogFilename = "weightoracle-identity.log"
+
+// IdentityLogEntry records a daemon identity that differed from the one
+// recorded before it.
+type IdentityLogEntry struct {
+	Seq              uint64    `json:"seq"`
+	Time             time.Time `json:"time"`
+	Daemon           string    `json:"daemon"`
+	GenesisHash      string    `json:"genesis_hash"`
+	ProtocolVersion  string    `json:"protocol_version"`
+	AlgorithmVersion string    `json:"algorithm_version"`
+
+	// Prev is the hex SHA-256 of the previous line of the log, or empty for
+	// the first entry. Editing, inserting or removing a line breaks the chain
+	// at the line after it.
+	Prev string `json:"prev"`
+}
+
+func (e IdentityLogEntry) sameIdentity(daemon string, id ledgercore.DaemonIdentity) bool {
+	return e.Daemon == daemon && e.GenesisHash == id.GenesisHash.String() &&
+		e.ProtocolVersion == id.WeightProtocolVersion && e.AlgorithmVersion == id.WeightAlgorithmVersion
+}
+
+// IdentityLog is an append-only, hash-chained file of the daemon identities a
+// node has used, one JSON entry per line. A new entry is written only when the
+// identity changes, so the log shows when the node started consuming different
+// weight data.
+type IdentityLog struct {
+	mu       sync.Mutex
+	file     *os.File
+	last     *IdentityLogEntry
+	lastHash string
+}
+
+// OpenIdentityLog opens or creates the identity log at path and verifies its
+// chain. A final line cut short by a crash is removed.
+func OpenIdentityLog(path string) (*IdentityLog, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	complete := data[:bytes.LastIndexByte(data, '\n')+1]
+	entries, err := VerifyIdentityLog(bytes.NewReader(complete))
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("identity log %s: %w", path, err)
+	}
+	if len(complete) < len(data) {
+		if err := file.Truncate(int64(len(complete))); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	l := &IdentityLog{file: file}
+	if len(entries) > 0 {
+		l.last = &entries[len(entries)-1]
+		lines := bytes.Split(bytes.TrimSuffix(complete, []byte("\n")), []byte("\n"))
+		l.lastHash = lineHash(lines[len(lines)-1])
+	}
+	return l, nil
+}
+
+// Observe appends an entry for id, as served by daemon at time t, if it
+// differs from the last one recorded. It returns the appended entry, or nil
+// if the identity is unchanged.
+func (l *IdentityLog) Observe(t time.Time, daemon string, id ledgercore.DaemonIdentity) (*IdentityLogEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.last != nil && l.last.sameIdentity(daemon, id) {
+		return nil, nil
+	}
+	entry := IdentityLogEntry{
+		Time:             t.UTC(),
+		Daemon:           daemon,
+		GenesisHash:      id.GenesisHash.String(),
+		ProtocolVersion:  id.WeightProtocolVersion,
+		AlgorithmVersion: id.WeightAlgorithmVersion,
+		Prev:             l.lastHash,
+	}
+	if l.last != nil {
+		entry.Seq = l.last.Seq + 1
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return nil, err
+	}
+	if err := l.file.Sync(); err != nil {
+		return nil, err
+	}
+	l.last = &entry
+	l.lastHash = lineHash(line)
+	return &entry, nil
+}
+
+// LastHash returns the hash of the latest entry, which anchors the whole chain
+// when copied somewhere the node cannot rewrite, such as a remote log.
+func (l *IdentityLog) LastHash() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastHash
+}
+
+// Close closes the log file.
+func (l *IdentityLog) Close() error {
+	return l.file.Close()
+}
+
+// VerifyIdentityLog reads an identity log and checks that every entry is
+// chained to the line before it and numbered in sequence.
+func VerifyIdentityLog(r io.Reader) ([]IdentityLogEntry, error) {
+	var entries []IdentityLogEntry
+	prevHash := ""
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		var e IdentityLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if e.Prev != prevHash {
+			return nil, fmt.Errorf("line %d: chain broken: previous line hashes to %q, entry records %q", line, prevHash, e.Prev)
+		}
+		if e.Seq != uint64(len(entries)) {
+			return nil, fmt.Errorf("line %d: sequence number %d, expected %d", line, e.Seq, len(entries))
+		}
+		entries = append(entries, e)
+		prevHash = lineHash(scanner.Bytes())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func lineHash(line []byte) string {
+	h := sha256.Sum256(line)
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestIdentityLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), IdentityLogFilename)
+	id := ledgercore.DaemonIdentity{GenesisHash: crypto.Digest{1}, WeightProtocolVersion: "1.0", WeightAlgorithmVersion: "2.0"}
+	start := time.Now()
+
+	l, err := OpenIdentityLog(path)
+	require.NoError(t, err)
+	entry, err := l.Observe(start, "http://127.0.0.1:9876", id)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, entry.Seq)
+	require.Empty(t, entry.Prev)
+
+	// Only changes are recorded.
+	entry, err = l.Observe(start.Add(time.Minute), "http://127.0.0.1:9876", id)
+	require.NoError(t, err)
+	require.Nil(t, entry)
+	id.WeightAlgorithmVersion = "2.1"
+	entry, err = l.Observe(start.Add(time.Hour), "http://127.0.0.1:9876", id)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, entry.Seq)
+	require.NotEmpty(t, entry.Prev)
+	require.NoError(t, l.Close())
+
+	// The chain continues across restarts, from the last identity recorded.
+	l, err = OpenIdentityLog(path)
+	require.NoError(t, err)
+	entry, err = l.Observe(start.Add(2*time.Hour), "http://127.0.0.1:9876", id)
+	require.NoError(t, err)
+	require.Nil(t, entry)
+	entry, err = l.Observe(start.Add(2*time.Hour), "http://127.0.0.1:9877", id)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, entry.Seq)
+	lastHash := l.LastHash()
+	require.NoError(t, l.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	entries, err := VerifyIdentityLog(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, "2.0", entries[0].AlgorithmVersion)
+	require.Equal(t, "2.1", entries[1].AlgorithmVersion)
+	require.True(t, entries[1].Time.Equal(start.Add(time.Hour)))
+	require.Equal(t, lineHash(bytes.Split(data, []byte("\n"))[2]), lastHash)
+
+	// A line cut short by a crash is dropped.
+	torn := append(append([]byte{}, data...), []byte(`{"seq":3,"ti`)...)
+	require.NoError(t, os.WriteFile(path, torn, 0600))
+	l, err = OpenIdentityLog(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+	repaired, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, data, repaired)
+}
+
+func TestIdentityLogTampering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), IdentityLogFilename)
+	l, err := OpenIdentityLog(path)
+	require.NoError(t, err)
+	for _, version := range []string{"1.0", "1.1", "1.2"} {
+		_, err = l.Observe(time.Now(), "http://127.0.0.1:9876", ledgercore.DaemonIdentity{WeightProtocolVersion: "1.0", WeightAlgorithmVersion: version})
+		require.NoError(t, err)
+	}
+	require.NoError(t, l.Close())
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"edited entry", bytes.Replace(data, []byte(`"1.1"`), []byte(`"1.9"`), 1), "line 3: chain broken"},
+		{"removed entry", bytes.Join([][]byte{lines[0], lines[2]}, nil), "line 2: chain broken"},
+		{"reordered entries", bytes.Join([][]byte{lines[1], lines[0], lines[2]}, nil), "line 1: chain broken"},
+		{"not json", append([]byte("garbage\n"), data...), "line 1"},
+	}
+	for _, test := range tests {
+		_, err := VerifyIdentityLog(bytes.NewReader(test.data))
+		require.ErrorContains(t, err, test.want, test.name)
+
+		require.NoError(t, os.WriteFile(path, test.data, 0600))
+		_, err = OpenIdentityLog(path)
+		require.ErrorContains(t, err, test.want, test.name)
+	}
+}
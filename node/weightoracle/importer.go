@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// Importer bulk-loads the daemon's full weight table of each balance round
+// within its horizon into the client's weight cache, one /weight_table
+// request per round rather than one query per account. It suits nodes that
+// validate every vote of a round, such as archival nodes catching up, for
+// which the weights of all accounts are needed, not just those of local
+// participation keys. Each balance round is imported once, unless its import
+// fails, in which case it is tried again on the next Advance; the importer
+// stops for good if the daemon does not serve weight tables.
+type Importer struct {
+	client  *Client
+	horizon SubscribeHorizon
+
+	// latest holds the round of the last Advance not yet taken by Run.
+	latest chan basics.Round
+
+	// imported are the balance rounds imported, within the last horizon.
+	imported map[basics.Round]bool
+}
+
+// NewImporter returns an Importer of the tables within horizon, through
+// client. It does nothing until Run is called.
+func NewImporter(client *Client, horizon SubscribeHorizon) *Importer {
+	return &Importer{
+		client:   client,
+		horizon:  horizon,
+		latest:   make(chan basics.Round, 1),
+		imported: make(map[basics.Round]bool),
+	}
+}
+
+// Advance tells the importer that latest has been committed. It does not
+// block. Advance must not be called concurrently with itself.
+func (im *Importer) Advance(latest basics.Round) {
+	offerLatest(im.latest, latest)
+}
+
+// Run imports the tables of the horizons of the rounds given to Advance
+// until done is closed, or until the daemon turns out not to serve tables.
+func (im *Importer) Run(done <-chan struct{}) {
+	for {
+		select {
+		case latest := <-im.latest:
+			if !im.importTables(latest) {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// importTables imports the tables within the horizon of latest that have not
+// been imported yet, and reports false if the daemon does not serve tables.
+func (im *Importer) importTables(latest basics.Round) bool {
+	first, last, err := im.horizon(latest)
+	if err != nil {
+		logging.Base().Debugf("weight tables after round %d not imported: %v", latest, err)
+		return true
+	}
+	for r := range im.imported {
+		if r < first {
+			delete(im.imported, r)
+		}
+	}
+	for rnd := first; rnd <= last; rnd++ {
+		if im.imported[rnd] {
+			continue
+		}
+		cached, err := im.client.WarmWeightCache(rnd)
+		switch {
+		case err == nil:
+			logging.Base().Debugf("imported %d weights of balance round %d from the weight daemon", cached, rnd)
+			im.imported[rnd] = true
+		case ledgercore.IsDaemonError(err, "unsupported"):
+			logging.Base().Infof("weight daemon does not serve weight tables, weights are pulled as needed: %v", err)
+			return false
+		default:
+			// A table not found may not have been computed yet.
+			logging.Base().Debugf("weight table of balance round %d not imported: %v", rnd, err)
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// tableCountingOracle counts the weight tables asked of a publishingOracle.
+type tableCountingOracle struct {
+	*publishingOracle
+	tables atomic.Int32
+}
+
+func (o *tableCountingOracle) WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	o.tables.Add(1)
+	return o.publishingOracle.WeightTable(balanceRound)
+}
+
+func TestImporter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	oracle := &tableCountingOracle{publishingOracle: newPublishingOracle()}
+	for rnd := basics.Round(9); rnd <= 11; rnd++ {
+		oracle.publish(rnd, testTable(addr, uint64(rnd)*100))
+	}
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientURL(server.URL())
+	cachedRound := func(rnd basics.Round) bool {
+		_, ok := client.cachedWeight(rnd, makeWeightCacheKey(rnd, addr, crypto.VRFVerifier{1}))
+		return ok
+	}
+
+	// The importer imports the tables of the latest round and the next two.
+	im := NewImporter(client, func(latest basics.Round) (basics.Round, basics.Round, error) {
+		return latest, latest + 2, nil
+	})
+	require.True(t, im.importTables(10))
+	require.False(t, cachedRound(9))
+	require.True(t, cachedRound(10))
+	require.True(t, cachedRound(11))
+	// Round 12's table is not published yet, so it is tried again.
+	require.False(t, cachedRound(12))
+	require.Equal(t, map[basics.Round]bool{10: true, 11: true}, im.imported)
+
+	oracle.publish(12, testTable(addr, 1200))
+	oracle.publish(13, testTable(addr, 1300))
+	tables := oracle.tables.Load()
+	require.True(t, im.importTables(11))
+	require.True(t, cachedRound(12))
+	require.True(t, cachedRound(13))
+	// Imported tables are not asked for again.
+	require.Equal(t, tables+2, oracle.tables.Load())
+	require.Equal(t, map[basics.Round]bool{11: true, 12: true, 13: true}, im.imported)
+	require.Zero(t, oracle.CallCount(mock.MethodWeight))
+
+	w, err := client.Weight(13, addr, crypto.VRFVerifier{1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1300), w)
+	require.Zero(t, oracle.CallCount(mock.MethodWeight))
+}
+
+func TestImporterStopsWithoutSupport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server, err := StartServer("127.0.0.1:0", mock.New())
+	require.NoError(t, err)
+	defer server.Close()
+
+	im := NewImporter(NewClientURL(server.URL()), func(latest basics.Round) (basics.Round, basics.Round, error) {
+		return latest, latest + 2, nil
+	})
+	im.Advance(10)
+	stopped := make(chan struct{})
+	go func() {
+		im.Run(make(chan struct{}))
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "importer did not stop")
+	}
+}
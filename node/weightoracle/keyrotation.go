@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// Compile-time interface check
+var _ ledgercore.KeyRotationNotifier = (*Client)(nil)
+
+// keyRotationsRequest is the JSON structure sent for a key_rotations
+// notification. The endpoint path (/key_rotations) identifies the request type.
+type keyRotationsRequest struct {
+	Rotations []keyRotation `json:"rotations"`
+}
+
+// keyRotation is a single rotation of a key_rotations notification.
+// OldSelectionID is omitted for accounts that were not online before.
+type keyRotation struct {
+	Address        string `json:"address"`
+	Round          string `json:"round"`
+	OldSelectionID string `json:"old_selection_id,omitempty"`
+	NewSelectionID string `json:"new_selection_id"`
+}
+
+// keyRotationsResponse is the expected response from a key_rotations
+// notification: an empty object, or an error.
+type keyRotationsResponse struct {
+	Error string `json:"error,omitempty"`
+	Code  string `json:"code,omitempty"`
+}
+
+// NotifyKeyRotations tells the daemon of key rotations, in one /key_rotations
+// request. Daemons that do not serve /key_rotations learn of rotations from
+// the chain themselves; once one has turned a notification down with a
+// not_found or unsupported error, no more are sent to it and nil is returned.
+func (c *Client) NotifyKeyRotations(rotations []ledgercore.KeyRotation) error {
+	if len(rotations) == 0 || c.noKeyRotations.Load() {
+		return nil
+	}
+	req := keyRotationsRequest{Rotations: make([]keyRotation, len(rotations))}
+	for i, r := range rotations {
+		req.Rotations[i] = keyRotation{
+			Address:        r.Addr.String(),
+			Round:          strconv.FormatUint(uint64(r.Round), 10),
+			NewSelectionID: hex.EncodeToString(r.New[:]),
+		}
+		if !r.Old.IsEmpty() {
+			req.Rotations[i].OldSelectionID = hex.EncodeToString(r.Old[:])
+		}
+	}
+
+	var resp keyRotationsResponse
+	err := c.doRequest(context.Background(), "/key_rotations", nil, req, &resp)
+	if err == nil && resp.Error != "" {
+		err = daemonError(resp.Code, resp.Error)
+	}
+	if ledgercore.IsDaemonError(err, "not_found") || ledgercore.IsDaemonError(err, "unsupported") {
+		c.noKeyRotations.Store(true)
+		return nil
+	}
+	return err
+}
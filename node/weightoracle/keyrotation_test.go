@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestNotifyKeyRotations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+
+	rotations := []ledgercore.KeyRotation{
+		{Addr: basics.Address{1}, Round: 10, Old: crypto.VRFVerifier{2}, New: crypto.VRFVerifier{3}},
+		{Addr: basics.Address{4}, Round: 10, New: crypto.VRFVerifier{5}},
+	}
+	require.NoError(t, client.NotifyKeyRotations(rotations))
+	require.NoError(t, client.NotifyKeyRotations(nil))
+	require.Equal(t, rotations, oracle.KeyRotations())
+}
+
+func TestNotifyKeyRotationsUnsupported(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, code := range []string{"not_found", "unsupported", "internal"} {
+		var requests atomic.Int32
+		server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+			requests.Add(1)
+			return map[string]string{"error": "no", "code": code}
+		})
+		client := NewClient(server.port)
+
+		rotations := []ledgercore.KeyRotation{{Addr: basics.Address{1}, Round: 10, New: crypto.VRFVerifier{3}}}
+		err := client.NotifyKeyRotations(rotations)
+		err2 := client.NotifyKeyRotations(rotations)
+		server.Close()
+		if code == "internal" {
+			// Failures other than a turned down endpoint do not stop notifications.
+			require.True(t, ledgercore.IsDaemonError(err, code))
+			require.True(t, ledgercore.IsDaemonError(err2, code))
+			continue
+		}
+		require.NoError(t, err)
+		require.NoError(t, err2)
+		require.EqualValues(t, 1, requests.Load(), code)
+	}
+}
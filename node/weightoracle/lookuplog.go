@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// LookupLogMessage is the message of the debug entries that log sampled
+// weight and total weight lookups (see ClientConfig.LookupLogSampling).
+const LookupLogMessage = "weight daemon lookup"
+
+// The cache states of a logged lookup: how the client found the answer
+// before asking the daemon, if it asked at all.
+const (
+	// LookupOverride is a weight set by an override (see SetOverrides).
+	LookupOverride = "override"
+	// LookupHit is an answer from the weight or total weight cache.
+	LookupHit = "hit"
+	// LookupNotFound is a not_found answer remembered for NotFoundTTL.
+	LookupNotFound = "not_found"
+	// LookupMiss is a lookup the daemon was asked for.
+	LookupMiss = "miss"
+)
+
+// The outcomes of a logged lookup.
+const (
+	LookupOK    = "ok"
+	LookupStale = "stale"
+	LookupError = "error"
+)
+
+// lookupLogger samples the lookups that are logged: one in every every, none
+// when every is 0.
+type lookupLogger struct {
+	log   logging.Logger
+	every uint64
+	count atomic.Uint64
+}
+
+// lookupLogEntry is a sampled lookup, logged when it completes. Its methods do
+// nothing on a nil entry, the lookups that are not logged.
+type lookupLogEntry struct {
+	log    logging.Logger
+	start  time.Time
+	fields logging.Fields
+	cache  string
+	stale  bool
+}
+
+// start returns the entry of a lookup at endpoint for balanceRound, or nil if
+// the lookup is not sampled or debug logging is off.
+func (l *lookupLogger) start(endpoint string, balanceRound basics.Round) *lookupLogEntry {
+	if l.every == 0 || l.count.Add(1)%l.every != 0 || !l.log.IsLevelEnabled(logging.Debug) {
+		return nil
+	}
+	return &lookupLogEntry{
+		log:   l.log,
+		start: time.Now(),
+		fields: logging.Fields{
+			"endpoint":      endpoint,
+			"balance_round": uint64(balanceRound),
+		},
+		cache: LookupMiss,
+	}
+}
+
+// set adds a field to the entry.
+func (e *lookupLogEntry) set(key string, value interface{}) {
+	if e != nil {
+		e.fields[key] = value
+	}
+}
+
+// setCache records the cache state the lookup was answered in.
+func (e *lookupLogEntry) setCache(state string) {
+	if e != nil {
+		e.cache = state
+	}
+}
+
+// setStale records that the lookup was answered with a stale answer.
+func (e *lookupLogEntry) setStale() {
+	if e != nil {
+		e.stale = true
+	}
+}
+
+// finish logs the lookup, which answered key with value or failed with err,
+// through the daemon in use by c.
+func (e *lookupLogEntry) finish(c *Client, key string, value uint64, err error) {
+	if e == nil {
+		return
+	}
+	e.fields["daemon"] = c.daemons[c.failover.active.Load()].url
+	e.fields["latency"] = time.Since(e.start).String()
+	e.fields["cache"] = e.cache
+	switch {
+	case err != nil:
+		e.fields["outcome"] = LookupError
+		e.fields["error"] = err.Error()
+	case e.stale:
+		e.fields["outcome"] = LookupStale
+		e.fields[key] = value
+	default:
+		e.fields["outcome"] = LookupOK
+		e.fields[key] = value
+	}
+	e.log.WithFields(e.fields).Debug(LookupLogMessage)
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// lookupLogClient returns a client of oracle that logs one in every lookups
+// to the returned buffer, as JSON, at the given level.
+func lookupLogClient(t *testing.T, oracle *mock.Oracle, every uint64, level logging.Level) (*Client, *bytes.Buffer) {
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+
+	cfg := testClientConfig()
+	cfg.LookupLogSampling = every
+	client := NewClientWithConfig(server.URL(), cfg)
+	var buf bytes.Buffer
+	log := logging.NewLogger()
+	log.SetOutput(&buf)
+	log.SetJSONFormatter()
+	log.SetLevel(level)
+	client.lookupLog.log = log
+	return client, &buf
+}
+
+// lookupLogEntries returns the lookup entries logged to buf.
+func lookupLogEntries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var entry map[string]interface{}
+		require.NoError(t, dec.Decode(&entry))
+		if entry["msg"] == LookupLogMessage {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func TestLookupLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	oracle.SetTotalWeight(500)
+	client, buf := lookupLogClient(t, oracle, 1, logging.Debug)
+	addr, selectionID := basics.Address{1}, crypto.VRFVerifier{9}
+
+	_, err := client.Weight(9, addr, selectionID)
+	require.NoError(t, err)
+	_, err = client.Weight(9, addr, selectionID)
+	require.NoError(t, err)
+	_, err = client.TotalWeight(9, 329)
+	require.NoError(t, err)
+	oracle.SetWeightError(errors.New("no weight"))
+	_, err = client.Weight(10, addr, selectionID)
+	require.Error(t, err)
+
+	entries := lookupLogEntries(t, buf)
+	require.Len(t, entries, 4)
+	for _, e := range entries {
+		require.Equal(t, client.daemons[0].url, e["daemon"])
+		require.NotEmpty(t, e["latency"])
+	}
+
+	miss := entries[0]
+	require.Equal(t, "/weight", miss["endpoint"])
+	require.Equal(t, float64(9), miss["balance_round"])
+	require.Equal(t, addr.String(), miss["address"])
+	require.Equal(t, hex.EncodeToString(selectionID[:]), miss["selection_id"])
+	require.Equal(t, LookupMiss, miss["cache"])
+	require.Equal(t, LookupOK, miss["outcome"])
+	require.Equal(t, float64(5), miss["weight"])
+
+	hit := entries[1]
+	require.Equal(t, LookupHit, hit["cache"])
+	require.Equal(t, float64(5), hit["weight"])
+
+	total := entries[2]
+	require.Equal(t, "/total_weight", total["endpoint"])
+	require.Equal(t, float64(329), total["vote_round"])
+	require.Equal(t, LookupMiss, total["cache"])
+	require.Equal(t, float64(500), total["total_weight"])
+
+	failed := entries[3]
+	require.Equal(t, LookupMiss, failed["cache"])
+	require.Equal(t, LookupError, failed["outcome"])
+	require.Contains(t, failed["error"], "no weight")
+	require.NotContains(t, failed, "weight")
+}
+
+func TestLookupLogSampling(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleLookupLogSampling = 3
+	require.Equal(t, uint64(3), MakeClientConfig(local).LookupLogSampling)
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	addr := basics.Address{1}
+
+	// One in every three lookups is logged.
+	client, buf := lookupLogClient(t, oracle, local.ExternalWeightOracleLookupLogSampling, logging.Debug)
+	for rnd := basics.Round(1); rnd <= 7; rnd++ {
+		_, err := client.Weight(rnd, addr, crypto.VRFVerifier{})
+		require.NoError(t, err)
+	}
+	entries := lookupLogEntries(t, buf)
+	require.Len(t, entries, 2)
+	require.Equal(t, float64(3), entries[0]["balance_round"])
+	require.Equal(t, float64(6), entries[1]["balance_round"])
+
+	// Nothing is logged above debug level, or with sampling off.
+	for _, c := range []struct {
+		every uint64
+		level logging.Level
+	}{{1, logging.Info}, {0, logging.Debug}} {
+		client, buf := lookupLogClient(t, oracle, c.every, c.level)
+		_, err := client.Weight(1, addr, crypto.VRFVerifier{})
+		require.NoError(t, err)
+		require.Empty(t, lookupLogEntries(t, buf))
+	}
+}
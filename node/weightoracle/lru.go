@@ -104,6 +104,49 @@ func (c *lruCache[K, V]) Put(key K, value V) {
 	c.items[key] = node
 }
 
+// Resize changes the capacity of the cache, evicting the least recently used
+// entries that no longer fit. The capacity must be greater than 0.
+func (c *lruCache[K, V]) Resize(capacity int) {
+	if capacity <= 0 {
+		panic("lruCache capacity must be > 0")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.capacity = capacity
+	for len(c.items) > c.capacity {
+		back := c.list.Back()
+		delete(c.items, back.Value.key)
+		c.list.Remove(back)
+	}
+}
+
+// RemoveIf removes the entries for which f returns true and returns how
+// many it removed. The cache is locked meanwhile, so f must not use it.
+func (c *lruCache[K, V]) RemoveIf(f func(key K, value V) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	removed := 0
+	for key, node := range c.items {
+		if f(key, node.Value.value) {
+			delete(c.items, key)
+			c.list.Remove(node)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Range calls f on every entry, in no particular order and without changing
+// their recency. The cache is locked meanwhile, so f must not use it.
+func (c *lruCache[K, V]) Range(f func(key K, value V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, node := range c.items {
+		f(key, node.Value.value)
+	}
+}
+
 // Len returns the current number of entries in the cache.
 func (c *lruCache[K, V]) Len() int {
 	c.mu.Lock()
@@ -189,6 +189,35 @@ func TestLRUCache_CapacityOne(t *testing.T) {
 	require.Equal(t, 2, val)
 }
 
+func TestLRUCache_Resize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cache := newLRUCache[string, int](3)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+
+	// Shrinking evicts the least recently used entries.
+	cache.Resize(2)
+	require.Equal(t, 2, cache.Len())
+	_, ok := cache.Get("b")
+	require.False(t, ok)
+
+	// Growing makes room without evicting.
+	cache.Resize(4)
+	cache.Put("d", 4)
+	cache.Put("e", 5)
+	require.Equal(t, 4, cache.Len())
+	for _, key := range []string{"a", "c", "d", "e"} {
+		_, ok := cache.Get(key)
+		require.True(t, ok, key)
+	}
+
+	require.Panics(t, func() { cache.Resize(0) })
+}
+
 func TestLRUCache_ZeroCapacityPanics(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -340,3 +369,25 @@ func TestLRUCache_LargeCapacity(t *testing.T) {
 	_, ok := cache.Get(0)
 	require.False(t, ok, "key 0 should have been evicted")
 }
+
+func TestLRUCache_RemoveIf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cache := newLRUCache[int, string](4)
+	for i := 1; i <= 4; i++ {
+		cache.Put(i, "v")
+	}
+	require.Equal(t, 2, cache.RemoveIf(func(k int, _ string) bool { return k%2 == 0 }))
+	require.Equal(t, 2, cache.Len())
+	_, ok := cache.Get(2)
+	require.False(t, ok)
+
+	// The removed entries free room without evicting the others.
+	cache.Put(5, "v")
+	cache.Put(6, "v")
+	for _, k := range []int{1, 3, 5, 6} {
+		_, ok := cache.Get(k)
+		require.True(t, ok, "key %d should exist", k)
+	}
+}
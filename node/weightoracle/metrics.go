@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var (
+	weightOracleCacheLookups    = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_cache_lookups_total", Description: "number of weight and total weight cache lookups, by endpoint and result (hit or miss)"})
+	weightOracleRequests        = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_requests_total", Description: "number of requests sent to the weight daemon, by endpoint"})
+	weightOracleRequestMicros   = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_request_micros_total", Description: "microseconds spent on weight daemon requests, retries included, by endpoint"})
+	weightOracleRequestDuration = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_request_duration_seconds_bucket", Description: "number of weight daemon requests that took at most le seconds, retries included, by endpoint"})
+	weightOracleErrors          = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_errors_total", Description: "number of weight daemon requests that failed, by endpoint and daemon error code"})
+	weightOracleRetries         = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_retries_total", Description: "number of weight daemon request attempts retried after a failure, by endpoint"})
+	weightOracleTimeouts        = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_timeouts_total", Description: "number of weight daemon request attempts that timed out, by endpoint"})
+)
+
+// requestDurationBuckets are the upper bounds, in seconds, of the buckets of
+// algod_weight_oracle_request_duration_seconds_bucket. Like a Prometheus
+// histogram's, the buckets are cumulative, and the last one counts every
+// request.
+var requestDurationBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// daemonErrorCodes are the DaemonError codes counted under their own name in
+// algod_weight_oracle_errors_total. Other codes are counted as "other", so
+// that a daemon cannot add label values without bound.
+var daemonErrorCodes = map[string]bool{
+	"not_found":   true,
+	"internal":    true,
+	"bad_request": true,
+	"unsupported": true,
+}
+
+// errorCodeLabel returns the code err is counted under in
+// algod_weight_oracle_errors_total: its DaemonError code, "timeout" for an
+// attempt that timed out, "abandoned" for a request its caller gave up on, or
+// "other" for any other failure.
+func errorCodeLabel(err error, class ErrorClass) string {
+	if errors.Is(err, ErrRequestAbandoned) {
+		return "abandoned"
+	}
+	var de *ledgercore.DaemonError
+	if errors.As(err, &de) {
+		if daemonErrorCodes[de.Code] {
+			return de.Code
+		}
+		return "other"
+	}
+	if class == RetryTimeout {
+		return "timeout"
+	}
+	return "other"
+}
+
+// observeRequest counts a request to endpoint that took d, and the error it
+// failed with, if any, whose last attempt failed in class.
+func observeRequest(endpoint string, d time.Duration, err error, class ErrorClass) {
+	weightOracleRequests.Inc(map[string]string{"endpoint": endpoint})
+	weightOracleRequestMicros.AddUint64(uint64(d.Microseconds()), map[string]string{"endpoint": endpoint})
+	seconds := d.Seconds()
+	for _, le := range requestDurationBuckets {
+		if seconds <= le {
+			weightOracleRequestDuration.Inc(map[string]string{"endpoint": endpoint, "le": strconv.FormatFloat(le, 'g', -1, 64)})
+		}
+	}
+	weightOracleRequestDuration.Inc(map[string]string{"endpoint": endpoint, "le": "+Inf"})
+	if err != nil {
+		weightOracleErrors.Inc(map[string]string{"endpoint": endpoint, "code": errorCodeLabel(err, class)})
+	}
+}
+
+// observeAttempt counts a failed attempt at a request to endpoint.
+func observeAttempt(endpoint string, class ErrorClass) {
+	if class == RetryTimeout {
+		weightOracleTimeouts.Inc(map[string]string{"endpoint": endpoint})
+	}
+}
+
+// observeRetry counts an attempt at a request to endpoint that is retried.
+func observeRetry(endpoint string) {
+	weightOracleRetries.Inc(map[string]string{"endpoint": endpoint})
+}
+
+// observeCacheLookup counts a cache lookup for endpoint.
+func observeCacheLookup(endpoint string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	weightOracleCacheLookups.Inc(map[string]string{"endpoint": endpoint, "result": result})
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// metricDelta returns a function reporting how much counter has grown for
+// labels since metricDelta was called.
+func metricDelta(counter *metrics.Counter, labels map[string]string) func() uint64 {
+	before := counter.GetUint64ValueForLabels(labels)
+	return func() uint64 {
+		return counter.GetUint64ValueForLabels(labels) - before
+	}
+}
+
+// TestClientMetrics tests that requests, their latency, their errors, retries,
+// timeouts and cache lookups are counted. The counters are shared by every
+// client, so the test does not run in parallel with the others.
+func TestClientMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	oracle := mock.New()
+	oracle.SetTotalWeight(1000)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+
+	endpoint := map[string]string{"endpoint": "/total_weight"}
+	requests := metricDelta(weightOracleRequests, endpoint)
+	all := metricDelta(weightOracleRequestDuration, map[string]string{"endpoint": "/total_weight", "le": "+Inf"})
+	slowest := metricDelta(weightOracleRequestDuration, map[string]string{"endpoint": "/total_weight", "le": "10"})
+	misses := metricDelta(weightOracleCacheLookups, map[string]string{"endpoint": "/total_weight", "result": "miss"})
+	hits := metricDelta(weightOracleCacheLookups, map[string]string{"endpoint": "/total_weight", "result": "hit"})
+	for i := 0; i < 2; i++ {
+		_, err = client.TotalWeight(1, 2)
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 1, requests())
+	require.EqualValues(t, 1, all())
+	require.EqualValues(t, 1, slowest())
+	require.EqualValues(t, 1, misses())
+	require.EqualValues(t, 1, hits())
+
+	// Errors are counted by their DaemonError code, and unknown codes as
+	// "other".
+	notFound := metricDelta(weightOracleErrors, map[string]string{"endpoint": "/total_weight", "code": "not_found"})
+	other := metricDelta(weightOracleErrors, map[string]string{"endpoint": "/total_weight", "code": "other"})
+	oracle.SetTotalWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "no snapshot"})
+	_, err = client.TotalWeight(2, 3)
+	require.Error(t, err)
+	oracle.SetTotalWeightError(&ledgercore.DaemonError{Code: "made_up", Msg: "no snapshot"})
+	_, err = client.TotalWeight(3, 4)
+	require.Error(t, err)
+	require.EqualValues(t, 1, notFound())
+	require.EqualValues(t, 1, other())
+
+	// Each attempt that times out is counted, and each one retried.
+	oracle.SetTotalWeightError(nil)
+	oracle.SetLatency(200 * time.Millisecond)
+	cfg := testClientConfig()
+	cfg.QueryTimeout = 50 * time.Millisecond
+	cfg.Retry = RetryPolicy{MaxAttempts: 3, Retryable: RetryTimeout}
+	client = NewClientWithConfig(server.URL(), cfg)
+	timeouts := metricDelta(weightOracleTimeouts, endpoint)
+	retries := metricDelta(weightOracleRetries, endpoint)
+	timedOut := metricDelta(weightOracleErrors, map[string]string{"endpoint": "/total_weight", "code": "timeout"})
+	_, err = client.TotalWeight(4, 5)
+	require.Error(t, err)
+	require.EqualValues(t, 3, timeouts())
+	require.EqualValues(t, 2, retries())
+	require.EqualValues(t, 1, timedOut())
+}
+
+// TestErrorCodeLabel tests the code failures are counted under.
+func TestErrorCodeLabel(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, "internal", errorCodeLabel(daemonError("internal", "x"), RetryInternal))
+	require.Equal(t, "other", errorCodeLabel(daemonError("something new", "x"), 0))
+	require.Equal(t, "timeout", errorCodeLabel(errors.New("deadline exceeded"), RetryTimeout))
+	require.Equal(t, "other", errorCodeLabel(errors.New("connection refused"), RetryConnect))
+}
@@ -0,0 +1,376 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package mock provides a configurable ledgercore.WeightOracle for tests.
+package mock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// Method names recorded in Call.Method.
+const (
+	MethodWeight            = "Weight"
+	MethodTotalWeight       = "TotalWeight"
+	MethodPing              = "Ping"
+	MethodIdentity          = "Identity"
+	MethodWeightAttestation = "WeightAttestation"
+)
+
+// Call records a single query made to an Oracle. Fields that the method does
+// not take are left zero.
+type Call struct {
+	Method       string
+	BalanceRound basics.Round
+	VoteRound    basics.Round
+	Address      basics.Address
+	SelectionID  crypto.VRFVerifier
+}
+
+// StakeSource is the part of a ledger read by an Oracle created with
+// NewStakeOracle. *ledger.Ledger implements it.
+type StakeSource interface {
+	LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
+	OnlineCirculation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error)
+	GenesisHash() crypto.Digest
+}
+
+// Oracle is a ledgercore.WeightOracle whose answers are set by the test. It is
+// safe for concurrent use, so its configuration may change while a node or
+// ledger is querying it.
+//
+// Unless configured otherwise, Weight returns the default weight (zero),
+// TotalWeight returns zero, Ping succeeds and Identity reports the expected
+// algorithm and protocol versions with a zero genesis hash.
+type Oracle struct {
+	mu sync.Mutex
+
+	stake         StakeSource
+	weights       map[basics.Address]uint64
+	keyWeights    map[ledgercore.WeightQuery]uint64
+	roundWeights  map[roundWeightKey]uint64
+	defaultWeight uint64
+	totalWeight   uint64
+	identity      ledgercore.DaemonIdentity
+
+	weightErr   error
+	totalErr    error
+	pingErr     error
+	identityErr error
+	latency     time.Duration
+
+	calls     []Call
+	rotations []ledgercore.KeyRotation
+}
+
+// roundWeightKey identifies a weight set with SetRoundWeight.
+type roundWeightKey struct {
+	balanceRound basics.Round
+	addr         basics.Address
+}
+
+// Compile-time interface checks
+var _ ledgercore.WeightOracle = (*Oracle)(nil)
+var _ ledgercore.KeyRotationNotifier = (*Oracle)(nil)
+
+// New creates an Oracle with no weights configured.
+func New() *Oracle {
+	return &Oracle{
+		weights:      make(map[basics.Address]uint64),
+		keyWeights:   make(map[ledgercore.WeightQuery]uint64),
+		roundWeights: make(map[roundWeightKey]uint64),
+		identity: ledgercore.DaemonIdentity{
+			WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+			WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+		},
+	}
+}
+
+// NewStakeOracle creates an Oracle that reports each account's latest balance
+// as its weight and the online circulation as the total weight, matching the
+// stake-based selection that ledger tests were written against. Accounts with
+// no balance get a weight of 1 so that block evaluation never sees a zero
+// weight for a participating account. Weights set with SetWeight take
+// precedence, and Identity reports the source's genesis hash.
+func NewStakeOracle(src StakeSource) *Oracle {
+	o := New()
+	o.stake = src
+	o.identity.GenesisHash = src.GenesisHash()
+	return o
+}
+
+// SetWeight sets the weight returned for addr, regardless of selection ID
+// and balance round.
+func (o *Oracle) SetWeight(addr basics.Address, weight uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.weights[addr] = weight
+}
+
+// SetKeyWeight sets the weight returned for addr under selectionID,
+// regardless of balance round. It takes precedence over SetWeight, so that an
+// account's participation keys can be weighed differently.
+func (o *Oracle) SetKeyWeight(addr basics.Address, selectionID crypto.VRFVerifier, weight uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.keyWeights[ledgercore.WeightQuery{Addr: addr, SelectionID: selectionID}] = weight
+}
+
+// SetRoundWeight sets the weight returned for addr at balanceRound,
+// regardless of selection ID. It takes precedence over SetKeyWeight and
+// SetWeight, so that an account's weight can change from round to round.
+func (o *Oracle) SetRoundWeight(balanceRound basics.Round, addr basics.Address, weight uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.roundWeights[roundWeightKey{balanceRound: balanceRound, addr: addr}] = weight
+}
+
+// SetDefaultWeight sets the weight returned for addresses without a weight of
+// their own. It has no effect on an Oracle created with NewStakeOracle.
+func (o *Oracle) SetDefaultWeight(weight uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.defaultWeight = weight
+}
+
+// SetTotalWeight sets the value returned by TotalWeight. It has no effect on
+// an Oracle created with NewStakeOracle.
+func (o *Oracle) SetTotalWeight(total uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.totalWeight = total
+}
+
+// SetIdentity sets the value returned by Identity.
+func (o *Oracle) SetIdentity(id ledgercore.DaemonIdentity) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.identity = id
+}
+
+// SetWeightError makes Weight fail with err; nil restores normal answers.
+func (o *Oracle) SetWeightError(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.weightErr = err
+}
+
+// SetTotalWeightError makes TotalWeight fail with err; nil restores normal answers.
+func (o *Oracle) SetTotalWeightError(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.totalErr = err
+}
+
+// SetPingError makes Ping fail with err; nil restores normal answers.
+func (o *Oracle) SetPingError(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.pingErr = err
+}
+
+// SetIdentityError makes Identity fail with err; nil restores normal answers.
+func (o *Oracle) SetIdentityError(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.identityErr = err
+}
+
+// SetLatency delays every answer by d, simulating a slow daemon.
+func (o *Oracle) SetLatency(d time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.latency = d
+}
+
+// Calls returns a copy of the queries made so far, in order.
+func (o *Oracle) Calls() []Call {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]Call(nil), o.calls...)
+}
+
+// CallCount returns how many times method has been called.
+func (o *Oracle) CallCount(method string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	n := 0
+	for _, c := range o.calls {
+		if c.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+// ResetCalls forgets the queries recorded so far.
+func (o *Oracle) ResetCalls() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.calls = nil
+}
+
+// record notes the call and returns the configured latency, which the caller
+// sleeps through without holding the lock.
+func (o *Oracle) record(c Call) time.Duration {
+	o.calls = append(o.calls, c)
+	return o.latency
+}
+
+// Weight implements ledgercore.WeightOracle.
+func (o *Oracle) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	return o.weight(Call{Method: MethodWeight, BalanceRound: balanceRound, Address: addr, SelectionID: selectionID})
+}
+
+// weight records c and answers the weight of its account.
+func (o *Oracle) weight(c Call) (uint64, error) {
+	balanceRound, addr, selectionID := c.BalanceRound, c.Address, c.SelectionID
+	o.mu.Lock()
+	latency := o.record(c)
+	weight, ok := o.roundWeights[roundWeightKey{balanceRound: balanceRound, addr: addr}]
+	if !ok {
+		weight, ok = o.keyWeights[ledgercore.WeightQuery{Addr: addr, SelectionID: selectionID}]
+	}
+	if !ok {
+		weight, ok = o.weights[addr]
+	}
+	if !ok {
+		weight = o.defaultWeight
+	}
+	stake, err := o.stake, o.weightErr
+	o.mu.Unlock()
+
+	time.Sleep(latency)
+	if err != nil {
+		return 0, err
+	}
+	if ok || stake == nil {
+		return weight, nil
+	}
+	acctData, _, _, _ := stake.LookupLatest(addr)
+	if acctData.MicroAlgos.IsZero() {
+		return 1, nil
+	}
+	return acctData.MicroAlgos.Raw, nil
+}
+
+// TotalWeight implements ledgercore.WeightOracle.
+func (o *Oracle) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	o.mu.Lock()
+	latency := o.record(Call{Method: MethodTotalWeight, BalanceRound: balanceRound, VoteRound: voteRound})
+	total, stake, err := o.totalWeight, o.stake, o.totalErr
+	o.mu.Unlock()
+
+	time.Sleep(latency)
+	if err != nil {
+		return 0, err
+	}
+	if stake == nil {
+		return total, nil
+	}
+	circulation, err := stake.OnlineCirculation(balanceRound, voteRound)
+	if err != nil {
+		return 0, err
+	}
+	return circulation.Raw, nil
+}
+
+// Ping implements ledgercore.WeightOracle.
+func (o *Oracle) Ping() error {
+	o.mu.Lock()
+	latency := o.record(Call{Method: MethodPing})
+	err := o.pingErr
+	o.mu.Unlock()
+
+	time.Sleep(latency)
+	return err
+}
+
+// Identity implements ledgercore.WeightOracle.
+func (o *Oracle) Identity() (ledgercore.DaemonIdentity, error) {
+	o.mu.Lock()
+	latency := o.record(Call{Method: MethodIdentity})
+	id, err := o.identity, o.identityErr
+	o.mu.Unlock()
+
+	time.Sleep(latency)
+	if err != nil {
+		return ledgercore.DaemonIdentity{}, err
+	}
+	return id, nil
+}
+
+// NotifyKeyRotations implements ledgercore.KeyRotationNotifier. The rotations
+// are recorded; weights are set by address, so they carry across rotations
+// without them.
+func (o *Oracle) NotifyKeyRotations(rotations []ledgercore.KeyRotation) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.rotations = append(o.rotations, rotations...)
+	return nil
+}
+
+// KeyRotations returns the key rotations the oracle has been told of, in order.
+func (o *Oracle) KeyRotations() []ledgercore.KeyRotation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]ledgercore.KeyRotation(nil), o.rotations...)
+}
+
+// Attester is an Oracle that signs the weights it answers with a key, as a
+// daemon does on networks that enable ExternalWeightAttestation.
+type Attester struct {
+	*Oracle
+	key *crypto.SignatureSecrets
+}
+
+// Compile-time interface check
+var _ ledgercore.AttestingWeightOracle = (*Attester)(nil)
+
+// NewAttester creates an Attester that answers as o does and signs with key.
+func NewAttester(o *Oracle, key *crypto.SignatureSecrets) *Attester {
+	return &Attester{Oracle: o, key: key}
+}
+
+// WeightAttestation implements ledgercore.AttestingWeightOracle. It signs the
+// weight Weight would answer, on the network of the oracle's identity, and
+// fails as Weight would.
+func (a *Attester) WeightAttestation(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (committee.WeightAttestation, error) {
+	weight, err := a.weight(Call{Method: MethodWeightAttestation, BalanceRound: balanceRound, Address: addr, SelectionID: selectionID})
+	if err != nil {
+		return committee.WeightAttestation{}, err
+	}
+	a.mu.Lock()
+	genesisHash := a.identity.GenesisHash
+	a.mu.Unlock()
+
+	return committee.WeightAttestation{
+		Signer: a.key.SignatureVerifier,
+		Sig: a.key.Sign(committee.WeightStatement{
+			BalanceRound: balanceRound,
+			Addr:         addr,
+			SelectionID:  selectionID,
+			Weight:       weight,
+			GenesisHash:  genesisHash,
+		}),
+	}, nil
+}
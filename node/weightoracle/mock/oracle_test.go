@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package mock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testStakeSource struct {
+	balances    map[basics.Address]uint64
+	circulation uint64
+	genesisHash crypto.Digest
+}
+
+func (s *testStakeSource) LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error) {
+	return basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: s.balances[addr]}}, 0, basics.MicroAlgos{}, nil
+}
+
+func (s *testStakeSource) OnlineCirculation(basics.Round, basics.Round) (basics.MicroAlgos, error) {
+	return basics.MicroAlgos{Raw: s.circulation}, nil
+}
+
+func (s *testStakeSource) GenesisHash() crypto.Digest {
+	return s.genesisHash
+}
+
+func TestOracleConfiguredAnswers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	o := New()
+	addr := basics.Address{1}
+	o.SetWeight(addr, 500)
+	o.SetDefaultWeight(7)
+	o.SetTotalWeight(10000)
+
+	w, err := o.Weight(10, addr, crypto.VRFVerifier{2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), w)
+
+	w, err = o.Weight(10, basics.Address{3}, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), w)
+
+	total, err := o.TotalWeight(10, 330)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10000), total)
+
+	require.NoError(t, o.Ping())
+	id, err := o.Identity()
+	require.NoError(t, err)
+	require.Equal(t, ledgercore.ExpectedWeightAlgorithmVersion, id.WeightAlgorithmVersion)
+	require.Equal(t, ledgercore.ExpectedWeightProtocolVersion, id.WeightProtocolVersion)
+
+	require.Equal(t, []Call{
+		{Method: MethodWeight, BalanceRound: 10, Address: addr, SelectionID: crypto.VRFVerifier{2}},
+		{Method: MethodWeight, BalanceRound: 10, Address: basics.Address{3}},
+		{Method: MethodTotalWeight, BalanceRound: 10, VoteRound: 330},
+		{Method: MethodPing},
+		{Method: MethodIdentity},
+	}, o.Calls())
+	require.Equal(t, 2, o.CallCount(MethodWeight))
+
+	o.ResetCalls()
+	require.Empty(t, o.Calls())
+}
+
+func TestOracleKeyWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	o := New()
+	addr := basics.Address{1}
+	o.SetWeight(addr, 500)
+	o.SetKeyWeight(addr, crypto.VRFVerifier{2}, 40)
+
+	// A key weight takes precedence for its selection ID only.
+	w, err := o.Weight(10, addr, crypto.VRFVerifier{2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), w)
+	w, err = o.Weight(10, addr, crypto.VRFVerifier{3})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), w)
+
+	// A round weight takes precedence at its balance round only.
+	o.SetRoundWeight(11, addr, 7)
+	w, err = o.Weight(11, addr, crypto.VRFVerifier{2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), w)
+	w, err = o.Weight(10, addr, crypto.VRFVerifier{2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), w)
+}
+
+func TestOracleErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	o := New()
+	o.SetDefaultWeight(1)
+	o.SetTotalWeight(1)
+	errWeight := &ledgercore.DaemonError{Code: "not_found", Msg: "unknown account"}
+	errOther := errors.New("daemon down")
+
+	o.SetWeightError(errWeight)
+	o.SetTotalWeightError(errOther)
+	o.SetPingError(errOther)
+	o.SetIdentityError(errOther)
+
+	_, err := o.Weight(1, basics.Address{}, crypto.VRFVerifier{})
+	require.ErrorIs(t, err, errWeight)
+	_, err = o.TotalWeight(1, 2)
+	require.ErrorIs(t, err, errOther)
+	require.ErrorIs(t, o.Ping(), errOther)
+	_, err = o.Identity()
+	require.ErrorIs(t, err, errOther)
+
+	o.SetWeightError(nil)
+	w, err := o.Weight(1, basics.Address{}, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), w)
+}
+
+func TestOracleLatency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	o := New()
+	o.SetLatency(50 * time.Millisecond)
+	start := time.Now()
+	require.NoError(t, o.Ping())
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestStakeOracle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rich, poor, pinned := basics.Address{1}, basics.Address{2}, basics.Address{3}
+	src := &testStakeSource{
+		balances:    map[basics.Address]uint64{rich: 1000, pinned: 2000},
+		circulation: 3000,
+		genesisHash: crypto.Digest{9},
+	}
+	o := NewStakeOracle(src)
+	o.SetWeight(pinned, 42)
+	o.SetTotalWeight(1) // ignored in favor of the online circulation
+
+	w, err := o.Weight(5, rich, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), w)
+
+	w, err = o.Weight(5, poor, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), w)
+
+	w, err = o.Weight(5, pinned, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), w)
+
+	total, err := o.TotalWeight(5, 325)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3000), total)
+
+	id, err := o.Identity()
+	require.NoError(t, err)
+	require.Equal(t, crypto.Digest{9}, id.GenesisHash)
+}
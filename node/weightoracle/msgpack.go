@@ -0,0 +1,477 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/algorand/go-codec/codec"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// A daemon that lists CapabilityMsgpack in its identity also accepts the
+// queries of msgpackEndpoints with a msgpack body, sent with the
+// msgpackContentType, and answers them in msgpack. The messages have the
+// fields of their JSON counterparts, under the same names, but addresses and
+// selection IDs are 32-byte binary strings and weights and rounds unsigned
+// integers. Error answers stay JSON. A msgpack /weight_table answer is its
+// entries, one map after another, so that it can be written and decoded an
+// entry at a time.
+//
+// The client keeps to JSON until an identity answer from a daemon lists the
+// capability, and goes back to it when one no longer does. Requests are
+// converted to and from their JSON protocol structs at the edges, as for
+// gRPC, so that answers are checked the same way in either encoding.
+
+const msgpackContentType = "application/msgpack"
+
+// msgpackEndpoints are the endpoints whose queries are sent in msgpack.
+var msgpackEndpoints = []string{"/weight", "/weights", "/total_weight", "/weight_table", "/subscribe"}
+
+// msgpackHandle decodes answers leniently, as json.Unmarshal does, and
+// msgpackStrictHandle rejects unknown fields, as decodeStrict does.
+var msgpackHandle, msgpackStrictHandle *codec.MsgpackHandle
+
+func init() {
+	msgpackHandle = newMsgpackHandle()
+	msgpackStrictHandle = newMsgpackHandle()
+	msgpackStrictHandle.ErrorIfNoField = true
+}
+
+func newMsgpackHandle() *codec.MsgpackHandle {
+	h := new(codec.MsgpackHandle)
+	h.Canonical = true
+	h.WriteExt = true
+	h.PositiveIntUnsigned = true
+	return h
+}
+
+func msgpackDecodeHandle(strict bool) *codec.MsgpackHandle {
+	if strict {
+		return msgpackStrictHandle
+	}
+	return msgpackHandle
+}
+
+// isMsgpack reports whether contentType names the msgpack media type.
+func isMsgpack(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == msgpackContentType
+}
+
+// hasCapability reports whether the identity answer lists capability.
+func (r *identityResponse) hasCapability(capability string) bool {
+	return slices.Contains(r.Capabilities, capability)
+}
+
+type mpWeightRequest struct {
+	Address      []byte `codec:"address"`
+	SelectionID  []byte `codec:"selection_id"`
+	BalanceRound uint64 `codec:"balance_round"`
+}
+
+type mpWeightResponse struct {
+	Weight *uint64 `codec:"weight,omitempty"`
+	Error  string  `codec:"error,omitempty"`
+	Code   string  `codec:"code,omitempty"`
+}
+
+type mpWeightsRequest struct {
+	BalanceRound uint64           `codec:"balance_round"`
+	Queries      []mpWeightsQuery `codec:"queries"`
+}
+
+type mpWeightsQuery struct {
+	Address     []byte `codec:"address"`
+	SelectionID []byte `codec:"selection_id"`
+}
+
+type mpWeightsResponse struct {
+	Weights []mpWeightResponse `codec:"weights"`
+	Error   string             `codec:"error,omitempty"`
+	Code    string             `codec:"code,omitempty"`
+}
+
+type mpTotalWeightRequest struct {
+	BalanceRound uint64 `codec:"balance_round"`
+	VoteRound    uint64 `codec:"vote_round"`
+}
+
+type mpTotalWeightResponse struct {
+	TotalWeight *uint64 `codec:"total_weight,omitempty"`
+	Error       string  `codec:"error,omitempty"`
+	Code        string  `codec:"code,omitempty"`
+}
+
+type mpWeightTableRequest struct {
+	BalanceRound uint64 `codec:"balance_round"`
+}
+
+type mpWeightTableEntry struct {
+	Address     []byte  `codec:"address"`
+	SelectionID []byte  `codec:"selection_id,omitempty"`
+	Weight      *uint64 `codec:"weight,omitempty"`
+}
+
+type mpSubscribeRequest struct {
+	From   uint64 `codec:"from"`
+	WaitMS uint64 `codec:"wait_ms"`
+}
+
+type mpSubscribeResponse struct {
+	BalanceRound *uint64              `codec:"balance_round,omitempty"`
+	Weights      []mpWeightTableEntry `codec:"weights,omitempty"`
+	Error        string               `codec:"error,omitempty"`
+	Code         string               `codec:"code,omitempty"`
+}
+
+// msgpackResult is the result of a request sent in msgpack. A msgpack answer
+// is decoded into msg and copied into result by fill; a JSON one, from a
+// daemon that answers in JSON regardless, is decoded into result directly.
+type msgpackResult struct {
+	result interface{}
+	msg    interface{}
+	fill   func() error
+}
+
+// decode decodes a successful answer of the given content type, with decode
+// for a JSON one.
+func (r *msgpackResult) decode(data []byte, contentType string, decode func([]byte, interface{}) error, strict bool) error {
+	if !isMsgpack(contentType) {
+		return decode(data, r.result)
+	}
+	if err := codec.NewDecoderBytes(data, msgpackDecodeHandle(strict)).Decode(r.msg); err != nil {
+		return err
+	}
+	return r.fill()
+}
+
+// encodeMsgpackCall returns the msgpack body of a JSON protocol request to
+// endpoint, and the result to decode its answer into: a *msgpackResult for
+// result, or result itself if it decodes msgpack answers on its own. It
+// returns a nil body for requests that are only sent in JSON.
+func encodeMsgpackCall(endpoint string, reqBody interface{}, result interface{}) (*requestBody, interface{}, error) {
+	if !slices.Contains(msgpackEndpoints, endpoint) {
+		return nil, nil, nil
+	}
+	req, mpResult, err := makeMsgpackCall(reqBody, result)
+	if err != nil || req == nil {
+		return nil, nil, err
+	}
+	body, err := encodeMsgpackRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return body, mpResult, nil
+}
+
+// makeMsgpackCall returns the msgpack message of a JSON protocol request, and
+// the result its answer is decoded into.
+func makeMsgpackCall(reqBody interface{}, result interface{}) (req interface{}, mpResult interface{}, err error) {
+	switch r := reqBody.(type) {
+	case weightRequest:
+		addr, sel, err := parseWeightKey(r.Address, r.SelectionID)
+		if err != nil {
+			return nil, nil, err
+		}
+		balanceRound, err := parseDecimal("balance_round", r.BalanceRound)
+		if err != nil {
+			return nil, nil, err
+		}
+		res := result.(*weightResponse)
+		m := &mpWeightResponse{}
+		return mpWeightRequest{Address: addr[:], SelectionID: sel[:], BalanceRound: balanceRound}, &msgpackResult{result, m, func() error {
+			*res = m.answer()
+			return nil
+		}}, nil
+
+	case weightsRequest:
+		q := mpWeightsRequest{Queries: make([]mpWeightsQuery, len(r.Queries))}
+		if q.BalanceRound, err = parseDecimal("balance_round", r.BalanceRound); err != nil {
+			return nil, nil, err
+		}
+		for i, wq := range r.Queries {
+			addr, sel, err := parseWeightKey(wq.Address, wq.SelectionID)
+			if err != nil {
+				return nil, nil, err
+			}
+			q.Queries[i] = mpWeightsQuery{Address: addr[:], SelectionID: sel[:]}
+		}
+		res := result.(*weightsResponse)
+		m := &mpWeightsResponse{}
+		return q, &msgpackResult{result, m, func() error {
+			*res = weightsResponse{Error: m.Error, Code: m.Code}
+			if m.Weights != nil {
+				res.Weights = make([]weightResponse, len(m.Weights))
+				for i := range m.Weights {
+					res.Weights[i] = m.Weights[i].answer()
+				}
+			}
+			return nil
+		}}, nil
+
+	case totalWeightRequest:
+		var q mpTotalWeightRequest
+		if q.BalanceRound, err = parseDecimal("balance_round", r.BalanceRound); err != nil {
+			return nil, nil, err
+		}
+		if q.VoteRound, err = parseDecimal("vote_round", r.VoteRound); err != nil {
+			return nil, nil, err
+		}
+		res := result.(*totalWeightResponse)
+		m := &mpTotalWeightResponse{}
+		return q, &msgpackResult{result, m, func() error {
+			*res = totalWeightResponse{TotalWeight: formatOptional(m.TotalWeight), Error: m.Error, Code: m.Code}
+			return nil
+		}}, nil
+
+	case weightTableRequest:
+		var q mpWeightTableRequest
+		if q.BalanceRound, err = parseDecimal("balance_round", r.BalanceRound); err != nil {
+			return nil, nil, err
+		}
+		// The decoder reads msgpack tables itself, an entry at a time.
+		return q, result, nil
+
+	case subscribeRequest:
+		var q mpSubscribeRequest
+		if q.From, err = parseDecimal("from", r.From); err != nil {
+			return nil, nil, err
+		}
+		if q.WaitMS, err = parseDecimal("wait_ms", r.WaitMS); err != nil {
+			return nil, nil, err
+		}
+		res := result.(*subscribeResponse)
+		m := &mpSubscribeResponse{}
+		return q, &msgpackResult{result, m, func() error {
+			*res = subscribeResponse{BalanceRound: formatOptional(m.BalanceRound), Error: m.Error, Code: m.Code}
+			if m.Weights != nil {
+				res.Weights = make([]weightTableEntry, len(m.Weights))
+				for i := range m.Weights {
+					e, err := m.Weights[i].convert(i)
+					if err != nil {
+						return err
+					}
+					res.Weights[i] = makeWeightTableEntry(e)
+				}
+			}
+			return nil
+		}}, nil
+	}
+	return nil, nil, nil
+}
+
+// answer returns the JSON protocol form of a weight answer.
+func (m *mpWeightResponse) answer() weightResponse {
+	return weightResponse{Weight: formatOptional(m.Weight), Error: m.Error, Code: m.Code}
+}
+
+// formatOptional formats a number that may be missing from an answer, which
+// the JSON protocol leaves empty.
+func formatOptional(v *uint64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatUint(*v, 10)
+}
+
+// convert checks the i'th entry of a msgpack weight table and converts it, as
+// weightTableEntry.convert does a JSON one.
+func (e *mpWeightTableEntry) convert(i int) (ledgercore.WeightTableEntry, error) {
+	var entry ledgercore.WeightTableEntry
+	if len(e.Address) != len(entry.Addr) {
+		return entry, fmt.Errorf("invalid address length in weight_table entry %d: expected %d bytes, got %d", i, len(entry.Addr), len(e.Address))
+	}
+	copy(entry.Addr[:], e.Address)
+	if len(e.SelectionID) != 0 {
+		if len(e.SelectionID) != len(entry.SelectionID) {
+			return entry, fmt.Errorf("invalid selection_id length in weight_table entry %d: expected %d bytes, got %d",
+				i, len(entry.SelectionID), len(e.SelectionID))
+		}
+		copy(entry.SelectionID[:], e.SelectionID)
+	}
+	if e.Weight == nil {
+		return entry, fmt.Errorf("missing weight field in weight_table entry %d", i)
+	}
+	entry.Weight = *e.Weight
+	return entry, nil
+}
+
+// makeMsgpackTableEntry returns the msgpack form of e.
+func makeMsgpackTableEntry(e ledgercore.WeightTableEntry) mpWeightTableEntry {
+	entry := mpWeightTableEntry{Address: e.Addr[:], Weight: &e.Weight}
+	if e.SelectionID != (crypto.VRFVerifier{}) {
+		entry.SelectionID = e.SelectionID[:]
+	}
+	return entry
+}
+
+// decodeMsgpack decodes a msgpack /weight_table answer, whose entries follow
+// one another to the end of the body.
+func (d *weightTableDecoder) decodeMsgpack(r io.Reader, strict bool) error {
+	d.found = true
+	dec := codec.NewDecoder(r, msgpackDecodeHandle(strict))
+	for {
+		var e mpWeightTableEntry
+		err := dec.Decode(&e)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		i := d.entries
+		d.entries++
+		entry, err := e.convert(i)
+		if err != nil {
+			return err
+		}
+		if err := d.visitEntry(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// decodeMsgpackRequest decodes the msgpack body of a request into req, its
+// JSON protocol form, for the handler to check as it does a JSON request.
+func decodeMsgpackRequest(body io.Reader, req interface{}) error {
+	dec := codec.NewDecoder(body, msgpackStrictHandle)
+	switch r := req.(type) {
+	case *weightRequest:
+		var m mpWeightRequest
+		if err := dec.Decode(&m); err != nil {
+			return err
+		}
+		*r = weightRequest{
+			Address:      addressText(m.Address),
+			SelectionID:  hex.EncodeToString(m.SelectionID),
+			BalanceRound: strconv.FormatUint(m.BalanceRound, 10),
+		}
+	case *weightsRequest:
+		var m mpWeightsRequest
+		if err := dec.Decode(&m); err != nil {
+			return err
+		}
+		*r = weightsRequest{
+			BalanceRound: strconv.FormatUint(m.BalanceRound, 10),
+			Queries:      make([]weightsQuery, len(m.Queries)),
+		}
+		for i, q := range m.Queries {
+			r.Queries[i] = weightsQuery{Address: addressText(q.Address), SelectionID: hex.EncodeToString(q.SelectionID)}
+		}
+	case *totalWeightRequest:
+		var m mpTotalWeightRequest
+		if err := dec.Decode(&m); err != nil {
+			return err
+		}
+		*r = totalWeightRequest{
+			BalanceRound: strconv.FormatUint(m.BalanceRound, 10),
+			VoteRound:    strconv.FormatUint(m.VoteRound, 10),
+		}
+	case *weightTableRequest:
+		var m mpWeightTableRequest
+		if err := dec.Decode(&m); err != nil {
+			return err
+		}
+		*r = weightTableRequest{BalanceRound: strconv.FormatUint(m.BalanceRound, 10)}
+	case *subscribeRequest:
+		var m mpSubscribeRequest
+		if err := dec.Decode(&m); err != nil {
+			return err
+		}
+		*r = subscribeRequest{From: strconv.FormatUint(m.From, 10), WaitMS: strconv.FormatUint(m.WaitMS, 10)}
+	default:
+		return fmt.Errorf("%T is not sent in msgpack", req)
+	}
+	return nil
+}
+
+// addressText returns the text form of a binary address, or text that fails
+// to parse as one if it has the wrong length.
+func addressText(b []byte) string {
+	var addr basics.Address
+	if len(b) != len(addr) {
+		return hex.EncodeToString(b)
+	}
+	copy(addr[:], b)
+	return addr.String()
+}
+
+// writeAnswer answers a request with resp, one of the JSON protocol answers,
+// in msgpack if the request was sent in msgpack.
+func writeAnswer(w http.ResponseWriter, r *http.Request, resp interface{}) {
+	if !isMsgpack(r.Header.Get("Content-Type")) {
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+	var m interface{}
+	switch resp := resp.(type) {
+	case weightResponse:
+		m = msgpackWeightAnswer(resp)
+	case weightsResponse:
+		answers := make([]mpWeightResponse, len(resp.Weights))
+		for i := range resp.Weights {
+			answers[i] = msgpackWeightAnswer(resp.Weights[i])
+		}
+		m = mpWeightsResponse{Weights: answers}
+	case totalWeightResponse:
+		total, _ := strconv.ParseUint(resp.TotalWeight, 10, 64)
+		m = mpTotalWeightResponse{TotalWeight: &total}
+	default:
+		writeError(w, fmt.Errorf("%T is not answered in msgpack", resp))
+		return
+	}
+	writeMsgpack(w, m)
+}
+
+// msgpackWeightAnswer returns the msgpack form of a weight answer written by
+// the handler.
+func msgpackWeightAnswer(resp weightResponse) mpWeightResponse {
+	if resp.Error != "" {
+		return mpWeightResponse{Error: resp.Error, Code: resp.Code}
+	}
+	weight, _ := strconv.ParseUint(resp.Weight, 10, 64)
+	return mpWeightResponse{Weight: &weight}
+}
+
+// writeMsgpackTable answers a /weight_table request sent in msgpack with
+// table, encoding each entry as it is written.
+func writeMsgpackTable(w http.ResponseWriter, table []ledgercore.WeightTableEntry) {
+	w.Header().Set("Content-Type", msgpackContentType)
+	w.WriteHeader(http.StatusOK)
+	enc := codec.NewEncoder(w, msgpackHandle)
+	for _, e := range table {
+		if err := enc.Encode(makeMsgpackTableEntry(e)); err != nil {
+			return
+		}
+	}
+}
+
+func writeMsgpack(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", msgpackContentType)
+	w.WriteHeader(http.StatusOK)
+	codec.NewEncoder(w, msgpackHandle).Encode(body)
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// contentTypeRecorder records the Content-Type of each request it carries,
+// by endpoint.
+type contentTypeRecorder struct {
+	mu    sync.Mutex
+	types map[string]string
+}
+
+func (r *contentTypeRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.types[req.URL.Path] = req.Header.Get("Content-Type")
+	r.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (r *contentTypeRecorder) contentType(endpoint string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.types[endpoint]
+}
+
+func msgpackTestClient(u *url.URL, msgpack bool) (*Client, *contentTypeRecorder) {
+	recorder := &contentTypeRecorder{types: make(map[string]string)}
+	cfg := testClientConfig()
+	cfg.Transport = recorder
+	cfg.Msgpack = msgpack
+	return NewClientWithConfig(u, cfg), recorder
+}
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	oracle := newPublishingOracle()
+	oracle.SetKeyWeight(addr, crypto.VRFVerifier{1}, 700)
+	oracle.SetTotalWeight(5000)
+	oracle.publish(10, testTable(addr, 700))
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client, recorder := msgpackTestClient(server.URL(), true)
+
+	// Until the daemon's identity is known, requests are JSON.
+	w, err := client.Weight(9, addr, crypto.VRFVerifier{1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), w)
+	require.Equal(t, "application/json", recorder.contentType("/weight"))
+
+	_, err = client.Identity()
+	require.NoError(t, err)
+	w, err = client.Weight(10, addr, crypto.VRFVerifier{1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), w)
+	require.Equal(t, msgpackContentType, recorder.contentType("/weight"))
+
+	results, err := client.Weights(context.Background(), 11, []ledgercore.WeightQuery{
+		{Addr: addr, SelectionID: crypto.VRFVerifier{1}},
+		{Addr: makeTestAddress(2), SelectionID: crypto.VRFVerifier{2}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, msgpackContentType, recorder.contentType("/weights"))
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Err)
+	require.Equal(t, uint64(700), results[0].Weight)
+
+	total, err := client.TotalWeight(10, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), total)
+	require.Equal(t, msgpackContentType, recorder.contentType("/total_weight"))
+
+	table, err := client.WeightTable(10)
+	require.NoError(t, err)
+	require.Equal(t, testTable(addr, 700), table)
+	require.Equal(t, msgpackContentType, recorder.contentType("/weight_table"))
+
+	rnd, cached, err := client.NextWeightTable(context.Background(), 5, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(10), rnd)
+	require.Equal(t, 1, cached)
+	require.Equal(t, msgpackContentType, recorder.contentType("/subscribe"))
+	_, _, err = client.NextWeightTable(context.Background(), 11, 50*time.Millisecond)
+	require.ErrorIs(t, err, ErrNoNewWeightTable)
+
+	// Errors are answered the same way in either encoding.
+	_, err = client.WeightTable(11)
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	oracle.SetTotalWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "no total"})
+	_, err = client.TotalWeight(12, 20)
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+
+	// Ping stays JSON.
+	require.NoError(t, client.Ping())
+	require.Equal(t, "application/json", recorder.contentType("/ping"))
+}
+
+func TestMsgpackDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server, err := StartServer("127.0.0.1:0", mock.New())
+	require.NoError(t, err)
+	defer server.Close()
+	client, recorder := msgpackTestClient(server.URL(), false)
+
+	_, err = client.Identity()
+	require.NoError(t, err)
+	_, err = client.TotalWeight(10, 20)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", recorder.contentType("/total_weight"))
+}
+
+func TestMsgpackFollowsCapabilities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// The daemon lists the capability only while advertise is set.
+	var advertise atomic.Bool
+	handler := NewHandler(mock.New())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity" || advertise.Load() {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		var resp identityResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		resp.Capabilities = nil
+		writeJSON(w, rec.Code, resp)
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, recorder := msgpackTestClient(u, true)
+
+	_, err = client.Identity()
+	require.NoError(t, err)
+	_, err = client.TotalWeight(10, 20)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", recorder.contentType("/total_weight"))
+
+	advertise.Store(true)
+	_, err = client.Identity()
+	require.NoError(t, err)
+	_, err = client.TotalWeight(10, 21)
+	require.NoError(t, err)
+	require.Equal(t, msgpackContentType, recorder.contentType("/total_weight"))
+
+	// A daemon replaced by one without msgpack is spoken to in JSON again
+	// once its identity is checked.
+	advertise.Store(false)
+	_, err = client.Identity()
+	require.NoError(t, err)
+	_, err = client.TotalWeight(10, 22)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", recorder.contentType("/total_weight"))
+}
+
+func TestMsgpackInvalidRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server, err := StartServer("127.0.0.1:0", mock.New())
+	require.NoError(t, err)
+	defer server.Close()
+
+	post := func(endpoint string, body interface{}) (int, string) {
+		var buf bytes.Buffer
+		require.NoError(t, codec.NewEncoder(&buf, msgpackHandle).Encode(body))
+		resp, err := http.Post(server.URL().String()+endpoint, msgpackContentType, &buf)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var answer struct {
+			Code string `json:"code"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&answer))
+		return resp.StatusCode, answer.Code
+	}
+
+	addr := makeTestAddress(1)
+	status, code := post("/weight", mpWeightRequest{Address: addr[:5], SelectionID: make([]byte, 32), BalanceRound: 1})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, "bad_request", code)
+	status, code = post("/weight", mpWeightRequest{Address: addr[:], SelectionID: make([]byte, 31), BalanceRound: 1})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, "bad_request", code)
+	status, code = post("/weight", mpTotalWeightRequest{BalanceRound: 1, VoteRound: 2})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, "bad_request", code)
+	// Only the queries of msgpackEndpoints are accepted in msgpack.
+	status, code = post("/ping", struct{}{})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, "bad_request", code)
+}
+
+func TestMsgpackTableEntryConvert(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	weight := uint64(5)
+	e, err := (&mpWeightTableEntry{Address: addr[:], Weight: &weight}).convert(0)
+	require.NoError(t, err)
+	require.Equal(t, ledgercore.WeightTableEntry{Addr: addr, Weight: 5}, e)
+
+	for _, bad := range []mpWeightTableEntry{
+		{Address: addr[:31], Weight: &weight},
+		{Address: addr[:], SelectionID: []byte{1}, Weight: &weight},
+		{Address: addr[:]},
+	} {
+		_, err := bad.convert(3)
+		require.ErrorContains(t, err, "entry 3")
+	}
+}
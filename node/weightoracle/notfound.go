@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var weightOracleNotFoundHits = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_not_found_cache_hits_total", Description: "number of weight lookups answered with a cached not_found error instead of asking the weight daemon"})
+
+// notFoundAnswer is a not_found answer to a weight lookup, and when it stops
+// being used.
+type notFoundAnswer struct {
+	err     ledgercore.DaemonError
+	expires time.Time
+}
+
+// notFoundCache remembers, for a while, the weight lookups the daemon
+// answered with not_found, so that votes from accounts it does not know cost
+// one request per account and TTL however many of them arrive. Answers are
+// not kept longer, as a daemon that is behind may know the account later.
+type notFoundCache struct {
+	ttl     time.Duration
+	answers *lruCache[weightCacheKey, notFoundAnswer]
+}
+
+// newNotFoundCache returns a cache of up to capacity not_found answers kept
+// for ttl, or nil if ttl is not positive. A nil cache remembers nothing.
+func newNotFoundCache(capacity int, ttl time.Duration) *notFoundCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &notFoundCache{ttl: ttl, answers: newLRUCache[weightCacheKey, notFoundAnswer](capacity)}
+}
+
+// get returns the not_found error cached for key at now, or nil.
+func (n *notFoundCache) get(key weightCacheKey, now time.Time) error {
+	if n == nil {
+		return nil
+	}
+	answer, ok := n.answers.Get(key)
+	if !ok || !now.Before(answer.expires) {
+		return nil
+	}
+	weightOracleNotFoundHits.Inc(nil)
+	err := answer.err
+	return &err
+}
+
+// put caches err as the answer for key at now, if it is a not_found
+// DaemonError.
+func (n *notFoundCache) put(key weightCacheKey, err error, now time.Time) {
+	if n == nil {
+		return
+	}
+	var de *ledgercore.DaemonError
+	if !errors.As(err, &de) || de.Code != "not_found" {
+		return
+	}
+	n.answers.Put(key, notFoundAnswer{err: *de, expires: now.Add(n.ttl)})
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestWeightNotFoundCached checks that a not_found answer is reused for the
+// same query until the TTL passes, and that other errors are not.
+func TestWeightNotFoundCached(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var requests atomic.Int32
+	var code atomic.Value
+	code.Store("not_found")
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		requests.Add(1)
+		return map[string]interface{}{"error": "account not found", "code": code.Load()}
+	})
+	defer server.Close()
+
+	cfg := DefaultClientConfig()
+	cfg.NotFoundTTL = time.Second
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+	now := time.Unix(1000, 0)
+	client.now = func() time.Time { return now }
+
+	for range 3 {
+		_, err := client.Weight(100, makeTestAddress(1), makeTestSelectionID(1))
+		require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	}
+	require.EqualValues(t, 1, requests.Load())
+
+	// Other accounts and rounds are asked about.
+	_, err := client.Weight(101, makeTestAddress(1), makeTestSelectionID(1))
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	require.EqualValues(t, 2, requests.Load())
+
+	// Once the TTL has passed the daemon is asked again, and its other
+	// errors are not cached.
+	now = now.Add(time.Second)
+	code.Store("internal")
+	for range 2 {
+		_, err = client.Weight(100, makeTestAddress(1), makeTestSelectionID(1))
+		require.True(t, ledgercore.IsDaemonError(err, "internal"), "%v", err)
+	}
+	require.EqualValues(t, 4, requests.Load())
+}
+
+// TestWeightsNotFoundCached checks that Weights caches the not_found answers
+// of a batch and serves them without asking again.
+func TestWeightsNotFoundCached(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var batches atomic.Int32
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		batches.Add(1)
+		return answerWeights(req, 2)
+	})
+	defer server.Close()
+
+	cfg := DefaultClientConfig()
+	cfg.NotFoundTTL = time.Minute
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+
+	queries := makeTestQueries(3)
+	for range 2 {
+		results, err := client.Weights(context.Background(), 100, queries)
+		require.NoError(t, err)
+		require.True(t, ledgercore.IsDaemonError(results[1].Err, "not_found"), "%v", results[1].Err)
+		require.Equal(t, uint64(30), results[2].Weight)
+	}
+	require.EqualValues(t, 1, batches.Load())
+
+	_, err := client.Weight(100, queries[1].Addr, queries[1].SelectionID)
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	require.EqualValues(t, 1, batches.Load())
+}
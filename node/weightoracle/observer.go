@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// Observer receives the events of a Client's dealings with its daemon, so
+// that operators can feed them to their own monitoring or policy engines.
+// Events are passed by value and observers cannot change what the client
+// does. They are delivered on the goroutine that caused them, which may be
+// agreement's, so an Observer must return quickly and must not call back
+// into the client.
+type Observer interface {
+	// OnQuery is called once a request to the daemon completes, with the
+	// record RecentQueries keeps of it.
+	OnQuery(QueryRecord)
+
+	// OnCache is called for every weight and total weight lookup, whether
+	// or not the cache answered it.
+	OnCache(CacheDecision)
+
+	// OnHealth is called when health checks find that the daemon stopped
+	// answering, or answers again.
+	OnHealth(HealthTransition)
+}
+
+// CacheDecision describes a lookup the client tried to answer from cache.
+type CacheDecision struct {
+	// Endpoint is the request a miss leads to, "/weight" or "/total_weight".
+	Endpoint     string
+	BalanceRound basics.Round
+	Hit          bool
+}
+
+// HealthTransition is a change in the outcome of the daemon's health checks.
+type HealthTransition struct {
+	Time    time.Time
+	Healthy bool
+
+	// Error is the failed check's error, empty when Healthy.
+	Error string
+}
+
+// observerList holds the registered observers. Registration replaces the
+// list rather than changing it, so that reporting events takes no lock.
+type observerList struct {
+	mu   deadlock.Mutex
+	list atomic.Pointer[[]Observer]
+}
+
+// AddObserver registers o to receive the client's events from now on.
+func (c *Client) AddObserver(o Observer) {
+	c.observers.mu.Lock()
+	defer c.observers.mu.Unlock()
+	list := append(slices.Clone(c.observers.observing()), o)
+	c.observers.list.Store(&list)
+}
+
+// observing returns the registered observers.
+func (l *observerList) observing() []Observer {
+	if list := l.list.Load(); list != nil {
+		return *list
+	}
+	return nil
+}
+
+func (c *Client) observeQuery(r QueryRecord) {
+	for _, o := range c.observers.observing() {
+		o.OnQuery(r)
+	}
+}
+
+func (c *Client) observeCache(endpoint string, balanceRound basics.Round, hit bool) {
+	observeCacheLookup(endpoint, hit)
+	for _, o := range c.observers.observing() {
+		o.OnCache(CacheDecision{Endpoint: endpoint, BalanceRound: balanceRound, Hit: hit})
+	}
+}
+
+// ReportHealth passes a change in the daemon's health, as found by the
+// caller's health checks, to the registered observers.
+func (c *Client) ReportHealth(t HealthTransition) {
+	for _, o := range c.observers.observing() {
+		o.OnHealth(t)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// recordingObserver keeps every event it is given.
+type recordingObserver struct {
+	mu      deadlock.Mutex
+	queries []QueryRecord
+	cache   []CacheDecision
+	health  []HealthTransition
+}
+
+func (o *recordingObserver) OnQuery(r QueryRecord) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.queries = append(o.queries, r)
+}
+
+func (o *recordingObserver) OnCache(d CacheDecision) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.cache = append(o.cache, d)
+}
+
+func (o *recordingObserver) OnHealth(t HealthTransition) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.health = append(o.health, t)
+}
+
+func TestObserverEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	oracle.SetTotalWeight(100)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+	first, second := &recordingObserver{}, &recordingObserver{}
+	client.AddObserver(first)
+	client.AddObserver(second)
+
+	addr := basics.Address{1}
+	for range 2 {
+		_, err = client.Weight(10, addr, crypto.VRFVerifier{})
+		require.NoError(t, err)
+		_, err = client.TotalWeight(10, 330)
+		require.NoError(t, err)
+	}
+	now := time.Now()
+	client.ReportHealth(HealthTransition{Time: now, Error: "connection refused"})
+
+	for _, o := range []*recordingObserver{first, second} {
+		require.Len(t, o.queries, 2)
+		require.Equal(t, "/weight", o.queries[0].Endpoint)
+		require.Equal(t, "/total_weight", o.queries[1].Endpoint)
+		require.Empty(t, o.queries[0].Error)
+		require.Equal(t, []CacheDecision{
+			{Endpoint: "/weight", BalanceRound: 10},
+			{Endpoint: "/total_weight", BalanceRound: 10},
+			{Endpoint: "/weight", BalanceRound: 10, Hit: true},
+			{Endpoint: "/total_weight", BalanceRound: 10, Hit: true},
+		}, o.cache)
+		require.Equal(t, []HealthTransition{{Time: now, Error: "connection refused"}}, o.health)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// overrideSigningPrefix separates override signatures from anything else the
+// signing key may sign, such as transactions.
+const overrideSigningPrefix = "WeightOverride"
+
+// OverrideBody is the signed content of an override file: the weights that
+// replace the daemon's answers for some accounts until Expires. It is meant
+// for incidents the daemon cannot be redeployed for quickly enough, such as
+// zeroing the weight of an account whose keys are compromised.
+type OverrideBody struct {
+	// GenesisHash is the base64 genesis hash of the network the overrides
+	// are for.
+	GenesisHash string `json:"genesis_hash"`
+
+	// Reason is logged whenever the overrides are in force.
+	Reason string `json:"reason"`
+
+	Expires time.Time `json:"expires"`
+
+	// EffectiveRound is the decimal balance round from which the overrides
+	// apply. Weights at earlier balance rounds remain the daemon's, so that
+	// certificates of blocks agreed before the incident still verify.
+	EffectiveRound string `json:"effective_round"`
+
+	// Weights maps account addresses to the decimal weights that replace
+	// the daemon's at balance rounds from EffectiveRound on. A weight of
+	// zero excludes the account from committees.
+	Weights map[string]string `json:"weights"`
+}
+
+// SignedOverride is the format of an override file. The signature covers the
+// compact JSON encoding of Body, so the file may be reformatted but not
+// otherwise changed.
+type SignedOverride struct {
+	Body      json.RawMessage `json:"body"`
+	Signer    string          `json:"signer"`
+	Signature string          `json:"signature"`
+}
+
+// SignOverride signs body, the JSON encoding of an OverrideBody, with secrets.
+func SignOverride(body []byte, secrets *crypto.SignatureSecrets) (SignedOverride, error) {
+	message, err := overrideMessage(body)
+	if err != nil {
+		return SignedOverride{}, err
+	}
+	sig := secrets.SignBytes(message)
+	return SignedOverride{
+		Body:      body,
+		Signer:    basics.Address(secrets.SignatureVerifier).String(),
+		Signature: base64.StdEncoding.EncodeToString(sig[:]),
+	}, nil
+}
+
+// overrideMessage returns the bytes an override signature covers.
+func overrideMessage(body []byte) ([]byte, error) {
+	message := bytes.NewBufferString(overrideSigningPrefix)
+	if err := json.Compact(message, body); err != nil {
+		return nil, fmt.Errorf("weight override body is not valid JSON: %w", err)
+	}
+	return message.Bytes(), nil
+}
+
+// Overrides are the weights of a verified override file.
+type Overrides struct {
+	Reason         string
+	Signer         basics.Address
+	Expires        time.Time
+	EffectiveRound basics.Round
+
+	weights map[basics.Address]uint64
+}
+
+// Len returns the number of accounts overridden.
+func (o *Overrides) Len() int {
+	return len(o.weights)
+}
+
+// Expired reports whether the overrides no longer apply at now.
+func (o *Overrides) Expired(now time.Time) bool {
+	return !now.Before(o.Expires)
+}
+
+// weight returns the weight that replaces the daemon's for addr at
+// balanceRound, if any, at now.
+func (o *Overrides) weight(balanceRound basics.Round, addr basics.Address, now time.Time) (uint64, bool) {
+	if balanceRound < o.EffectiveRound || o.Expired(now) {
+		return 0, false
+	}
+	weight, ok := o.weights[addr]
+	return weight, ok
+}
+
+// LoadOverrides reads the override file at path and checks that it is signed
+// by one of signers, given as addresses, and meant for the network of
+// genesisHash. Expired overrides are returned too; the caller decides what to
+// do with them.
+func LoadOverrides(path string, signers []string, genesisHash crypto.Digest) (*Overrides, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var signed SignedOverride
+	if err := json.Unmarshal(data, &signed); err != nil {
+		return nil, fmt.Errorf("cannot parse weight override file %s: %w", path, err)
+	}
+	return verifyOverrides(signed, signers, genesisHash)
+}
+
+func verifyOverrides(signed SignedOverride, signers []string, genesisHash crypto.Digest) (*Overrides, error) {
+	trusted := make([]basics.Address, len(signers))
+	for i, s := range signers {
+		addr, err := basics.UnmarshalChecksumAddress(s)
+		if err != nil {
+			return nil, fmt.Errorf("ExternalWeightOracleOverrideSigners entry %q: %w", s, err)
+		}
+		trusted[i] = addr
+	}
+	signer, err := basics.UnmarshalChecksumAddress(signed.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("weight override signer %q: %w", signed.Signer, err)
+	}
+	if !slices.Contains(trusted, signer) {
+		return nil, fmt.Errorf("weight override signer %s is not listed in ExternalWeightOracleOverrideSigners", signer)
+	}
+	var sig crypto.Signature
+	sigBytes, err := base64.StdEncoding.DecodeString(signed.Signature)
+	if err != nil || len(sigBytes) != len(sig) {
+		return nil, fmt.Errorf("weight override signature is not a base64 ed25519 signature")
+	}
+	copy(sig[:], sigBytes)
+	message, err := overrideMessage(signed.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !crypto.SignatureVerifier(signer).VerifyBytes(message, sig) {
+		return nil, fmt.Errorf("weight override signature does not match its body and signer %s", signer)
+	}
+
+	var body OverrideBody
+	if err := json.Unmarshal(signed.Body, &body); err != nil {
+		return nil, fmt.Errorf("cannot parse weight override body: %w", err)
+	}
+	if body.GenesisHash != base64.StdEncoding.EncodeToString(genesisHash[:]) {
+		return nil, fmt.Errorf("weight override is for genesis %s, not this network's", body.GenesisHash)
+	}
+	if body.Expires.IsZero() {
+		return nil, fmt.Errorf("weight override has no expiry")
+	}
+	if body.EffectiveRound == "" {
+		return nil, fmt.Errorf("weight override has no effective round")
+	}
+	effectiveRound, err := parseDecimal("effective round", body.EffectiveRound)
+	if err != nil {
+		return nil, fmt.Errorf("weight override: %w", err)
+	}
+	o := &Overrides{
+		Reason:         body.Reason,
+		Signer:         signer,
+		Expires:        body.Expires,
+		EffectiveRound: basics.Round(effectiveRound),
+		weights:        make(map[basics.Address]uint64, len(body.Weights)),
+	}
+	for a, w := range body.Weights {
+		addr, err := basics.UnmarshalChecksumAddress(a)
+		if err != nil {
+			return nil, fmt.Errorf("weight override account %q: %w", a, err)
+		}
+		o.weights[addr], err = parseDecimal("weight", w)
+		if err != nil {
+			return nil, fmt.Errorf("weight override account %s: %w", a, err)
+		}
+	}
+	return o, nil
+}
+
+// SetOverrides makes the weights of o replace the daemon's answers, in Weight
+// and Weights, at balance rounds from o.EffectiveRound on until o expires; nil
+// removes them. Overridden weights are neither cached nor bounds-checked, and
+// total weights are not adjusted. Accounts overridden to zero are answered
+// with ledgercore.ErrWeightExcluded rather than a weight, so that agreement
+// rejects their votes instead of taking the zero for a daemon fault.
+func (c *Client) SetOverrides(o *Overrides) {
+	c.overrides.Store(o)
+}
+
+// overriddenWeight returns the weight that replaces the daemon's for addr at
+// balanceRound, if any, or ledgercore.ErrWeightExcluded if that weight is
+// zero.
+func (c *Client) overriddenWeight(balanceRound basics.Round, addr basics.Address) (uint64, bool, error) {
+	o := c.overrides.Load()
+	if o == nil {
+		return 0, false, nil
+	}
+	weight, ok := o.weight(balanceRound, addr, c.now())
+	if ok && weight == 0 {
+		return 0, true, ledgercore.ErrWeightExcluded
+	}
+	return weight, ok, nil
+}
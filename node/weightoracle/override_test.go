@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// writeOverride signs body with key and writes it, indented, to a file in dir.
+func writeOverride(t *testing.T, dir string, key *crypto.SignatureSecrets, body OverrideBody) string {
+	t.Helper()
+	bodyBytes, err := json.Marshal(body)
+	require.NoError(t, err)
+	signed, err := SignOverride(bodyBytes, key)
+	require.NoError(t, err)
+	data, err := json.MarshalIndent(signed, "", "  ")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "override.json")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	return path
+}
+
+func TestLoadOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisHash := crypto.Digest{7}
+	key := crypto.GenerateSignatureSecrets(crypto.Seed{1})
+	other := crypto.GenerateSignatureSecrets(crypto.Seed{2})
+	signer := basics.Address(key.SignatureVerifier).String()
+	account := basics.Address{3}
+	body := OverrideBody{
+		GenesisHash:    base64.StdEncoding.EncodeToString(genesisHash[:]),
+		Reason:         "account 3 keys leaked",
+		Expires:        time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+		EffectiveRound: "100",
+		Weights:        map[string]string{account.String(): "0"},
+	}
+
+	path := writeOverride(t, t.TempDir(), key, body)
+	o, err := LoadOverrides(path, []string{basics.Address(other.SignatureVerifier).String(), signer}, genesisHash)
+	require.NoError(t, err)
+	require.Equal(t, 1, o.Len())
+	require.Equal(t, body.Reason, o.Reason)
+	require.Equal(t, body.Expires, o.Expires)
+	require.Equal(t, basics.Round(100), o.EffectiveRound)
+	require.False(t, o.Expired(time.Now()))
+	weight, ok := o.weight(100, account, time.Now())
+	require.True(t, ok)
+	require.Zero(t, weight)
+	// Earlier balance rounds keep the daemon's weights.
+	_, ok = o.weight(99, account, time.Now())
+	require.False(t, ok)
+	_, ok = o.weight(100, account, o.Expires)
+	require.False(t, ok)
+
+	_, err = LoadOverrides(path, []string{basics.Address(other.SignatureVerifier).String()}, genesisHash)
+	require.ErrorContains(t, err, "not listed in ExternalWeightOracleOverrideSigners")
+
+	_, err = LoadOverrides(path, []string{signer}, crypto.Digest{8})
+	require.ErrorContains(t, err, "not this network's")
+
+	// Signed by a key the body does not name as signer.
+	forged := writeOverride(t, t.TempDir(), other, body)
+	data, err := os.ReadFile(forged)
+	require.NoError(t, err)
+	var signed SignedOverride
+	require.NoError(t, json.Unmarshal(data, &signed))
+	signed.Signer = signer
+	_, err = verifyOverrides(signed, []string{signer}, genesisHash)
+	require.ErrorContains(t, err, "signature does not match")
+
+	// Altered after signing.
+	body.Weights[account.String()] = "1000"
+	altered, err := json.Marshal(body)
+	require.NoError(t, err)
+	original := writeOverride(t, t.TempDir(), key, OverrideBody{})
+	data, err = os.ReadFile(original)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &signed))
+	signed.Body = altered
+	_, err = verifyOverrides(signed, []string{signer}, genesisHash)
+	require.ErrorContains(t, err, "signature does not match")
+
+	body.Expires = time.Now().Add(-time.Minute)
+	o, err = LoadOverrides(writeOverride(t, t.TempDir(), key, body), []string{signer}, genesisHash)
+	require.NoError(t, err)
+	require.True(t, o.Expired(time.Now()))
+	_, ok = o.weight(100, account, time.Now())
+	require.False(t, ok)
+
+	body.EffectiveRound = ""
+	_, err = LoadOverrides(writeOverride(t, t.TempDir(), key, body), []string{signer}, genesisHash)
+	require.ErrorContains(t, err, "no effective round")
+}
+
+func TestClientOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+	now := time.Now()
+	client.now = func() time.Time { return now }
+	zeroed, raised, other := basics.Address{1}, basics.Address{2}, basics.Address{3}
+	client.SetOverrides(&Overrides{
+		Expires:        now.Add(time.Hour),
+		EffectiveRound: 10,
+		weights:        map[basics.Address]uint64{zeroed: 0, raised: 7},
+	})
+
+	_, err = client.Weight(10, zeroed, crypto.VRFVerifier{})
+	require.ErrorIs(t, err, ledgercore.ErrWeightExcluded)
+	weight, err := client.Weight(10, raised, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), weight)
+	require.Zero(t, oracle.CallCount(mock.MethodWeight))
+
+	results, err := client.Weights(context.Background(), 10, []ledgercore.WeightQuery{{Addr: zeroed}, {Addr: raised}, {Addr: other}})
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Err: ledgercore.ErrWeightExcluded}, {Weight: 7}, {Weight: 5}}, results)
+
+	// Block evaluation gets the daemon's answers whatever the overrides.
+	results, err = client.DaemonWeights(context.Background(), 10, []ledgercore.WeightQuery{{Addr: zeroed}, {Addr: raised}, {Addr: other}})
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Weight: 5}, {Weight: 5}, {Weight: 5}}, results)
+
+	// Balance rounds before the effective round are the daemon's.
+	weight, err = client.Weight(9, zeroed, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), weight)
+
+	// Expiry follows the client's clock.
+	now = now.Add(time.Hour)
+	weight, err = client.Weight(10, raised, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), weight)
+
+	now = now.Add(-time.Minute)
+	client.SetOverrides(nil)
+	weight, err = client.Weight(10, zeroed, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), weight)
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+)
+
+// CertificatePinError is returned when an https daemon presents a certificate
+// whose key matches none of the pins in ClientConfig.TLSPins.
+type CertificatePinError struct {
+	// Got is the pin of the presented certificate, in the form accepted by
+	// ExternalWeightOracleTLSPins.
+	Got string
+}
+
+func (e *CertificatePinError) Error() string {
+	return fmt.Sprintf("weight daemon certificate key %s matches none of the pinned keys", e.Got)
+}
+
+// SPKIPin returns the pin of cert's public key in the form accepted by
+// ExternalWeightOracleTLSPins.
+func SPKIPin(cert *x509.Certificate) string {
+	return config.WeightOracleTLSPinPrefix + base64.StdEncoding.EncodeToString(spkiHash(cert))
+}
+
+func spkiHash(cert *x509.Certificate) []byte {
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return hash[:]
+}
+
+// daemonTLSConfig returns the TLS configuration for an https daemon, or nil
+// when the defaults apply.
+func daemonTLSConfig(cfg ClientConfig) *tls.Config {
+	var tlsConfig *tls.Config
+	switch {
+	case len(cfg.TLSPins) > 0:
+		tlsConfig = pinnedTLSConfig(cfg.TLSPins, cfg.TLSRootCAs)
+	case cfg.TLSRootCAs != nil || cfg.TLSClientCertificate != nil:
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: cfg.TLSRootCAs}
+	default:
+		return nil
+	}
+	if cfg.TLSClientCertificate != nil {
+		tlsConfig.Certificates = []tls.Certificate{*cfg.TLSClientCertificate}
+	}
+	return tlsConfig
+}
+
+// pinnedTLSConfig returns a TLS configuration that accepts a daemon
+// certificate if and only if its key hashes to one of pins. Without roots the
+// CA chain and host name are deliberately not verified: the pin is the trust
+// anchor, which lets operators run the daemon with a self-signed certificate.
+// With roots the certificate must also be issued by one of them for the host.
+func pinnedTLSConfig(pins [][]byte, roots *x509.CertPool) *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: true, //nolint:gosec // VerifyConnection below checks the pins instead
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("weight daemon presented no certificate")
+			}
+			leaf := cs.PeerCertificates[0]
+			if roots != nil {
+				opts := x509.VerifyOptions{Roots: roots, DNSName: cs.ServerName, Intermediates: x509.NewCertPool()}
+				for _, cert := range cs.PeerCertificates[1:] {
+					opts.Intermediates.AddCert(cert)
+				}
+				if _, err := leaf.Verify(opts); err != nil {
+					return err
+				}
+			}
+			got := spkiHash(leaf)
+			for _, pin := range pins {
+				if bytes.Equal(got, pin) {
+					return nil
+				}
+			}
+			return &CertificatePinError{Got: SPKIPin(leaf)}
+		},
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// newTLSDaemon serves oracle over https with a self-signed certificate and
+// returns the daemon's URL and certificate pin.
+func newTLSDaemon(t *testing.T, oracle *mock.Oracle) (*url.URL, string) {
+	server := httptest.NewTLSServer(NewHandler(oracle))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u, SPKIPin(server.Certificate())
+}
+
+func TestCertificatePinMatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	u, pin := newTLSDaemon(t, mock.New())
+
+	// The pin is accepted by the configuration parser.
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleURL = u.String()
+	local.ExternalWeightOracleTLSPins = "sha256/47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=," + pin
+	require.NoError(t, local.ValidateExternalWeightOracleConfig())
+	cfg := MakeClientConfig(local)
+	require.Len(t, cfg.TLSPins, 2)
+
+	// The self-signed certificate is trusted because of its pin alone.
+	c := NewClientWithConfig(u, cfg)
+	require.NoError(t, c.Ping())
+}
+
+func TestCertificatePinMismatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	u, pin := newTLSDaemon(t, oracle)
+
+	cfg := testClientConfig()
+	other := sha256.Sum256([]byte("another key"))
+	cfg.TLSPins = [][]byte{other[:]}
+	cfg.Retry = RetryPolicy{MaxAttempts: 3, Retryable: RetryConnect | RetryTimeout}
+	c := NewClientWithConfig(u, cfg)
+
+	err := c.Ping()
+	var pinErr *CertificatePinError
+	require.ErrorAs(t, err, &pinErr)
+	require.Equal(t, pin, pinErr.Got)
+	// A pin mismatch is not a transient failure, so it is not retried.
+	require.Equal(t, uint64(1), c.Stats().Queries)
+	require.Empty(t, oracle.Calls())
+}
+
+func TestCertificateUnpinnedSelfSigned(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	u, _ := newTLSDaemon(t, mock.New())
+
+	// Without pins the system CA store decides, and it does not know the
+	// test certificate.
+	c := NewClientWithConfig(u, testClientConfig())
+	err := c.Ping()
+	require.Error(t, err)
+	require.NotErrorAs(t, err, new(*CertificatePinError))
+}
+
+// testCA issues certificates for TLS tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "weight daemon test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+// issue returns a certificate signed by the CA, for 127.0.0.1 when server
+// is set and for client authentication otherwise.
+func (ca *testCA) issue(t *testing.T, server bool) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "algod"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if server {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		template.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1)}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// newCADaemon serves oracle over https with a certificate from ca, and
+// requires client certificates from clientCA when it is not nil.
+func newCADaemon(t *testing.T, ca *testCA, clientCA *testCA, oracle *mock.Oracle) *url.URL {
+	server := httptest.NewUnstartedServer(NewHandler(oracle))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{ca.issue(t, true)}}
+	if clientCA != nil {
+		server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+		server.TLS.ClientCAs = clientCA.pool
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u
+}
+
+// TestPrivateCA tests that a daemon certificate from a private CA is trusted
+// once the CA is configured, and that pins then also apply.
+func TestPrivateCA(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ca := newTestCA(t)
+	u := newCADaemon(t, ca, nil, mock.New())
+
+	require.Error(t, NewClientWithConfig(u, testClientConfig()).Ping())
+	cfg := testClientConfig()
+	cfg.TLSRootCAs = ca.pool
+	require.NoError(t, NewClientWithConfig(u, cfg).Ping())
+
+	// Another CA's certificate is refused even with a matching pin.
+	other := newTestCA(t)
+	otherURL := newCADaemon(t, other, nil, mock.New())
+	conn, err := tls.Dial("tcp", otherURL.Host, &tls.Config{RootCAs: other.pool})
+	require.NoError(t, err)
+	pin := spkiHash(conn.ConnectionState().PeerCertificates[0])
+	conn.Close()
+	cfg.TLSPins = [][]byte{pin}
+	err = NewClientWithConfig(otherURL, cfg).Ping()
+	require.ErrorContains(t, err, "certificate signed by unknown authority")
+	cfg.TLSRootCAs = other.pool
+	require.NoError(t, NewClientWithConfig(otherURL, cfg).Ping())
+}
+
+// TestMutualTLS tests that the client certificate is presented to a daemon
+// that requires one, over HTTP and over gRPC.
+func TestMutualTLS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ca := newTestCA(t)
+	clientCA := newTestCA(t)
+	oracle := mock.New()
+	oracle.SetWeight(makeTestAddress(1), 9)
+	u := newCADaemon(t, ca, clientCA, oracle)
+
+	cfg := testClientConfig()
+	cfg.TLSRootCAs = ca.pool
+	require.Error(t, NewClientWithConfig(u, cfg).Ping())
+
+	cert := clientCA.issue(t, false)
+	cfg.TLSClientCertificate = &cert
+	w, err := NewClientWithConfig(u, cfg).Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), w)
+
+	// A certificate from an authority the daemon does not trust is refused.
+	untrusted := ca.issue(t, false)
+	cfg.TLSClientCertificate = &untrusted
+	require.Error(t, NewClientWithConfig(u, cfg).Ping())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.ForceServerCodec(protoCodec{}), grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, true)},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCA.pool,
+	})))
+	srv.RegisterService(&grpcServiceDesc, &grpcHandler{oracle: oracle})
+	go srv.Serve(listener)
+	defer srv.Stop()
+	cfg.GRPC = true
+	cfg.TLSClientCertificate = &cert
+	grpcURL := &url.URL{Scheme: "https", Host: listener.Addr().String()}
+	w, err = NewClientWithConfig(grpcURL, cfg).Weight(2, makeTestAddress(1), makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), w)
+}
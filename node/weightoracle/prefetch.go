@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// PrefetchRound is an upcoming vote round whose weights a Prefetcher looks
+// up: the total weight, and the weights of Queries, at BalanceRound.
+type PrefetchRound struct {
+	VoteRound    basics.Round
+	BalanceRound basics.Round
+	Queries      []ledgercore.WeightQuery
+}
+
+// PrefetchPlan returns the rounds to prefetch once latest is the latest
+// committed round.
+type PrefetchPlan func(latest basics.Round) ([]PrefetchRound, error)
+
+// Prefetcher looks up the weights of upcoming vote rounds in the background
+// as the ledger advances, so that agreement finds them in the client's
+// caches rather than waiting on the daemon. Each vote round is looked up
+// once, unless a lookup fails, in which case it is tried again on the next
+// Advance.
+type Prefetcher struct {
+	client *Client
+	plan   PrefetchPlan
+
+	// latest holds the round of the last Advance not yet taken by Run.
+	latest chan basics.Round
+
+	// fetched are the vote rounds prefetched, above the latest round.
+	fetched map[basics.Round]bool
+}
+
+// NewPrefetcher returns a Prefetcher of the rounds plan returns, through
+// client. It does nothing until Run is called.
+func NewPrefetcher(client *Client, plan PrefetchPlan) *Prefetcher {
+	return &Prefetcher{
+		client:  client,
+		plan:    plan,
+		latest:  make(chan basics.Round, 1),
+		fetched: make(map[basics.Round]bool),
+	}
+}
+
+// Advance tells the prefetcher that latest has been committed. It does not
+// block: if Run is still busy with an earlier round, that round is replaced,
+// since its vote rounds are covered by the later ones. Advance must not be
+// called concurrently with itself.
+func (p *Prefetcher) Advance(latest basics.Round) {
+	offerLatest(p.latest, latest)
+}
+
+// offerLatest puts latest in the one-slot channel ch, replacing any round
+// still waiting there.
+func offerLatest(ch chan basics.Round, latest basics.Round) {
+	for {
+		select {
+		case ch <- latest:
+			return
+		default:
+		}
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
+
+// Run prefetches the weights of the rounds given to Advance until done is
+// closed.
+func (p *Prefetcher) Run(done <-chan struct{}) {
+	for {
+		select {
+		case latest := <-p.latest:
+			p.prefetch(latest)
+		case <-done:
+			return
+		}
+	}
+}
+
+// prefetch looks up the rounds planned for latest that have not been
+// prefetched yet, and returns how many of them it completed.
+func (p *Prefetcher) prefetch(latest basics.Round) int {
+	for r := range p.fetched {
+		if r <= latest {
+			delete(p.fetched, r)
+		}
+	}
+	rounds, err := p.plan(latest)
+	if err != nil {
+		logging.Base().Debugf("weights of the rounds after %d not prefetched: %v", latest, err)
+		return 0
+	}
+	completed := 0
+	for _, r := range rounds {
+		if r.VoteRound <= latest || p.fetched[r.VoteRound] {
+			continue
+		}
+		if err := p.prefetchRound(r); err != nil {
+			logging.Base().Debugf("weights of vote round %d not prefetched: %v", r.VoteRound, err)
+			continue
+		}
+		p.fetched[r.VoteRound] = true
+		completed++
+	}
+	return completed
+}
+
+// prefetchRound looks up the total weight and the weights of r, which the
+// client caches. It fails if any lookup failed.
+func (p *Prefetcher) prefetchRound(r PrefetchRound) error {
+	if _, err := p.client.TotalWeight(r.BalanceRound, r.VoteRound); err != nil {
+		return err
+	}
+	if len(r.Queries) == 0 {
+		return nil
+	}
+	results, err := p.client.Weights(context.Background(), r.BalanceRound, r.Queries)
+	if err != nil {
+		return err
+	}
+	for i, res := range results {
+		if res.Err != nil {
+			return fmt.Errorf("weight of %s: %w", r.Queries[i].Addr, res.Err)
+		}
+	}
+	return nil
+}
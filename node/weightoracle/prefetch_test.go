@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// twoRoundPlan plans the two vote rounds after latest, with a balance
+// lookback of 100 rounds and the accounts of makeTestQueries(2).
+func twoRoundPlan(latest basics.Round) ([]PrefetchRound, error) {
+	queries := makeTestQueries(2)
+	return []PrefetchRound{
+		{VoteRound: latest + 1, BalanceRound: latest - 99, Queries: queries},
+		{VoteRound: latest + 2, BalanceRound: latest - 98, Queries: queries},
+	}, nil
+}
+
+// TestPrefetch checks that the prefetcher looks up each planned vote round
+// once, leaving the weights and total weights cached.
+func TestPrefetch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var totals, batches atomic.Int32
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		if path == "/total_weight" {
+			totals.Add(1)
+			return map[string]string{"total_weight": "1000"}
+		}
+		batches.Add(1)
+		return answerWeights(req, 0)
+	})
+	defer server.Close()
+	client := NewClient(server.port)
+	p := NewPrefetcher(client, twoRoundPlan)
+
+	require.Equal(t, 2, p.prefetch(200))
+	require.EqualValues(t, 2, totals.Load())
+	require.EqualValues(t, 2, batches.Load())
+
+	// Vote round 202 was prefetched already.
+	require.Equal(t, 1, p.prefetch(201))
+	require.EqualValues(t, 3, totals.Load())
+	require.EqualValues(t, 3, batches.Load())
+
+	// Agreement finds the weights of vote round 203 cached.
+	before := client.Stats()
+	q := makeTestQueries(2)[1]
+	weight, err := client.Weight(103, q.Addr, q.SelectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), weight)
+	total, err := client.TotalWeight(103, 203)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), total)
+	require.Equal(t, QueryStats{CacheHits: 2}, client.Stats().Sub(before))
+}
+
+// TestPrefetchRetriesFailures checks that a vote round whose lookups failed
+// is looked up again on the next round.
+func TestPrefetchRetriesFailures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var fail atomic.Bool
+	fail.Store(true)
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		if path == "/total_weight" {
+			return map[string]string{"total_weight": "1000"}
+		}
+		if fail.Load() {
+			return answerWeights(req, 2)
+		}
+		return answerWeights(req, 0)
+	})
+	defer server.Close()
+	p := NewPrefetcher(NewClient(server.port), twoRoundPlan)
+
+	require.Equal(t, 0, p.prefetch(200))
+	fail.Store(false)
+	require.Equal(t, 2, p.prefetch(201))
+}
+
+// TestPrefetcherAdvance checks that Run prefetches the rounds given to
+// Advance, and that Advance does not block while Run is busy.
+func TestPrefetcherAdvance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	planned := make(chan basics.Round, 10)
+	release := make(chan struct{})
+	p := NewPrefetcher(nil, func(latest basics.Round) ([]PrefetchRound, error) {
+		planned <- latest
+		<-release
+		return nil, nil
+	})
+	done := make(chan struct{})
+	defer close(done)
+	go p.Run(done)
+
+	p.Advance(1)
+	require.Equal(t, basics.Round(1), <-planned)
+	for r := basics.Round(2); r <= 5; r++ {
+		p.Advance(r)
+	}
+	close(release)
+	// Only the latest of the rounds advanced while Run was busy is planned.
+	require.Equal(t, basics.Round(5), <-planned)
+	select {
+	case r := <-planned:
+		t.Fatalf("round %d planned after round 5", r)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
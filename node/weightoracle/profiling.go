@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util"
+)
+
+// Profiler labels attached to the goroutines that make daemon requests. The
+// endpoint label holds the request's path, such as "/weight", and the phase
+// label one of the Phase values.
+const (
+	EndpointProfileLabel = "weightOracleEndpoint"
+	PhaseProfileLabel    = "weightOraclePhase"
+)
+
+// Phases of the node's use of the daemon, as given by PhaseProfileLabel.
+const (
+	// PhaseStartup is every request made while the client is marked as
+	// starting, by SetStarting.
+	PhaseStartup = "startup"
+
+	// PhaseMembership is a total weight lookup, or a weight lookup of an
+	// account with participation keys on this node: the lookups that place
+	// the node's own accounts on committees.
+	PhaseMembership = "membership"
+
+	// PhaseVerification is a weight lookup of any other account, or a
+	// batched weights lookup, made to verify other nodes' votes.
+	PhaseVerification = "verification"
+
+	// PhaseBackground is a ping, identity or weight table request, made by
+	// health and consistency checks.
+	PhaseBackground = "background"
+)
+
+// ProfileHook is called around every request to the daemon, on the goroutine
+// that makes it and under its profiler labels, with the request's endpoint and
+// phase. The function it returns is called once the request completes.
+type ProfileHook func(endpoint, phase string) (end func())
+
+// TraceHook is a ProfileHook that marks each request as a task in execution
+// traces, with its phase logged in the task, so that a trace of a slow round
+// shows the requests it waited on. It costs little when no trace is running.
+func TraceHook(endpoint, phase string) func() {
+	ctx, task := trace.NewTask(context.Background(), "weightOracle"+endpoint)
+	trace.Log(ctx, PhaseProfileLabel, phase)
+	return task.End
+}
+
+// SetStarting marks the client as serving the node's startup, or no longer.
+// Requests made meanwhile are labeled PhaseStartup.
+func (c *Client) SetStarting(starting bool) {
+	c.starting.Store(starting)
+}
+
+// phase returns the phase of a request to endpoint; account is as for
+// sendRequest.
+func (c *Client) phase(endpoint string, account *basics.Address) string {
+	switch {
+	case c.starting.Load():
+		return PhaseStartup
+	case endpoint == "/total_weight":
+		return PhaseMembership
+	case endpoint == "/weights":
+		return PhaseVerification
+	case account != nil:
+		if own := c.ownAccounts.Load(); own != nil && (*own)(*account) {
+			return PhaseMembership
+		}
+		return PhaseVerification
+	}
+	return PhaseBackground
+}
+
+// doRequest is sendRequest made on a goroutine labeled with the request's
+// endpoint and phase, so that CPU profiles attribute the encoding, decoding
+// and waiting of daemon requests to them. The labels are not set on the
+// caller's goroutine, since they would replace its own labels, such as an
+// execution pool's, and could not be restored.
+func (c *Client) doRequest(ctx context.Context, endpoint string, account *basics.Address, reqBody interface{}, result interface{}) error {
+	phase := c.phase(endpoint, account)
+	done := make(chan error, 1)
+	go func() {
+		util.SetGoroutineLabels(EndpointProfileLabel, endpoint, PhaseProfileLabel, phase)
+		end := func() {}
+		if c.profileHook != nil {
+			end = c.profileHook(endpoint, phase)
+		}
+		err := c.sendRequest(ctx, endpoint, account, reqBody, result)
+		end()
+		done <- err
+	}()
+	err := <-done
+	if err == nil {
+		c.answered()
+	}
+	return err
+}
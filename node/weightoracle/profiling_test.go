@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"runtime/pprof"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestProfilePhases tests that requests are labeled with their endpoint and
+// the phase of the node's use of the daemon they belong to, and that the
+// profile hook runs under those labels.
+func TestProfilePhases(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		switch path {
+		case "/weight":
+			return map[string]interface{}{"weight": "1"}
+		case "/total_weight":
+			return map[string]interface{}{"total_weight": "100"}
+		}
+		return map[string]interface{}{"pong": true}
+	})
+	defer server.Close()
+
+	type call struct{ endpoint, phase string }
+	var mu sync.Mutex
+	var calls []call
+	var unlabeled []string
+	cfg := testClientConfig()
+	cfg.ProfileHook = func(endpoint, phase string) func() {
+		var profile bytes.Buffer
+		pprof.Lookup("goroutine").WriteTo(&profile, 1)
+		labels := fmt.Sprintf(`"%s":"%s"`, PhaseProfileLabel, phase)
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call{endpoint, phase})
+		if !bytes.Contains(profile.Bytes(), []byte(labels)) {
+			unlabeled = append(unlabeled, endpoint)
+		}
+		return func() {}
+	}
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, cfg)
+	own := makeTestAddress(1)
+	client.SetOwnAccounts(func(addr basics.Address) bool { return addr == own })
+
+	client.SetStarting(true)
+	require.NoError(t, client.Ping())
+	_, err := client.Weight(10, own, makeTestSelectionID(1))
+	require.NoError(t, err)
+	client.SetStarting(false)
+
+	require.NoError(t, client.Ping())
+	_, err = client.Weight(11, own, makeTestSelectionID(1))
+	require.NoError(t, err)
+	_, err = client.Weight(11, makeTestAddress(2), makeTestSelectionID(2))
+	require.NoError(t, err)
+	_, err = client.TotalWeight(11, 13)
+	require.NoError(t, err)
+
+	require.Equal(t, []call{
+		{"/ping", PhaseStartup},
+		{"/weight", PhaseStartup},
+		{"/ping", PhaseBackground},
+		{"/weight", PhaseMembership},
+		{"/weight", PhaseVerification},
+		{"/total_weight", PhaseMembership},
+	}, calls)
+	require.Empty(t, unlabeled)
+}
+
+// TestProfileLabelsLeaveCaller tests that a request does not change the
+// profiler labels of the goroutine that makes it.
+func TestProfileLabelsLeaveCaller(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		return map[string]interface{}{"pong": true}
+	})
+	defer server.Close()
+
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, testClientConfig())
+	pprof.Do(context.Background(), pprof.Labels("caller", "test"), func(context.Context) {
+		require.NoError(t, client.Ping())
+		var profile bytes.Buffer
+		pprof.Lookup("goroutine").WriteTo(&profile, 1)
+		require.Contains(t, profile.String(), `{"caller":"test"}`)
+		require.NotContains(t, profile.String(), `"caller":"test", "`+EndpointProfileLabel)
+	})
+}
+
+// TestMakeClientConfigTraceRequests tests that ExternalWeightOracleTraceRequests
+// installs TraceHook, and that TraceHook runs whether or not a trace is taken.
+func TestMakeClientConfigTraceRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	require.Nil(t, MakeClientConfig(local).ProfileHook)
+
+	local.ExternalWeightOracleTraceRequests = true
+	hook := MakeClientConfig(local).ProfileHook
+	require.NotNil(t, hook)
+	hook("/weight", PhaseVerification)()
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// ErrRateLimited is returned for a weight lookup dropped because its endpoint's
+// rate limit was used up (see ClientConfig.RateLimits).
+var ErrRateLimited = errors.New("weight daemon request rate limit reached")
+
+// tokenBucket admits requests at a steady rate, allowing bursts of up to one
+// second's worth.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(perSecond int, now time.Time) *tokenBucket {
+	burst := float64(max(perSecond, 1))
+	return &tokenBucket{rate: float64(perSecond), burst: burst, tokens: burst, last: now}
+}
+
+// take removes a token at time now. If none is available it reserves the next
+// one, provided that one arrives within maxWait, and returns how long to wait
+// for it. ok is false if no token could be had within maxWait.
+func (b *tokenBucket) take(now time.Time, maxWait time.Duration) (wait time.Duration, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	wait = time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if wait > maxWait {
+		return 0, false
+	}
+	b.tokens--
+	return wait, true
+}
+
+// SetOwnAccounts tells the client which accounts have participation keys on
+// this node. Weight lookups of these accounts are never dropped by the rate
+// limit; those of other accounts, which come from verifying other nodes'
+// messages, are. Until it is called every account is treated as foreign.
+func (c *Client) SetOwnAccounts(own func(basics.Address) bool) {
+	c.ownAccounts.Store(&own)
+}
+
+// waitRate takes a token from endpoint's rate limit, if it has one. A weight
+// lookup of a foreign account is dropped when no token is left; any other
+// request waits up to the query timeout for one. account is the account a
+// /weight request looks up, or nil for other requests.
+func (c *Client) waitRate(endpoint string, account *basics.Address) error {
+	bucket := c.rateLimits[endpoint]
+	if bucket == nil {
+		return nil
+	}
+	maxWait := c.queryTimeout
+	if account != nil {
+		own := c.ownAccounts.Load()
+		if own == nil || !(*own)(*account) {
+			maxWait = 0
+		}
+	}
+	wait, ok := bucket.take(time.Now(), maxWait)
+	if !ok {
+		if maxWait == 0 {
+			return fmt.Errorf("%w for %s", ErrRateLimited, endpoint)
+		}
+		return fmt.Errorf("%w for %s: no request allowed within %v", ErrRateLimited, endpoint, maxWait)
+	}
+	if wait > 0 {
+		c.sleep(wait)
+	}
+	return nil
+}
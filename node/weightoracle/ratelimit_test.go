@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTokenBucket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	start := time.Now()
+	b := newTokenBucket(10, start)
+
+	// A full second's worth is available at once.
+	for i := 0; i < 10; i++ {
+		wait, ok := b.take(start, 0)
+		require.True(t, ok)
+		require.Zero(t, wait)
+	}
+	_, ok := b.take(start, 0)
+	require.False(t, ok)
+
+	// Waiting callers reserve the following tokens in turn.
+	wait, ok := b.take(start, time.Second)
+	require.True(t, ok)
+	require.InDelta(t, 100*time.Millisecond, wait, float64(time.Millisecond))
+	wait, ok = b.take(start, time.Second)
+	require.True(t, ok)
+	require.InDelta(t, 200*time.Millisecond, wait, float64(time.Millisecond))
+	_, ok = b.take(start, 100*time.Millisecond)
+	require.False(t, ok)
+
+	// Idle time refills the bucket, but no further than the burst.
+	later := start.Add(time.Hour)
+	for i := 0; i < 10; i++ {
+		_, ok = b.take(later, 0)
+		require.True(t, ok)
+	}
+	_, ok = b.take(later, 0)
+	require.False(t, ok)
+}
+
+// TestRateLimitShedding tests that once the weight endpoint's rate is used up,
+// lookups of foreign accounts are dropped while those of the node's own
+// accounts, and requests to other endpoints, still go through.
+func TestRateLimitShedding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	local.ExternalWeightOracleRateLimitPerEndpoint = "weight=2"
+	require.Equal(t, map[string]int{"/weight": 2}, MakeClientConfig(local).RateLimits)
+
+	client, transport, delays := faultClient(RetryPolicy{MaxAttempts: 1}, respond(http.StatusOK, `{"weight":"5","pong":true}`))
+	client.rateLimits = map[string]*tokenBucket{"/weight": newTokenBucket(2, time.Now())}
+	own := basics.Address{0xaa}
+	client.SetOwnAccounts(func(addr basics.Address) bool { return addr == own })
+
+	for i := byte(1); i <= 2; i++ {
+		_, err := client.Weight(1, basics.Address{i}, crypto.VRFVerifier{})
+		require.NoError(t, err)
+	}
+	_, err := client.Weight(1, basics.Address{3}, crypto.VRFVerifier{})
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, 2, transport.attempts)
+	require.Len(t, client.RecentQueries(), 3)
+
+	weight, err := client.Weight(1, own, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, weight)
+	require.Len(t, *delays, 1)
+	require.Positive(t, (*delays)[0])
+
+	require.NoError(t, client.Ping())
+	require.Equal(t, 4, transport.attempts)
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// RecentQueryCapacity is the number of requests a Client remembers for
+// diagnostics.
+const RecentQueryCapacity = 256
+
+// QueryRecord describes a request the client sent to the daemon. A request
+// that was retried is recorded once, with the outcome of its last attempt.
+type QueryRecord struct {
+	Time     time.Time     `json:"time"`
+	Endpoint string        `json:"endpoint"`
+	Request  string        `json:"request"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// queryLog keeps the most recent RecentQueryCapacity records in a ring.
+type queryLog struct {
+	mu      deadlock.Mutex
+	records []QueryRecord
+	// next is the slot the next record overwrites once the ring is full.
+	next int
+}
+
+func (l *queryLog) add(r QueryRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.records) < RecentQueryCapacity {
+		l.records = append(l.records, r)
+		return
+	}
+	l.records[l.next] = r
+	l.next = (l.next + 1) % RecentQueryCapacity
+}
+
+// snapshot returns a copy of the records, oldest first.
+func (l *queryLog) snapshot() []QueryRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	out := make([]QueryRecord, 0, len(l.records))
+	out = append(out, l.records[l.next:]...)
+	return append(out, l.records[:l.next]...)
+}
+
+// RecentQueries returns the last RecentQueryCapacity requests sent to the
+// daemon, oldest first. Lookups answered from cache are not included.
+func (c *Client) RecentQueries() []QueryRecord {
+	return c.recent.snapshot()
+}
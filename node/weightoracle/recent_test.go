@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestQueryLogKeepsNewest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var l queryLog
+	require.Empty(t, l.snapshot())
+
+	total := RecentQueryCapacity + 10
+	for i := 0; i < total; i++ {
+		l.add(QueryRecord{Endpoint: fmt.Sprint(i)})
+	}
+	records := l.snapshot()
+	require.Len(t, records, RecentQueryCapacity)
+	for i, r := range records {
+		require.Equal(t, fmt.Sprint(total-RecentQueryCapacity+i), r.Endpoint)
+	}
+}
+
+func TestRecentQueries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetTotalWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "no such round"})
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+	before := time.Now()
+	require.NoError(t, client.Ping())
+	_, err = client.TotalWeight(10, 20)
+	require.Error(t, err)
+
+	records := client.RecentQueries()
+	require.Len(t, records, 2)
+	require.Equal(t, "/ping", records[0].Endpoint)
+	require.Equal(t, "{}", records[0].Request)
+	require.Empty(t, records[0].Error)
+	require.False(t, records[0].Time.Before(before))
+
+	require.Equal(t, "/total_weight", records[1].Endpoint)
+	require.Contains(t, records[1].Request, `"balance_round":"10"`)
+	require.Contains(t, records[1].Error, "no such round")
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Exchange is one request to a weight daemon and the response it received,
+// as stored in a recording. Credentials are never recorded.
+type Exchange struct {
+	// Path is the URL path of the request, including any base URL prefix.
+	Path string `json:"path"`
+
+	// Request is the JSON request body.
+	Request json.RawMessage `json:"request"`
+
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Body is the response body when it is JSON; Text holds any other body.
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+// recording is the file format written by RecordingTransport.Save.
+type recording struct {
+	Exchanges []Exchange `json:"exchanges"`
+}
+
+// RecordingTransport is an http.RoundTripper that passes requests through to
+// another transport and remembers every completed exchange, so that a session
+// with a live daemon can be saved as a golden file for ReplayTransport. Set it
+// as ClientConfig.Transport.
+type RecordingTransport struct {
+	next http.RoundTripper
+
+	mu        sync.Mutex
+	exchanges []Exchange
+}
+
+// NewRecordingTransport returns a RecordingTransport that sends requests
+// through next, or through http.DefaultTransport if next is nil.
+func NewRecordingTransport(next http.RoundTripper) *RecordingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &RecordingTransport{next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		// Failures to reach the daemon carry no daemon data to replay.
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	x := Exchange{Path: req.URL.Path, Request: compactJSON(reqBody), Status: resp.StatusCode}
+	if json.Valid(respBody) {
+		x.Body = compactJSON(respBody)
+	} else {
+		x.Text = string(respBody)
+	}
+	t.mu.Lock()
+	t.exchanges = append(t.exchanges, x)
+	t.mu.Unlock()
+	return resp, nil
+}
+
+// Exchanges returns the exchanges recorded so far, in the order they completed.
+func (t *RecordingTransport) Exchanges() []Exchange {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Exchange(nil), t.exchanges...)
+}
+
+// Save writes the recorded exchanges to path in the format read by
+// LoadReplayTransport.
+func (t *RecordingTransport) Save(path string) error {
+	data, err := json.MarshalIndent(recording{Exchanges: t.Exchanges()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// ReplayTransport is an http.RoundTripper that answers requests from a
+// recording instead of contacting a daemon. A request is matched on its path
+// and JSON body, ignoring the host; identical requests are answered in the
+// order they were recorded, and the last answer is repeated once the
+// recording runs out. A request that was never recorded fails, so a test
+// notices when the code under test asks for data the recording lacks.
+type ReplayTransport struct {
+	mu        sync.Mutex
+	exchanges map[string][]Exchange
+}
+
+// NewReplayTransport returns a ReplayTransport that answers with exchanges.
+func NewReplayTransport(exchanges []Exchange) *ReplayTransport {
+	t := &ReplayTransport{exchanges: make(map[string][]Exchange)}
+	for _, x := range exchanges {
+		key := replayKey(x.Path, x.Request)
+		t.exchanges[key] = append(t.exchanges[key], x)
+	}
+	return t
+}
+
+// LoadReplayTransport returns a ReplayTransport for the recording saved at path.
+func LoadReplayTransport(path string) (*ReplayTransport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rec recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("invalid weight daemon recording %s: %w", path, err)
+	}
+	return NewReplayTransport(rec.Exchanges), nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key := replayKey(req.URL.Path, reqBody)
+
+	t.mu.Lock()
+	queue := t.exchanges[key]
+	if len(queue) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("no recorded weight daemon response for %s %s", req.URL.Path, compactJSON(reqBody))
+	}
+	x := queue[0]
+	if len(queue) > 1 {
+		t.exchanges[key] = queue[1:]
+	}
+	t.mu.Unlock()
+
+	body := []byte(x.Text)
+	header := make(http.Header)
+	if x.Body != nil {
+		body = x.Body
+		header.Set("Content-Type", "application/json")
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", x.Status, http.StatusText(x.Status)),
+		StatusCode:    x.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// readRequestBody reads the body of req and puts an unread copy back.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// compactJSON returns data without insignificant whitespace, or data itself
+// if it is not valid JSON.
+func compactJSON(data []byte) json.RawMessage {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return data
+	}
+	return buf.Bytes()
+}
+
+func replayKey(path string, request []byte) string {
+	return strings.TrimSuffix(path, "/") + " " + string(compactJSON(request))
+}
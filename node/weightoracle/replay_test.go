@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestRecordReplay records a session with a daemon and checks that replaying
+// the saved recording, with the daemon gone, gives the same answers.
+func TestRecordReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	known, unknown := makeTestAddress(1), makeTestAddress(2)
+	var weightQueries int
+	server := newTestServerWithPath(t, func(path string, req map[string]interface{}) interface{} {
+		switch strings.TrimPrefix(path, "/oracle") {
+		case "/weight":
+			if req["address"] != known.String() {
+				return map[string]interface{}{"error": "account not found", "code": "not_found"}
+			}
+			weightQueries++
+			return map[string]interface{}{"weight": fmt.Sprint(100 * weightQueries)}
+		case "/total_weight":
+			return map[string]interface{}{"total_weight": "5000"}
+		}
+		return map[string]interface{}{"pong": true}
+	})
+
+	baseURL := &url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port), Path: "/oracle"}
+	query := func(cfg ClientConfig) (answers []interface{}) {
+		// With room for one cached weight, alternating rounds makes every
+		// weight query reach the transport.
+		cfg.WeightCacheCapacity = 1
+		client := NewClientWithConfig(baseURL, cfg)
+		answers = append(answers, client.Ping())
+		for i := 0; i < 4; i++ {
+			w, err := client.Weight(basics.Round(10+i%2), known, makeTestSelectionID(1))
+			answers = append(answers, w, err)
+		}
+		_, err := client.Weight(10, unknown, makeTestSelectionID(2))
+		answers = append(answers, ledgercore.IsDaemonError(err, "not_found"))
+		total, err := client.TotalWeight(10, 330)
+		return append(answers, total, err)
+	}
+
+	// The daemon lives below a path prefix, which the recording keeps.
+	recorder := NewRecordingTransport(nil)
+	cfg := testClientConfig()
+	cfg.Transport = recorder
+	live := query(cfg)
+	server.Close()
+
+	require.Len(t, recorder.Exchanges(), 7)
+	for _, x := range recorder.Exchanges() {
+		require.Contains(t, x.Path, "/oracle/")
+		require.Equal(t, http.StatusOK, x.Status)
+	}
+
+	golden := filepath.Join(t.TempDir(), "session.json")
+	require.NoError(t, recorder.Save(golden))
+	replay, err := LoadReplayTransport(golden)
+	require.NoError(t, err)
+
+	require.Equal(t, []interface{}{nil,
+		uint64(100), nil, uint64(200), nil, uint64(300), nil, uint64(400), nil,
+		true, uint64(5000), nil}, live)
+	cfg = testClientConfig()
+	cfg.Transport = replay
+	require.Equal(t, live, query(cfg))
+
+	// The last answer repeats once a request's recordings are used up, and
+	// requests that were never recorded fail.
+	client := NewClientWithConfig(baseURL, cfg)
+	w, err := client.Weight(11, known, makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), w)
+	_, err = client.Weight(12, known, makeTestSelectionID(1))
+	require.ErrorContains(t, err, "no recorded weight daemon response for /oracle/weight")
+}
+
+// TestReplayNonJSONResponse checks that responses which are not JSON are
+// recorded as text and replayed verbatim.
+func TestReplayNonJSONResponse(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	replay := NewReplayTransport([]Exchange{{
+		Path:    "/ping",
+		Request: []byte(`{}`),
+		Status:  http.StatusBadGateway,
+		Text:    "upstream unavailable",
+	}})
+	cfg := testClientConfig()
+	cfg.Transport = replay
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: "weights.invalid"}, cfg)
+	err := client.Ping()
+	require.ErrorContains(t, err, "HTTP error 502: upstream unavailable")
+	require.False(t, ledgercore.IsDaemonError(err, ""))
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// answerCache is a bounded cache of daemon answers; lruCache and roundCache
+// implement it with different eviction policies.
+type answerCache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Resize(capacity int)
+	Range(f func(key K, value V))
+	RemoveIf(f func(key K, value V) bool) int
+	Len() int
+}
+
+var _ answerCache[weightCacheKey, weightAnswer] = (*lruCache[weightCacheKey, weightAnswer])(nil)
+var _ answerCache[weightCacheKey, weightAnswer] = (*roundCache[weightCacheKey, weightAnswer])(nil)
+
+// EvictionPolicy selects which entry a full weight cache drops.
+type EvictionPolicy uint8
+
+const (
+	// EvictLRU drops the least recently used entry.
+	EvictLRU EvictionPolicy = iota
+	// EvictOldestRound drops an entry for the oldest balance round cached,
+	// however recently it was used. Agreement moves on to newer balance
+	// rounds as the chain advances, so with many participation keys this
+	// keeps the rounds still voted on whole, where LRU may drop their
+	// weights in favor of a burst of lookups for an old round.
+	EvictOldestRound
+)
+
+// newAnswerCache returns a cache of the given capacity that evicts by policy,
+// where round tells the balance round of an entry.
+func newAnswerCache[K comparable, V any](capacity int, policy EvictionPolicy, round func(K, V) basics.Round) answerCache[K, V] {
+	if policy == EvictOldestRound {
+		return newRoundCache(capacity, round)
+	}
+	return newLRUCache[K, V](capacity)
+}
+
+// roundCache is a thread-safe, bounded cache that evicts the entries of the
+// oldest balance round first. Lookups do not reorder anything.
+type roundCache[K comparable, V any] struct {
+	mu       deadlock.Mutex
+	capacity int
+	round    func(K, V) basics.Round
+	items    map[K]V
+
+	// byRound holds the keys of each balance round cached. oldest is the
+	// oldest of them when oldestValid is set, and is found again otherwise.
+	byRound     map[basics.Round]map[K]struct{}
+	oldest      basics.Round
+	oldestValid bool
+}
+
+// newRoundCache creates a round-evicting cache with the specified capacity,
+// which must be greater than 0.
+func newRoundCache[K comparable, V any](capacity int, round func(K, V) basics.Round) *roundCache[K, V] {
+	if capacity <= 0 {
+		panic("roundCache capacity must be > 0")
+	}
+	return &roundCache[K, V]{
+		capacity: capacity,
+		round:    round,
+		items:    make(map[K]V, capacity),
+		byRound:  make(map[basics.Round]map[K]struct{}),
+	}
+}
+
+// Get retrieves a value from the cache by key.
+func (c *roundCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.items[key]
+	return value, ok
+}
+
+// Put adds or updates a key-value pair in the cache. If the cache is at
+// capacity and the key is new, an entry of the oldest round is evicted first.
+func (c *roundCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if old, exists := c.items[key]; exists {
+		c.unindex(key, c.round(key, old))
+	} else if len(c.items) >= c.capacity {
+		c.evictOne()
+	}
+	c.items[key] = value
+	rnd := c.round(key, value)
+	keys := c.byRound[rnd]
+	if keys == nil {
+		keys = make(map[K]struct{})
+		c.byRound[rnd] = keys
+	}
+	keys[key] = struct{}{}
+	if c.oldestValid && rnd < c.oldest {
+		c.oldest = rnd
+	}
+}
+
+// Resize changes the capacity of the cache, evicting entries of the oldest
+// rounds that no longer fit. The capacity must be greater than 0.
+func (c *roundCache[K, V]) Resize(capacity int) {
+	if capacity <= 0 {
+		panic("roundCache capacity must be > 0")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.capacity = capacity
+	for len(c.items) > c.capacity {
+		c.evictOne()
+	}
+}
+
+// Range calls f on every entry, in no particular order. The cache is locked
+// meanwhile, so f must not use it.
+func (c *roundCache[K, V]) Range(f func(key K, value V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, value := range c.items {
+		f(key, value)
+	}
+}
+
+// RemoveIf removes the entries for which f returns true and returns how
+// many it removed. The cache is locked meanwhile, so f must not use it.
+func (c *roundCache[K, V]) RemoveIf(f func(key K, value V) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	removed := 0
+	for key, value := range c.items {
+		if f(key, value) {
+			delete(c.items, key)
+			c.unindex(key, c.round(key, value))
+			removed++
+		}
+	}
+	return removed
+}
+
+// Len returns the current number of entries in the cache.
+func (c *roundCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// evictOne removes an entry of the oldest round cached. c.mu must be held.
+func (c *roundCache[K, V]) evictOne() {
+	if !c.oldestValid {
+		first := true
+		for rnd := range c.byRound {
+			if first || rnd < c.oldest {
+				c.oldest = rnd
+				first = false
+			}
+		}
+		c.oldestValid = !first
+	}
+	for key := range c.byRound[c.oldest] {
+		delete(c.items, key)
+		c.unindex(key, c.oldest)
+		return
+	}
+}
+
+// unindex removes key from the keys of rnd. c.mu must be held.
+func (c *roundCache[K, V]) unindex(key K, rnd basics.Round) {
+	keys := c.byRound[rnd]
+	delete(keys, key)
+	if len(keys) == 0 {
+		delete(c.byRound, rnd)
+		if rnd == c.oldest {
+			c.oldestValid = false
+		}
+	}
+}
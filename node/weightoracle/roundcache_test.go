@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"fmt"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// keyRound uses a test cache's key as the balance round of its entry.
+func keyRound(k int, _ string) basics.Round { return basics.Round(k / 10) }
+
+func TestRoundCacheEvictsOldestRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cache := newRoundCache(3, keyRound)
+	cache.Put(20, "round 2")
+	cache.Put(10, "round 1")
+	cache.Put(30, "round 3")
+
+	// Recent use does not save round 1.
+	_, ok := cache.Get(10)
+	require.True(t, ok)
+	cache.Put(40, "round 4")
+	require.Equal(t, 3, cache.Len())
+	_, ok = cache.Get(10)
+	require.False(t, ok)
+
+	// Updating an entry evicts nothing.
+	cache.Put(20, "round 2 again")
+	require.Equal(t, 3, cache.Len())
+	value, ok := cache.Get(20)
+	require.True(t, ok)
+	require.Equal(t, "round 2 again", value)
+
+	// An entry older than everything cached is the next to go.
+	cache.Put(5, "round 0")
+	_, ok = cache.Get(20)
+	require.False(t, ok)
+	cache.Put(41, "round 4")
+	_, ok = cache.Get(5)
+	require.False(t, ok)
+
+	cache.Resize(1)
+	kept := map[int]string{}
+	cache.Range(func(k int, v string) { kept[k] = v })
+	require.Len(t, kept, 1)
+	for k := range kept {
+		require.Equal(t, basics.Round(4), keyRound(k, ""))
+	}
+}
+
+func TestRoundCacheRemoveIf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cache := newRoundCache(4, keyRound)
+	cache.Put(10, "round 1")
+	cache.Put(11, "round 1")
+	cache.Put(20, "round 2")
+	cache.Put(30, "round 3")
+	require.Equal(t, 2, cache.RemoveIf(func(k int, _ string) bool { return keyRound(k, "") < 2 }))
+	require.Equal(t, 2, cache.Len())
+
+	// The oldest round left is the next evicted.
+	cache.Put(40, "round 4")
+	cache.Put(50, "round 5")
+	cache.Put(60, "round 6")
+	_, ok := cache.Get(20)
+	require.False(t, ok)
+	_, ok = cache.Get(30)
+	require.True(t, ok)
+}
+
+// TestClientRoundEviction checks that a client configured for round eviction
+// keeps the newest balance rounds cached.
+func TestClientRoundEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := newTestServer(t, func(req map[string]interface{}) interface{} {
+		requests.Add(1)
+		return map[string]interface{}{"weight": "7"}
+	})
+	defer server.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOracleCacheEviction = config.WeightOracleCacheEvictionRound
+	clientConfig := MakeClientConfig(cfg)
+	require.Equal(t, EvictOldestRound, clientConfig.CacheEviction)
+	clientConfig.WeightCacheCapacity = 2
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", server.port)}, clientConfig)
+
+	addr, selectionID := makeTestAddress(1), makeTestSelectionID(1)
+	for _, rnd := range []basics.Round{20, 10, 30} {
+		_, err := client.Weight(rnd, addr, selectionID)
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 3, requests.Load())
+
+	// Round 10 was looked up after round 20, but is older.
+	_, err := client.Weight(20, addr, selectionID)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, requests.Load())
+	_, err = client.Weight(10, addr, selectionID)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, requests.Load())
+}
+
+// TestClientEvictBefore checks that EvictBefore drops the answers for older
+// balance rounds only.
+func TestClientEvictBefore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(7)
+	oracle.SetTotalWeight(100)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	addr, selectionID := makeTestAddress(1), makeTestSelectionID(1)
+	for _, policy := range []EvictionPolicy{EvictLRU, EvictOldestRound} {
+		clientConfig := testClientConfig()
+		clientConfig.CacheEviction = policy
+		client := NewClientWithConfig(server.URL(), clientConfig)
+		for _, rnd := range []basics.Round{10, 20, 30} {
+			_, err := client.Weight(rnd, addr, selectionID)
+			require.NoError(t, err)
+			_, err = client.TotalWeight(rnd, rnd+320)
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 4, client.EvictBefore(30))
+		before := client.Stats()
+		_, err := client.Weight(30, addr, selectionID)
+		require.NoError(t, err)
+		_, err = client.TotalWeight(30, 350)
+		require.NoError(t, err)
+		require.Equal(t, QueryStats{CacheHits: 2}, client.Stats().Sub(before))
+
+		before = client.Stats()
+		_, err = client.Weight(20, addr, selectionID)
+		require.NoError(t, err)
+		require.Equal(t, QueryStats{Queries: 1, CacheMisses: 1}, client.Stats().Sub(before))
+	}
+}
@@ -0,0 +1,472 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// WeightTabler is implemented by oracles that can list every weight they know
+// for a balance round. A Server answers /weight_table only for such oracles.
+type WeightTabler interface {
+	WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error)
+}
+
+// Server is an in-process weight daemon: it answers the HTTP protocol spoken
+// by Client from a ledgercore.WeightOracle. It lets Go tests run real algod
+// nodes against a daemon without the Python test daemon.
+type Server struct {
+	listener   net.Listener
+	httpServer *http.Server
+	url        *url.URL
+
+	// grpcServer, if not nil, serves the gRPC protocol instead of httpServer.
+	grpcServer *grpc.Server
+}
+
+// NewHandler returns an http.Handler serving the weight daemon protocol from
+// oracle. Errors returned by oracle are passed on with their DaemonError code,
+// or as internal errors otherwise.
+func NewHandler(oracle ledgercore.WeightOracle) http.Handler {
+	h := &handler{oracle: oracle}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ping", h.ping)
+	mux.HandleFunc("/identity", h.identity)
+	mux.HandleFunc("/weight", h.weight)
+	mux.HandleFunc("/weights", h.weights)
+	mux.HandleFunc("/total_weight", h.totalWeight)
+	mux.HandleFunc("/weight_table", h.weightTable)
+	mux.HandleFunc("/key_rotations", h.keyRotations)
+	mux.HandleFunc("/subscribe", h.subscribe)
+	mux.HandleFunc("/attestation", h.attestation)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, &ledgercore.DaemonError{Code: "not_found", Msg: fmt.Sprintf("Unknown endpoint: %s", r.URL.Path)})
+	})
+	return mux
+}
+
+// RequireAuthToken wraps a weight daemon handler, such as one returned by
+// NewHandler, so that it only serves requests presenting token as a bearer
+// token, as clients configured with ClientConfig.AuthToken do. Other requests
+// are answered with an unauthorized error.
+func RequireAuthToken(next http.Handler, token []byte) http.Handler {
+	want := []byte("Bearer " + string(token))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			writeError(w, &ledgercore.DaemonError{Code: "unauthorized", Msg: "missing or wrong bearer token"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// StartServer listens on addr (host:port; port 0 picks a free port) and serves
+// oracle there until Close is called.
+func StartServer(addr string, oracle ledgercore.WeightOracle) (*Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for weight daemon requests on %s: %w", addr, err)
+	}
+	s := &Server{
+		listener:   listener,
+		httpServer: &http.Server{Handler: NewHandler(oracle)},
+		url:        &url.URL{Scheme: "http", Host: listener.Addr().String()},
+	}
+	go s.httpServer.Serve(listener)
+	return s, nil
+}
+
+// StartUnixServer listens on a unix domain socket created at path and serves
+// oracle there until Close is called, which removes the socket. The socket is
+// made readable and writable by its owner alone, before any request is served.
+func StartUnixServer(path string, oracle ledgercore.WeightOracle) (*Server, error) {
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for weight daemon requests on %s: %w", path, err)
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("unable to restrict access to weight daemon socket %s: %w", path, err)
+	}
+	s := &Server{
+		listener:   listener,
+		httpServer: &http.Server{Handler: NewHandler(oracle)},
+		url:        &url.URL{Scheme: "unix", Path: path},
+	}
+	go s.httpServer.Serve(listener)
+	return s, nil
+}
+
+// StartGRPCServer listens on addr (host:port; port 0 picks a free port) and
+// serves oracle there over the gRPC protocol of weightoracle.proto until Close
+// is called. Clients reach it at URL with ClientConfig.GRPC set.
+func StartGRPCServer(addr string, oracle ledgercore.WeightOracle) (*Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for weight daemon requests on %s: %w", addr, err)
+	}
+	s := &Server{
+		listener:   listener,
+		grpcServer: grpc.NewServer(grpc.ForceServerCodec(protoCodec{})),
+		url:        &url.URL{Scheme: "http", Host: listener.Addr().String()},
+	}
+	s.grpcServer.RegisterService(&grpcServiceDesc, &grpcHandler{oracle: oracle})
+	go s.grpcServer.Serve(listener)
+	return s, nil
+}
+
+// URL returns the base URL of the server, suitable for ExternalWeightOracleURL.
+func (s *Server) URL() *url.URL {
+	u := *s.url
+	return &u
+}
+
+// Port returns the TCP port the server listens on, or 0 for a server on a
+// unix domain socket.
+func (s *Server) Port() uint16 {
+	addr, ok := s.listener.Addr().(*net.TCPAddr)
+	if !ok {
+		return 0
+	}
+	return uint16(addr.Port)
+}
+
+// Close stops the server, dropping any open connections.
+func (s *Server) Close() error {
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+		return nil
+	}
+	return s.httpServer.Close()
+}
+
+type handler struct {
+	oracle ledgercore.WeightOracle
+}
+
+func (h *handler) ping(w http.ResponseWriter, r *http.Request) {
+	if !decodeRequest(w, r, &emptyRequest{}) {
+		return
+	}
+	if err := h.oracle.Ping(); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, pingResponse{Pong: true})
+}
+
+func (h *handler) identity(w http.ResponseWriter, r *http.Request) {
+	// The handshake fields are accepted but not checked: the oracle has no
+	// snapshot schedule of its own.
+	if !decodeRequest(w, r, &handshakeRequest{}) {
+		return
+	}
+	id, err := h.oracle.Identity()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	resp := identityResponse{
+		GenesisHash:      base64.StdEncoding.EncodeToString(id.GenesisHash[:]),
+		ProtocolVersion:  id.WeightProtocolVersion,
+		AlgorithmVersion: id.WeightAlgorithmVersion,
+		WeightUnit:       id.Display.Unit,
+		Capabilities:     handlerCapabilities(h.oracle),
+	}
+	if id.Display.Scale != 0 {
+		resp.WeightScale = strconv.FormatUint(id.Display.Scale, 10)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *handler) weight(w http.ResponseWriter, r *http.Request) {
+	var req weightRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+	addr, selectionID, err := parseWeightKey(req.Address, req.SelectionID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	balanceRound, ok := parseRound(w, "balance_round", req.BalanceRound)
+	if !ok {
+		return
+	}
+
+	weight, err := h.oracle.Weight(balanceRound, addr, selectionID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeAnswer(w, r, weightResponse{Weight: strconv.FormatUint(weight, 10)})
+}
+
+func (h *handler) weights(w http.ResponseWriter, r *http.Request) {
+	var req weightsRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+	balanceRound, ok := parseRound(w, "balance_round", req.BalanceRound)
+	if !ok {
+		return
+	}
+	if len(req.Queries) > MaxWeightsBatch {
+		writeError(w, badRequest("Too many queries: %d, at most %d are allowed", len(req.Queries), MaxWeightsBatch))
+		return
+	}
+	queries := make([]ledgercore.WeightQuery, len(req.Queries))
+	for i, q := range req.Queries {
+		addr, selectionID, err := parseWeightKey(q.Address, q.SelectionID)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		queries[i] = ledgercore.WeightQuery{Addr: addr, SelectionID: selectionID}
+	}
+
+	var results []ledgercore.WeightResult
+	if bo, ok := h.oracle.(ledgercore.BatchWeightOracle); ok {
+		var err error
+		results, err = bo.Weights(r.Context(), balanceRound, queries)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	} else {
+		results = make([]ledgercore.WeightResult, len(queries))
+		for i, q := range queries {
+			results[i].Weight, results[i].Err = h.oracle.Weight(balanceRound, q.Addr, q.SelectionID)
+		}
+	}
+	resp := weightsResponse{Weights: make([]weightResponse, len(results))}
+	for i, res := range results {
+		if res.Err != nil {
+			de := errorAnswer(res.Err)
+			resp.Weights[i] = weightResponse{Error: de.Msg, Code: de.Code}
+			continue
+		}
+		resp.Weights[i] = weightResponse{Weight: strconv.FormatUint(res.Weight, 10)}
+	}
+	writeAnswer(w, r, resp)
+}
+
+func (h *handler) totalWeight(w http.ResponseWriter, r *http.Request) {
+	var req totalWeightRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+	balanceRound, ok := parseRound(w, "balance_round", req.BalanceRound)
+	if !ok {
+		return
+	}
+	voteRound, ok := parseRound(w, "vote_round", req.VoteRound)
+	if !ok {
+		return
+	}
+
+	total, err := h.oracle.TotalWeight(balanceRound, voteRound)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeAnswer(w, r, totalWeightResponse{TotalWeight: strconv.FormatUint(total, 10)})
+}
+
+func (h *handler) weightTable(w http.ResponseWriter, r *http.Request) {
+	var req weightTableRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+	balanceRound, ok := parseRound(w, "balance_round", req.BalanceRound)
+	if !ok {
+		return
+	}
+	tabler, ok := h.oracle.(WeightTabler)
+	if !ok {
+		writeError(w, &ledgercore.DaemonError{Code: "unsupported", Msg: "Weight table unavailable from this oracle"})
+		return
+	}
+
+	table, err := tabler.WeightTable(balanceRound)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if isMsgpack(r.Header.Get("Content-Type")) {
+		writeMsgpackTable(w, table)
+		return
+	}
+	if acceptsNDJSON(r) {
+		// One line per entry, encoded as it is written.
+		w.Header().Set("Content-Type", ndjsonContentType)
+		w.WriteHeader(http.StatusOK)
+		enc := json.NewEncoder(w)
+		for _, e := range table {
+			if err := enc.Encode(makeWeightTableEntry(e)); err != nil {
+				return
+			}
+		}
+		return
+	}
+	resp := weightTableResponse{Weights: make([]weightTableEntry, len(table))}
+	for i, e := range table {
+		resp.Weights[i] = makeWeightTableEntry(e)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// decodeRequest reads a POSTed JSON body, or a msgpack one (see msgpack.go),
+// into req, answering the request with an error and returning false if that
+// fails.
+func decodeRequest(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if r.Method != http.MethodPost {
+		writeError(w, badRequest("Unsupported method: %s", r.Method))
+		return false
+	}
+	if isMsgpack(r.Header.Get("Content-Type")) {
+		if err := decodeMsgpackRequest(r.Body, req); err != nil {
+			writeError(w, badRequest("Invalid msgpack: %v", err))
+			return false
+		}
+		return true
+	}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeError(w, badRequest("Invalid JSON: %v", err))
+		return false
+	}
+	return true
+}
+
+func (h *handler) keyRotations(w http.ResponseWriter, r *http.Request) {
+	var req keyRotationsRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+	rotations := make([]ledgercore.KeyRotation, len(req.Rotations))
+	for i, kr := range req.Rotations {
+		addr, sel, err := parseWeightKey(kr.Address, kr.NewSelectionID)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		rnd, ok := parseRound(w, "round", kr.Round)
+		if !ok {
+			return
+		}
+		rotations[i] = ledgercore.KeyRotation{Addr: addr, Round: rnd, New: sel}
+		if kr.OldSelectionID != "" {
+			if _, rotations[i].Old, err = parseWeightKey(kr.Address, kr.OldSelectionID); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+	}
+	notifier, ok := h.oracle.(ledgercore.KeyRotationNotifier)
+	if !ok {
+		writeError(w, &ledgercore.DaemonError{Code: "unsupported", Msg: "Key rotations not accepted by this oracle"})
+		return
+	}
+
+	if err := notifier.NotifyKeyRotations(rotations); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, keyRotationsResponse{})
+}
+
+func parseRound(w http.ResponseWriter, field, value string) (basics.Round, bool) {
+	if value == "" {
+		writeError(w, badRequest("Missing %s field", field))
+		return 0, false
+	}
+	rnd, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		writeError(w, badRequest("Invalid %s: %q", field, value))
+		return 0, false
+	}
+	return basics.Round(rnd), true
+}
+
+// parseWeightKey parses the account of a weight query.
+func parseWeightKey(address, selectionID string) (basics.Address, crypto.VRFVerifier, error) {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return basics.Address{}, crypto.VRFVerifier{}, badRequest("Invalid address: %v", err)
+	}
+	var sel crypto.VRFVerifier
+	selBytes, err := hex.DecodeString(selectionID)
+	if err != nil || len(selBytes) != len(sel) {
+		return basics.Address{}, crypto.VRFVerifier{}, badRequest("Invalid selection_id: %q", selectionID)
+	}
+	copy(sel[:], selBytes)
+	return addr, sel, nil
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &ledgercore.DaemonError{Code: "bad_request", Msg: fmt.Sprintf(format, args...)}
+}
+
+// writeError answers with the protocol's JSON error body. The HTTP status
+// follows the error code the same way the Python test daemon maps it.
+func writeError(w http.ResponseWriter, err error) {
+	de := errorAnswer(err)
+	status := http.StatusInternalServerError
+	switch de.Code {
+	case "bad_request":
+		status = http.StatusBadRequest
+	case "not_found":
+		status = http.StatusNotFound
+	case "unauthorized":
+		status = http.StatusUnauthorized
+	}
+	writeJSON(w, status, struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{de.Msg, de.Code})
+}
+
+// errorAnswer returns the DaemonError that err is answered with.
+func errorAnswer(err error) *ledgercore.DaemonError {
+	var de *ledgercore.DaemonError
+	if !errors.As(err, &de) {
+		de = &ledgercore.DaemonError{Code: "internal", Msg: err.Error()}
+	}
+	return de
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// tableOracle adds a weight table to a mock oracle.
+type tableOracle struct {
+	*mock.Oracle
+	table []ledgercore.WeightTableEntry
+}
+
+func (o tableOracle) WeightTable(basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	return o.table, nil
+}
+
+// TestServerRoundTrip checks that a Client talking to a Server gets the
+// answers of the oracle behind it.
+func TestServerRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	oracle := mock.New()
+	oracle.SetWeight(addr, 1500)
+	oracle.SetTotalWeight(5500)
+	oracle.SetIdentity(ledgercore.DaemonIdentity{
+		GenesisHash:            crypto.Hash([]byte("genesis")),
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	})
+
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	require.NotZero(t, server.Port())
+
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+	require.NoError(t, client.Ping())
+
+	id, err := client.Handshake(ledgercore.LookbackParams{BalanceLookback: 320, SeedLookback: 2, SeedRefreshInterval: 80})
+	require.NoError(t, err)
+	require.Equal(t, crypto.Hash([]byte("genesis")), id.GenesisHash)
+
+	w, err := client.Weight(100, addr, makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500), w)
+	total, err := client.TotalWeight(100, 420)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5500), total)
+
+	calls := oracle.Calls()
+	require.Equal(t, mock.Call{Method: mock.MethodWeight, BalanceRound: 100, Address: addr, SelectionID: makeTestSelectionID(1)}, calls[2])
+
+	// Without a weight table the endpoint is unsupported.
+	_, err = client.WeightTable(100)
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"), "%v", err)
+}
+
+// TestServerUnixSocket checks that a Server can listen on a unix domain
+// socket, and that clients reach it there.
+func TestServerUnixSocket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	oracle := mock.New()
+	oracle.SetWeight(addr, 1500)
+
+	path := filepath.Join(t.TempDir(), "weightd.sock")
+	server, err := StartUnixServer(path, oracle)
+	require.NoError(t, err)
+	require.Zero(t, server.Port())
+	require.Equal(t, "unix://"+path, server.URL().String())
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	for _, client := range []*Client{NewClientUnix(path), NewClientWithConfig(server.URL(), testClientConfig())} {
+		require.NoError(t, client.Ping())
+		w, err := client.Weight(100, addr, makeTestSelectionID(1))
+		require.NoError(t, err)
+		require.Equal(t, uint64(1500), w)
+	}
+
+	// Closing the server removes its socket.
+	require.NoError(t, server.Close())
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err), "%v", err)
+	require.Error(t, NewClientWithConfig(server.URL(), testClientConfig()).Ping())
+}
+
+// TestServerErrors checks that oracle errors reach the client with their
+// daemon error codes, and that other errors become internal errors.
+func TestServerErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "unknown account"})
+	oracle.SetTotalWeightError(errors.New("snapshot missing"))
+
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	cfg := testClientConfig()
+	cfg.Retry.MaxAttempts = 1
+	client := NewClientWithConfig(server.URL(), cfg)
+
+	_, err = client.Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.True(t, ledgercore.IsDaemonError(err, "not_found"), "%v", err)
+	_, err = client.TotalWeight(1, 2)
+	require.True(t, ledgercore.IsDaemonError(err, "internal"), "%v", err)
+	require.ErrorContains(t, err, "snapshot missing")
+}
+
+// TestRequireAuthToken checks that a handler requiring a token serves only
+// clients that present it.
+func TestRequireAuthToken(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(7)
+	server := httptest.NewServer(RequireAuthToken(NewHandler(oracle), []byte("s3cr3t")))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := testClientConfig()
+	cfg.Retry.MaxAttempts = 1
+	_, err = NewClientWithConfig(u, cfg).Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("s3cr3t\n"), 0600))
+	cfg.AuthToken, err = config.LoadSecret("file:"+tokenFile, "")
+	require.NoError(t, err)
+	weight, err := NewClientWithConfig(u, cfg).Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), weight)
+}
+
+// TestServerWeightTable checks that oracles with a weight table serve it.
+func TestServerWeightTable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	table := []ledgercore.WeightTableEntry{
+		{Addr: makeTestAddress(1), SelectionID: makeTestSelectionID(1), Weight: 10},
+		{Addr: makeTestAddress(2), Weight: 20},
+	}
+	server, err := StartServer("127.0.0.1:0", tableOracle{Oracle: mock.New(), table: table})
+	require.NoError(t, err)
+	defer server.Close()
+
+	got, err := NewClientWithConfig(server.URL(), testClientConfig()).WeightTable(7)
+	require.NoError(t, err)
+	require.Equal(t, table, got)
+}
+
+// TestServerWeights checks that batched weight queries are answered from the
+// oracle, with its errors reported for the accounts they concern.
+func TestServerWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetWeight(makeTestAddress(1), 10)
+	oracle.SetWeight(makeTestAddress(2), 20)
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+
+	queries := []ledgercore.WeightQuery{
+		{Addr: makeTestAddress(1), SelectionID: makeTestSelectionID(1)},
+		{Addr: makeTestAddress(2), SelectionID: makeTestSelectionID(2)},
+	}
+	results, err := client.Weights(context.Background(), 9, queries)
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightResult{{Weight: 10}, {Weight: 20}}, results)
+	require.Len(t, oracle.Calls(), 2)
+
+	oracle.SetWeightError(&ledgercore.DaemonError{Code: "not_found", Msg: "unknown account"})
+	results, err = client.Weights(context.Background(), 10, queries[:1])
+	require.NoError(t, err)
+	require.True(t, ledgercore.IsDaemonError(results[0].Err, "not_found"), "%v", results[0].Err)
+
+	// Oversized batches are refused as a whole.
+	var req weightsRequest
+	req.BalanceRound = "1"
+	req.Queries = make([]weightsQuery, MaxWeightsBatch+1)
+	err = client.doRequest(context.Background(), "/weights", nil, req, &weightsResponse{})
+	require.True(t, ledgercore.IsDaemonError(err, "bad_request"), "%v", err)
+}
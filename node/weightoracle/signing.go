@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// Headers of a signed request. The signature is the hex HMAC-SHA256, under the
+// shared key, of the endpoint path, the timestamp and the nonce, each followed
+// by a newline, and then the request body.
+const (
+	TimestampHeader = "X-Weight-Oracle-Timestamp"
+	NonceHeader     = "X-Weight-Oracle-Nonce"
+	SignatureHeader = "X-Weight-Oracle-Signature"
+)
+
+// ResponseSignatureHeader carries the signature of a signed response: the hex
+// HMAC-SHA256 of "response", the status code, the signature of the request it
+// answers and the response nonce, each followed by a newline, and then the
+// response body. Since the request signature covers the endpoint, its nonce
+// and the rounds in its body, a signed answer cannot be replayed as the answer
+// to another request.
+const ResponseSignatureHeader = "X-Weight-Oracle-Response-Signature"
+
+// ResponseNonceHeader carries the nonce of a signed response, made like a
+// request nonce from the daemon's own prefix and counter.
+const ResponseNonceHeader = "X-Weight-Oracle-Response-Nonce"
+
+// MaxSignatureSkew is how far the timestamp of a signed request may be from
+// the daemon's clock. Nonces are remembered for as long as a request carrying
+// them could be accepted.
+const MaxSignatureSkew = 30 * time.Second
+
+// NonceWindow is how far below the highest counter accepted from a signer a
+// nonce's counter may be and still be accepted, once: requests signed in order
+// but sent concurrently may arrive out of order.
+const NonceWindow = 1024
+
+// maxSignedRequestSize bounds the request bodies RequireSignatures reads; the
+// protocol's requests are a few hundred bytes.
+const maxSignedRequestSize = 64 << 10
+
+// requestSignature returns the signature of a request to path.
+func requestSignature(key []byte, path, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(path + "\n" + timestamp + "\n" + nonce + "\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// responseSignature returns the signature of a response, with nonce, to the
+// request signed with requestSig.
+func responseSignature(key []byte, requestSig string, nonce string, status int, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("response\n" + strconv.Itoa(status) + "\n" + requestSig + "\n" + nonce + "\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// noncePrefix starts the nonce of every request or response signed by the
+// process, and nonceCounter ends it. Nonces are thus monotonic: they never
+// repeat within a process, and without relying on the random source to avoid
+// collisions, and the prefix keeps them apart from those of earlier runs. The
+// receiving side holds each prefix to its counter with nonceMarks.
+var (
+	noncePrefix  = newNoncePrefix()
+	nonceCounter atomic.Uint64
+)
+
+func newNoncePrefix() (prefix [8]byte) {
+	rand.Read(prefix[:])
+	return prefix
+}
+
+// nonceSize is the size of a nonce: noncePrefix, then the counter.
+const nonceSize = 16
+
+// nextNonce returns the next nonce.
+func nextNonce() []byte {
+	nonce := make([]byte, nonceSize)
+	copy(nonce, noncePrefix[:])
+	binary.BigEndian.PutUint64(nonce[8:], nonceCounter.Add(1))
+	return nonce
+}
+
+// nonceMarks enforces the monotonic nonces of the signers it hears from. For
+// each nonce prefix it keeps a high-water mark, the highest counter accepted,
+// and which of the NonceWindow counters below it were accepted, and it refuses
+// a counter at or below the window or accepted already. A prefix is forgotten
+// once the messages accepted with it have expired, and with them any replay.
+type nonceMarks struct {
+	mu      sync.Mutex
+	signers map[[8]byte]*nonceMark
+	// lastPrune is when expired signers were last forgotten.
+	lastPrune time.Time
+}
+
+type nonceMark struct {
+	high uint64
+	// seen holds the counters above high-NonceWindow that were accepted.
+	seen   map[uint64]struct{}
+	expiry time.Time
+}
+
+// accept checks the hex nonce of a message that expires at expiry, recording
+// it if it is accepted. It returns why the nonce is refused, or "".
+func (m *nonceMarks) accept(nonceHex string, expiry, now time.Time) string {
+	nonce, err := hex.DecodeString(nonceHex)
+	if err != nil || len(nonce) != nonceSize {
+		return "Malformed nonce"
+	}
+	var prefix [8]byte
+	copy(prefix[:], nonce)
+	counter := binary.BigEndian.Uint64(nonce[8:])
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.signers == nil {
+		m.signers = make(map[[8]byte]*nonceMark)
+	}
+	if now.Sub(m.lastPrune) > MaxSignatureSkew {
+		for p, mark := range m.signers {
+			if now.After(mark.expiry) {
+				delete(m.signers, p)
+			}
+		}
+		m.lastPrune = now
+	}
+	mark := m.signers[prefix]
+	if mark == nil {
+		mark = &nonceMark{seen: make(map[uint64]struct{})}
+		m.signers[prefix] = mark
+	}
+	if counter+NonceWindow <= mark.high {
+		return "Nonce is below the signer's high-water mark"
+	}
+	if _, seen := mark.seen[counter]; seen {
+		return "Replayed nonce"
+	}
+	mark.seen[counter] = struct{}{}
+	if counter > mark.high {
+		mark.high = counter
+		for c := range mark.seen {
+			if c+NonceWindow <= mark.high {
+				delete(mark.seen, c)
+			}
+		}
+	}
+	if expiry.After(mark.expiry) {
+		mark.expiry = expiry
+	}
+	return ""
+}
+
+// signRequest adds the signature headers for a request to endpoint to req.
+// Each call uses a fresh nonce, so retried attempts are not taken for replays.
+func signRequest(req *http.Request, key []byte, endpoint string, body []byte, now time.Time) {
+	nonce := nextNonce()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	nonceHex := hex.EncodeToString(nonce)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(NonceHeader, nonceHex)
+	req.Header.Set(SignatureHeader, requestSignature(key, endpoint, timestamp, nonceHex, body))
+}
+
+// RequireSignatures wraps a weight daemon handler, such as one returned by
+// NewHandler, so that it only serves requests signed with key that are recent
+// and not replayed. Other requests are answered with an unauthorized error.
+// The answers to accepted requests are signed with the same key.
+func RequireSignatures(next http.Handler, key []byte) http.Handler {
+	return &signatureVerifier{
+		next: next,
+		key:  key,
+		now:  time.Now,
+	}
+}
+
+type signatureVerifier struct {
+	next http.Handler
+	key  []byte
+	now  func() time.Time
+
+	// nonces holds each client to the monotonic nonces it signs with.
+	nonces nonceMarks
+}
+
+func (v *signatureVerifier) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSignedRequestSize))
+	if err != nil {
+		writeError(w, badRequest("Unreadable request body: %v", err))
+		return
+	}
+	if msg := v.verify(r, body); msg != "" {
+		writeError(w, &ledgercore.DaemonError{Code: "unauthorized", Msg: msg})
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	rec := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+	v.next.ServeHTTP(rec, r)
+	signature := r.Header.Get(SignatureHeader)
+	nonce := hex.EncodeToString(nextNonce())
+	w.Header().Set(ResponseNonceHeader, nonce)
+	w.Header().Set(ResponseSignatureHeader, responseSignature(v.key, signature, nonce, rec.status, rec.body.Bytes()))
+	w.WriteHeader(rec.status)
+	w.Write(rec.body.Bytes())
+}
+
+// bufferedResponse holds a handler's response until it can be signed.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header         { return b.header }
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
+func (b *bufferedResponse) WriteHeader(status int)      { b.status = status }
+
+// verify checks the signature headers of r, returning why the request is
+// rejected or "" if it is accepted.
+func (v *signatureVerifier) verify(r *http.Request, body []byte) string {
+	timestamp := r.Header.Get(TimestampHeader)
+	nonce := r.Header.Get(NonceHeader)
+	signature := r.Header.Get(SignatureHeader)
+	if timestamp == "" || nonce == "" || signature == "" {
+		return "Missing request signature"
+	}
+	want := requestSignature(v.key, r.URL.Path, timestamp, nonce, body)
+	if !hmac.Equal([]byte(signature), []byte(want)) {
+		return "Invalid request signature"
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "Invalid request timestamp"
+	}
+	now := v.now()
+	sent := time.Unix(seconds, 0)
+	if sent.Before(now.Add(-MaxSignatureSkew)) || sent.After(now.Add(MaxSignatureSkew)) {
+		return "Request timestamp is outside the accepted window"
+	}
+	return v.nonces.accept(nonce, sent.Add(MaxSignatureSkew), now)
+}
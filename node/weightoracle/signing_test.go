@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// newSigningDaemon serves oracle behind RequireSignatures with key.
+func newSigningDaemon(t *testing.T, oracle *mock.Oracle, key string) (*url.URL, *signatureVerifier) {
+	verifier := RequireSignatures(NewHandler(oracle), []byte(key)).(*signatureVerifier)
+	server := httptest.NewServer(verifier)
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u, verifier
+}
+
+// TestSignedRequests tests that a client with the daemon's key is served and
+// that clients without it are rejected as unauthorized.
+func TestSignedRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	oracle := mock.New()
+	oracle.SetTotalWeight(1000)
+	u, _ := newSigningDaemon(t, oracle, "shared-key")
+
+	cfg := testClientConfig()
+	cfg.Retry = RetryPolicy{MaxAttempts: 3, Retryable: allRetryable}
+	require.ErrorIs(t, NewClientWithConfig(u, cfg).Ping(), ErrUnauthorized)
+
+	t.Setenv("TEST_WEIGHT_ORACLE_SIGNING_KEY", "other-key")
+	var err error
+	cfg.SigningKey, err = config.LoadSecret("env:TEST_WEIGHT_ORACLE_SIGNING_KEY", "")
+	require.NoError(t, err)
+	require.ErrorIs(t, NewClientWithConfig(u, cfg).Ping(), ErrUnauthorized)
+	require.Empty(t, oracle.Calls())
+
+	t.Setenv("TEST_WEIGHT_ORACLE_SIGNING_KEY", "shared-key")
+	cfg.SigningKey, err = config.LoadSecret("env:TEST_WEIGHT_ORACLE_SIGNING_KEY", "")
+	require.NoError(t, err)
+	c := NewClientWithConfig(u, cfg)
+	require.NoError(t, c.Ping())
+	total, err := c.TotalWeight(1, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, total)
+}
+
+// TestSignatureVerification tests the verifier's handling of tampered, stale
+// and replayed requests.
+func TestSignatureVerification(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	key := []byte("shared-key")
+	u, verifier := newSigningDaemon(t, mock.New(), string(key))
+	now := time.Unix(time.Now().Unix(), 0)
+	verifier.now = func() time.Time { return now }
+
+	send := func(body string, sign func(*http.Request)) int {
+		req, err := http.NewRequest(http.MethodPost, u.String()+"/ping", bytes.NewReader([]byte(body)))
+		require.NoError(t, err)
+		sign(req)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	signAt := func(at time.Time) func(*http.Request) {
+		return func(req *http.Request) {
+			signRequest(req, key, "/ping", []byte("{}"), at)
+		}
+	}
+
+	require.Equal(t, http.StatusOK, send("{}", signAt(now)))
+	require.Equal(t, http.StatusOK, send("{}", signAt(now.Add(-MaxSignatureSkew))))
+
+	// The body is covered by the signature.
+	require.Equal(t, http.StatusUnauthorized, send(`{"x":1}`, signAt(now)))
+
+	// Requests from outside the skew window are refused.
+	require.Equal(t, http.StatusUnauthorized, send("{}", signAt(now.Add(-2*MaxSignatureSkew))))
+	require.Equal(t, http.StatusUnauthorized, send("{}", signAt(now.Add(2*MaxSignatureSkew))))
+
+	// A captured request is served once.
+	var captured http.Header
+	require.Equal(t, http.StatusOK, send("{}", func(req *http.Request) {
+		signAt(now)(req)
+		captured = req.Header.Clone()
+	}))
+	replay := func(req *http.Request) { req.Header = captured.Clone() }
+	require.Equal(t, http.StatusUnauthorized, send("{}", replay))
+
+	// A nonce that falls below its client's high-water mark is refused.
+	signCounter := func(counter uint64) func(*http.Request) {
+		return func(req *http.Request) {
+			timestamp := strconv.FormatInt(now.Unix(), 10)
+			nonce := make([]byte, nonceSize)
+			nonce[0] = 0xff
+			binary.BigEndian.PutUint64(nonce[8:], counter)
+			nonceHex := hex.EncodeToString(nonce)
+			req.Header.Set(TimestampHeader, timestamp)
+			req.Header.Set(NonceHeader, nonceHex)
+			req.Header.Set(SignatureHeader, requestSignature(key, "/ping", timestamp, nonceHex, []byte("{}")))
+		}
+	}
+	require.Equal(t, http.StatusOK, send("{}", signCounter(2*NonceWindow)))
+	require.Equal(t, http.StatusOK, send("{}", signCounter(NonceWindow+1)))
+	require.Equal(t, http.StatusUnauthorized, send("{}", signCounter(NonceWindow)))
+
+	// Clients are forgotten once their requests have expired, and their
+	// requests with them.
+	require.Len(t, verifier.nonces.signers, 2)
+	now = now.Add(3 * MaxSignatureSkew)
+	require.Equal(t, http.StatusUnauthorized, send("{}", replay))
+	require.Equal(t, http.StatusOK, send("{}", signAt(now)))
+	require.Len(t, verifier.nonces.signers, 1)
+}
+
+// TestNonceMonotonic tests that nonces share the process prefix and increase
+// from one to the next.
+func TestNonceMonotonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	first := nextNonce()
+	second := nextNonce()
+	require.Len(t, first, nonceSize)
+	require.Equal(t, noncePrefix[:], first[:8])
+	require.Equal(t, noncePrefix[:], second[:8])
+	require.Less(t, binary.BigEndian.Uint64(first[8:]), binary.BigEndian.Uint64(second[8:]))
+}
+
+// TestNonceMarks tests that each signer is held to its high-water mark, with
+// room for nonces that arrive out of order within NonceWindow.
+func TestNonceMarks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	nonce := func(prefix byte, counter uint64) string {
+		n := make([]byte, nonceSize)
+		n[0] = prefix
+		binary.BigEndian.PutUint64(n[8:], counter)
+		return hex.EncodeToString(n)
+	}
+	var m nonceMarks
+	now := time.Unix(1000, 0)
+	expiry := now.Add(MaxSignatureSkew)
+
+	require.Empty(t, m.accept(nonce(1, 5), expiry, now))
+	require.Equal(t, "Replayed nonce", m.accept(nonce(1, 5), expiry, now))
+	// Counters need not arrive in order, but each is accepted once.
+	require.Empty(t, m.accept(nonce(1, 3), expiry, now))
+	require.Equal(t, "Replayed nonce", m.accept(nonce(1, 3), expiry, now))
+	require.Empty(t, m.accept(nonce(1, 5+NonceWindow), expiry, now))
+	require.Equal(t, "Nonce is below the signer's high-water mark", m.accept(nonce(1, 4), expiry, now))
+	require.Empty(t, m.accept(nonce(1, 6), expiry, now))
+	require.Len(t, m.signers[[8]byte{1}].seen, 2)
+
+	// Each prefix has its own mark.
+	require.Empty(t, m.accept(nonce(2, 4), expiry, now))
+	require.Equal(t, "Malformed nonce", m.accept("00ff", expiry, now))
+	require.Equal(t, "Malformed nonce", m.accept(nonce(1, 7)+"zz", expiry, now))
+
+	// Marks are forgotten once their messages have expired.
+	later := now.Add(2 * MaxSignatureSkew)
+	require.Empty(t, m.accept(nonce(1, 4), later.Add(MaxSignatureSkew), later))
+	require.Len(t, m.signers, 1)
+}
+
+// stickyTransport forwards the first request to the daemon and answers every
+// later one with a copy of the first response, as an attacker holding a
+// captured signed answer would.
+type stickyTransport struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (s *stickyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if s.header == nil {
+		resp, err := http.DefaultTransport.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		s.body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		s.status, s.header = resp.StatusCode, resp.Header.Clone()
+	}
+	return &http.Response{
+		StatusCode: s.status,
+		Header:     s.header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(s.body)),
+		Request:    req,
+	}, nil
+}
+
+// TestSignedResponses tests that a client requiring signed responses accepts
+// the daemon's answers and rejects an answer replayed for another request.
+func TestSignedResponses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	oracle := mock.New()
+	oracle.SetTotalWeight(1000)
+	u, _ := newSigningDaemon(t, oracle, "shared-key")
+
+	t.Setenv("TEST_WEIGHT_ORACLE_SIGNING_KEY", "shared-key")
+	cfg := testClientConfig()
+	var err error
+	cfg.SigningKey, err = config.LoadSecret("env:TEST_WEIGHT_ORACLE_SIGNING_KEY", "")
+	require.NoError(t, err)
+	cfg.SignedResponses = true
+
+	c := NewClientWithConfig(u, cfg)
+	require.NoError(t, c.Ping())
+	total, err := c.TotalWeight(1, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, total)
+
+	// The first answer is genuine; the same answer to a later round is not.
+	cfg.Transport = &stickyTransport{}
+	c = NewClientWithConfig(u, cfg)
+	total, err = c.TotalWeight(3, 4)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, total)
+	_, err = c.TotalWeight(5, 6)
+	require.ErrorIs(t, err, ErrBadResponseSignature)
+
+	// An answer signed for the request but with a nonce the daemon already
+	// used is refused.
+	key := []byte("shared-key")
+	replayedNonce := hex.EncodeToString(nextNonce())
+	cfg.Transport = &faultTransport{script: []fault{func(req *http.Request) (*http.Response, error) {
+		resp, err := respond(http.StatusOK, `{"pong":true}`)(req)
+		resp.Header.Set(ResponseNonceHeader, replayedNonce)
+		resp.Header.Set(ResponseSignatureHeader, responseSignature(key, req.Header.Get(SignatureHeader), replayedNonce, resp.StatusCode, []byte(`{"pong":true}`)))
+		return resp, err
+	}}}
+	c = NewClientWithConfig(u, cfg)
+	require.NoError(t, c.Ping())
+	err = c.Ping()
+	require.ErrorIs(t, err, ErrReplayedResponse)
+
+	// A daemon that does not sign its answers is refused.
+	plain := httptest.NewServer(NewHandler(oracle))
+	defer plain.Close()
+	plainURL, err := url.Parse(plain.URL)
+	require.NoError(t, err)
+	cfg.Transport = nil
+	require.ErrorIs(t, NewClientWithConfig(plainURL, cfg).Ping(), ErrBadResponseSignature)
+}
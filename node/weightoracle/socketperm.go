@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+
+package weightoracle
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// checkSocket checks that the unix socket at path is owned by owner and cannot
+// be written by its group or others, who could otherwise have replaced the
+// daemon behind it.
+func checkSocket(path string, owner int) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%w: %s is not a socket", ErrUntrustedSocket, path)
+	}
+	if st, ok := info.Sys().(*syscall.Stat_t); ok && int(st.Uid) != owner {
+		return fmt.Errorf("%w: %s is owned by uid %d, not %d", ErrUntrustedSocket, path, st.Uid, owner)
+	}
+	if perm := info.Mode().Perm(); perm&0022 != 0 {
+		return fmt.Errorf("%w: %s has mode %v, writable by its group or others", ErrUntrustedSocket, path, perm)
+	}
+	return nil
+}
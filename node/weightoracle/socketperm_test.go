@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+
+package weightoracle
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestCheckSocket checks that clients connect to a daemon's unix socket only
+// when it is owned by the expected user and no one else can write to it.
+func TestCheckSocket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	local := config.GetDefaultLocal()
+	require.Equal(t, os.Getuid(), MakeClientConfig(local).SocketOwner)
+	local.ExternalWeightOracleSocketOwner = 0
+	require.Zero(t, MakeClientConfig(local).SocketOwner)
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "weightd.sock")
+	server, err := StartUnixServer(path, oracle)
+	require.NoError(t, err)
+	defer server.Close()
+
+	grpcPath := filepath.Join(dir, "weightd-grpc.sock")
+	listener, err := net.Listen("unix", grpcPath)
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.ForceServerCodec(protoCodec{}))
+	srv.RegisterService(&grpcServiceDesc, &grpcHandler{oracle: oracle})
+	go srv.Serve(listener)
+	defer srv.Stop()
+
+	notSocket := filepath.Join(dir, "weightd.file")
+	require.NoError(t, os.WriteFile(notSocket, nil, 0600))
+
+	for _, c := range []struct {
+		name  string
+		path  string
+		mode  os.FileMode
+		owner int
+		err   string
+	}{
+		{"owner only", path, 0600, os.Getuid(), ""},
+		{"readable by others", path, 0644, os.Getuid(), ""},
+		{"group writable", path, 0620, os.Getuid(), "writable by its group or others"},
+		{"world writable", path, 0602, os.Getuid(), "writable by its group or others"},
+		{"other owner", path, 0600, os.Getuid() + 1, "is owned by uid"},
+		{"not a socket", notSocket, 0600, os.Getuid(), "is not a socket"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			require.NoError(t, os.Chmod(c.path, c.mode))
+			err := checkSocket(c.path, c.owner)
+			if c.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrUntrustedSocket)
+				require.ErrorContains(t, err, c.err)
+			}
+
+			// Both transports check the socket before connecting to it.
+			for _, socket := range []string{path, grpcPath} {
+				if c.path != path {
+					break
+				}
+				require.NoError(t, os.Chmod(socket, c.mode))
+				cfg := testClientConfig()
+				cfg.SocketOwner = c.owner
+				cfg.GRPC = socket == grpcPath
+				client := NewClientWithConfig(&url.URL{Scheme: "unix", Path: socket}, cfg)
+				_, err := client.Weight(1, makeTestAddress(1), makeTestSelectionID(1))
+				if c.err == "" {
+					require.NoError(t, err)
+				} else {
+					require.ErrorContains(t, err, c.err)
+					require.True(t, cfg.GRPC || errors.Is(err, ErrUntrustedSocket), "%v", err)
+				}
+			}
+		})
+	}
+}
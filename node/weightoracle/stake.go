@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// StakeLedger is the part of the ledger read by StakeOracle.
+type StakeLedger interface {
+	LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error)
+	OnlineCirculation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error)
+}
+
+// StakeOracle is the built-in weight oracle: it reports each account's online
+// stake as its weight, so weighted consensus selects exactly as stake-based
+// consensus would. It lets development networks run without a weight daemon
+// and is not meant for networks whose weights differ from stake.
+type StakeOracle struct {
+	ledger      StakeLedger
+	genesisHash crypto.Digest
+}
+
+// Compile-time interface check
+var _ ledgercore.WeightOracle = (*StakeOracle)(nil)
+
+// NewStakeOracle creates a stake-mirroring oracle backed by the given ledger.
+func NewStakeOracle(l StakeLedger, genesisHash crypto.Digest) *StakeOracle {
+	return &StakeOracle{ledger: l, genesisHash: genesisHash}
+}
+
+// Weight returns the account's voting stake at balanceRound. As with a daemon,
+// a selection ID other than the account's registered one is not_found.
+func (o *StakeOracle) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	data, err := o.ledger.LookupAgreement(balanceRound, addr)
+	if err != nil {
+		return 0, err
+	}
+	if data.SelectionID != selectionID {
+		return 0, &ledgercore.DaemonError{Code: "not_found", Msg: "selection ID is not registered for the account"}
+	}
+	return data.VotingStake().Raw, nil
+}
+
+// TotalWeight returns the online stake at balanceRound of the accounts whose
+// keys are valid in voteRound.
+func (o *StakeOracle) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	total, err := o.ledger.OnlineCirculation(balanceRound, voteRound)
+	if err != nil {
+		return 0, err
+	}
+	return total.Raw, nil
+}
+
+// Ping always succeeds.
+func (o *StakeOracle) Ping() error {
+	return nil
+}
+
+// Identity reports the node's own genesis hash and the versions it expects,
+// since the oracle runs inside the node.
+func (o *StakeOracle) Identity() (ledgercore.DaemonIdentity, error) {
+	return ledgercore.DaemonIdentity{
+		GenesisHash:            o.genesisHash,
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	}, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testStakeLedger struct {
+	accounts map[basics.Address]basics.OnlineAccountData
+	online   basics.MicroAlgos
+}
+
+func (l *testStakeLedger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	if rnd > 100 {
+		return basics.OnlineAccountData{}, errors.New("round not available")
+	}
+	return l.accounts[addr], nil
+}
+
+func (l *testStakeLedger) OnlineCirculation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error) {
+	return l.online, nil
+}
+
+func TestStakeOracle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}
+	selectionID := crypto.VRFVerifier{2}
+	l := &testStakeLedger{
+		accounts: map[basics.Address]basics.OnlineAccountData{
+			addr: {
+				MicroAlgosWithRewards: basics.MicroAlgos{Raw: 2500},
+				VotingData:            basics.VotingData{SelectionID: selectionID},
+			},
+		},
+		online: basics.MicroAlgos{Raw: 10000},
+	}
+	genesisHash := crypto.Digest{3}
+	o := NewStakeOracle(l, genesisHash)
+
+	w, err := o.Weight(10, addr, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2500), w)
+
+	_, err = o.Weight(10, addr, crypto.VRFVerifier{9})
+	var de *ledgercore.DaemonError
+	require.ErrorAs(t, err, &de)
+	require.Equal(t, "not_found", de.Code)
+
+	_, err = o.Weight(101, addr, selectionID)
+	require.ErrorContains(t, err, "round not available")
+
+	total, err := o.TotalWeight(10, 12)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10000), total)
+
+	require.NoError(t, o.Ping())
+	identity, err := o.Identity()
+	require.NoError(t, err)
+	require.NoError(t, ledgercore.ValidateIdentity(identity, genesisHash, ledgercore.DefaultVersionPolicy))
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var weightOracleStaleAnswers = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_stale_answers_total", Description: "number of weight and total weight lookups answered from the cache of an earlier balance round because the weight daemon could not be reached"})
+
+// unreachable reports whether err, returned for a request, means the daemon
+// gave no answer, rather than an answer that was an error.
+func unreachable(err error) bool {
+	var de *ledgercore.DaemonError
+	return err != nil && !errors.As(err, &de)
+}
+
+// staleWeight returns the cached weight of the query for the newest of the
+// staleRounds balance rounds before balanceRound, if any.
+func (c *Client) staleWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, bool) {
+	for back := basics.Round(1); back <= c.staleRounds && back <= balanceRound; back++ {
+		if answer, ok := c.weightCache.Get(makeWeightCacheKey(balanceRound-back, addr, selectionID)); ok {
+			c.servedStale()
+			return answer.weight, true
+		}
+	}
+	return 0, false
+}
+
+// staleTotalWeight returns the cached total weight for the newest of the
+// staleRounds balance rounds before balanceRound, voted on as many rounds
+// before voteRound, if any.
+func (c *Client) staleTotalWeight(balanceRound, voteRound basics.Round) (uint64, bool) {
+	for back := basics.Round(1); back <= c.staleRounds && back <= balanceRound; back++ {
+		if total, ok := c.totalWeightCache.Get(totalWeightCacheKey{balanceRound: balanceRound - back, voteRound: voteRound - back}); ok {
+			c.servedStale()
+			return total, true
+		}
+	}
+	return 0, false
+}
+
+// staleWeights answers the queries at the given indices from the caches of
+// earlier balance rounds, and reports whether it could answer all of them.
+func (c *Client) staleWeights(balanceRound basics.Round, queries []ledgercore.WeightQuery, indices []int, results []ledgercore.WeightResult) bool {
+	for _, i := range indices {
+		weight, ok := c.staleWeight(balanceRound, queries[i].Addr, queries[i].SelectionID)
+		if !ok {
+			return false
+		}
+		results[i].Weight = weight
+	}
+	return true
+}
+
+// servedStale counts a stale answer and logs the first one after the daemon
+// last answered.
+func (c *Client) servedStale() {
+	weightOracleStaleAnswers.Inc(nil)
+	if !c.stale.Swap(true) {
+		logging.Base().Warnf("weight daemon unreachable: answering from the weights cached for up to %d earlier balance rounds", c.staleRounds)
+	}
+}
+
+// answered notes when the daemon answered a request, after which stale
+// answers, if any were served, are no longer.
+func (c *Client) answered() {
+	c.lastAnswer.Store(c.now().UnixNano())
+	if c.stale.Load() && c.stale.Swap(false) {
+		logging.Base().Infof("weight daemon answering again: no longer answering from the weights of earlier balance rounds")
+	}
+}
+
+// Stale reports whether the client has answered lookups from the caches of
+// earlier balance rounds since the daemon last answered a request.
+func (c *Client) Stale() bool {
+	return c.stale.Load()
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestStaleAnswers checks that a client allowed stale answers serves the
+// weights of earlier balance rounds only while the daemon cannot be reached.
+func TestStaleAnswers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	oracle.SetDefaultWeight(5)
+	oracle.SetTotalWeight(100)
+	var down atomic.Bool
+	handler := NewHandler(oracle)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	clientConfig := testClientConfig()
+	clientConfig.StaleRounds = 2
+	client := NewClientWithConfig(u, clientConfig)
+	a, b := basics.Address{1}, basics.Address{2}
+	selectionID := crypto.VRFVerifier{9}
+
+	_, err = client.Weight(10, a, selectionID)
+	require.NoError(t, err)
+	_, err = client.TotalWeight(10, 330)
+	require.NoError(t, err)
+	require.False(t, client.Stale())
+
+	down.Store(true)
+	weight, err := client.Weight(12, a, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), weight)
+	require.True(t, client.Stale())
+	total, err := client.TotalWeight(11, 331)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), total)
+	results, err := client.Weights(context.Background(), 11, []ledgercore.WeightQuery{{Addr: a, SelectionID: selectionID}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), results[0].Weight)
+
+	// Too old, or never looked up.
+	_, err = client.Weight(13, a, selectionID)
+	require.Error(t, err)
+	_, err = client.Weight(11, b, selectionID)
+	require.Error(t, err)
+	_, err = client.Weights(context.Background(), 11, []ledgercore.WeightQuery{{Addr: a, SelectionID: selectionID}, {Addr: b, SelectionID: selectionID}})
+	require.Error(t, err)
+
+	down.Store(false)
+	oracle.SetDefaultWeight(6)
+	weight, err = client.Weight(13, a, selectionID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), weight)
+	require.False(t, client.Stale())
+
+	// Daemon error answers are not replaced.
+	oracle.SetWeightError(&ledgercore.DaemonError{Code: "internal", Msg: "broken"})
+	_, err = client.Weight(14, a, selectionID)
+	require.Error(t, err)
+	require.False(t, client.Stale())
+}
+
+// TestStaleRoundsConfig checks that only the failure modes that allow stale
+// answers enable them.
+func TestStaleRoundsConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	require.Zero(t, MakeClientConfig(cfg).StaleRounds)
+	for _, mode := range []string{config.WeightOracleFailureStaleCache, config.WeightOracleFailureObserveOnly} {
+		cfg.ExternalWeightOracleFailureMode = mode
+		require.Equal(t, cfg.ExternalWeightOracleStaleRounds, MakeClientConfig(cfg).StaleRounds)
+	}
+}
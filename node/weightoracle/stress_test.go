@@ -0,0 +1,216 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// stressOracle answers with weights derived from the query, so any answer can
+// be checked without shared state: a cache entry served for the wrong round,
+// address or selection ID shows up as a wrong weight.
+type stressOracle struct{}
+
+const stressTotalWeight = 1 << 40
+
+func stressWeight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) uint64 {
+	return 1 + uint64(balanceRound)*7919 + uint64(addr[0])<<8 + uint64(addr[1])<<16 + uint64(selectionID[0])
+}
+
+func stressTotal(balanceRound, voteRound basics.Round) uint64 {
+	return stressTotalWeight + uint64(balanceRound)<<20 + uint64(voteRound)
+}
+
+func (stressOracle) Weight(balanceRound basics.Round, addr basics.Address, selectionID crypto.VRFVerifier) (uint64, error) {
+	return stressWeight(balanceRound, addr, selectionID), nil
+}
+
+func (stressOracle) TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error) {
+	return stressTotal(balanceRound, voteRound), nil
+}
+
+func (stressOracle) Ping() error { return nil }
+
+func (stressOracle) Identity() (ledgercore.DaemonIdentity, error) {
+	return ledgercore.DaemonIdentity{
+		GenesisHash:            crypto.Hash([]byte("stress")),
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	}, nil
+}
+
+// handlerTransport serves requests from an http.Handler in process, failing
+// every failEvery-th request as a dropped connection.
+type handlerTransport struct {
+	handler   http.Handler
+	failEvery uint64
+	requests  atomic.Uint64
+	failures  atomic.Uint64
+}
+
+func (h *handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	n := h.requests.Add(1)
+	if h.failEvery > 0 && n%h.failEvery == 0 {
+		h.failures.Add(1)
+		return nil, errors.New("connection reset by peer")
+	}
+	rec := httptest.NewRecorder()
+	h.handler.ServeHTTP(rec, req)
+	return rec.Result(), nil
+}
+
+// stressConfig sizes a stress run.
+type stressConfig struct {
+	goroutines int
+	ops        int
+	accounts   int
+	rounds     int
+	// cacheCapacity is kept well below accounts*rounds so that entries are
+	// evicted all the time.
+	cacheCapacity int
+	failEvery     uint64
+}
+
+// runStress has many goroutines query one client with random rounds and
+// accounts, mixed with the client's diagnostic accessors and with direct use
+// of a small LRU cache, and checks every answer. It is meant to be run with
+// the race detector.
+func runStress(t *testing.T, cfg stressConfig) {
+	transport := &handlerTransport{handler: NewHandler(stressOracle{}), failEvery: cfg.failEvery}
+	clientConfig := testClientConfig()
+	clientConfig.Transport = transport
+	clientConfig.WeightCacheCapacity = cfg.cacheCapacity
+	clientConfig.TotalWeightCacheCapacity = cfg.cacheCapacity / 4
+	clientConfig.MaxConcurrentRequests = 8
+	clientConfig.EndpointConcurrency = map[string]int{"/weight": 4}
+	clientConfig.Bounds = WeightBounds{MaxRatioPPM: 1000000}
+	clientConfig.Retry = RetryPolicy{MaxAttempts: 2, Retryable: RetryConnect}
+	client := NewClientWithConfig(&url.URL{Scheme: "http", Host: "weights.invalid"}, clientConfig)
+	client.sleep = func(time.Duration) {}
+
+	lru := newLRUCache[int, int](cfg.cacheCapacity)
+
+	var lookups, failed atomic.Uint64
+	var wg sync.WaitGroup
+	for g := 0; g < cfg.goroutines; g++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(seed))
+			for i := 0; i < cfg.ops; i++ {
+				round := basics.Round(1 + rng.Intn(cfg.rounds))
+				account := rng.Intn(cfg.accounts)
+				switch op := rng.Intn(100); {
+				case op < 60:
+					addr, selID := makeTestAddress(account), makeTestSelectionID(account)
+					w, err := client.Weight(round, addr, selID)
+					lookups.Add(1)
+					if err != nil {
+						failed.Add(1)
+						continue
+					}
+					if w != stressWeight(round, addr, selID) {
+						t.Errorf("weight of account %d in round %d: got %d, want %d", account, round, w, stressWeight(round, addr, selID))
+						return
+					}
+				case op < 80:
+					voteRound := round + basics.Round(rng.Intn(3))
+					total, err := client.TotalWeight(round, voteRound)
+					lookups.Add(1)
+					if err != nil {
+						failed.Add(1)
+						continue
+					}
+					if total != stressTotal(round, voteRound) {
+						t.Errorf("total weight of rounds %d/%d: got %d, want %d", round, voteRound, total, stressTotal(round, voteRound))
+						return
+					}
+				case op < 85:
+					client.Ping()
+				case op < 88:
+					client.Identity()
+					client.LastIdentity()
+				case op < 90:
+					client.Stats()
+					client.RecentQueries()
+				default:
+					key := account*cfg.rounds + int(round)
+					if v, ok := lru.Get(key); ok && v != -key {
+						t.Errorf("lru entry %d holds %d", key, v)
+						return
+					}
+					lru.Put(key, -key)
+				}
+			}
+		}(int64(g))
+	}
+	wg.Wait()
+
+	stats := client.Stats()
+	require.Equal(t, transport.requests.Load(), stats.Queries)
+	require.LessOrEqual(t, stats.CacheHits, lookups.Load())
+	require.LessOrEqual(t, client.weightCache.Len(), cfg.cacheCapacity)
+	require.LessOrEqual(t, lru.Len(), cfg.cacheCapacity)
+	require.LessOrEqual(t, len(client.RecentQueries()), RecentQueryCapacity)
+	if cfg.failEvery > 0 {
+		require.NotZero(t, transport.failures.Load())
+	}
+	// Only requests whose retry also failed surface to the caller.
+	require.LessOrEqual(t, failed.Load(), transport.failures.Load())
+	t.Logf("%d lookups, %d cache hits, %d requests, %d injected failures, %d failed lookups",
+		lookups.Load(), stats.CacheHits, stats.Queries, transport.failures.Load(), failed.Load())
+}
+
+func TestClientStress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := stressConfig{goroutines: 16, ops: 500, accounts: 50, rounds: 20, cacheCapacity: 64, failEvery: 7}
+	if testing.Short() {
+		cfg.ops = 100
+	}
+	runStress(t, cfg)
+}
+
+func TestLongClientStress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// This test runs hundreds of goroutines for a few minutes, and is meant
+	// to be run manually under the race detector, as:
+	//
+	//   WEIGHTORACLE_STRESS=x go test -race . -run=LongClientStress -count=1 -timeout=0
+
+	if os.Getenv("WEIGHTORACLE_STRESS") == "" {
+		t.Skip("Skipping; WEIGHTORACLE_STRESS not set")
+	}
+	runStress(t, stressConfig{goroutines: 256, ops: 5000, accounts: 2000, rounds: 500, cacheCapacity: 1024, failEvery: 13})
+}
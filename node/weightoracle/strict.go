@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// MaxResponseStringLength is the longest JSON string, key or value,
+	// accepted in a response when strict parsing is enabled. The protocol's
+	// strings are addresses, hashes, decimals and short messages.
+	MaxResponseStringLength = 1 << 10
+
+	// maxResponseDepth bounds the nesting of JSON values in a strictly parsed
+	// response; /weight_table answers nest three deep.
+	maxResponseDepth = 8
+)
+
+// decodeStrict decodes a response body into result, rejecting bodies that a
+// conforming daemon never sends: unknown or repeated fields, overlong strings,
+// deep nesting and data after the response object. The body is checked in
+// full before anything is decoded into result.
+func decodeStrict(data []byte, result interface{}) error {
+	if err := checkStrict(data, false); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(result)
+}
+
+// checkStrict checks a response body as decodeStrict does, without decoding
+// it. The body is a single value unless stream is set, when it is a sequence
+// of values such as an NDJSON weight table.
+func checkStrict(data []byte, stream bool) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if stream {
+		for dec.More() {
+			if err := checkValue(dec, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := checkValue(dec, 0); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data after response object")
+	}
+	return nil
+}
+
+// checkValue reads the next JSON value from dec, checking its string lengths,
+// nesting depth and object keys.
+func checkValue(dec *json.Decoder, depth int) error {
+	if depth > maxResponseDepth {
+		return fmt.Errorf("response nests deeper than %d levels", maxResponseDepth)
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch t := tok.(type) {
+	case string:
+		return checkStringLength(t)
+	case json.Delim:
+		switch t {
+		case '{':
+			seen := make(map[string]bool)
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key := keyTok.(string)
+				if err := checkStringLength(key); err != nil {
+					return err
+				}
+				if seen[key] {
+					return fmt.Errorf("response repeats field %q", key)
+				}
+				seen[key] = true
+				if err := checkValue(dec, depth+1); err != nil {
+					return err
+				}
+			}
+		case '[':
+			for dec.More() {
+				if err := checkValue(dec, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		// The closing delimiter.
+		_, err := dec.Token()
+		return err
+	}
+	return nil
+}
+
+func checkStringLength(s string) error {
+	if len(s) > MaxResponseStringLength {
+		return fmt.Errorf("response string of %d bytes exceeds %d bytes", len(s), MaxResponseStringLength)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestStrictResponses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	long := strings.Repeat("x", MaxResponseStringLength+1)
+	tests := []struct {
+		name string
+		body string
+		want string // empty if accepted
+	}{
+		{"plain", `{"pong":true}`, ""},
+		{"error fields", `{"pong":true,"error":"","code":""}`, ""},
+		{"unknown field", `{"pong":true,"extra":1}`, `unknown field "extra"`},
+		{"repeated field", `{"pong":false,"pong":true}`, `repeats field "pong"`},
+		{"long value", `{"pong":true,"extra":"` + long + `"}`, "exceeds"},
+		{"long key", `{"pong":true,"` + long + `":1}`, "exceeds"},
+		{"deep nesting", `{"pong":true,"x":` + strings.Repeat("[", 20) + strings.Repeat("]", 20) + `}`, "deeper than"},
+		{"trailing data", `{"pong":true}{"pong":true}`, "after response object"},
+	}
+	for _, test := range tests {
+		// Without strict parsing everything but the repeated field and trailing
+		// data is accepted.
+		client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1}, respond(http.StatusOK, test.body))
+		lenientErr := client.Ping()
+		if test.name == "trailing data" {
+			require.Error(t, lenientErr, test.name)
+		} else {
+			require.NoError(t, lenientErr, test.name)
+		}
+
+		client, transport, _ := faultClient(RetryPolicy{MaxAttempts: 3, Retryable: allRetryable}, respond(http.StatusOK, test.body))
+		client.strict = true
+		err := client.Ping()
+		if test.want == "" {
+			require.NoError(t, err, test.name)
+			continue
+		}
+		require.ErrorContains(t, err, "failed to decode response", test.name)
+		require.ErrorContains(t, err, test.want, test.name)
+		require.Equal(t, 1, transport.attempts, test.name)
+	}
+}
+
+// TestStrictWeightTable tests that strict parsing applies to the entries of a
+// weight table.
+func TestStrictWeightTable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}.String()
+	client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1},
+		respond(http.StatusOK, `{"weights":[{"address":"`+addr+`","weight":"5"}]}`),
+		respond(http.StatusOK, `{"weights":[{"address":"`+addr+`","weight":"5","stake":"7"}]}`))
+	client.strict = true
+	entries, err := client.WeightTable(1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	_, err = client.WeightTable(1)
+	require.ErrorContains(t, err, `unknown field "stake"`)
+}
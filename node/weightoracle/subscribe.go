@@ -0,0 +1,317 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// A /subscribe request is a long poll: the daemon holds it until it has the
+// weight table of a balance round at or after "from", and answers with the
+// earliest such table, or with no balance round once "wait_ms" has passed.
+// The client asks again from the round after the one it got, so tables are
+// pushed to it as the daemon computes them.
+
+const (
+	// SubscribeWait is how long a Subscriber asks the daemon to hold each
+	// /subscribe request before answering that no table is ready.
+	SubscribeWait = 30 * time.Second
+
+	// MaxSubscribeWait bounds how long a Server holds a /subscribe request.
+	MaxSubscribeWait = 2 * time.Minute
+
+	// subscribeRetryInterval is how long a Subscriber waits after a failed
+	// /subscribe request before sending another.
+	subscribeRetryInterval = time.Second
+)
+
+// ErrNoNewWeightTable is returned by NextWeightTable when the daemon had no
+// weight table to push before the wait ran out.
+var ErrNoNewWeightTable = errors.New("no new weight table from the weight daemon")
+
+// subscribeRequest asks for the weight table of the earliest balance round at
+// or after From, waiting up to WaitMS milliseconds for one.
+type subscribeRequest struct {
+	From   string `json:"from"`
+	WaitMS string `json:"wait_ms"`
+}
+
+// subscribeResponse is the answer to a /subscribe request. BalanceRound is
+// empty if no table became available in time.
+type subscribeResponse struct {
+	BalanceRound string             `json:"balance_round,omitempty"`
+	Weights      []weightTableEntry `json:"weights,omitempty"`
+	Error        string             `json:"error,omitempty"`
+	Code         string             `json:"code,omitempty"`
+}
+
+// WeightTablePublisher is implemented by oracles that know when their weight
+// tables become available. A Server answers /subscribe only for oracles that
+// implement it as well as WeightTabler.
+type WeightTablePublisher interface {
+	// NextWeightTable waits until the weight table of a balance round at or
+	// after from is available and returns the earliest such round. It returns
+	// ctx's error if ctx is done first.
+	NextWeightTable(ctx context.Context, from basics.Round) (basics.Round, error)
+}
+
+func (h *handler) subscribe(w http.ResponseWriter, r *http.Request) {
+	var req subscribeRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+	from, ok := parseRound(w, "from", req.From)
+	if !ok {
+		return
+	}
+	waitMS, err := strconv.ParseUint(req.WaitMS, 10, 32)
+	if err != nil {
+		writeError(w, badRequest("Invalid wait_ms: %q", req.WaitMS))
+		return
+	}
+	publisher, ok := h.oracle.(WeightTablePublisher)
+	tabler, tables := h.oracle.(WeightTabler)
+	if !ok || !tables {
+		writeError(w, &ledgercore.DaemonError{Code: "unsupported", Msg: "Weight table subscriptions unavailable from this oracle"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), min(time.Duration(waitMS)*time.Millisecond, MaxSubscribeWait))
+	defer cancel()
+	balanceRound, err := publisher.NextWeightTable(ctx, from)
+	if err != nil {
+		if ctx.Err() != nil {
+			writeSubscribeAnswer(w, r, 0, nil, false)
+			return
+		}
+		writeError(w, err)
+		return
+	}
+	table, err := tabler.WeightTable(balanceRound)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeSubscribeAnswer(w, r, balanceRound, table, true)
+}
+
+// writeSubscribeAnswer answers a /subscribe request, in the encoding it was
+// sent in, with the table of balanceRound if found, or with no table.
+func writeSubscribeAnswer(w http.ResponseWriter, r *http.Request, balanceRound basics.Round, table []ledgercore.WeightTableEntry, found bool) {
+	if isMsgpack(r.Header.Get("Content-Type")) {
+		var resp mpSubscribeResponse
+		if found {
+			rnd := uint64(balanceRound)
+			resp.BalanceRound = &rnd
+			resp.Weights = make([]mpWeightTableEntry, len(table))
+			for i, e := range table {
+				resp.Weights[i] = makeMsgpackTableEntry(e)
+			}
+		}
+		writeMsgpack(w, resp)
+		return
+	}
+	var resp subscribeResponse
+	if found {
+		resp.BalanceRound = strconv.FormatUint(uint64(balanceRound), 10)
+		resp.Weights = make([]weightTableEntry, len(table))
+		for i, e := range table {
+			resp.Weights[i] = makeWeightTableEntry(e)
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// NextWeightTable asks the daemon for the weight table of the earliest balance
+// round at or after from, waiting up to wait for one to become available, and
+// caches its weights as WarmWeightCache does. It returns the table's balance
+// round and the number of weights cached, or ErrNoNewWeightTable if the wait
+// ran out. The request is abandoned when ctx is done. It is sent once, outside
+// the retry, rate and concurrency limits of queries, and only over HTTP: over
+// gRPC, or to a daemon that does not list CapabilityPush, it fails with an
+// unsupported DaemonError.
+func (c *Client) NextWeightTable(ctx context.Context, from basics.Round, wait time.Duration) (basics.Round, int, error) {
+	d := c.activeDaemon()
+	if d.grpc != nil {
+		return 0, 0, daemonError("unsupported", "/subscribe is not part of the gRPC protocol")
+	}
+	if !c.daemonSupports(CapabilityPush) {
+		return 0, 0, daemonError("unsupported", "the weight daemon does not list the push capability")
+	}
+	req := subscribeRequest{
+		From:   strconv.FormatUint(uint64(from), 10),
+		WaitMS: strconv.FormatInt(wait.Milliseconds(), 10),
+	}
+	body, err := encodeRequest(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	defer body.release()
+
+	var resp subscribeResponse
+	if _, err := c.attemptHTTP(ctx, d, "/subscribe", req, body, &resp, wait+c.queryTimeout); err != nil {
+		return 0, 0, err
+	}
+	c.answered()
+	if resp.Error != "" {
+		return 0, 0, daemonError(resp.Code, resp.Error)
+	}
+	if resp.BalanceRound == "" {
+		return 0, 0, ErrNoNewWeightTable
+	}
+	rnd, err := strconv.ParseUint(resp.BalanceRound, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid balance_round %q in subscribe response", truncateText(resp.BalanceRound))
+	}
+	balanceRound := basics.Round(rnd)
+	if balanceRound < from {
+		return 0, 0, fmt.Errorf("weight daemon pushed balance round %d, before the requested %d", balanceRound, from)
+	}
+
+	cached := 0
+	for i := range resp.Weights {
+		e, err := resp.Weights[i].convert(i)
+		if err != nil {
+			return balanceRound, cached, err
+		}
+		ok, err := c.cacheTableEntry(balanceRound, e)
+		if err != nil {
+			return balanceRound, cached, err
+		}
+		if ok {
+			cached++
+		}
+	}
+	return balanceRound, cached, nil
+}
+
+// SubscribeHorizon returns the balance rounds whose weight tables a
+// Subscriber or Importer caches once latest is the latest committed round:
+// from first, the one the next vote round needs, through last.
+type SubscribeHorizon func(latest basics.Round) (first, last basics.Round, err error)
+
+// Subscriber keeps the client's weight cache filled with the weight tables
+// the daemon pushes over /subscribe, so that agreement finds the weights of
+// upcoming rounds cached instead of pulling them while it verifies votes. It
+// asks for tables only as far ahead as its horizon, which moves as the ledger
+// advances, and stops for good if the daemon does not support subscriptions.
+type Subscriber struct {
+	client  *Client
+	horizon SubscribeHorizon
+	wait    time.Duration
+
+	// latest holds the round of the last Advance not yet taken by Run.
+	latest chan basics.Round
+
+	// next is the first balance round whose table has not been pushed, and
+	// through the last one within the horizon. Run asks for tables while
+	// next <= through.
+	next, through basics.Round
+	started       bool
+}
+
+// NewSubscriber returns a Subscriber of the tables within horizon, through
+// client. It does nothing until Run is called and the first Advance.
+func NewSubscriber(client *Client, horizon SubscribeHorizon) *Subscriber {
+	return &Subscriber{
+		client:  client,
+		horizon: horizon,
+		wait:    SubscribeWait,
+		latest:  make(chan basics.Round, 1),
+	}
+}
+
+// Advance tells the subscriber that latest has been committed. It does not
+// block. Advance must not be called concurrently with itself.
+func (s *Subscriber) Advance(latest basics.Round) {
+	offerLatest(s.latest, latest)
+}
+
+// Run receives the daemon's weight tables until done is closed, or until the
+// daemon turns out not to push them.
+func (s *Subscriber) Run(done <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case latest := <-s.latest:
+			s.advance(latest)
+		default:
+		}
+		if !s.started || s.next > s.through {
+			select {
+			case latest := <-s.latest:
+				s.advance(latest)
+			case <-done:
+				return
+			}
+			continue
+		}
+
+		balanceRound, cached, err := s.client.NextWeightTable(ctx, s.next, s.wait)
+		switch {
+		case err == nil:
+			logging.Base().Debugf("cached %d weights of balance round %d pushed by the weight daemon", cached, balanceRound)
+			s.next = balanceRound + 1
+		case errors.Is(err, ErrNoNewWeightTable):
+		case ctx.Err() != nil:
+			return
+		case ledgercore.IsDaemonError(err, "unsupported") || ledgercore.IsDaemonError(err, "not_found"):
+			logging.Base().Infof("weight daemon does not push weight tables, weights are pulled as needed: %v", err)
+			return
+		default:
+			logging.Base().Debugf("weight tables from balance round %d not received: %v", s.next, err)
+			select {
+			case <-time.After(subscribeRetryInterval):
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+// advance moves the horizon to that of latest. Tables before the new horizon
+// are no longer asked for.
+func (s *Subscriber) advance(latest basics.Round) {
+	first, last, err := s.horizon(latest)
+	if err != nil {
+		logging.Base().Debugf("weight tables after round %d not subscribed to: %v", latest, err)
+		return
+	}
+	if !s.started || s.next < first {
+		s.next = first
+	}
+	s.through = last
+	s.started = true
+}
@@ -0,0 +1,233 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// publishingOracle adds weight tables, published one balance round at a
+// time, to a mock oracle.
+type publishingOracle struct {
+	*mock.Oracle
+
+	mu      sync.Mutex
+	tables  map[basics.Round][]ledgercore.WeightTableEntry
+	changed chan struct{}
+}
+
+func newPublishingOracle() *publishingOracle {
+	return &publishingOracle{
+		Oracle:  mock.New(),
+		tables:  make(map[basics.Round][]ledgercore.WeightTableEntry),
+		changed: make(chan struct{}),
+	}
+}
+
+func (o *publishingOracle) publish(balanceRound basics.Round, table []ledgercore.WeightTableEntry) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.tables[balanceRound] = table
+	close(o.changed)
+	o.changed = make(chan struct{})
+}
+
+func (o *publishingOracle) WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	table, ok := o.tables[balanceRound]
+	if !ok {
+		return nil, &ledgercore.DaemonError{Code: "not_found", Msg: "no table"}
+	}
+	return table, nil
+}
+
+func (o *publishingOracle) NextWeightTable(ctx context.Context, from basics.Round) (basics.Round, error) {
+	for {
+		o.mu.Lock()
+		found := false
+		var earliest basics.Round
+		for rnd := range o.tables {
+			if rnd >= from && (!found || rnd < earliest) {
+				earliest, found = rnd, true
+			}
+		}
+		changed := o.changed
+		o.mu.Unlock()
+		if found {
+			return earliest, nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+func testTable(addr basics.Address, weight uint64) []ledgercore.WeightTableEntry {
+	return []ledgercore.WeightTableEntry{
+		{Addr: addr, SelectionID: crypto.VRFVerifier{1}, Weight: weight},
+		// Weights without a selection ID are not cached.
+		{Addr: makeTestAddress(99), Weight: 5},
+	}
+}
+
+func TestNextWeightTable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	oracle := newPublishingOracle()
+	oracle.publish(10, testTable(addr, 700))
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientURL(server.URL())
+	ctx := context.Background()
+
+	rnd, cached, err := client.NextWeightTable(ctx, 5, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(10), rnd)
+	require.Equal(t, 1, cached)
+	w, err := client.Weight(10, addr, crypto.VRFVerifier{1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), w)
+	require.Zero(t, oracle.CallCount(mock.MethodWeight))
+
+	_, _, err = client.NextWeightTable(ctx, 11, 50*time.Millisecond)
+	require.ErrorIs(t, err, ErrNoNewWeightTable)
+
+	// A table published while the request waits is pushed at once.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		oracle.publish(12, testTable(addr, 800))
+	}()
+	start := time.Now()
+	rnd, _, err = client.NextWeightTable(ctx, 11, 10*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(12), rnd)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// Cancelling the context abandons the request.
+	cancelled, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	_, _, err = client.NextWeightTable(cancelled, 13, 10*time.Second)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNoNewWeightTable)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestNextWeightTableUnsupported(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server, err := StartServer("127.0.0.1:0", mock.New())
+	require.NoError(t, err)
+	defer server.Close()
+
+	_, _, err = NewClientURL(server.URL()).NextWeightTable(context.Background(), 1, time.Second)
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"), "%v", err)
+}
+
+func TestSubscriber(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1)
+	oracle := newPublishingOracle()
+	server, err := StartServer("127.0.0.1:0", oracle)
+	require.NoError(t, err)
+	defer server.Close()
+	client := NewClientURL(server.URL())
+
+	// The subscriber caches the tables of the latest round and the next two.
+	s := NewSubscriber(client, func(latest basics.Round) (basics.Round, basics.Round, error) {
+		return latest, latest + 2, nil
+	})
+	s.wait = 100 * time.Millisecond
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		s.Run(done)
+		close(stopped)
+	}()
+
+	s.Advance(10)
+	for rnd := basics.Round(9); rnd <= 12; rnd++ {
+		oracle.publish(rnd, testTable(addr, uint64(rnd)*100))
+	}
+	cachedRound := func(rnd basics.Round) func() bool {
+		return func() bool {
+			_, ok := client.cachedWeight(rnd, makeWeightCacheKey(rnd, addr, crypto.VRFVerifier{1}))
+			return ok
+		}
+	}
+	for rnd := basics.Round(10); rnd <= 12; rnd++ {
+		require.Eventually(t, cachedRound(rnd), 5*time.Second, 10*time.Millisecond)
+	}
+	require.False(t, cachedRound(9)())
+
+	// The horizon moves with the ledger.
+	s.Advance(11)
+	oracle.publish(13, testTable(addr, 1300))
+	require.Eventually(t, cachedRound(13), 5*time.Second, 10*time.Millisecond)
+	require.Zero(t, oracle.CallCount(mock.MethodWeight))
+
+	close(done)
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "subscriber did not stop")
+	}
+}
+
+func TestSubscriberStopsWithoutSupport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server, err := StartServer("127.0.0.1:0", mock.New())
+	require.NoError(t, err)
+	defer server.Close()
+
+	s := NewSubscriber(NewClientURL(server.URL()), func(latest basics.Round) (basics.Round, basics.Round, error) {
+		return latest, latest + 2, nil
+	})
+	s.Advance(10)
+	stopped := make(chan struct{})
+	go func() {
+		s.Run(make(chan struct{}))
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "subscriber did not stop")
+	}
+}
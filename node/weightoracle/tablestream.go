@@ -0,0 +1,329 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// ndjsonContentType is the media type of a /weight_table answer sent as one
+// entry object per line instead of a single {"weights":[...]} object. The
+// client asks for it, and accepts either.
+const ndjsonContentType = "application/x-ndjson"
+
+// weightTableYieldEntries is how many weight table entries are decoded between
+// yields to the scheduler.
+const weightTableYieldEntries = 4096
+
+var errResponseTooLarge = errors.New("response exceeds the size limit")
+
+// streamingResult is implemented by results that are decoded while their
+// response body is read, rather than from a buffered copy of it.
+type streamingResult interface {
+	// decodeStream decodes a successful response from r. It starts over from
+	// the beginning when a request is retried.
+	decodeStream(r io.Reader, contentType string, strict bool) error
+}
+
+// isNDJSON reports whether contentType names the NDJSON media type.
+func isNDJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == ndjsonContentType
+}
+
+// acceptsNDJSON reports whether r lists the NDJSON media type in its Accept
+// header.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			if isNDJSON(strings.TrimSpace(mediaRange)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// streamResponse decodes a successful response into result as the body
+// arrives, reading no more than limit bytes of it.
+func streamResponse(ctx context.Context, endpoint string, resp *http.Response, limit int64, result streamingResult) (ErrorClass, error) {
+	body := &responseStream{r: resp.Body, remaining: limit}
+	if err := result.decodeStream(body, resp.Header.Get("Content-Type"), false); err != nil {
+		switch {
+		case body.readErr != nil:
+			return transportErrorClass(ctx), fmt.Errorf("failed to read response from weight daemon: %w", body.readErr)
+		case body.exceeded:
+			return 0, fmt.Errorf("weight daemon response to %s exceeds %d bytes", endpoint, limit)
+		}
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return 0, nil
+}
+
+// decodeBuffered decodes a successful response already read into data, first
+// checking it as decodeStrict would if strict is set.
+func decodeBuffered(result streamingResult, data []byte, contentType string, strict bool) error {
+	if strict && !isMsgpack(contentType) {
+		if err := checkStrict(data, isNDJSON(contentType)); err != nil {
+			return err
+		}
+	}
+	return result.decodeStream(bytes.NewReader(data), contentType, strict)
+}
+
+// responseStream reads a response body up to a size limit, remembering why
+// reading stopped so that a failed decode can be attributed to the transport,
+// the limit or the body itself.
+type responseStream struct {
+	r         io.Reader
+	remaining int64
+	exceeded  bool
+	readErr   error
+}
+
+func (s *responseStream) Read(p []byte) (int, error) {
+	if s.remaining == 0 {
+		// Only the end of the body is acceptable here.
+		var probe [1]byte
+		p = probe[:]
+	} else if int64(len(p)) > s.remaining {
+		p = p[:s.remaining]
+	}
+	n, err := s.r.Read(p)
+	if s.remaining == 0 && n > 0 {
+		s.exceeded = true
+		return 0, errResponseTooLarge
+	}
+	s.remaining -= int64(n)
+	if err != nil && err != io.EOF {
+		s.readErr = err
+	}
+	return n, err
+}
+
+// weightTableDecoder decodes a /weight_table answer one entry at a time into a
+// single reused wire entry, passing each converted entry to visit, so that a
+// large table is never held in memory in both forms. Of the rest of the
+// answer only the daemon's error is kept. Keys match as in json.Unmarshal,
+// including a repeated weights field replacing the earlier one.
+type weightTableDecoder struct {
+	// reset is called whenever decoding starts over, after which visit sees
+	// the table again from its first entry.
+	reset func()
+	visit func(ledgercore.WeightTableEntry) error
+
+	entry   weightTableEntry
+	entries int
+	found   bool
+	errText string
+	code    string
+}
+
+func (d *weightTableDecoder) decodeStream(r io.Reader, contentType string, strict bool) error {
+	d.errText, d.code = "", ""
+	d.restart()
+	if isMsgpack(contentType) {
+		return d.decodeMsgpack(r, strict)
+	}
+	dec := json.NewDecoder(r)
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+
+	if isNDJSON(contentType) {
+		d.found = true
+		for {
+			err := d.decodeEntry(dec)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		// A null answer has no weights field.
+		return expectEOF(dec)
+	}
+	if tok != json.Delim('{') {
+		return errors.New("weight_table response is not a JSON object")
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+		switch {
+		case strings.EqualFold(key, "weights"):
+			err = d.decodeWeights(dec)
+		case strings.EqualFold(key, "error"):
+			err = dec.Decode(&d.errText)
+		case strings.EqualFold(key, "code"):
+			err = dec.Decode(&d.code)
+		case strict:
+			err = fmt.Errorf("json: unknown field %q", truncateText(key))
+		default:
+			var skipped json.RawMessage
+			err = dec.Decode(&skipped)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// The closing brace.
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	return expectEOF(dec)
+}
+
+func (d *weightTableDecoder) restart() {
+	d.entries = 0
+	d.found = false
+	if d.reset != nil {
+		d.reset()
+	}
+}
+
+// decodeWeights decodes the value of a weights field.
+func (d *weightTableDecoder) decodeWeights(dec *json.Decoder) error {
+	d.restart()
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return errors.New("weights field is not an array")
+	}
+	d.found = true
+	for dec.More() {
+		if err := d.decodeEntry(dec); err != nil {
+			return err
+		}
+	}
+	// The closing bracket.
+	_, err = dec.Token()
+	return err
+}
+
+// decodeEntry decodes the next entry from dec and visits it. Entries that
+// follow an error field are decoded but not visited: the answer is an error.
+func (d *weightTableDecoder) decodeEntry(dec *json.Decoder) error {
+	d.entry = weightTableEntry{}
+	if err := dec.Decode(&d.entry); err != nil {
+		return err
+	}
+	i := d.entries
+	d.entries++
+	if d.errText != "" {
+		return nil
+	}
+	entry, err := d.entry.convert(i)
+	if err != nil {
+		return err
+	}
+	return d.visitEntry(entry)
+}
+
+// visitEntry visits the latest entry decoded, yielding to the scheduler every
+// weightTableYieldEntries entries.
+func (d *weightTableDecoder) visitEntry(entry ledgercore.WeightTableEntry) error {
+	if d.entries%weightTableYieldEntries == 0 {
+		runtime.Gosched()
+	}
+	return d.visit(entry)
+}
+
+// convert checks the i'th entry of a weight table and converts it from its
+// wire form.
+func (e *weightTableEntry) convert(i int) (ledgercore.WeightTableEntry, error) {
+	var entry ledgercore.WeightTableEntry
+	addr, err := basics.UnmarshalChecksumAddress(e.Address)
+	if err != nil {
+		return entry, fmt.Errorf("invalid address %q in weight_table entry %d: %w", truncateText(e.Address), i, err)
+	}
+	entry.Addr = addr
+
+	if e.SelectionID != "" {
+		if hex.DecodedLen(len(e.SelectionID)) != len(entry.SelectionID) {
+			if _, err := hex.DecodeString(e.SelectionID); err != nil {
+				return entry, fmt.Errorf("invalid selection_id %q in weight_table entry %d: %w", truncateText(e.SelectionID), i, err)
+			}
+			return entry, fmt.Errorf("invalid selection_id length in weight_table entry %d: expected %d bytes, got %d",
+				i, len(entry.SelectionID), hex.DecodedLen(len(e.SelectionID)))
+		}
+		if _, err := hex.Decode(entry.SelectionID[:], []byte(e.SelectionID)); err != nil {
+			return entry, fmt.Errorf("invalid selection_id %q in weight_table entry %d: %w", truncateText(e.SelectionID), i, err)
+		}
+	}
+
+	weight, err := parseDecimal("weight", e.Weight)
+	if err != nil {
+		return entry, fmt.Errorf("%w in weight_table entry %d", err, i)
+	}
+	entry.Weight = weight
+	return entry, nil
+}
+
+// makeWeightTableEntry returns the wire form of e.
+func makeWeightTableEntry(e ledgercore.WeightTableEntry) weightTableEntry {
+	entry := weightTableEntry{
+		Address: e.Addr.String(),
+		Weight:  strconv.FormatUint(e.Weight, 10),
+	}
+	if e.SelectionID != (crypto.VRFVerifier{}) {
+		entry.SelectionID = hex.EncodeToString(e.SelectionID[:])
+	}
+	return entry
+}
+
+// expectEOF checks that nothing but whitespace follows the response object.
+func expectEOF(dec *json.Decoder) error {
+	_, err := dec.Token()
+	switch err {
+	case io.EOF:
+		return nil
+	case nil:
+		return errors.New("unexpected data after response object")
+	}
+	return err
+}
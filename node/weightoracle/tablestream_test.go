@@ -0,0 +1,347 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-deadlock"
+)
+
+// respondNDJSON answers with a 200 whose body is an NDJSON weight table.
+func respondNDJSON(body string) fault {
+	return func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{ndjsonContentType + "; charset=utf-8"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+}
+
+var testResponseKey = []byte("response-key")
+
+// signResponses signs the answers of next with key, as RequireSignatures does.
+func signResponses(key []byte, next fault) fault {
+	return func(req *http.Request) (*http.Response, error) {
+		resp, err := next(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		nonce := hex.EncodeToString(nextNonce())
+		resp.Header.Set(ResponseNonceHeader, nonce)
+		resp.Header.Set(ResponseSignatureHeader, responseSignature(key, req.Header.Get(SignatureHeader), nonce, resp.StatusCode, body))
+		return resp, nil
+	}
+}
+
+// TestWeightTableNDJSON tests that a weight table sent as NDJSON is decoded
+// like the same table in a JSON object, and that the client asks for it.
+func TestWeightTableNDJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr1, addr2 := makeTestAddress(1), makeTestAddress(2)
+	selID := makeTestSelectionID(1)
+	var accept string
+	client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1}, func(req *http.Request) (*http.Response, error) {
+		accept = req.Header.Get("Accept")
+		return respondNDJSON(`{"address":"` + addr1.String() + `","selection_id":"` + hex.EncodeToString(selID[:]) + `","weight":"10"}` + "\n\n" +
+			`{"address":"` + addr2.String() + `","weight":"20"}` + "\n")(req)
+	})
+
+	table, err := client.WeightTable(3)
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.WeightTableEntry{
+		{Addr: addr1, SelectionID: selID, Weight: 10},
+		{Addr: addr2, Weight: 20},
+	}, table)
+	require.Contains(t, accept, ndjsonContentType)
+
+	client, _, _ = faultClient(RetryPolicy{MaxAttempts: 1}, respondNDJSON(""))
+	table, err = client.WeightTable(3)
+	require.NoError(t, err)
+	require.Empty(t, table)
+
+	client, _, _ = faultClient(RetryPolicy{MaxAttempts: 1}, respondNDJSON(`{"address":"`+addr1.String()+`","weight":"10"}`+"\n"+`{"address":"nope","weight":"1"}`))
+	_, err = client.WeightTable(3)
+	require.ErrorContains(t, err, "invalid address \"nope\" in weight_table entry 1")
+}
+
+// TestWeightTableStreamDecoding tests that a streamed weight table is read the
+// way json.Unmarshal would read it, whether or not it is buffered first.
+func TestWeightTableStreamDecoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1).String()
+	entry := func(weight int) string {
+		return fmt.Sprintf(`{"address":"%s","weight":"%d"}`, addr, weight)
+	}
+	tests := []struct {
+		name    string
+		body    string
+		weights []uint64
+		err     string
+	}{
+		{"object", `{"weights":[` + entry(1) + `,` + entry(2) + `]}`, []uint64{1, 2}, ""},
+		{"key case", `{"Weights":[` + entry(1) + `]}`, []uint64{1}, ""},
+		{"unknown fields", `{"round":"5","weights":[` + entry(1) + `],"extra":{"a":[1,2]}}`, []uint64{1}, ""},
+		{"repeated weights", `{"weights":[` + entry(1) + `,` + entry(2) + `],"weights":[` + entry(3) + `]}`, []uint64{3}, ""},
+		{"null weights", `{"weights":null}`, nil, "missing weights field"},
+		{"weights then null", `{"weights":[` + entry(1) + `],"weights":null}`, nil, "missing weights field"},
+		{"null body", `null`, nil, "missing weights field"},
+		{"null entry", `{"weights":[null]}`, nil, "invalid address"},
+		{"weights not array", `{"weights":{}}`, nil, "not an array"},
+		{"not object", `[]`, nil, "not a JSON object"},
+		{"trailing data", `{"weights":[]} {}`, nil, "unexpected data after response object"},
+		{"truncated", `{"weights":[` + entry(1), nil, "failed to decode response"},
+		{"error", `{"error":"no snapshot","code":"not_found","weights":[{"address":"nope"}]}`, nil, "no snapshot"},
+		{"numeric weight", `{"weights":[{"address":"` + addr + `","weight":5}]}`, nil, "cannot unmarshal"},
+	}
+
+	for _, test := range tests {
+		for _, buffered := range []bool{false, true} {
+			answer := respond(http.StatusOK, test.body)
+			if buffered {
+				answer = signResponses(testResponseKey, answer)
+			}
+			client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1}, answer)
+			if buffered {
+				// Signed answers are checked whole before they are decoded.
+				client.signingKey = testResponseKey
+				client.signedResponses = true
+			}
+			table, err := client.WeightTable(1)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err, "%s buffered=%v", test.name, buffered)
+				continue
+			}
+			require.NoError(t, err, "%s buffered=%v", test.name, buffered)
+			weights := make([]uint64, len(table))
+			for i, e := range table {
+				weights[i] = e.Weight
+			}
+			require.Equal(t, test.weights, weights, "%s buffered=%v", test.name, buffered)
+		}
+	}
+}
+
+// TestWeightTableRetryStartsOver tests that a weight table cut off part way
+// is fetched again from the start, without keeping the entries already seen.
+func TestWeightTableRetryStartsOver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1).String()
+	body := `{"weights":[{"address":"` + addr + `","weight":"1"},{"address":"` + addr + `","weight":"2"}]}`
+	client, transport, _ := faultClient(RetryPolicy{MaxAttempts: 2, Retryable: allRetryable},
+		partialBody(body, strings.Index(body, "},")+2), respond(http.StatusOK, body))
+
+	table, err := client.WeightTable(1)
+	require.NoError(t, err)
+	require.Len(t, table, 2)
+	require.Equal(t, 2, transport.attempts)
+	transport.requireBodiesClosed(t)
+
+	// Without another attempt the transport failure is reported as one.
+	client, _, _ = faultClient(RetryPolicy{MaxAttempts: 1}, partialBody(body, len(body)-3))
+	_, err = client.WeightTable(1)
+	require.ErrorContains(t, err, "failed to read response from weight daemon")
+}
+
+// TestResponseStreamLimit tests that a streamed body may fill its size limit
+// but not exceed it.
+func TestResponseStreamLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s := &responseStream{r: strings.NewReader("12345"), remaining: 5}
+	data, err := io.ReadAll(s)
+	require.NoError(t, err)
+	require.Equal(t, "12345", string(data))
+	require.False(t, s.exceeded)
+
+	s = &responseStream{r: strings.NewReader("123456"), remaining: 5}
+	data, err = io.ReadAll(s)
+	require.ErrorIs(t, err, errResponseTooLarge)
+	require.Equal(t, "12345", string(data))
+	require.True(t, s.exceeded)
+	require.NoError(t, s.readErr)
+}
+
+// TestStrictWeightTableNDJSON tests that strict parsing applies to every line
+// of an NDJSON weight table.
+func TestStrictWeightTableNDJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := makeTestAddress(1).String()
+	tests := []struct {
+		body string
+		err  string
+	}{
+		{`{"address":"` + addr + `","weight":"5"}` + "\n", ""},
+		{`{"address":"` + addr + `","weight":"5","stake":"7"}`, `unknown field "stake"`},
+		{`{"address":"` + addr + `","weight":"5","weight":"6"}`, `repeats field "weight"`},
+		{`{"address":"` + strings.Repeat("A", MaxResponseStringLength+1) + `","weight":"5"}`, "exceeds"},
+	}
+	for _, test := range tests {
+		client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1}, respondNDJSON(test.body))
+		client.strict = true
+		table, err := client.WeightTable(1)
+		if test.err == "" {
+			require.NoError(t, err)
+			require.Len(t, table, 1)
+			continue
+		}
+		require.ErrorContains(t, err, test.err)
+	}
+}
+
+// TestServerWeightTableFormats tests that the server sends NDJSON only to
+// clients that ask for it.
+func TestServerWeightTableFormats(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	table := []ledgercore.WeightTableEntry{{Addr: makeTestAddress(1), Weight: 10}}
+	server, err := StartServer("127.0.0.1:0", tableOracle{Oracle: mock.New(), table: table})
+	require.NoError(t, err)
+	defer server.Close()
+
+	for _, accept := range []string{"", "application/json", "application/json, " + ndjsonContentType + ";q=0.9"} {
+		req, err := http.NewRequest("POST", server.URL().String()+"/weight_table", strings.NewReader(`{"balance_round":"1"}`))
+		require.NoError(t, err)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+
+		wantNDJSON := strings.Contains(accept, ndjsonContentType)
+		require.Equal(t, wantNDJSON, isNDJSON(resp.Header.Get("Content-Type")), accept)
+		if wantNDJSON {
+			require.Equal(t, `{"address":"`+table[0].Addr.String()+`","weight":"10"}`+"\n", string(body))
+		} else {
+			require.Equal(t, `{"weights":[{"address":"`+table[0].Addr.String()+`","weight":"10"}]}`+"\n", string(body))
+		}
+	}
+}
+
+// TestWarmWeightCache tests that warming the cache from the weight table
+// answers later Weight queries for participation keys without the daemon.
+func TestWarmWeightCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	table := []ledgercore.WeightTableEntry{
+		{Addr: makeTestAddress(1), SelectionID: makeTestSelectionID(1), Weight: 10},
+		{Addr: makeTestAddress(2), SelectionID: makeTestSelectionID(2), Weight: 20},
+		{Addr: makeTestAddress(3), Weight: 30},
+	}
+	server, err := StartServer("127.0.0.1:0", tableOracle{Oracle: mock.New(), table: table})
+	require.NoError(t, err)
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL(), testClientConfig())
+	cached, err := client.WarmWeightCache(7)
+	require.NoError(t, err)
+	require.Equal(t, 2, cached)
+
+	before := client.Stats()
+	for _, e := range table[:2] {
+		weight, err := client.Weight(7, e.Addr, e.SelectionID)
+		require.NoError(t, err)
+		require.Equal(t, e.Weight, weight)
+	}
+	require.Equal(t, QueryStats{CacheHits: 2}, client.Stats().Sub(before))
+
+	// Entries outside the weight bounds are not cached.
+	cfg := testClientConfig()
+	cfg.Bounds = WeightBounds{MaxWeight: 15}
+	client = NewClientWithConfig(server.URL(), cfg)
+	cached, err = client.WarmWeightCache(7)
+	var boundsErr *WeightBoundsError
+	require.ErrorAs(t, err, &boundsErr)
+	require.Equal(t, 1, cached)
+}
+
+// BenchmarkWeightTable measures fetching a 100,000 entry weight table, as a
+// JSON object and as NDJSON.
+func BenchmarkWeightTable(b *testing.B) {
+	deadlock.Opts.Disable = true
+	const entries = 100_000
+
+	var object, lines strings.Builder
+	object.WriteString(`{"weights":[`)
+	for i := 0; i < entries; i++ {
+		var addr basics.Address
+		var selID crypto.VRFVerifier
+		addr[0], addr[1], addr[2] = byte(i), byte(i>>8), byte(i>>16)
+		selID[0] = byte(i)
+		entry := fmt.Sprintf(`{"address":"%s","selection_id":"%s","weight":"%d"}`, addr, hex.EncodeToString(selID[:]), i+1)
+		if i > 0 {
+			object.WriteString(",")
+		}
+		object.WriteString(entry)
+		lines.WriteString(entry + "\n")
+	}
+	object.WriteString("]}")
+
+	for _, format := range []struct {
+		name   string
+		answer fault
+	}{
+		{"json", respond(http.StatusOK, object.String())},
+		{"ndjson", respondNDJSON(lines.String())},
+	} {
+		b.Run(format.name, func(b *testing.B) {
+			client, _, _ := faultClient(RetryPolicy{MaxAttempts: 1}, format.answer)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				table, err := client.WeightTable(1)
+				if err != nil || len(table) != entries {
+					b.Fatal(err, len(table))
+				}
+			}
+		})
+	}
+}
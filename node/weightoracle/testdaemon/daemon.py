@@ -30,29 +30,46 @@ Endpoints:
     POST /ping         - Health check
     POST /identity     - Get daemon identity
     POST /weight       - Query individual account weight
+    POST /weights      - Query the weights of a batch of accounts
     POST /total_weight - Query total network weight
+    POST /weight_table - Query every known weight for a balance round
+    POST /key_rotations - Learn of accounts switching to new selection IDs
 
 Request formats:
     /ping:         {} (empty body)
-    /identity:     {} (empty body)
+    /identity:     {} or {"balance_lookback":"<decimal>","seed_lookback":"<decimal>","seed_refresh_interval":"<decimal>"}
     /weight:       {"address":"<base32>","selection_id":"<hex>","balance_round":"<decimal>"}
+    /weights:      {"balance_round":"<decimal>","queries":[{"address":"<base32>","selection_id":"<hex>"}]}
     /total_weight: {"balance_round":"<decimal>","vote_round":"<decimal>"}
+    /weight_table: {"balance_round":"<decimal>"}
+    /key_rotations: {"rotations":[{"address":"<base32>","round":"<decimal>","old_selection_id":"<hex, optional>","new_selection_id":"<hex>"}]}
 
 Success responses:
     /ping:         {"pong":true}
     /identity:     {"genesis_hash":"<base64>","protocol_version":"<str>","algorithm_version":"<str>"}
+                   (optionally with "weight_unit":"<str>" and "weight_scale":"<decimal power of ten>")
     /weight:       {"weight":"<decimal>"}
+    /weights:      {"weights":[{"weight":"<decimal>"} or {"error":"<message>","code":"<code>"}]}
+                   (one entry per query, in order; at most 256 queries per request)
     /total_weight: {"total_weight":"<decimal>"}
+    /weight_table: {"weights":[{"address":"<base32>","selection_id":"<hex, optional>","weight":"<decimal>"}]}
+    /key_rotations: {}
 
 Error response (any endpoint):
     {"error":"<message>","code":"<code>"}
-    HTTP Status: 400 (bad_request), 404 (not_found), 500 (internal)
-    Codes: "not_found", "bad_request", "internal"
+    HTTP Status: 400 (bad_request), 401 (unauthorized), 404 (not_found), 500 (internal)
+    Codes: "not_found", "bad_request", "unauthorized", "internal"
 """
 
 import argparse
 import base64
+import hashlib
+import hmac
 import json
+import os
+import socketserver
+import ssl
+import stat
 import sys
 import threading
 import time
@@ -60,6 +77,26 @@ from http.server import HTTPServer, BaseHTTPRequestHandler
 from typing import Any
 
 
+# How far a signed request's timestamp may be from the daemon's clock, in seconds
+MAX_SIGNATURE_SKEW = 30
+
+# How far below a client's highest nonce counter a request's counter may be, for
+# requests that arrive out of order
+NONCE_WINDOW = 1024
+
+# The most accounts one /weights request may ask about
+MAX_WEIGHTS_BATCH = 256
+
+
+class UnixHTTPServer(socketserver.UnixStreamServer):
+    """HTTP server listening on a unix domain socket."""
+
+    def get_request(self) -> tuple[Any, Any]:
+        request, _ = super().get_request()
+        # BaseHTTPRequestHandler expects a (host, port) client address
+        return request, ("local", 0)
+
+
 class WeightDaemonHandler(BaseHTTPRequestHandler):
     """HTTP request handler for the weight daemon."""
 
@@ -69,10 +106,20 @@ class WeightDaemonHandler(BaseHTTPRequestHandler):
 
     def _send_json_response(self, status_code: int, response: dict[str, Any]) -> None:
         """Send a JSON response with the given status code."""
+        body = json.dumps(response).encode("utf-8")
         self.send_response(status_code)
         self.send_header("Content-Type", "application/json")
+        request_signature = getattr(self, "_request_signature", None)
+        if request_signature is not None:
+            daemon = self.server.daemon  # type: ignore[attr-defined]
+            nonce = daemon._next_nonce()
+            self.send_header("X-Weight-Oracle-Response-Nonce", nonce)
+            self.send_header(
+                "X-Weight-Oracle-Response-Signature",
+                daemon._response_signature(request_signature, nonce, status_code, body),
+            )
         self.end_headers()
-        self.wfile.write(json.dumps(response).encode("utf-8"))
+        self.wfile.write(body)
 
     def _send_json_error(self, status_code: int, message: str, code: str) -> None:
         """Send a JSON error response (always JSON, not HTML)."""
@@ -82,13 +129,29 @@ class WeightDaemonHandler(BaseHTTPRequestHandler):
         """Handle POST requests by routing to the appropriate handler."""
         # Apply latency if configured
         daemon = self.server.daemon  # type: ignore[attr-defined]
+        self._request_signature: str | None = None
         if daemon.latency > 0:
             time.sleep(daemon.latency)
 
+        # Check credentials before looking at the request
+        if daemon.auth_token is not None:
+            if self.headers.get("Authorization", "") != f"Bearer {daemon.auth_token}":
+                self._send_json_error(401, "Missing or invalid credentials", "unauthorized")
+                return
+
         # Read request body
         content_length = int(self.headers.get("Content-Length", 0))
         body = self.rfile.read(content_length)
 
+        # Check the request signature, which covers the body
+        if daemon.signing_key is not None:
+            error = daemon._check_signature(self.path, self.headers, body)
+            if error is not None:
+                self._send_json_error(401, error, "unauthorized")
+                return
+            # Answers to signed requests are signed, binding them to the request
+            self._request_signature = self.headers.get("X-Weight-Oracle-Signature")
+
         try:
             request = json.loads(body) if body else {}
         except json.JSONDecodeError as e:
@@ -99,11 +162,17 @@ class WeightDaemonHandler(BaseHTTPRequestHandler):
         if self.path == "/ping":
             response = daemon._handle_ping()
         elif self.path == "/identity":
-            response = daemon._handle_identity()
+            response = daemon._handle_identity(request)
         elif self.path == "/weight":
             response = daemon._handle_weight(request)
+        elif self.path == "/weights":
+            response = daemon._handle_weights(request)
         elif self.path == "/total_weight":
             response = daemon._handle_total_weight(request)
+        elif self.path == "/weight_table":
+            response = daemon._handle_weight_table(request)
+        elif self.path == "/key_rotations":
+            response = daemon._handle_key_rotations(request)
         else:
             self._send_json_error(404, f"Unknown endpoint: {self.path}", "not_found")
             return
@@ -131,11 +200,19 @@ class WeightDaemon:
         genesis_hash: bytes,
         protocol_version: str = "1.0",
         algorithm_version: str = "1.0",
+        weight_unit: str | None = None,
+        weight_scale: int | None = None,
         latency: float = 0.0,
         weight_table: dict[str, int] | None = None,
         total_weight: int = 1000000,
+        total_weights: dict[str, int] | None = None,
         default_weight: int | None = None,
         address_weights: dict[str, int] | None = None,
+        balance_lookback: int | None = None,
+        auth_token: str | None = None,
+        signing_key: bytes | None = None,
+        socket_path: str | None = None,
+        tls_context: ssl.SSLContext | None = None,
     ):
         """
         Initialize the mock daemon.
@@ -145,47 +222,155 @@ class WeightDaemon:
             genesis_hash: 32-byte genesis hash (will be base64 encoded in responses)
             protocol_version: Weight protocol version string
             algorithm_version: Weight algorithm version string
+            weight_unit: If set, the unit weights are displayed in
+            weight_scale: If set, the number of weight units in one display unit (a power of ten)
             latency: Artificial latency to add to each response (seconds)
             weight_table: Dict mapping "address:selection_id:balance_round" to weight
             total_weight: Default total weight to return
+            total_weights: Dict mapping "balance_round:vote_round" to total weight, overriding total_weight
             default_weight: If set, return this weight for all queries (bypasses table lookup)
             address_weights: Dict mapping just address to weight (simpler lookup, ignores selection_id/round)
+            balance_lookback: If set, reject identity requests whose balance_lookback differs
+            auth_token: If set, require this bearer token on every request
+            signing_key: If set, require every request to be signed with this HMAC key
+            socket_path: If set, listen on a unix domain socket at this path instead of port
+            tls_context: If set, serve HTTPS on port with this server context
         """
         self.port = port
         self.genesis_hash = genesis_hash
         self.protocol_version = protocol_version
         self.algorithm_version = algorithm_version
+        self.weight_unit = weight_unit
+        self.weight_scale = weight_scale
         self.latency = latency
         self.weight_table = weight_table or {}
         self.total_weight = total_weight
+        self.total_weights = total_weights or {}
         self.default_weight = default_weight
         self.address_weights = address_weights or {}
+        self.balance_lookback = balance_lookback
+        self.auth_token = auth_token
+        self.signing_key = signing_key
+        self.socket_path = socket_path
+        self.tls_context = tls_context
+        # Maps each client's nonce prefix to its high-water mark, the counters
+        # accepted within NONCE_WINDOW below it, and when it may be forgotten
+        self._nonce_marks: dict[bytes, tuple[int, set[int], float]] = {}
+        # Response nonces: a prefix chosen at startup, then a counter
+        self._nonce_prefix = os.urandom(8)
+        self._nonce_counter = 0
+        # Maps "address:new_selection_id" to the (old_selection_id, round) of a key rotation
+        self.rotations: dict[str, tuple[str, int]] = {}
         self._lock = threading.Lock()
-        self.server: HTTPServer | None = None
+        self.server: HTTPServer | UnixHTTPServer | None = None
 
     def start(self) -> None:
         """Start the daemon server."""
-        self.server = HTTPServer(("127.0.0.1", self.port), WeightDaemonHandler)
+        if self.socket_path:
+            # A socket left behind by an earlier run would fail the bind
+            try:
+                if stat.S_ISSOCK(os.stat(self.socket_path).st_mode):
+                    os.unlink(self.socket_path)
+            except FileNotFoundError:
+                pass
+            self.server = UnixHTTPServer(self.socket_path, WeightDaemonHandler)
+            print(f"Weight daemon listening on unix://{self.socket_path}", file=sys.stderr)
+        else:
+            self.server = HTTPServer(("127.0.0.1", self.port), WeightDaemonHandler)
+            scheme = "http"
+            if self.tls_context:
+                self.server.socket = self.tls_context.wrap_socket(self.server.socket, server_side=True)
+                scheme = "https"
+            print(f"Weight daemon listening on {scheme}://127.0.0.1:{self.port}", file=sys.stderr)
         self.server.daemon = self  # type: ignore[attr-defined]
-        print(f"Weight daemon listening on http://127.0.0.1:{self.port}", file=sys.stderr)
         self.server.serve_forever()
 
     def stop(self) -> None:
         """Stop the daemon server gracefully."""
         if self.server:
             self.server.shutdown()
+            self.server.server_close()
+            if self.socket_path:
+                os.unlink(self.socket_path)
+
+    def _check_signature(self, path: str, headers: Any, body: bytes) -> str | None:
+        """Check a request's HMAC signature, timestamp and nonce; return an error message or None."""
+        timestamp = headers.get("X-Weight-Oracle-Timestamp", "")
+        nonce = headers.get("X-Weight-Oracle-Nonce", "")
+        signature = headers.get("X-Weight-Oracle-Signature", "")
+        if not timestamp or not nonce or not signature:
+            return "Missing request signature"
+        message = f"{path}\n{timestamp}\n{nonce}\n".encode("utf-8") + body
+        expected = hmac.new(self.signing_key, message, hashlib.sha256).hexdigest()
+        if not hmac.compare_digest(signature, expected):
+            return "Invalid request signature"
+        try:
+            sent = int(timestamp)
+        except ValueError:
+            return "Invalid request timestamp"
+        now = time.time()
+        if abs(now - sent) > MAX_SIGNATURE_SKEW:
+            return "Request timestamp is outside the accepted window"
+        try:
+            nonce_bytes = bytes.fromhex(nonce)
+        except ValueError:
+            return "Malformed nonce"
+        if len(nonce_bytes) != 16:
+            return "Malformed nonce"
+        prefix, counter = nonce_bytes[:8], int.from_bytes(nonce_bytes[8:], "big")
+        with self._lock:
+            self._nonce_marks = {p: m for p, m in self._nonce_marks.items() if m[2] >= now}
+            high, seen, expiry = self._nonce_marks.get(prefix, (0, set(), 0.0))
+            if counter + NONCE_WINDOW <= high:
+                return "Nonce is below the signer's high-water mark"
+            if counter in seen:
+                return "Replayed nonce"
+            seen.add(counter)
+            if counter > high:
+                high = counter
+                seen = {c for c in seen if c + NONCE_WINDOW > high}
+            self._nonce_marks[prefix] = (high, seen, max(expiry, sent + MAX_SIGNATURE_SKEW))
+        return None
+
+    def _next_nonce(self) -> str:
+        """Return the next response nonce, as hex."""
+        with self._lock:
+            self._nonce_counter += 1
+            counter = self._nonce_counter
+        return (self._nonce_prefix + counter.to_bytes(8, "big")).hex()
+
+    def _response_signature(self, request_signature: str, nonce: str, status: int, body: bytes) -> str:
+        """Sign a response, with the given nonce, to the request with the given signature."""
+        message = f"response\n{status}\n{request_signature}\n{nonce}\n".encode("utf-8") + body
+        return hmac.new(self.signing_key, message, hashlib.sha256).hexdigest()
 
     def _handle_ping(self) -> dict[str, Any]:
         """Handle a ping request."""
         return {"pong": True}
 
-    def _handle_identity(self) -> dict[str, Any]:
-        """Handle an identity request."""
-        return {
+    def _handle_identity(self, request: dict[str, Any]) -> dict[str, Any]:
+        """Handle an identity request, checking the node's lookback parameters if sent."""
+        node_lookback = request.get("balance_lookback")
+        if node_lookback is not None and self.balance_lookback is not None:
+            try:
+                lookback = int(node_lookback)
+            except ValueError:
+                return {"error": f"Invalid balance_lookback: {node_lookback}", "code": "bad_request"}
+            if lookback != self.balance_lookback:
+                return {
+                    "error": f"balance lookback mismatch: node uses {lookback}, daemon snapshots use {self.balance_lookback}",
+                    "code": "bad_request",
+                }
+        response = {
             "genesis_hash": base64.b64encode(self.genesis_hash).decode("ascii"),
             "protocol_version": self.protocol_version,
             "algorithm_version": self.algorithm_version,
         }
+        if self.weight_unit is not None:
+            response["weight_unit"] = self.weight_unit
+        if self.weight_scale is not None:
+            response["weight_scale"] = str(self.weight_scale)
+        return response
 
     def _handle_weight(self, request: dict[str, Any]) -> dict[str, Any]:
         """Handle a weight request."""
@@ -212,7 +397,7 @@ class WeightDaemon:
                 return {"weight": str(self.address_weights[address])}
 
             # Fall back to full key lookup in weight_table
-            key = f"{address}:{selection_id}:{balance_round}"
+            key = self._rotated_key(address, selection_id, balance_round)
             if key in self.weight_table:
                 weight = self.weight_table[key]
             else:
@@ -222,6 +407,77 @@ class WeightDaemon:
 
         return {"weight": str(weight)}
 
+    def _handle_weights(self, request: dict[str, Any]) -> dict[str, Any]:
+        """Handle a batched weights request."""
+        balance_round = request.get("balance_round")
+        queries = request.get("queries")
+
+        if not balance_round:
+            return {"error": "Missing balance_round field", "code": "bad_request"}
+        if not isinstance(queries, list):
+            return {"error": "Missing queries field", "code": "bad_request"}
+        if len(queries) > MAX_WEIGHTS_BATCH:
+            return {
+                "error": f"Too many queries: {len(queries)}, at most {MAX_WEIGHTS_BATCH} are allowed",
+                "code": "bad_request",
+            }
+
+        # Each query is answered as a /weight request for it would be, but a
+        # malformed query fails the whole request
+        weights = []
+        for query in queries:
+            if not isinstance(query, dict):
+                return {"error": "Invalid query", "code": "bad_request"}
+            answer = self._handle_weight({**query, "balance_round": balance_round})
+            if answer.get("code") == "bad_request":
+                return answer
+            weights.append(answer)
+        return {"weights": weights}
+
+    def _rotated_key(self, address: str, selection_id: str, balance_round: str) -> str:
+        """Return the weight table key for a weight query, carrying weights across key rotations.
+
+        A selection ID missing from the table that an account rotated to at or before
+        balance_round is answered with the weight of the one it rotated from.
+        Caller must hold self._lock.
+        """
+        key = f"{address}:{selection_id}:{balance_round}"
+        seen = set()
+        while key not in self.weight_table and selection_id not in seen:
+            seen.add(selection_id)
+            rotation = self.rotations.get(f"{address}:{selection_id}")
+            if rotation is None or not rotation[0] or int(balance_round) < rotation[1]:
+                break
+            selection_id = rotation[0]
+            key = f"{address}:{selection_id}:{balance_round}"
+        return key
+
+    def _handle_key_rotations(self, request: dict[str, Any]) -> dict[str, Any]:
+        """Handle a key_rotations notification."""
+        rotations = request.get("rotations")
+        if not isinstance(rotations, list):
+            return {"error": "Missing rotations field", "code": "bad_request"}
+
+        parsed = []
+        for rotation in rotations:
+            if not isinstance(rotation, dict):
+                return {"error": "Invalid rotation", "code": "bad_request"}
+            address = rotation.get("address")
+            new_selection_id = rotation.get("new_selection_id")
+            if not address:
+                return {"error": "Missing address field", "code": "bad_request"}
+            if not new_selection_id:
+                return {"error": "Missing new_selection_id field", "code": "bad_request"}
+            try:
+                rotation_round = int(rotation.get("round", ""))
+            except ValueError:
+                return {"error": "Missing or invalid round field", "code": "bad_request"}
+            parsed.append((f"{address}:{new_selection_id}", (rotation.get("old_selection_id", ""), rotation_round)))
+
+        with self._lock:
+            self.rotations.update(parsed)
+        return {}
+
     def _handle_total_weight(self, request: dict[str, Any]) -> dict[str, Any]:
         """Handle a total_weight request."""
         balance_round = request.get("balance_round")
@@ -232,7 +488,32 @@ class WeightDaemon:
         if not vote_round:
             return {"error": "Missing vote_round field", "code": "bad_request"}
 
-        return {"total_weight": str(self.total_weight)}
+        with self._lock:
+            total_weight = self.total_weights.get(f"{balance_round}:{vote_round}", self.total_weight)
+        return {"total_weight": str(total_weight)}
+
+    def _handle_weight_table(self, request: dict[str, Any]) -> dict[str, Any]:
+        """Handle a weight_table request."""
+        balance_round = request.get("balance_round")
+
+        if not balance_round:
+            return {"error": "Missing balance_round field", "code": "bad_request"}
+
+        if self.default_weight is not None:
+            return {"error": "Weight table unavailable with a default weight", "code": "unsupported"}
+
+        entries = []
+        with self._lock:
+            # address_weights apply to every selection ID, so they are reported without one
+            for address, weight in self.address_weights.items():
+                entries.append({"address": address, "weight": str(weight)})
+
+            for key, weight in self.weight_table.items():
+                address, selection_id, key_round = key.split(":")
+                if key_round == balance_round and address not in self.address_weights:
+                    entries.append({"address": address, "selection_id": selection_id, "weight": str(weight)})
+
+        return {"weights": entries}
 
     def set_weight(self, address: str, selection_id: str, balance_round: str, weight: int) -> None:
         """Set a specific weight in the weight table (thread-safe)."""
@@ -246,21 +527,24 @@ class WeightDaemon:
             self.total_weight = total_weight
 
 
-def load_weight_table(filename: str) -> dict[str, int]:
+def load_weight_table(filename: str) -> tuple[dict[str, int], dict[str, int]]:
     """
-    Load a weight table from a JSON file.
+    Load a weight table, and optionally total weights, from a JSON file.
 
-    Expected format:
+    Expected format, as written by `goal node weight-cache-export`:
     {
         "weights": {
             "address1:selection_id1:round1": 1000,
             "address2:selection_id2:round2": 2000
+        },
+        "total_weights": {
+            "balance_round:vote_round": 3000
         }
     }
     """
     with open(filename, "r") as f:
         data = json.load(f)
-    return data.get("weights", {})
+    return data.get("weights") or {}, data.get("total_weights") or {}
 
 
 def load_address_weights(filename: str) -> dict[str, int]:
@@ -320,6 +604,9 @@ Examples:
     # Start with weight table from file
     python daemon.py --port 9876 --weight-file weights.json
 
+    # Listen on a unix domain socket (ExternalWeightOracleURL "unix:///tmp/weightd.sock")
+    python daemon.py --socket /tmp/weightd.sock
+
     # Start with fixed weight for all queries (useful for testing weighted consensus)
     python daemon.py --port 9876 --default-weight 1000000 --total-weight 5500000
 
@@ -330,12 +617,17 @@ Examples:
 """,
     )
 
-    parser.add_argument(
+    listen = parser.add_mutually_exclusive_group(required=True)
+    listen.add_argument(
         "--port",
         type=int,
-        required=True,
         help="TCP port to listen on",
     )
+    listen.add_argument(
+        "--socket",
+        type=str,
+        help="Path of a unix domain socket to listen on instead of a TCP port",
+    )
     parser.add_argument(
         "--genesis-hash",
         type=str,
@@ -354,6 +646,18 @@ Examples:
         default="1.0",
         help="Weight algorithm version to report (default: 1.0)",
     )
+    parser.add_argument(
+        "--weight-unit",
+        type=str,
+        default=None,
+        help="Unit to display weights in, e.g. credits (default: none)",
+    )
+    parser.add_argument(
+        "--weight-scale",
+        type=int,
+        default=None,
+        help="Weight units in one display unit, a power of ten (default: none)",
+    )
     parser.add_argument(
         "--latency",
         type=float,
@@ -384,6 +688,42 @@ Examples:
         default=None,
         help="JSON file mapping addresses to weights (simpler than --weight-file)",
     )
+    parser.add_argument(
+        "--balance-lookback",
+        type=int,
+        default=None,
+        help="If set, reject nodes whose balance lookback differs at handshake",
+    )
+    parser.add_argument(
+        "--auth-token-file",
+        type=str,
+        default=None,
+        help="File containing a bearer token that every request must present",
+    )
+    parser.add_argument(
+        "--signing-key-file",
+        type=str,
+        default=None,
+        help="File containing an HMAC key that every request must be signed with",
+    )
+    parser.add_argument(
+        "--tls-cert",
+        type=str,
+        default=None,
+        help="PEM certificate chain to serve HTTPS with (requires --tls-key and --port)",
+    )
+    parser.add_argument(
+        "--tls-key",
+        type=str,
+        default=None,
+        help="PEM private key of --tls-cert",
+    )
+    parser.add_argument(
+        "--tls-client-ca",
+        type=str,
+        default=None,
+        help="If set, require client certificates issued by the CAs in this PEM file (mutual TLS)",
+    )
 
     args = parser.parse_args()
 
@@ -396,10 +736,11 @@ Examples:
 
     # Load weight table if specified
     weight_table = {}
+    total_weights = {}
     if args.weight_file:
         try:
-            weight_table = load_weight_table(args.weight_file)
-            print(f"Loaded {len(weight_table)} weights from {args.weight_file}", file=sys.stderr)
+            weight_table, total_weights = load_weight_table(args.weight_file)
+            print(f"Loaded {len(weight_table)} weights and {len(total_weights)} total weights from {args.weight_file}", file=sys.stderr)
         except Exception as e:
             print(f"Error loading weight file: {e}", file=sys.stderr)
             sys.exit(1)
@@ -414,17 +755,62 @@ Examples:
             print(f"Error loading address weights file: {e}", file=sys.stderr)
             sys.exit(1)
 
+    # Load the bearer token if specified
+    auth_token = None
+    if args.auth_token_file:
+        try:
+            with open(args.auth_token_file) as f:
+                auth_token = f.read().strip()
+        except Exception as e:
+            print(f"Error loading auth token file: {e}", file=sys.stderr)
+            sys.exit(1)
+
+    # Load the request signing key if specified
+    signing_key = None
+    if args.signing_key_file:
+        try:
+            with open(args.signing_key_file) as f:
+                signing_key = f.read().strip().encode("utf-8")
+        except Exception as e:
+            print(f"Error loading signing key file: {e}", file=sys.stderr)
+            sys.exit(1)
+
+    # Set up HTTPS if specified
+    tls_context = None
+    if args.tls_cert or args.tls_key or args.tls_client_ca:
+        if not args.tls_cert or not args.tls_key or args.socket:
+            print("--tls-cert and --tls-key must be given together, with --port", file=sys.stderr)
+            sys.exit(1)
+        try:
+            tls_context = ssl.SSLContext(ssl.PROTOCOL_TLS_SERVER)
+            tls_context.minimum_version = ssl.TLSVersion.TLSv1_2
+            tls_context.load_cert_chain(args.tls_cert, args.tls_key)
+            if args.tls_client_ca:
+                tls_context.verify_mode = ssl.CERT_REQUIRED
+                tls_context.load_verify_locations(args.tls_client_ca)
+        except Exception as e:
+            print(f"Error setting up TLS: {e}", file=sys.stderr)
+            sys.exit(1)
+
     # Create and start daemon
     daemon = WeightDaemon(
-        port=args.port,
+        port=args.port or 0,
         genesis_hash=genesis_hash,
         protocol_version=args.protocol_version,
         algorithm_version=args.algorithm_version,
+        weight_unit=args.weight_unit,
+        weight_scale=args.weight_scale,
         latency=args.latency,
         weight_table=weight_table,
         total_weight=args.total_weight,
+        total_weights=total_weights,
         default_weight=args.default_weight,
         address_weights=address_weights,
+        balance_lookback=args.balance_lookback,
+        auth_token=auth_token,
+        signing_key=signing_key,
+        socket_path=args.socket,
+        tls_context=tls_context,
     )
 
     try:
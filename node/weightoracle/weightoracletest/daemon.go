@@ -0,0 +1,296 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package weightoracletest provides an in-process weight daemon for tests of
+// packages that talk to a daemon over HTTP, such as node startup tests, and
+// for integrators testing their own code against the weight oracle protocol.
+// The weightoracle package's own tests cannot import it, since it imports
+// weightoracle.
+package weightoracletest
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+)
+
+// Daemon is a weight daemon served by an httptest.Server from a mock.Oracle.
+// Its setters return the Daemon so that a test can configure it in one
+// expression:
+//
+//	d := weightoracletest.NewDaemon(t).SetGenesisHash(gh).SetWeight(addr, 1000)
+//
+// A new Daemon reports the expected protocol and algorithm versions, a zero
+// genesis hash, zero weight for every account and a total weight of 1000000.
+type Daemon struct {
+	server  *httptest.Server
+	oracle  *mock.Oracle
+	handler http.Handler
+
+	mu       sync.Mutex
+	identity ledgercore.DaemonIdentity
+	faults   map[string]*injectedFault
+}
+
+// Fault is a failure a Daemon injects in the HTTP exchange itself, below the
+// daemon errors of SetError.
+type Fault int
+
+const (
+	// NoFault answers normally.
+	NoFault Fault = iota
+	// FaultHTTPError answers with an HTTP 500 whose body is not a daemon
+	// error.
+	FaultHTTPError
+	// FaultDisconnect drops the connection without answering.
+	FaultDisconnect
+	// FaultMalformed answers with a body that is not JSON.
+	FaultMalformed
+)
+
+// injectedFault is a Fault set for a method, with the number of requests it
+// has left to fail, or 0 if it fails every request. It turns into NoFault
+// once it has failed them.
+type injectedFault struct {
+	fault Fault
+	left  int
+}
+
+// methodPaths are the endpoints SetFault applies to for each method.
+var methodPaths = map[string][]string{
+	mock.MethodWeight:      {"/weight", "/weights"},
+	mock.MethodTotalWeight: {"/total_weight"},
+	mock.MethodPing:        {"/ping"},
+	mock.MethodIdentity:    {"/identity"},
+}
+
+// NewDaemon starts a Daemon on a free local port. It is closed when the test
+// finishes.
+func NewDaemon(t testing.TB) *Daemon {
+	t.Helper()
+	return newDaemon(t, httptest.NewServer)
+}
+
+// NewTLSDaemon starts a Daemon serving HTTPS on a free local port, with a
+// certificate clients do not trust unless they pin it (see Certificate). It
+// is closed when the test finishes.
+func NewTLSDaemon(t testing.TB) *Daemon {
+	t.Helper()
+	return newDaemon(t, httptest.NewTLSServer)
+}
+
+func newDaemon(t testing.TB, serve func(http.Handler) *httptest.Server) *Daemon {
+	oracle := mock.New()
+	oracle.SetTotalWeight(1000000)
+	d := &Daemon{
+		oracle:  oracle,
+		handler: weightoracle.NewHandler(oracle),
+		identity: ledgercore.DaemonIdentity{
+			WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+			WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+		},
+		faults: make(map[string]*injectedFault),
+	}
+	d.server = serve(http.HandlerFunc(d.serveHTTP))
+	t.Cleanup(d.Close)
+	return d
+}
+
+// serveHTTP answers a request, or fails it with the fault set for its
+// endpoint.
+func (d *Daemon) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch d.takeFault(r.URL.Path) {
+	case FaultHTTPError:
+		http.Error(w, "injected failure", http.StatusInternalServerError)
+	case FaultDisconnect:
+		panic(http.ErrAbortHandler)
+	case FaultMalformed:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{not json"))
+	default:
+		d.handler.ServeHTTP(w, r)
+	}
+}
+
+// takeFault returns the fault a request to path fails with, counting it
+// against the fault's remaining requests.
+func (d *Daemon) takeFault(path string) Fault {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	f, ok := d.faults[path]
+	if !ok {
+		return NoFault
+	}
+	fault := f.fault
+	if f.left > 0 {
+		f.left--
+		if f.left == 0 {
+			f.fault = NoFault
+		}
+	}
+	return fault
+}
+
+// URL returns the base URL of the daemon, for ExternalWeightOracleURL.
+func (d *Daemon) URL() *url.URL {
+	u, _ := url.Parse(d.server.URL)
+	return u
+}
+
+// Port returns the port the daemon listens on, for ExternalWeightOraclePort.
+func (d *Daemon) Port() uint16 {
+	port, _ := strconv.ParseUint(d.URL().Port(), 10, 16)
+	return uint16(port)
+}
+
+// Oracle returns the oracle answering the daemon's queries, which records
+// every query it receives.
+func (d *Daemon) Oracle() *mock.Oracle {
+	return d.oracle
+}
+
+// Certificate returns the certificate of a daemon started with NewTLSDaemon,
+// for pinning it with weightoracle.SPKIPin.
+func (d *Daemon) Certificate() *x509.Certificate {
+	return d.server.Certificate()
+}
+
+// Close stops the daemon. It is safe to call more than once.
+func (d *Daemon) Close() {
+	d.server.Close()
+}
+
+// SetWeight sets the weight of addr, regardless of selection ID and balance
+// round.
+func (d *Daemon) SetWeight(addr basics.Address, weight uint64) *Daemon {
+	d.oracle.SetWeight(addr, weight)
+	return d
+}
+
+// SetKeyWeight sets the weight of addr under selectionID, regardless of
+// balance round, taking precedence over SetWeight.
+func (d *Daemon) SetKeyWeight(addr basics.Address, selectionID crypto.VRFVerifier, weight uint64) *Daemon {
+	d.oracle.SetKeyWeight(addr, selectionID, weight)
+	return d
+}
+
+// SetRoundWeight sets the weight of addr at balanceRound, regardless of
+// selection ID, taking precedence over SetKeyWeight and SetWeight.
+func (d *Daemon) SetRoundWeight(balanceRound basics.Round, addr basics.Address, weight uint64) *Daemon {
+	d.oracle.SetRoundWeight(balanceRound, addr, weight)
+	return d
+}
+
+// SetDefaultWeight sets the weight of accounts without a weight of their own.
+func (d *Daemon) SetDefaultWeight(weight uint64) *Daemon {
+	d.oracle.SetDefaultWeight(weight)
+	return d
+}
+
+// SetTotal sets the total weight.
+func (d *Daemon) SetTotal(total uint64) *Daemon {
+	d.oracle.SetTotalWeight(total)
+	return d
+}
+
+// SetGenesisHash sets the genesis hash the daemon reports in /identity.
+func (d *Daemon) SetGenesisHash(h crypto.Digest) *Daemon {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.identity.GenesisHash = h
+	d.oracle.SetIdentity(d.identity)
+	return d
+}
+
+// SetVersions sets the protocol and algorithm versions the daemon reports in
+// /identity.
+func (d *Daemon) SetVersions(protocolVersion, algorithmVersion string) *Daemon {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.identity.WeightProtocolVersion = protocolVersion
+	d.identity.WeightAlgorithmVersion = algorithmVersion
+	d.oracle.SetIdentity(d.identity)
+	return d
+}
+
+// SetDisplay sets the weight display the daemon declares in /identity.
+func (d *Daemon) SetDisplay(unit string, scale uint64) *Daemon {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.identity.Display = ledgercore.WeightDisplay{Unit: unit, Scale: scale}
+	d.oracle.SetIdentity(d.identity)
+	return d
+}
+
+// SetError makes the daemon answer queries to method, one of the mock.Method
+// names, with a daemon error carrying code and msg. An empty code restores
+// normal answers.
+func (d *Daemon) SetError(method, code, msg string) *Daemon {
+	var err error
+	if code != "" {
+		err = &ledgercore.DaemonError{Code: code, Msg: msg}
+	}
+	switch method {
+	case mock.MethodWeight:
+		d.oracle.SetWeightError(err)
+	case mock.MethodTotalWeight:
+		d.oracle.SetTotalWeightError(err)
+	case mock.MethodPing:
+		d.oracle.SetPingError(err)
+	case mock.MethodIdentity:
+		d.oracle.SetIdentityError(err)
+	default:
+		panic("weightoracletest: unknown method " + method)
+	}
+	return d
+}
+
+// SetLatency delays every answer by latency.
+func (d *Daemon) SetLatency(latency time.Duration) *Daemon {
+	d.oracle.SetLatency(latency)
+	return d
+}
+
+// SetFault makes the daemon fail the next times requests for method, one of
+// the mock.Method names, with fault, or every request if times is 0. The
+// failed requests never reach the oracle, so they are not recorded in its
+// calls. NoFault restores normal answers.
+func (d *Daemon) SetFault(method string, fault Fault, times int) *Daemon {
+	paths, ok := methodPaths[method]
+	if !ok {
+		panic("weightoracletest: unknown method " + method)
+	}
+	// The endpoints of a method, such as batched and single weight requests,
+	// share one count.
+	f := &injectedFault{fault: fault, left: times}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, path := range paths {
+		d.faults[path] = f
+	}
+	return d
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracletest
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDaemonAnswers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var addr, other basics.Address
+	addr[0], other[0] = 1, 2
+	gh := crypto.Hash([]byte("genesis"))
+	d := NewDaemon(t).SetGenesisHash(gh).SetWeight(addr, 500).SetDefaultWeight(7).SetTotal(10000)
+	client := weightoracle.NewClient(d.Port())
+
+	require.NoError(t, client.Ping())
+	id, err := client.Identity()
+	require.NoError(t, err)
+	require.Equal(t, gh, id.GenesisHash)
+	require.Equal(t, ledgercore.ExpectedWeightProtocolVersion, id.WeightProtocolVersion)
+
+	w, err := client.Weight(10, addr, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), w)
+	w, err = client.Weight(10, other, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), w)
+	total, err := weightoracle.NewClientURL(d.URL()).TotalWeight(10, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10000), total)
+	require.Equal(t, 1, d.Oracle().CallCount(mock.MethodTotalWeight))
+
+	d.SetVersions("2.0", "3.0")
+	id, err = client.Identity()
+	require.NoError(t, err)
+	require.Equal(t, gh, id.GenesisHash)
+	require.Equal(t, "2.0", id.WeightProtocolVersion)
+	require.Equal(t, "3.0", id.WeightAlgorithmVersion)
+}
+
+func TestDaemonErrorsAndLatency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	d := NewDaemon(t).SetError(mock.MethodWeight, "not_found", "no such account")
+	client := weightoracle.NewClient(d.Port())
+
+	_, err := client.Weight(10, basics.Address{}, crypto.VRFVerifier{})
+	var de *ledgercore.DaemonError
+	require.ErrorAs(t, err, &de)
+	require.Equal(t, "not_found", de.Code)
+	require.Contains(t, de.Msg, "no such account")
+
+	d.SetError(mock.MethodWeight, "", "")
+	_, err = client.Weight(10, basics.Address{}, crypto.VRFVerifier{})
+	require.NoError(t, err)
+
+	d.SetLatency(50 * time.Millisecond)
+	start := time.Now()
+	require.NoError(t, client.Ping())
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	require.Panics(t, func() { d.SetError("Bogus", "internal", "") })
+}
+
+func TestDaemonFaults(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	d := NewDaemon(t).SetWeight(basics.Address{1}, 10)
+	client := weightoracle.NewClient(d.Port())
+
+	d.SetFault(mock.MethodPing, FaultHTTPError, 0)
+	for i := 0; i < 2; i++ {
+		err := client.Ping()
+		require.Error(t, err)
+		var de *ledgercore.DaemonError
+		require.NotErrorAs(t, err, &de)
+	}
+	d.SetFault(mock.MethodPing, NoFault, 0)
+	require.NoError(t, client.Ping())
+
+	d.SetFault(mock.MethodIdentity, FaultMalformed, 1)
+	_, err := client.Identity()
+	require.Error(t, err)
+	_, err = client.Identity()
+	require.NoError(t, err)
+
+	// The faults are counted across the weight endpoints, and failed
+	// requests never reach the oracle.
+	d.SetFault(mock.MethodWeight, FaultDisconnect, 2)
+	_, err = client.Weights(context.Background(), 10, []ledgercore.WeightQuery{{Addr: basics.Address{1}}})
+	require.Error(t, err)
+	_, err = client.Weight(11, basics.Address{1}, crypto.VRFVerifier{})
+	require.Error(t, err)
+	require.Zero(t, d.Oracle().CallCount(mock.MethodWeight))
+	w, err := client.Weight(12, basics.Address{1}, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), w)
+
+	// A client that retries dropped connections rides out a transient fault.
+	cfg := weightoracle.DefaultClientConfig()
+	cfg.Retry = weightoracle.RetryPolicy{MaxAttempts: 3, Retryable: weightoracle.RetryConnect}
+	retrying := weightoracle.NewClientWithConfig(d.URL(), cfg)
+	d.SetFault(mock.MethodTotalWeight, FaultDisconnect, 2)
+	total, err := retrying.TotalWeight(10, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000000), total)
+	require.Equal(t, 1, d.Oracle().CallCount(mock.MethodTotalWeight))
+
+	require.Panics(t, func() { d.SetFault("Bogus", FaultDisconnect, 0) })
+}
+
+func TestDaemonRoundWeights(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}
+	d := NewDaemon(t).SetWeight(addr, 100).SetRoundWeight(20, addr, 200)
+	client := weightoracle.NewClient(d.Port())
+
+	w, err := client.Weight(10, addr, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), w)
+	w, err = client.Weight(20, addr, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), w)
+}
+
+func TestTLSDaemon(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	d := NewTLSDaemon(t)
+	require.Equal(t, "https", d.URL().Scheme)
+	cfg := weightoracle.DefaultClientConfig()
+	cfg.TLSRootCAs = x509.NewCertPool()
+	cfg.TLSRootCAs.AddCert(d.Certificate())
+	require.NoError(t, weightoracle.NewClientWithConfig(d.URL(), cfg).Ping())
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracletest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// GenesisConfig describes a genesis built by MakeWeightedGenesis. Zero
+// fields take the defaults noted on them.
+type GenesisConfig struct {
+	SchemaID string
+	// Proto defaults to protocol.ConsensusCurrentVersion.
+	Proto protocol.ConsensusVersion
+	// Network defaults to config.Devtestnet.
+	Network     protocol.NetworkID
+	FeeSink     basics.Address
+	RewardsPool basics.Address
+
+	// FirstValid and LastValid bound the participation keys' rounds.
+	// LastValid defaults to 1000.
+	FirstValid basics.Round
+	LastValid  basics.Round
+
+	// Stake is each account's balance; it defaults to 1000000 microAlgos.
+	Stake uint64
+}
+
+// WeightedAccount is an online account of a WeightedGenesis.
+type WeightedAccount struct {
+	Address     basics.Address
+	SelectionID crypto.VRFVerifier
+	VoteID      crypto.OneTimeSignatureVerifier
+
+	// Weight is the account's weight in the weight table.
+	Weight uint64
+}
+
+// WeightedGenesis is a genesis whose online accounts have participation
+// keys on disk and weights for a weight daemon.
+type WeightedGenesis struct {
+	Genesis bookkeeping.Genesis
+
+	// Dir is the genesis directory under the node's data directory, which
+	// holds the accounts' root and participation keys.
+	Dir string
+
+	Accounts []WeightedAccount
+}
+
+// MakeWeightedGenesis builds a genesis with one online account per weight,
+// and writes each account's root and participation keys to the genesis
+// directory under dataDir, where a node started in dataDir finds them.
+//
+// Tests that need unusual accounts, such as keys with no matching account,
+// may edit Genesis.Allocation before starting a node; the genesis hash is
+// read only when the node or daemon starts.
+func MakeWeightedGenesis(t testing.TB, dataDir string, cfg GenesisConfig, weights ...uint64) *WeightedGenesis {
+	t.Helper()
+	if cfg.Proto == "" {
+		cfg.Proto = protocol.ConsensusCurrentVersion
+	}
+	if cfg.Network == "" {
+		cfg.Network = config.Devtestnet
+	}
+	if cfg.LastValid == 0 {
+		cfg.LastValid = 1000
+	}
+	if cfg.Stake == 0 {
+		cfg.Stake = 1000000
+	}
+
+	g := &WeightedGenesis{
+		Genesis: bookkeeping.Genesis{
+			SchemaID:    cfg.SchemaID,
+			Proto:       cfg.Proto,
+			Network:     cfg.Network,
+			FeeSink:     cfg.FeeSink.String(),
+			RewardsPool: cfg.RewardsPool.String(),
+		},
+	}
+	g.Dir = filepath.Join(dataDir, g.Genesis.ID())
+	require.NoError(t, os.MkdirAll(g.Dir, 0700))
+
+	keyDilution := config.Consensus[cfg.Proto].DefaultKeyDilution
+	for i, weight := range weights {
+		rootAccess, err := db.MakeAccessor(filepath.Join(g.Dir, fmt.Sprintf("root%d.rootkey", i)), false, false)
+		require.NoError(t, err)
+		root, err := account.GenerateRoot(rootAccess)
+		rootAccess.Close()
+		require.NoError(t, err)
+
+		partName := config.PartKeyFilename(fmt.Sprintf("%s%d", cfg.SchemaID, i), uint64(cfg.FirstValid), uint64(cfg.LastValid))
+		partAccess, err := db.MakeAccessor(filepath.Join(g.Dir, partName), false, false)
+		require.NoError(t, err)
+		part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), cfg.FirstValid, cfg.LastValid, keyDilution)
+		partAccess.Close()
+		require.NoError(t, err)
+
+		acct := WeightedAccount{
+			Address:     root.Address(),
+			SelectionID: part.VRFSecrets().PK,
+			VoteID:      part.VotingSecrets().OneTimeSignatureVerifier,
+			Weight:      weight,
+		}
+		g.Accounts = append(g.Accounts, acct)
+		g.Genesis.Allocation = append(g.Genesis.Allocation, bookkeeping.GenesisAllocation{
+			Address: acct.Address.String(),
+			State: bookkeeping.GenesisAccountData{
+				Status:      basics.Online,
+				MicroAlgos:  basics.MicroAlgos{Raw: cfg.Stake},
+				SelectionID: acct.SelectionID,
+				VoteID:      acct.VoteID,
+			},
+		})
+	}
+	return g
+}
+
+// TotalWeight returns the sum of the accounts' weights.
+func (g *WeightedGenesis) TotalWeight() uint64 {
+	var total uint64
+	for _, a := range g.Accounts {
+		total += a.Weight
+	}
+	return total
+}
+
+// NewDaemon starts a Daemon that serves the genesis: it reports the genesis
+// hash, each account's weight and their total.
+func (g *WeightedGenesis) NewDaemon(t testing.TB) *Daemon {
+	t.Helper()
+	d := NewDaemon(t).SetGenesisHash(g.Genesis.Hash()).SetTotal(g.TotalWeight())
+	for _, a := range g.Accounts {
+		d.SetWeight(a.Address, a.Weight)
+	}
+	return d
+}
+
+// WriteWeightFile writes the accounts' weights to path as a JSON object
+// mapping each address to its weight, the format of the test daemon's
+// --address-weights-file.
+func (g *WeightedGenesis) WriteWeightFile(path string) error {
+	weights := make(map[string]uint64, len(g.Accounts))
+	for _, a := range g.Accounts {
+		weights[a.Address.String()] = a.Weight
+	}
+	data, err := json.Marshal(weights)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracletest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWeightedGenesis(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	g := MakeWeightedGenesis(t, dataDir, GenesisConfig{SchemaID: "weighted"}, 100, 300)
+	require.Equal(t, filepath.Join(dataDir, string(config.Devtestnet)+"-weighted"), g.Dir)
+	require.Len(t, g.Accounts, 2)
+	require.Len(t, g.Genesis.Allocation, 2)
+	require.Equal(t, uint64(400), g.TotalWeight())
+	for i, a := range g.Accounts {
+		alloc := g.Genesis.Allocation[i]
+		require.Equal(t, a.Address.String(), alloc.Address)
+		require.Equal(t, basics.Online, alloc.State.Status)
+		require.Equal(t, a.SelectionID, alloc.State.SelectionID)
+		require.False(t, a.SelectionID.IsEmpty())
+	}
+	partKeys, err := filepath.Glob(filepath.Join(g.Dir, "*.partkey"))
+	require.NoError(t, err)
+	require.Len(t, partKeys, 2)
+
+	client := weightoracle.NewClient(g.NewDaemon(t).Port())
+	id, err := client.Identity()
+	require.NoError(t, err)
+	require.Equal(t, g.Genesis.Hash(), id.GenesisHash)
+	w, err := client.Weight(1, g.Accounts[1].Address, crypto.VRFVerifier{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), w)
+
+	path := filepath.Join(dataDir, "weights.json")
+	require.NoError(t, g.WriteWeightFile(path))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var weights map[string]uint64
+	require.NoError(t, json.Unmarshal(data, &weights))
+	require.Equal(t, map[string]uint64{
+		g.Accounts[0].Address.String(): 100,
+		g.Accounts[1].Address.String(): 300,
+	}, weights)
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var weightOracleBlockQueriesGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_weight_oracle_block_queries", Description: "weight daemon queries made while agreeing on and validating the latest block"})
+var weightOracleBlockCacheHitsGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_weight_oracle_block_cache_hits", Description: "weight lookups answered from cache while agreeing on and validating the latest block"})
+
+const (
+	// weightCacheMissStormMisses and weightCacheMissStormPercent are the weight
+	// cache misses of a block, and their percentage of its lookups, at which
+	// the block counts towards a cache-miss storm.
+	weightCacheMissStormMisses  = 100
+	weightCacheMissStormPercent = 50
+
+	// weightCacheMissStormBlocks is how many blocks in a row must count
+	// towards a storm for it to be reported.
+	weightCacheMissStormBlocks = 5
+)
+
+// weightQueryAccounting attributes the weight oracle client's cumulative query
+// counts to individual blocks, so that daemon load can be related to vote
+// volume per round. It also reports sustained storms of cache misses, which
+// send most lookups to the daemon.
+type weightQueryAccounting struct {
+	mu   deadlock.Mutex
+	last weightoracle.QueryStats
+
+	// stormBlocks is the number of blocks in a row that counted towards a
+	// cache-miss storm.
+	stormBlocks uint64
+}
+
+// blockDone records the oracle usage since the previous block as the usage of
+// round rnd and publishes it to the block-level gauges. A cache-miss storm is
+// reported once, when it has lasted weightCacheMissStormBlocks blocks.
+func (a *weightQueryAccounting) blockDone(rnd basics.Round, now weightoracle.QueryStats, log logging.Logger) weightoracle.QueryStats {
+	a.mu.Lock()
+	delta := now.Sub(a.last)
+	a.last = now
+	if delta.CacheMisses >= weightCacheMissStormMisses &&
+		delta.CacheMisses*100 >= (delta.CacheMisses+delta.CacheHits)*weightCacheMissStormPercent {
+		a.stormBlocks++
+	} else {
+		a.stormBlocks = 0
+	}
+	stormBlocks := a.stormBlocks
+	a.mu.Unlock()
+
+	weightOracleBlockQueriesGauge.Set(delta.Queries)
+	weightOracleBlockCacheHitsGauge.Set(delta.CacheHits)
+	log.Debugf("weight oracle usage for round %d: %d queries, %d cache hits, %d cache misses", rnd, delta.Queries, delta.CacheHits, delta.CacheMisses)
+	if stormBlocks == weightCacheMissStormBlocks {
+		log.Warnf("weight cache misses sent most weight lookups to the weight daemon for the last %d blocks (%d misses, %d hits in round %d)",
+			stormBlocks, delta.CacheMisses, delta.CacheHits, rnd)
+		log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.WeightOracleCacheMissStormEvent, telemetryspec.WeightOracleCacheMissStormEventDetails{
+			Round:       uint64(rnd),
+			Blocks:      stormBlocks,
+			CacheMisses: delta.CacheMisses,
+			CacheHits:   delta.CacheHits,
+		})
+	}
+	return delta
+}
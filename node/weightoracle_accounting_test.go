@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWeightQueryAccountingPerBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var a weightQueryAccounting
+	log := logging.TestingLog(t)
+
+	delta := a.blockDone(1, weightoracle.QueryStats{Queries: 10, CacheHits: 90}, log)
+	require.Equal(t, weightoracle.QueryStats{Queries: 10, CacheHits: 90}, delta)
+
+	delta = a.blockDone(2, weightoracle.QueryStats{Queries: 13, CacheHits: 190}, log)
+	require.Equal(t, weightoracle.QueryStats{Queries: 3, CacheHits: 100}, delta)
+}
+
+// eventLogger records the telemetry events logged through it.
+type eventLogger struct {
+	logging.Logger
+	events []interface{}
+}
+
+func (l *eventLogger) EventWithDetails(category telemetryspec.Category, identifier telemetryspec.Event, details interface{}) {
+	l.events = append(l.events, details)
+}
+
+func TestWeightQueryAccountingCacheMissStorm(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var a weightQueryAccounting
+	log := &eventLogger{Logger: logging.TestingLog(t)}
+	var stats weightoracle.QueryStats
+	block := func(rnd basics.Round, misses, hits uint64) {
+		stats.CacheMisses += misses
+		stats.CacheHits += hits
+		a.blockDone(rnd, stats, log)
+	}
+
+	// Too few misses, or too small a share of the lookups, are no storm.
+	for rnd := basics.Round(1); rnd <= 10; rnd++ {
+		block(rnd, weightCacheMissStormMisses-1, 0)
+	}
+	for rnd := basics.Round(11); rnd <= 20; rnd++ {
+		block(rnd, weightCacheMissStormMisses, weightCacheMissStormMisses+1)
+	}
+	require.Empty(t, log.events)
+
+	// A storm is reported once, after weightCacheMissStormBlocks blocks.
+	for rnd := basics.Round(21); rnd < 21+weightCacheMissStormBlocks-1; rnd++ {
+		block(rnd, 500, 10)
+	}
+	require.Empty(t, log.events)
+	block(25, 500, 10)
+	require.Equal(t, []interface{}{telemetryspec.WeightOracleCacheMissStormEventDetails{
+		Round: 25, Blocks: weightCacheMissStormBlocks, CacheMisses: 500, CacheHits: 10,
+	}}, log.events)
+	for rnd := basics.Round(26); rnd <= 40; rnd++ {
+		block(rnd, 500, 10)
+	}
+	require.Len(t, log.events, 1)
+
+	// A block without a storm ends it, and the next one is reported again.
+	block(41, 0, 500)
+	for rnd := basics.Round(42); rnd < 42+weightCacheMissStormBlocks; rnd++ {
+		block(rnd, 500, 10)
+	}
+	require.Len(t, log.events, 2)
+}
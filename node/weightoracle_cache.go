@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// weightCacheSaveInterval is how often weightCacheSaveThread saves the
+// weight cache, bounding the answers a crash loses.
+const weightCacheSaveInterval = time.Minute
+
+// weightCacheEvictionLag is how many balance rounds before the horizon the
+// weight caches keep, for proposerShareThread, which looks up the weights of
+// committed blocks up to proposerShareRoundsQueue rounds late.
+const weightCacheEvictionLag = proposerShareRoundsQueue
+
+// weightCachePath is where the node keeps its weight cache across restarts.
+func (node *AlgorandFullNode) weightCachePath() string {
+	return filepath.Join(node.genesisDirs.HotGenesisDir, weightoracle.CacheFilename)
+}
+
+// weightCacheHorizon returns the oldest balance round the node can still
+// look weights up for: that of the round after the latest one. Blocks
+// fetched during catch-up are for that round and later ones.
+func (node *AlgorandFullNode) weightCacheHorizon() (basics.Round, error) {
+	voteRound := node.ledger.Latest() + 1
+	cparams, err := node.ledger.ConsensusParams(agreement.ParamsRound(voteRound))
+	if err != nil {
+		return 0, err
+	}
+	return agreement.BalanceRound(voteRound, cparams), nil
+}
+
+// evictWeightCache drops the cached weights that no round after latest can
+// need once proposerShareThread has caught up.
+func (node *AlgorandFullNode) evictWeightCache(latest basics.Round) {
+	voteRound := latest + 1
+	cparams, err := node.ledger.ConsensusParams(agreement.ParamsRound(voteRound))
+	if err != nil {
+		return
+	}
+	horizon := agreement.BalanceRound(voteRound, cparams)
+	if horizon <= weightCacheEvictionLag {
+		return
+	}
+	node.weightOracle.EvictBefore(horizon - weightCacheEvictionLag)
+}
+
+// loadWeightCache fills oracle's caches with the answers saved before the
+// node last stopped, if they came from a daemon with the same identity, so
+// that catching up after a restart does not ask the daemon for all of them
+// at once. A cache that cannot be loaded is only logged.
+func (node *AlgorandFullNode) loadWeightCache(oracle *weightoracle.Client, identity ledgercore.DaemonIdentity) {
+	since, err := node.weightCacheHorizon()
+	if err != nil {
+		node.log.Warnf("weight cache not loaded: %v", err)
+		return
+	}
+	n, err := oracle.LoadCache(node.weightCachePath(), since, identity)
+	if errors.Is(err, weightoracle.ErrCacheIdentityMismatch) {
+		node.log.Infof("weight cache not loaded: %v", err)
+		return
+	}
+	if err != nil {
+		node.log.Warnf("weight cache not loaded: %v", err)
+		return
+	}
+	node.log.Infof("Loaded %d weight daemon answers for balance rounds from %d on", n, since)
+}
+
+// weightCacheSaveThread saves the weight cache every weightCacheSaveInterval
+// and when the node stops. The ledger may be closed by then, so the last
+// save uses the horizon found by the one before.
+func (node *AlgorandFullNode) weightCacheSaveThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	ticker := time.NewTicker(weightCacheSaveInterval)
+	defer ticker.Stop()
+	since, err := node.weightCacheHorizon()
+	if err != nil {
+		node.log.Warnf("cannot determine which weights to save: %v", err)
+	}
+	for {
+		stopping := false
+		select {
+		case <-ticker.C:
+			if horizon, err := node.weightCacheHorizon(); err == nil {
+				since = horizon
+			}
+		case <-done:
+			stopping = true
+		}
+		identity, ok := node.weightOracle.LastIdentity()
+		if ok {
+			if err := node.weightOracle.SaveCache(node.weightCachePath(), since, identity); err != nil {
+				node.log.Warnf("weight cache not saved: %v", err)
+			}
+		}
+		if stopping {
+			return
+		}
+	}
+}
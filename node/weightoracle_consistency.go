@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+const (
+	// weightConsistencyCheckInterval is how often the node samples the daemon's
+	// weight table and compares its sum against the reported total weight.
+	weightConsistencyCheckInterval = 10 * time.Minute
+
+	// weightConsistencyTolerancePPM is the largest difference between the summed
+	// weight table and the reported total weight, in parts per million of the
+	// total, that is not reported as an inconsistency.
+	weightConsistencyTolerancePPM = 1000
+)
+
+var weightConsistencyMismatches = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_consistency_mismatch_total", Description: "number of sampled rounds where the weight daemon's table did not sum to its total weight"})
+
+// weightTableSource is the part of the weight oracle client used by the
+// consistency checker.
+type weightTableSource interface {
+	WeightTable(balanceRound basics.Round) ([]ledgercore.WeightTableEntry, error)
+	TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error)
+}
+
+// weightInconsistencyError reports a daemon weight table whose sum disagrees
+// with the daemon's total weight by more than the allowed tolerance.
+type weightInconsistencyError struct {
+	balanceRound basics.Round
+	entries      int
+	sum          uint64
+	total        uint64
+	tolerancePPM uint64
+}
+
+func (e *weightInconsistencyError) Error() string {
+	return fmt.Sprintf("weight table for balance round %d sums to %d over %d entries but total weight is %d (tolerance %d ppm)",
+		e.balanceRound, e.sum, e.entries, e.total, e.tolerancePPM)
+}
+
+// checkWeightConsistency sums the daemon's weight table for balanceRound and
+// compares it against the total weight the daemon reports for voteRound. It
+// returns an error if the two differ by more than tolerancePPM parts per
+// million of the total, in which case the error is a *weightInconsistencyError.
+func checkWeightConsistency(src weightTableSource, balanceRound, voteRound basics.Round, tolerancePPM uint64) error {
+	table, err := src.WeightTable(balanceRound)
+	if err != nil {
+		return fmt.Errorf("failed to fetch weight table for balance round %d: %w", balanceRound, err)
+	}
+	total, err := src.TotalWeight(balanceRound, voteRound)
+	if err != nil {
+		return fmt.Errorf("failed to fetch total weight for balance round %d: %w", balanceRound, err)
+	}
+
+	var sum uint64
+	for _, e := range table {
+		sum = basics.AddSaturate(sum, e.Weight)
+	}
+
+	diff := sum - total
+	if total > sum {
+		diff = total - sum
+	}
+	allowed, overflow := basics.Muldiv(total, tolerancePPM, 1000000)
+	if overflow || diff > allowed {
+		return &weightInconsistencyError{
+			balanceRound: balanceRound,
+			entries:      len(table),
+			sum:          sum,
+			total:        total,
+			tolerancePPM: tolerancePPM,
+		}
+	}
+	return nil
+}
+
+// weightConsistencyThread periodically checks that the weight daemon's table
+// for the current balance round is consistent with its total weight, logging
+// an error and bumping a metric on mismatch. An inconsistent daemon otherwise
+// only shows up as unexplained committee-size anomalies.
+func (node *AlgorandFullNode) weightConsistencyThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	ticker := time.NewTicker(weightConsistencyCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		}
+
+		voteRound := node.ledger.Latest() + 1
+		cparams, err := node.ledger.ConsensusParams(agreement.ParamsRound(voteRound))
+		if err != nil {
+			node.log.Debugf("weight consistency check skipped for round %d: %v", voteRound, err)
+			continue
+		}
+		balanceRound := agreement.BalanceRound(voteRound, cparams)
+
+		err = checkWeightConsistency(node.weightOracle, balanceRound, voteRound, weightConsistencyTolerancePPM)
+		var wie *weightInconsistencyError
+		switch {
+		case err == nil:
+			node.log.Debugf("weight consistency check passed for balance round %d", balanceRound)
+		case errors.As(err, &wie):
+			node.log.Errorf("weight daemon inconsistency: %v", err)
+			weightConsistencyMismatches.Inc(nil)
+		case ledgercore.IsDaemonError(err, "not_found") || ledgercore.IsDaemonError(err, "unsupported"):
+			node.log.Infof("weight consistency check unavailable: %v", err)
+		default:
+			node.log.Warnf("weight consistency check failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type fakeWeightTableSource struct {
+	table    []ledgercore.WeightTableEntry
+	total    uint64
+	tableErr error
+	totalErr error
+}
+
+func (f *fakeWeightTableSource) WeightTable(basics.Round) ([]ledgercore.WeightTableEntry, error) {
+	return f.table, f.tableErr
+}
+
+func (f *fakeWeightTableSource) TotalWeight(basics.Round, basics.Round) (uint64, error) {
+	return f.total, f.totalErr
+}
+
+func TestCheckWeightConsistency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	table := []ledgercore.WeightTableEntry{
+		{Addr: basics.Address{1}, Weight: 400000},
+		{Addr: basics.Address{2}, Weight: 600000},
+	}
+
+	// Exact match
+	src := &fakeWeightTableSource{table: table, total: 1000000}
+	require.NoError(t, checkWeightConsistency(src, 10, 20, 0))
+
+	// Within tolerance: 1000 ppm of 1000500 is 1000
+	src.total = 1000500
+	require.NoError(t, checkWeightConsistency(src, 10, 20, 1000))
+
+	// Beyond tolerance in either direction
+	var wie *weightInconsistencyError
+	src.total = 1002000
+	err := checkWeightConsistency(src, 10, 20, 1000)
+	require.ErrorAs(t, err, &wie)
+	require.Equal(t, uint64(1000000), wie.sum)
+	require.Equal(t, uint64(1002000), wie.total)
+
+	src.total = 990000
+	require.ErrorAs(t, checkWeightConsistency(src, 10, 20, 1000), &wie)
+
+	// Fetch failures are not inconsistencies
+	src.tableErr = &ledgercore.DaemonError{Code: "unsupported", Msg: "no table"}
+	err = checkWeightConsistency(src, 10, 20, 1000)
+	require.True(t, ledgercore.IsDaemonError(err, "unsupported"))
+	require.False(t, errors.As(err, &wie))
+
+	src.tableErr = nil
+	src.totalErr = errors.New("connection refused")
+	err = checkWeightConsistency(src, 10, 20, 1000)
+	require.ErrorContains(t, err, "failed to fetch total weight")
+	require.False(t, errors.As(err, &wie))
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// weightCrossCheckInterval is how often the node compares the total weight of
+// its main weight daemon with that of the daemons in
+// ExternalWeightOracleCrossCheckURLs.
+const weightCrossCheckInterval = time.Minute
+
+var weightCrossCheckMismatches = metrics.MakeCounter(metrics.MetricName{Name: "algod_weight_oracle_cross_check_mismatch_total", Description: "number of cross-checks where weight daemons reported different total weights"})
+
+// totalWeightSource is the part of the weight oracle client used by the
+// cross-checker.
+type totalWeightSource interface {
+	TotalWeight(balanceRound basics.Round, voteRound basics.Round) (uint64, error)
+}
+
+// crossCheckDaemon is a daemon consulted only to cross-check the main one.
+type crossCheckDaemon struct {
+	name   string
+	source totalWeightSource
+}
+
+// weightDisagreementError reports daemons whose total weights differ from the
+// main daemon's.
+type weightDisagreementError struct {
+	balanceRound basics.Round
+	voteRound    basics.Round
+	total        uint64
+	others       map[string]uint64
+}
+
+func (e *weightDisagreementError) Error() string {
+	var answers []string
+	for name, total := range e.others {
+		answers = append(answers, fmt.Sprintf("%s reports %d", name, total))
+	}
+	slices.Sort(answers)
+	return fmt.Sprintf("total weight for balance round %d, vote round %d is %d at the main weight daemon but %s",
+		e.balanceRound, e.voteRound, e.total, strings.Join(answers, ", "))
+}
+
+// crossCheckTotalWeight compares the total weight main reports for the given
+// rounds with the answers of others. Disagreements are reported with a
+// *weightDisagreementError, which takes precedence over failures to get an
+// answer.
+func crossCheckTotalWeight(main totalWeightSource, others []crossCheckDaemon, balanceRound, voteRound basics.Round) error {
+	total, err := main.TotalWeight(balanceRound, voteRound)
+	if err != nil {
+		return fmt.Errorf("failed to fetch total weight from the main weight daemon: %w", err)
+	}
+	disagreement := &weightDisagreementError{balanceRound: balanceRound, voteRound: voteRound, total: total, others: make(map[string]uint64)}
+	var errs []error
+	for _, d := range others {
+		other, err := d.source.TotalWeight(balanceRound, voteRound)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to fetch total weight from %s: %w", d.name, err))
+			continue
+		}
+		if other != total {
+			disagreement.others[d.name] = other
+		}
+	}
+	if len(disagreement.others) > 0 {
+		return disagreement
+	}
+	return errors.Join(errs...)
+}
+
+// weightCrossCheckThread periodically cross-checks the main weight daemon
+// against the daemons in ExternalWeightOracleCrossCheckURLs. Under the "halt"
+// policy a disagreement stops the node's own participation until a check
+// finds every daemon in agreement.
+func (node *AlgorandFullNode) weightCrossCheckThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	ticker := time.NewTicker(weightCrossCheckInterval)
+	defer ticker.Stop()
+	halt := node.config.ExternalWeightOracleCrossCheckPolicy == config.WeightOracleCrossCheckHalt
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		}
+
+		voteRound := node.ledger.Latest() + 1
+		cparams, err := node.ledger.ConsensusParams(agreement.ParamsRound(voteRound))
+		if err != nil {
+			node.log.Debugf("weight cross-check skipped for round %d: %v", voteRound, err)
+			continue
+		}
+		balanceRound := agreement.BalanceRound(voteRound, cparams)
+
+		err = crossCheckTotalWeight(node.weightOracle, node.weightCrossCheckDaemons, balanceRound, voteRound)
+		var wde *weightDisagreementError
+		switch {
+		case errors.As(err, &wde):
+			node.log.Errorf("weight daemons disagree: %v", err)
+			weightCrossCheckMismatches.Inc(nil)
+			if halt && !node.weightParticipationHalted.Swap(true) {
+				node.log.Errorf("stopping participation until the weight daemons agree again")
+			}
+		case err != nil:
+			node.log.Warnf("weight cross-check failed: %v", err)
+		default:
+			node.log.Debugf("weight cross-check passed for balance round %d", balanceRound)
+			if node.weightParticipationHalted.Swap(false) {
+				node.log.Infof("weight daemons agree again; resuming participation")
+			}
+		}
+	}
+}
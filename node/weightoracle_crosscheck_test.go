@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCrossCheckTotalWeight(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	main := &fakeWeightTableSource{total: 1000}
+	a := &fakeWeightTableSource{total: 1000}
+	b := &fakeWeightTableSource{total: 1000}
+	others := []crossCheckDaemon{{name: "http://a", source: a}, {name: "http://b", source: b}}
+
+	require.NoError(t, crossCheckTotalWeight(main, others, 10, 20))
+
+	// Totals must agree exactly.
+	var wde *weightDisagreementError
+	b.total = 1001
+	err := crossCheckTotalWeight(main, others, 10, 20)
+	require.ErrorAs(t, err, &wde)
+	require.Equal(t, map[string]uint64{"http://b": 1001}, wde.others)
+	require.ErrorContains(t, err, "is 1000 at the main weight daemon but http://b reports 1001")
+
+	// A disagreement is reported even when another daemon cannot answer.
+	a.totalErr = errors.New("connection refused")
+	require.ErrorAs(t, crossCheckTotalWeight(main, others, 10, 20), &wde)
+
+	// Without one, failures are not disagreements.
+	b.total = 1000
+	err = crossCheckTotalWeight(main, others, 10, 20)
+	require.ErrorContains(t, err, "failed to fetch total weight from http://a")
+	require.False(t, errors.As(err, &wde))
+
+	main.totalErr = errors.New("connection refused")
+	err = crossCheckTotalWeight(main, others, 10, 20)
+	require.ErrorContains(t, err, "main weight daemon")
+	require.False(t, errors.As(err, &wde))
+}
+
+// TestVotingKeysHaltedByCrossCheck tests that a node whose weight daemons
+// disagree under the halt policy offers agreement no keys to vote with.
+func TestVotingKeysHaltedByCrossCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	node := &AlgorandFullNode{}
+	node.weightParticipationHalted.Store(true)
+	require.Empty(t, node.VotingKeys(10, 10))
+}
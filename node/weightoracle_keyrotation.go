@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// keyRotationQueue bounds the blocks with key rotations waiting for
+// keyRotationThread. Key registrations are rare enough that it fills only if
+// the weight daemon stops answering.
+const keyRotationQueue = 64
+
+// blockKeyRotations returns the key rotations committed by block: the
+// accounts its key registrations, including inner ones, leave online with a
+// selection ID, which is taken from delta. Old is left for the caller to
+// fill in.
+func blockKeyRotations(block bookkeeping.Block, delta ledgercore.StateDelta) []ledgercore.KeyRotation {
+	var rotations []ledgercore.KeyRotation
+	seen := make(map[basics.Address]bool)
+	var visit func(stxn *transactions.SignedTxnWithAD)
+	visit = func(stxn *transactions.SignedTxnWithAD) {
+		if addr := stxn.Txn.Sender; stxn.Txn.Type == protocol.KeyRegistrationTx && !seen[addr] {
+			seen[addr] = true
+			if data, ok := delta.Accts.GetData(addr); ok && !data.SelectionID.IsEmpty() {
+				rotations = append(rotations, ledgercore.KeyRotation{Addr: addr, Round: block.Round(), New: data.SelectionID})
+			}
+		}
+		for i := range stxn.EvalDelta.InnerTxns {
+			visit(&stxn.EvalDelta.InnerTxns[i])
+		}
+	}
+	for i := range block.Payset {
+		visit(&block.Payset[i].SignedTxnWithAD)
+	}
+	return rotations
+}
+
+// keyRotationThread tells the weight daemon of the key rotations OnNewBlock
+// hands it. They are committed a balance lookback ahead of the first query
+// for the new selection IDs, which gives the daemon time to carry the
+// accounts' weights over to them.
+func (node *AlgorandFullNode) keyRotationThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	for {
+		var rotations []ledgercore.KeyRotation
+		select {
+		case rotations = <-node.keyRotations:
+		case <-done:
+			return
+		}
+		rotations = node.previousSelectionIDs(rotations)
+		if err := node.weightOracle.NotifyKeyRotations(rotations); err != nil {
+			node.log.Warnf("unable to tell the weight daemon of %d key rotations in round %d: %v", len(rotations), rotations[0].Round, err)
+		}
+	}
+}
+
+// previousSelectionIDs fills in the selection IDs the rotating accounts had
+// before the rotations, and drops the rotations that kept the same one.
+func (node *AlgorandFullNode) previousSelectionIDs(rotations []ledgercore.KeyRotation) []ledgercore.KeyRotation {
+	changed := rotations[:0]
+	for _, r := range rotations {
+		data, err := node.ledger.LookupAgreement(r.Round-1, r.Addr)
+		if err != nil {
+			node.log.Debugf("selection ID of %s before round %d not found: %v", r.Addr, r.Round, err)
+		}
+		r.Old = data.SelectionID
+		if r.Old != r.New {
+			changed = append(changed, r)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockKeyRotations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	online, offline, payer, app := basics.Address{1}, basics.Address{2}, basics.Address{3}, basics.Address{4}
+	txn := func(sender basics.Address, typ protocol.TxType) transactions.SignedTxnWithAD {
+		var stxn transactions.SignedTxnWithAD
+		stxn.Txn.Type = typ
+		stxn.Txn.Sender = sender
+		return stxn
+	}
+	var block bookkeeping.Block
+	block.BlockHeader.Round = 7
+	call := txn(payer, protocol.ApplicationCallTx)
+	call.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{txn(app, protocol.KeyRegistrationTx)}
+	for _, stxn := range []transactions.SignedTxnWithAD{
+		txn(online, protocol.KeyRegistrationTx),
+		txn(offline, protocol.KeyRegistrationTx),
+		txn(payer, protocol.PaymentTx),
+		call,
+		// A second registration in the block is already reflected in the delta.
+		txn(online, protocol.KeyRegistrationTx),
+	} {
+		block.Payset = append(block.Payset, transactions.SignedTxnInBlock{SignedTxnWithAD: stxn})
+	}
+
+	var delta ledgercore.StateDelta
+	account := func(sel crypto.VRFVerifier) ledgercore.AccountData {
+		var data ledgercore.AccountData
+		data.SelectionID = sel
+		return data
+	}
+	delta.Accts.Upsert(online, account(crypto.VRFVerifier{9}))
+	delta.Accts.Upsert(offline, account(crypto.VRFVerifier{}))
+	delta.Accts.Upsert(payer, account(crypto.VRFVerifier{8}))
+	delta.Accts.Upsert(app, account(crypto.VRFVerifier{7}))
+
+	require.Equal(t, []ledgercore.KeyRotation{
+		{Addr: online, Round: 7, New: crypto.VRFVerifier{9}},
+		{Addr: app, Round: 7, New: crypto.VRFVerifier{7}},
+	}, blockKeyRotations(block, delta))
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var weightOracleStateGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_weight_oracle_state", Description: "liveness of the weight daemon found by the health checks: 0 healthy, 1 degraded, 2 down"})
+
+// weightOracleDownAfterFailures is how many consecutive health checks must
+// fail for the weight daemon to be considered down rather than degraded.
+const weightOracleDownAfterFailures = 3
+
+// WeightOracleState is the liveness of the weight daemon found by the node's
+// health checks.
+type WeightOracleState uint8
+
+const (
+	// WeightOracleHealthy means the last health check succeeded.
+	WeightOracleHealthy WeightOracleState = iota
+	// WeightOracleDegraded means the last health checks failed, but fewer
+	// than weightOracleDownAfterFailures of them in a row.
+	WeightOracleDegraded
+	// WeightOracleDown means at least weightOracleDownAfterFailures
+	// consecutive health checks failed.
+	WeightOracleDown
+)
+
+// String returns the state's name.
+func (s WeightOracleState) String() string {
+	switch s {
+	case WeightOracleHealthy:
+		return "healthy"
+	case WeightOracleDegraded:
+		return "degraded"
+	case WeightOracleDown:
+		return "down"
+	}
+	return "unknown"
+}
+
+// WeightOracleStatus is the weight daemon's liveness as reported in the
+// node status.
+type WeightOracleStatus struct {
+	State WeightOracleState
+
+	// Since is when the daemon entered State; the node's start time if it
+	// has not left the state it started in.
+	Since time.Time
+
+	// ConsecutiveFailures is the number of health checks that failed since
+	// the last one that succeeded.
+	ConsecutiveFailures int
+
+	// LastCheck is the time of the last health check, zero before the
+	// first, and LastError is its error, empty if it succeeded.
+	LastCheck time.Time
+	LastError string
+}
+
+// WeightOracleMonitor tracks the weight daemon's liveness from the outcomes
+// of the node's health checks. It starts out Healthy; a failed check makes
+// it Degraded, weightOracleDownAfterFailures consecutive failures make it
+// Down, and a successful check makes it Healthy again.
+type WeightOracleMonitor struct {
+	mu        deadlock.Mutex
+	downAfter int
+	status    WeightOracleStatus
+}
+
+// makeWeightOracleMonitor returns a Healthy monitor that considers the
+// daemon down after downAfter consecutive failures.
+func makeWeightOracleMonitor(downAfter int, now time.Time) *WeightOracleMonitor {
+	weightOracleStateGauge.Set(uint64(WeightOracleHealthy))
+	return &WeightOracleMonitor{
+		downAfter: downAfter,
+		status:    WeightOracleStatus{State: WeightOracleHealthy, Since: now},
+	}
+}
+
+// Record updates the state with the outcome of a health check made at now,
+// and returns the states before and after.
+func (m *WeightOracleMonitor) Record(now time.Time, err error) (from, to WeightOracleState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	from = m.status.State
+	m.status.LastCheck = now
+	if err == nil {
+		m.status.LastError = ""
+		m.status.ConsecutiveFailures = 0
+		to = WeightOracleHealthy
+	} else {
+		m.status.LastError = err.Error()
+		m.status.ConsecutiveFailures++
+		to = WeightOracleDegraded
+		if m.status.ConsecutiveFailures >= m.downAfter {
+			to = WeightOracleDown
+		}
+	}
+	if to != from {
+		m.status.State = to
+		m.status.Since = now
+		weightOracleStateGauge.Set(uint64(to))
+	}
+	return from, to
+}
+
+// Status returns the current liveness of the daemon.
+func (m *WeightOracleMonitor) Status() WeightOracleStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
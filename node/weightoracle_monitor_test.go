@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWeightOracleMonitorTransitions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	start := time.Unix(1000, 0)
+	m := makeWeightOracleMonitor(3, start)
+	require.Equal(t, WeightOracleStatus{State: WeightOracleHealthy, Since: start}, m.Status())
+
+	failure := errors.New("connection refused")
+	at := func(s int) time.Time { return start.Add(time.Duration(s) * time.Second) }
+
+	from, to := m.Record(at(1), nil)
+	require.Equal(t, WeightOracleHealthy, from)
+	require.Equal(t, WeightOracleHealthy, to)
+	require.Equal(t, start, m.Status().Since)
+
+	from, to = m.Record(at(2), failure)
+	require.Equal(t, WeightOracleHealthy, from)
+	require.Equal(t, WeightOracleDegraded, to)
+	_, to = m.Record(at(3), failure)
+	require.Equal(t, WeightOracleDegraded, to)
+	from, to = m.Record(at(4), failure)
+	require.Equal(t, WeightOracleDegraded, from)
+	require.Equal(t, WeightOracleDown, to)
+	require.Equal(t, WeightOracleStatus{
+		State:               WeightOracleDown,
+		Since:               at(4),
+		ConsecutiveFailures: 3,
+		LastCheck:           at(4),
+		LastError:           failure.Error(),
+	}, m.Status())
+
+	_, to = m.Record(at(5), failure)
+	require.Equal(t, WeightOracleDown, to)
+	require.Equal(t, at(4), m.Status().Since)
+
+	from, to = m.Record(at(6), nil)
+	require.Equal(t, WeightOracleDown, from)
+	require.Equal(t, WeightOracleHealthy, to)
+	require.Equal(t, WeightOracleStatus{State: WeightOracleHealthy, Since: at(6), LastCheck: at(6)}, m.Status())
+
+	// A single failure after recovering only degrades the daemon again.
+	_, to = m.Record(at(7), failure)
+	require.Equal(t, WeightOracleDegraded, to)
+	require.Equal(t, 1, m.Status().ConsecutiveFailures)
+}
+
+func TestWeightOracleStateString(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, "healthy", WeightOracleHealthy.String())
+	require.Equal(t, "degraded", WeightOracleDegraded.String())
+	require.Equal(t, "down", WeightOracleDown.String())
+	require.Equal(t, "unknown", WeightOracleState(9).String())
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// weightOverrideReminderInterval is how often the node repeats its warning
+// that weight overrides are in force.
+const weightOverrideReminderInterval = 10 * time.Minute
+
+// loadWeightOverrides makes oracle answer with the weights of the
+// ExternalWeightOracleOverrideFile. A file that does not verify stops the
+// node from starting; one that has expired is ignored, so that a forgotten
+// file does not stop restarts.
+func (node *AlgorandFullNode) loadWeightOverrides(oracle *weightoracle.Client, dataDir string) error {
+	path := node.config.ExternalWeightOracleOverrideFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dataDir, path)
+	}
+	overrides, err := weightoracle.LoadOverrides(path, node.config.ExternalWeightOracleOverrideSignerList(), node.genesisHash)
+	if err != nil {
+		return fmt.Errorf("cannot load ExternalWeightOracleOverrideFile: %w", err)
+	}
+	if overrides.Expired(time.Now()) {
+		node.log.Warnf("weight overrides in %s expired at %v and are ignored; remove ExternalWeightOracleOverrideFile from the configuration", path, overrides.Expires)
+		return nil
+	}
+	oracle.SetOverrides(overrides)
+	node.weightOverrides = overrides
+	node.logWeightOverrides()
+	return nil
+}
+
+// logWeightOverrides warns that weight overrides are in force.
+func (node *AlgorandFullNode) logWeightOverrides() {
+	o := node.weightOverrides
+	node.log.Warnf("WEIGHT OVERRIDES IN FORCE: the weights of %d accounts replace the weight daemon's from balance round %d until %v (signed by %s, reason: %q). "+
+		"Other nodes do not apply them, so this node's committees may differ from theirs",
+		o.Len(), o.EffectiveRound, o.Expires, o.Signer, o.Reason)
+}
+
+// weightOverrideThread repeats the warning that weight overrides are in force
+// and removes them once they expire, after which the daemon's answers apply
+// again.
+func (node *AlgorandFullNode) weightOverrideThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	expiry := time.NewTimer(time.Until(node.weightOverrides.Expires))
+	defer expiry.Stop()
+	ticker := time.NewTicker(weightOverrideReminderInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			node.logWeightOverrides()
+		case <-expiry.C:
+			node.weightOracle.SetOverrides(nil)
+			node.log.Warnf("weight overrides expired at %v; the weight daemon's answers apply again", node.weightOverrides.Expires)
+			return
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// weightPrefetchThread runs node.weightPrefetcher, which OnNewBlock advances.
+func (node *AlgorandFullNode) weightPrefetchThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	node.weightPrefetcher.Run(done)
+}
+
+// weightPrefetchPlan returns the ExternalWeightOraclePrefetchRounds vote
+// rounds after latest, each with the participation keys that can vote in it:
+// those valid for the round whose selection ID is the one registered for
+// their account at the round's balance round, which is the one agreement
+// asks the weight of.
+func (node *AlgorandFullNode) weightPrefetchPlan(latest basics.Round) ([]weightoracle.PrefetchRound, error) {
+	records := node.accountManager.Registry().GetAll()
+	rounds := make([]weightoracle.PrefetchRound, 0, node.config.ExternalWeightOraclePrefetchRounds)
+	for i := uint64(1); i <= node.config.ExternalWeightOraclePrefetchRounds; i++ {
+		voteRound := latest + basics.Round(i)
+		cparams, err := node.ledger.ConsensusParams(agreement.ParamsRound(voteRound))
+		if err != nil {
+			return rounds, err
+		}
+		r := weightoracle.PrefetchRound{VoteRound: voteRound, BalanceRound: agreement.BalanceRound(voteRound, cparams)}
+		for _, record := range records {
+			if voteRound < record.FirstValid || voteRound > record.LastValid || record.VRF == nil {
+				continue
+			}
+			data, err := node.ledger.LookupAgreement(r.BalanceRound, record.Account)
+			if err != nil || data.SelectionID != record.VRF.PK {
+				continue
+			}
+			r.Queries = append(r.Queries, ledgercore.WeightQuery{Addr: record.Account, SelectionID: data.SelectionID})
+		}
+		rounds = append(rounds, r)
+	}
+	return rounds, nil
+}
+
+// weightSubscribeThread runs node.weightSubscriber, which OnNewBlock
+// advances.
+func (node *AlgorandFullNode) weightSubscribeThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	node.weightSubscriber.Run(done)
+}
+
+// weightSubscribeHorizon returns the balance rounds of the vote rounds from
+// the one after latest through ExternalWeightOracleSubscribeRounds after it.
+func (node *AlgorandFullNode) weightSubscribeHorizon(latest basics.Round) (basics.Round, basics.Round, error) {
+	return node.weightTableHorizon(latest, node.config.ExternalWeightOracleSubscribeRounds)
+}
+
+// weightImportThread runs node.weightImporter, which OnNewBlock advances.
+func (node *AlgorandFullNode) weightImportThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	node.weightImporter.Run(done)
+}
+
+// weightImportHorizon returns the balance rounds of the vote rounds from the
+// one after latest through ExternalWeightOracleImportRounds after it.
+func (node *AlgorandFullNode) weightImportHorizon(latest basics.Round) (basics.Round, basics.Round, error) {
+	return node.weightTableHorizon(latest, node.config.ExternalWeightOracleImportRounds)
+}
+
+// weightTableHorizon returns the balance rounds of the vote rounds from the
+// one after latest through rounds after it. The later vote rounds' consensus
+// parameters are not known yet, so the next round's stand in for them.
+func (node *AlgorandFullNode) weightTableHorizon(latest basics.Round, rounds uint64) (basics.Round, basics.Round, error) {
+	cparams, err := node.ledger.ConsensusParams(agreement.ParamsRound(latest + 1))
+	if err != nil {
+		return 0, 0, err
+	}
+	last := latest + basics.Round(rounds)
+	return agreement.BalanceRound(latest+1, cparams), agreement.BalanceRound(last, cparams), nil
+}
@@ -0,0 +1,488 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// startupWeightQueries bounds the participation key weights queried at once
+// during startup; the client's own concurrency limits apply as well.
+const startupWeightQueries = 16
+
+// weightVersionPolicy returns how closely cfg requires the weight daemon's
+// versions to follow the node's, and the versions cfg and cparams accept
+// besides.
+func weightVersionPolicy(cfg config.Local, cparams config.ConsensusParams) ledgercore.VersionPolicy {
+	// validated by ValidateExternalWeightOracleConfig
+	protocolVersions, _ := cfg.ExternalWeightOracleAcceptedProtocolVersionList()
+	algorithmVersions, _ := cfg.ExternalWeightOracleAcceptedAlgorithmVersionList()
+	return ledgercore.VersionPolicy{
+		Protocol:          ledgercore.VersionMatch(cfg.ExternalWeightOracleProtocolVersionMatch),
+		Algorithm:         ledgercore.VersionMatch(cfg.ExternalWeightOracleAlgorithmVersionMatch),
+		ProtocolVersions:  protocolVersions,
+		AlgorithmVersions: append(algorithmVersions, cparams.WeightAlgorithmVersions...),
+	}
+}
+
+// checkWeightAttestationSupport fails if cparams, or a protocol it approves an
+// upgrade to, requires weight attestations that oracle cannot supply: every
+// vote of such a protocol would fail verification. The oracle attests if it
+// is a ledgercore.AttestingWeightOracle whose daemon's identity lists
+// weightoracle.CapabilityAttestation.
+func checkWeightAttestationSupport(oracle ledgercore.WeightOracle, identity ledgercore.DaemonIdentity, cparams config.ConsensusParams) error {
+	if _, ok := oracle.(ledgercore.AttestingWeightOracle); ok && identity.Supports(weightoracle.CapabilityAttestation) {
+		return nil
+	}
+	if cparams.ExternalWeightAttestation {
+		return fmt.Errorf("the consensus protocol requires weight attestations, which the weight oracle cannot supply")
+	}
+	for version := range cparams.ApprovedUpgrades {
+		if config.Consensus[version].ExternalWeightAttestation {
+			return fmt.Errorf("consensus protocol %s, which the current one may upgrade to, requires weight attestations, which the weight oracle cannot supply", version)
+		}
+	}
+	return nil
+}
+
+// weightOracleStartup is a weight daemon client being brought up by
+// startWeightOracle, and the outcome of its first ping.
+type weightOracleStartup struct {
+	endpoint     *url.URL
+	clientConfig weightoracle.ClientConfig
+	oracle       *weightoracle.Client
+	pinged       chan error
+}
+
+// startWeightOracle checks the weight oracle configuration, creates the daemon
+// client and pings the daemon in the background, so that a slow daemon is
+// reached while the ledger opens. It returns nil when the built-in stake
+// oracle replaces the daemon.
+func (node *AlgorandFullNode) startWeightOracle(dataDir string) (*weightOracleStartup, error) {
+	if err := node.config.ValidateExternalWeightOracleConfig(); err != nil {
+		return nil, err
+	}
+	if node.config.EnableBuiltinStakeWeightOracle {
+		return nil, nil
+	}
+	endpoint, err := node.config.ExternalWeightOracleEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	if node.config.ExternalWeightOraclePort != 0 {
+		node.log.Warnf("ExternalWeightOraclePort is deprecated; set ExternalWeightOracleURL to %q instead", endpoint.String())
+	}
+
+	secrets, err := node.config.LoadExternalWeightOracleSecrets(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the oracle client
+	clientConfig := weightoracle.MakeClientConfig(node.config)
+	clientConfig.AuthToken = secrets.AuthToken
+	clientConfig.SigningKey = secrets.SigningKey
+	clientConfig.TLSRootCAs = secrets.TLSRootCAs
+	clientConfig.TLSClientCertificate = secrets.TLSClientCertificate
+	start := &weightOracleStartup{
+		endpoint:     endpoint,
+		clientConfig: clientConfig,
+		oracle:       weightoracle.NewClientWithConfig(endpoint, clientConfig),
+		pinged:       make(chan error, 1),
+	}
+	if node.config.ExternalWeightOracleOverrideFile != "" {
+		if err := node.loadWeightOverrides(start.oracle, dataDir); err != nil {
+			return nil, err
+		}
+	}
+	start.oracle.SetOwnAccounts(node.hasParticipationKey)
+	start.oracle.SetStarting(true)
+	go func() { start.pinged <- start.oracle.Ping() }()
+	return start, nil
+}
+
+// initializeWeightOracle completes the weight oracle setup begun by
+// startWeightOracle. With EnableBuiltinStakeWeightOracle the node uses
+// weightoracle.StakeOracle and none of the daemon checks apply. Otherwise:
+// 1. Waits for the daemon to answer the ping sent by startWeightOracle
+// 2. Sends the lookback parameters and validates the daemon's identity (genesis hash, algorithm/protocol version)
+// 3. Injects the oracle into the ledger
+// 4. Validates that all eligible participation keys have non-zero weight, within the total weight
+//
+// The identity, total weight and participation key weight queries are sent
+// together, and the answers are checked in that order once all have arrived.
+func (node *AlgorandFullNode) initializeWeightOracle(start *weightOracleStartup) error {
+	cparams, err := node.ledger.ConsensusParams(node.ledger.Latest())
+	if err != nil {
+		return fmt.Errorf("cannot determine consensus parameters for weight oracle setup: %w", err)
+	}
+
+	if start == nil {
+		node.log.Warnf("Using the built-in stake weight oracle: account weights mirror online stake. This is intended for development networks only")
+		oracle := weightoracle.NewStakeOracle(node.ledger.Ledger, node.genesisHash)
+		if err := checkWeightAttestationSupport(oracle, ledgercore.DaemonIdentity{}, cparams); err != nil {
+			return err
+		}
+		node.ledger.Ledger.SetWeightOracle(oracle)
+		return nil
+	}
+	endpoint, oracle := start.endpoint, start.oracle
+	defer oracle.SetStarting(false)
+
+	// Check that the daemon is reachable
+	if err := <-start.pinged; err != nil {
+		var pinErr *weightoracle.CertificatePinError
+		if errors.As(err, &pinErr) {
+			return fmt.Errorf("weight daemon at %s failed certificate pinning; check ExternalWeightOracleTLSPins: %w", endpoint, err)
+		}
+		return fmt.Errorf("weight daemon not reachable at %s: %w", endpoint, err)
+	}
+	node.log.Infof("Weight daemon reachable at %s", endpoint)
+
+	// Get and validate daemon identity, telling the daemon which lookback
+	// schedule we expect it to serve
+	keys, err := node.eligibleParticipationKeys()
+	if err != nil {
+		return fmt.Errorf("participation key weight validation failed: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	var identity ledgercore.DaemonIdentity
+	var identityErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		identity, identityErr = oracle.Handshake(ledgercore.LookbackParams{
+			BalanceLookback:     agreement.BalanceLookback(cparams),
+			SeedLookback:        cparams.SeedLookback,
+			SeedRefreshInterval: cparams.SeedRefreshInterval,
+		})
+	}()
+	weights := keys.query(oracle, &wg)
+	wg.Wait()
+
+	if identityErr != nil {
+		return fmt.Errorf("weight daemon identity query failed: %w", identityErr)
+	}
+
+	// Validate genesis hash, algorithm version and protocol version
+	if err := ledgercore.ValidateIdentity(identity, node.genesisHash, weightVersionPolicy(node.config, cparams)); err != nil {
+		return err
+	}
+	if err := checkWeightAttestationSupport(oracle, identity, cparams); err != nil {
+		return err
+	}
+
+	node.log.Infof("Weight daemon identity validated: genesis=%v, algorithm=%s, protocol=%s",
+		identity.GenesisHash, identity.WeightAlgorithmVersion, identity.WeightProtocolVersion)
+
+	identityLogPath := filepath.Join(node.genesisDirs.RootGenesisDir, weightoracle.IdentityLogFilename)
+	node.weightIdentityLog, err = weightoracle.OpenIdentityLog(identityLogPath)
+	if err != nil {
+		return fmt.Errorf("cannot open weight daemon identity log; if it was altered, move it aside after investigating: %w", err)
+	}
+	node.recordWeightOracleIdentity(endpoint.String(), identity)
+	if node.config.ExternalWeightOraclePersistCache {
+		node.loadWeightCache(oracle, identity)
+	}
+
+	// Inject the oracle into the ledger
+	node.ledger.Ledger.SetWeightOracle(oracle)
+	node.weightOracle = oracle
+
+	// The cross-check daemons are not required to be up: a failed check is
+	// only logged.
+	crossCheck, err := node.config.ExternalWeightOracleCrossCheckEndpoints()
+	if err != nil {
+		return err
+	}
+	// Each is checked on its own, rather than standing in for another.
+	crossCheckConfig := start.clientConfig
+	crossCheckConfig.Failover = nil
+	for _, u := range crossCheck {
+		node.weightCrossCheckDaemons = append(node.weightCrossCheckDaemons, crossCheckDaemon{
+			name:   u.String(),
+			source: weightoracle.NewClientWithConfig(u, crossCheckConfig),
+		})
+	}
+
+	// Validate participation key weights
+	if err := node.checkParticipationKeyWeights(keys, weights); err != nil {
+		return fmt.Errorf("participation key weight validation failed: %w", err)
+	}
+
+	// Open the connections agreement will use before the node participates.
+	// The daemon has answered already, so a failure here is not fatal.
+	if node.config.ExternalWeightOracleWarmConnections > 0 {
+		if err := oracle.WarmConnections(); err != nil {
+			node.log.Warnf("weight daemon connection warm-up failed: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// weightConnectionWarmInterval is how often idle weight daemon connections
+// are topped up, well within the client's 90 second idle connection timeout.
+const weightConnectionWarmInterval = 30 * time.Second
+
+// weightConnectionWarmThread keeps ExternalWeightOracleWarmConnections
+// connections to the weight daemon open through quiet periods, replacing those
+// the daemon or the idle timeout closed.
+func (node *AlgorandFullNode) weightConnectionWarmThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	ticker := time.NewTicker(weightConnectionWarmInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		}
+		if err := node.weightOracle.WarmConnections(); err != nil {
+			node.log.Debugf("weight daemon connection warm-up failed: %v", err)
+		}
+	}
+}
+
+// startupKeys are the participation keys whose weights are checked at
+// startup, and the rounds they are checked for.
+type startupKeys struct {
+	voteRound    basics.Round
+	balanceRound basics.Round
+	records      []account.ParticipationRecord
+	selectionIDs []crypto.VRFVerifier
+
+	// unregistered are keys valid for the vote round whose account has
+	// registered a different selection key, such as the next key of a
+	// rotation. Their weights under their own selection keys are logged but
+	// not checked.
+	unregistered []account.ParticipationRecord
+}
+
+// startupWeights are the daemon's answers to the queries for startupKeys.
+type startupWeights struct {
+	total    uint64
+	totalErr error
+	weights  []uint64
+	errs     []error
+
+	unregisteredWeights []uint64
+	unregisteredErrs    []error
+}
+
+// eligibleParticipationKeys returns the participation keys that can vote in
+// the next round. A key is "eligible" if:
+// 1. It's valid for the current vote round (FirstValid <= voteRound <= LastValid)
+// 2. It has a VRF key
+// 3. The account is online in the balance snapshot
+// 4. The key's SelectionID matches the snapshot's SelectionID
+// 5. The key passes key-validity gating (VoteFirstValid/VoteLastValid)
+func (node *AlgorandFullNode) eligibleParticipationKeys() (startupKeys, error) {
+	// Compute the vote round (next round to be agreed upon)
+	voteRound := node.ledger.Latest() + 1
+	keys := startupKeys{voteRound: voteRound}
+
+	// Get consensus params for the vote round
+	paramsRound := agreement.ParamsRound(voteRound)
+	cparams, err := node.ledger.ConsensusParams(paramsRound)
+	if err != nil {
+		// If we can't get params, it might be too early in the chain
+		node.log.Warnf("Cannot get consensus params for round %d (params round %d): %v; skipping participation key validation",
+			voteRound, paramsRound, err)
+		return keys, nil
+	}
+
+	// Compute the balance round
+	keys.balanceRound = agreement.BalanceRound(voteRound, cparams)
+
+	// Get all participation records
+	records := node.accountManager.Registry().GetAll()
+	if len(records) == 0 {
+		node.log.Infof("No participation keys registered; skipping weight validation")
+		return keys, nil
+	}
+
+	node.log.Infof("Validating %d participation key(s) for vote round %d (balance round %d)",
+		len(records), voteRound, keys.balanceRound)
+
+	skippedCount := 0
+	for _, record := range records {
+		// Skip if key is not valid for this round
+		if voteRound < record.FirstValid || voteRound > record.LastValid {
+			node.log.Debugf("Skipping key %s for account %s: not valid for round %d (valid %d-%d)",
+				record.ParticipationID, record.Account, voteRound, record.FirstValid, record.LastValid)
+			skippedCount++
+			continue
+		}
+
+		// A nil VRF key indicates a corrupted or malformed participation record.
+		// Under normal operation, all participation keys have VRF secrets generated
+		// during key creation. A nil VRF suggests database corruption or a serious bug.
+		// We fail startup to surface this data integrity issue rather than silently
+		// ignoring the key.
+		if record.VRF == nil {
+			return keys, fmt.Errorf("participation key %s for account %s has nil VRF (corrupted or malformed record)",
+				record.ParticipationID, record.Account)
+		}
+
+		// Look up the account in the balance snapshot
+		snapshotData, err := node.ledger.LookupAgreement(keys.balanceRound, record.Account)
+		if err != nil {
+			// Account not online in snapshot, skip
+			node.log.Debugf("Skipping key %s for account %s: not found in balance snapshot at round %d: %v",
+				record.ParticipationID, record.Account, keys.balanceRound, err)
+			skippedCount++
+			continue
+		}
+
+		// Skip if SelectionID doesn't match
+		if snapshotData.SelectionID != record.VRF.PK {
+			node.log.Debugf("Skipping key %s for account %s: SelectionID mismatch (key: %v, snapshot: %v)",
+				record.ParticipationID, record.Account, record.VRF.PK, snapshotData.SelectionID)
+			keys.unregistered = append(keys.unregistered, record)
+			skippedCount++
+			continue
+		}
+
+		// Apply key-validity gating per DD §4.11
+		// The key is eligible only if:
+		// - voteRound >= snapshotData.VoteFirstValid
+		// - AND (snapshotData.VoteLastValid == 0 OR voteRound <= snapshotData.VoteLastValid)
+		keyEligible := (voteRound >= snapshotData.VoteFirstValid) &&
+			(snapshotData.VoteLastValid == 0 || voteRound <= snapshotData.VoteLastValid)
+		if !keyEligible {
+			node.log.Debugf("Skipping key %s for account %s: key-validity gating (vote round %d, key valid %d-%d)",
+				record.ParticipationID, record.Account, voteRound, snapshotData.VoteFirstValid, snapshotData.VoteLastValid)
+			skippedCount++
+			continue
+		}
+
+		keys.records = append(keys.records, record)
+		keys.selectionIDs = append(keys.selectionIDs, snapshotData.SelectionID)
+	}
+	if skippedCount > 0 {
+		node.log.Infof("Skipped %d participation key(s) not eligible for round %d", skippedCount, voteRound)
+	}
+	return keys, nil
+}
+
+// query sends the total weight query and up to startupWeightQueries weight
+// queries at a time for keys to oracle, adding them to wg. The answers are
+// in the returned startupWeights once wg is done.
+func (keys startupKeys) query(oracle ledgercore.WeightOracle, wg *sync.WaitGroup) *startupWeights {
+	w := &startupWeights{
+		weights:             make([]uint64, len(keys.records)),
+		errs:                make([]error, len(keys.records)),
+		unregisteredWeights: make([]uint64, len(keys.unregistered)),
+		unregisteredErrs:    make([]error, len(keys.unregistered)),
+	}
+	slots := make(chan struct{}, startupWeightQueries)
+	wg.Add(len(keys.unregistered))
+	for i, record := range keys.unregistered {
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			w.unregisteredWeights[i], w.unregisteredErrs[i] = oracle.Weight(keys.balanceRound, record.Account, record.VRF.PK)
+		}()
+	}
+	if len(keys.records) == 0 {
+		return w
+	}
+	wg.Add(1 + len(keys.records))
+	go func() {
+		defer wg.Done()
+		w.total, w.totalErr = oracle.TotalWeight(keys.balanceRound, keys.voteRound)
+	}()
+	for i := range keys.records {
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			w.weights[i], w.errs[i] = oracle.Weight(keys.balanceRound, keys.records[i].Account, keys.selectionIDs[i])
+		}()
+	}
+	return w
+}
+
+// checkParticipationKeyWeights validates the daemon's answers for keys: each
+// key must have non-zero weight, no more than the total weight. Failures are
+// reported for the first key in registry order.
+func (node *AlgorandFullNode) checkParticipationKeyWeights(keys startupKeys, w *startupWeights) error {
+	node.logUnregisteredKeyWeights(keys, w)
+	if len(keys.records) == 0 {
+		return nil
+	}
+	for i, record := range keys.records {
+		weight, err := w.weights[i], w.errs[i]
+		if err != nil {
+			return fmt.Errorf("failed to query weight for account %s: %w", record.Account, err)
+		}
+
+		if weight == 0 {
+			return fmt.Errorf("participation key %s for account %s has zero weight at balance round %d; "+
+				"this key cannot participate in consensus",
+				record.ParticipationID, record.Account, keys.balanceRound)
+		}
+	}
+	if w.totalErr != nil {
+		return fmt.Errorf("failed to query total weight for balance round %d, vote round %d: %w",
+			keys.balanceRound, keys.voteRound, w.totalErr)
+	}
+	for i, record := range keys.records {
+		if w.weights[i] > w.total {
+			return fmt.Errorf("participation key %s for account %s has weight %d above the total weight %d at balance round %d",
+				record.ParticipationID, record.Account, w.weights[i], w.total, keys.balanceRound)
+		}
+		node.log.Infof("Validated participation key %s for account %s: weight=%d",
+			record.ParticipationID, record.Account, w.weights[i])
+	}
+
+	node.log.Infof("Participation key validation complete: %d validated, total weight %d",
+		len(keys.records), w.total)
+	return nil
+}
+
+// logUnregisteredKeyWeights logs the weights of keys.unregistered under their
+// own selection keys. A daemon may weigh an account's keys differently, and
+// one that learns of registrations from the chain may not know a key before
+// it is registered, so neither a zero weight nor an error is a failure.
+func (node *AlgorandFullNode) logUnregisteredKeyWeights(keys startupKeys, w *startupWeights) {
+	for i, record := range keys.unregistered {
+		if err := w.unregisteredErrs[i]; err != nil {
+			node.log.Infof("Participation key %s for account %s is not registered; its weight at balance round %d is unknown: %v",
+				record.ParticipationID, record.Account, keys.balanceRound, err)
+			continue
+		}
+		node.log.Infof("Participation key %s for account %s is not registered; its weight at balance round %d would be %d",
+			record.ParticipationID, record.Account, keys.balanceRound, w.unregisteredWeights[i])
+	}
+}
@@ -17,260 +17,27 @@
 package node
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"net"
 	"os"
 	"path/filepath"
-	"strconv"
-	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/algorand/go-deadlock"
-
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
-	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node/weightoracle"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/node/weightoracle/weightoracletest"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
-	"github.com/algorand/go-algorand/util/db"
 )
 
-// mockWeightServer is a TCP server that simulates a weight daemon for testing.
-// It accepts JSON requests and returns configurable responses.
-//
-// Note: Tests in this file validate startup behavior before the node's Start() method
-// is called. Since we don't call Start(), we also don't call Stop() - the node is not
-// running and there's nothing to stop.
-type mockWeightServer struct {
-	listener net.Listener
-	port     uint16
-	wg       sync.WaitGroup
-	mu       deadlock.Mutex
-	closed   bool
-
-	// Configurable responses
-	genesisHash      crypto.Digest
-	protocolVersion  string
-	algorithmVersion string
-
-	// Weight responses: map from address string to weight
-	weights map[string]uint64
-
-	// DefaultWeight is returned for addresses not in the weights map
-	// If 0, returns "0" for unknown addresses
-	defaultWeight uint64
-
-	// TotalWeight response
-	totalWeight uint64
-
-	// Error injection
-	pingError     string
-	pingErrorCode string
-	identityError string
-	weightError   string
-}
-
-// newMockWeightServer creates and starts a mock weight server.
-func newMockWeightServer(t *testing.T) *mockWeightServer {
-	t.Helper()
-
-	listener, err := net.Listen("tcp", "127.0.0.1:0")
-	require.NoError(t, err)
-
-	addr := listener.Addr().(*net.TCPAddr)
-	s := &mockWeightServer{
-		listener:         listener,
-		port:             uint16(addr.Port),
-		protocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
-		algorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
-		weights:          make(map[string]uint64),
-		totalWeight:      1000000,
-	}
-
-	s.wg.Add(1)
-	go s.serve()
-
-	return s
-}
-
-func (s *mockWeightServer) serve() {
-	defer s.wg.Done()
-
-	for {
-		conn, err := s.listener.Accept()
-		if err != nil {
-			s.mu.Lock()
-			closed := s.closed
-			s.mu.Unlock()
-			if closed {
-				return
-			}
-			continue
-		}
-
-		s.wg.Add(1)
-		go s.handleConn(conn)
-	}
-}
-
-func (s *mockWeightServer) handleConn(conn net.Conn) {
-	defer s.wg.Done()
-	defer conn.Close()
-
-	var req map[string]interface{}
-	decoder := json.NewDecoder(conn)
-	if err := decoder.Decode(&req); err != nil {
-		return
-	}
-
-	var resp interface{}
-	reqType, _ := req["type"].(string)
-
-	switch reqType {
-	case "ping":
-		s.mu.Lock()
-		pingErr := s.pingError
-		pingCode := s.pingErrorCode
-		s.mu.Unlock()
-
-		if pingErr != "" {
-			resp = map[string]interface{}{
-				"error": pingErr,
-				"code":  pingCode,
-			}
-		} else {
-			resp = map[string]interface{}{
-				"pong": true,
-			}
-		}
-
-	case "identity":
-		s.mu.Lock()
-		identityErr := s.identityError
-		genesisHash := s.genesisHash
-		protocolVer := s.protocolVersion
-		algorithmVer := s.algorithmVersion
-		s.mu.Unlock()
-
-		if identityErr != "" {
-			resp = map[string]interface{}{
-				"error": identityErr,
-				"code":  "internal",
-			}
-		} else {
-			resp = map[string]interface{}{
-				"genesis_hash":      base64.StdEncoding.EncodeToString(genesisHash[:]),
-				"protocol_version":  protocolVer,
-				"algorithm_version": algorithmVer,
-			}
-		}
-
-	case "weight":
-		s.mu.Lock()
-		weightErr := s.weightError
-		addr, _ := req["address"].(string)
-		weight, ok := s.weights[addr]
-		defWeight := s.defaultWeight
-		s.mu.Unlock()
-
-		if weightErr != "" {
-			resp = map[string]interface{}{
-				"error": weightErr,
-				"code":  "internal",
-			}
-		} else if !ok {
-			// Return default weight for unknown addresses
-			resp = map[string]interface{}{
-				"weight": formatUint64(defWeight),
-			}
-		} else {
-			resp = map[string]interface{}{
-				"weight": formatUint64(weight),
-			}
-		}
-
-	case "total_weight":
-		s.mu.Lock()
-		tw := s.totalWeight
-		s.mu.Unlock()
-
-		resp = map[string]interface{}{
-			"total_weight": formatUint64(tw),
-		}
-
-	default:
-		resp = map[string]interface{}{
-			"error": "unknown request type",
-			"code":  "unsupported",
-		}
-	}
-
-	encoder := json.NewEncoder(conn)
-	_ = encoder.Encode(resp)
-}
-
-func formatUint64(v uint64) string {
-	return strconv.FormatUint(v, 10)
-}
-
-func (s *mockWeightServer) SetGenesisHash(h crypto.Digest) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.genesisHash = h
-}
-
-func (s *mockWeightServer) SetProtocolVersion(v string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.protocolVersion = v
-}
-
-func (s *mockWeightServer) SetAlgorithmVersion(v string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.algorithmVersion = v
-}
-
-func (s *mockWeightServer) SetWeight(addr string, weight uint64) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.weights[addr] = weight
-}
-
-func (s *mockWeightServer) SetPingError(errMsg, code string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.pingError = errMsg
-	s.pingErrorCode = code
-}
-
-func (s *mockWeightServer) SetIdentityError(errMsg string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.identityError = errMsg
-}
-
-func (s *mockWeightServer) SetWeightError(errMsg string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.weightError = errMsg
-}
-
-func (s *mockWeightServer) Close() {
-	s.mu.Lock()
-	s.closed = true
-	s.mu.Unlock()
-	s.listener.Close()
-	s.wg.Wait()
-}
-
 // TestStartupValidationPortZero tests that node startup fails when ExternalWeightOraclePort is 0.
 func TestStartupValidationPortZero(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -294,7 +61,7 @@ func TestStartupValidationPortZero(t *testing.T) {
 	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
 	require.Error(t, err)
 	require.Nil(t, node)
-	require.Contains(t, err.Error(), "ExternalWeightOraclePort must be configured")
+	require.Contains(t, err.Error(), "ExternalWeightOracleURL must be configured")
 }
 
 // TestStartupValidationDaemonUnreachable tests that node startup fails when the daemon is not reachable.
@@ -329,6 +96,37 @@ func TestStartupValidationDaemonUnreachable(t *testing.T) {
 	require.Contains(t, err.Error(), "weight daemon not reachable")
 }
 
+// TestStartupValidationCertificatePinMismatch tests that node startup fails when an https daemon's
+// certificate matches none of the configured pins.
+func TestStartupValidationCertificatePinMismatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testDir := t.TempDir()
+
+	server := weightoracletest.NewTLSDaemon(t)
+
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "test-startup-pin-mismatch",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     sinkAddr.String(),
+		RewardsPool: poolAddr.String(),
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOracleURL = server.URL().String()
+	cfg.ExternalWeightOracleTLSPins = "sha256/47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+
+	log := logging.TestingLog(t)
+
+	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	require.Error(t, err)
+	require.Nil(t, node)
+	require.Contains(t, err.Error(), "failed certificate pinning")
+	require.Contains(t, err.Error(), weightoracle.SPKIPin(server.Certificate()))
+}
+
 // TestStartupValidationGenesisHashMismatch tests that node startup fails when genesis hash doesn't match.
 func TestStartupValidationGenesisHashMismatch(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -337,8 +135,7 @@ func TestStartupValidationGenesisHashMismatch(t *testing.T) {
 	testDir := t.TempDir()
 
 	// Start mock server with a different genesis hash
-	server := newMockWeightServer(t)
-	defer server.Close()
+	server := weightoracletest.NewDaemon(t)
 
 	// Set a different genesis hash
 	var wrongHash crypto.Digest
@@ -355,7 +152,7 @@ func TestStartupValidationGenesisHashMismatch(t *testing.T) {
 	}
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
@@ -372,8 +169,7 @@ func TestStartupValidationAlgorithmVersionMismatch(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
+	server := weightoracletest.NewDaemon(t)
 
 	// Server will compute genesis hash from the genesis block during startup,
 	// so we need to set it correctly. For this test, we first create a minimal
@@ -389,10 +185,10 @@ func TestStartupValidationAlgorithmVersionMismatch(t *testing.T) {
 	// Compute the genesis hash that the node will expect
 	genesisHash := genesis.Hash()
 	server.SetGenesisHash(genesisHash)
-	server.SetAlgorithmVersion("2.0") // Wrong version
+	server.SetVersions(ledgercore.ExpectedWeightProtocolVersion, "2.0") // Wrong version
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
@@ -409,8 +205,7 @@ func TestStartupValidationProtocolVersionMismatch(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
+	server := weightoracletest.NewDaemon(t)
 
 	genesis := bookkeeping.Genesis{
 		SchemaID:    "test-startup-proto-mismatch",
@@ -422,10 +217,10 @@ func TestStartupValidationProtocolVersionMismatch(t *testing.T) {
 
 	genesisHash := genesis.Hash()
 	server.SetGenesisHash(genesisHash)
-	server.SetProtocolVersion("2.0") // Wrong version
+	server.SetVersions("2.0", ledgercore.ExpectedWeightAlgorithmVersion) // Wrong version
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
@@ -435,29 +230,31 @@ func TestStartupValidationProtocolVersionMismatch(t *testing.T) {
 	require.Contains(t, err.Error(), "protocol version mismatch")
 }
 
-// TestStartupValidationSuccessNoKeys tests successful startup with no participation keys.
-func TestStartupValidationSuccessNoKeys(t *testing.T) {
+// TestStartupValidationAcceptedVersions tests that node startup accepts daemon
+// versions listed in the configuration.
+func TestStartupValidationAcceptedVersions(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
+	server := weightoracletest.NewDaemon(t)
 
 	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-success-nokeys",
+		SchemaID:    "test-startup-accepted-versions",
 		Proto:       protocol.ConsensusCurrentVersion,
 		Network:     config.Devtestnet,
 		FeeSink:     sinkAddr.String(),
 		RewardsPool: poolAddr.String(),
 	}
 
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
+	server.SetGenesisHash(genesis.Hash())
+	server.SetVersions("2.0", "1.1")
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
+	cfg.ExternalWeightOracleAcceptedProtocolVersions = "2.0"
+	cfg.ExternalWeightOracleAcceptedAlgorithmVersions = "1.1"
 
 	log := logging.TestingLog(t)
 
@@ -466,23 +263,17 @@ func TestStartupValidationSuccessNoKeys(t *testing.T) {
 	require.NotNil(t, node)
 }
 
-// TestStartupValidationWithEligibleKeyHavingWeight tests successful startup with
-// a participation key that has non-zero weight from the daemon.
-func TestStartupValidationWithEligibleKeyHavingWeight(t *testing.T) {
+// TestStartupValidationSuccessNoKeys tests successful startup with no participation keys.
+func TestStartupValidationSuccessNoKeys(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
-
-	// Create a participation key
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
+	server := weightoracletest.NewDaemon(t)
 
 	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-key-with-weight",
+		SchemaID:    "test-startup-success-nokeys",
 		Proto:       protocol.ConsensusCurrentVersion,
 		Network:     config.Devtestnet,
 		FeeSink:     sinkAddr.String(),
@@ -492,52 +283,62 @@ func TestStartupValidationWithEligibleKeyHavingWeight(t *testing.T) {
 	genesisHash := genesis.Hash()
 	server.SetGenesisHash(genesisHash)
 
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOraclePort = server.Port()
+
+	log := logging.TestingLog(t)
 
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
+	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
 	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
+	require.NotNil(t, node)
+
+	// The startup handshake is what a bug report needs first.
+	d, err := node.WeightOracleDiagnostics()
 	require.NoError(t, err)
+	require.NotNil(t, d.Identity)
+	require.Equal(t, genesisHash, d.Identity.GenesisHash)
+	require.Len(t, d.RecentQueries, 2)
+	require.Equal(t, "/ping", d.RecentQueries[0].Endpoint)
+	require.Equal(t, "/identity", d.RecentQueries[1].Endpoint)
 
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
+	// The identity is the first entry of the identity log.
+	f, err := os.Open(filepath.Join(node.genesisDirs.RootGenesisDir, weightoracle.IdentityLogFilename))
 	require.NoError(t, err)
-	part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+	defer f.Close()
+	entries, err := weightoracle.VerifyIdentityLog(f)
 	require.NoError(t, err)
-	partAccess.Close()
-
-	// Add the account to genesis with the participation key's SelectionID and VoteID
-	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-		Address: root.Address().String(),
-		State: bookkeeping.GenesisAccountData{
-			Status:      basics.Online,
-			MicroAlgos:  basics.MicroAlgos{Raw: 1000000},
-			SelectionID: part.VRFSecrets().PK,
-			VoteID:      part.VotingSecrets().OneTimeSignatureVerifier,
-		},
-	})
-
-	// Recompute genesis hash after adding allocation
-	genesisHash = genesis.Hash()
-	server.SetGenesisHash(genesisHash)
+	require.Len(t, entries, 1)
+	require.Equal(t, genesisHash.String(), entries[0].GenesisHash)
+	require.Equal(t, server.URL().String(), entries[0].Daemon)
+}
+
+// startupGenesis builds a genesis with one online account per weight, whose
+// keys are in testDir.
+func startupGenesis(t *testing.T, testDir string, schemaID string, weights ...uint64) *weightoracletest.WeightedGenesis {
+	return weightoracletest.MakeWeightedGenesis(t, testDir, weightoracletest.GenesisConfig{
+		SchemaID:    schemaID,
+		FeeSink:     sinkAddr,
+		RewardsPool: poolAddr,
+	}, weights...)
+}
+
+// TestStartupValidationWithEligibleKeyHavingWeight tests successful startup with
+// a participation key that has non-zero weight from the daemon.
+func TestStartupValidationWithEligibleKeyHavingWeight(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testDir := t.TempDir()
 
-	// Set weight for this address
-	server.SetWeight(root.Address().String(), 1000)
+	g := startupGenesis(t, testDir, "test-startup-key-with-weight", 1000)
+	server := g.NewDaemon(t)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err)
 	require.NotNil(t, node)
 }
@@ -550,64 +351,15 @@ func TestStartupValidationEligibleKeyZeroWeight(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
-
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
-
-	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-key-zero-weight",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
-
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
-
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
-	require.NoError(t, err)
-
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
-	require.NoError(t, err)
-	part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
-	require.NoError(t, err)
-	partAccess.Close()
-
-	// Add the account to genesis with the participation key's SelectionID and VoteID
-	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-		Address: root.Address().String(),
-		State: bookkeeping.GenesisAccountData{
-			Status:      basics.Online,
-			MicroAlgos:  basics.MicroAlgos{Raw: 1000000},
-			SelectionID: part.VRFSecrets().PK,
-			VoteID:      part.VotingSecrets().OneTimeSignatureVerifier,
-		},
-	})
-
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
-
-	// DO NOT set weight for this address - it will return 0
+	g := startupGenesis(t, testDir, "test-startup-key-zero-weight", 0)
+	server := g.NewDaemon(t).SetTotal(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.Error(t, err)
 	require.Nil(t, node)
 	require.Contains(t, err.Error(), "zero weight")
@@ -620,67 +372,24 @@ func TestStartupValidationKeyOutOfRoundWindow(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
-
 	// Create a key that's valid for rounds 1000-2000 (way in the future)
 	// At startup, voteRound will be ~1, so this key should be skipped
-	firstRound := basics.Round(1000)
-	lastRound := basics.Round(2000)
-
-	genesis := bookkeeping.Genesis{
+	g := weightoracletest.MakeWeightedGenesis(t, testDir, weightoracletest.GenesisConfig{
 		SchemaID:    "test-startup-key-out-of-window",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
-
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
-
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
-	require.NoError(t, err)
-
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
-	require.NoError(t, err)
-	part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
-	require.NoError(t, err)
-	partAccess.Close()
-
-	// Add the account to genesis with the participation key's SelectionID and VoteID
-	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-		Address: root.Address().String(),
-		State: bookkeeping.GenesisAccountData{
-			Status:      basics.Online,
-			MicroAlgos:  basics.MicroAlgos{Raw: 1000000},
-			SelectionID: part.VRFSecrets().PK,
-			VoteID:      part.VotingSecrets().OneTimeSignatureVerifier,
-		},
-	})
-
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
-
-	// DO NOT set weight - the key should be skipped because it's out of the round window
-	// If it's NOT skipped, the test would fail with "zero weight" error
+		FeeSink:     sinkAddr,
+		RewardsPool: poolAddr,
+		FirstValid:  1000,
+		LastValid:   2000,
+	}, 0)
+	// The key has zero weight. If it's NOT skipped, the test would fail with "zero weight" error
+	server := g.NewDaemon(t).SetTotal(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err) // Should succeed because the key is skipped
 	require.NotNil(t, node)
 }
@@ -693,54 +402,18 @@ func TestStartupValidationAccountNotInSnapshot(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
-
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
-
-	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-account-not-in-snapshot",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
-
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
-
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
-	require.NoError(t, err)
-
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
-	require.NoError(t, err)
-	_, err = account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
-	require.NoError(t, err)
-	partAccess.Close()
-
-	// DO NOT add the account to genesis - it won't be in the balance snapshot
+	g := startupGenesis(t, testDir, "test-startup-account-not-in-snapshot", 0)
+	// Remove the account from genesis - it won't be in the balance snapshot
 	// The key should be skipped
-
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
+	g.Genesis.Allocation = nil
+	server := g.NewDaemon(t).SetTotal(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err) // Should succeed because the account is not in snapshot
 	require.NotNil(t, node)
 }
@@ -753,138 +426,86 @@ func TestStartupValidationSelectionIDMismatch(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
-
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
-
-	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-selectionid-mismatch",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
-
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
-
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
-	require.NoError(t, err)
-
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
-	require.NoError(t, err)
-	_, err = account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
-	require.NoError(t, err)
-	partAccess.Close()
-
-	// Add the account to genesis with a DIFFERENT SelectionID than the participation key
+	g := startupGenesis(t, testDir, "test-startup-selectionid-mismatch", 0)
+	// Give the account a DIFFERENT SelectionID than the participation key
 	var differentSelectionID crypto.VRFVerifier
 	differentSelectionID[0] = 0xFF
 	differentSelectionID[1] = 0xEE
-	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-		Address: root.Address().String(),
-		State: bookkeeping.GenesisAccountData{
-			Status:      basics.Online,
-			MicroAlgos:  basics.MicroAlgos{Raw: 1000000},
-			SelectionID: differentSelectionID, // Different from part.VRFSecrets().PK
-		},
-	})
-
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
-
-	// DO NOT set weight - the key should be skipped due to SelectionID mismatch
+	g.Genesis.Allocation[0].State.SelectionID = differentSelectionID
+	// The key has zero weight - it should be skipped due to SelectionID mismatch
+	server := g.NewDaemon(t).SetTotal(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err) // Should succeed because the key is skipped
 	require.NotNil(t, node)
 }
 
-// TestStartupValidationKeyValidityGating tests that keys failing key-validity gating
-// (VoteFirstValid/VoteLastValid) are skipped.
-func TestStartupValidationKeyValidityGating(t *testing.T) {
+// TestStartupValidationUnregisteredKeyWeight tests that a key other than the
+// registered one is looked up under its own selection key without failing
+// startup, whatever its weight.
+func TestStartupValidationUnregisteredKeyWeight(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
-
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
+	g := startupGenesis(t, testDir, "test-startup-unregistered-key-weight", 1000)
+	var registered crypto.VRFVerifier
+	registered[0] = 0xFF
+	g.Genesis.Allocation[0].State.SelectionID = registered
+	server := g.NewDaemon(t)
+	// The installed key is weighed differently from the registered one.
+	addr, err := basics.UnmarshalChecksumAddress(g.Genesis.Allocation[0].Address)
+	require.NoError(t, err)
+	server.SetKeyWeight(addr, registered, 1000)
+	server.SetWeight(addr, 0)
 
-	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-key-validity-gating",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOraclePort = server.Port()
 
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
+	log := logging.TestingLog(t)
 
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err)
+	require.NotNil(t, node)
 
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
-	require.NoError(t, err)
-	part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
-	require.NoError(t, err)
-	partAccess.Close()
+	var installed []mock.Call
+	for _, c := range server.Oracle().Calls() {
+		if c.Method == mock.MethodWeight {
+			installed = append(installed, c)
+		}
+	}
+	require.Len(t, installed, 1)
+	require.Equal(t, addr, installed[0].Address)
+	require.NotEqual(t, registered, installed[0].SelectionID)
+}
 
-	// Add the account to genesis with VoteFirstValid in the future
-	// This means the key won't be eligible at voteRound=1
-	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-		Address: root.Address().String(),
-		State: bookkeeping.GenesisAccountData{
-			Status:         basics.Online,
-			MicroAlgos:     basics.MicroAlgos{Raw: 1000000},
-			SelectionID:    part.VRFSecrets().PK,
-			VoteID:         part.VotingSecrets().OneTimeSignatureVerifier,
-			VoteFirstValid: basics.Round(100), // Key not valid until round 100
-		},
-	})
+// TestStartupValidationKeyValidityGating tests that keys failing key-validity gating
+// (VoteFirstValid/VoteLastValid) are skipped.
+func TestStartupValidationKeyValidityGating(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
 
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
+	testDir := t.TempDir()
 
-	// DO NOT set weight - the key should be skipped due to VoteFirstValid gating
+	g := startupGenesis(t, testDir, "test-startup-key-validity-gating", 0)
+	// Set VoteFirstValid in the future
+	// This means the key won't be eligible at voteRound=1
+	g.Genesis.Allocation[0].State.VoteFirstValid = basics.Round(100)
+	// The key has zero weight - it should be skipped due to VoteFirstValid gating
+	server := g.NewDaemon(t).SetTotal(1000000)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err) // Should succeed because the key is skipped
 	require.NotNil(t, node)
 }
@@ -897,74 +518,24 @@ func TestStartupValidationKeyValidityGatingLastValid(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
-
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
-
-	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-key-validity-gating-last",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
-
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
-
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
-	require.NoError(t, err)
-
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
-	require.NoError(t, err)
-	part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
-	require.NoError(t, err)
-	partAccess.Close()
-
-	// Add the account to genesis - but note that since this is the genesis state,
-	// VoteLastValid=0 means "no expiration" (the key is always valid if VoteFirstValid passes).
-	// So we can't really test VoteLastValid in the past at genesis time because
-	// there's no round 0 that has already passed.
+	// Since this is the genesis state, VoteLastValid=0 means "no expiration" (the
+	// key is always valid if VoteFirstValid passes). So we can't really test
+	// VoteLastValid in the past at genesis time because there's no round 0 that
+	// has already passed.
 	//
-	// However, for completeness, let's add the account with the correct SelectionID
-	// and VoteFirstValid=0, VoteLastValid=0 (unlimited validity).
-	// This key SHOULD be validated.
-	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-		Address: root.Address().String(),
-		State: bookkeeping.GenesisAccountData{
-			Status:         basics.Online,
-			MicroAlgos:     basics.MicroAlgos{Raw: 1000000},
-			SelectionID:    part.VRFSecrets().PK,
-			VoteID:         part.VotingSecrets().OneTimeSignatureVerifier,
-			VoteFirstValid: basics.Round(0),
-			VoteLastValid:  basics.Round(0), // 0 means unlimited
-		},
-	})
-
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
-
-	// Set weight for this address - it should be validated
-	server.SetWeight(root.Address().String(), 1000)
+	// However, for completeness, the account has the correct SelectionID and
+	// VoteFirstValid=0, VoteLastValid=0 (unlimited validity).
+	// This key SHOULD be validated, and has a weight.
+	g := startupGenesis(t, testDir, "test-startup-key-validity-gating-last", 1000)
+	require.Zero(t, g.Genesis.Allocation[0].State.VoteLastValid)
+	server := g.NewDaemon(t)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err)
 	require.NotNil(t, node)
 }
@@ -977,146 +548,156 @@ func TestStartupValidationDaemonWeightQueryError(t *testing.T) {
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
+	g := startupGenesis(t, testDir, "test-startup-weight-query-error", 1000)
+	// Configure server to return an error for weight queries
+	server := g.NewDaemon(t).SetError(mock.MethodWeight, "internal", "database unavailable")
 
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOraclePort = server.Port()
 
-	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-weight-query-error",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
+	log := logging.TestingLog(t)
 
-	// Create genesis directory and participation key
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
+	require.Error(t, err)
+	require.Nil(t, node)
+	require.Contains(t, err.Error(), "failed to query weight")
+}
 
-	// Generate a root key
-	rootFilename := filepath.Join(genesisDir, "root.rootkey")
-	rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-	require.NoError(t, err)
-	root, err := account.GenerateRoot(rootAccess)
-	rootAccess.Close()
-	require.NoError(t, err)
+// TestStartupValidationMultipleKeys tests startup validation with multiple participation keys.
+func TestStartupValidationMultipleKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
 
-	// Generate participation key with proper filename format
-	partKeyName := config.PartKeyFilename(t.Name(), uint64(firstRound), uint64(lastRound))
-	partFilename := filepath.Join(genesisDir, partKeyName)
-	partAccess, err := db.MakeAccessor(partFilename, false, false)
-	require.NoError(t, err)
-	part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+	testDir := t.TempDir()
+
+	g := startupGenesis(t, testDir, "test-startup-multiple-keys", 1000, 1000)
+	server := g.NewDaemon(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOraclePort = server.Port()
+
+	log := logging.TestingLog(t)
+
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err)
-	partAccess.Close()
-
-	// Add the account to genesis with the participation key's SelectionID and VoteID
-	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-		Address: root.Address().String(),
-		State: bookkeeping.GenesisAccountData{
-			Status:      basics.Online,
-			MicroAlgos:  basics.MicroAlgos{Raw: 1000000},
-			SelectionID: part.VRFSecrets().PK,
-			VoteID:      part.VotingSecrets().OneTimeSignatureVerifier,
-		},
-	})
+	require.NotNil(t, node)
+}
 
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
+// TestStartupValidationWeightAboveTotal tests that startup fails when the daemon
+// gives a participation key more weight than the total.
+func TestStartupValidationWeightAboveTotal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
 
-	// Configure server to return an error for weight queries
-	server.SetWeightError("database unavailable")
+	testDir := t.TempDir()
+
+	g := startupGenesis(t, testDir, "test-startup-weight-above-total", 1000)
+	server := g.NewDaemon(t).SetTotal(500)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
 	require.Error(t, err)
 	require.Nil(t, node)
-	require.Contains(t, err.Error(), "failed to query weight")
+	require.Contains(t, err.Error(), "above the total weight 500")
 }
 
-// TestStartupValidationMultipleKeys tests startup validation with multiple participation keys,
-// some valid, some skipped, and some that should fail.
-func TestStartupValidationMultipleKeys(t *testing.T) {
+// TestStartupValidationTotalWeightQueryError tests that startup fails when the
+// daemon cannot answer the total weight for the keys' balance round.
+func TestStartupValidationTotalWeightQueryError(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
 	testDir := t.TempDir()
 
-	server := newMockWeightServer(t)
-	defer server.Close()
+	g := startupGenesis(t, testDir, "test-startup-total-weight-error", 1000)
+	server := g.NewDaemon(t).SetError(mock.MethodTotalWeight, "internal", "database unavailable")
 
-	firstRound := basics.Round(0)
-	lastRound := basics.Round(1000)
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOraclePort = server.Port()
 
-	genesis := bookkeeping.Genesis{
-		SchemaID:    "test-startup-multiple-keys",
-		Proto:       protocol.ConsensusCurrentVersion,
-		Network:     config.Devtestnet,
-		FeeSink:     sinkAddr.String(),
-		RewardsPool: poolAddr.String(),
-	}
+	log := logging.TestingLog(t)
 
-	// Create genesis directory
-	genesisDir := filepath.Join(testDir, genesis.ID())
-	err := os.MkdirAll(genesisDir, 0700)
-	require.NoError(t, err)
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
+	require.Error(t, err)
+	require.Nil(t, node)
+	require.Contains(t, err.Error(), "failed to query total weight")
+}
 
-	// Create two accounts with participation keys
-	var validAccounts []basics.Address
-
-	for i := 0; i < 2; i++ {
-		// Generate a root key
-		rootFilename := filepath.Join(genesisDir, "root"+string(rune('0'+i))+".rootkey")
-		rootAccess, err := db.MakeAccessor(rootFilename, false, false)
-		require.NoError(t, err)
-		root, err := account.GenerateRoot(rootAccess)
-		rootAccess.Close()
-		require.NoError(t, err)
-
-		// Generate participation key with proper filename format
-		partKeyName := config.PartKeyFilename(t.Name()+string(rune('0'+i)), uint64(firstRound), uint64(lastRound))
-		partFilename := filepath.Join(genesisDir, partKeyName)
-		partAccess, err := db.MakeAccessor(partFilename, false, false)
-		require.NoError(t, err)
-		part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
-		require.NoError(t, err)
-		partAccess.Close()
-
-		validAccounts = append(validAccounts, root.Address())
-
-		// Add the account to genesis with VoteID
-		genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
-			Address: root.Address().String(),
-			State: bookkeeping.GenesisAccountData{
-				Status:      basics.Online,
-				MicroAlgos:  basics.MicroAlgos{Raw: 1000000},
-				SelectionID: part.VRFSecrets().PK,
-				VoteID:      part.VotingSecrets().OneTimeSignatureVerifier,
-			},
-		})
-	}
+// TestStartupValidationPipelined tests that a slow daemon's startup queries
+// overlap rather than adding up, one latency per key.
+func TestStartupValidationPipelined(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
 
-	genesisHash := genesis.Hash()
-	server.SetGenesisHash(genesisHash)
+	testDir := t.TempDir()
 
-	// Set weight for both accounts
-	for _, addr := range validAccounts {
-		server.SetWeight(addr.String(), 1000)
+	const keys = 6
+	const latency = 500 * time.Millisecond
+	weights := make([]uint64, keys)
+	for i := range weights {
+		weights[i] = 1000
 	}
+	g := startupGenesis(t, testDir, "test-startup-pipelined", weights...)
+	server := g.NewDaemon(t).SetLatency(latency)
 
 	cfg := config.GetDefaultLocal()
-	cfg.ExternalWeightOraclePort = server.port
+	cfg.ExternalWeightOraclePort = server.Port()
 
 	log := logging.TestingLog(t)
 
-	node, err := MakeFull(log, testDir, cfg, []string{}, genesis)
+	start := time.Now()
+	node, err := MakeFull(log, testDir, cfg, []string{}, g.Genesis)
+	require.NoError(t, err)
+	require.NotNil(t, node)
+	// Sequential queries would take keys+3 latencies: ping, identity, the
+	// total weight and each key's weight.
+	require.Less(t, time.Since(start), keys*latency)
+	require.Equal(t, keys, server.Oracle().CallCount(mock.MethodWeight))
+	require.Equal(t, 1, server.Oracle().CallCount(mock.MethodTotalWeight))
+}
+
+// TestStartupWarmConnections tests that the node warms its weight daemon
+// connections once the daemon is validated.
+func TestStartupWarmConnections(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testDir := t.TempDir()
+	g := startupGenesis(t, testDir, "test-startup-warm", 1000)
+	server := g.NewDaemon(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.ExternalWeightOraclePort = server.Port()
+	cfg.ExternalWeightOracleWarmConnections = 4
+
+	node, err := MakeFull(logging.TestingLog(t), testDir, cfg, []string{}, g.Genesis)
 	require.NoError(t, err)
 	require.NotNil(t, node)
+	// The startup ping and one per warm connection.
+	require.Equal(t, 5, server.Oracle().CallCount(mock.MethodPing))
+}
+
+// TestCheckWeightAttestationSupport tests that a protocol requiring weight
+// attestations is rejected unless the weight oracle can supply them.
+func TestCheckWeightAttestationSupport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	oracle := mock.New()
+	attester := mock.NewAttester(oracle, crypto.GenerateSignatureSecrets(crypto.Seed{1}))
+	listed := ledgercore.DaemonIdentity{Capabilities: []string{weightoracle.CapabilityAttestation}}
+	unlisted := ledgercore.DaemonIdentity{Capabilities: []string{weightoracle.CapabilityBatch}}
+
+	cparams := config.Consensus[protocol.ConsensusCurrentVersion]
+	require.NoError(t, checkWeightAttestationSupport(oracle, unlisted, cparams))
+
+	cparams.ExternalWeightAttestation = true
+	require.ErrorContains(t, checkWeightAttestationSupport(oracle, listed, cparams), "requires weight attestations")
+	require.ErrorContains(t, checkWeightAttestationSupport(attester, unlisted, cparams), "requires weight attestations")
+	require.NoError(t, checkWeightAttestationSupport(attester, listed, cparams))
+	require.NoError(t, checkWeightAttestationSupport(attester, ledgercore.DaemonIdentity{}, cparams))
 }
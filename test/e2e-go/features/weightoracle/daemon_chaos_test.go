@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/netdeploy"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// templateNodeOrder lists the nodes in FiveNodesWeighted.json order, which is
+// also the order of the ports returned by weightDaemonPorts.
+var templateNodeOrder = []string{"Relay", "Node1", "Node2", "Node3", "Node4", "Node5"}
+
+// chaosRetryPolicy is the weight oracle resilience policy every node runs
+// with during the chaos test: a daemon that is unreachable for less than
+// about chaosRetryWindow goes unnoticed by consensus.
+var chaosRetryPolicy = config.Local{
+	ExternalWeightOracleMaxAttempts:         4,
+	ExternalWeightOracleQueryTimeout:        2 * time.Second,
+	ExternalWeightOracleRetryInitialBackoff: 500 * time.Millisecond,
+	ExternalWeightOracleRetryMaxBackoff:     2 * time.Second,
+	ExternalWeightOracleRetryableErrors:     "connect,timeout",
+}
+
+const (
+	// chaosRetryWindow is how long chaosRetryPolicy keeps retrying a
+	// request whose daemon refuses connections (0.5s + 1s + 2s of backoff).
+	chaosRetryWindow = 3500 * time.Millisecond
+
+	// chaosRecoveryTimeout bounds how long the network may take to produce
+	// blocks again once the daemons of an outage are back.
+	chaosRecoveryTimeout = 90 * time.Second
+
+	// chaosProgressTimeout bounds the same wait after an outage that
+	// consensus should not have noticed.
+	chaosProgressTimeout = 30 * time.Second
+)
+
+// daemonOutage kills the weight daemons of some nodes and restarts them after
+// downtime. When the daemons left running carry enough weight, or the outage
+// fits in the retry window, rounds must keep advancing while they are down
+// (continues); otherwise consensus may stall, but must recover once they are
+// restarted.
+type daemonOutage struct {
+	name      string
+	nodes     []string
+	downtime  time.Duration
+	continues bool
+}
+
+// chaosSchedule is run in order against a single network. Node weights are
+// 1.0M for Node1-4 and 1.5M for Node5, so losing Node1 alone leaves about 82%
+// of the weight online, while losing Node4 and Node5 leaves about 55%. The
+// relay verifies and forwards all votes, so without its daemon nothing moves.
+var chaosSchedule = []daemonOutage{
+	{name: "relay blip within retry window", nodes: []string{"Relay"}, downtime: chaosRetryWindow / 2, continues: true},
+	{name: "minority participant", nodes: []string{"Node1"}, downtime: 30 * time.Second, continues: true},
+	{name: "relay", nodes: []string{"Relay"}, downtime: 20 * time.Second},
+	{name: "weight majority", nodes: []string{"Node4", "Node5"}, downtime: 20 * time.Second},
+	{name: "all daemons", nodes: templateNodeOrder, downtime: 15 * time.Second},
+}
+
+// TestWeightDaemonChaos kills and restarts weight daemons on a schedule while
+// the network runs, checking that rounds continue or recover as the retry
+// policy and the remaining online weight dictate.
+func TestWeightDaemonChaos(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+	t.Parallel()
+
+	a := require.New(fixtures.SynchronizedTest(t))
+
+	var fixture fixtures.RestClientFixture
+	fixture.SetupNoStart(t, filepath.Join("nettemplates", "FiveNodesWeighted.json"),
+		netdeploy.OverrideWeightOracleAutoPorts,
+		overrideRetryPolicy(t, chaosRetryPolicy))
+	ports := weightDaemonPorts(t, &fixture)
+
+	genesisHash := getGenesisHashFromNetwork(t, &fixture)
+	addressWeightsFile := createAddressWeightsFile(t, &fixture)
+	daemons := startAllDaemonsWithWeights(t, ports, genesisHash, addressWeightsFile)
+	t.Cleanup(func() {
+		for _, d := range daemons {
+			stopDaemon(d)
+		}
+	})
+	for _, d := range daemons {
+		waitForDaemonReady(t, d)
+	}
+
+	fixture.Start()
+	defer fixture.Shutdown()
+
+	// Let the network settle before the first outage.
+	round := currentRound(t, &fixture)
+	a.NoError(fixture.WaitForRound(round+3, chaosRecoveryTimeout))
+
+	for _, outage := range chaosSchedule {
+		t.Logf("Outage %q: stopping daemons of %s for %v", outage.name, strings.Join(outage.nodes, ", "), outage.downtime)
+		before := currentRound(t, &fixture)
+		for _, name := range outage.nodes {
+			stopDaemon(daemons[templateNodeIndex(t, name)])
+		}
+
+		time.Sleep(outage.downtime)
+		during := currentRound(t, &fixture)
+		t.Logf("Outage %q: round %d -> %d while down", outage.name, before, during)
+		if outage.continues {
+			// A blip shorter than a round may pass without a block, so it
+			// only has to be survived without a stall.
+			if outage.downtime >= chaosRetryWindow {
+				a.Greater(during, before, "rounds stalled during outage %q", outage.name)
+			}
+		}
+
+		for _, name := range outage.nodes {
+			idx := templateNodeIndex(t, name)
+			daemons[idx] = startDaemonWithWeightsFile(t, ports[idx], totalWeight, genesisHash, addressWeightsFile)
+			waitForDaemonReady(t, daemons[idx])
+		}
+
+		timeout := chaosRecoveryTimeout
+		if outage.continues {
+			timeout = chaosProgressTimeout
+		}
+		err := fixture.WaitForRound(during+2, timeout)
+		a.NoError(err, "network did not make progress after outage %q within %v", outage.name, timeout)
+		t.Logf("Outage %q: recovered, at round %d", outage.name, currentRound(t, &fixture))
+	}
+}
+
+// overrideRetryPolicy sets the weight oracle retry settings of policy on every
+// node of the template.
+func overrideRetryPolicy(t *testing.T, policy config.Local) netdeploy.TemplateOverride {
+	settings, err := json.Marshal(map[string]interface{}{
+		"ExternalWeightOracleMaxAttempts":         policy.ExternalWeightOracleMaxAttempts,
+		"ExternalWeightOracleQueryTimeout":        policy.ExternalWeightOracleQueryTimeout,
+		"ExternalWeightOracleRetryInitialBackoff": policy.ExternalWeightOracleRetryInitialBackoff,
+		"ExternalWeightOracleRetryMaxBackoff":     policy.ExternalWeightOracleRetryMaxBackoff,
+		"ExternalWeightOracleRetryableErrors":     policy.ExternalWeightOracleRetryableErrors,
+	})
+	require.NoError(t, err)
+	return func(template *netdeploy.NetworkTemplate) {
+		for i := range template.Nodes {
+			require.Empty(t, template.Nodes[i].ConfigJSONOverride, "node %s already has a config override", template.Nodes[i].Name)
+			template.Nodes[i].ConfigJSONOverride = string(settings)
+		}
+	}
+}
+
+// templateNodeIndex returns the position of the named node in the template.
+func templateNodeIndex(t *testing.T, name string) int {
+	for i, n := range templateNodeOrder {
+		if n == name {
+			return i
+		}
+	}
+	t.Fatalf("unknown node %s", name)
+	return -1
+}
+
+// currentRound returns the last round of the fixture's primary node.
+func currentRound(t *testing.T, fixture *fixtures.RestClientFixture) basics.Round {
+	status, err := fixture.LibGoalClient.Status()
+	require.NoError(t, err)
+	return basics.Round(status.LastRound)
+}
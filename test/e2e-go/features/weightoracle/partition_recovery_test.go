@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package weightoracle
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/node/weightoracle/mock"
+	"github.com/algorand/go-algorand/nodecontrol"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const (
+	// partitionLookback shortens the balance lookback so that the rounds of a
+	// short test have distinct balance rounds.
+	partitionLookback = basics.Round(8)
+
+	// partitionStallTime is how long the partitioned network is left stalled;
+	// it spans several recovery periods of the stalled round.
+	partitionStallTime = 20 * time.Second
+
+	// partitionRecoveryTime bounds how long the network may take to produce
+	// blocks again once the partition heals.
+	partitionRecoveryTime = 5 * time.Minute
+)
+
+// partitionedNodes hold 2.5M of the 5.5M weight, so the nodes left running
+// hold too little weight to certify a block on their own.
+var partitionedNodes = []string{"Node4", "Node5"}
+
+// TestWeightedPartitionRecovery stops the nodes holding the weight majority,
+// checks that the network stalls, restarts them and checks that it recovers.
+// The restarted nodes must verify the votes the rest of the network cast for
+// the stalled round while they were away, which needs the weights of the
+// stalled round's balance round from their daemons.
+func TestWeightedPartitionRecovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+
+	a := require.New(fixtures.SynchronizedTest(t))
+
+	var fixture fixtures.RestClientFixture
+	fixture.FasterConsensus(protocol.ConsensusFuture, time.Second, partitionLookback)
+	fixture.SetupNoStart(t, filepath.Join("nettemplates", "FiveNodesWeighted.json"))
+
+	// Every node gets its own daemon, all with the same weights, so that the
+	// queries of each node can be told apart.
+	weights := genesisWalletWeights(t, &fixture)
+	oracles := make(map[string]*mock.Oracle)
+	for _, name := range templateNodeOrder {
+		oracle := mock.New()
+		oracle.SetIdentity(fixture.WeightDaemonIdentity())
+		oracle.SetTotalWeight(totalWeight)
+		for addr, weight := range weights {
+			oracle.SetWeight(addr, weight)
+		}
+		oracles[name] = oracle
+		fixture.StartWeightDaemon(name, oracle)
+	}
+
+	fixture.Start()
+	defer fixture.Shutdown()
+
+	// Let the network get past the first balance lookback.
+	a.NoError(fixture.WaitForRound(2*partitionLookback, partitionRecoveryTime))
+
+	// Partition the network by stopping the weight majority.
+	controllers := make([]nodecontrol.NodeController, len(partitionedNodes))
+	for i, name := range partitionedNodes {
+		nc, err := fixture.GetNodeController(name)
+		a.NoError(err)
+		a.NoError(nc.FullStop())
+		controllers[i] = nc
+	}
+	// A round that was nearly certified when the nodes stopped may still
+	// complete, so measure the stall after it has had a chance to.
+	time.Sleep(5 * time.Second)
+	stalledAt := currentRound(t, &fixture)
+	time.Sleep(partitionStallTime)
+	a.Equal(stalledAt, currentRound(t, &fixture), "network made progress without the weight majority")
+	stalledRound := stalledAt + 1
+	t.Logf("Network stalled on round %d", stalledRound)
+
+	// Heal the partition.
+	for i, name := range partitionedNodes {
+		oracles[name].ResetCalls()
+		_, err := fixture.StartNode(controllers[i].GetDataDir())
+		a.NoError(err, "restarting %s", name)
+	}
+	err := fixture.WaitForRound(stalledRound+2, partitionRecoveryTime)
+	a.NoError(err, "network did not recover from the partition within %v", partitionRecoveryTime)
+	t.Logf("Network recovered, at round %d", currentRound(t, &fixture))
+
+	// The restarted nodes looked up the weights they needed for the stalled
+	// round's votes, including ones cast before they came back.
+	balanceRound := stalledRound.SubSaturate(partitionLookback)
+	for _, name := range partitionedNodes {
+		var weightQueries, totalQueries int
+		for _, c := range oracles[name].Calls() {
+			switch {
+			case c.Method == mock.MethodWeight && c.BalanceRound == balanceRound:
+				weightQueries++
+			case c.Method == mock.MethodTotalWeight && c.VoteRound == stalledRound:
+				totalQueries++
+			}
+		}
+		t.Logf("%s: %d weight and %d total weight queries for round %d", name, weightQueries, totalQueries, stalledRound)
+		a.NotZero(weightQueries, "%s did not look up weights for balance round %d", name, balanceRound)
+		a.NotZero(totalQueries, "%s did not look up the total weight for round %d", name, stalledRound)
+	}
+}
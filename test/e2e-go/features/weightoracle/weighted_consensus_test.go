@@ -21,13 +21,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"maps"
-	"net"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"testing"
 	"time"
 
@@ -44,7 +43,6 @@ import (
 // Test configuration constants
 const (
 	numParticipatingNodes = 5
-	numTotalNodes         = 6 // 5 participating + 1 relay
 	normalWeight          = 1000000
 	weightedWeight        = 1500000
 	totalWeight           = 5500000 // 4*1M + 1*1.5M
@@ -61,9 +59,6 @@ const (
 // Node names (must match template) - participating nodes only
 var nodeNames = []string{"Node1", "Node2", "Node3", "Node4", "Node5"}
 
-// All node names including relay
-var allNodeNames = []string{"Node1", "Node2", "Node3", "Node4", "Node5", "Relay"}
-
 // Normal nodes (all except weighted node) for ratio computation
 var normalNodes = []string{"Node1", "Node2", "Node3", "Node4"}
 
@@ -160,31 +155,28 @@ func TestWeightedConsensus(t *testing.T) {
 
 	var fixture fixtures.RestClientFixture
 
-	// Step 1: Allocate ports BEFORE setting up network (for all nodes including relay)
-	// NOTE: The current algod build requires ExternalWeightOraclePort to be configured
+	// Step 1: SetupNoStart creates network directories and genesis, giving every
+	// template node its own free weight daemon port.
+	// NOTE: The current algod build requires the weight daemon to be configured
 	// AND reachable at startup for all nodes.
-	basePort := allocateBasePorts(t, numTotalNodes)
-	t.Logf("Allocated base port: %d", basePort)
-
-	// Step 2: Create TemplateOverride to inject dynamic ports
-	portOverride := createPortOverride(basePort)
-
-	// Step 3: SetupNoStart with port override creates network directories and genesis
 	// The template uses ConsensusFuture which has external weight oracle support
-	fixture.SetupNoStart(t, filepath.Join("nettemplates", "FiveNodesWeighted.json"), portOverride)
+	fixture.SetupNoStart(t, filepath.Join("nettemplates", "FiveNodesWeighted.json"),
+		netdeploy.OverrideWeightOracleAutoPorts)
+	ports := weightDaemonPorts(t, &fixture)
+	t.Logf("Weight daemon ports: %v", ports)
 
-	// Step 4: Get genesis hash from created network
+	// Step 2: Get genesis hash from created network
 	genesisHash := getGenesisHashFromNetwork(t, &fixture)
 	t.Logf("Genesis hash: %s", genesisHash)
 
-	// Step 5: Extract wallet addresses from genesis and create weight table
+	// Step 3: Extract wallet addresses from genesis and create weight table
 	// CRITICAL: All nodes must see the same weight for every address.
 	// This is required because credential verification uses the receiver's view of stake.
 	addressWeightsFile := createAddressWeightsFile(t, &fixture)
 	t.Logf("Created address weights file: %s", addressWeightsFile)
 
-	// Step 6: Start weight daemons with allocated ports and shared weight table
-	daemons := startAllDaemonsWithWeights(t, basePort, genesisHash, addressWeightsFile)
+	// Step 4: Start weight daemons on the assigned ports with a shared weight table
+	daemons := startAllDaemonsWithWeights(t, ports, genesisHash, addressWeightsFile)
 
 	// Register cleanup with t.Cleanup for robust shutdown
 	t.Cleanup(func() {
@@ -193,17 +185,17 @@ func TestWeightedConsensus(t *testing.T) {
 		}
 	})
 
-	// Step 7: Verify all daemons are healthy
+	// Step 5: Verify all daemons are healthy
 	for i, d := range daemons {
 		waitForDaemonReady(t, d)
 		t.Logf("Daemon %d (port %d) is ready", i+1, d.port)
 	}
 
-	// Step 8: Start the network (nodes will connect to daemons)
+	// Step 6: Start the network (nodes will connect to daemons)
 	fixture.Start()
 	defer fixture.Shutdown()
 
-	// Step 9: Build address-to-node mapping
+	// Step 7: Build address-to-node mapping
 	addressToNode := buildAddressMapping(t, &fixture, a)
 	t.Logf("Address to node mapping complete. Found %d nodes.", len(addressToNode))
 
@@ -220,7 +212,7 @@ func TestWeightedConsensus(t *testing.T) {
 	testDuration := getTestDuration(t)
 	checkpoints := getCheckpoints(testDuration)
 
-	// Step 10: Run checkpoint loop collecting statistics
+	// Step 8: Run checkpoint loop collecting statistics
 	startTime := time.Now()
 	var allStats []*checkpointStats
 
@@ -279,42 +271,6 @@ func TestWeightedConsensus(t *testing.T) {
 	t.Logf("Test completed successfully after %v", time.Since(startTime).Round(time.Second))
 }
 
-// createPortOverride returns a TemplateOverride that injects ExternalWeightOraclePort
-func createPortOverride(basePort int) netdeploy.TemplateOverride {
-	return func(template *netdeploy.NetworkTemplate) {
-		for i := range template.Nodes {
-			node := &template.Nodes[i]
-
-			// Find node index from allNodeNames
-			nodeIdx := -1
-			for j, name := range allNodeNames {
-				if node.Name == name {
-					nodeIdx = j
-					break
-				}
-			}
-			if nodeIdx < 0 {
-				continue
-			}
-
-			port := basePort + nodeIdx
-			override := map[string]interface{}{
-				"ExternalWeightOraclePort": port,
-			}
-
-			// Merge with existing override if present
-			if node.ConfigJSONOverride != "" {
-				var existing map[string]interface{}
-				if err := json.Unmarshal([]byte(node.ConfigJSONOverride), &existing); err == nil {
-					maps.Copy(override, existing)
-				}
-			}
-			overrideBytes, _ := json.Marshal(override)
-			node.ConfigJSONOverride = string(overrideBytes)
-		}
-	}
-}
-
 // getGenesisHashFromNetwork reads genesis.json from the network directory
 func getGenesisHashFromNetwork(t *testing.T, fixture *fixtures.RestClientFixture) string {
 	genesisPath := filepath.Join(fixture.PrimaryDataDir(), "..", "genesis.json")
@@ -328,35 +284,45 @@ func getGenesisHashFromNetwork(t *testing.T, fixture *fixtures.RestClientFixture
 // mapping each address to its weight. This ensures all daemons return the same weight
 // for every address, which is CRITICAL for credential verification in consensus.
 func createAddressWeightsFile(t *testing.T, fixture *fixtures.RestClientFixture) string {
+	addressWeights := make(map[string]uint64)
+	for addr, weight := range genesisWalletWeights(t, fixture) {
+		addressWeights[addr.String()] = weight
+	}
+
+	// Write to a JSON file in the network directory
+	weightsPath := filepath.Join(fixture.PrimaryDataDir(), "..", "address_weights.json")
+	weightsJSON, err := json.Marshal(addressWeights)
+	require.NoError(t, err, "failed to marshal address weights")
+	err = os.WriteFile(weightsPath, weightsJSON, 0644)
+	require.NoError(t, err, "failed to write address weights file")
+
+	return weightsPath
+}
+
+// genesisWalletWeights returns the weight of every wallet account in the network's genesis:
+// Wallet5 (Node5) gets 1.5x weight, others get 1x.
+func genesisWalletWeights(t *testing.T, fixture *fixtures.RestClientFixture) map[basics.Address]uint64 {
 	genesisPath := filepath.Join(fixture.PrimaryDataDir(), "..", "genesis.json")
 	genesis, err := bookkeeping.LoadGenesisFromFile(genesisPath)
 	require.NoError(t, err, "failed to load genesis")
 
-	// Build address -> weight mapping
-	// Wallet5 (Node5) gets 1.5x weight, others get 1x
-	addressWeights := make(map[string]int)
+	weights := make(map[basics.Address]uint64)
 	for _, alloc := range genesis.Allocation {
 		// Skip non-wallet accounts (RewardsPool, FeeSink, etc.)
 		if alloc.Comment == "" || !isWalletComment(alloc.Comment) {
 			continue
 		}
 
-		weight := normalWeight
+		weight := uint64(normalWeight)
 		if alloc.Comment == "Wallet5" {
 			weight = weightedWeight
 		}
-		addressWeights[alloc.Address] = weight
+		addr, err := basics.UnmarshalChecksumAddress(alloc.Address)
+		require.NoError(t, err)
+		weights[addr] = weight
 		t.Logf("Address weight: %s (%s) = %d", alloc.Address, alloc.Comment, weight)
 	}
-
-	// Write to a JSON file in the network directory
-	weightsPath := filepath.Join(fixture.PrimaryDataDir(), "..", "address_weights.json")
-	weightsJSON, err := json.Marshal(addressWeights)
-	require.NoError(t, err, "failed to marshal address weights")
-	err = os.WriteFile(weightsPath, weightsJSON, 0644)
-	require.NoError(t, err, "failed to write address weights file")
-
-	return weightsPath
+	return weights
 }
 
 // isWalletComment checks if a genesis allocation comment is for a wallet
@@ -378,35 +344,19 @@ func buildAddressMapping(t *testing.T, fixture *fixtures.RestClientFixture, a *r
 	return addressToNode
 }
 
-// allocateBasePorts finds an available port range for daemons
-func allocateBasePorts(t *testing.T, count int) int {
-	basePort := 19001 + (os.Getpid()%1000)*10
-
-	for attempt := 0; attempt < 5; attempt++ {
-		allAvailable := true
-		for i := 0; i < count; i++ {
-			if !isPortAvailable(basePort + i) {
-				allAvailable = false
-				break
-			}
-		}
-		if allAvailable {
-			return basePort
-		}
-		basePort += 100
-	}
-	t.Fatalf("failed to find %d available ports", count)
-	return 0
-}
-
-// isPortAvailable checks if a TCP port is available for binding
-func isPortAvailable(port int) bool {
-	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
-	if err != nil {
-		return false
+// weightDaemonPorts returns the weight daemon port network generation assigned
+// to each node, in templateNodeOrder.
+func weightDaemonPorts(t *testing.T, fixture *fixtures.RestClientFixture) []int {
+	ports := make([]int, len(templateNodeOrder))
+	for i, name := range templateNodeOrder {
+		endpoint, err := fixture.WeightOracleEndpoint(name)
+		require.NoError(t, err)
+		require.NotNil(t, endpoint, "node %s has no weight daemon configured", name)
+		port, err := strconv.Atoi(endpoint.Port())
+		require.NoError(t, err)
+		ports[i] = port
 	}
-	ln.Close()
-	return true
+	return ports
 }
 
 // getDaemonPath returns the absolute path to daemon.py
@@ -421,10 +371,10 @@ func getDaemonPath() string {
 
 // startAllDaemonsWithWeights starts weight daemons for all nodes with a shared address weights file.
 // CRITICAL: All nodes must see the same weight for every address for consensus to work.
-func startAllDaemonsWithWeights(t *testing.T, basePort int, genesisHash string, addressWeightsFile string) []*weightDaemon {
-	daemons := make([]*weightDaemon, numTotalNodes)
-	for i := 0; i < numTotalNodes; i++ {
-		daemons[i] = startDaemonWithWeightsFile(t, basePort+i, totalWeight, genesisHash, addressWeightsFile)
+func startAllDaemonsWithWeights(t *testing.T, ports []int, genesisHash string, addressWeightsFile string) []*weightDaemon {
+	daemons := make([]*weightDaemon, len(ports))
+	for i, port := range ports {
+		daemons[i] = startDaemonWithWeightsFile(t, port, totalWeight, genesisHash, addressWeightsFile)
 	}
 	return daemons
 }
@@ -19,6 +19,7 @@ package fixtures
 import (
 	"bufio"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -437,6 +438,11 @@ func (f *LibGoalFixture) GetNodeDir(nodeName string) (string, error) {
 	return f.network.GetNodeDir(nodeName)
 }
 
+// WeightOracleEndpoint returns the weight daemon endpoint configured for the named node.
+func (f *LibGoalFixture) WeightOracleEndpoint(nodeName string) (*url.URL, error) {
+	return f.network.WeightOracleEndpoint(nodeName)
+}
+
 // GetNodeController returns the node controller that is associated with the given node name.
 func (f *LibGoalFixture) GetNodeController(nodeName string) (nodecontrol.NodeController, error) {
 	return f.network.GetNodeController(f.binDir, nodeName)
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2026 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package fixtures
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/node/weightoracle"
+)
+
+// weightDaemonReadyTimeout bounds how long StartWeightDaemon waits for a new
+// daemon to answer a ping.
+const weightDaemonReadyTimeout = 10 * time.Second
+
+// WeightDaemonIdentity returns the identity a weight daemon must report for the
+// nodes of the fixture's network to accept it.
+func (f *LibGoalFixture) WeightDaemonIdentity() ledgercore.DaemonIdentity {
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(f.rootDir, config.GenesisJSONFile))
+	require.NoError(f.t, err)
+	return ledgercore.DaemonIdentity{
+		GenesisHash:            genesis.Hash(),
+		WeightAlgorithmVersion: ledgercore.ExpectedWeightAlgorithmVersion,
+		WeightProtocolVersion:  ledgercore.ExpectedWeightProtocolVersion,
+	}
+}
+
+// StartWeightDaemons starts an in-process weight daemon serving oracle for every
+// node of the network, keyed by node name in the result. Call it after
+// SetupNoStart and before Start, since nodes check their daemon at startup.
+func (f *LibGoalFixture) StartWeightDaemons(oracle ledgercore.WeightOracle) map[string]*weightoracle.Server {
+	daemons := make(map[string]*weightoracle.Server)
+	for _, dir := range append(f.RelayDataDirs(), f.NodeDataDirs()...) {
+		name := filepath.Base(dir)
+		daemons[name] = f.StartWeightDaemon(name, oracle)
+	}
+	return daemons
+}
+
+// StartWeightDaemon starts an in-process weight daemon serving oracle for the
+// named node and waits until it answers. The daemon listens where the node's
+// config expects it; a node with no daemon configured gets one on a free local
+// port, which is written into its config. The daemon is closed when the test
+// ends, and may be closed earlier and started again to simulate an outage.
+func (f *LibGoalFixture) StartWeightDaemon(nodeName string, oracle ledgercore.WeightOracle) *weightoracle.Server {
+	dir, err := f.GetNodeDir(nodeName)
+	require.NoError(f.t, err)
+	cfg, err := config.LoadConfigFromDisk(dir)
+	require.NoError(f.t, err)
+	endpoint, err := cfg.ExternalWeightOracleEndpoint()
+	require.NoError(f.t, err)
+
+	addr := "127.0.0.1:0"
+	if endpoint != nil {
+		require.Equal(f.t, "http", endpoint.Scheme, "node %s expects its weight daemon at %s", nodeName, endpoint)
+		require.Empty(f.t, endpoint.Path, "node %s expects its weight daemon at %s", nodeName, endpoint)
+		addr = endpoint.Host
+	}
+	server, err := weightoracle.StartServer(addr, oracle)
+	require.NoError(f.t, err, "node %s", nodeName)
+	f.t.Cleanup(func() { server.Close() })
+
+	if endpoint == nil {
+		cfg.ExternalWeightOracleURL = server.URL().String()
+		cfg.ExternalWeightOraclePort = 0
+		require.NoError(f.t, cfg.SaveToDisk(dir))
+	}
+
+	client := weightoracle.NewClientURL(server.URL())
+	deadline := time.Now().Add(weightDaemonReadyTimeout)
+	for {
+		err = client.Ping()
+		if err == nil {
+			return server
+		}
+		if time.Now().After(deadline) {
+			require.NoError(f.t, fmt.Errorf("weight daemon for node %s not ready: %w", nodeName, err))
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}